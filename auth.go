@@ -0,0 +1,300 @@
+package tdlib
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// loginMaxAttempts is how many times Login asks for a rejected phone number, code or password before giving up
+const loginMaxAttempts = 5
+
+// Authenticator provides the credentials Login asks for while going through the authorization states
+type Authenticator interface {
+	// BotToken returns the token of a bot account, or an empty string to log in as a user
+	BotToken(ctx context.Context) (string, error)
+	// PhoneNumber returns the phone number of the user, or an empty string to log in with a QR code
+	PhoneNumber(ctx context.Context) (string, error)
+	// Code returns the authentication code sent as described by codeInfo
+	Code(ctx context.Context, codeInfo *AuthenticationCodeInfo) (string, error)
+	// Password returns the two-step verification password
+	Password(ctx context.Context, state *AuthorizationStateWaitPassword) (string, error)
+	// Registration returns the first and last name of a new user accepting the terms of service
+	Registration(ctx context.Context, termsOfService *TermsOfService) (firstName string, lastName string, err error)
+	// OtherDeviceConfirmation shows the tg:// link to scan as a QR code with a logged in device,
+	// it's called again every time the link is refreshed
+	OtherDeviceConfirmation(ctx context.Context, link string) error
+}
+
+// AuthenticationErrorHandler can be implemented by an Authenticator to be told why TDLib rejected
+// a phone number, code or password before Login asks for it again
+type AuthenticationErrorHandler interface {
+	Rejected(ctx context.Context, state AuthorizationState, err error)
+}
+
+// Login drives the authorization flow from updateAuthorizationState updates until authorizationStateReady,
+// asking auth for the credentials required by each state. Rejected credentials are asked for again,
+// up to 5 times. Login fails if ctx is done, if an answer is rejected in any other way or if the client is closed.
+func (client *Client) Login(ctx context.Context, auth Authenticator) error {
+	// only the latest state matters, a receiver being late on older ones must not block the client
+	receiver := client.AddEventReceiverWithPolicy(&UpdateAuthorizationState{}, func(msg *TdMessage) bool { return true }, 1, OverflowDropOldest)
+	defer client.RemoveEventReceiver(receiver)
+
+	state, err := client.GetAuthorizationStateContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var handled AuthorizationState
+	for {
+		// the same state may come both from GetAuthorizationState and from an update
+		if !sameAuthorizationState(state, handled) {
+			ready, err := client.handleAuthorizationState(ctx, auth, state)
+			if ready || err != nil {
+				return err
+			}
+			handled = state
+		}
+
+		select {
+		case msg, ok := <-receiver.Chan:
+			if !ok {
+				return ErrClosed
+			}
+			state = msg.(*UpdateAuthorizationState).AuthorizationState
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// sameAuthorizationState reports whether two states are equal, ignoring the @extra a state returned
+// by GetAuthorizationState carries and the same state received as an update doesn't
+func sameAuthorizationState(a AuthorizationState, b AuthorizationState) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return reflect.DeepEqual(withoutExtra(a), withoutExtra(b))
+}
+
+// withoutExtra converts an object to UpdateData without its @extra
+func withoutExtra(msg interface{}) UpdateData {
+	var data UpdateData
+	msgBytes, _ := json.Marshal(msg)
+	json.Unmarshal(msgBytes, &data)
+	delete(data, "@extra")
+	return data
+}
+
+// handleAuthorizationState answers a single authorization state, reports whether the authorization is complete
+func (client *Client) handleAuthorizationState(ctx context.Context, auth Authenticator, state AuthorizationState) (bool, error) {
+	switch state := state.(type) {
+	case *AuthorizationStateWaitTdlibParameters:
+		return false, client.sendTdLibParams(ctx)
+
+	case *AuthorizationStateWaitEncryptionKey:
+		return false, client.checkDatabaseEncryptionKey(ctx)
+
+	case *AuthorizationStateWaitPhoneNumber:
+		token, err := auth.BotToken(ctx)
+		if err != nil {
+			return false, err
+		}
+		if token != "" {
+			_, err = client.CheckAuthenticationBotTokenContext(ctx, token)
+			return false, err
+		}
+
+		return false, client.answerUntilAccepted(ctx, auth, state, func() error {
+			phoneNumber, err := auth.PhoneNumber(ctx)
+			if err != nil {
+				return err
+			}
+			if phoneNumber == "" {
+				_, err = client.RequestQrCodeAuthenticationContext(ctx, nil)
+				return err
+			}
+
+			_, err = client.SetAuthenticationPhoneNumberContext(ctx, phoneNumber, &PhoneNumberAuthenticationSettings{})
+			return err
+		})
+
+	case *AuthorizationStateWaitCode:
+		return false, client.answerUntilAccepted(ctx, auth, state, func() error {
+			code, err := auth.Code(ctx, state.CodeInfo)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.CheckAuthenticationCodeContext(ctx, code)
+			return err
+		})
+
+	case *AuthorizationStateWaitPassword:
+		return false, client.answerUntilAccepted(ctx, auth, state, func() error {
+			password, err := auth.Password(ctx, state)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.CheckAuthenticationPasswordContext(ctx, password)
+			return err
+		})
+
+	case *AuthorizationStateWaitRegistration:
+		return false, client.answerUntilAccepted(ctx, auth, state, func() error {
+			firstName, lastName, err := auth.Registration(ctx, state.TermsOfService)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RegisterUserContext(ctx, firstName, lastName)
+			return err
+		})
+
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		return false, auth.OtherDeviceConfirmation(ctx, state.Link)
+
+	case *AuthorizationStateReady:
+		return true, nil
+
+	case *AuthorizationStateLoggingOut, *AuthorizationStateClosing, *AuthorizationStateClosed:
+		return false, fmt.Errorf("%w: authorization state is %s", ErrClosed, state.GetAuthorizationStateEnum())
+	}
+
+	return false, fmt.Errorf("unexpected authorization state %s", state.GetAuthorizationStateEnum())
+}
+
+// answerUntilAccepted calls answer again while TDLib rejects it as a bad request, e.g. with PHONE_CODE_INVALID
+func (client *Client) answerUntilAccepted(ctx context.Context, auth Authenticator, state AuthorizationState, answer func() error) error {
+	var err error
+	for attempt := 0; attempt < loginMaxAttempts; attempt++ {
+		err = answer()
+		if !errors.Is(err, ErrBadRequest) {
+			return err
+		}
+
+		if handler, ok := auth.(AuthenticationErrorHandler); ok {
+			handler.Rejected(ctx, state, err)
+		}
+	}
+
+	return err
+}
+
+// BotAuthenticator returns an Authenticator logging in as the bot with the given token
+func BotAuthenticator(token string) Authenticator {
+	return botAuthenticator(token)
+}
+
+type botAuthenticator string
+
+func (token botAuthenticator) BotToken(ctx context.Context) (string, error) {
+	return string(token), nil
+}
+
+func (token botAuthenticator) PhoneNumber(ctx context.Context) (string, error) {
+	return "", errors.New("bot authenticator has no phone number")
+}
+
+func (token botAuthenticator) Code(ctx context.Context, codeInfo *AuthenticationCodeInfo) (string, error) {
+	return "", errors.New("bot authenticator has no authentication code")
+}
+
+func (token botAuthenticator) Password(ctx context.Context, state *AuthorizationStateWaitPassword) (string, error) {
+	return "", errors.New("bot authenticator has no password")
+}
+
+func (token botAuthenticator) Registration(ctx context.Context, termsOfService *TermsOfService) (string, string, error) {
+	return "", "", errors.New("bot authenticator can't register users")
+}
+
+func (token botAuthenticator) OtherDeviceConfirmation(ctx context.Context, link string) error {
+	return errors.New("bot authenticator can't confirm on another device")
+}
+
+// TerminalAuthenticator is an Authenticator prompting the user on a terminal
+type TerminalAuthenticator struct {
+	In  io.Reader // os.Stdin when nil
+	Out io.Writer // os.Stdout when nil
+
+	reader *bufio.Reader
+}
+
+// BotToken always logs in as a user
+func (auth *TerminalAuthenticator) BotToken(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+// PhoneNumber prompts for the phone number, an empty answer starts QR code login
+func (auth *TerminalAuthenticator) PhoneNumber(ctx context.Context) (string, error) {
+	return auth.prompt("Enter phone (empty to log in with a QR code): ")
+}
+
+// Code prompts for the authentication code
+func (auth *TerminalAuthenticator) Code(ctx context.Context, codeInfo *AuthenticationCodeInfo) (string, error) {
+	return auth.prompt("Enter code: ")
+}
+
+// Password prompts for the two-step verification password
+func (auth *TerminalAuthenticator) Password(ctx context.Context, state *AuthorizationStateWaitPassword) (string, error) {
+	if state.PasswordHint != "" {
+		return auth.prompt(fmt.Sprintf("Enter Password (hint: %s): ", state.PasswordHint))
+	}
+	return auth.prompt("Enter Password: ")
+}
+
+// Registration prompts for the first and last name of a new user
+func (auth *TerminalAuthenticator) Registration(ctx context.Context, termsOfService *TermsOfService) (string, string, error) {
+	if termsOfService != nil && termsOfService.Text != nil {
+		fmt.Fprintln(auth.out(), termsOfService.Text.Text)
+	}
+
+	firstName, err := auth.prompt("Enter first name: ")
+	if err != nil {
+		return "", "", err
+	}
+	lastName, err := auth.prompt("Enter last name: ")
+	return firstName, lastName, err
+}
+
+// OtherDeviceConfirmation prints the link to confirm the login with
+func (auth *TerminalAuthenticator) OtherDeviceConfirmation(ctx context.Context, link string) error {
+	_, err := fmt.Fprintf(auth.out(), "Confirm the login on another device: %s\n", link)
+	return err
+}
+
+// Rejected prints why the last answer was rejected
+func (auth *TerminalAuthenticator) Rejected(ctx context.Context, state AuthorizationState, err error) {
+	fmt.Fprintf(auth.out(), "Rejected: %v\n", err)
+}
+
+func (auth *TerminalAuthenticator) prompt(message string) (string, error) {
+	if auth.reader == nil {
+		in := auth.In
+		if in == nil {
+			in = os.Stdin
+		}
+		auth.reader = bufio.NewReader(in)
+	}
+
+	fmt.Fprint(auth.out(), message)
+	line, err := auth.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func (auth *TerminalAuthenticator) out() io.Writer {
+	if auth.Out == nil {
+		return os.Stdout
+	}
+	return auth.Out
+}
@@ -0,0 +1,81 @@
+package tdlib_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+// countingAuthenticator logs in as a user with a fixed phone number and counts how often it's asked for it
+type countingAuthenticator struct {
+	phoneNumbers int32
+}
+
+func (auth *countingAuthenticator) BotToken(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (auth *countingAuthenticator) PhoneNumber(ctx context.Context) (string, error) {
+	atomic.AddInt32(&auth.phoneNumbers, 1)
+	return "+10000000000", nil
+}
+
+func (auth *countingAuthenticator) Code(ctx context.Context, codeInfo *tdlib.AuthenticationCodeInfo) (string, error) {
+	return "12345", nil
+}
+
+func (auth *countingAuthenticator) Password(ctx context.Context, state *tdlib.AuthorizationStateWaitPassword) (string, error) {
+	return "", nil
+}
+
+func (auth *countingAuthenticator) Registration(ctx context.Context, termsOfService *tdlib.TermsOfService) (string, string, error) {
+	return "", "", nil
+}
+
+func (auth *countingAuthenticator) OtherDeviceConfirmation(ctx context.Context, link string) error {
+	return nil
+}
+
+func TestLoginHandlesRepeatedStateOnce(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.HandleFunc("getAuthorizationState", func(request tdlib.UpdateData) interface{} {
+		// TDLib announces the state with an update as well
+		server.PushUpdate(tdlib.NewUpdateAuthorizationState(tdlib.NewAuthorizationStateWaitPhoneNumber()))
+		return tdlib.NewAuthorizationStateWaitPhoneNumber()
+	})
+	server.HandleFunc("setAuthenticationPhoneNumber", func(request tdlib.UpdateData) interface{} {
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			server.PushUpdate(tdlib.NewUpdateAuthorizationState(tdlib.NewAuthorizationStateReady()))
+		}()
+		return tdlib.NewOk()
+	})
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	auth := &countingAuthenticator{}
+	if err := client.Login(ctx, auth); err != nil {
+		t.Fatalf("Login returned %v", err)
+	}
+
+	if prompts := atomic.LoadInt32(&auth.phoneNumbers); prompts != 1 {
+		t.Errorf("phone number asked %d times, want 1", prompts)
+	}
+
+	sent := 0
+	for _, request := range server.Requests() {
+		if request["@type"] == "setAuthenticationPhoneNumber" {
+			sent++
+		}
+	}
+	if sent != 1 {
+		t.Errorf("setAuthenticationPhoneNumber sent %d times, want 1", sent)
+	}
+}
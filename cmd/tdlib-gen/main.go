@@ -0,0 +1,86 @@
+// Command tdlib-gen generates the TDLib types, methods and typed update handlers of package tdlib
+// from a td_api.tl schema:
+//
+//	go run ./cmd/tdlib-gen -schema schema/td_api.tl -out .
+//
+// It writes types.go (the *Enum constants, structs and unmarshal* functions), unknown.go
+// (the Unknown* fallback of every abstract type for constructors missing from the schema), methods.go
+// (a Client method and its Context variant per TDLib function), requests.go (a *Request struct per TDLib
+// function, for Invoke), registry.go (the @type to Go type registry), updates.go (the OnUpdate* handlers)
+// and schema.go (SchemaVersion and SchemaCommitHash, set with -version and -commit).
+// The output only depends on the schema, so regenerating from the bundled schema reproduces the committed files.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// emptyConstructorDoc matches the doc comment of a constructor without parameters,
+// whose trailing "//" line go/format drops since Go 1.19
+var emptyConstructorDoc = regexp.MustCompile(`(?m)^(// New\w+ creates a new \w+\n)(func New\w+\(\))`)
+
+func main() {
+	schemaPath := flag.String("schema", "schema/td_api.tl", "path to the td_api.tl schema")
+	outDir := flag.String("out", ".", "directory of package tdlib")
+	version := flag.String("version", "", "TDLib version the schema belongs to, e.g. 1.7.0")
+	commitHash := flag.String("commit", "", "TDLib commit hash the schema was taken from")
+	flag.Parse()
+
+	if *version == "" {
+		fmt.Fprintln(os.Stderr, "tdlib-gen: -version is required")
+		os.Exit(2)
+	}
+	if *commitHash == "" {
+		fmt.Fprintln(os.Stderr, "tdlib-gen: warning: no -commit, the commit_hash of the linked TDLib won't be checked")
+	}
+
+	if err := run(*schemaPath, *outDir, *version, *commitHash); err != nil {
+		fmt.Fprintln(os.Stderr, "tdlib-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath string, outDir string, version string, commitHash string) error {
+	file, err := os.Open(schemaPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	schema, err := ParseSchema(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", schemaPath, err)
+	}
+
+	outputs := []struct {
+		name   string
+		source string
+	}{
+		{"types.go", generateTypes(schema)},
+		{"methods.go", generateMethods(schema)},
+		{"updates.go", generateUpdates(schema)},
+		{"requests.go", generateRequests(schema)},
+		{"registry.go", generateRegistry(schema)},
+		{"unknown.go", generateUnknown(schema)},
+		{"schema.go", generateSchemaVersion(version, commitHash)},
+	}
+
+	for _, output := range outputs {
+		source, err := format.Source([]byte(output.source))
+		if err != nil {
+			return fmt.Errorf("%s: %w", output.name, err)
+		}
+		source = emptyConstructorDoc.ReplaceAll(source, []byte("$1//\n$2"))
+
+		if err := os.WriteFile(filepath.Join(outDir, output.name), source, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
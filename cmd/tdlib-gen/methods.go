@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const methodsHeader = `package tdlib
+
+import (
+	"context"
+)
+
+`
+
+// generateMethods renders methods.go
+func generateMethods(schema *Schema) string {
+	var b strings.Builder
+	b.WriteString(methodsHeader)
+
+	for i, function := range schema.Functions {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		schema.writeFunction(&b, function)
+	}
+
+	return b.String()
+}
+
+func (schema *Schema) writeFunction(b *strings.Builder, function *Function) {
+	name := goName(function.Name)
+
+	var (
+		paramNames []string
+		params     []string
+	)
+	for _, param := range function.Params {
+		paramNames = append(paramNames, goParamName(param.Name))
+		params = append(params, goParamName(param.Name)+" "+schema.goType(param.Type))
+	}
+
+	result := "*" + goName(function.Result)
+	if schema.isClass(function.Result) {
+		result = goName(function.Result)
+	}
+
+	fmt.Fprintf(b, "%s\n", comment(name+" "+function.Description))
+	for _, param := range function.Params {
+		fmt.Fprintf(b, "%s\n", comment("@param "+goParamName(param.Name)+" "+param.Description))
+	}
+	fmt.Fprintf(b, "func (client *Client) %s(%s) (%s, error) {\n", name, strings.Join(params, ", "), result)
+	fmt.Fprintf(b, "\treturn client.%sContext(%s)\n}\n\n", name, strings.Join(append([]string{"context.Background()"}, paramNames...), ", "))
+
+	fmt.Fprintf(b, "// %sContext is %s with ctx controlling the request's cancellation and deadline\n", name, name)
+	fmt.Fprintf(b, "func (client *Client) %sContext(%s) (%s, error) {\n", name, strings.Join(append([]string{"ctx context.Context"}, params...), ", "), result)
+	fmt.Fprintf(b, "\treturn Invoke[%s](ctx, client, &%sRequest{", result, name)
+	if len(function.Params) > 0 {
+		b.WriteString("\n")
+		for _, param := range function.Params {
+			fmt.Fprintf(b, "\t\t%s: %s,\n", goFieldName(param.Name), goParamName(param.Name))
+		}
+		b.WriteString("\t")
+	}
+	b.WriteString("})\n}\n")
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"go/token"
+	"strings"
+)
+
+// keywordReplacer turns common initialisms into their golint spelling.
+// It replaces substrings, not words (e.g. identityCard becomes IDentityCard),
+// which is what the existing API was generated with.
+var keywordReplacer = strings.NewReplacer(
+	"Api", "API",
+	"Url", "URL",
+	"Id", "ID",
+	"Ttl", "TTL",
+	"Html", "HTML",
+	"Uri", "URI",
+	"Ip", "IP",
+	"Udp", "UDP",
+)
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// goName converts a TL type, class or function name to an exported Go name, e.g. inputFileId to InputFileID
+func goName(name string) string {
+	return keywordReplacer.Replace(upperFirst(name))
+}
+
+// goFieldName converts a TL parameter name to an exported Go field name, e.g. chat_id to ChatID
+func goFieldName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		parts[i] = upperFirst(part)
+	}
+	return keywordReplacer.Replace(strings.Join(parts, ""))
+}
+
+// paramNames are parameter names that would shadow a Go keyword, an imported package or a builtin type,
+// with the names the existing API uses instead
+var paramNames = map[string]string{
+	"json":  "jsonstring",
+	"types": "typeParams",
+}
+
+// goParamName converts a TL parameter name to a Go function parameter name, e.g. chat_id to chatID
+func goParamName(name string) string {
+	param := lowerFirst(goFieldName(name))
+	if replacement, ok := paramNames[param]; ok {
+		return replacement
+	}
+	if token.IsKeyword(param) {
+		param += "Param"
+	}
+	return param
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Schema is the parsed content of a td_api.tl file
+type Schema struct {
+	Classes      []*Class
+	Constructors []*Constructor
+	Functions    []*Function
+
+	classes      map[string]*Class
+	constructors map[string]*Constructor
+}
+
+// Class is an abstract type, e.g. AuthorizationState
+type Class struct {
+	Name         string
+	Description  string
+	Constructors []*Constructor
+}
+
+// Constructor is a concrete type, e.g. authorizationStateReady
+type Constructor struct {
+	Name        string
+	Description string
+	Params      []*Param
+	Class       *Class // nil if the type isn't a sub-class of an abstract type
+}
+
+// Function is a TDLib method, e.g. getAuthorizationState
+type Function struct {
+	Name        string
+	Description string
+	Params      []*Param
+	Result      string
+}
+
+// Param is a field of a constructor or a parameter of a function
+type Param struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// builtinTypes are declared at the top of td_api.tl and mapped to Go types directly
+var builtinTypes = map[string]bool{
+	"Double": true,
+	"String": true,
+	"Int32":  true,
+	"Int53":  true,
+	"Int64":  true,
+	"Bytes":  true,
+	"Bool":   true,
+	"Vector": true,
+}
+
+var (
+	declarationPattern = regexp.MustCompile(`^(\w+)((?:\s+\w+:[\w<>]+)*)\s*=\s*(\w+);$`)
+	paramPattern       = regexp.MustCompile(`(\w+):([\w<>]+)`)
+	docTagPattern      = regexp.MustCompile(`(?:^|\s)@(\w+)`)
+)
+
+// ParseSchema reads a td_api.tl file
+func ParseSchema(r io.Reader) (*Schema, error) {
+	schema := &Schema{
+		classes:      make(map[string]*Class),
+		constructors: make(map[string]*Constructor),
+	}
+
+	var (
+		doc         []string
+		isFunctions bool
+		lineNumber  int
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			// a class declaration is a comment on its own
+			if len(doc) > 0 && strings.HasPrefix(doc[0], "@class") {
+				if err := schema.addClass(strings.Join(doc, " ")); err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+				}
+				doc = nil
+			}
+
+		case line == "---functions---":
+			isFunctions = true
+			doc = nil
+
+		case strings.HasPrefix(line, "---"):
+			doc = nil
+
+		case strings.HasPrefix(line, "//-"):
+			if len(doc) > 0 {
+				doc[len(doc)-1] += " " + strings.TrimSpace(line[3:])
+			}
+
+		case strings.HasPrefix(line, "//"):
+			doc = append(doc, strings.TrimSpace(line[2:]))
+
+		default:
+			if err := schema.addDeclaration(line, strings.Join(doc, " "), isFunctions); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			doc = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return schema, schema.resolve()
+}
+
+func (schema *Schema) addClass(doc string) error {
+	tags := parseDoc(doc, nil)
+	name := tags["class"]
+	if name == "" {
+		return fmt.Errorf("class declaration without a name")
+	}
+
+	class := &Class{Name: name, Description: tags["description"]}
+	schema.Classes = append(schema.Classes, class)
+	schema.classes[name] = class
+	return nil
+}
+
+func (schema *Schema) addDeclaration(line string, doc string, isFunction bool) error {
+	// built-in types, e.g. double ? = Double; or vector {t:Type} # [ t ] = Vector t;
+	if index := strings.LastIndex(line, "="); !isFunction && index >= 0 {
+		if result := strings.Fields(strings.TrimSuffix(line[index+1:], ";")); len(result) > 0 && builtinTypes[result[0]] {
+			return nil
+		}
+	}
+
+	match := declarationPattern.FindStringSubmatch(line)
+	if match == nil {
+		return fmt.Errorf("can't parse %q", line)
+	}
+	name, result := match[1], strings.TrimSpace(match[3])
+
+	var params []*Param
+	names := make(map[string]bool)
+	for _, paramMatch := range paramPattern.FindAllStringSubmatch(match[2], -1) {
+		params = append(params, &Param{Name: paramMatch[1], Type: paramMatch[2]})
+		names[paramMatch[1]] = true
+	}
+
+	tags := parseDoc(doc, names)
+	for _, param := range params {
+		param.Description = tags[param.Name]
+		if param.Name == "description" {
+			param.Description = tags["param_description"]
+		}
+	}
+
+	if isFunction {
+		schema.Functions = append(schema.Functions, &Function{
+			Name:        name,
+			Description: tags["description"],
+			Params:      params,
+			Result:      result,
+		})
+		return nil
+	}
+
+	constructor := &Constructor{
+		Name:        name,
+		Description: tags["description"],
+		Params:      params,
+	}
+	if result != upperFirst(name) {
+		// the class is bound once every declaration has been read, it may be declared later
+		constructor.Class = &Class{Name: result}
+	}
+	schema.Constructors = append(schema.Constructors, constructor)
+	schema.constructors[name] = constructor
+	return nil
+}
+
+// resolve binds constructors to their classes
+func (schema *Schema) resolve() error {
+	for _, constructor := range schema.Constructors {
+		if constructor.Class == nil {
+			continue
+		}
+
+		class, ok := schema.classes[constructor.Class.Name]
+		if !ok {
+			// undeclared class, e.g. in a schema without //@class comments
+			class = &Class{Name: constructor.Class.Name}
+			schema.Classes = append(schema.Classes, class)
+			schema.classes[class.Name] = class
+		}
+		constructor.Class = class
+		class.Constructors = append(class.Constructors, constructor)
+	}
+
+	for _, class := range schema.Classes {
+		if len(class.Constructors) == 0 {
+			return fmt.Errorf("class %s has no constructors", class.Name)
+		}
+	}
+
+	return nil
+}
+
+// parseDoc splits a //@tag documentation comment into its tags.
+// Only known tags start a new entry, so a stray @ inside a description is kept as text.
+func parseDoc(doc string, params map[string]bool) map[string]string {
+	tags := make(map[string]string)
+
+	known := func(tag string) bool {
+		return tag == "description" || tag == "class" || tag == "param_description" || params[tag]
+	}
+
+	var (
+		current string
+		start   int
+	)
+	for _, loc := range docTagPattern.FindAllStringSubmatchIndex(doc, -1) {
+		tag := doc[loc[2]:loc[3]]
+		if !known(tag) {
+			continue
+		}
+		if current != "" {
+			tags[current] = strings.TrimSpace(doc[start:loc[0]])
+		}
+		current, start = tag, loc[1]
+	}
+	if current != "" {
+		tags[current] = strings.TrimSpace(doc[start:])
+	}
+
+	return tags
+}
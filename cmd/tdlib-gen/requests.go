@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// generateRequests renders requests.go, a request struct per TDLib function to be sent with Invoke
+func generateRequests(schema *Schema) string {
+	var b strings.Builder
+	b.WriteString("package tdlib\n\nimport (\n\t\"encoding/json\"\n)\n\n")
+
+	for _, function := range schema.Functions {
+		name := goName(function.Name) + "Request"
+		receiver := lowerFirst(name)
+
+		fmt.Fprintf(&b, "%s\n", comment(name+" is the request of "+goName(function.Name)+": "+function.Description))
+		fmt.Fprintf(&b, "type %s struct {\n", name)
+		for _, param := range function.Params {
+			schema.writeField(&b, param)
+		}
+		b.WriteString("}\n\n")
+
+		fmt.Fprintf(&b, "// MessageType return the string telegram-type of %s\n", name)
+		fmt.Fprintf(&b, "func (%s *%s) MessageType() string {\n\treturn %q\n}\n\n", receiver, name, function.Name)
+
+		fmt.Fprintf(&b, "// MarshalJSON marshals to json, with @type set\n")
+		fmt.Fprintf(&b, "func (%s %s) MarshalJSON() ([]byte, error) {\n", receiver, name)
+		fmt.Fprintf(&b, "\ttype alias %s\n", name)
+		b.WriteString("\treturn json.Marshal(struct {\n\t\tType string `json:\"@type\"`\n\t\talias\n\t}{")
+		fmt.Fprintf(&b, "%q, alias(%s)})\n}\n\n", function.Name, receiver)
+
+		// so that requests stored as JSON can be decoded back, see Decode
+		if schema.hasClassFields(function.Params) {
+			schema.writeUnmarshalJSON(&b, name, function.Params, false)
+		}
+	}
+
+	return b.String()
+}
+
+// generateRegistry renders registry.go, which maps the @type of every object to its Go type
+func generateRegistry(schema *Schema) string {
+	var b strings.Builder
+	b.WriteString("package tdlib\n\n")
+	b.WriteString("// typeRegistry creates an empty object of the Go type of an @type, e.g. &Chat{} for chat\n")
+	b.WriteString("// or &GetChatRequest{} for getChat\n")
+	b.WriteString("var typeRegistry = map[string]func() TdMessage{\n")
+	for _, constructor := range schema.Constructors {
+		fmt.Fprintf(&b, "\t%q: func() TdMessage { return &%s{} },\n", constructor.Name, goName(constructor.Name))
+	}
+	for _, function := range schema.Functions {
+		fmt.Fprintf(&b, "\t%q: func() TdMessage { return &%sRequest{} },\n", function.Name, goName(function.Name))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// generateSchemaVersion renders schema.go, which records the TDLib release the schema was taken from
+func generateSchemaVersion(version string, commitHash string) string {
+	var b strings.Builder
+	b.WriteString("package tdlib\n\n")
+	b.WriteString("// SchemaVersion is the TDLib version the types and methods were generated for,\n")
+	b.WriteString("// it is compared with the version option of the connected TDLib on startup\n")
+	fmt.Fprintf(&b, "const SchemaVersion = %q\n\n", version)
+	b.WriteString("// SchemaCommitHash is the TDLib commit the schema was taken from, it is compared with the commit_hash option\n")
+	b.WriteString("// of the connected TDLib on startup; empty if unknown\n")
+	fmt.Fprintf(&b, "const SchemaCommitHash = %q\n", commitHash)
+	return b.String()
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const typesHeader = `package tdlib
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+type tdCommon struct {
+	Type  string ` + "`json:\"@type\"`" + `
+	Extra string ` + "`json:\"@extra\"`" + `
+}
+
+// JSONInt64 alias for int64, in order to deal with json big number problem.
+// Every 64-bit integer is a JSONInt64, it is encoded as a string like TDLib does and decoded from a string or a number.
+type JSONInt64 int64
+
+// MarshalJSON marshals to json, as a string so that values above 2^53 don't lose precision
+func (jsonInt JSONInt64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatInt(int64(jsonInt), 10))), nil
+}
+
+// UnmarshalJSON unmarshals from json, either a string or a number
+func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
+	intStr := string(b)
+	if intStr == "null" {
+		return nil
+	}
+	intStr = strings.Replace(intStr, "\"", "", 2)
+	jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
+	if err != nil {
+		return err
+	}
+	*jsonInt = JSONInt64(jsonBigInt)
+	return nil
+}
+
+// TdMessage is the interface for all messages send and received to/from tdlib
+type TdMessage interface {
+	MessageType() string
+}
+
+`
+
+// isClass reports whether the TL type is abstract
+func (schema *Schema) isClass(tlType string) bool {
+	_, ok := schema.classes[tlType]
+	return ok
+}
+
+// goType returns the Go type of a TL parameter type
+func (schema *Schema) goType(tlType string) string {
+	switch tlType {
+	case "int32":
+		return "int32"
+	case "int53", "int64":
+		return "JSONInt64"
+	case "double":
+		return "float64"
+	case "string":
+		return "string"
+	case "Bool":
+		return "bool"
+	case "bytes":
+		return "[]byte"
+	}
+
+	if strings.HasPrefix(tlType, "vector<") && strings.HasSuffix(tlType, ">") {
+		return "[]" + strings.TrimPrefix(schema.goType(tlType[len("vector<"):len(tlType)-1]), "*")
+	}
+
+	if schema.isClass(tlType) {
+		return goName(tlType)
+	}
+
+	return "*" + goName(tlType)
+}
+
+// comment returns a line comment, without a trailing space if text is empty
+func comment(text string) string {
+	return strings.TrimRight("// "+text, " ")
+}
+
+// generateTypes renders types.go
+func generateTypes(schema *Schema) string {
+	var b strings.Builder
+	b.WriteString(typesHeader)
+
+	for i, class := range schema.Classes {
+		name := goName(class.Name)
+		fmt.Fprintf(&b, "// %sEnum Alias for abstract %s 'Sub-Classes', used as constant-enum here\n", name, name)
+		fmt.Fprintf(&b, "type %sEnum string\n\n", name)
+		fmt.Fprintf(&b, "// %s enums\n", name)
+		b.WriteString("const (\n")
+		for _, constructor := range class.Constructors {
+			constructorName := goName(constructor.Name)
+			// the values are derived from the Go name, not the TL name, as they always have been
+			fmt.Fprintf(&b, "\t%sType %sEnum = %q\n", constructorName, name, lowerFirst(constructorName))
+		}
+		b.WriteString(")")
+		if i < len(schema.Classes)-1 {
+			b.WriteString("\n\n")
+		}
+	}
+	b.WriteString(" ")
+
+	for _, class := range schema.Classes {
+		name := goName(class.Name)
+		fmt.Fprintf(&b, "%s\n", comment(name+" "+class.Description))
+		fmt.Fprintf(&b, "type %s interface {\n\tGet%sEnum() %sEnum\n}\n\n", name, name, name)
+	}
+
+	for _, constructor := range schema.Constructors {
+		schema.writeConstructor(&b, constructor)
+	}
+
+	for i, class := range schema.Classes {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		schema.writeClassUnmarshaler(&b, class)
+	}
+
+	return b.String()
+}
+
+func (schema *Schema) writeConstructor(b *strings.Builder, constructor *Constructor) {
+	name := goName(constructor.Name)
+	receiver := lowerFirst(name)
+
+	fmt.Fprintf(b, "%s\n", comment(name+" "+constructor.Description))
+	fmt.Fprintf(b, "type %s struct {\n\ttdCommon\n", name)
+	for _, param := range constructor.Params {
+		schema.writeField(b, param)
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(b, "// MessageType return the string telegram-type of %s\n", name)
+	fmt.Fprintf(b, "func (%s *%s) MessageType() string {\n\treturn %q\n}\n\n", receiver, name, constructor.Name)
+
+	// a value receiver, so that values and struct literals nested anywhere get their @type too
+	fmt.Fprintf(b, "// MarshalJSON marshals to json, with @type set even if %s wasn't created by New%s\n", name, name)
+	fmt.Fprintf(b, "func (%s %s) MarshalJSON() ([]byte, error) {\n", receiver, name)
+	fmt.Fprintf(b, "\ttype alias %s\n\t%s.tdCommon.Type = %q\n\treturn json.Marshal(alias(%s))\n}\n\n", name, receiver, constructor.Name, receiver)
+
+	fmt.Fprintf(b, "// New%s creates a new %s\n//\n", name, name)
+	args := make([]string, 0, len(constructor.Params))
+	for _, param := range constructor.Params {
+		fmt.Fprintf(b, "%s\n", comment("@param "+goParamName(param.Name)+" "+param.Description))
+		args = append(args, goParamName(param.Name)+" "+schema.goType(param.Type))
+	}
+	fmt.Fprintf(b, "func New%s(%s) *%s {\n", name, strings.Join(args, ", "), name)
+	fmt.Fprintf(b, "\t%sTemp := %s{\n", receiver, name)
+	fmt.Fprintf(b, "\t\ttdCommon: tdCommon{Type: %q},\n", constructor.Name)
+	for _, param := range constructor.Params {
+		fmt.Fprintf(b, "\t\t%s: %s,\n", goFieldName(param.Name), goParamName(param.Name))
+	}
+	fmt.Fprintf(b, "\t}\n\n\treturn &%sTemp\n}\n\n", receiver)
+
+	if schema.hasClassFields(constructor.Params) {
+		schema.writeUnmarshalJSON(b, name, constructor.Params, true)
+	}
+
+	if constructor.Class != nil {
+		className := goName(constructor.Class.Name)
+		fmt.Fprintf(b, "// Get%sEnum return the enum type of this object\n", className)
+		fmt.Fprintf(b, "func (%s *%s) Get%sEnum() %sEnum {\n\treturn %sType\n}\n\n", receiver, name, className, className, name)
+	}
+}
+
+func (schema *Schema) writeField(b *strings.Builder, param *Param) {
+	fmt.Fprintf(b, "\t%s %s `json:\"%s\"` %s\n", goFieldName(param.Name), schema.goType(param.Type), param.Name, comment(param.Description))
+}
+
+// hasClassFields reports whether a constructor or function has fields of an abstract type, which need a custom UnmarshalJSON.
+// Vectors of abstract types are left to encoding/json.
+func (schema *Schema) hasClassFields(params []*Param) bool {
+	for _, param := range params {
+		if schema.isClass(param.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUnmarshalJSON renders the UnmarshalJSON of the struct name with the given fields,
+// withCommon is false for the request structs, which have no tdCommon
+func (schema *Schema) writeUnmarshalJSON(b *strings.Builder, name string, params []*Param, withCommon bool) {
+	receiver := lowerFirst(name)
+
+	b.WriteString("// UnmarshalJSON unmarshal to json\n")
+	fmt.Fprintf(b, "func (%s *%s) UnmarshalJSON(b []byte) error {\n", receiver, name)
+	b.WriteString("\tvar objMap map[string]*json.RawMessage\n\terr := json.Unmarshal(b, &objMap)\n\tif err != nil {\n\t\treturn err\n\t}\n")
+	b.WriteString("\ttempObj := struct {\n")
+	if withCommon {
+		b.WriteString("\t\ttdCommon\n")
+	}
+	written := false
+	for _, param := range params {
+		if !schema.isClass(param.Type) {
+			b.WriteString("\t")
+			schema.writeField(b, param)
+			written = true
+		}
+	}
+	// kept for byte-compatibility with the previously generated files
+	if written && schema.isClass(params[len(params)-1].Type) {
+		b.WriteString("\n")
+	}
+	b.WriteString("\t}{}\n\terr = json.Unmarshal(b, &tempObj)\n\tif err != nil {\n\t\treturn err\n\t}\n\n")
+
+	if withCommon {
+		fmt.Fprintf(b, "\t%s.tdCommon = tempObj.tdCommon\n", receiver)
+	}
+	for _, param := range params {
+		if !schema.isClass(param.Type) {
+			fmt.Fprintf(b, "\t%s.%s = tempObj.%s\n", receiver, goFieldName(param.Name), goFieldName(param.Name))
+		}
+	}
+	b.WriteString("\n")
+
+	for _, param := range params {
+		if schema.isClass(param.Type) {
+			field := goFieldName(param.Name)
+			fmt.Fprintf(b, "\tfield%s, _ := unmarshal%s(objMap[%q])\n", field, goName(param.Type), param.Name)
+			fmt.Fprintf(b, "\t%s.%s = field%s\n\n", receiver, field, field)
+		}
+	}
+	b.WriteString("\treturn nil\n}\n\n")
+}
+
+func (schema *Schema) writeClassUnmarshaler(b *strings.Builder, class *Class) {
+	name := goName(class.Name)
+
+	fmt.Fprintf(b, "func unmarshal%s(rawMsg *json.RawMessage) (%s, error) {\n\n", name, name)
+	b.WriteString("\tif rawMsg == nil {\n\t\treturn nil, nil\n\t}\n")
+	b.WriteString("\tvar objMap map[string]interface{}\n\terr := json.Unmarshal(*rawMsg, &objMap)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	fmt.Fprintf(b, "\tswitch %sEnum(objMap[\"@type\"].(string)) {\n", name)
+	for _, constructor := range class.Constructors {
+		constructorName := goName(constructor.Name)
+		variable := lowerFirst(constructorName)
+		fmt.Fprintf(b, "\tcase %sType:\n", constructorName)
+		fmt.Fprintf(b, "\t\tvar %s %s\n", variable, constructorName)
+		fmt.Fprintf(b, "\t\terr := json.Unmarshal(*rawMsg, &%s)\n", variable)
+		fmt.Fprintf(b, "\t\treturn &%s, err\n\n", variable)
+	}
+	b.WriteString("\tdefault:\n")
+	fmt.Fprintf(b, "\t\tvar unknown %s\n", unknownName(class))
+	b.WriteString("\t\terr := json.Unmarshal(*rawMsg, &unknown)\n")
+	b.WriteString("\t\treturn &unknown, err\n")
+	b.WriteString("\t}\n}\n")
+}
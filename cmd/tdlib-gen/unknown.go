@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const unknownHeader = `package tdlib
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// unknownType is the Unknown* fallback of an abstract type
+type unknownType struct {
+	prefix     string       // the common prefix of the @type of its constructors, e.g. authorizationState
+	class      reflect.Type // the abstract type, e.g. AuthorizationState
+	newUnknown func() TdMessage
+}
+
+`
+
+// unknownName returns the name of the fallback type of a class, e.g. UnknownMessageContent.
+// The Unknown prefix avoids TDLib's own constructors like userTypeUnknown.
+func unknownName(class *Class) string {
+	return "Unknown" + goName(class.Name)
+}
+
+// generateUnknown renders unknown.go, a fallback type per class for the constructors the schema doesn't have,
+// which the unmarshal* functions return instead of failing
+func generateUnknown(schema *Schema) string {
+	var b strings.Builder
+	b.WriteString(unknownHeader)
+
+	b.WriteString("// unknownTypes lists the Unknown* fallback of every abstract type\n")
+	b.WriteString("var unknownTypes = []unknownType{\n")
+	for _, class := range schema.Classes {
+		fmt.Fprintf(&b, "\t{%q, reflect.TypeOf((*%s)(nil)).Elem(), func() TdMessage { return &%s{} }},\n", constructorPrefix(class), goName(class.Name), unknownName(class))
+	}
+	b.WriteString("}\n\n")
+
+	for i, class := range schema.Classes {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		name := goName(class.Name)
+		unknown := unknownName(class)
+		receiver := lowerFirst(unknown)
+
+		fmt.Fprintf(&b, "// %s is the %s fallback for types missing from the schema, e.g. ones added by a newer TDLib.\n", unknown, name)
+		b.WriteString("// Raw keeps the whole object, so it can still be inspected or sent back.\n")
+		fmt.Fprintf(&b, "type %s struct {\n\ttdCommon\n\tRaw json.RawMessage `json:\"-\"` // The object as received\n}\n\n", unknown)
+
+		fmt.Fprintf(&b, "// MessageType return the string telegram-type of %s\n", unknown)
+		fmt.Fprintf(&b, "func (%s *%s) MessageType() string {\n\treturn %s.Type\n}\n\n", receiver, unknown, receiver)
+
+		fmt.Fprintf(&b, "// Get%sEnum return the enum type of this object\n", name)
+		fmt.Fprintf(&b, "func (%s *%s) Get%sEnum() %sEnum {\n\treturn %sEnum(%s.Type)\n}\n\n", receiver, unknown, name, name, name, receiver)
+
+		b.WriteString("// UnmarshalJSON unmarshal to json\n")
+		fmt.Fprintf(&b, "func (%s *%s) UnmarshalJSON(b []byte) error {\n", receiver, unknown)
+		fmt.Fprintf(&b, "\t%s.Raw = append(json.RawMessage(nil), b...)\n", receiver)
+		fmt.Fprintf(&b, "\treturn json.Unmarshal(b, &%s.tdCommon)\n}\n\n", receiver)
+
+		b.WriteString("// MarshalJSON marshals to json, the object as received\n")
+		fmt.Fprintf(&b, "func (%s %s) MarshalJSON() ([]byte, error) {\n", receiver, unknown)
+		fmt.Fprintf(&b, "\tif %s.Raw == nil {\n\t\treturn json.Marshal(%s.tdCommon)\n\t}\n", receiver, receiver)
+		fmt.Fprintf(&b, "\treturn %s.Raw, nil\n}\n", receiver)
+	}
+
+	return b.String()
+}
+
+// constructorPrefix returns the prefix shared by the names of the constructors of a class, which is the class name
+// except for a few classes like MessageContent, whose constructors are named message*
+func constructorPrefix(class *Class) string {
+	prefix := lowerFirst(class.Name)
+	for _, constructor := range class.Constructors {
+		for !strings.HasPrefix(constructor.Name, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
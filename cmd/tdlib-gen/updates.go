@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// updateClass is the class of the updates dispatched to the typed OnUpdate* handlers
+const updateClass = "Update"
+
+// generateUpdates renders updates.go
+func generateUpdates(schema *Schema) string {
+	var b strings.Builder
+	b.WriteString("package tdlib\n")
+
+	class, ok := schema.classes[updateClass]
+	if !ok {
+		return b.String()
+	}
+
+	for _, constructor := range class.Constructors {
+		name := goName(constructor.Name)
+		fmt.Fprintf(&b, "\n// On%s Registers a handler called for every %s: %s\n", name, constructor.Name, constructor.Description)
+		fmt.Fprintf(&b, "func (client *Client) On%s(handler func(update *%s)) {\n", name, name)
+		fmt.Fprintf(&b, "\tclient.addUpdateHandler(%sType, func(update Update) {\n", name)
+		fmt.Fprintf(&b, "\t\thandler(update.(*%s))\n", name)
+		b.WriteString("\t})\n}\n")
+	}
+
+	return b.String()
+}
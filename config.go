@@ -0,0 +1,176 @@
+package tdlib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix is the prefix of the environment variables read by LoadConfig,
+// e.g. TDLIB_API_ID or TDLIB_USE_MESSAGE_DATABASE
+const EnvPrefix = "TDLIB_"
+
+// envDatabaseEncryptionKey holds the database encryption key, which is never read from config files
+const envDatabaseEncryptionKey = EnvPrefix + "DATABASE_ENCRYPTION_KEY"
+
+var apiHashPattern = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+
+// ConfigError lists every problem found in a Config
+type ConfigError struct {
+	Problems []string
+}
+
+func (e *ConfigError) Error() string {
+	return "invalid tdlib config: " + strings.Join(e.Problems, "; ")
+}
+
+// LoadConfig Reads a Config from a JSON, YAML or TOML file (chosen by extension),
+// overrides it with TDLIB_* environment variables and validates the result.
+// Keys are the tdlibParameters names, e.g. api_id, api_hash, use_test_dc;
+// api_id is a string, so quote it in JSON and TOML files.
+// If path is empty, the Config is built from the environment only.
+func LoadConfig(path string) (Config, error) {
+	var config Config
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return config, err
+		}
+
+		switch ext := strings.ToLower(filepath.Ext(path)); ext {
+		case ".json":
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.DisallowUnknownFields()
+			err = decoder.Decode(&config)
+		case ".yaml", ".yml":
+			decoder := yaml.NewDecoder(bytes.NewReader(data))
+			decoder.KnownFields(true)
+			err = decoder.Decode(&config)
+		case ".toml":
+			var meta toml.MetaData
+			meta, err = toml.Decode(string(data), &config)
+			if err == nil {
+				for _, key := range meta.Undecoded() {
+					// Options decodes its own table, toml still lists those keys as undecoded
+					if len(key) > 1 && key[0] == "options" {
+						continue
+					}
+					err = fmt.Errorf("unknown key %q", key.String())
+					break
+				}
+			}
+		default:
+			return config, fmt.Errorf("unsupported config file extension %q", ext)
+		}
+
+		if err != nil {
+			return config, fmt.Errorf("error parsing %s: %w", path, err)
+		}
+	}
+
+	if err := config.loadEnv(); err != nil {
+		return config, err
+	}
+
+	return config, config.Validate()
+}
+
+// loadEnv overrides config fields with their TDLIB_* environment variables
+func (config *Config) loadEnv() error {
+	value := reflect.ValueOf(config).Elem()
+	configType := value.Type()
+
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		env := EnvPrefix + strings.ToUpper(name)
+		raw, ok := os.LookupEnv(env)
+		if !ok {
+			continue
+		}
+
+		switch field.Type.Kind() {
+		case reflect.String:
+			value.Field(i).SetString(raw)
+		case reflect.Bool:
+			b, err := strconv.ParseBool(raw)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %q is not a boolean", env, raw)
+			}
+			value.Field(i).SetBool(b)
+		}
+	}
+
+	if key, ok := os.LookupEnv(envDatabaseEncryptionKey); ok {
+		config.DatabaseEncryptionKey = []byte(key)
+	}
+
+	return nil
+}
+
+// Validate Checks that every required field is set and well-formed.
+// The returned *ConfigError reports all problems at once, not only the first one.
+func (config Config) Validate() error {
+	var problems []string
+
+	if config.APIID == "" {
+		problems = append(problems, "api_id must be non-empty")
+	} else if id, err := strconv.ParseInt(config.APIID, 10, 32); err != nil || id <= 0 {
+		problems = append(problems, fmt.Sprintf("api_id %q must be a positive integer", config.APIID))
+	}
+
+	if config.APIHash == "" {
+		problems = append(problems, "api_hash must be non-empty")
+	} else if !apiHashPattern.MatchString(config.APIHash) {
+		problems = append(problems, "api_hash must be 32 hexadecimal characters")
+	}
+
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"system_language_code", config.SystemLanguageCode},
+		{"device_model", config.DeviceModel},
+		{"system_version", config.SystemVersion},
+		{"application_version", config.ApplicationVersion},
+	}
+	for _, field := range required {
+		if strings.TrimSpace(field.value) == "" {
+			problems = append(problems, field.name+" must be non-empty")
+		}
+	}
+
+	for _, name := range config.Options.names() {
+		if name == "" {
+			problems = append(problems, "options must not contain an empty option name")
+		} else if config.Options[name] == nil {
+			problems = append(problems, fmt.Sprintf("option %s has no value", name))
+		}
+	}
+
+	switch config.SchemaMismatch {
+	case "", SchemaMismatchWarn, SchemaMismatchError, SchemaMismatchIgnore:
+	default:
+		problems = append(problems, fmt.Sprintf("schema_mismatch %q must be warn, error or ignore", config.SchemaMismatch))
+	}
+
+	if len(problems) > 0 {
+		return &ConfigError{Problems: problems}
+	}
+
+	return nil
+}
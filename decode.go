@@ -0,0 +1,69 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// NewByType Creates an empty object of the Go type of the given @type, e.g. &MessagePhoto{} for messagePhoto
+// or &GetChatRequest{} for getChat. Returns nil if the type is unknown.
+func NewByType(name string) TdMessage {
+	newMsg, ok := typeRegistry[name]
+	if !ok {
+		return nil
+	}
+	return newMsg()
+}
+
+// Decode Unmarshals any TDLib object, e.g. the Raw of an UpdateMsg received on GetRawUpdatesChannel,
+// into the Go type of its @type. An object of a type missing from the schema is decoded into the Unknown* fallback
+// of the abstract type its name belongs to, e.g. *UnknownUpdate for updateStory.
+func Decode(raw []byte) (TdMessage, error) {
+	var header struct {
+		Type string `json:"@type"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+
+	msg := NewByType(header.Type)
+	if msg == nil {
+		msg = newUnknownByName(header.Type)
+	}
+	if msg == nil {
+		return nil, fmt.Errorf("tdlib: unknown type %q", header.Type)
+	}
+
+	if err := json.Unmarshal(raw, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// newUnknownByName creates the Unknown* fallback of the abstract type whose constructors share the longest prefix
+// with name, e.g. &UnknownAuthorizationState{} for authorizationStateWaitEmailAddress. Returns nil if none does.
+func newUnknownByName(name string) TdMessage {
+	var match *unknownType
+	for i := range unknownTypes {
+		candidate := &unknownTypes[i]
+		if strings.HasPrefix(name, candidate.prefix) && (match == nil || len(candidate.prefix) > len(match.prefix)) {
+			match = candidate
+		}
+	}
+	if match == nil {
+		return nil
+	}
+	return match.newUnknown()
+}
+
+// newUnknownOf creates the Unknown* fallback of an abstract type, nil if class isn't one
+func newUnknownOf(class reflect.Type) TdMessage {
+	for _, unknown := range unknownTypes {
+		if unknown.class == class {
+			return unknown.newUnknown()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package tdlib_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+func TestDecodeUnknownTypes(t *testing.T) {
+	tests := []struct {
+		raw      string
+		wantType string
+	}{
+		{`{"@type":"updateStory","story":{}}`, "*tdlib.UnknownUpdate"},
+		{`{"@type":"authorizationStateWaitEmailAddress"}`, "*tdlib.UnknownAuthorizationState"},
+		{`{"@type":"messageStory","story_id":7}`, "*tdlib.UnknownMessageContent"},
+		{`{"@type":"chatEventStoryPosted"}`, "*tdlib.UnknownChatEventAction"},
+	}
+
+	for _, test := range tests {
+		msg, err := tdlib.Decode([]byte(test.raw))
+		if err != nil {
+			t.Errorf("Decode(%s) returned %v", test.raw, err)
+			continue
+		}
+		if got := typeName(msg); got != test.wantType {
+			t.Errorf("Decode(%s) returned %s, want %s", test.raw, got, test.wantType)
+		}
+	}
+
+	if _, err := tdlib.Decode([]byte(`{"@type":"somethingElse"}`)); err == nil {
+		t.Error("Decode of a type matching no abstract type succeeded")
+	}
+}
+
+func TestDecodeKeepsRawJSON(t *testing.T) {
+	raw := `{"@type":"updateNewMessage","message":{"@type":"message","id":5,"content":{"@type":"messageStory","story_id":7}}}`
+	msg, err := tdlib.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, ok := msg.(*tdlib.UpdateNewMessage).Message.Content.(*tdlib.UnknownMessageContent)
+	if !ok {
+		t.Fatalf("content decoded into %T", msg.(*tdlib.UpdateNewMessage).Message.Content)
+	}
+	if content.GetMessageContentEnum() != "messageStory" || string(content.Raw) != `{"@type":"messageStory","story_id":7}` {
+		t.Errorf("unexpected fallback %s %s", content.GetMessageContentEnum(), content.Raw)
+	}
+}
+
+func TestInvokeUnknownAbstractResponse(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.Handle("getAuthorizationState", tdlib.UpdateData{"@type": "authorizationStateWaitEmailAddress", "allow_apple_id": true})
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	state, err := client.GetAuthorizationState()
+	if err != nil {
+		t.Fatalf("GetAuthorizationState returned %v", err)
+	}
+
+	unknown, ok := state.(*tdlib.UnknownAuthorizationState)
+	if !ok {
+		t.Fatalf("GetAuthorizationState returned %T", state)
+	}
+	if unknown.GetAuthorizationStateEnum() != "authorizationStateWaitEmailAddress" {
+		t.Errorf("GetAuthorizationStateEnum() = %s", unknown.GetAuthorizationStateEnum())
+	}
+}
+
+func TestDecodeRequestRoundTrip(t *testing.T) {
+	request := &tdlib.SendMessageRequest{
+		ChatID:              -1001234567890,
+		ReplyMarkup:         tdlib.NewReplyMarkupRemoveKeyboard(true),
+		InputMessageContent: tdlib.NewInputMessageText(tdlib.NewFormattedText("hello", nil), false, true),
+	}
+
+	raw, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := tdlib.Decode(raw)
+	if err != nil {
+		t.Fatalf("Decode(%s) failed: %v", raw, err)
+	}
+
+	decoded, ok := msg.(*tdlib.SendMessageRequest)
+	if !ok {
+		t.Fatalf("Decode(%s) returned %s", raw, typeName(msg))
+	}
+	if decoded.ChatID != request.ChatID {
+		t.Errorf("ChatID = %d, want %d", decoded.ChatID, request.ChatID)
+	}
+	text, ok := decoded.InputMessageContent.(*tdlib.InputMessageText)
+	if !ok || text.Text == nil || text.Text.Text != "hello" || !text.ClearDraft {
+		t.Errorf("InputMessageContent = %+v", decoded.InputMessageContent)
+	}
+	if markup, ok := decoded.ReplyMarkup.(*tdlib.ReplyMarkupRemoveKeyboard); !ok || !markup.IsPersonal {
+		t.Errorf("ReplyMarkup = %+v", decoded.ReplyMarkup)
+	}
+
+	// marshaling it again gives back the same JSON
+	again, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(raw) {
+		t.Errorf("round trip changed the request:\n%s\n%s", raw, again)
+	}
+}
+
+func typeName(value interface{}) string {
+	return fmt.Sprintf("%T", value)
+}
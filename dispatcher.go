@@ -0,0 +1,64 @@
+package tdlib
+
+import (
+	"encoding/json"
+)
+
+// handlerQueueSize is how many decoded updates may wait for the handlers before the receive loop blocks
+const handlerQueueSize = 1000
+
+// updateHandler is a handler registered with OnUpdate or one of the OnUpdate* methods
+type updateHandler func(update Update)
+
+// OnUpdate registers a handler called for every update, whatever its type.
+// Handlers run one at a time on a dedicated goroutine in the order updates arrive, so they can send requests,
+// but a slow handler delays all the following updates.
+func (client *Client) OnUpdate(handler func(update Update)) {
+	client.addUpdateHandler("", handler)
+}
+
+// addUpdateHandler registers a handler for the given update type, the empty type matches every update
+func (client *Client) addUpdateHandler(updateType UpdateEnum, handler updateHandler) {
+	client.handlersLock.Lock()
+	defer client.handlersLock.Unlock()
+
+	client.handlers[updateType] = append(client.handlers[updateType], handler)
+}
+
+// dispatchUpdate decodes the update once and queues it for the handlers registered for its type
+func (client *Client) dispatchUpdate(updateType string, updateBytes []byte) {
+	client.handlersLock.RLock()
+	wanted := len(client.handlers[UpdateEnum(updateType)]) > 0 || len(client.handlers[""]) > 0
+	client.handlersLock.RUnlock()
+
+	if !wanted {
+		return
+	}
+
+	rawMsg := json.RawMessage(updateBytes)
+	update, err := unmarshalUpdate(&rawMsg)
+	if err != nil {
+		getLogger().Error("tdlib: failed to unmarshal update", "type", updateType, "error", err)
+		return
+	}
+
+	select {
+	case client.handlerQueue <- update:
+	case <-client.stop:
+	}
+}
+
+// runHandlers calls the handlers of every queued update until the queue is closed
+func (client *Client) runHandlers() {
+	for update := range client.handlerQueue {
+		client.handlersLock.RLock()
+		handlers := make([]updateHandler, 0, len(client.handlers[update.GetUpdateEnum()])+len(client.handlers[""]))
+		handlers = append(handlers, client.handlers[update.GetUpdateEnum()]...)
+		handlers = append(handlers, client.handlers[""]...)
+		client.handlersLock.RUnlock()
+
+		for _, handler := range handlers {
+			handler(update)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package tdlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// ErrWrongEncryptionKey is matched by the error returned when TDLib rejects the database encryption key
+var ErrWrongEncryptionKey = errors.New("wrong database encryption key")
+
+// wrongEncryptionKeyPattern matches the messages TDLib rejects a database encryption key with,
+// e.g. "Wrong key or database is corrupted" and "Wrong database encryption key"
+var wrongEncryptionKeyPattern = regexp.MustCompile(`(?i)wrong (database encryption )?key`)
+
+// EncryptionKeyError is returned when TDLib rejects the database encryption key,
+// it matches ErrWrongEncryptionKey with errors.Is and unwraps to the TDLib *Error
+type EncryptionKeyError struct {
+	Err error
+}
+
+// Error returns the error message
+func (e *EncryptionKeyError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrWrongEncryptionKey, e.Err)
+}
+
+// Is reports whether target is ErrWrongEncryptionKey
+func (e *EncryptionKeyError) Is(target error) bool {
+	return target == ErrWrongEncryptionKey
+}
+
+// Unwrap returns the TDLib error
+func (e *EncryptionKeyError) Unwrap() error {
+	return e.Err
+}
+
+// EncryptionKeyProvider supplies the database encryption key when TDLib asks for it
+type EncryptionKeyProvider interface {
+	EncryptionKey(ctx context.Context) ([]byte, error)
+}
+
+// EncryptionKeyFunc is a function used as EncryptionKeyProvider
+type EncryptionKeyFunc func(ctx context.Context) ([]byte, error)
+
+// EncryptionKey calls fn
+func (fn EncryptionKeyFunc) EncryptionKey(ctx context.Context) ([]byte, error) {
+	return fn(ctx)
+}
+
+// EncryptionKeyFromEnv returns a provider reading the key from the environment variable name, which must be set
+func EncryptionKeyFromEnv(name string) EncryptionKeyProvider {
+	return EncryptionKeyFunc(func(ctx context.Context) ([]byte, error) {
+		key, found := os.LookupEnv(name)
+		if !found {
+			return nil, fmt.Errorf("database encryption key: environment variable %s is not set", name)
+		}
+		return []byte(key), nil
+	})
+}
+
+// EncryptionKeyFromFile returns a provider reading the whole content of the file at path as key
+func EncryptionKeyFromFile(path string) EncryptionKeyProvider {
+	return EncryptionKeyFunc(func(ctx context.Context) ([]byte, error) {
+		key, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("database encryption key: %w", err)
+		}
+		return key, nil
+	})
+}
+
+// databaseEncryptionKey returns the key from Config.DatabaseEncryptionKeyProvider, or Config.DatabaseEncryptionKey if not set
+func (client *Client) databaseEncryptionKey(ctx context.Context) ([]byte, error) {
+	if client.Config.DatabaseEncryptionKeyProvider != nil {
+		return client.Config.DatabaseEncryptionKeyProvider.EncryptionKey(ctx)
+	}
+	return client.Config.DatabaseEncryptionKey, nil
+}
+
+// checkDatabaseEncryptionKey answers authorizationStateWaitEncryptionKey with the configured key
+func (client *Client) checkDatabaseEncryptionKey(ctx context.Context) error {
+	key, err := client.databaseEncryptionKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CheckDatabaseEncryptionKeyContext(ctx, key)
+
+	// other errors, e.g. a request in the wrong authorization state or an unusable database directory, are passed through
+	var tdErr *Error
+	if errors.As(err, &tdErr) && wrongEncryptionKeyPattern.MatchString(tdErr.Message) {
+		return &EncryptionKeyError{Err: tdErr}
+	}
+	return err
+}
+
+// RotateDatabaseEncryptionKey re-encrypts the local database with newKey and keeps it in Config.DatabaseEncryptionKey.
+// When a DatabaseEncryptionKeyProvider is configured, it must supply newKey from now on.
+func (client *Client) RotateDatabaseEncryptionKey(ctx context.Context, newKey []byte) error {
+	if _, err := client.SetDatabaseEncryptionKeyContext(ctx, newKey); err != nil {
+		return err
+	}
+
+	client.Config.DatabaseEncryptionKey = newKey
+	return nil
+}
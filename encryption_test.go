@@ -0,0 +1,40 @@
+package tdlib_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+func TestCheckDatabaseEncryptionKeyErrors(t *testing.T) {
+	tests := []struct {
+		response *tdlib.Error
+		wrongKey bool
+	}{
+		{tdlib.NewError(401, "Wrong key or database is corrupted"), true},
+		{tdlib.NewError(400, "Wrong database encryption key"), true},
+		{tdlib.NewError(400, "Call to checkDatabaseEncryptionKey unexpected"), false},
+		{tdlib.NewError(400, "Can't open database directory"), false},
+	}
+
+	for _, test := range tests {
+		server := tdlibtest.NewServer()
+		server.Handle("getAuthorizationState", tdlib.NewAuthorizationStateWaitEncryptionKey(true))
+		server.Handle("checkDatabaseEncryptionKey", test.response)
+		client := server.NewClient(tdlib.Config{DatabaseEncryptionKey: []byte("secret")})
+
+		_, err := client.Authorize()
+		client.DestroyInstance()
+
+		if errors.Is(err, tdlib.ErrWrongEncryptionKey) != test.wrongKey {
+			t.Errorf("%q: errors.Is(%v, ErrWrongEncryptionKey) = %v, want %v", test.response.Message, err, !test.wrongKey, test.wrongKey)
+		}
+
+		var tdErr *tdlib.Error
+		if !errors.As(err, &tdErr) || tdErr.Message != test.response.Message {
+			t.Errorf("%q: returned %v, want the TDLib error", test.response.Message, err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Error classes, use errors.Is on an error returned by a request to check them
+var (
+	ErrTimeout      = errors.New("timeout")        // no response arrived before the deadline
+	ErrClosed       = errors.New("client closed")  // the client has been shut down
+	ErrBadRequest   = errors.New("bad request")    // TDLib error code 400
+	ErrUnauthorized = errors.New("unauthorized")   // TDLib error code 401
+	ErrNotFound     = errors.New("not found")      // TDLib error code 404
+	ErrFloodWait    = errors.New("flood wait")     // TDLib error code 429, see Error.RetryAfter
+	ErrInternal     = errors.New("internal error") // TDLib error code 500
+)
+
+// retryAfterRegexp matches both "FLOOD_WAIT_X" and "Too Many Requests: retry after X" messages
+var retryAfterRegexp = regexp.MustCompile(`(?:FLOOD_WAIT_|retry after )(\d+)`)
+
+// Error makes TDLib errors returned by requests usable as Go errors,
+// use errors.As to get the code and message out of a returned error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("error! code: %d msg: %s", e.Code, e.Message)
+}
+
+// Is reports whether the error belongs to one of the Err* classes
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Code == 400
+	case ErrUnauthorized:
+		return e.Code == 401
+	case ErrNotFound:
+		return e.Code == 404
+	case ErrFloodWait:
+		return e.Code == 429
+	case ErrInternal:
+		return e.Code == 500
+	}
+	return false
+}
+
+// RetryAfter returns how long to wait before repeating a request that failed with a 429 flood wait error,
+// 0 if the message doesn't specify it
+func (e *Error) RetryAfter() time.Duration {
+	if e.Code != 429 {
+		return 0
+	}
+
+	match := retryAfterRegexp.FindStringSubmatch(e.Message)
+	if match == nil {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// responseError converts an error response into *Error
+func responseError(result UpdateMsg) error {
+	var tdErr Error
+	if err := json.Unmarshal(result.Raw, &tdErr); err != nil {
+		return fmt.Errorf("error! code: %v msg: %v", result.Data["code"], result.Data["message"])
+	}
+	return &tdErr
+}
@@ -69,7 +69,7 @@ func getChatList(client *tdlib.Client, limit int) error {
 
 	if !haveFullChatList && limit > len(allChats) {
 		offsetOrder := int64(math.MaxInt64)
-		offsetChatID := int64(0)
+		offsetChatID := tdlib.JSONInt64(0)
 		var chatList = tdlib.NewChatListMain()
 		var lastChat *tdlib.Chat
 
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+)
+
+func main() {
+	tdlib.SetLogVerbosityLevel(1)
+	tdlib.SetFilePath("./errors.txt")
+
+	// Create new instance of client
+	client := tdlib.NewClient(tdlib.Config{
+		APIID:               "187786",
+		APIHash:             "e782045df67ba48e441ccb105da8fc85",
+		SystemLanguageCode:  "en",
+		DeviceModel:         "Server",
+		SystemVersion:       "1.0.0",
+		ApplicationVersion:  "1.0.0",
+		UseMessageDatabase:  true,
+		UseFileDatabase:     true,
+		UseChatInfoDatabase: true,
+		UseTestDataCenter:   false,
+		DatabaseDirectory:   "./tdlib-db",
+		FileDirectory:       "./tdlib-files",
+		IgnoreFileNames:     false,
+	})
+
+	// Login follows the authorization states and prompts for phone, code and password on the terminal,
+	// use tdlib.BotAuthenticator("<your bot token>") to log in as a bot instead
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	if err := client.Login(ctx, &tdlib.TerminalAuthenticator{}); err != nil {
+		fmt.Printf("Error logging in: %v\n", err)
+		return
+	}
+	fmt.Println("Authorization Ready! Let's rock")
+
+	// rawUpdates gets all updates comming from tdlib
+	rawUpdates := client.GetRawUpdatesChannel(100)
+	for update := range rawUpdates {
+		// Show all updates
+		fmt.Println(update.Data)
+		fmt.Print("\n\n")
+	}
+}
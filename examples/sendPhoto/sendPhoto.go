@@ -48,7 +48,7 @@ func main() {
 
 	// Send "/start" text every 5 seconds to Forsquare bot chat
 	// Should get chatID somehow, check out "getChats" example
-	chatID := int64(198529620) // Foursquare bot chat id
+	chatID := tdlib.JSONInt64(198529620) // Foursquare bot chat id
 
 	inputMsg := tdlib.NewInputMessagePhoto(tdlib.NewInputFileLocal("./bunny.jpg"), nil, nil, 400, 400,
 		tdlib.NewFormattedText("A photo sent from go-tdlib!", nil), 0)
@@ -58,7 +58,7 @@ func main() {
 	// Send "/start" text every 5 seconds to Forsquare bot chat
 	go func() {
 		// Should get chatID somehow, check out "getChats" example
-		chatID := int64(198529620) // Foursquare bot chat id
+		chatID := tdlib.JSONInt64(198529620) // Foursquare bot chat id
 
 		inputMsgTxt := tdlib.NewInputMessageText(tdlib.NewFormattedText("/start", nil), true, true)
 		client.SendMessage(chatID, 0, false, true, nil, inputMsgTxt)
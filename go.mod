@@ -1,3 +1,9 @@
 module github.com/Arman92/go-tdlib
 
-go 1.15
+go 1.21
+
+require (
+	github.com/BurntSushi/toml v1.4.0
+	gopkg.in/yaml.v3 v3.0.1
+	rsc.io/qr v0.2.0
+)
@@ -0,0 +1,90 @@
+package tdlib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Invoke Sends a request and decodes its response into Resp, which is either a pointer to a concrete type
+// or an abstract type, e.g.
+//
+//	chat, err := tdlib.Invoke[*tdlib.Chat](ctx, client, &tdlib.GetChatRequest{ChatID: chatID})
+//	state, err := tdlib.Invoke[tdlib.AuthorizationState](ctx, client, &tdlib.GetAuthorizationStateRequest{})
+//
+// It is named Invoke because Call is the TDLib call type.
+// req is usually one of the generated *Request structs, its @type is taken from MessageType().
+// TDLib errors are returned as *Error, like every other request.
+func Invoke[Resp any](ctx context.Context, client *Client, req TdMessage) (Resp, error) {
+	var resp Resp
+
+	result, err := client.SendAndCatchContext(ctx, requestData(req))
+	if err != nil {
+		return resp, err
+	}
+
+	if result.Data["@type"] == "error" {
+		return resp, responseError(result)
+	}
+
+	respType := reflect.TypeOf((*Resp)(nil)).Elem()
+	msgType, _ := result.Data["@type"].(string)
+
+	// a type missing from the schema is decoded into the fallback of the abstract type asked for,
+	// Decode could only guess it from the name
+	if NewByType(msgType) == nil {
+		if unknown := newUnknownOf(respType); unknown != nil {
+			if err := json.Unmarshal(result.Raw, unknown); err != nil {
+				return resp, err
+			}
+			return unknown.(Resp), nil
+		}
+	}
+
+	msg, err := Decode(result.Raw)
+	if err != nil {
+		return resp, err
+	}
+
+	resp, ok := msg.(Resp)
+	if !ok {
+		return resp, fmt.Errorf("tdlib: %s returned %s, not %v", req.MessageType(), msg.MessageType(), respType)
+	}
+	return resp, nil
+}
+
+// requestData converts a request struct to UpdateData, keyed by the json names of its fields.
+// The field values are kept as they are, so they are encoded exactly as if they had been put in UpdateData by hand.
+func requestData(req TdMessage) UpdateData {
+	update := UpdateData{"@type": req.MessageType()}
+
+	value := reflect.ValueOf(req)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return update
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return update
+	}
+
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		// tdCommon, the @type is MessageType()
+		if field.Anonymous || !field.IsExported() {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		update[name] = value.Field(i).Interface()
+	}
+
+	return update
+}
@@ -0,0 +1,136 @@
+package tdlib
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+// LevelFatal is the slog level of TDLib fatal errors (verbosity level 0)
+const LevelFatal = slog.LevelError + 4
+
+// LogMessageCallback receives TDLib internal log messages together with their verbosity level:
+// 0 fatal errors, 1 errors, 2 warnings, 3 informational, 4 debug, 5 and above verbose debug
+type LogMessageCallback func(verbosityLevel int, message string)
+
+var (
+	// logger is used for the package diagnostics and TDLib log messages routed with RedirectLogToLogger
+	logger atomic.Pointer[slog.Logger]
+
+	logCallbacksLock     sync.RWMutex
+	logMessageCallback   LogMessageCallback
+	logMaxVerbosityLevel int
+	logFatalCallback     func(message string)
+)
+
+// SetLogger sets the logger used for the package diagnostics and for TDLib log messages routed with RedirectLogToLogger,
+// slog.Default() is used until it's called
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// getLogger returns the configured logger
+func getLogger() *slog.Logger {
+	if l := logger.Load(); l != nil {
+		return l
+	}
+	return slog.Default()
+}
+
+// SetLogMessageCallback delivers TDLib internal log messages up to maxVerbosityLevel to callback, nil stops delivering them.
+// The callback is called from TDLib threads, so it must be safe for concurrent use and must not call TDLib.
+// Requires TDLib 1.8.0 or newer and building with -tags tdlib_1_8, otherwise the callback is never called.
+func SetLogMessageCallback(maxVerbosityLevel int, callback LogMessageCallback) {
+	logCallbacksLock.Lock()
+	defer logCallbacksLock.Unlock()
+
+	logMessageCallback = callback
+	logMaxVerbosityLevel = maxVerbosityLevel
+	registerLogCallbacks()
+}
+
+// SetLogFatalErrorCallback sets a callback called with the message of every TDLib fatal error, right before TDLib aborts the process.
+// nil removes it. It's registered with td_set_log_fatal_error_callback, or with the log message callback
+// when building with -tags tdlib_1_8. Without cgo the callback is never called.
+func SetLogFatalErrorCallback(callback func(message string)) {
+	logCallbacksLock.Lock()
+	defer logCallbacksLock.Unlock()
+
+	logFatalCallback = callback
+	registerLogCallbacks()
+}
+
+// RedirectLogToLogger routes TDLib internal log messages up to maxVerbosityLevel into the logger set with SetLogger,
+// with verbosity levels mapped to slog levels. It also sets TDLib's verbosity level accordingly and disables
+// TDLib's own log stream, so messages aren't written twice.
+// Requires TDLib 1.8.0 or newer and building with -tags tdlib_1_8, otherwise TDLib keeps its own log stream
+// and only fatal errors can be caught, with SetLogFatalErrorCallback.
+func RedirectLogToLogger(maxVerbosityLevel int) {
+	if !logMessageCallbackSupported {
+		getLogger().Warn("tdlib: can't redirect TDLib logs, log message callbacks need TDLib 1.8.0 or newer and the tdlib_1_8 build tag")
+		return
+	}
+
+	bytes, _ := json.Marshal(UpdateData{
+		"@type": "setLogStream",
+		"log_stream": UpdateData{
+			"@type": "logStreamEmpty",
+		},
+	})
+	tdExecute(bytes)
+
+	SetLogVerbosityLevel(maxVerbosityLevel)
+	SetLogMessageCallback(maxVerbosityLevel, func(verbosityLevel int, message string) {
+		getLogger().Log(context.Background(), slogLevel(verbosityLevel), message, "source", "tdlib", "verbosity_level", verbosityLevel)
+	})
+}
+
+// slogLevel maps a TDLib verbosity level to a slog level
+func slogLevel(verbosityLevel int) slog.Level {
+	switch {
+	case verbosityLevel <= 0:
+		return LevelFatal
+	case verbosityLevel == 1:
+		return slog.LevelError
+	case verbosityLevel == 2:
+		return slog.LevelWarn
+	case verbosityLevel == 3:
+		return slog.LevelInfo
+	default:
+		// 4 is debug, every further verbosity level goes one slog level lower
+		return slog.LevelDebug - slog.Level(verbosityLevel-4)
+	}
+}
+
+// registerLogCallbacks (re)registers the TDLib callbacks according to the configured callbacks,
+// logCallbacksLock must be held
+func registerLogCallbacks() {
+	setTdLogCallbacks(logMessageCallback != nil, logMaxVerbosityLevel, logFatalCallback != nil)
+}
+
+// handleTdLogMessage is called by TDLib for every log message
+func handleTdLogMessage(verbosityLevel int, message string) {
+	logCallbacksLock.RLock()
+	messageCallback, maxVerbosityLevel, fatalCallback := logMessageCallback, logMaxVerbosityLevel, logFatalCallback
+	logCallbacksLock.RUnlock()
+
+	if messageCallback != nil && verbosityLevel <= maxVerbosityLevel {
+		messageCallback(verbosityLevel, message)
+	}
+	if fatalCallback != nil && verbosityLevel == 0 {
+		fatalCallback(message)
+	}
+}
+
+// handleTdLogFatalError is called by TDLib for a fatal error when the log message callback isn't linked
+func handleTdLogFatalError(message string) {
+	logCallbacksLock.RLock()
+	fatalCallback := logFatalCallback
+	logCallbacksLock.RUnlock()
+
+	if fatalCallback != nil {
+		fatalCallback(message)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build cgo && tdlib_1_8
+
+package tdlib
+
+//#include <td/telegram/td_json_client.h>
+//
+//extern void goTdLogMessage(int verbosity_level, char *message);
+//
+//static void tdLogMessageCallback(int verbosity_level, const char *message) {
+//	goTdLogMessage(verbosity_level, (char *)message);
+//}
+//
+//static void setLogMessageCallback(int max_verbosity_level, int enabled) {
+//	td_set_log_message_callback(max_verbosity_level, enabled ? tdLogMessageCallback : NULL);
+//}
+import "C"
+
+// logMessageCallbackSupported reports whether td_set_log_message_callback is linked, it only exists since TDLib 1.8.0
+const logMessageCallbackSupported = true
+
+// setTdLogCallbacks makes TDLib call handleTdLogMessage for messages up to maxVerbosityLevel,
+// or only for fatal errors if there is just a fatal error callback, or stops it
+func setTdLogCallbacks(messageCallback bool, maxVerbosityLevel int, fatalCallback bool) {
+	switch {
+	case messageCallback:
+		C.setLogMessageCallback(C.int(maxVerbosityLevel), 1)
+	case fatalCallback:
+		C.setLogMessageCallback(0, 1)
+	default:
+		C.setLogMessageCallback(0, 0)
+	}
+}
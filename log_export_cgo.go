@@ -0,0 +1,13 @@
+//go:build cgo && tdlib_1_8
+
+package tdlib
+
+import "C"
+
+// goTdLogMessage is the TDLib log message callback, the preamble of a file exporting
+// functions can't hold C definitions so the C side lives in log_cgo.go
+//
+//export goTdLogMessage
+func goTdLogMessage(verbosityLevel C.int, message *C.char) {
+	handleTdLogMessage(int(verbosityLevel), C.GoString(message))
+}
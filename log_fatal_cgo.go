@@ -0,0 +1,34 @@
+//go:build cgo && !tdlib_1_8
+
+package tdlib
+
+//#include <td/telegram/td_log.h>
+//
+//extern void goTdLogFatalError(char *message);
+//
+//static void tdLogFatalErrorCallback(const char *message) {
+//	goTdLogFatalError((char *)message);
+//}
+//
+//static void setLogFatalErrorCallback(int enabled) {
+//	td_set_log_fatal_error_callback(enabled ? tdLogFatalErrorCallback : NULL);
+//}
+import "C"
+
+// logMessageCallbackSupported reports whether td_set_log_message_callback is linked, it only exists since TDLib 1.8.0.
+// Build with -tags tdlib_1_8 to link it.
+const logMessageCallbackSupported = false
+
+// setTdLogCallbacks makes TDLib call handleTdLogFatalError for fatal errors, or stops it.
+// TDLib 1.7 has no log message callback, so other messages stay in TDLib's own log stream.
+func setTdLogCallbacks(messageCallback bool, maxVerbosityLevel int, fatalCallback bool) {
+	if messageCallback {
+		getLogger().Warn("tdlib: log message callbacks need TDLib 1.8.0 or newer and the tdlib_1_8 build tag")
+	}
+
+	cEnabled := C.int(0)
+	if fatalCallback {
+		cEnabled = 1
+	}
+	C.setLogFatalErrorCallback(cEnabled)
+}
@@ -0,0 +1,13 @@
+//go:build cgo && !tdlib_1_8
+
+package tdlib
+
+import "C"
+
+// goTdLogFatalError is the TDLib fatal error callback, the preamble of a file exporting
+// functions can't hold C definitions so the C side lives in log_fatal_cgo.go
+//
+//export goTdLogFatalError
+func goTdLogFatalError(message *C.char) {
+	handleTdLogFatalError(C.GoString(message))
+}
@@ -0,0 +1,13 @@
+//go:build !cgo
+
+package tdlib
+
+// logMessageCallbackSupported reports whether td_set_log_message_callback is linked, it needs cgo
+const logMessageCallbackSupported = false
+
+// setTdLogCallbacks can't register any callback, without cgo there is no TDLib to call them
+func setTdLogCallbacks(messageCallback bool, maxVerbosityLevel int, fatalCallback bool) {
+	if messageCallback || fatalCallback {
+		getLogger().Warn("tdlib: log callbacks need cgo")
+	}
+}
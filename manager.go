@@ -0,0 +1,255 @@
+package tdlib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// MultiplexTransport is the raw JSON channel to many TDLib instances sharing a single receive queue,
+// as in the td_create_client_id / td_send / td_receive interface of libtdjson.
+// The default implementation is backed by libtdjson through cgo, any other implementation
+// can be passed to NewClientManagerWithTransport.
+type MultiplexTransport interface {
+	// CreateClientID creates a new TDLib instance and returns its identifier,
+	// the instance isn't started until the first request is sent to it
+	CreateClientID() int
+	// Send sends a JSON-serialized request to the TDLib instance with the given identifier,
+	// it must not block on the response
+	Send(clientID int, query []byte)
+	// Receive waits at most timeout seconds for an incoming update or request response of any instance,
+	// the instance is identified by the @client_id field; returns nil if nothing arrived in time
+	Receive(timeout float64) []byte
+	// Execute synchronously executes a JSON-serialized request and returns the JSON-serialized result
+	Execute(query []byte) []byte
+}
+
+// ClientManager runs many Clients on top of one MultiplexTransport: a single receive loop
+// demultiplexes responses and updates by @client_id, instead of one TDLib receive thread per client.
+type ClientManager struct {
+	transport MultiplexTransport
+	clients   map[int]*managedClient
+	lock      *sync.RWMutex
+	stop      chan struct{} // closed to ask the receive loop to stop
+	done      chan struct{} // closed once the receive loop has returned
+	stopOnce  sync.Once
+}
+
+// managedClient is a Client registered with a ClientManager
+type managedClient struct {
+	client    *Client
+	transport *managedTransport
+}
+
+// NewClientManager Creates a new ClientManager on top of libtdjson.
+// Requires TDLib 1.7.0 or newer.
+func NewClientManager() *ClientManager {
+	return NewClientManagerWithTransport(newTdMultiplexTransport())
+}
+
+// NewClientManagerWithTransport Creates a new ClientManager on top of the given transport instead of libtdjson.
+func NewClientManagerWithTransport(transport MultiplexTransport) *ClientManager {
+	manager := ClientManager{
+		transport: transport,
+		clients:   make(map[int]*managedClient),
+		lock:      &sync.RWMutex{},
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	go manager.receiveLoop()
+
+	return &manager
+}
+
+// NewClient Creates a new TDLib instance managed by the manager.
+// The returned Client has the same API as one created with NewClient.
+func (manager *ClientManager) NewClient(config Config) *Client {
+	transport := newManagedTransport(manager, manager.transport.CreateClientID())
+
+	// register before the client sends anything, so no response can arrive for an unknown identifier
+	managed := &managedClient{transport: transport}
+	manager.lock.Lock()
+	manager.clients[transport.clientID] = managed
+	manager.lock.Unlock()
+
+	client := NewClientWithTransport(config, transport)
+	manager.lock.Lock()
+	managed.client = client
+	manager.lock.Unlock()
+
+	return client
+}
+
+// Shutdown gracefully closes every managed client concurrently (see Client.Shutdown),
+// then stops the receive loop. The manager can't be used anymore afterwards.
+func (manager *ClientManager) Shutdown(ctx context.Context) error {
+	manager.lock.RLock()
+	clients := make([]*Client, 0, len(manager.clients))
+	for _, managed := range manager.clients {
+		if managed.client != nil {
+			clients = append(clients, managed.client)
+		}
+	}
+	manager.lock.RUnlock()
+
+	errs := make([]error, len(clients))
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func(i int, client *Client) {
+			defer wg.Done()
+			errs[i] = client.Shutdown(ctx)
+		}(i, client)
+	}
+	wg.Wait()
+
+	manager.stopOnce.Do(func() {
+		close(manager.stop)
+	})
+
+	select {
+	case <-manager.done:
+	case <-ctx.Done():
+		errs = append(errs, ctx.Err())
+	}
+
+	return errors.Join(errs...)
+}
+
+// receiveLoop hands every message received from the shared transport to the client it belongs to
+func (manager *ClientManager) receiveLoop() {
+	defer close(manager.done)
+
+	for {
+		select {
+		case <-manager.stop:
+			return
+		default:
+		}
+
+		message := manager.transport.Receive(receiveTimeout)
+		if message == nil {
+			continue
+		}
+
+		var header struct {
+			ClientID *int `json:"@client_id"`
+		}
+		if err := json.Unmarshal(message, &header); err != nil || header.ClientID == nil {
+			getLogger().Warn("tdlib: dropped message without @client_id", "message", string(message))
+			continue
+		}
+
+		manager.lock.RLock()
+		managed, found := manager.clients[*header.ClientID]
+		manager.lock.RUnlock()
+
+		// messages of destroyed clients are dropped
+		if found {
+			managed.transport.push(message)
+		}
+	}
+}
+
+// unregister forgets the client, further messages addressed to it are dropped
+func (manager *ClientManager) unregister(clientID int) {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+
+	delete(manager.clients, clientID)
+}
+
+// managedTransport is the Transport of a single managed client, it is fed by the manager's receive loop
+type managedTransport struct {
+	manager  *ClientManager
+	clientID int
+
+	queue     [][]byte
+	queueLock sync.Mutex
+	signal    chan struct{}
+	destroyed chan struct{}
+	destroy   sync.Once
+}
+
+func newManagedTransport(manager *ClientManager, clientID int) *managedTransport {
+	return &managedTransport{
+		manager:   manager,
+		clientID:  clientID,
+		signal:    make(chan struct{}, 1),
+		destroyed: make(chan struct{}),
+	}
+}
+
+// push queues a message for the client, it never blocks, so a slow client can't stall the others
+func (transport *managedTransport) push(message []byte) {
+	transport.queueLock.Lock()
+	transport.queue = append(transport.queue, message)
+	transport.queueLock.Unlock()
+
+	select {
+	case transport.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Send Sends request to the TDLib instance of the client.
+func (transport *managedTransport) Send(query []byte) {
+	transport.manager.transport.Send(transport.clientID, query)
+}
+
+// Receive Returns the next message of the client, or nil if nothing arrived within timeout seconds.
+func (transport *managedTransport) Receive(timeout float64) []byte {
+	timer := time.NewTimer(time.Duration(timeout * float64(time.Second)))
+	defer timer.Stop()
+
+	return transport.next(timer.C, nil)
+}
+
+// receiveUntil returns the next message of the client, or nil once stop is closed;
+// the client's receive loop uses it to wait on the queue without a timer per call
+func (transport *managedTransport) receiveUntil(stop <-chan struct{}) []byte {
+	return transport.next(nil, stop)
+}
+
+// next pops the oldest queued message, waiting for one until timeout fires, stop is closed or the transport is destroyed
+func (transport *managedTransport) next(timeout <-chan time.Time, stop <-chan struct{}) []byte {
+	for {
+		transport.queueLock.Lock()
+		if len(transport.queue) > 0 {
+			message := transport.queue[0]
+			transport.queue[0] = nil
+			transport.queue = transport.queue[1:]
+			transport.queueLock.Unlock()
+			return message
+		}
+		transport.queueLock.Unlock()
+
+		select {
+		case <-transport.signal:
+		case <-timeout:
+			return nil
+		case <-stop:
+			return nil
+		case <-transport.destroyed:
+			return nil
+		}
+	}
+}
+
+// Execute Synchronously executes TDLib request.
+func (transport *managedTransport) Execute(query []byte) []byte {
+	return transport.manager.transport.Execute(query)
+}
+
+// Destroy Unregisters the client from the manager.
+// The multiplexed interface has no destroy call: TDLib releases the instance once it has been closed,
+// so use Client.Shutdown to close it first.
+func (transport *managedTransport) Destroy() {
+	transport.destroy.Do(func() {
+		transport.manager.unregister(transport.clientID)
+		close(transport.destroyed)
+	})
+}
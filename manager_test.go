@@ -0,0 +1,86 @@
+package tdlib_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+)
+
+// fakeMultiplexTransport answers every request with ok, tagged with the @client_id of the sender
+type fakeMultiplexTransport struct {
+	lock     sync.Mutex
+	lastID   int
+	messages chan []byte
+}
+
+func newFakeMultiplexTransport() *fakeMultiplexTransport {
+	return &fakeMultiplexTransport{messages: make(chan []byte, 100)}
+}
+
+func (transport *fakeMultiplexTransport) CreateClientID() int {
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+
+	transport.lastID++
+	return transport.lastID
+}
+
+func (transport *fakeMultiplexTransport) Send(clientID int, query []byte) {
+	var request tdlib.UpdateData
+	json.Unmarshal(query, &request)
+
+	response, _ := json.Marshal(tdlib.UpdateData{"@type": "ok", "@extra": request["@extra"], "@client_id": clientID})
+	transport.messages <- response
+}
+
+func (transport *fakeMultiplexTransport) Receive(timeout float64) []byte {
+	select {
+	case message := <-transport.messages:
+		return message
+	case <-time.After(time.Duration(timeout * float64(time.Second))):
+		return nil
+	}
+}
+
+func (transport *fakeMultiplexTransport) Execute(query []byte) []byte {
+	return nil
+}
+
+func TestClientManagerRoutesResponses(t *testing.T) {
+	manager := tdlib.NewClientManagerWithTransport(newFakeMultiplexTransport())
+	clients := []*tdlib.Client{manager.NewClient(tdlib.Config{}), manager.NewClient(tdlib.Config{})}
+
+	for i, client := range clients {
+		response, err := client.SendAndCatch(tdlib.UpdateData{"@type": "testCallEmpty"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Data["@client_id"] != float64(i+1) {
+			t.Errorf("client %d got the response of client %v", i+1, response.Data["@client_id"])
+		}
+	}
+
+	for _, client := range clients {
+		client.DestroyInstance()
+	}
+	manager.Shutdown(context.Background())
+}
+
+func TestManagedClientStopsWithoutWaitingForReceiveTimeout(t *testing.T) {
+	manager := tdlib.NewClientManagerWithTransport(newFakeMultiplexTransport())
+	defer manager.Shutdown(context.Background())
+
+	client := manager.NewClient(tdlib.Config{})
+	time.Sleep(100 * time.Millisecond)
+
+	// the receive loop is waiting for a message, stopping the client must wake it up right away
+	start := time.Now()
+	client.DestroyInstance()
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("DestroyInstance took %v", elapsed)
+	}
+}
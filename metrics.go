@@ -0,0 +1,279 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the request latency histogram
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics collects request latency, pending requests, update throughput and receiver statistics of one or more clients.
+// It implements expvar.Var, e.g. expvar.Publish("tdlib", metrics), and http.Handler serving the
+// Prometheus text exposition format, e.g. http.Handle("/metrics", metrics).
+type Metrics struct {
+	clients map[string]*clientMetrics
+	lock    sync.Mutex
+}
+
+// clientMetrics holds the counters of a single client
+type clientMetrics struct {
+	client   *Client
+	requests map[string]*requestMetrics
+	updates  map[string]uint64
+}
+
+// metricsTarget is the Metrics a client reports to and its name there
+type metricsTarget struct {
+	metrics *Metrics
+	name    string
+}
+
+// requestMetrics holds the counters of a single request @type
+type requestMetrics struct {
+	Count      uint64   `json:"count"`
+	Errors     uint64   `json:"errors"`
+	LatencySum float64  `json:"latency_seconds_sum"`
+	Buckets    []uint64 `json:"-"`
+}
+
+// receiverMetrics describes the state of an EventReceiver
+type receiverMetrics struct {
+	Type    string `json:"type"`
+	Queued  int    `json:"queued"`
+	Dropped uint64 `json:"dropped"`
+}
+
+// clientMetricsSnapshot is a copy of the metrics of a client
+type clientMetricsSnapshot struct {
+	Requests        map[string]requestMetrics `json:"requests"`
+	PendingRequests int                       `json:"pending_requests"`
+	Updates         map[string]uint64         `json:"updates"`
+	Receivers       []receiverMetrics         `json:"receivers"`
+}
+
+// NewMetrics creates an empty Metrics, attach clients to it with Client.EnableMetrics
+func NewMetrics() *Metrics {
+	return &Metrics{clients: make(map[string]*clientMetrics)}
+}
+
+// EnableMetrics makes the client report to metrics under the given name, which must be unique among the clients of metrics.
+// It should be called before sending any request.
+func (client *Client) EnableMetrics(metrics *Metrics, name string) {
+	clientMetrics := &clientMetrics{
+		client:   client,
+		requests: make(map[string]*requestMetrics),
+		updates:  make(map[string]uint64),
+	}
+
+	metrics.lock.Lock()
+	metrics.clients[name] = clientMetrics
+	metrics.lock.Unlock()
+
+	client.metrics.Store(&metricsTarget{metrics: metrics, name: name})
+	client.Use(Observe(func(requestType string, elapsed time.Duration, response UpdateMsg, err error) {
+		metrics.observeRequest(name, requestType, elapsed, err)
+	}))
+}
+
+// observeRequest accounts a finished request
+func (metrics *Metrics) observeRequest(name string, requestType string, elapsed time.Duration, err error) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	clientMetrics, found := metrics.clients[name]
+	if !found {
+		return
+	}
+
+	request, found := clientMetrics.requests[requestType]
+	if !found {
+		request = &requestMetrics{Buckets: make([]uint64, len(latencyBuckets))}
+		clientMetrics.requests[requestType] = request
+	}
+
+	request.Count++
+	if err != nil {
+		request.Errors++
+	}
+	seconds := elapsed.Seconds()
+	request.LatencySum += seconds
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			request.Buckets[i]++
+		}
+	}
+}
+
+// observeUpdate accounts a received update
+func (metrics *Metrics) observeUpdate(name string, updateType string) {
+	metrics.lock.Lock()
+	defer metrics.lock.Unlock()
+
+	if clientMetrics, found := metrics.clients[name]; found {
+		clientMetrics.updates[updateType]++
+	}
+}
+
+// snapshot copies the counters and reads the current gauges of every client
+func (metrics *Metrics) snapshot() map[string]clientMetricsSnapshot {
+	metrics.lock.Lock()
+	snapshots := make(map[string]clientMetricsSnapshot, len(metrics.clients))
+	clients := make(map[string]*Client, len(metrics.clients))
+	for name, clientMetrics := range metrics.clients {
+		snapshot := clientMetricsSnapshot{
+			Requests: make(map[string]requestMetrics, len(clientMetrics.requests)),
+			Updates:  make(map[string]uint64, len(clientMetrics.updates)),
+		}
+		for requestType, request := range clientMetrics.requests {
+			requestCopy := *request
+			requestCopy.Buckets = append([]uint64(nil), request.Buckets...)
+			snapshot.Requests[requestType] = requestCopy
+		}
+		for updateType, count := range clientMetrics.updates {
+			snapshot.Updates[updateType] = count
+		}
+		snapshots[name] = snapshot
+		clients[name] = clientMetrics.client
+	}
+	metrics.lock.Unlock()
+
+	// gauges are read without holding the metrics lock, the client may be reporting meanwhile
+	for name, client := range clients {
+		snapshot := snapshots[name]
+
+		client.waitersLock.RLock()
+		snapshot.PendingRequests = len(client.waiters)
+		client.waitersLock.RUnlock()
+
+		client.receiverLock.Lock()
+		receivers := make([]EventReceiver, len(client.receivers))
+		copy(receivers, client.receivers)
+		client.receiverLock.Unlock()
+
+		snapshot.Receivers = make([]receiverMetrics, 0, len(receivers))
+		for _, receiver := range receivers {
+			snapshot.Receivers = append(snapshot.Receivers, receiverMetrics{
+				Type:    receiver.Instance.MessageType(),
+				Queued:  receiver.Len(),
+				Dropped: receiver.Dropped(),
+			})
+		}
+
+		snapshots[name] = snapshot
+	}
+
+	return snapshots
+}
+
+// String returns the metrics as JSON, implementing expvar.Var
+func (metrics *Metrics) String() string {
+	bytes, err := json.Marshal(metrics.snapshot())
+	if err != nil {
+		return "{}"
+	}
+	return string(bytes)
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format
+func (metrics *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	snapshots := metrics.snapshot()
+
+	names := make([]string, 0, len(snapshots))
+	for name := range snapshots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out strings.Builder
+
+	writeHeader(&out, "tdlib_requests_total", "counter", "Requests sent, by @type.")
+	forEachRequest(snapshots, names, func(labels string, request requestMetrics) {
+		fmt.Fprintf(&out, "tdlib_requests_total{%s} %d\n", labels, request.Count)
+	})
+
+	writeHeader(&out, "tdlib_request_errors_total", "counter", "Requests that failed or got an error response, by @type.")
+	forEachRequest(snapshots, names, func(labels string, request requestMetrics) {
+		fmt.Fprintf(&out, "tdlib_request_errors_total{%s} %d\n", labels, request.Errors)
+	})
+
+	writeHeader(&out, "tdlib_request_duration_seconds", "histogram", "Time between sending a request and receiving its response, by @type.")
+	forEachRequest(snapshots, names, func(labels string, request requestMetrics) {
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(&out, "tdlib_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n", labels, strconv.FormatFloat(bound, 'g', -1, 64), request.Buckets[i])
+		}
+		fmt.Fprintf(&out, "tdlib_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, request.Count)
+		fmt.Fprintf(&out, "tdlib_request_duration_seconds_sum{%s} %s\n", labels, strconv.FormatFloat(request.LatencySum, 'g', -1, 64))
+		fmt.Fprintf(&out, "tdlib_request_duration_seconds_count{%s} %d\n", labels, request.Count)
+	})
+
+	writeHeader(&out, "tdlib_pending_requests", "gauge", "Requests waiting for their response.")
+	for _, name := range names {
+		fmt.Fprintf(&out, "tdlib_pending_requests{client=\"%s\"} %d\n", escapeLabel(name), snapshots[name].PendingRequests)
+	}
+
+	writeHeader(&out, "tdlib_updates_total", "counter", "Updates received, by @type.")
+	for _, name := range names {
+		updateTypes := make([]string, 0, len(snapshots[name].Updates))
+		for updateType := range snapshots[name].Updates {
+			updateTypes = append(updateTypes, updateType)
+		}
+		sort.Strings(updateTypes)
+
+		for _, updateType := range updateTypes {
+			fmt.Fprintf(&out, "tdlib_updates_total{client=\"%s\",type=\"%s\"} %d\n", escapeLabel(name), escapeLabel(updateType), snapshots[name].Updates[updateType])
+		}
+	}
+
+	writeHeader(&out, "tdlib_receiver_queued_updates", "gauge", "Updates waiting to be consumed from an event receiver.")
+	forEachReceiver(snapshots, names, func(labels string, receiver receiverMetrics) {
+		fmt.Fprintf(&out, "tdlib_receiver_queued_updates{%s} %d\n", labels, receiver.Queued)
+	})
+
+	writeHeader(&out, "tdlib_receiver_dropped_updates_total", "counter", "Updates discarded because an event receiver was full or paused.")
+	forEachReceiver(snapshots, names, func(labels string, receiver receiverMetrics) {
+		fmt.Fprintf(&out, "tdlib_receiver_dropped_updates_total{%s} %d\n", labels, receiver.Dropped)
+	})
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write([]byte(out.String()))
+}
+
+func writeHeader(out *strings.Builder, name string, metricType string, help string) {
+	fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// forEachRequest calls fn for every request @type of every client in order, with the matching labels
+func forEachRequest(snapshots map[string]clientMetricsSnapshot, names []string, fn func(labels string, request requestMetrics)) {
+	for _, name := range names {
+		requestTypes := make([]string, 0, len(snapshots[name].Requests))
+		for requestType := range snapshots[name].Requests {
+			requestTypes = append(requestTypes, requestType)
+		}
+		sort.Strings(requestTypes)
+
+		for _, requestType := range requestTypes {
+			fn(fmt.Sprintf("client=\"%s\",type=\"%s\"", escapeLabel(name), escapeLabel(requestType)), snapshots[name].Requests[requestType])
+		}
+	}
+}
+
+// forEachReceiver calls fn for every event receiver of every client in order, with the matching labels
+func forEachReceiver(snapshots map[string]clientMetricsSnapshot, names []string, fn func(labels string, receiver receiverMetrics)) {
+	for _, name := range names {
+		for i, receiver := range snapshots[name].Receivers {
+			fn(fmt.Sprintf("client=\"%s\",receiver=\"%d\",type=\"%s\"", escapeLabel(name), i, escapeLabel(receiver.Type)), receiver)
+		}
+	}
+}
+
+// escapeLabel escapes a Prometheus label value
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,53 @@
+package tdlib_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+)
+
+func TestMetricsWhileReceiverBlocked(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	metrics := tdlib.NewMetrics()
+
+	// TDLib sends updates on its own, the receive loop may be handling one while metrics are enabled
+	server.PushUpdate(tdlib.NewUpdateOption("version", tdlib.NewOptionValueString(tdlib.SchemaVersion)))
+	time.Sleep(50 * time.Millisecond)
+	client.EnableMetrics(metrics, "test")
+
+	// nobody reads the unbuffered channel, the receive loop is stuck delivering the second update
+	receiver := client.AddEventReceiver(&tdlib.UpdateNewMessage{}, acceptAll, 0)
+	defer client.RemoveEventReceiver(receiver)
+	pushMessages(t, server, client)
+	if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{ID: 1})); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	scraped := make(chan string, 1)
+	go func() {
+		recorder := httptest.NewRecorder()
+		metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		scraped <- recorder.Body.String() + metrics.String()
+	}()
+
+	var out string
+	select {
+	case out = <-scraped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("metrics blocked by a receiver waiting for its consumer")
+	}
+
+	for _, want := range []string{
+		`tdlib_updates_total{client="test",type="updateNewMessage"} 1`,
+		`tdlib_receiver_queued_updates{client="test",receiver="0",type="updateNewMessage"} 0`,
+		`tdlib_requests_total{client="test",type="getAuthorizationState"} 1`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("metrics don't contain %s:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package tdlib
+
+import (
+	"context"
+	"time"
+)
+
+// RoundTripFunc sends a request and waits for its response, like SendAndCatchContext does
+type RoundTripFunc func(ctx context.Context, request UpdateData) (UpdateMsg, error)
+
+// Middleware wraps the round trip of every request sent with SendAndCatch, and so of every generated method.
+// It may inspect or rewrite the request, answer it by itself without calling next, or inspect the response.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// ObserveFunc is called after every request with its @type, how long it took, its response
+// and its failure, TDLib error responses are reported as *Error. Requests sent with Send are reported
+// as soon as they are sent, with an empty response.
+type ObserveFunc func(requestType string, elapsed time.Duration, response UpdateMsg, err error)
+
+// Use appends middlewares to the chain of the client, the first one is the outermost.
+// Requests are retried according to the RetryPolicy underneath the chain.
+// It should be called before sending any request.
+// Requests sent with Send go through the chain too, without a response (see IsSendOnly); Execute bypasses it.
+func (client *Client) Use(middlewares ...Middleware) {
+	client.middlewares = append(client.middlewares, middlewares...)
+
+	chain := RoundTripFunc(client.sendWithRetry)
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		chain = client.middlewares[i](chain)
+	}
+	client.chain = chain
+}
+
+// sendOnlyKey marks the context of a request sent with Send
+type sendOnlyKey struct{}
+
+// IsSendOnly reports whether the request a Middleware is handling was sent with Send:
+// nothing waits for its response, the chain returns an empty UpdateMsg for it
+func IsSendOnly(ctx context.Context) bool {
+	sendOnly, _ := ctx.Value(sendOnlyKey{}).(bool)
+	return sendOnly
+}
+
+// Observe returns a Middleware reporting every request to fn, e.g. for logging or metrics
+func Observe(fn ObserveFunc) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(ctx context.Context, request UpdateData) (UpdateMsg, error) {
+			requestType, _ := request["@type"].(string)
+			start := time.Now()
+
+			response, err := next(ctx, request)
+
+			observedErr := err
+			if err == nil && response.Data["@type"] == "error" {
+				observedErr = responseError(response)
+			}
+			fn(requestType, time.Since(start), response, observedErr)
+
+			return response, err
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package tdlib_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+// recordedRequest is a request seen by a middleware
+type recordedRequest struct {
+	requestType string
+	sendOnly    bool
+}
+
+func TestMiddlewareSeesSend(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.Handle("testCallEmpty", tdlib.NewOk())
+	server.Handle("close", tdlib.NewOk())
+	client := server.NewClient(tdlib.Config{})
+
+	var lock sync.Mutex
+	var seen []recordedRequest
+	client.Use(func(next tdlib.RoundTripFunc) tdlib.RoundTripFunc {
+		return func(ctx context.Context, request tdlib.UpdateData) (tdlib.UpdateMsg, error) {
+			requestType, _ := request["@type"].(string)
+			lock.Lock()
+			seen = append(seen, recordedRequest{requestType, tdlib.IsSendOnly(ctx)})
+			lock.Unlock()
+
+			// a mock answering by itself
+			if requestType == "testSquareInt" {
+				return tdlib.UpdateMsg{}, nil
+			}
+			return next(ctx, request)
+		}
+	})
+
+	client.Send(tdlib.UpdateData{"@type": "testCallEmpty"})
+	client.Send(`{"@type":"testSquareInt","x":2}`)
+	if _, err := client.SendAndCatch(tdlib.UpdateData{"@type": "testCallEmpty"}); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	client.Shutdown(ctx)
+
+	want := []recordedRequest{{"testCallEmpty", true}, {"testSquareInt", true}, {"testCallEmpty", false}, {"close", true}}
+	lock.Lock()
+	defer lock.Unlock()
+	if len(seen) != len(want) {
+		t.Fatalf("middleware saw %v, want %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Errorf("middleware saw %v, want %v", seen, want)
+			break
+		}
+	}
+
+	for _, request := range server.Requests() {
+		if request["@type"] == "testSquareInt" {
+			t.Errorf("request answered by the middleware reached TDLib")
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package tdlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Options TDLib runtime options applied with SetOption right after tdlib parameters are accepted,
+// e.g. online, use_quick_ack, prefer_ipv6 or notification_group_count_max.
+// In config files the values are plain booleans, integers and strings (null means optionValueEmpty),
+// or TDLib JSON objects such as {"@type": "optionValueInteger", "value": 5}.
+type Options map[string]OptionValue
+
+// OptionsError holds every SetOption failure, keyed by option name
+type OptionsError struct {
+	Errors map[string]error
+}
+
+func (e *OptionsError) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%s: %v", name, e.Errors[name]))
+	}
+	return "error setting options: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual SetOption errors, so errors.Is and errors.As match any of them
+func (e *OptionsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// names returns option names in a stable order
+func (options Options) names() []string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UnmarshalJSON unmarshals from json
+func (options *Options) UnmarshalJSON(b []byte) error {
+	var rawOptions map[string]json.RawMessage
+	if err := json.Unmarshal(b, &rawOptions); err != nil {
+		return err
+	}
+
+	result := make(Options, len(rawOptions))
+	for name, rawValue := range rawOptions {
+		value, err := unmarshalOptionJSON(rawValue)
+		if err != nil {
+			return fmt.Errorf("option %s: %w", name, err)
+		}
+		result[name] = value
+	}
+
+	*options = result
+	return nil
+}
+
+// UnmarshalYAML unmarshals from yaml
+func (options *Options) UnmarshalYAML(node *yaml.Node) error {
+	var rawOptions map[string]interface{}
+	if err := node.Decode(&rawOptions); err != nil {
+		return err
+	}
+	return options.fromMap(rawOptions)
+}
+
+// UnmarshalTOML unmarshals from toml
+func (options *Options) UnmarshalTOML(data interface{}) error {
+	rawOptions, ok := data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("options must be a table, got %T", data)
+	}
+	return options.fromMap(rawOptions)
+}
+
+func (options *Options) fromMap(rawOptions map[string]interface{}) error {
+	result := make(Options, len(rawOptions))
+	for name, rawValue := range rawOptions {
+		value, err := newOptionValue(rawValue)
+		if err != nil {
+			return fmt.Errorf("option %s: %w", name, err)
+		}
+		result[name] = value
+	}
+
+	*options = result
+	return nil
+}
+
+// unmarshalOptionJSON accepts either a TDLib OptionValue object or a plain json scalar
+func unmarshalOptionJSON(rawValue json.RawMessage) (OptionValue, error) {
+	if trimmed := bytes.TrimSpace(rawValue); len(trimmed) > 0 && trimmed[0] == '{' {
+		var typed struct {
+			Type string `json:"@type"`
+		}
+		if err := json.Unmarshal(trimmed, &typed); err != nil {
+			return nil, err
+		}
+		if typed.Type == "" {
+			return nil, fmt.Errorf("object value without @type")
+		}
+		value, err := unmarshalOptionValue(&rawValue)
+		if err == nil && value == nil {
+			err = fmt.Errorf("unknown option value type %q", typed.Type)
+		}
+		return value, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawValue))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return newOptionValue(value)
+}
+
+// newOptionValue maps a decoded config value to the matching OptionValue variant
+func newOptionValue(value interface{}) (OptionValue, error) {
+	switch v := value.(type) {
+	case nil:
+		return NewOptionValueEmpty(), nil
+	case OptionValue:
+		return v, nil
+	case bool:
+		return NewOptionValueBoolean(v), nil
+	case string:
+		return NewOptionValueString(v), nil
+	case int:
+		return NewOptionValueInteger(JSONInt64(v)), nil
+	case int64:
+		return NewOptionValueInteger(JSONInt64(v)), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return nil, fmt.Errorf("integer %d out of range", v)
+		}
+		return NewOptionValueInteger(JSONInt64(v)), nil
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an integer", v)
+		}
+		return NewOptionValueInteger(JSONInt64(i)), nil
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v > math.MaxInt64 {
+			return nil, fmt.Errorf("%v is not an integer", v)
+		}
+		return NewOptionValueInteger(JSONInt64(v)), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// applyOptions sends every Config.Options entry with SetOption, reporting each failure separately
+func (client *Client) applyOptions(ctx context.Context) error {
+	errs := make(map[string]error)
+	for _, name := range client.Config.Options.names() {
+		if _, err := client.SetOptionContext(ctx, name, client.Config.Options[name]); err != nil {
+			errs[name] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return &OptionsError{Errors: errs}
+	}
+
+	return nil
+}
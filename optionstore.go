@@ -0,0 +1,119 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// OptionStore keeps the latest value of every option TDLib announced with updateOption,
+// e.g. my_id, version, unix_time or message_text_length_max.
+// It is safe for concurrent use.
+type OptionStore struct {
+	client *Client
+	lock   *sync.RWMutex
+	values map[string]OptionValue
+}
+
+func newOptionStore(client *Client) *OptionStore {
+	return &OptionStore{
+		client: client,
+		lock:   &sync.RWMutex{},
+		values: make(map[string]OptionValue),
+	}
+}
+
+// Options returns the options received from TDLib so far
+func (client *Client) Options() *OptionStore {
+	return client.options
+}
+
+// observe stores the value carried by a raw updateOption, an optionValueEmpty removes the option
+func (store *OptionStore) observe(updateBytes []byte) {
+	var update struct {
+		Name  string          `json:"name"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(updateBytes, &update); err != nil {
+		getLogger().Error("tdlib: failed to unmarshal updateOption", "error", err)
+		return
+	}
+
+	value, err := unmarshalOptionValue(&update.Value)
+	if err != nil {
+		getLogger().Error("tdlib: failed to unmarshal option value", "name", update.Name, "error", err)
+		return
+	}
+
+	store.lock.Lock()
+	if value == nil || value.GetOptionValueEnum() == OptionValueEmptyType {
+		delete(store.values, update.Name)
+	} else {
+		store.values[update.Name] = value
+	}
+	store.lock.Unlock()
+
+	if update.Name == "version" || update.Name == "commit_hash" {
+		store.client.observeSchemaVersion()
+	}
+}
+
+// Get returns the current value of the option, false if TDLib hasn't sent it or it is empty
+func (store *OptionStore) Get(name string) (OptionValue, bool) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	value, ok := store.values[name]
+	return value, ok
+}
+
+// Int returns the value of an integer option, false if it is missing or has another type
+func (store *OptionStore) Int(name string) (int64, bool) {
+	value, _ := store.Get(name)
+	integer, ok := value.(*OptionValueInteger)
+	if !ok {
+		return 0, false
+	}
+	return int64(integer.Value), true
+}
+
+// String returns the value of a string option, false if it is missing or has another type
+func (store *OptionStore) String(name string) (string, bool) {
+	value, _ := store.Get(name)
+	str, ok := value.(*OptionValueString)
+	if !ok {
+		return "", false
+	}
+	return str.Value, true
+}
+
+// Bool returns the value of a boolean option, false if it is missing or has another type
+func (store *OptionStore) Bool(name string) (bool, bool) {
+	value, _ := store.Get(name)
+	boolean, ok := value.(*OptionValueBoolean)
+	if !ok {
+		return false, false
+	}
+	return boolean.Value, true
+}
+
+// Snapshot returns a copy of every option currently known
+func (store *OptionStore) Snapshot() map[string]OptionValue {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	snapshot := make(map[string]OptionValue, len(store.values))
+	for name, value := range store.values {
+		snapshot[name] = value
+	}
+	return snapshot
+}
+
+// OnChange registers a handler called with the new value every time TDLib sends updateOption.
+// The store already holds the new value when the handler runs; an optionValueEmpty means the option was removed.
+// Handlers run on the update handlers goroutine, see OnUpdate.
+func (store *OptionStore) OnChange(handler func(name string, value OptionValue)) {
+	store.client.addUpdateHandler(UpdateOptionType, func(update Update) {
+		option := update.(*UpdateOption)
+		handler(option.Name, option.Value)
+	})
+}
@@ -0,0 +1,122 @@
+package tdlib
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"rsc.io/qr"
+)
+
+// qrQuietZone is the width, in modules, of the blank border required around a QR code
+const qrQuietZone = 2
+
+// QRAuthenticator is an Authenticator logging in by scanning a QR code with an already logged in device,
+// the QR code is rendered again every time TDLib refreshes the tg://login link.
+// The embedded TerminalAuthenticator prompts for the two-step verification password if the account has one.
+type QRAuthenticator struct {
+	TerminalAuthenticator
+
+	ASCII   bool                                   // Draw the terminal QR code with ASCII characters instead of Unicode blocks
+	PNGFile string                                 // Path of a PNG image of the QR code, rewritten on every refresh; no image is written if empty
+	OnLink  func(link string, code *qr.Code) error // Called on every refresh, e.g. to serve the QR code elsewhere
+}
+
+// LoginWithQRCode logs in by printing a QR code on out to be scanned with an already logged in device,
+// see Login and QRAuthenticator
+func (client *Client) LoginWithQRCode(ctx context.Context, out io.Writer) error {
+	return client.Login(ctx, &QRAuthenticator{TerminalAuthenticator: TerminalAuthenticator{Out: out}})
+}
+
+// PhoneNumber always starts QR code login
+func (auth *QRAuthenticator) PhoneNumber(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+// OtherDeviceConfirmation renders the refreshed link as a QR code
+func (auth *QRAuthenticator) OtherDeviceConfirmation(ctx context.Context, link string) error {
+	code, err := qr.Encode(link, qr.L)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(auth.out(), "Scan the QR code in Telegram > Settings > Devices > Link Desktop Device (%s):\n", link)
+	if err := writeQRCode(auth.out(), code, auth.ASCII); err != nil {
+		return err
+	}
+
+	if auth.PNGFile != "" {
+		if err := os.WriteFile(auth.PNGFile, code.PNG(), 0644); err != nil {
+			return err
+		}
+	}
+
+	if auth.OnLink != nil {
+		return auth.OnLink(link, code)
+	}
+	return nil
+}
+
+// RenderQRCode writes text as a QR code drawn with Unicode blocks, or with ASCII characters if ascii is true.
+// Light modules are drawn, which suits terminals with a dark background.
+func RenderQRCode(w io.Writer, text string, ascii bool) error {
+	code, err := qr.Encode(text, qr.L)
+	if err != nil {
+		return err
+	}
+	return writeQRCode(w, code, ascii)
+}
+
+// QRCodePNG returns text encoded as a QR code PNG image
+func QRCodePNG(text string) ([]byte, error) {
+	code, err := qr.Encode(text, qr.L)
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(), nil
+}
+
+func writeQRCode(w io.Writer, code *qr.Code, ascii bool) error {
+	var out strings.Builder
+	light := func(x, y int) bool {
+		return !code.Black(x, y)
+	}
+	from, to := -qrQuietZone, code.Size+qrQuietZone
+
+	if ascii {
+		// every module is two characters wide, to look roughly square
+		for y := from; y < to; y++ {
+			for x := from; x < to; x++ {
+				if light(x, y) {
+					out.WriteString("##")
+				} else {
+					out.WriteString("  ")
+				}
+			}
+			out.WriteString("\n")
+		}
+	} else {
+		// every character draws two rows of modules
+		for y := from; y < to; y += 2 {
+			for x := from; x < to; x++ {
+				top, bottom := light(x, y), y+1 < to && light(x, y+1)
+				switch {
+				case top && bottom:
+					out.WriteString("█")
+				case top:
+					out.WriteString("▀")
+				case bottom:
+					out.WriteString("▄")
+				default:
+					out.WriteString(" ")
+				}
+			}
+			out.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, out.String())
+	return err
+}
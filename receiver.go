@@ -0,0 +1,204 @@
+package tdlib
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what happens to an update when the channel of an EventReceiver is full
+type OverflowPolicy int
+
+// OverflowPolicy values
+const (
+	OverflowBlock      OverflowPolicy = iota // Wait for the consumer, stalling every other update and response meanwhile (default)
+	OverflowDropNewest                       // Discard the incoming update
+	OverflowDropOldest                       // Discard the oldest buffered update to make room for the incoming one
+	OverflowQueue                            // Keep the updates in an unbounded in-memory queue
+)
+
+// receiverState is shared by all the copies of an EventReceiver
+type receiverState struct {
+	dropped  uint64 // accessed atomically, kept first for 64-bit alignment
+	queueLen int64  // length of queue, accessed atomically so Len doesn't wait for a blocked delivery
+	paused   int32  // accessed atomically
+	policy   OverflowPolicy
+
+	lock      sync.Mutex // serializes deliveries with closing of the channel
+	closed    bool
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// OverflowQueue only
+	queue  []TdMessage
+	queued chan struct{}
+}
+
+// AddEventReceiverWithPolicy adds a new receiver like AddEventReceiver, with the given policy applied once the channel is full.
+// OverflowDropOldest needs room for the latest update, its channelCapacity is at least 1.
+func (client *Client) AddEventReceiverWithPolicy(msgInstance TdMessage, filterFunc EventFilterFunc, channelCapacity int, policy OverflowPolicy) EventReceiver {
+	if policy == OverflowDropOldest && channelCapacity < 1 {
+		// an unbuffered channel has nothing to drop, the delivery would never succeed
+		channelCapacity = 1
+	}
+
+	receiver := EventReceiver{
+		Instance:   msgInstance,
+		Chan:       make(chan TdMessage, channelCapacity),
+		FilterFunc: filterFunc,
+		state: &receiverState{
+			policy: policy,
+			done:   make(chan struct{}),
+			queued: make(chan struct{}, 1),
+		},
+	}
+
+	if policy == OverflowQueue {
+		go receiver.pump()
+	}
+
+	client.receiverLock.Lock()
+	defer client.receiverLock.Unlock()
+	client.receivers = append(client.receivers, receiver)
+
+	return receiver
+}
+
+// RemoveEventReceiver unsubscribes the receiver and closes its channel
+func (client *Client) RemoveEventReceiver(receiver EventReceiver) {
+	client.receiverLock.Lock()
+	for i, r := range client.receivers {
+		if r.Chan == receiver.Chan {
+			client.receivers = append(client.receivers[:i], client.receivers[i+1:]...)
+			break
+		}
+	}
+	client.receiverLock.Unlock()
+
+	receiver.close()
+}
+
+// Pause stops delivering updates to the receiver until Resume is called, updates arriving meanwhile are dropped
+func (receiver EventReceiver) Pause() {
+	atomic.StoreInt32(&receiver.state.paused, 1)
+}
+
+// Resume restarts delivering updates to a paused receiver
+func (receiver EventReceiver) Resume() {
+	atomic.StoreInt32(&receiver.state.paused, 0)
+}
+
+// Dropped returns how many updates were discarded because the receiver was full or paused
+func (receiver EventReceiver) Dropped() uint64 {
+	return atomic.LoadUint64(&receiver.state.dropped)
+}
+
+// Len returns how many updates are waiting to be consumed, it never waits for a delivery in progress
+func (receiver EventReceiver) Len() int {
+	return len(receiver.Chan) + int(atomic.LoadInt64(&receiver.state.queueLen))
+}
+
+// deliver hands the update over to the receiver according to its overflow policy,
+// a delivery waiting for the consumer is given up once stop is closed
+func (receiver EventReceiver) deliver(msg TdMessage, stop <-chan struct{}) {
+	state := receiver.state
+	if atomic.LoadInt32(&state.paused) == 1 {
+		atomic.AddUint64(&state.dropped, 1)
+		return
+	}
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	if state.closed {
+		return
+	}
+
+	switch state.policy {
+	case OverflowDropNewest:
+		select {
+		case receiver.Chan <- msg:
+		default:
+			atomic.AddUint64(&state.dropped, 1)
+		}
+
+	case OverflowDropOldest:
+		for {
+			select {
+			case receiver.Chan <- msg:
+				return
+			default:
+			}
+
+			select {
+			case <-receiver.Chan:
+				atomic.AddUint64(&state.dropped, 1)
+			default:
+			}
+		}
+
+	case OverflowQueue:
+		state.queue = append(state.queue, msg)
+		atomic.AddInt64(&state.queueLen, 1)
+		select {
+		case state.queued <- struct{}{}:
+		default:
+		}
+
+	default:
+		select {
+		case receiver.Chan <- msg:
+		case <-state.done:
+		case <-stop:
+		}
+	}
+}
+
+// pump moves queued updates into the channel of an OverflowQueue receiver, it owns the channel
+func (receiver EventReceiver) pump() {
+	state := receiver.state
+	defer close(receiver.Chan)
+
+	for {
+		state.lock.Lock()
+		if len(state.queue) == 0 {
+			state.lock.Unlock()
+
+			select {
+			case <-state.queued:
+				continue
+			case <-state.done:
+				return
+			}
+		}
+		msg := state.queue[0]
+		state.queue[0] = nil
+		state.queue = state.queue[1:]
+		atomic.AddInt64(&state.queueLen, -1)
+		state.lock.Unlock()
+
+		select {
+		case receiver.Chan <- msg:
+		case <-state.done:
+			return
+		}
+	}
+}
+
+// close stops deliveries and closes the channel, it's safe to call more than once
+func (receiver EventReceiver) close() {
+	state := receiver.state
+	state.closeOnce.Do(func() {
+		// unblocks a delivery waiting for the consumer
+		close(state.done)
+
+		state.lock.Lock()
+		defer state.lock.Unlock()
+
+		state.closed = true
+		state.queue = nil
+		atomic.StoreInt64(&state.queueLen, 0)
+		if state.policy != OverflowQueue {
+			close(receiver.Chan)
+		}
+	})
+}
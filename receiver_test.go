@@ -0,0 +1,230 @@
+package tdlib_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+// newReceiverTestClient returns a client on a fake answering getAuthorizationState,
+// which sync uses to wait until the receive loop has handled every update pushed before
+func newReceiverTestClient(t *testing.T) (*tdlibtest.Server, *tdlib.Client) {
+	server := tdlibtest.NewServer()
+	server.Handle("getAuthorizationState", tdlib.NewAuthorizationStateReady())
+	client := server.NewClient(tdlib.Config{})
+	t.Cleanup(client.DestroyInstance)
+	return server, client
+}
+
+// pushMessages pushes an updateNewMessage for every id and waits until they have been delivered
+func pushMessages(t *testing.T, server *tdlibtest.Server, client *tdlib.Client, ids ...int64) {
+	t.Helper()
+	for _, id := range ids {
+		if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{ID: tdlib.JSONInt64(id)})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.GetAuthorizationState(); err != nil {
+		t.Fatalf("sync request failed: %v", err)
+	}
+}
+
+// receivedIDs drains the buffered messages of the receiver
+func receivedIDs(receiver tdlib.EventReceiver) []int64 {
+	var ids []int64
+	for {
+		select {
+		case msg := <-receiver.Chan:
+			ids = append(ids, int64(msg.(*tdlib.UpdateNewMessage).Message.ID))
+		default:
+			return ids
+		}
+	}
+}
+
+func acceptAll(msg *tdlib.TdMessage) bool {
+	return true
+}
+
+func equalIDs(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReceiverOverflowDropNewest(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiverWithPolicy(&tdlib.UpdateNewMessage{}, acceptAll, 2, tdlib.OverflowDropNewest)
+
+	pushMessages(t, server, client, 1, 2, 3, 4)
+
+	if ids := receivedIDs(receiver); !equalIDs(ids, []int64{1, 2}) {
+		t.Errorf("received %v, want [1 2]", ids)
+	}
+	if dropped := receiver.Dropped(); dropped != 2 {
+		t.Errorf("Dropped() = %d, want 2", dropped)
+	}
+}
+
+func TestReceiverOverflowDropOldest(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiverWithPolicy(&tdlib.UpdateNewMessage{}, acceptAll, 2, tdlib.OverflowDropOldest)
+
+	pushMessages(t, server, client, 1, 2, 3, 4)
+
+	if ids := receivedIDs(receiver); !equalIDs(ids, []int64{3, 4}) {
+		t.Errorf("received %v, want [3 4]", ids)
+	}
+	if dropped := receiver.Dropped(); dropped != 2 {
+		t.Errorf("Dropped() = %d, want 2", dropped)
+	}
+}
+
+func TestReceiverOverflowDropOldestUnbuffered(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiverWithPolicy(&tdlib.UpdateNewMessage{}, acceptAll, 0, tdlib.OverflowDropOldest)
+
+	// used to spin forever holding the receive loop, so the sync request timed out
+	pushMessages(t, server, client, 1, 2, 3)
+
+	if ids := receivedIDs(receiver); !equalIDs(ids, []int64{3}) {
+		t.Errorf("received %v, want [3]", ids)
+	}
+	if dropped := receiver.Dropped(); dropped != 2 {
+		t.Errorf("Dropped() = %d, want 2", dropped)
+	}
+}
+
+func TestReceiverOverflowQueue(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiverWithPolicy(&tdlib.UpdateNewMessage{}, acceptAll, 1, tdlib.OverflowQueue)
+
+	pushMessages(t, server, client, 1, 2, 3, 4, 5)
+
+	var ids []int64
+	for len(ids) < 5 {
+		select {
+		case msg := <-receiver.Chan:
+			ids = append(ids, int64(msg.(*tdlib.UpdateNewMessage).Message.ID))
+		case <-time.After(3 * time.Second):
+			t.Fatalf("received only %v", ids)
+		}
+	}
+	if !equalIDs(ids, []int64{1, 2, 3, 4, 5}) {
+		t.Errorf("received %v, want [1 2 3 4 5]", ids)
+	}
+	if dropped := receiver.Dropped(); dropped != 0 {
+		t.Errorf("Dropped() = %d, want 0", dropped)
+	}
+}
+
+func TestReceiverOverflowBlock(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiver(&tdlib.UpdateNewMessage{}, acceptAll, 1)
+
+	// the receive loop waits for the consumer instead of dropping anything
+	for i := int64(1); i <= 3; i++ {
+		if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{ID: tdlib.JSONInt64(i)})); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var ids []int64
+	for len(ids) < 3 {
+		select {
+		case msg := <-receiver.Chan:
+			ids = append(ids, int64(msg.(*tdlib.UpdateNewMessage).Message.ID))
+		case <-time.After(3 * time.Second):
+			t.Fatalf("received only %v", ids)
+		}
+	}
+	pushMessages(t, server, client)
+
+	if !equalIDs(ids, []int64{1, 2, 3}) {
+		t.Errorf("received %v, want [1 2 3]", ids)
+	}
+	if dropped := receiver.Dropped(); dropped != 0 {
+		t.Errorf("Dropped() = %d, want 0", dropped)
+	}
+}
+
+func TestReceiverPause(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiver(&tdlib.UpdateNewMessage{}, acceptAll, 10)
+
+	receiver.Pause()
+	pushMessages(t, server, client, 1, 2)
+	receiver.Resume()
+	pushMessages(t, server, client, 3)
+
+	if ids := receivedIDs(receiver); !equalIDs(ids, []int64{3}) {
+		t.Errorf("received %v, want [3]", ids)
+	}
+	if dropped := receiver.Dropped(); dropped != 2 {
+		t.Errorf("Dropped() = %d, want 2", dropped)
+	}
+}
+
+func TestRemoveEventReceiverDuringDelivery(t *testing.T) {
+	for _, policy := range []tdlib.OverflowPolicy{tdlib.OverflowBlock, tdlib.OverflowQueue} {
+		server, client := newReceiverTestClient(t)
+		receiver := client.AddEventReceiverWithPolicy(&tdlib.UpdateNewMessage{}, acceptAll, 0, policy)
+
+		// nobody reads, an OverflowBlock delivery is stuck until the receiver is removed
+		for i := int64(1); i <= 3; i++ {
+			server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{ID: tdlib.JSONInt64(i)}))
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		client.RemoveEventReceiver(receiver)
+
+		select {
+		case _, ok := <-receiver.Chan:
+			if ok {
+				// a message handed over right before closing, the channel must be closed next
+				if _, ok := <-receiver.Chan; ok {
+					t.Errorf("policy %d: channel not closed after RemoveEventReceiver", policy)
+				}
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("policy %d: channel not closed after RemoveEventReceiver", policy)
+		}
+
+		// the receive loop carries on
+		pushMessages(t, server, client, 4)
+	}
+}
+
+func TestReceiverLenWhileDeliveryBlocked(t *testing.T) {
+	server, client := newReceiverTestClient(t)
+	receiver := client.AddEventReceiver(&tdlib.UpdateNewMessage{}, acceptAll, 0)
+	defer client.RemoveEventReceiver(receiver)
+
+	// nobody reads, the delivery waits for the consumer
+	if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{ID: 1})); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	length := make(chan int, 1)
+	go func() {
+		length <- receiver.Len()
+	}()
+
+	select {
+	case n := <-length:
+		if n != 0 {
+			t.Errorf("Len() = %d, want 0", n)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Len blocked by a delivery waiting for the consumer")
+	}
+}
@@ -0,0 +1,1277 @@
+package tdlib
+
+// typeRegistry creates an empty object of the Go type of an @type, e.g. &Chat{} for chat
+// or &GetChatRequest{} for getChat
+var typeRegistry = map[string]func() TdMessage{
+	"error":                                           func() TdMessage { return &Error{} },
+	"ok":                                              func() TdMessage { return &Ok{} },
+	"tdlibParameters":                                 func() TdMessage { return &TdlibParameters{} },
+	"authenticationCodeTypeTelegramMessage":           func() TdMessage { return &AuthenticationCodeTypeTelegramMessage{} },
+	"authenticationCodeTypeSms":                       func() TdMessage { return &AuthenticationCodeTypeSms{} },
+	"authenticationCodeTypeCall":                      func() TdMessage { return &AuthenticationCodeTypeCall{} },
+	"authenticationCodeTypeFlashCall":                 func() TdMessage { return &AuthenticationCodeTypeFlashCall{} },
+	"authenticationCodeInfo":                          func() TdMessage { return &AuthenticationCodeInfo{} },
+	"emailAddressAuthenticationCodeInfo":              func() TdMessage { return &EmailAddressAuthenticationCodeInfo{} },
+	"textEntity":                                      func() TdMessage { return &TextEntity{} },
+	"textEntities":                                    func() TdMessage { return &TextEntities{} },
+	"formattedText":                                   func() TdMessage { return &FormattedText{} },
+	"termsOfService":                                  func() TdMessage { return &TermsOfService{} },
+	"authorizationStateWaitTdlibParameters":           func() TdMessage { return &AuthorizationStateWaitTdlibParameters{} },
+	"authorizationStateWaitEncryptionKey":             func() TdMessage { return &AuthorizationStateWaitEncryptionKey{} },
+	"authorizationStateWaitPhoneNumber":               func() TdMessage { return &AuthorizationStateWaitPhoneNumber{} },
+	"authorizationStateWaitCode":                      func() TdMessage { return &AuthorizationStateWaitCode{} },
+	"authorizationStateWaitOtherDeviceConfirmation":   func() TdMessage { return &AuthorizationStateWaitOtherDeviceConfirmation{} },
+	"authorizationStateWaitRegistration":              func() TdMessage { return &AuthorizationStateWaitRegistration{} },
+	"authorizationStateWaitPassword":                  func() TdMessage { return &AuthorizationStateWaitPassword{} },
+	"authorizationStateReady":                         func() TdMessage { return &AuthorizationStateReady{} },
+	"authorizationStateLoggingOut":                    func() TdMessage { return &AuthorizationStateLoggingOut{} },
+	"authorizationStateClosing":                       func() TdMessage { return &AuthorizationStateClosing{} },
+	"authorizationStateClosed":                        func() TdMessage { return &AuthorizationStateClosed{} },
+	"passwordState":                                   func() TdMessage { return &PasswordState{} },
+	"recoveryEmailAddress":                            func() TdMessage { return &RecoveryEmailAddress{} },
+	"temporaryPasswordState":                          func() TdMessage { return &TemporaryPasswordState{} },
+	"localFile":                                       func() TdMessage { return &LocalFile{} },
+	"remoteFile":                                      func() TdMessage { return &RemoteFile{} },
+	"file":                                            func() TdMessage { return &File{} },
+	"inputFileId":                                     func() TdMessage { return &InputFileID{} },
+	"inputFileRemote":                                 func() TdMessage { return &InputFileRemote{} },
+	"inputFileLocal":                                  func() TdMessage { return &InputFileLocal{} },
+	"inputFileGenerated":                              func() TdMessage { return &InputFileGenerated{} },
+	"photoSize":                                       func() TdMessage { return &PhotoSize{} },
+	"minithumbnail":                                   func() TdMessage { return &Minithumbnail{} },
+	"thumbnailFormatJpeg":                             func() TdMessage { return &ThumbnailFormatJpeg{} },
+	"thumbnailFormatPng":                              func() TdMessage { return &ThumbnailFormatPng{} },
+	"thumbnailFormatWebp":                             func() TdMessage { return &ThumbnailFormatWebp{} },
+	"thumbnailFormatGif":                              func() TdMessage { return &ThumbnailFormatGif{} },
+	"thumbnailFormatTgs":                              func() TdMessage { return &ThumbnailFormatTgs{} },
+	"thumbnailFormatMpeg4":                            func() TdMessage { return &ThumbnailFormatMpeg4{} },
+	"thumbnail":                                       func() TdMessage { return &Thumbnail{} },
+	"maskPointForehead":                               func() TdMessage { return &MaskPointForehead{} },
+	"maskPointEyes":                                   func() TdMessage { return &MaskPointEyes{} },
+	"maskPointMouth":                                  func() TdMessage { return &MaskPointMouth{} },
+	"maskPointChin":                                   func() TdMessage { return &MaskPointChin{} },
+	"maskPosition":                                    func() TdMessage { return &MaskPosition{} },
+	"closedVectorPath":                                func() TdMessage { return &ClosedVectorPath{} },
+	"pollOption":                                      func() TdMessage { return &PollOption{} },
+	"pollTypeRegular":                                 func() TdMessage { return &PollTypeRegular{} },
+	"pollTypeQuiz":                                    func() TdMessage { return &PollTypeQuiz{} },
+	"animation":                                       func() TdMessage { return &Animation{} },
+	"audio":                                           func() TdMessage { return &Audio{} },
+	"document":                                        func() TdMessage { return &Document{} },
+	"photo":                                           func() TdMessage { return &Photo{} },
+	"sticker":                                         func() TdMessage { return &Sticker{} },
+	"video":                                           func() TdMessage { return &Video{} },
+	"videoNote":                                       func() TdMessage { return &VideoNote{} },
+	"voiceNote":                                       func() TdMessage { return &VoiceNote{} },
+	"contact":                                         func() TdMessage { return &Contact{} },
+	"location":                                        func() TdMessage { return &Location{} },
+	"venue":                                           func() TdMessage { return &Venue{} },
+	"game":                                            func() TdMessage { return &Game{} },
+	"poll":                                            func() TdMessage { return &Poll{} },
+	"profilePhoto":                                    func() TdMessage { return &ProfilePhoto{} },
+	"chatPhotoInfo":                                   func() TdMessage { return &ChatPhotoInfo{} },
+	"userTypeRegular":                                 func() TdMessage { return &UserTypeRegular{} },
+	"userTypeDeleted":                                 func() TdMessage { return &UserTypeDeleted{} },
+	"userTypeBot":                                     func() TdMessage { return &UserTypeBot{} },
+	"userTypeUnknown":                                 func() TdMessage { return &UserTypeUnknown{} },
+	"botCommand":                                      func() TdMessage { return &BotCommand{} },
+	"botInfo":                                         func() TdMessage { return &BotInfo{} },
+	"chatLocation":                                    func() TdMessage { return &ChatLocation{} },
+	"animatedChatPhoto":                               func() TdMessage { return &AnimatedChatPhoto{} },
+	"chatPhoto":                                       func() TdMessage { return &ChatPhoto{} },
+	"chatPhotos":                                      func() TdMessage { return &ChatPhotos{} },
+	"inputChatPhotoPrevious":                          func() TdMessage { return &InputChatPhotoPrevious{} },
+	"inputChatPhotoStatic":                            func() TdMessage { return &InputChatPhotoStatic{} },
+	"inputChatPhotoAnimation":                         func() TdMessage { return &InputChatPhotoAnimation{} },
+	"user":                                            func() TdMessage { return &User{} },
+	"userFullInfo":                                    func() TdMessage { return &UserFullInfo{} },
+	"users":                                           func() TdMessage { return &Users{} },
+	"chatAdministrator":                               func() TdMessage { return &ChatAdministrator{} },
+	"chatAdministrators":                              func() TdMessage { return &ChatAdministrators{} },
+	"chatPermissions":                                 func() TdMessage { return &ChatPermissions{} },
+	"chatMemberStatusCreator":                         func() TdMessage { return &ChatMemberStatusCreator{} },
+	"chatMemberStatusAdministrator":                   func() TdMessage { return &ChatMemberStatusAdministrator{} },
+	"chatMemberStatusMember":                          func() TdMessage { return &ChatMemberStatusMember{} },
+	"chatMemberStatusRestricted":                      func() TdMessage { return &ChatMemberStatusRestricted{} },
+	"chatMemberStatusLeft":                            func() TdMessage { return &ChatMemberStatusLeft{} },
+	"chatMemberStatusBanned":                          func() TdMessage { return &ChatMemberStatusBanned{} },
+	"chatMember":                                      func() TdMessage { return &ChatMember{} },
+	"chatMembers":                                     func() TdMessage { return &ChatMembers{} },
+	"chatMembersFilterContacts":                       func() TdMessage { return &ChatMembersFilterContacts{} },
+	"chatMembersFilterAdministrators":                 func() TdMessage { return &ChatMembersFilterAdministrators{} },
+	"chatMembersFilterMembers":                        func() TdMessage { return &ChatMembersFilterMembers{} },
+	"chatMembersFilterMention":                        func() TdMessage { return &ChatMembersFilterMention{} },
+	"chatMembersFilterRestricted":                     func() TdMessage { return &ChatMembersFilterRestricted{} },
+	"chatMembersFilterBanned":                         func() TdMessage { return &ChatMembersFilterBanned{} },
+	"chatMembersFilterBots":                           func() TdMessage { return &ChatMembersFilterBots{} },
+	"supergroupMembersFilterRecent":                   func() TdMessage { return &SupergroupMembersFilterRecent{} },
+	"supergroupMembersFilterContacts":                 func() TdMessage { return &SupergroupMembersFilterContacts{} },
+	"supergroupMembersFilterAdministrators":           func() TdMessage { return &SupergroupMembersFilterAdministrators{} },
+	"supergroupMembersFilterSearch":                   func() TdMessage { return &SupergroupMembersFilterSearch{} },
+	"supergroupMembersFilterRestricted":               func() TdMessage { return &SupergroupMembersFilterRestricted{} },
+	"supergroupMembersFilterBanned":                   func() TdMessage { return &SupergroupMembersFilterBanned{} },
+	"supergroupMembersFilterMention":                  func() TdMessage { return &SupergroupMembersFilterMention{} },
+	"supergroupMembersFilterBots":                     func() TdMessage { return &SupergroupMembersFilterBots{} },
+	"chatInviteLink":                                  func() TdMessage { return &ChatInviteLink{} },
+	"chatInviteLinks":                                 func() TdMessage { return &ChatInviteLinks{} },
+	"chatInviteLinkMember":                            func() TdMessage { return &ChatInviteLinkMember{} },
+	"chatInviteLinkMembers":                           func() TdMessage { return &ChatInviteLinkMembers{} },
+	"chatInviteLinkInfo":                              func() TdMessage { return &ChatInviteLinkInfo{} },
+	"basicGroup":                                      func() TdMessage { return &BasicGroup{} },
+	"basicGroupFullInfo":                              func() TdMessage { return &BasicGroupFullInfo{} },
+	"supergroup":                                      func() TdMessage { return &Supergroup{} },
+	"supergroupFullInfo":                              func() TdMessage { return &SupergroupFullInfo{} },
+	"secretChatStatePending":                          func() TdMessage { return &SecretChatStatePending{} },
+	"secretChatStateReady":                            func() TdMessage { return &SecretChatStateReady{} },
+	"secretChatStateClosed":                           func() TdMessage { return &SecretChatStateClosed{} },
+	"secretChat":                                      func() TdMessage { return &SecretChat{} },
+	"messageSenderUser":                               func() TdMessage { return &MessageSenderUser{} },
+	"messageSenderChat":                               func() TdMessage { return &MessageSenderChat{} },
+	"messageSenders":                                  func() TdMessage { return &MessageSenders{} },
+	"messageForwardOriginUser":                        func() TdMessage { return &MessageForwardOriginUser{} },
+	"messageForwardOriginChat":                        func() TdMessage { return &MessageForwardOriginChat{} },
+	"messageForwardOriginHiddenUser":                  func() TdMessage { return &MessageForwardOriginHiddenUser{} },
+	"messageForwardOriginChannel":                     func() TdMessage { return &MessageForwardOriginChannel{} },
+	"messageForwardOriginMessageImport":               func() TdMessage { return &MessageForwardOriginMessageImport{} },
+	"messageForwardInfo":                              func() TdMessage { return &MessageForwardInfo{} },
+	"messageReplyInfo":                                func() TdMessage { return &MessageReplyInfo{} },
+	"messageInteractionInfo":                          func() TdMessage { return &MessageInteractionInfo{} },
+	"messageSendingStatePending":                      func() TdMessage { return &MessageSendingStatePending{} },
+	"messageSendingStateFailed":                       func() TdMessage { return &MessageSendingStateFailed{} },
+	"message":                                         func() TdMessage { return &Message{} },
+	"messages":                                        func() TdMessage { return &Messages{} },
+	"foundMessages":                                   func() TdMessage { return &FoundMessages{} },
+	"notificationSettingsScopePrivateChats":           func() TdMessage { return &NotificationSettingsScopePrivateChats{} },
+	"notificationSettingsScopeGroupChats":             func() TdMessage { return &NotificationSettingsScopeGroupChats{} },
+	"notificationSettingsScopeChannelChats":           func() TdMessage { return &NotificationSettingsScopeChannelChats{} },
+	"chatNotificationSettings":                        func() TdMessage { return &ChatNotificationSettings{} },
+	"scopeNotificationSettings":                       func() TdMessage { return &ScopeNotificationSettings{} },
+	"draftMessage":                                    func() TdMessage { return &DraftMessage{} },
+	"chatTypePrivate":                                 func() TdMessage { return &ChatTypePrivate{} },
+	"chatTypeBasicGroup":                              func() TdMessage { return &ChatTypeBasicGroup{} },
+	"chatTypeSupergroup":                              func() TdMessage { return &ChatTypeSupergroup{} },
+	"chatTypeSecret":                                  func() TdMessage { return &ChatTypeSecret{} },
+	"chatFilter":                                      func() TdMessage { return &ChatFilter{} },
+	"chatFilterInfo":                                  func() TdMessage { return &ChatFilterInfo{} },
+	"recommendedChatFilter":                           func() TdMessage { return &RecommendedChatFilter{} },
+	"recommendedChatFilters":                          func() TdMessage { return &RecommendedChatFilters{} },
+	"chatListMain":                                    func() TdMessage { return &ChatListMain{} },
+	"chatListArchive":                                 func() TdMessage { return &ChatListArchive{} },
+	"chatListFilter":                                  func() TdMessage { return &ChatListFilter{} },
+	"chatLists":                                       func() TdMessage { return &ChatLists{} },
+	"chatSourceMtprotoProxy":                          func() TdMessage { return &ChatSourceMtprotoProxy{} },
+	"chatSourcePublicServiceAnnouncement":             func() TdMessage { return &ChatSourcePublicServiceAnnouncement{} },
+	"chatPosition":                                    func() TdMessage { return &ChatPosition{} },
+	"chat":                                            func() TdMessage { return &Chat{} },
+	"chats":                                           func() TdMessage { return &Chats{} },
+	"chatNearby":                                      func() TdMessage { return &ChatNearby{} },
+	"chatsNearby":                                     func() TdMessage { return &ChatsNearby{} },
+	"publicChatTypeHasUsername":                       func() TdMessage { return &PublicChatTypeHasUsername{} },
+	"publicChatTypeIsLocationBased":                   func() TdMessage { return &PublicChatTypeIsLocationBased{} },
+	"chatActionBarReportSpam":                         func() TdMessage { return &ChatActionBarReportSpam{} },
+	"chatActionBarReportUnrelatedLocation":            func() TdMessage { return &ChatActionBarReportUnrelatedLocation{} },
+	"chatActionBarInviteMembers":                      func() TdMessage { return &ChatActionBarInviteMembers{} },
+	"chatActionBarReportAddBlock":                     func() TdMessage { return &ChatActionBarReportAddBlock{} },
+	"chatActionBarAddContact":                         func() TdMessage { return &ChatActionBarAddContact{} },
+	"chatActionBarSharePhoneNumber":                   func() TdMessage { return &ChatActionBarSharePhoneNumber{} },
+	"keyboardButtonTypeText":                          func() TdMessage { return &KeyboardButtonTypeText{} },
+	"keyboardButtonTypeRequestPhoneNumber":            func() TdMessage { return &KeyboardButtonTypeRequestPhoneNumber{} },
+	"keyboardButtonTypeRequestLocation":               func() TdMessage { return &KeyboardButtonTypeRequestLocation{} },
+	"keyboardButtonTypeRequestPoll":                   func() TdMessage { return &KeyboardButtonTypeRequestPoll{} },
+	"keyboardButton":                                  func() TdMessage { return &KeyboardButton{} },
+	"inlineKeyboardButtonTypeUrl":                     func() TdMessage { return &InlineKeyboardButtonTypeURL{} },
+	"inlineKeyboardButtonTypeLoginUrl":                func() TdMessage { return &InlineKeyboardButtonTypeLoginURL{} },
+	"inlineKeyboardButtonTypeCallback":                func() TdMessage { return &InlineKeyboardButtonTypeCallback{} },
+	"inlineKeyboardButtonTypeCallbackWithPassword":    func() TdMessage { return &InlineKeyboardButtonTypeCallbackWithPassword{} },
+	"inlineKeyboardButtonTypeCallbackGame":            func() TdMessage { return &InlineKeyboardButtonTypeCallbackGame{} },
+	"inlineKeyboardButtonTypeSwitchInline":            func() TdMessage { return &InlineKeyboardButtonTypeSwitchInline{} },
+	"inlineKeyboardButtonTypeBuy":                     func() TdMessage { return &InlineKeyboardButtonTypeBuy{} },
+	"inlineKeyboardButton":                            func() TdMessage { return &InlineKeyboardButton{} },
+	"replyMarkupRemoveKeyboard":                       func() TdMessage { return &ReplyMarkupRemoveKeyboard{} },
+	"replyMarkupForceReply":                           func() TdMessage { return &ReplyMarkupForceReply{} },
+	"replyMarkupShowKeyboard":                         func() TdMessage { return &ReplyMarkupShowKeyboard{} },
+	"replyMarkupInlineKeyboard":                       func() TdMessage { return &ReplyMarkupInlineKeyboard{} },
+	"loginUrlInfoOpen":                                func() TdMessage { return &LoginURLInfoOpen{} },
+	"loginUrlInfoRequestConfirmation":                 func() TdMessage { return &LoginURLInfoRequestConfirmation{} },
+	"messageThreadInfo":                               func() TdMessage { return &MessageThreadInfo{} },
+	"richTextPlain":                                   func() TdMessage { return &RichTextPlain{} },
+	"richTextBold":                                    func() TdMessage { return &RichTextBold{} },
+	"richTextItalic":                                  func() TdMessage { return &RichTextItalic{} },
+	"richTextUnderline":                               func() TdMessage { return &RichTextUnderline{} },
+	"richTextStrikethrough":                           func() TdMessage { return &RichTextStrikethrough{} },
+	"richTextFixed":                                   func() TdMessage { return &RichTextFixed{} },
+	"richTextUrl":                                     func() TdMessage { return &RichTextURL{} },
+	"richTextEmailAddress":                            func() TdMessage { return &RichTextEmailAddress{} },
+	"richTextSubscript":                               func() TdMessage { return &RichTextSubscript{} },
+	"richTextSuperscript":                             func() TdMessage { return &RichTextSuperscript{} },
+	"richTextMarked":                                  func() TdMessage { return &RichTextMarked{} },
+	"richTextPhoneNumber":                             func() TdMessage { return &RichTextPhoneNumber{} },
+	"richTextIcon":                                    func() TdMessage { return &RichTextIcon{} },
+	"richTextReference":                               func() TdMessage { return &RichTextReference{} },
+	"richTextAnchor":                                  func() TdMessage { return &RichTextAnchor{} },
+	"richTextAnchorLink":                              func() TdMessage { return &RichTextAnchorLink{} },
+	"richTexts":                                       func() TdMessage { return &RichTexts{} },
+	"pageBlockCaption":                                func() TdMessage { return &PageBlockCaption{} },
+	"pageBlockListItem":                               func() TdMessage { return &PageBlockListItem{} },
+	"pageBlockHorizontalAlignmentLeft":                func() TdMessage { return &PageBlockHorizontalAlignmentLeft{} },
+	"pageBlockHorizontalAlignmentCenter":              func() TdMessage { return &PageBlockHorizontalAlignmentCenter{} },
+	"pageBlockHorizontalAlignmentRight":               func() TdMessage { return &PageBlockHorizontalAlignmentRight{} },
+	"pageBlockVerticalAlignmentTop":                   func() TdMessage { return &PageBlockVerticalAlignmentTop{} },
+	"pageBlockVerticalAlignmentMiddle":                func() TdMessage { return &PageBlockVerticalAlignmentMiddle{} },
+	"pageBlockVerticalAlignmentBottom":                func() TdMessage { return &PageBlockVerticalAlignmentBottom{} },
+	"pageBlockTableCell":                              func() TdMessage { return &PageBlockTableCell{} },
+	"pageBlockRelatedArticle":                         func() TdMessage { return &PageBlockRelatedArticle{} },
+	"pageBlockTitle":                                  func() TdMessage { return &PageBlockTitle{} },
+	"pageBlockSubtitle":                               func() TdMessage { return &PageBlockSubtitle{} },
+	"pageBlockAuthorDate":                             func() TdMessage { return &PageBlockAuthorDate{} },
+	"pageBlockHeader":                                 func() TdMessage { return &PageBlockHeader{} },
+	"pageBlockSubheader":                              func() TdMessage { return &PageBlockSubheader{} },
+	"pageBlockKicker":                                 func() TdMessage { return &PageBlockKicker{} },
+	"pageBlockParagraph":                              func() TdMessage { return &PageBlockParagraph{} },
+	"pageBlockPreformatted":                           func() TdMessage { return &PageBlockPreformatted{} },
+	"pageBlockFooter":                                 func() TdMessage { return &PageBlockFooter{} },
+	"pageBlockDivider":                                func() TdMessage { return &PageBlockDivider{} },
+	"pageBlockAnchor":                                 func() TdMessage { return &PageBlockAnchor{} },
+	"pageBlockList":                                   func() TdMessage { return &PageBlockList{} },
+	"pageBlockBlockQuote":                             func() TdMessage { return &PageBlockBlockQuote{} },
+	"pageBlockPullQuote":                              func() TdMessage { return &PageBlockPullQuote{} },
+	"pageBlockAnimation":                              func() TdMessage { return &PageBlockAnimation{} },
+	"pageBlockAudio":                                  func() TdMessage { return &PageBlockAudio{} },
+	"pageBlockPhoto":                                  func() TdMessage { return &PageBlockPhoto{} },
+	"pageBlockVideo":                                  func() TdMessage { return &PageBlockVideo{} },
+	"pageBlockVoiceNote":                              func() TdMessage { return &PageBlockVoiceNote{} },
+	"pageBlockCover":                                  func() TdMessage { return &PageBlockCover{} },
+	"pageBlockEmbedded":                               func() TdMessage { return &PageBlockEmbedded{} },
+	"pageBlockEmbeddedPost":                           func() TdMessage { return &PageBlockEmbeddedPost{} },
+	"pageBlockCollage":                                func() TdMessage { return &PageBlockCollage{} },
+	"pageBlockSlideshow":                              func() TdMessage { return &PageBlockSlideshow{} },
+	"pageBlockChatLink":                               func() TdMessage { return &PageBlockChatLink{} },
+	"pageBlockTable":                                  func() TdMessage { return &PageBlockTable{} },
+	"pageBlockDetails":                                func() TdMessage { return &PageBlockDetails{} },
+	"pageBlockRelatedArticles":                        func() TdMessage { return &PageBlockRelatedArticles{} },
+	"pageBlockMap":                                    func() TdMessage { return &PageBlockMap{} },
+	"webPageInstantView":                              func() TdMessage { return &WebPageInstantView{} },
+	"webPage":                                         func() TdMessage { return &WebPage{} },
+	"countryInfo":                                     func() TdMessage { return &CountryInfo{} },
+	"countries":                                       func() TdMessage { return &Countries{} },
+	"phoneNumberInfo":                                 func() TdMessage { return &PhoneNumberInfo{} },
+	"bankCardActionOpenUrl":                           func() TdMessage { return &BankCardActionOpenURL{} },
+	"bankCardInfo":                                    func() TdMessage { return &BankCardInfo{} },
+	"address":                                         func() TdMessage { return &Address{} },
+	"labeledPricePart":                                func() TdMessage { return &LabeledPricePart{} },
+	"invoice":                                         func() TdMessage { return &Invoice{} },
+	"orderInfo":                                       func() TdMessage { return &OrderInfo{} },
+	"shippingOption":                                  func() TdMessage { return &ShippingOption{} },
+	"savedCredentials":                                func() TdMessage { return &SavedCredentials{} },
+	"inputCredentialsSaved":                           func() TdMessage { return &InputCredentialsSaved{} },
+	"inputCredentialsNew":                             func() TdMessage { return &InputCredentialsNew{} },
+	"inputCredentialsApplePay":                        func() TdMessage { return &InputCredentialsApplePay{} },
+	"inputCredentialsGooglePay":                       func() TdMessage { return &InputCredentialsGooglePay{} },
+	"paymentsProviderStripe":                          func() TdMessage { return &PaymentsProviderStripe{} },
+	"paymentForm":                                     func() TdMessage { return &PaymentForm{} },
+	"validatedOrderInfo":                              func() TdMessage { return &ValidatedOrderInfo{} },
+	"paymentResult":                                   func() TdMessage { return &PaymentResult{} },
+	"paymentReceipt":                                  func() TdMessage { return &PaymentReceipt{} },
+	"datedFile":                                       func() TdMessage { return &DatedFile{} },
+	"passportElementTypePersonalDetails":              func() TdMessage { return &PassportElementTypePersonalDetails{} },
+	"passportElementTypePassport":                     func() TdMessage { return &PassportElementTypePassport{} },
+	"passportElementTypeDriverLicense":                func() TdMessage { return &PassportElementTypeDriverLicense{} },
+	"passportElementTypeIdentityCard":                 func() TdMessage { return &PassportElementTypeIDentityCard{} },
+	"passportElementTypeInternalPassport":             func() TdMessage { return &PassportElementTypeInternalPassport{} },
+	"passportElementTypeAddress":                      func() TdMessage { return &PassportElementTypeAddress{} },
+	"passportElementTypeUtilityBill":                  func() TdMessage { return &PassportElementTypeUtilityBill{} },
+	"passportElementTypeBankStatement":                func() TdMessage { return &PassportElementTypeBankStatement{} },
+	"passportElementTypeRentalAgreement":              func() TdMessage { return &PassportElementTypeRentalAgreement{} },
+	"passportElementTypePassportRegistration":         func() TdMessage { return &PassportElementTypePassportRegistration{} },
+	"passportElementTypeTemporaryRegistration":        func() TdMessage { return &PassportElementTypeTemporaryRegistration{} },
+	"passportElementTypePhoneNumber":                  func() TdMessage { return &PassportElementTypePhoneNumber{} },
+	"passportElementTypeEmailAddress":                 func() TdMessage { return &PassportElementTypeEmailAddress{} },
+	"date":                                            func() TdMessage { return &Date{} },
+	"personalDetails":                                 func() TdMessage { return &PersonalDetails{} },
+	"identityDocument":                                func() TdMessage { return &IDentityDocument{} },
+	"inputIdentityDocument":                           func() TdMessage { return &InputIDentityDocument{} },
+	"personalDocument":                                func() TdMessage { return &PersonalDocument{} },
+	"inputPersonalDocument":                           func() TdMessage { return &InputPersonalDocument{} },
+	"passportElementPersonalDetails":                  func() TdMessage { return &PassportElementPersonalDetails{} },
+	"passportElementPassport":                         func() TdMessage { return &PassportElementPassport{} },
+	"passportElementDriverLicense":                    func() TdMessage { return &PassportElementDriverLicense{} },
+	"passportElementIdentityCard":                     func() TdMessage { return &PassportElementIDentityCard{} },
+	"passportElementInternalPassport":                 func() TdMessage { return &PassportElementInternalPassport{} },
+	"passportElementAddress":                          func() TdMessage { return &PassportElementAddress{} },
+	"passportElementUtilityBill":                      func() TdMessage { return &PassportElementUtilityBill{} },
+	"passportElementBankStatement":                    func() TdMessage { return &PassportElementBankStatement{} },
+	"passportElementRentalAgreement":                  func() TdMessage { return &PassportElementRentalAgreement{} },
+	"passportElementPassportRegistration":             func() TdMessage { return &PassportElementPassportRegistration{} },
+	"passportElementTemporaryRegistration":            func() TdMessage { return &PassportElementTemporaryRegistration{} },
+	"passportElementPhoneNumber":                      func() TdMessage { return &PassportElementPhoneNumber{} },
+	"passportElementEmailAddress":                     func() TdMessage { return &PassportElementEmailAddress{} },
+	"inputPassportElementPersonalDetails":             func() TdMessage { return &InputPassportElementPersonalDetails{} },
+	"inputPassportElementPassport":                    func() TdMessage { return &InputPassportElementPassport{} },
+	"inputPassportElementDriverLicense":               func() TdMessage { return &InputPassportElementDriverLicense{} },
+	"inputPassportElementIdentityCard":                func() TdMessage { return &InputPassportElementIDentityCard{} },
+	"inputPassportElementInternalPassport":            func() TdMessage { return &InputPassportElementInternalPassport{} },
+	"inputPassportElementAddress":                     func() TdMessage { return &InputPassportElementAddress{} },
+	"inputPassportElementUtilityBill":                 func() TdMessage { return &InputPassportElementUtilityBill{} },
+	"inputPassportElementBankStatement":               func() TdMessage { return &InputPassportElementBankStatement{} },
+	"inputPassportElementRentalAgreement":             func() TdMessage { return &InputPassportElementRentalAgreement{} },
+	"inputPassportElementPassportRegistration":        func() TdMessage { return &InputPassportElementPassportRegistration{} },
+	"inputPassportElementTemporaryRegistration":       func() TdMessage { return &InputPassportElementTemporaryRegistration{} },
+	"inputPassportElementPhoneNumber":                 func() TdMessage { return &InputPassportElementPhoneNumber{} },
+	"inputPassportElementEmailAddress":                func() TdMessage { return &InputPassportElementEmailAddress{} },
+	"passportElements":                                func() TdMessage { return &PassportElements{} },
+	"passportElementErrorSourceUnspecified":           func() TdMessage { return &PassportElementErrorSourceUnspecified{} },
+	"passportElementErrorSourceDataField":             func() TdMessage { return &PassportElementErrorSourceDataField{} },
+	"passportElementErrorSourceFrontSide":             func() TdMessage { return &PassportElementErrorSourceFrontSide{} },
+	"passportElementErrorSourceReverseSide":           func() TdMessage { return &PassportElementErrorSourceReverseSide{} },
+	"passportElementErrorSourceSelfie":                func() TdMessage { return &PassportElementErrorSourceSelfie{} },
+	"passportElementErrorSourceTranslationFile":       func() TdMessage { return &PassportElementErrorSourceTranslationFile{} },
+	"passportElementErrorSourceTranslationFiles":      func() TdMessage { return &PassportElementErrorSourceTranslationFiles{} },
+	"passportElementErrorSourceFile":                  func() TdMessage { return &PassportElementErrorSourceFile{} },
+	"passportElementErrorSourceFiles":                 func() TdMessage { return &PassportElementErrorSourceFiles{} },
+	"passportElementError":                            func() TdMessage { return &PassportElementError{} },
+	"passportSuitableElement":                         func() TdMessage { return &PassportSuitableElement{} },
+	"passportRequiredElement":                         func() TdMessage { return &PassportRequiredElement{} },
+	"passportAuthorizationForm":                       func() TdMessage { return &PassportAuthorizationForm{} },
+	"passportElementsWithErrors":                      func() TdMessage { return &PassportElementsWithErrors{} },
+	"encryptedCredentials":                            func() TdMessage { return &EncryptedCredentials{} },
+	"encryptedPassportElement":                        func() TdMessage { return &EncryptedPassportElement{} },
+	"inputPassportElementErrorSourceUnspecified":      func() TdMessage { return &InputPassportElementErrorSourceUnspecified{} },
+	"inputPassportElementErrorSourceDataField":        func() TdMessage { return &InputPassportElementErrorSourceDataField{} },
+	"inputPassportElementErrorSourceFrontSide":        func() TdMessage { return &InputPassportElementErrorSourceFrontSide{} },
+	"inputPassportElementErrorSourceReverseSide":      func() TdMessage { return &InputPassportElementErrorSourceReverseSide{} },
+	"inputPassportElementErrorSourceSelfie":           func() TdMessage { return &InputPassportElementErrorSourceSelfie{} },
+	"inputPassportElementErrorSourceTranslationFile":  func() TdMessage { return &InputPassportElementErrorSourceTranslationFile{} },
+	"inputPassportElementErrorSourceTranslationFiles": func() TdMessage { return &InputPassportElementErrorSourceTranslationFiles{} },
+	"inputPassportElementErrorSourceFile":             func() TdMessage { return &InputPassportElementErrorSourceFile{} },
+	"inputPassportElementErrorSourceFiles":            func() TdMessage { return &InputPassportElementErrorSourceFiles{} },
+	"inputPassportElementError":                       func() TdMessage { return &InputPassportElementError{} },
+	"messageText":                                     func() TdMessage { return &MessageText{} },
+	"messageAnimation":                                func() TdMessage { return &MessageAnimation{} },
+	"messageAudio":                                    func() TdMessage { return &MessageAudio{} },
+	"messageDocument":                                 func() TdMessage { return &MessageDocument{} },
+	"messagePhoto":                                    func() TdMessage { return &MessagePhoto{} },
+	"messageExpiredPhoto":                             func() TdMessage { return &MessageExpiredPhoto{} },
+	"messageSticker":                                  func() TdMessage { return &MessageSticker{} },
+	"messageVideo":                                    func() TdMessage { return &MessageVideo{} },
+	"messageExpiredVideo":                             func() TdMessage { return &MessageExpiredVideo{} },
+	"messageVideoNote":                                func() TdMessage { return &MessageVideoNote{} },
+	"messageVoiceNote":                                func() TdMessage { return &MessageVoiceNote{} },
+	"messageLocation":                                 func() TdMessage { return &MessageLocation{} },
+	"messageVenue":                                    func() TdMessage { return &MessageVenue{} },
+	"messageContact":                                  func() TdMessage { return &MessageContact{} },
+	"messageDice":                                     func() TdMessage { return &MessageDice{} },
+	"messageGame":                                     func() TdMessage { return &MessageGame{} },
+	"messagePoll":                                     func() TdMessage { return &MessagePoll{} },
+	"messageInvoice":                                  func() TdMessage { return &MessageInvoice{} },
+	"messageCall":                                     func() TdMessage { return &MessageCall{} },
+	"messageVoiceChatStarted":                         func() TdMessage { return &MessageVoiceChatStarted{} },
+	"messageVoiceChatEnded":                           func() TdMessage { return &MessageVoiceChatEnded{} },
+	"messageInviteVoiceChatParticipants":              func() TdMessage { return &MessageInviteVoiceChatParticipants{} },
+	"messageBasicGroupChatCreate":                     func() TdMessage { return &MessageBasicGroupChatCreate{} },
+	"messageSupergroupChatCreate":                     func() TdMessage { return &MessageSupergroupChatCreate{} },
+	"messageChatChangeTitle":                          func() TdMessage { return &MessageChatChangeTitle{} },
+	"messageChatChangePhoto":                          func() TdMessage { return &MessageChatChangePhoto{} },
+	"messageChatDeletePhoto":                          func() TdMessage { return &MessageChatDeletePhoto{} },
+	"messageChatAddMembers":                           func() TdMessage { return &MessageChatAddMembers{} },
+	"messageChatJoinByLink":                           func() TdMessage { return &MessageChatJoinByLink{} },
+	"messageChatDeleteMember":                         func() TdMessage { return &MessageChatDeleteMember{} },
+	"messageChatUpgradeTo":                            func() TdMessage { return &MessageChatUpgradeTo{} },
+	"messageChatUpgradeFrom":                          func() TdMessage { return &MessageChatUpgradeFrom{} },
+	"messagePinMessage":                               func() TdMessage { return &MessagePinMessage{} },
+	"messageScreenshotTaken":                          func() TdMessage { return &MessageScreenshotTaken{} },
+	"messageChatSetTtl":                               func() TdMessage { return &MessageChatSetTTL{} },
+	"messageCustomServiceAction":                      func() TdMessage { return &MessageCustomServiceAction{} },
+	"messageGameScore":                                func() TdMessage { return &MessageGameScore{} },
+	"messagePaymentSuccessful":                        func() TdMessage { return &MessagePaymentSuccessful{} },
+	"messagePaymentSuccessfulBot":                     func() TdMessage { return &MessagePaymentSuccessfulBot{} },
+	"messageContactRegistered":                        func() TdMessage { return &MessageContactRegistered{} },
+	"messageWebsiteConnected":                         func() TdMessage { return &MessageWebsiteConnected{} },
+	"messagePassportDataSent":                         func() TdMessage { return &MessagePassportDataSent{} },
+	"messagePassportDataReceived":                     func() TdMessage { return &MessagePassportDataReceived{} },
+	"messageProximityAlertTriggered":                  func() TdMessage { return &MessageProximityAlertTriggered{} },
+	"messageUnsupported":                              func() TdMessage { return &MessageUnsupported{} },
+	"textEntityTypeMention":                           func() TdMessage { return &TextEntityTypeMention{} },
+	"textEntityTypeHashtag":                           func() TdMessage { return &TextEntityTypeHashtag{} },
+	"textEntityTypeCashtag":                           func() TdMessage { return &TextEntityTypeCashtag{} },
+	"textEntityTypeBotCommand":                        func() TdMessage { return &TextEntityTypeBotCommand{} },
+	"textEntityTypeUrl":                               func() TdMessage { return &TextEntityTypeURL{} },
+	"textEntityTypeEmailAddress":                      func() TdMessage { return &TextEntityTypeEmailAddress{} },
+	"textEntityTypePhoneNumber":                       func() TdMessage { return &TextEntityTypePhoneNumber{} },
+	"textEntityTypeBankCardNumber":                    func() TdMessage { return &TextEntityTypeBankCardNumber{} },
+	"textEntityTypeBold":                              func() TdMessage { return &TextEntityTypeBold{} },
+	"textEntityTypeItalic":                            func() TdMessage { return &TextEntityTypeItalic{} },
+	"textEntityTypeUnderline":                         func() TdMessage { return &TextEntityTypeUnderline{} },
+	"textEntityTypeStrikethrough":                     func() TdMessage { return &TextEntityTypeStrikethrough{} },
+	"textEntityTypeCode":                              func() TdMessage { return &TextEntityTypeCode{} },
+	"textEntityTypePre":                               func() TdMessage { return &TextEntityTypePre{} },
+	"textEntityTypePreCode":                           func() TdMessage { return &TextEntityTypePreCode{} },
+	"textEntityTypeTextUrl":                           func() TdMessage { return &TextEntityTypeTextURL{} },
+	"textEntityTypeMentionName":                       func() TdMessage { return &TextEntityTypeMentionName{} },
+	"inputThumbnail":                                  func() TdMessage { return &InputThumbnail{} },
+	"messageSchedulingStateSendAtDate":                func() TdMessage { return &MessageSchedulingStateSendAtDate{} },
+	"messageSchedulingStateSendWhenOnline":            func() TdMessage { return &MessageSchedulingStateSendWhenOnline{} },
+	"messageSendOptions":                              func() TdMessage { return &MessageSendOptions{} },
+	"messageCopyOptions":                              func() TdMessage { return &MessageCopyOptions{} },
+	"inputMessageText":                                func() TdMessage { return &InputMessageText{} },
+	"inputMessageAnimation":                           func() TdMessage { return &InputMessageAnimation{} },
+	"inputMessageAudio":                               func() TdMessage { return &InputMessageAudio{} },
+	"inputMessageDocument":                            func() TdMessage { return &InputMessageDocument{} },
+	"inputMessagePhoto":                               func() TdMessage { return &InputMessagePhoto{} },
+	"inputMessageSticker":                             func() TdMessage { return &InputMessageSticker{} },
+	"inputMessageVideo":                               func() TdMessage { return &InputMessageVideo{} },
+	"inputMessageVideoNote":                           func() TdMessage { return &InputMessageVideoNote{} },
+	"inputMessageVoiceNote":                           func() TdMessage { return &InputMessageVoiceNote{} },
+	"inputMessageLocation":                            func() TdMessage { return &InputMessageLocation{} },
+	"inputMessageVenue":                               func() TdMessage { return &InputMessageVenue{} },
+	"inputMessageContact":                             func() TdMessage { return &InputMessageContact{} },
+	"inputMessageDice":                                func() TdMessage { return &InputMessageDice{} },
+	"inputMessageGame":                                func() TdMessage { return &InputMessageGame{} },
+	"inputMessageInvoice":                             func() TdMessage { return &InputMessageInvoice{} },
+	"inputMessagePoll":                                func() TdMessage { return &InputMessagePoll{} },
+	"inputMessageForwarded":                           func() TdMessage { return &InputMessageForwarded{} },
+	"searchMessagesFilterEmpty":                       func() TdMessage { return &SearchMessagesFilterEmpty{} },
+	"searchMessagesFilterAnimation":                   func() TdMessage { return &SearchMessagesFilterAnimation{} },
+	"searchMessagesFilterAudio":                       func() TdMessage { return &SearchMessagesFilterAudio{} },
+	"searchMessagesFilterDocument":                    func() TdMessage { return &SearchMessagesFilterDocument{} },
+	"searchMessagesFilterPhoto":                       func() TdMessage { return &SearchMessagesFilterPhoto{} },
+	"searchMessagesFilterVideo":                       func() TdMessage { return &SearchMessagesFilterVideo{} },
+	"searchMessagesFilterVoiceNote":                   func() TdMessage { return &SearchMessagesFilterVoiceNote{} },
+	"searchMessagesFilterPhotoAndVideo":               func() TdMessage { return &SearchMessagesFilterPhotoAndVideo{} },
+	"searchMessagesFilterUrl":                         func() TdMessage { return &SearchMessagesFilterURL{} },
+	"searchMessagesFilterChatPhoto":                   func() TdMessage { return &SearchMessagesFilterChatPhoto{} },
+	"searchMessagesFilterCall":                        func() TdMessage { return &SearchMessagesFilterCall{} },
+	"searchMessagesFilterMissedCall":                  func() TdMessage { return &SearchMessagesFilterMissedCall{} },
+	"searchMessagesFilterVideoNote":                   func() TdMessage { return &SearchMessagesFilterVideoNote{} },
+	"searchMessagesFilterVoiceAndVideoNote":           func() TdMessage { return &SearchMessagesFilterVoiceAndVideoNote{} },
+	"searchMessagesFilterMention":                     func() TdMessage { return &SearchMessagesFilterMention{} },
+	"searchMessagesFilterUnreadMention":               func() TdMessage { return &SearchMessagesFilterUnreadMention{} },
+	"searchMessagesFilterFailedToSend":                func() TdMessage { return &SearchMessagesFilterFailedToSend{} },
+	"searchMessagesFilterPinned":                      func() TdMessage { return &SearchMessagesFilterPinned{} },
+	"chatActionTyping":                                func() TdMessage { return &ChatActionTyping{} },
+	"chatActionRecordingVideo":                        func() TdMessage { return &ChatActionRecordingVideo{} },
+	"chatActionUploadingVideo":                        func() TdMessage { return &ChatActionUploadingVideo{} },
+	"chatActionRecordingVoiceNote":                    func() TdMessage { return &ChatActionRecordingVoiceNote{} },
+	"chatActionUploadingVoiceNote":                    func() TdMessage { return &ChatActionUploadingVoiceNote{} },
+	"chatActionUploadingPhoto":                        func() TdMessage { return &ChatActionUploadingPhoto{} },
+	"chatActionUploadingDocument":                     func() TdMessage { return &ChatActionUploadingDocument{} },
+	"chatActionChoosingLocation":                      func() TdMessage { return &ChatActionChoosingLocation{} },
+	"chatActionChoosingContact":                       func() TdMessage { return &ChatActionChoosingContact{} },
+	"chatActionStartPlayingGame":                      func() TdMessage { return &ChatActionStartPlayingGame{} },
+	"chatActionRecordingVideoNote":                    func() TdMessage { return &ChatActionRecordingVideoNote{} },
+	"chatActionUploadingVideoNote":                    func() TdMessage { return &ChatActionUploadingVideoNote{} },
+	"chatActionCancel":                                func() TdMessage { return &ChatActionCancel{} },
+	"userStatusEmpty":                                 func() TdMessage { return &UserStatusEmpty{} },
+	"userStatusOnline":                                func() TdMessage { return &UserStatusOnline{} },
+	"userStatusOffline":                               func() TdMessage { return &UserStatusOffline{} },
+	"userStatusRecently":                              func() TdMessage { return &UserStatusRecently{} },
+	"userStatusLastWeek":                              func() TdMessage { return &UserStatusLastWeek{} },
+	"userStatusLastMonth":                             func() TdMessage { return &UserStatusLastMonth{} },
+	"stickers":                                        func() TdMessage { return &Stickers{} },
+	"emojis":                                          func() TdMessage { return &Emojis{} },
+	"stickerSet":                                      func() TdMessage { return &StickerSet{} },
+	"stickerSetInfo":                                  func() TdMessage { return &StickerSetInfo{} },
+	"stickerSets":                                     func() TdMessage { return &StickerSets{} },
+	"callDiscardReasonEmpty":                          func() TdMessage { return &CallDiscardReasonEmpty{} },
+	"callDiscardReasonMissed":                         func() TdMessage { return &CallDiscardReasonMissed{} },
+	"callDiscardReasonDeclined":                       func() TdMessage { return &CallDiscardReasonDeclined{} },
+	"callDiscardReasonDisconnected":                   func() TdMessage { return &CallDiscardReasonDisconnected{} },
+	"callDiscardReasonHungUp":                         func() TdMessage { return &CallDiscardReasonHungUp{} },
+	"callProtocol":                                    func() TdMessage { return &CallProtocol{} },
+	"callServerTypeTelegramReflector":                 func() TdMessage { return &CallServerTypeTelegramReflector{} },
+	"callServerTypeWebrtc":                            func() TdMessage { return &CallServerTypeWebrtc{} },
+	"callServer":                                      func() TdMessage { return &CallServer{} },
+	"callId":                                          func() TdMessage { return &CallID{} },
+	"groupCallId":                                     func() TdMessage { return &GroupCallID{} },
+	"callStatePending":                                func() TdMessage { return &CallStatePending{} },
+	"callStateExchangingKeys":                         func() TdMessage { return &CallStateExchangingKeys{} },
+	"callStateReady":                                  func() TdMessage { return &CallStateReady{} },
+	"callStateHangingUp":                              func() TdMessage { return &CallStateHangingUp{} },
+	"callStateDiscarded":                              func() TdMessage { return &CallStateDiscarded{} },
+	"callStateError":                                  func() TdMessage { return &CallStateError{} },
+	"groupCallRecentSpeaker":                          func() TdMessage { return &GroupCallRecentSpeaker{} },
+	"groupCall":                                       func() TdMessage { return &GroupCall{} },
+	"groupCallPayloadFingerprint":                     func() TdMessage { return &GroupCallPayloadFingerprint{} },
+	"groupCallPayload":                                func() TdMessage { return &GroupCallPayload{} },
+	"groupCallJoinResponseCandidate":                  func() TdMessage { return &GroupCallJoinResponseCandidate{} },
+	"groupCallJoinResponse":                           func() TdMessage { return &GroupCallJoinResponse{} },
+	"groupCallParticipant":                            func() TdMessage { return &GroupCallParticipant{} },
+	"callProblemEcho":                                 func() TdMessage { return &CallProblemEcho{} },
+	"callProblemNoise":                                func() TdMessage { return &CallProblemNoise{} },
+	"callProblemInterruptions":                        func() TdMessage { return &CallProblemInterruptions{} },
+	"callProblemDistortedSpeech":                      func() TdMessage { return &CallProblemDistortedSpeech{} },
+	"callProblemSilentLocal":                          func() TdMessage { return &CallProblemSilentLocal{} },
+	"callProblemSilentRemote":                         func() TdMessage { return &CallProblemSilentRemote{} },
+	"callProblemDropped":                              func() TdMessage { return &CallProblemDropped{} },
+	"callProblemDistortedVideo":                       func() TdMessage { return &CallProblemDistortedVideo{} },
+	"callProblemPixelatedVideo":                       func() TdMessage { return &CallProblemPixelatedVideo{} },
+	"call":                                            func() TdMessage { return &Call{} },
+	"phoneNumberAuthenticationSettings":               func() TdMessage { return &PhoneNumberAuthenticationSettings{} },
+	"animations":                                      func() TdMessage { return &Animations{} },
+	"diceStickersRegular":                             func() TdMessage { return &DiceStickersRegular{} },
+	"diceStickersSlotMachine":                         func() TdMessage { return &DiceStickersSlotMachine{} },
+	"importedContacts":                                func() TdMessage { return &ImportedContacts{} },
+	"httpUrl":                                         func() TdMessage { return &HttpURL{} },
+	"inputInlineQueryResultAnimation":                 func() TdMessage { return &InputInlineQueryResultAnimation{} },
+	"inputInlineQueryResultArticle":                   func() TdMessage { return &InputInlineQueryResultArticle{} },
+	"inputInlineQueryResultAudio":                     func() TdMessage { return &InputInlineQueryResultAudio{} },
+	"inputInlineQueryResultContact":                   func() TdMessage { return &InputInlineQueryResultContact{} },
+	"inputInlineQueryResultDocument":                  func() TdMessage { return &InputInlineQueryResultDocument{} },
+	"inputInlineQueryResultGame":                      func() TdMessage { return &InputInlineQueryResultGame{} },
+	"inputInlineQueryResultLocation":                  func() TdMessage { return &InputInlineQueryResultLocation{} },
+	"inputInlineQueryResultPhoto":                     func() TdMessage { return &InputInlineQueryResultPhoto{} },
+	"inputInlineQueryResultSticker":                   func() TdMessage { return &InputInlineQueryResultSticker{} },
+	"inputInlineQueryResultVenue":                     func() TdMessage { return &InputInlineQueryResultVenue{} },
+	"inputInlineQueryResultVideo":                     func() TdMessage { return &InputInlineQueryResultVideo{} },
+	"inputInlineQueryResultVoiceNote":                 func() TdMessage { return &InputInlineQueryResultVoiceNote{} },
+	"inlineQueryResultArticle":                        func() TdMessage { return &InlineQueryResultArticle{} },
+	"inlineQueryResultContact":                        func() TdMessage { return &InlineQueryResultContact{} },
+	"inlineQueryResultLocation":                       func() TdMessage { return &InlineQueryResultLocation{} },
+	"inlineQueryResultVenue":                          func() TdMessage { return &InlineQueryResultVenue{} },
+	"inlineQueryResultGame":                           func() TdMessage { return &InlineQueryResultGame{} },
+	"inlineQueryResultAnimation":                      func() TdMessage { return &InlineQueryResultAnimation{} },
+	"inlineQueryResultAudio":                          func() TdMessage { return &InlineQueryResultAudio{} },
+	"inlineQueryResultDocument":                       func() TdMessage { return &InlineQueryResultDocument{} },
+	"inlineQueryResultPhoto":                          func() TdMessage { return &InlineQueryResultPhoto{} },
+	"inlineQueryResultSticker":                        func() TdMessage { return &InlineQueryResultSticker{} },
+	"inlineQueryResultVideo":                          func() TdMessage { return &InlineQueryResultVideo{} },
+	"inlineQueryResultVoiceNote":                      func() TdMessage { return &InlineQueryResultVoiceNote{} },
+	"inlineQueryResults":                              func() TdMessage { return &InlineQueryResults{} },
+	"callbackQueryPayloadData":                        func() TdMessage { return &CallbackQueryPayloadData{} },
+	"callbackQueryPayloadDataWithPassword":            func() TdMessage { return &CallbackQueryPayloadDataWithPassword{} },
+	"callbackQueryPayloadGame":                        func() TdMessage { return &CallbackQueryPayloadGame{} },
+	"callbackQueryAnswer":                             func() TdMessage { return &CallbackQueryAnswer{} },
+	"customRequestResult":                             func() TdMessage { return &CustomRequestResult{} },
+	"gameHighScore":                                   func() TdMessage { return &GameHighScore{} },
+	"gameHighScores":                                  func() TdMessage { return &GameHighScores{} },
+	"chatEventMessageEdited":                          func() TdMessage { return &ChatEventMessageEdited{} },
+	"chatEventMessageDeleted":                         func() TdMessage { return &ChatEventMessageDeleted{} },
+	"chatEventPollStopped":                            func() TdMessage { return &ChatEventPollStopped{} },
+	"chatEventMessagePinned":                          func() TdMessage { return &ChatEventMessagePinned{} },
+	"chatEventMessageUnpinned":                        func() TdMessage { return &ChatEventMessageUnpinned{} },
+	"chatEventMemberJoined":                           func() TdMessage { return &ChatEventMemberJoined{} },
+	"chatEventMemberLeft":                             func() TdMessage { return &ChatEventMemberLeft{} },
+	"chatEventMemberInvited":                          func() TdMessage { return &ChatEventMemberInvited{} },
+	"chatEventMemberPromoted":                         func() TdMessage { return &ChatEventMemberPromoted{} },
+	"chatEventMemberRestricted":                       func() TdMessage { return &ChatEventMemberRestricted{} },
+	"chatEventTitleChanged":                           func() TdMessage { return &ChatEventTitleChanged{} },
+	"chatEventPermissionsChanged":                     func() TdMessage { return &ChatEventPermissionsChanged{} },
+	"chatEventDescriptionChanged":                     func() TdMessage { return &ChatEventDescriptionChanged{} },
+	"chatEventUsernameChanged":                        func() TdMessage { return &ChatEventUsernameChanged{} },
+	"chatEventPhotoChanged":                           func() TdMessage { return &ChatEventPhotoChanged{} },
+	"chatEventInvitesToggled":                         func() TdMessage { return &ChatEventInvitesToggled{} },
+	"chatEventLinkedChatChanged":                      func() TdMessage { return &ChatEventLinkedChatChanged{} },
+	"chatEventSlowModeDelayChanged":                   func() TdMessage { return &ChatEventSlowModeDelayChanged{} },
+	"chatEventSignMessagesToggled":                    func() TdMessage { return &ChatEventSignMessagesToggled{} },
+	"chatEventStickerSetChanged":                      func() TdMessage { return &ChatEventStickerSetChanged{} },
+	"chatEventLocationChanged":                        func() TdMessage { return &ChatEventLocationChanged{} },
+	"chatEventIsAllHistoryAvailableToggled":           func() TdMessage { return &ChatEventIsAllHistoryAvailableToggled{} },
+	"chatEventVoiceChatCreated":                       func() TdMessage { return &ChatEventVoiceChatCreated{} },
+	"chatEventVoiceChatDiscarded":                     func() TdMessage { return &ChatEventVoiceChatDiscarded{} },
+	"chatEventVoiceChatParticipantIsMutedToggled":     func() TdMessage { return &ChatEventVoiceChatParticipantIsMutedToggled{} },
+	"chatEventVoiceChatMuteNewParticipantsToggled":    func() TdMessage { return &ChatEventVoiceChatMuteNewParticipantsToggled{} },
+	"chatEvent":                                       func() TdMessage { return &ChatEvent{} },
+	"chatEvents":                                      func() TdMessage { return &ChatEvents{} },
+	"chatEventLogFilters":                             func() TdMessage { return &ChatEventLogFilters{} },
+	"languagePackStringValueOrdinary":                 func() TdMessage { return &LanguagePackStringValueOrdinary{} },
+	"languagePackStringValuePluralized":               func() TdMessage { return &LanguagePackStringValuePluralized{} },
+	"languagePackStringValueDeleted":                  func() TdMessage { return &LanguagePackStringValueDeleted{} },
+	"languagePackString":                              func() TdMessage { return &LanguagePackString{} },
+	"languagePackStrings":                             func() TdMessage { return &LanguagePackStrings{} },
+	"languagePackInfo":                                func() TdMessage { return &LanguagePackInfo{} },
+	"localizationTargetInfo":                          func() TdMessage { return &LocalizationTargetInfo{} },
+	"deviceTokenFirebaseCloudMessaging":               func() TdMessage { return &DeviceTokenFirebaseCloudMessaging{} },
+	"deviceTokenApplePush":                            func() TdMessage { return &DeviceTokenApplePush{} },
+	"deviceTokenApplePushVoIP":                        func() TdMessage { return &DeviceTokenApplePushVoIP{} },
+	"deviceTokenWindowsPush":                          func() TdMessage { return &DeviceTokenWindowsPush{} },
+	"deviceTokenMicrosoftPush":                        func() TdMessage { return &DeviceTokenMicrosoftPush{} },
+	"deviceTokenMicrosoftPushVoIP":                    func() TdMessage { return &DeviceTokenMicrosoftPushVoIP{} },
+	"deviceTokenWebPush":                              func() TdMessage { return &DeviceTokenWebPush{} },
+	"deviceTokenSimplePush":                           func() TdMessage { return &DeviceTokenSimplePush{} },
+	"deviceTokenUbuntuPush":                           func() TdMessage { return &DeviceTokenUbuntuPush{} },
+	"deviceTokenBlackBerryPush":                       func() TdMessage { return &DeviceTokenBlackBerryPush{} },
+	"deviceTokenTizenPush":                            func() TdMessage { return &DeviceTokenTizenPush{} },
+	"pushReceiverId":                                  func() TdMessage { return &PushReceiverID{} },
+	"backgroundFillSolid":                             func() TdMessage { return &BackgroundFillSolid{} },
+	"backgroundFillGradient":                          func() TdMessage { return &BackgroundFillGradient{} },
+	"backgroundTypeWallpaper":                         func() TdMessage { return &BackgroundTypeWallpaper{} },
+	"backgroundTypePattern":                           func() TdMessage { return &BackgroundTypePattern{} },
+	"backgroundTypeFill":                              func() TdMessage { return &BackgroundTypeFill{} },
+	"background":                                      func() TdMessage { return &Background{} },
+	"backgrounds":                                     func() TdMessage { return &Backgrounds{} },
+	"inputBackgroundLocal":                            func() TdMessage { return &InputBackgroundLocal{} },
+	"inputBackgroundRemote":                           func() TdMessage { return &InputBackgroundRemote{} },
+	"hashtags":                                        func() TdMessage { return &Hashtags{} },
+	"canTransferOwnershipResultOk":                    func() TdMessage { return &CanTransferOwnershipResultOk{} },
+	"canTransferOwnershipResultPasswordNeeded":        func() TdMessage { return &CanTransferOwnershipResultPasswordNeeded{} },
+	"canTransferOwnershipResultPasswordTooFresh":      func() TdMessage { return &CanTransferOwnershipResultPasswordTooFresh{} },
+	"canTransferOwnershipResultSessionTooFresh":       func() TdMessage { return &CanTransferOwnershipResultSessionTooFresh{} },
+	"checkChatUsernameResultOk":                       func() TdMessage { return &CheckChatUsernameResultOk{} },
+	"checkChatUsernameResultUsernameInvalid":          func() TdMessage { return &CheckChatUsernameResultUsernameInvalid{} },
+	"checkChatUsernameResultUsernameOccupied":         func() TdMessage { return &CheckChatUsernameResultUsernameOccupied{} },
+	"checkChatUsernameResultPublicChatsTooMuch":       func() TdMessage { return &CheckChatUsernameResultPublicChatsTooMuch{} },
+	"checkChatUsernameResultPublicGroupsUnavailable":  func() TdMessage { return &CheckChatUsernameResultPublicGroupsUnavailable{} },
+	"messageFileTypePrivate":                          func() TdMessage { return &MessageFileTypePrivate{} },
+	"messageFileTypeGroup":                            func() TdMessage { return &MessageFileTypeGroup{} },
+	"messageFileTypeUnknown":                          func() TdMessage { return &MessageFileTypeUnknown{} },
+	"pushMessageContentHidden":                        func() TdMessage { return &PushMessageContentHidden{} },
+	"pushMessageContentAnimation":                     func() TdMessage { return &PushMessageContentAnimation{} },
+	"pushMessageContentAudio":                         func() TdMessage { return &PushMessageContentAudio{} },
+	"pushMessageContentContact":                       func() TdMessage { return &PushMessageContentContact{} },
+	"pushMessageContentContactRegistered":             func() TdMessage { return &PushMessageContentContactRegistered{} },
+	"pushMessageContentDocument":                      func() TdMessage { return &PushMessageContentDocument{} },
+	"pushMessageContentGame":                          func() TdMessage { return &PushMessageContentGame{} },
+	"pushMessageContentGameScore":                     func() TdMessage { return &PushMessageContentGameScore{} },
+	"pushMessageContentInvoice":                       func() TdMessage { return &PushMessageContentInvoice{} },
+	"pushMessageContentLocation":                      func() TdMessage { return &PushMessageContentLocation{} },
+	"pushMessageContentPhoto":                         func() TdMessage { return &PushMessageContentPhoto{} },
+	"pushMessageContentPoll":                          func() TdMessage { return &PushMessageContentPoll{} },
+	"pushMessageContentScreenshotTaken":               func() TdMessage { return &PushMessageContentScreenshotTaken{} },
+	"pushMessageContentSticker":                       func() TdMessage { return &PushMessageContentSticker{} },
+	"pushMessageContentText":                          func() TdMessage { return &PushMessageContentText{} },
+	"pushMessageContentVideo":                         func() TdMessage { return &PushMessageContentVideo{} },
+	"pushMessageContentVideoNote":                     func() TdMessage { return &PushMessageContentVideoNote{} },
+	"pushMessageContentVoiceNote":                     func() TdMessage { return &PushMessageContentVoiceNote{} },
+	"pushMessageContentBasicGroupChatCreate":          func() TdMessage { return &PushMessageContentBasicGroupChatCreate{} },
+	"pushMessageContentChatAddMembers":                func() TdMessage { return &PushMessageContentChatAddMembers{} },
+	"pushMessageContentChatChangePhoto":               func() TdMessage { return &PushMessageContentChatChangePhoto{} },
+	"pushMessageContentChatChangeTitle":               func() TdMessage { return &PushMessageContentChatChangeTitle{} },
+	"pushMessageContentChatDeleteMember":              func() TdMessage { return &PushMessageContentChatDeleteMember{} },
+	"pushMessageContentChatJoinByLink":                func() TdMessage { return &PushMessageContentChatJoinByLink{} },
+	"pushMessageContentMessageForwards":               func() TdMessage { return &PushMessageContentMessageForwards{} },
+	"pushMessageContentMediaAlbum":                    func() TdMessage { return &PushMessageContentMediaAlbum{} },
+	"notificationTypeNewMessage":                      func() TdMessage { return &NotificationTypeNewMessage{} },
+	"notificationTypeNewSecretChat":                   func() TdMessage { return &NotificationTypeNewSecretChat{} },
+	"notificationTypeNewCall":                         func() TdMessage { return &NotificationTypeNewCall{} },
+	"notificationTypeNewPushMessage":                  func() TdMessage { return &NotificationTypeNewPushMessage{} },
+	"notificationGroupTypeMessages":                   func() TdMessage { return &NotificationGroupTypeMessages{} },
+	"notificationGroupTypeMentions":                   func() TdMessage { return &NotificationGroupTypeMentions{} },
+	"notificationGroupTypeSecretChat":                 func() TdMessage { return &NotificationGroupTypeSecretChat{} },
+	"notificationGroupTypeCalls":                      func() TdMessage { return &NotificationGroupTypeCalls{} },
+	"notification":                                    func() TdMessage { return &Notification{} },
+	"notificationGroup":                               func() TdMessage { return &NotificationGroup{} },
+	"optionValueBoolean":                              func() TdMessage { return &OptionValueBoolean{} },
+	"optionValueEmpty":                                func() TdMessage { return &OptionValueEmpty{} },
+	"optionValueInteger":                              func() TdMessage { return &OptionValueInteger{} },
+	"optionValueString":                               func() TdMessage { return &OptionValueString{} },
+	"jsonObjectMember":                                func() TdMessage { return &JsonObjectMember{} },
+	"jsonValueNull":                                   func() TdMessage { return &JsonValueNull{} },
+	"jsonValueBoolean":                                func() TdMessage { return &JsonValueBoolean{} },
+	"jsonValueNumber":                                 func() TdMessage { return &JsonValueNumber{} },
+	"jsonValueString":                                 func() TdMessage { return &JsonValueString{} },
+	"jsonValueArray":                                  func() TdMessage { return &JsonValueArray{} },
+	"jsonValueObject":                                 func() TdMessage { return &JsonValueObject{} },
+	"userPrivacySettingRuleAllowAll":                  func() TdMessage { return &UserPrivacySettingRuleAllowAll{} },
+	"userPrivacySettingRuleAllowContacts":             func() TdMessage { return &UserPrivacySettingRuleAllowContacts{} },
+	"userPrivacySettingRuleAllowUsers":                func() TdMessage { return &UserPrivacySettingRuleAllowUsers{} },
+	"userPrivacySettingRuleAllowChatMembers":          func() TdMessage { return &UserPrivacySettingRuleAllowChatMembers{} },
+	"userPrivacySettingRuleRestrictAll":               func() TdMessage { return &UserPrivacySettingRuleRestrictAll{} },
+	"userPrivacySettingRuleRestrictContacts":          func() TdMessage { return &UserPrivacySettingRuleRestrictContacts{} },
+	"userPrivacySettingRuleRestrictUsers":             func() TdMessage { return &UserPrivacySettingRuleRestrictUsers{} },
+	"userPrivacySettingRuleRestrictChatMembers":       func() TdMessage { return &UserPrivacySettingRuleRestrictChatMembers{} },
+	"userPrivacySettingRules":                         func() TdMessage { return &UserPrivacySettingRules{} },
+	"userPrivacySettingShowStatus":                    func() TdMessage { return &UserPrivacySettingShowStatus{} },
+	"userPrivacySettingShowProfilePhoto":              func() TdMessage { return &UserPrivacySettingShowProfilePhoto{} },
+	"userPrivacySettingShowLinkInForwardedMessages":   func() TdMessage { return &UserPrivacySettingShowLinkInForwardedMessages{} },
+	"userPrivacySettingShowPhoneNumber":               func() TdMessage { return &UserPrivacySettingShowPhoneNumber{} },
+	"userPrivacySettingAllowChatInvites":              func() TdMessage { return &UserPrivacySettingAllowChatInvites{} },
+	"userPrivacySettingAllowCalls":                    func() TdMessage { return &UserPrivacySettingAllowCalls{} },
+	"userPrivacySettingAllowPeerToPeerCalls":          func() TdMessage { return &UserPrivacySettingAllowPeerToPeerCalls{} },
+	"userPrivacySettingAllowFindingByPhoneNumber":     func() TdMessage { return &UserPrivacySettingAllowFindingByPhoneNumber{} },
+	"accountTtl":                                      func() TdMessage { return &AccountTTL{} },
+	"session":                                         func() TdMessage { return &Session{} },
+	"sessions":                                        func() TdMessage { return &Sessions{} },
+	"connectedWebsite":                                func() TdMessage { return &ConnectedWebsite{} },
+	"connectedWebsites":                               func() TdMessage { return &ConnectedWebsites{} },
+	"chatReportReasonSpam":                            func() TdMessage { return &ChatReportReasonSpam{} },
+	"chatReportReasonViolence":                        func() TdMessage { return &ChatReportReasonViolence{} },
+	"chatReportReasonPornography":                     func() TdMessage { return &ChatReportReasonPornography{} },
+	"chatReportReasonChildAbuse":                      func() TdMessage { return &ChatReportReasonChildAbuse{} },
+	"chatReportReasonCopyright":                       func() TdMessage { return &ChatReportReasonCopyright{} },
+	"chatReportReasonUnrelatedLocation":               func() TdMessage { return &ChatReportReasonUnrelatedLocation{} },
+	"chatReportReasonFake":                            func() TdMessage { return &ChatReportReasonFake{} },
+	"chatReportReasonCustom":                          func() TdMessage { return &ChatReportReasonCustom{} },
+	"messageLink":                                     func() TdMessage { return &MessageLink{} },
+	"messageLinkInfo":                                 func() TdMessage { return &MessageLinkInfo{} },
+	"filePart":                                        func() TdMessage { return &FilePart{} },
+	"fileTypeNone":                                    func() TdMessage { return &FileTypeNone{} },
+	"fileTypeAnimation":                               func() TdMessage { return &FileTypeAnimation{} },
+	"fileTypeAudio":                                   func() TdMessage { return &FileTypeAudio{} },
+	"fileTypeDocument":                                func() TdMessage { return &FileTypeDocument{} },
+	"fileTypePhoto":                                   func() TdMessage { return &FileTypePhoto{} },
+	"fileTypeProfilePhoto":                            func() TdMessage { return &FileTypeProfilePhoto{} },
+	"fileTypeSecret":                                  func() TdMessage { return &FileTypeSecret{} },
+	"fileTypeSecretThumbnail":                         func() TdMessage { return &FileTypeSecretThumbnail{} },
+	"fileTypeSecure":                                  func() TdMessage { return &FileTypeSecure{} },
+	"fileTypeSticker":                                 func() TdMessage { return &FileTypeSticker{} },
+	"fileTypeThumbnail":                               func() TdMessage { return &FileTypeThumbnail{} },
+	"fileTypeUnknown":                                 func() TdMessage { return &FileTypeUnknown{} },
+	"fileTypeVideo":                                   func() TdMessage { return &FileTypeVideo{} },
+	"fileTypeVideoNote":                               func() TdMessage { return &FileTypeVideoNote{} },
+	"fileTypeVoiceNote":                               func() TdMessage { return &FileTypeVoiceNote{} },
+	"fileTypeWallpaper":                               func() TdMessage { return &FileTypeWallpaper{} },
+	"storageStatisticsByFileType":                     func() TdMessage { return &StorageStatisticsByFileType{} },
+	"storageStatisticsByChat":                         func() TdMessage { return &StorageStatisticsByChat{} },
+	"storageStatistics":                               func() TdMessage { return &StorageStatistics{} },
+	"storageStatisticsFast":                           func() TdMessage { return &StorageStatisticsFast{} },
+	"databaseStatistics":                              func() TdMessage { return &DatabaseStatistics{} },
+	"networkTypeNone":                                 func() TdMessage { return &NetworkTypeNone{} },
+	"networkTypeMobile":                               func() TdMessage { return &NetworkTypeMobile{} },
+	"networkTypeMobileRoaming":                        func() TdMessage { return &NetworkTypeMobileRoaming{} },
+	"networkTypeWiFi":                                 func() TdMessage { return &NetworkTypeWiFi{} },
+	"networkTypeOther":                                func() TdMessage { return &NetworkTypeOther{} },
+	"networkStatisticsEntryFile":                      func() TdMessage { return &NetworkStatisticsEntryFile{} },
+	"networkStatisticsEntryCall":                      func() TdMessage { return &NetworkStatisticsEntryCall{} },
+	"networkStatistics":                               func() TdMessage { return &NetworkStatistics{} },
+	"autoDownloadSettings":                            func() TdMessage { return &AutoDownloadSettings{} },
+	"autoDownloadSettingsPresets":                     func() TdMessage { return &AutoDownloadSettingsPresets{} },
+	"connectionStateWaitingForNetwork":                func() TdMessage { return &ConnectionStateWaitingForNetwork{} },
+	"connectionStateConnectingToProxy":                func() TdMessage { return &ConnectionStateConnectingToProxy{} },
+	"connectionStateConnecting":                       func() TdMessage { return &ConnectionStateConnecting{} },
+	"connectionStateUpdating":                         func() TdMessage { return &ConnectionStateUpdating{} },
+	"connectionStateReady":                            func() TdMessage { return &ConnectionStateReady{} },
+	"topChatCategoryUsers":                            func() TdMessage { return &TopChatCategoryUsers{} },
+	"topChatCategoryBots":                             func() TdMessage { return &TopChatCategoryBots{} },
+	"topChatCategoryGroups":                           func() TdMessage { return &TopChatCategoryGroups{} },
+	"topChatCategoryChannels":                         func() TdMessage { return &TopChatCategoryChannels{} },
+	"topChatCategoryInlineBots":                       func() TdMessage { return &TopChatCategoryInlineBots{} },
+	"topChatCategoryCalls":                            func() TdMessage { return &TopChatCategoryCalls{} },
+	"topChatCategoryForwardChats":                     func() TdMessage { return &TopChatCategoryForwardChats{} },
+	"tMeUrlTypeUser":                                  func() TdMessage { return &TMeURLTypeUser{} },
+	"tMeUrlTypeSupergroup":                            func() TdMessage { return &TMeURLTypeSupergroup{} },
+	"tMeUrlTypeChatInvite":                            func() TdMessage { return &TMeURLTypeChatInvite{} },
+	"tMeUrlTypeStickerSet":                            func() TdMessage { return &TMeURLTypeStickerSet{} },
+	"tMeUrl":                                          func() TdMessage { return &TMeURL{} },
+	"tMeUrls":                                         func() TdMessage { return &TMeURLs{} },
+	"suggestedActionEnableArchiveAndMuteNewChats":     func() TdMessage { return &SuggestedActionEnableArchiveAndMuteNewChats{} },
+	"suggestedActionCheckPhoneNumber":                 func() TdMessage { return &SuggestedActionCheckPhoneNumber{} },
+	"suggestedActionSeeTicksHint":                     func() TdMessage { return &SuggestedActionSeeTicksHint{} },
+	"count":                                           func() TdMessage { return &Count{} },
+	"text":                                            func() TdMessage { return &Text{} },
+	"seconds":                                         func() TdMessage { return &Seconds{} },
+	"deepLinkInfo":                                    func() TdMessage { return &DeepLinkInfo{} },
+	"textParseModeMarkdown":                           func() TdMessage { return &TextParseModeMarkdown{} },
+	"textParseModeHTML":                               func() TdMessage { return &TextParseModeHTML{} },
+	"proxyTypeSocks5":                                 func() TdMessage { return &ProxyTypeSocks5{} },
+	"proxyTypeHttp":                                   func() TdMessage { return &ProxyTypeHttp{} },
+	"proxyTypeMtproto":                                func() TdMessage { return &ProxyTypeMtproto{} },
+	"proxy":                                           func() TdMessage { return &Proxy{} },
+	"proxies":                                         func() TdMessage { return &Proxies{} },
+	"inputStickerStatic":                              func() TdMessage { return &InputStickerStatic{} },
+	"inputStickerAnimated":                            func() TdMessage { return &InputStickerAnimated{} },
+	"dateRange":                                       func() TdMessage { return &DateRange{} },
+	"statisticalValue":                                func() TdMessage { return &StatisticalValue{} },
+	"statisticalGraphData":                            func() TdMessage { return &StatisticalGraphData{} },
+	"statisticalGraphAsync":                           func() TdMessage { return &StatisticalGraphAsync{} },
+	"statisticalGraphError":                           func() TdMessage { return &StatisticalGraphError{} },
+	"chatStatisticsMessageInteractionInfo":            func() TdMessage { return &ChatStatisticsMessageInteractionInfo{} },
+	"chatStatisticsMessageSenderInfo":                 func() TdMessage { return &ChatStatisticsMessageSenderInfo{} },
+	"chatStatisticsAdministratorActionsInfo":          func() TdMessage { return &ChatStatisticsAdministratorActionsInfo{} },
+	"chatStatisticsInviterInfo":                       func() TdMessage { return &ChatStatisticsInviterInfo{} },
+	"chatStatisticsSupergroup":                        func() TdMessage { return &ChatStatisticsSupergroup{} },
+	"chatStatisticsChannel":                           func() TdMessage { return &ChatStatisticsChannel{} },
+	"messageStatistics":                               func() TdMessage { return &MessageStatistics{} },
+	"point":                                           func() TdMessage { return &Point{} },
+	"vectorPathCommandLine":                           func() TdMessage { return &VectorPathCommandLine{} },
+	"vectorPathCommandCubicBezierCurve":               func() TdMessage { return &VectorPathCommandCubicBezierCurve{} },
+	"updateAuthorizationState":                        func() TdMessage { return &UpdateAuthorizationState{} },
+	"updateNewMessage":                                func() TdMessage { return &UpdateNewMessage{} },
+	"updateMessageSendAcknowledged":                   func() TdMessage { return &UpdateMessageSendAcknowledged{} },
+	"updateMessageSendSucceeded":                      func() TdMessage { return &UpdateMessageSendSucceeded{} },
+	"updateMessageSendFailed":                         func() TdMessage { return &UpdateMessageSendFailed{} },
+	"updateMessageContent":                            func() TdMessage { return &UpdateMessageContent{} },
+	"updateMessageEdited":                             func() TdMessage { return &UpdateMessageEdited{} },
+	"updateMessageIsPinned":                           func() TdMessage { return &UpdateMessageIsPinned{} },
+	"updateMessageInteractionInfo":                    func() TdMessage { return &UpdateMessageInteractionInfo{} },
+	"updateMessageContentOpened":                      func() TdMessage { return &UpdateMessageContentOpened{} },
+	"updateMessageMentionRead":                        func() TdMessage { return &UpdateMessageMentionRead{} },
+	"updateMessageLiveLocationViewed":                 func() TdMessage { return &UpdateMessageLiveLocationViewed{} },
+	"updateNewChat":                                   func() TdMessage { return &UpdateNewChat{} },
+	"updateChatTitle":                                 func() TdMessage { return &UpdateChatTitle{} },
+	"updateChatPhoto":                                 func() TdMessage { return &UpdateChatPhoto{} },
+	"updateChatPermissions":                           func() TdMessage { return &UpdateChatPermissions{} },
+	"updateChatLastMessage":                           func() TdMessage { return &UpdateChatLastMessage{} },
+	"updateChatPosition":                              func() TdMessage { return &UpdateChatPosition{} },
+	"updateChatIsMarkedAsUnread":                      func() TdMessage { return &UpdateChatIsMarkedAsUnread{} },
+	"updateChatIsBlocked":                             func() TdMessage { return &UpdateChatIsBlocked{} },
+	"updateChatHasScheduledMessages":                  func() TdMessage { return &UpdateChatHasScheduledMessages{} },
+	"updateChatVoiceChat":                             func() TdMessage { return &UpdateChatVoiceChat{} },
+	"updateChatDefaultDisableNotification":            func() TdMessage { return &UpdateChatDefaultDisableNotification{} },
+	"updateChatReadInbox":                             func() TdMessage { return &UpdateChatReadInbox{} },
+	"updateChatReadOutbox":                            func() TdMessage { return &UpdateChatReadOutbox{} },
+	"updateChatUnreadMentionCount":                    func() TdMessage { return &UpdateChatUnreadMentionCount{} },
+	"updateChatNotificationSettings":                  func() TdMessage { return &UpdateChatNotificationSettings{} },
+	"updateScopeNotificationSettings":                 func() TdMessage { return &UpdateScopeNotificationSettings{} },
+	"updateChatActionBar":                             func() TdMessage { return &UpdateChatActionBar{} },
+	"updateChatReplyMarkup":                           func() TdMessage { return &UpdateChatReplyMarkup{} },
+	"updateChatDraftMessage":                          func() TdMessage { return &UpdateChatDraftMessage{} },
+	"updateChatFilters":                               func() TdMessage { return &UpdateChatFilters{} },
+	"updateChatOnlineMemberCount":                     func() TdMessage { return &UpdateChatOnlineMemberCount{} },
+	"updateNotification":                              func() TdMessage { return &UpdateNotification{} },
+	"updateNotificationGroup":                         func() TdMessage { return &UpdateNotificationGroup{} },
+	"updateActiveNotifications":                       func() TdMessage { return &UpdateActiveNotifications{} },
+	"updateHavePendingNotifications":                  func() TdMessage { return &UpdateHavePendingNotifications{} },
+	"updateDeleteMessages":                            func() TdMessage { return &UpdateDeleteMessages{} },
+	"updateUserChatAction":                            func() TdMessage { return &UpdateUserChatAction{} },
+	"updateUserStatus":                                func() TdMessage { return &UpdateUserStatus{} },
+	"updateUser":                                      func() TdMessage { return &UpdateUser{} },
+	"updateBasicGroup":                                func() TdMessage { return &UpdateBasicGroup{} },
+	"updateSupergroup":                                func() TdMessage { return &UpdateSupergroup{} },
+	"updateSecretChat":                                func() TdMessage { return &UpdateSecretChat{} },
+	"updateUserFullInfo":                              func() TdMessage { return &UpdateUserFullInfo{} },
+	"updateBasicGroupFullInfo":                        func() TdMessage { return &UpdateBasicGroupFullInfo{} },
+	"updateSupergroupFullInfo":                        func() TdMessage { return &UpdateSupergroupFullInfo{} },
+	"updateServiceNotification":                       func() TdMessage { return &UpdateServiceNotification{} },
+	"updateFile":                                      func() TdMessage { return &UpdateFile{} },
+	"updateFileGenerationStart":                       func() TdMessage { return &UpdateFileGenerationStart{} },
+	"updateFileGenerationStop":                        func() TdMessage { return &UpdateFileGenerationStop{} },
+	"updateCall":                                      func() TdMessage { return &UpdateCall{} },
+	"updateGroupCall":                                 func() TdMessage { return &UpdateGroupCall{} },
+	"updateGroupCallParticipant":                      func() TdMessage { return &UpdateGroupCallParticipant{} },
+	"updateNewCallSignalingData":                      func() TdMessage { return &UpdateNewCallSignalingData{} },
+	"updateUserPrivacySettingRules":                   func() TdMessage { return &UpdateUserPrivacySettingRules{} },
+	"updateUnreadMessageCount":                        func() TdMessage { return &UpdateUnreadMessageCount{} },
+	"updateUnreadChatCount":                           func() TdMessage { return &UpdateUnreadChatCount{} },
+	"updateOption":                                    func() TdMessage { return &UpdateOption{} },
+	"updateStickerSet":                                func() TdMessage { return &UpdateStickerSet{} },
+	"updateInstalledStickerSets":                      func() TdMessage { return &UpdateInstalledStickerSets{} },
+	"updateTrendingStickerSets":                       func() TdMessage { return &UpdateTrendingStickerSets{} },
+	"updateRecentStickers":                            func() TdMessage { return &UpdateRecentStickers{} },
+	"updateFavoriteStickers":                          func() TdMessage { return &UpdateFavoriteStickers{} },
+	"updateSavedAnimations":                           func() TdMessage { return &UpdateSavedAnimations{} },
+	"updateSelectedBackground":                        func() TdMessage { return &UpdateSelectedBackground{} },
+	"updateLanguagePackStrings":                       func() TdMessage { return &UpdateLanguagePackStrings{} },
+	"updateConnectionState":                           func() TdMessage { return &UpdateConnectionState{} },
+	"updateTermsOfService":                            func() TdMessage { return &UpdateTermsOfService{} },
+	"updateUsersNearby":                               func() TdMessage { return &UpdateUsersNearby{} },
+	"updateDiceEmojis":                                func() TdMessage { return &UpdateDiceEmojis{} },
+	"updateAnimationSearchParameters":                 func() TdMessage { return &UpdateAnimationSearchParameters{} },
+	"updateSuggestedActions":                          func() TdMessage { return &UpdateSuggestedActions{} },
+	"updateNewInlineQuery":                            func() TdMessage { return &UpdateNewInlineQuery{} },
+	"updateNewChosenInlineResult":                     func() TdMessage { return &UpdateNewChosenInlineResult{} },
+	"updateNewCallbackQuery":                          func() TdMessage { return &UpdateNewCallbackQuery{} },
+	"updateNewInlineCallbackQuery":                    func() TdMessage { return &UpdateNewInlineCallbackQuery{} },
+	"updateNewShippingQuery":                          func() TdMessage { return &UpdateNewShippingQuery{} },
+	"updateNewPreCheckoutQuery":                       func() TdMessage { return &UpdateNewPreCheckoutQuery{} },
+	"updateNewCustomEvent":                            func() TdMessage { return &UpdateNewCustomEvent{} },
+	"updateNewCustomQuery":                            func() TdMessage { return &UpdateNewCustomQuery{} },
+	"updatePoll":                                      func() TdMessage { return &UpdatePoll{} },
+	"updatePollAnswer":                                func() TdMessage { return &UpdatePollAnswer{} },
+	"updates":                                         func() TdMessage { return &Updates{} },
+	"logStreamDefault":                                func() TdMessage { return &LogStreamDefault{} },
+	"logStreamFile":                                   func() TdMessage { return &LogStreamFile{} },
+	"logStreamEmpty":                                  func() TdMessage { return &LogStreamEmpty{} },
+	"logVerbosityLevel":                               func() TdMessage { return &LogVerbosityLevel{} },
+	"logTags":                                         func() TdMessage { return &LogTags{} },
+	"testInt":                                         func() TdMessage { return &TestInt{} },
+	"testString":                                      func() TdMessage { return &TestString{} },
+	"testBytes":                                       func() TdMessage { return &TestBytes{} },
+	"testVectorInt":                                   func() TdMessage { return &TestVectorInt{} },
+	"testVectorIntObject":                             func() TdMessage { return &TestVectorIntObject{} },
+	"testVectorString":                                func() TdMessage { return &TestVectorString{} },
+	"testVectorStringObject":                          func() TdMessage { return &TestVectorStringObject{} },
+	"getAuthorizationState":                           func() TdMessage { return &GetAuthorizationStateRequest{} },
+	"setTdlibParameters":                              func() TdMessage { return &SetTdlibParametersRequest{} },
+	"checkDatabaseEncryptionKey":                      func() TdMessage { return &CheckDatabaseEncryptionKeyRequest{} },
+	"setAuthenticationPhoneNumber":                    func() TdMessage { return &SetAuthenticationPhoneNumberRequest{} },
+	"resendAuthenticationCode":                        func() TdMessage { return &ResendAuthenticationCodeRequest{} },
+	"checkAuthenticationCode":                         func() TdMessage { return &CheckAuthenticationCodeRequest{} },
+	"requestQrCodeAuthentication":                     func() TdMessage { return &RequestQrCodeAuthenticationRequest{} },
+	"registerUser":                                    func() TdMessage { return &RegisterUserRequest{} },
+	"checkAuthenticationPassword":                     func() TdMessage { return &CheckAuthenticationPasswordRequest{} },
+	"requestAuthenticationPasswordRecovery":           func() TdMessage { return &RequestAuthenticationPasswordRecoveryRequest{} },
+	"recoverAuthenticationPassword":                   func() TdMessage { return &RecoverAuthenticationPasswordRequest{} },
+	"checkAuthenticationBotToken":                     func() TdMessage { return &CheckAuthenticationBotTokenRequest{} },
+	"logOut":                                          func() TdMessage { return &LogOutRequest{} },
+	"close":                                           func() TdMessage { return &CloseRequest{} },
+	"destroy":                                         func() TdMessage { return &DestroyRequest{} },
+	"confirmQrCodeAuthentication":                     func() TdMessage { return &ConfirmQrCodeAuthenticationRequest{} },
+	"getCurrentState":                                 func() TdMessage { return &GetCurrentStateRequest{} },
+	"setDatabaseEncryptionKey":                        func() TdMessage { return &SetDatabaseEncryptionKeyRequest{} },
+	"getPasswordState":                                func() TdMessage { return &GetPasswordStateRequest{} },
+	"setPassword":                                     func() TdMessage { return &SetPasswordRequest{} },
+	"getRecoveryEmailAddress":                         func() TdMessage { return &GetRecoveryEmailAddressRequest{} },
+	"setRecoveryEmailAddress":                         func() TdMessage { return &SetRecoveryEmailAddressRequest{} },
+	"checkRecoveryEmailAddressCode":                   func() TdMessage { return &CheckRecoveryEmailAddressCodeRequest{} },
+	"resendRecoveryEmailAddressCode":                  func() TdMessage { return &ResendRecoveryEmailAddressCodeRequest{} },
+	"requestPasswordRecovery":                         func() TdMessage { return &RequestPasswordRecoveryRequest{} },
+	"recoverPassword":                                 func() TdMessage { return &RecoverPasswordRequest{} },
+	"createTemporaryPassword":                         func() TdMessage { return &CreateTemporaryPasswordRequest{} },
+	"getTemporaryPasswordState":                       func() TdMessage { return &GetTemporaryPasswordStateRequest{} },
+	"getMe":                                           func() TdMessage { return &GetMeRequest{} },
+	"getUser":                                         func() TdMessage { return &GetUserRequest{} },
+	"getUserFullInfo":                                 func() TdMessage { return &GetUserFullInfoRequest{} },
+	"getBasicGroup":                                   func() TdMessage { return &GetBasicGroupRequest{} },
+	"getBasicGroupFullInfo":                           func() TdMessage { return &GetBasicGroupFullInfoRequest{} },
+	"getSupergroup":                                   func() TdMessage { return &GetSupergroupRequest{} },
+	"getSupergroupFullInfo":                           func() TdMessage { return &GetSupergroupFullInfoRequest{} },
+	"getSecretChat":                                   func() TdMessage { return &GetSecretChatRequest{} },
+	"getChat":                                         func() TdMessage { return &GetChatRequest{} },
+	"getMessage":                                      func() TdMessage { return &GetMessageRequest{} },
+	"getMessageLocally":                               func() TdMessage { return &GetMessageLocallyRequest{} },
+	"getRepliedMessage":                               func() TdMessage { return &GetRepliedMessageRequest{} },
+	"getChatPinnedMessage":                            func() TdMessage { return &GetChatPinnedMessageRequest{} },
+	"getCallbackQueryMessage":                         func() TdMessage { return &GetCallbackQueryMessageRequest{} },
+	"getMessages":                                     func() TdMessage { return &GetMessagesRequest{} },
+	"getMessageThread":                                func() TdMessage { return &GetMessageThreadRequest{} },
+	"getFile":                                         func() TdMessage { return &GetFileRequest{} },
+	"getRemoteFile":                                   func() TdMessage { return &GetRemoteFileRequest{} },
+	"getChats":                                        func() TdMessage { return &GetChatsRequest{} },
+	"searchPublicChat":                                func() TdMessage { return &SearchPublicChatRequest{} },
+	"searchPublicChats":                               func() TdMessage { return &SearchPublicChatsRequest{} },
+	"searchChats":                                     func() TdMessage { return &SearchChatsRequest{} },
+	"searchChatsOnServer":                             func() TdMessage { return &SearchChatsOnServerRequest{} },
+	"searchChatsNearby":                               func() TdMessage { return &SearchChatsNearbyRequest{} },
+	"getTopChats":                                     func() TdMessage { return &GetTopChatsRequest{} },
+	"removeTopChat":                                   func() TdMessage { return &RemoveTopChatRequest{} },
+	"addRecentlyFoundChat":                            func() TdMessage { return &AddRecentlyFoundChatRequest{} },
+	"removeRecentlyFoundChat":                         func() TdMessage { return &RemoveRecentlyFoundChatRequest{} },
+	"clearRecentlyFoundChats":                         func() TdMessage { return &ClearRecentlyFoundChatsRequest{} },
+	"checkChatUsername":                               func() TdMessage { return &CheckChatUsernameRequest{} },
+	"getCreatedPublicChats":                           func() TdMessage { return &GetCreatedPublicChatsRequest{} },
+	"checkCreatedPublicChatsLimit":                    func() TdMessage { return &CheckCreatedPublicChatsLimitRequest{} },
+	"getSuitableDiscussionChats":                      func() TdMessage { return &GetSuitableDiscussionChatsRequest{} },
+	"getInactiveSupergroupChats":                      func() TdMessage { return &GetInactiveSupergroupChatsRequest{} },
+	"getGroupsInCommon":                               func() TdMessage { return &GetGroupsInCommonRequest{} },
+	"getChatHistory":                                  func() TdMessage { return &GetChatHistoryRequest{} },
+	"getMessageThreadHistory":                         func() TdMessage { return &GetMessageThreadHistoryRequest{} },
+	"deleteChatHistory":                               func() TdMessage { return &DeleteChatHistoryRequest{} },
+	"deleteChat":                                      func() TdMessage { return &DeleteChatRequest{} },
+	"searchChatMessages":                              func() TdMessage { return &SearchChatMessagesRequest{} },
+	"searchMessages":                                  func() TdMessage { return &SearchMessagesRequest{} },
+	"searchSecretMessages":                            func() TdMessage { return &SearchSecretMessagesRequest{} },
+	"searchCallMessages":                              func() TdMessage { return &SearchCallMessagesRequest{} },
+	"deleteAllCallMessages":                           func() TdMessage { return &DeleteAllCallMessagesRequest{} },
+	"searchChatRecentLocationMessages":                func() TdMessage { return &SearchChatRecentLocationMessagesRequest{} },
+	"getActiveLiveLocationMessages":                   func() TdMessage { return &GetActiveLiveLocationMessagesRequest{} },
+	"getChatMessageByDate":                            func() TdMessage { return &GetChatMessageByDateRequest{} },
+	"getChatMessageCount":                             func() TdMessage { return &GetChatMessageCountRequest{} },
+	"getChatScheduledMessages":                        func() TdMessage { return &GetChatScheduledMessagesRequest{} },
+	"getMessagePublicForwards":                        func() TdMessage { return &GetMessagePublicForwardsRequest{} },
+	"removeNotification":                              func() TdMessage { return &RemoveNotificationRequest{} },
+	"removeNotificationGroup":                         func() TdMessage { return &RemoveNotificationGroupRequest{} },
+	"getMessageLink":                                  func() TdMessage { return &GetMessageLinkRequest{} },
+	"getMessageEmbeddingCode":                         func() TdMessage { return &GetMessageEmbeddingCodeRequest{} },
+	"getMessageLinkInfo":                              func() TdMessage { return &GetMessageLinkInfoRequest{} },
+	"sendMessage":                                     func() TdMessage { return &SendMessageRequest{} },
+	"sendMessageAlbum":                                func() TdMessage { return &SendMessageAlbumRequest{} },
+	"sendBotStartMessage":                             func() TdMessage { return &SendBotStartMessageRequest{} },
+	"sendInlineQueryResultMessage":                    func() TdMessage { return &SendInlineQueryResultMessageRequest{} },
+	"forwardMessages":                                 func() TdMessage { return &ForwardMessagesRequest{} },
+	"resendMessages":                                  func() TdMessage { return &ResendMessagesRequest{} },
+	"sendChatSetTtlMessage":                           func() TdMessage { return &SendChatSetTTLMessageRequest{} },
+	"sendChatScreenshotTakenNotification":             func() TdMessage { return &SendChatScreenshotTakenNotificationRequest{} },
+	"addLocalMessage":                                 func() TdMessage { return &AddLocalMessageRequest{} },
+	"deleteMessages":                                  func() TdMessage { return &DeleteMessagesRequest{} },
+	"deleteChatMessagesFromUser":                      func() TdMessage { return &DeleteChatMessagesFromUserRequest{} },
+	"editMessageText":                                 func() TdMessage { return &EditMessageTextRequest{} },
+	"editMessageLiveLocation":                         func() TdMessage { return &EditMessageLiveLocationRequest{} },
+	"editMessageMedia":                                func() TdMessage { return &EditMessageMediaRequest{} },
+	"editMessageCaption":                              func() TdMessage { return &EditMessageCaptionRequest{} },
+	"editMessageReplyMarkup":                          func() TdMessage { return &EditMessageReplyMarkupRequest{} },
+	"editInlineMessageText":                           func() TdMessage { return &EditInlineMessageTextRequest{} },
+	"editInlineMessageLiveLocation":                   func() TdMessage { return &EditInlineMessageLiveLocationRequest{} },
+	"editInlineMessageMedia":                          func() TdMessage { return &EditInlineMessageMediaRequest{} },
+	"editInlineMessageCaption":                        func() TdMessage { return &EditInlineMessageCaptionRequest{} },
+	"editInlineMessageReplyMarkup":                    func() TdMessage { return &EditInlineMessageReplyMarkupRequest{} },
+	"editMessageSchedulingState":                      func() TdMessage { return &EditMessageSchedulingStateRequest{} },
+	"getTextEntities":                                 func() TdMessage { return &GetTextEntitiesRequest{} },
+	"parseTextEntities":                               func() TdMessage { return &ParseTextEntitiesRequest{} },
+	"parseMarkdown":                                   func() TdMessage { return &ParseMarkdownRequest{} },
+	"getMarkdownText":                                 func() TdMessage { return &GetMarkdownTextRequest{} },
+	"getFileMimeType":                                 func() TdMessage { return &GetFileMimeTypeRequest{} },
+	"getFileExtension":                                func() TdMessage { return &GetFileExtensionRequest{} },
+	"cleanFileName":                                   func() TdMessage { return &CleanFileNameRequest{} },
+	"getLanguagePackString":                           func() TdMessage { return &GetLanguagePackStringRequest{} },
+	"getJsonValue":                                    func() TdMessage { return &GetJsonValueRequest{} },
+	"getJsonString":                                   func() TdMessage { return &GetJsonStringRequest{} },
+	"setPollAnswer":                                   func() TdMessage { return &SetPollAnswerRequest{} },
+	"getPollVoters":                                   func() TdMessage { return &GetPollVotersRequest{} },
+	"stopPoll":                                        func() TdMessage { return &StopPollRequest{} },
+	"hideSuggestedAction":                             func() TdMessage { return &HideSuggestedActionRequest{} },
+	"getLoginUrlInfo":                                 func() TdMessage { return &GetLoginURLInfoRequest{} },
+	"getLoginUrl":                                     func() TdMessage { return &GetLoginURLRequest{} },
+	"getInlineQueryResults":                           func() TdMessage { return &GetInlineQueryResultsRequest{} },
+	"answerInlineQuery":                               func() TdMessage { return &AnswerInlineQueryRequest{} },
+	"getCallbackQueryAnswer":                          func() TdMessage { return &GetCallbackQueryAnswerRequest{} },
+	"answerCallbackQuery":                             func() TdMessage { return &AnswerCallbackQueryRequest{} },
+	"answerShippingQuery":                             func() TdMessage { return &AnswerShippingQueryRequest{} },
+	"answerPreCheckoutQuery":                          func() TdMessage { return &AnswerPreCheckoutQueryRequest{} },
+	"setGameScore":                                    func() TdMessage { return &SetGameScoreRequest{} },
+	"setInlineGameScore":                              func() TdMessage { return &SetInlineGameScoreRequest{} },
+	"getGameHighScores":                               func() TdMessage { return &GetGameHighScoresRequest{} },
+	"getInlineGameHighScores":                         func() TdMessage { return &GetInlineGameHighScoresRequest{} },
+	"deleteChatReplyMarkup":                           func() TdMessage { return &DeleteChatReplyMarkupRequest{} },
+	"sendChatAction":                                  func() TdMessage { return &SendChatActionRequest{} },
+	"openChat":                                        func() TdMessage { return &OpenChatRequest{} },
+	"closeChat":                                       func() TdMessage { return &CloseChatRequest{} },
+	"viewMessages":                                    func() TdMessage { return &ViewMessagesRequest{} },
+	"openMessageContent":                              func() TdMessage { return &OpenMessageContentRequest{} },
+	"readAllChatMentions":                             func() TdMessage { return &ReadAllChatMentionsRequest{} },
+	"createPrivateChat":                               func() TdMessage { return &CreatePrivateChatRequest{} },
+	"createBasicGroupChat":                            func() TdMessage { return &CreateBasicGroupChatRequest{} },
+	"createSupergroupChat":                            func() TdMessage { return &CreateSupergroupChatRequest{} },
+	"createSecretChat":                                func() TdMessage { return &CreateSecretChatRequest{} },
+	"createNewBasicGroupChat":                         func() TdMessage { return &CreateNewBasicGroupChatRequest{} },
+	"createNewSupergroupChat":                         func() TdMessage { return &CreateNewSupergroupChatRequest{} },
+	"createNewSecretChat":                             func() TdMessage { return &CreateNewSecretChatRequest{} },
+	"upgradeBasicGroupChatToSupergroupChat":           func() TdMessage { return &UpgradeBasicGroupChatToSupergroupChatRequest{} },
+	"getChatListsToAddChat":                           func() TdMessage { return &GetChatListsToAddChatRequest{} },
+	"addChatToList":                                   func() TdMessage { return &AddChatToListRequest{} },
+	"getChatFilter":                                   func() TdMessage { return &GetChatFilterRequest{} },
+	"createChatFilter":                                func() TdMessage { return &CreateChatFilterRequest{} },
+	"editChatFilter":                                  func() TdMessage { return &EditChatFilterRequest{} },
+	"deleteChatFilter":                                func() TdMessage { return &DeleteChatFilterRequest{} },
+	"reorderChatFilters":                              func() TdMessage { return &ReorderChatFiltersRequest{} },
+	"getRecommendedChatFilters":                       func() TdMessage { return &GetRecommendedChatFiltersRequest{} },
+	"getChatFilterDefaultIconName":                    func() TdMessage { return &GetChatFilterDefaultIconNameRequest{} },
+	"setChatTitle":                                    func() TdMessage { return &SetChatTitleRequest{} },
+	"setChatPhoto":                                    func() TdMessage { return &SetChatPhotoRequest{} },
+	"setChatPermissions":                              func() TdMessage { return &SetChatPermissionsRequest{} },
+	"setChatDraftMessage":                             func() TdMessage { return &SetChatDraftMessageRequest{} },
+	"setChatNotificationSettings":                     func() TdMessage { return &SetChatNotificationSettingsRequest{} },
+	"toggleChatIsMarkedAsUnread":                      func() TdMessage { return &ToggleChatIsMarkedAsUnreadRequest{} },
+	"toggleChatDefaultDisableNotification":            func() TdMessage { return &ToggleChatDefaultDisableNotificationRequest{} },
+	"setChatClientData":                               func() TdMessage { return &SetChatClientDataRequest{} },
+	"setChatDescription":                              func() TdMessage { return &SetChatDescriptionRequest{} },
+	"setChatDiscussionGroup":                          func() TdMessage { return &SetChatDiscussionGroupRequest{} },
+	"setChatLocation":                                 func() TdMessage { return &SetChatLocationRequest{} },
+	"setChatSlowModeDelay":                            func() TdMessage { return &SetChatSlowModeDelayRequest{} },
+	"pinChatMessage":                                  func() TdMessage { return &PinChatMessageRequest{} },
+	"unpinChatMessage":                                func() TdMessage { return &UnpinChatMessageRequest{} },
+	"unpinAllChatMessages":                            func() TdMessage { return &UnpinAllChatMessagesRequest{} },
+	"joinChat":                                        func() TdMessage { return &JoinChatRequest{} },
+	"leaveChat":                                       func() TdMessage { return &LeaveChatRequest{} },
+	"addChatMember":                                   func() TdMessage { return &AddChatMemberRequest{} },
+	"addChatMembers":                                  func() TdMessage { return &AddChatMembersRequest{} },
+	"setChatMemberStatus":                             func() TdMessage { return &SetChatMemberStatusRequest{} },
+	"banChatMember":                                   func() TdMessage { return &BanChatMemberRequest{} },
+	"canTransferOwnership":                            func() TdMessage { return &CanTransferOwnershipRequest{} },
+	"transferChatOwnership":                           func() TdMessage { return &TransferChatOwnershipRequest{} },
+	"getChatMember":                                   func() TdMessage { return &GetChatMemberRequest{} },
+	"searchChatMembers":                               func() TdMessage { return &SearchChatMembersRequest{} },
+	"getChatAdministrators":                           func() TdMessage { return &GetChatAdministratorsRequest{} },
+	"clearAllDraftMessages":                           func() TdMessage { return &ClearAllDraftMessagesRequest{} },
+	"getChatNotificationSettingsExceptions":           func() TdMessage { return &GetChatNotificationSettingsExceptionsRequest{} },
+	"getScopeNotificationSettings":                    func() TdMessage { return &GetScopeNotificationSettingsRequest{} },
+	"setScopeNotificationSettings":                    func() TdMessage { return &SetScopeNotificationSettingsRequest{} },
+	"resetAllNotificationSettings":                    func() TdMessage { return &ResetAllNotificationSettingsRequest{} },
+	"toggleChatIsPinned":                              func() TdMessage { return &ToggleChatIsPinnedRequest{} },
+	"setPinnedChats":                                  func() TdMessage { return &SetPinnedChatsRequest{} },
+	"downloadFile":                                    func() TdMessage { return &DownloadFileRequest{} },
+	"getFileDownloadedPrefixSize":                     func() TdMessage { return &GetFileDownloadedPrefixSizeRequest{} },
+	"cancelDownloadFile":                              func() TdMessage { return &CancelDownloadFileRequest{} },
+	"uploadFile":                                      func() TdMessage { return &UploadFileRequest{} },
+	"cancelUploadFile":                                func() TdMessage { return &CancelUploadFileRequest{} },
+	"writeGeneratedFilePart":                          func() TdMessage { return &WriteGeneratedFilePartRequest{} },
+	"setFileGenerationProgress":                       func() TdMessage { return &SetFileGenerationProgressRequest{} },
+	"finishFileGeneration":                            func() TdMessage { return &FinishFileGenerationRequest{} },
+	"readFilePart":                                    func() TdMessage { return &ReadFilePartRequest{} },
+	"deleteFile":                                      func() TdMessage { return &DeleteFileRequest{} },
+	"getMessageFileType":                              func() TdMessage { return &GetMessageFileTypeRequest{} },
+	"importMessages":                                  func() TdMessage { return &ImportMessagesRequest{} },
+	"replacePermanentChatInviteLink":                  func() TdMessage { return &ReplacePermanentChatInviteLinkRequest{} },
+	"checkChatInviteLink":                             func() TdMessage { return &CheckChatInviteLinkRequest{} },
+	"joinChatByInviteLink":                            func() TdMessage { return &JoinChatByInviteLinkRequest{} },
+	"createCall":                                      func() TdMessage { return &CreateCallRequest{} },
+	"acceptCall":                                      func() TdMessage { return &AcceptCallRequest{} },
+	"sendCallSignalingData":                           func() TdMessage { return &SendCallSignalingDataRequest{} },
+	"discardCall":                                     func() TdMessage { return &DiscardCallRequest{} },
+	"sendCallRating":                                  func() TdMessage { return &SendCallRatingRequest{} },
+	"sendCallDebugInformation":                        func() TdMessage { return &SendCallDebugInformationRequest{} },
+	"createVoiceChat":                                 func() TdMessage { return &CreateVoiceChatRequest{} },
+	"getGroupCall":                                    func() TdMessage { return &GetGroupCallRequest{} },
+	"joinGroupCall":                                   func() TdMessage { return &JoinGroupCallRequest{} },
+	"toggleGroupCallMuteNewParticipants":              func() TdMessage { return &ToggleGroupCallMuteNewParticipantsRequest{} },
+	"inviteGroupCallParticipants":                     func() TdMessage { return &InviteGroupCallParticipantsRequest{} },
+	"setGroupCallParticipantIsSpeaking":               func() TdMessage { return &SetGroupCallParticipantIsSpeakingRequest{} },
+	"toggleGroupCallParticipantIsMuted":               func() TdMessage { return &ToggleGroupCallParticipantIsMutedRequest{} },
+	"setGroupCallParticipantVolumeLevel":              func() TdMessage { return &SetGroupCallParticipantVolumeLevelRequest{} },
+	"loadGroupCallParticipants":                       func() TdMessage { return &LoadGroupCallParticipantsRequest{} },
+	"leaveGroupCall":                                  func() TdMessage { return &LeaveGroupCallRequest{} },
+	"discardGroupCall":                                func() TdMessage { return &DiscardGroupCallRequest{} },
+	"toggleMessageSenderIsBlocked":                    func() TdMessage { return &ToggleMessageSenderIsBlockedRequest{} },
+	"blockMessageSenderFromReplies":                   func() TdMessage { return &BlockMessageSenderFromRepliesRequest{} },
+	"getBlockedMessageSenders":                        func() TdMessage { return &GetBlockedMessageSendersRequest{} },
+	"addContact":                                      func() TdMessage { return &AddContactRequest{} },
+	"importContacts":                                  func() TdMessage { return &ImportContactsRequest{} },
+	"getContacts":                                     func() TdMessage { return &GetContactsRequest{} },
+	"searchContacts":                                  func() TdMessage { return &SearchContactsRequest{} },
+	"removeContacts":                                  func() TdMessage { return &RemoveContactsRequest{} },
+	"getImportedContactCount":                         func() TdMessage { return &GetImportedContactCountRequest{} },
+	"changeImportedContacts":                          func() TdMessage { return &ChangeImportedContactsRequest{} },
+	"clearImportedContacts":                           func() TdMessage { return &ClearImportedContactsRequest{} },
+	"sharePhoneNumber":                                func() TdMessage { return &SharePhoneNumberRequest{} },
+	"getUserProfilePhotos":                            func() TdMessage { return &GetUserProfilePhotosRequest{} },
+	"getStickers":                                     func() TdMessage { return &GetStickersRequest{} },
+	"searchStickers":                                  func() TdMessage { return &SearchStickersRequest{} },
+	"getInstalledStickerSets":                         func() TdMessage { return &GetInstalledStickerSetsRequest{} },
+	"getArchivedStickerSets":                          func() TdMessage { return &GetArchivedStickerSetsRequest{} },
+	"getTrendingStickerSets":                          func() TdMessage { return &GetTrendingStickerSetsRequest{} },
+	"getAttachedStickerSets":                          func() TdMessage { return &GetAttachedStickerSetsRequest{} },
+	"getStickerSet":                                   func() TdMessage { return &GetStickerSetRequest{} },
+	"searchStickerSet":                                func() TdMessage { return &SearchStickerSetRequest{} },
+	"searchInstalledStickerSets":                      func() TdMessage { return &SearchInstalledStickerSetsRequest{} },
+	"searchStickerSets":                               func() TdMessage { return &SearchStickerSetsRequest{} },
+	"changeStickerSet":                                func() TdMessage { return &ChangeStickerSetRequest{} },
+	"viewTrendingStickerSets":                         func() TdMessage { return &ViewTrendingStickerSetsRequest{} },
+	"reorderInstalledStickerSets":                     func() TdMessage { return &ReorderInstalledStickerSetsRequest{} },
+	"getRecentStickers":                               func() TdMessage { return &GetRecentStickersRequest{} },
+	"addRecentSticker":                                func() TdMessage { return &AddRecentStickerRequest{} },
+	"removeRecentSticker":                             func() TdMessage { return &RemoveRecentStickerRequest{} },
+	"clearRecentStickers":                             func() TdMessage { return &ClearRecentStickersRequest{} },
+	"getFavoriteStickers":                             func() TdMessage { return &GetFavoriteStickersRequest{} },
+	"addFavoriteSticker":                              func() TdMessage { return &AddFavoriteStickerRequest{} },
+	"removeFavoriteSticker":                           func() TdMessage { return &RemoveFavoriteStickerRequest{} },
+	"getStickerEmojis":                                func() TdMessage { return &GetStickerEmojisRequest{} },
+	"searchEmojis":                                    func() TdMessage { return &SearchEmojisRequest{} },
+	"getEmojiSuggestionsUrl":                          func() TdMessage { return &GetEmojiSuggestionsURLRequest{} },
+	"getSavedAnimations":                              func() TdMessage { return &GetSavedAnimationsRequest{} },
+	"addSavedAnimation":                               func() TdMessage { return &AddSavedAnimationRequest{} },
+	"removeSavedAnimation":                            func() TdMessage { return &RemoveSavedAnimationRequest{} },
+	"getRecentInlineBots":                             func() TdMessage { return &GetRecentInlineBotsRequest{} },
+	"searchHashtags":                                  func() TdMessage { return &SearchHashtagsRequest{} },
+	"removeRecentHashtag":                             func() TdMessage { return &RemoveRecentHashtagRequest{} },
+	"getWebPagePreview":                               func() TdMessage { return &GetWebPagePreviewRequest{} },
+	"getWebPageInstantView":                           func() TdMessage { return &GetWebPageInstantViewRequest{} },
+	"setProfilePhoto":                                 func() TdMessage { return &SetProfilePhotoRequest{} },
+	"deleteProfilePhoto":                              func() TdMessage { return &DeleteProfilePhotoRequest{} },
+	"setName":                                         func() TdMessage { return &SetNameRequest{} },
+	"setBio":                                          func() TdMessage { return &SetBioRequest{} },
+	"setUsername":                                     func() TdMessage { return &SetUsernameRequest{} },
+	"setLocation":                                     func() TdMessage { return &SetLocationRequest{} },
+	"changePhoneNumber":                               func() TdMessage { return &ChangePhoneNumberRequest{} },
+	"resendChangePhoneNumberCode":                     func() TdMessage { return &ResendChangePhoneNumberCodeRequest{} },
+	"checkChangePhoneNumberCode":                      func() TdMessage { return &CheckChangePhoneNumberCodeRequest{} },
+	"setCommands":                                     func() TdMessage { return &SetCommandsRequest{} },
+	"getActiveSessions":                               func() TdMessage { return &GetActiveSessionsRequest{} },
+	"terminateSession":                                func() TdMessage { return &TerminateSessionRequest{} },
+	"terminateAllOtherSessions":                       func() TdMessage { return &TerminateAllOtherSessionsRequest{} },
+	"getConnectedWebsites":                            func() TdMessage { return &GetConnectedWebsitesRequest{} },
+	"disconnectWebsite":                               func() TdMessage { return &DisconnectWebsiteRequest{} },
+	"disconnectAllWebsites":                           func() TdMessage { return &DisconnectAllWebsitesRequest{} },
+	"setSupergroupUsername":                           func() TdMessage { return &SetSupergroupUsernameRequest{} },
+	"setSupergroupStickerSet":                         func() TdMessage { return &SetSupergroupStickerSetRequest{} },
+	"toggleSupergroupSignMessages":                    func() TdMessage { return &ToggleSupergroupSignMessagesRequest{} },
+	"toggleSupergroupIsAllHistoryAvailable":           func() TdMessage { return &ToggleSupergroupIsAllHistoryAvailableRequest{} },
+	"reportSupergroupSpam":                            func() TdMessage { return &ReportSupergroupSpamRequest{} },
+	"getSupergroupMembers":                            func() TdMessage { return &GetSupergroupMembersRequest{} },
+	"closeSecretChat":                                 func() TdMessage { return &CloseSecretChatRequest{} },
+	"getChatEventLog":                                 func() TdMessage { return &GetChatEventLogRequest{} },
+	"getPaymentForm":                                  func() TdMessage { return &GetPaymentFormRequest{} },
+	"validateOrderInfo":                               func() TdMessage { return &ValidateOrderInfoRequest{} },
+	"sendPaymentForm":                                 func() TdMessage { return &SendPaymentFormRequest{} },
+	"getPaymentReceipt":                               func() TdMessage { return &GetPaymentReceiptRequest{} },
+	"getSavedOrderInfo":                               func() TdMessage { return &GetSavedOrderInfoRequest{} },
+	"deleteSavedOrderInfo":                            func() TdMessage { return &DeleteSavedOrderInfoRequest{} },
+	"deleteSavedCredentials":                          func() TdMessage { return &DeleteSavedCredentialsRequest{} },
+	"getSupportUser":                                  func() TdMessage { return &GetSupportUserRequest{} },
+	"getBackgrounds":                                  func() TdMessage { return &GetBackgroundsRequest{} },
+	"getBackgroundUrl":                                func() TdMessage { return &GetBackgroundURLRequest{} },
+	"searchBackground":                                func() TdMessage { return &SearchBackgroundRequest{} },
+	"setBackground":                                   func() TdMessage { return &SetBackgroundRequest{} },
+	"removeBackground":                                func() TdMessage { return &RemoveBackgroundRequest{} },
+	"resetBackgrounds":                                func() TdMessage { return &ResetBackgroundsRequest{} },
+	"getLocalizationTargetInfo":                       func() TdMessage { return &GetLocalizationTargetInfoRequest{} },
+	"getLanguagePackInfo":                             func() TdMessage { return &GetLanguagePackInfoRequest{} },
+	"getLanguagePackStrings":                          func() TdMessage { return &GetLanguagePackStringsRequest{} },
+	"synchronizeLanguagePack":                         func() TdMessage { return &SynchronizeLanguagePackRequest{} },
+	"addCustomServerLanguagePack":                     func() TdMessage { return &AddCustomServerLanguagePackRequest{} },
+	"setCustomLanguagePack":                           func() TdMessage { return &SetCustomLanguagePackRequest{} },
+	"editCustomLanguagePackInfo":                      func() TdMessage { return &EditCustomLanguagePackInfoRequest{} },
+	"setCustomLanguagePackString":                     func() TdMessage { return &SetCustomLanguagePackStringRequest{} },
+	"deleteLanguagePack":                              func() TdMessage { return &DeleteLanguagePackRequest{} },
+	"registerDevice":                                  func() TdMessage { return &RegisterDeviceRequest{} },
+	"processPushNotification":                         func() TdMessage { return &ProcessPushNotificationRequest{} },
+	"getPushReceiverId":                               func() TdMessage { return &GetPushReceiverIDRequest{} },
+	"getRecentlyVisitedTMeUrls":                       func() TdMessage { return &GetRecentlyVisitedTMeURLsRequest{} },
+	"setUserPrivacySettingRules":                      func() TdMessage { return &SetUserPrivacySettingRulesRequest{} },
+	"getUserPrivacySettingRules":                      func() TdMessage { return &GetUserPrivacySettingRulesRequest{} },
+	"getOption":                                       func() TdMessage { return &GetOptionRequest{} },
+	"setOption":                                       func() TdMessage { return &SetOptionRequest{} },
+	"setAccountTtl":                                   func() TdMessage { return &SetAccountTTLRequest{} },
+	"getAccountTtl":                                   func() TdMessage { return &GetAccountTTLRequest{} },
+	"deleteAccount":                                   func() TdMessage { return &DeleteAccountRequest{} },
+	"removeChatActionBar":                             func() TdMessage { return &RemoveChatActionBarRequest{} },
+	"reportChat":                                      func() TdMessage { return &ReportChatRequest{} },
+	"getChatStatisticsUrl":                            func() TdMessage { return &GetChatStatisticsURLRequest{} },
+	"getChatStatistics":                               func() TdMessage { return &GetChatStatisticsRequest{} },
+	"getMessageStatistics":                            func() TdMessage { return &GetMessageStatisticsRequest{} },
+	"getStatisticalGraph":                             func() TdMessage { return &GetStatisticalGraphRequest{} },
+	"getStorageStatistics":                            func() TdMessage { return &GetStorageStatisticsRequest{} },
+	"getStorageStatisticsFast":                        func() TdMessage { return &GetStorageStatisticsFastRequest{} },
+	"getDatabaseStatistics":                           func() TdMessage { return &GetDatabaseStatisticsRequest{} },
+	"optimizeStorage":                                 func() TdMessage { return &OptimizeStorageRequest{} },
+	"setNetworkType":                                  func() TdMessage { return &SetNetworkTypeRequest{} },
+	"getNetworkStatistics":                            func() TdMessage { return &GetNetworkStatisticsRequest{} },
+	"addNetworkStatistics":                            func() TdMessage { return &AddNetworkStatisticsRequest{} },
+	"resetNetworkStatistics":                          func() TdMessage { return &ResetNetworkStatisticsRequest{} },
+	"getAutoDownloadSettingsPresets":                  func() TdMessage { return &GetAutoDownloadSettingsPresetsRequest{} },
+	"setAutoDownloadSettings":                         func() TdMessage { return &SetAutoDownloadSettingsRequest{} },
+	"getBankCardInfo":                                 func() TdMessage { return &GetBankCardInfoRequest{} },
+	"getPassportElement":                              func() TdMessage { return &GetPassportElementRequest{} },
+	"getAllPassportElements":                          func() TdMessage { return &GetAllPassportElementsRequest{} },
+	"setPassportElement":                              func() TdMessage { return &SetPassportElementRequest{} },
+	"deletePassportElement":                           func() TdMessage { return &DeletePassportElementRequest{} },
+	"setPassportElementErrors":                        func() TdMessage { return &SetPassportElementErrorsRequest{} },
+	"getPreferredCountryLanguage":                     func() TdMessage { return &GetPreferredCountryLanguageRequest{} },
+	"sendPhoneNumberVerificationCode":                 func() TdMessage { return &SendPhoneNumberVerificationCodeRequest{} },
+	"resendPhoneNumberVerificationCode":               func() TdMessage { return &ResendPhoneNumberVerificationCodeRequest{} },
+	"checkPhoneNumberVerificationCode":                func() TdMessage { return &CheckPhoneNumberVerificationCodeRequest{} },
+	"sendEmailAddressVerificationCode":                func() TdMessage { return &SendEmailAddressVerificationCodeRequest{} },
+	"resendEmailAddressVerificationCode":              func() TdMessage { return &ResendEmailAddressVerificationCodeRequest{} },
+	"checkEmailAddressVerificationCode":               func() TdMessage { return &CheckEmailAddressVerificationCodeRequest{} },
+	"getPassportAuthorizationForm":                    func() TdMessage { return &GetPassportAuthorizationFormRequest{} },
+	"getPassportAuthorizationFormAvailableElements":   func() TdMessage { return &GetPassportAuthorizationFormAvailableElementsRequest{} },
+	"sendPassportAuthorizationForm":                   func() TdMessage { return &SendPassportAuthorizationFormRequest{} },
+	"sendPhoneNumberConfirmationCode":                 func() TdMessage { return &SendPhoneNumberConfirmationCodeRequest{} },
+	"resendPhoneNumberConfirmationCode":               func() TdMessage { return &ResendPhoneNumberConfirmationCodeRequest{} },
+	"checkPhoneNumberConfirmationCode":                func() TdMessage { return &CheckPhoneNumberConfirmationCodeRequest{} },
+	"setBotUpdatesStatus":                             func() TdMessage { return &SetBotUpdatesStatusRequest{} },
+	"uploadStickerFile":                               func() TdMessage { return &UploadStickerFileRequest{} },
+	"createNewStickerSet":                             func() TdMessage { return &CreateNewStickerSetRequest{} },
+	"addStickerToSet":                                 func() TdMessage { return &AddStickerToSetRequest{} },
+	"setStickerSetThumbnail":                          func() TdMessage { return &SetStickerSetThumbnailRequest{} },
+	"setStickerPositionInSet":                         func() TdMessage { return &SetStickerPositionInSetRequest{} },
+	"removeStickerFromSet":                            func() TdMessage { return &RemoveStickerFromSetRequest{} },
+	"getMapThumbnailFile":                             func() TdMessage { return &GetMapThumbnailFileRequest{} },
+	"acceptTermsOfService":                            func() TdMessage { return &AcceptTermsOfServiceRequest{} },
+	"sendCustomRequest":                               func() TdMessage { return &SendCustomRequestRequest{} },
+	"answerCustomQuery":                               func() TdMessage { return &AnswerCustomQueryRequest{} },
+	"setAlarm":                                        func() TdMessage { return &SetAlarmRequest{} },
+	"getCountries":                                    func() TdMessage { return &GetCountriesRequest{} },
+	"getCountryCode":                                  func() TdMessage { return &GetCountryCodeRequest{} },
+	"getPhoneNumberInfo":                              func() TdMessage { return &GetPhoneNumberInfoRequest{} },
+	"getInviteText":                                   func() TdMessage { return &GetInviteTextRequest{} },
+	"getDeepLinkInfo":                                 func() TdMessage { return &GetDeepLinkInfoRequest{} },
+	"getApplicationConfig":                            func() TdMessage { return &GetApplicationConfigRequest{} },
+	"saveApplicationLogEvent":                         func() TdMessage { return &SaveApplicationLogEventRequest{} },
+	"addProxy":                                        func() TdMessage { return &AddProxyRequest{} },
+	"editProxy":                                       func() TdMessage { return &EditProxyRequest{} },
+	"enableProxy":                                     func() TdMessage { return &EnableProxyRequest{} },
+	"disableProxy":                                    func() TdMessage { return &DisableProxyRequest{} },
+	"removeProxy":                                     func() TdMessage { return &RemoveProxyRequest{} },
+	"getProxies":                                      func() TdMessage { return &GetProxiesRequest{} },
+	"getProxyLink":                                    func() TdMessage { return &GetProxyLinkRequest{} },
+	"pingProxy":                                       func() TdMessage { return &PingProxyRequest{} },
+	"setLogStream":                                    func() TdMessage { return &SetLogStreamRequest{} },
+	"getLogStream":                                    func() TdMessage { return &GetLogStreamRequest{} },
+	"setLogVerbosityLevel":                            func() TdMessage { return &SetLogVerbosityLevelRequest{} },
+	"getLogVerbosityLevel":                            func() TdMessage { return &GetLogVerbosityLevelRequest{} },
+	"getLogTags":                                      func() TdMessage { return &GetLogTagsRequest{} },
+	"setLogTagVerbosityLevel":                         func() TdMessage { return &SetLogTagVerbosityLevelRequest{} },
+	"getLogTagVerbosityLevel":                         func() TdMessage { return &GetLogTagVerbosityLevelRequest{} },
+	"addLogMessage":                                   func() TdMessage { return &AddLogMessageRequest{} },
+	"testCallEmpty":                                   func() TdMessage { return &TestCallEmptyRequest{} },
+	"testCallString":                                  func() TdMessage { return &TestCallStringRequest{} },
+	"testCallBytes":                                   func() TdMessage { return &TestCallBytesRequest{} },
+	"testCallVectorInt":                               func() TdMessage { return &TestCallVectorIntRequest{} },
+	"testCallVectorIntObject":                         func() TdMessage { return &TestCallVectorIntObjectRequest{} },
+	"testCallVectorString":                            func() TdMessage { return &TestCallVectorStringRequest{} },
+	"testCallVectorStringObject":                      func() TdMessage { return &TestCallVectorStringObjectRequest{} },
+	"testSquareInt":                                   func() TdMessage { return &TestSquareIntRequest{} },
+	"testNetwork":                                     func() TdMessage { return &TestNetworkRequest{} },
+	"testProxy":                                       func() TdMessage { return &TestProxyRequest{} },
+	"testGetDifference":                               func() TdMessage { return &TestGetDifferenceRequest{} },
+	"testUseUpdate":                                   func() TdMessage { return &TestUseUpdateRequest{} },
+	"testReturnError":                                 func() TdMessage { return &TestReturnErrorRequest{} },
+}
@@ -0,0 +1,9872 @@
+package tdlib
+
+import (
+	"encoding/json"
+)
+
+// GetAuthorizationStateRequest is the request of GetAuthorizationState: Returns the current authorization state; this is an offline request. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization
+type GetAuthorizationStateRequest struct {
+}
+
+// MessageType return the string telegram-type of GetAuthorizationStateRequest
+func (getAuthorizationStateRequest *GetAuthorizationStateRequest) MessageType() string {
+	return "getAuthorizationState"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getAuthorizationStateRequest GetAuthorizationStateRequest) MarshalJSON() ([]byte, error) {
+	type alias GetAuthorizationStateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getAuthorizationState", alias(getAuthorizationStateRequest)})
+}
+
+// SetTdlibParametersRequest is the request of SetTdlibParameters: Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters
+type SetTdlibParametersRequest struct {
+	Parameters *TdlibParameters `json:"parameters"` // Parameters
+}
+
+// MessageType return the string telegram-type of SetTdlibParametersRequest
+func (setTdlibParametersRequest *SetTdlibParametersRequest) MessageType() string {
+	return "setTdlibParameters"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setTdlibParametersRequest SetTdlibParametersRequest) MarshalJSON() ([]byte, error) {
+	type alias SetTdlibParametersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setTdlibParameters", alias(setTdlibParametersRequest)})
+}
+
+// CheckDatabaseEncryptionKeyRequest is the request of CheckDatabaseEncryptionKey: Checks the database encryption key for correctness. Works only when the current authorization state is authorizationStateWaitEncryptionKey
+type CheckDatabaseEncryptionKeyRequest struct {
+	EncryptionKey []byte `json:"encryption_key"` // Encryption key to check or set up
+}
+
+// MessageType return the string telegram-type of CheckDatabaseEncryptionKeyRequest
+func (checkDatabaseEncryptionKeyRequest *CheckDatabaseEncryptionKeyRequest) MessageType() string {
+	return "checkDatabaseEncryptionKey"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkDatabaseEncryptionKeyRequest CheckDatabaseEncryptionKeyRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckDatabaseEncryptionKeyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkDatabaseEncryptionKey", alias(checkDatabaseEncryptionKeyRequest)})
+}
+
+// SetAuthenticationPhoneNumberRequest is the request of SetAuthenticationPhoneNumber: Sets the phone number of the user and sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitPhoneNumber,
+type SetAuthenticationPhoneNumberRequest struct {
+	PhoneNumber string                             `json:"phone_number"` // The phone number of the user, in international format
+	Settings    *PhoneNumberAuthenticationSettings `json:"settings"`     // Settings for the authentication of the user's phone number
+}
+
+// MessageType return the string telegram-type of SetAuthenticationPhoneNumberRequest
+func (setAuthenticationPhoneNumberRequest *SetAuthenticationPhoneNumberRequest) MessageType() string {
+	return "setAuthenticationPhoneNumber"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setAuthenticationPhoneNumberRequest SetAuthenticationPhoneNumberRequest) MarshalJSON() ([]byte, error) {
+	type alias SetAuthenticationPhoneNumberRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setAuthenticationPhoneNumber", alias(setAuthenticationPhoneNumberRequest)})
+}
+
+// ResendAuthenticationCodeRequest is the request of ResendAuthenticationCode: Re-sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitCode and the next_code_type of the result is not null
+type ResendAuthenticationCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of ResendAuthenticationCodeRequest
+func (resendAuthenticationCodeRequest *ResendAuthenticationCodeRequest) MessageType() string {
+	return "resendAuthenticationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendAuthenticationCodeRequest ResendAuthenticationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendAuthenticationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendAuthenticationCode", alias(resendAuthenticationCodeRequest)})
+}
+
+// CheckAuthenticationCodeRequest is the request of CheckAuthenticationCode: Checks the authentication code. Works only when the current authorization state is authorizationStateWaitCode
+type CheckAuthenticationCodeRequest struct {
+	Code string `json:"code"` // The verification code received via SMS, Telegram message, phone call, or flash call
+}
+
+// MessageType return the string telegram-type of CheckAuthenticationCodeRequest
+func (checkAuthenticationCodeRequest *CheckAuthenticationCodeRequest) MessageType() string {
+	return "checkAuthenticationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkAuthenticationCodeRequest CheckAuthenticationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckAuthenticationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkAuthenticationCode", alias(checkAuthenticationCodeRequest)})
+}
+
+// RequestQrCodeAuthenticationRequest is the request of RequestQrCodeAuthentication: Requests QR code authentication by scanning a QR code on another logged in device. Works only when the current authorization state is authorizationStateWaitPhoneNumber,
+type RequestQrCodeAuthenticationRequest struct {
+	OtherUserIDs []int32 `json:"other_user_ids"` // List of user identifiers of other users currently using the application
+}
+
+// MessageType return the string telegram-type of RequestQrCodeAuthenticationRequest
+func (requestQrCodeAuthenticationRequest *RequestQrCodeAuthenticationRequest) MessageType() string {
+	return "requestQrCodeAuthentication"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (requestQrCodeAuthenticationRequest RequestQrCodeAuthenticationRequest) MarshalJSON() ([]byte, error) {
+	type alias RequestQrCodeAuthenticationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"requestQrCodeAuthentication", alias(requestQrCodeAuthenticationRequest)})
+}
+
+// RegisterUserRequest is the request of RegisterUser: Finishes user registration. Works only when the current authorization state is authorizationStateWaitRegistration
+type RegisterUserRequest struct {
+	FirstName string `json:"first_name"` // The first name of the user; 1-64 characters
+	LastName  string `json:"last_name"`  // The last name of the user; 0-64 characters
+}
+
+// MessageType return the string telegram-type of RegisterUserRequest
+func (registerUserRequest *RegisterUserRequest) MessageType() string {
+	return "registerUser"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (registerUserRequest RegisterUserRequest) MarshalJSON() ([]byte, error) {
+	type alias RegisterUserRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"registerUser", alias(registerUserRequest)})
+}
+
+// CheckAuthenticationPasswordRequest is the request of CheckAuthenticationPassword: Checks the authentication password for correctness. Works only when the current authorization state is authorizationStateWaitPassword
+type CheckAuthenticationPasswordRequest struct {
+	Password string `json:"password"` // The password to check
+}
+
+// MessageType return the string telegram-type of CheckAuthenticationPasswordRequest
+func (checkAuthenticationPasswordRequest *CheckAuthenticationPasswordRequest) MessageType() string {
+	return "checkAuthenticationPassword"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkAuthenticationPasswordRequest CheckAuthenticationPasswordRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckAuthenticationPasswordRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkAuthenticationPassword", alias(checkAuthenticationPasswordRequest)})
+}
+
+// RequestAuthenticationPasswordRecoveryRequest is the request of RequestAuthenticationPasswordRecovery: Requests to send a password recovery code to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+type RequestAuthenticationPasswordRecoveryRequest struct {
+}
+
+// MessageType return the string telegram-type of RequestAuthenticationPasswordRecoveryRequest
+func (requestAuthenticationPasswordRecoveryRequest *RequestAuthenticationPasswordRecoveryRequest) MessageType() string {
+	return "requestAuthenticationPasswordRecovery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (requestAuthenticationPasswordRecoveryRequest RequestAuthenticationPasswordRecoveryRequest) MarshalJSON() ([]byte, error) {
+	type alias RequestAuthenticationPasswordRecoveryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"requestAuthenticationPasswordRecovery", alias(requestAuthenticationPasswordRecoveryRequest)})
+}
+
+// RecoverAuthenticationPasswordRequest is the request of RecoverAuthenticationPassword: Recovers the password with a password recovery code sent to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+type RecoverAuthenticationPasswordRequest struct {
+	RecoveryCode string `json:"recovery_code"` // Recovery code to check
+}
+
+// MessageType return the string telegram-type of RecoverAuthenticationPasswordRequest
+func (recoverAuthenticationPasswordRequest *RecoverAuthenticationPasswordRequest) MessageType() string {
+	return "recoverAuthenticationPassword"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (recoverAuthenticationPasswordRequest RecoverAuthenticationPasswordRequest) MarshalJSON() ([]byte, error) {
+	type alias RecoverAuthenticationPasswordRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"recoverAuthenticationPassword", alias(recoverAuthenticationPasswordRequest)})
+}
+
+// CheckAuthenticationBotTokenRequest is the request of CheckAuthenticationBotToken: Checks the authentication token of a bot; to log in as a bot. Works only when the current authorization state is authorizationStateWaitPhoneNumber. Can be used instead of setAuthenticationPhoneNumber and checkAuthenticationCode to log in
+type CheckAuthenticationBotTokenRequest struct {
+	Token string `json:"token"` // The bot token
+}
+
+// MessageType return the string telegram-type of CheckAuthenticationBotTokenRequest
+func (checkAuthenticationBotTokenRequest *CheckAuthenticationBotTokenRequest) MessageType() string {
+	return "checkAuthenticationBotToken"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkAuthenticationBotTokenRequest CheckAuthenticationBotTokenRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckAuthenticationBotTokenRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkAuthenticationBotToken", alias(checkAuthenticationBotTokenRequest)})
+}
+
+// LogOutRequest is the request of LogOut: Closes the TDLib instance after a proper logout. Requires an available network connection. All local data will be destroyed. After the logout completes, updateAuthorizationState with authorizationStateClosed will be sent
+type LogOutRequest struct {
+}
+
+// MessageType return the string telegram-type of LogOutRequest
+func (logOutRequest *LogOutRequest) MessageType() string {
+	return "logOut"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (logOutRequest LogOutRequest) MarshalJSON() ([]byte, error) {
+	type alias LogOutRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"logOut", alias(logOutRequest)})
+}
+
+// CloseRequest is the request of Close: Closes the TDLib instance. All databases will be flushed to disk and properly closed. After the close completes, updateAuthorizationState with authorizationStateClosed will be sent. Can be called before initialization
+type CloseRequest struct {
+}
+
+// MessageType return the string telegram-type of CloseRequest
+func (closeRequest *CloseRequest) MessageType() string {
+	return "close"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (closeRequest CloseRequest) MarshalJSON() ([]byte, error) {
+	type alias CloseRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"close", alias(closeRequest)})
+}
+
+// DestroyRequest is the request of Destroy: Closes the TDLib instance, destroying all local data without a proper logout. The current user session will remain in the list of all active sessions. All local data will be destroyed. After the destruction completes updateAuthorizationState with authorizationStateClosed will be sent. Can be called before authorization
+type DestroyRequest struct {
+}
+
+// MessageType return the string telegram-type of DestroyRequest
+func (destroyRequest *DestroyRequest) MessageType() string {
+	return "destroy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (destroyRequest DestroyRequest) MarshalJSON() ([]byte, error) {
+	type alias DestroyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"destroy", alias(destroyRequest)})
+}
+
+// ConfirmQrCodeAuthenticationRequest is the request of ConfirmQrCodeAuthentication: Confirms QR code authentication on another device. Returns created session on success
+type ConfirmQrCodeAuthenticationRequest struct {
+	Link string `json:"link"` // A link from a QR code. The link must be scanned by the in-app camera
+}
+
+// MessageType return the string telegram-type of ConfirmQrCodeAuthenticationRequest
+func (confirmQrCodeAuthenticationRequest *ConfirmQrCodeAuthenticationRequest) MessageType() string {
+	return "confirmQrCodeAuthentication"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (confirmQrCodeAuthenticationRequest ConfirmQrCodeAuthenticationRequest) MarshalJSON() ([]byte, error) {
+	type alias ConfirmQrCodeAuthenticationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"confirmQrCodeAuthentication", alias(confirmQrCodeAuthenticationRequest)})
+}
+
+// GetCurrentStateRequest is the request of GetCurrentState: Returns all updates needed to restore current TDLib state, i.e. all actual UpdateAuthorizationState/UpdateUser/UpdateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization
+type GetCurrentStateRequest struct {
+}
+
+// MessageType return the string telegram-type of GetCurrentStateRequest
+func (getCurrentStateRequest *GetCurrentStateRequest) MessageType() string {
+	return "getCurrentState"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getCurrentStateRequest GetCurrentStateRequest) MarshalJSON() ([]byte, error) {
+	type alias GetCurrentStateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getCurrentState", alias(getCurrentStateRequest)})
+}
+
+// SetDatabaseEncryptionKeyRequest is the request of SetDatabaseEncryptionKey: Changes the database encryption key. Usually the encryption key is never changed and is stored in some OS keychain
+type SetDatabaseEncryptionKeyRequest struct {
+	NewEncryptionKey []byte `json:"new_encryption_key"` // New encryption key
+}
+
+// MessageType return the string telegram-type of SetDatabaseEncryptionKeyRequest
+func (setDatabaseEncryptionKeyRequest *SetDatabaseEncryptionKeyRequest) MessageType() string {
+	return "setDatabaseEncryptionKey"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setDatabaseEncryptionKeyRequest SetDatabaseEncryptionKeyRequest) MarshalJSON() ([]byte, error) {
+	type alias SetDatabaseEncryptionKeyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setDatabaseEncryptionKey", alias(setDatabaseEncryptionKeyRequest)})
+}
+
+// GetPasswordStateRequest is the request of GetPasswordState: Returns the current state of 2-step verification
+type GetPasswordStateRequest struct {
+}
+
+// MessageType return the string telegram-type of GetPasswordStateRequest
+func (getPasswordStateRequest *GetPasswordStateRequest) MessageType() string {
+	return "getPasswordState"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPasswordStateRequest GetPasswordStateRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPasswordStateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPasswordState", alias(getPasswordStateRequest)})
+}
+
+// SetPasswordRequest is the request of SetPassword: Changes the password for the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed
+type SetPasswordRequest struct {
+	OldPassword             string `json:"old_password"`               // Previous password of the user
+	NewPassword             string `json:"new_password"`               // New password of the user; may be empty to remove the password
+	NewHint                 string `json:"new_hint"`                   // New password hint; may be empty
+	SetRecoveryEmailAddress bool   `json:"set_recovery_email_address"` // Pass true if the recovery email address should be changed
+	NewRecoveryEmailAddress string `json:"new_recovery_email_address"` // New recovery email address; may be empty
+}
+
+// MessageType return the string telegram-type of SetPasswordRequest
+func (setPasswordRequest *SetPasswordRequest) MessageType() string {
+	return "setPassword"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setPasswordRequest SetPasswordRequest) MarshalJSON() ([]byte, error) {
+	type alias SetPasswordRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setPassword", alias(setPasswordRequest)})
+}
+
+// GetRecoveryEmailAddressRequest is the request of GetRecoveryEmailAddress: Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user
+type GetRecoveryEmailAddressRequest struct {
+	Password string `json:"password"` // The password for the current user
+}
+
+// MessageType return the string telegram-type of GetRecoveryEmailAddressRequest
+func (getRecoveryEmailAddressRequest *GetRecoveryEmailAddressRequest) MessageType() string {
+	return "getRecoveryEmailAddress"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRecoveryEmailAddressRequest GetRecoveryEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRecoveryEmailAddressRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRecoveryEmailAddress", alias(getRecoveryEmailAddressRequest)})
+}
+
+// SetRecoveryEmailAddressRequest is the request of SetRecoveryEmailAddress: Changes the 2-step verification recovery email address of the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed.
+type SetRecoveryEmailAddressRequest struct {
+	Password                string `json:"password"`                   //
+	NewRecoveryEmailAddress string `json:"new_recovery_email_address"` //
+}
+
+// MessageType return the string telegram-type of SetRecoveryEmailAddressRequest
+func (setRecoveryEmailAddressRequest *SetRecoveryEmailAddressRequest) MessageType() string {
+	return "setRecoveryEmailAddress"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setRecoveryEmailAddressRequest SetRecoveryEmailAddressRequest) MarshalJSON() ([]byte, error) {
+	type alias SetRecoveryEmailAddressRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setRecoveryEmailAddress", alias(setRecoveryEmailAddressRequest)})
+}
+
+// CheckRecoveryEmailAddressCodeRequest is the request of CheckRecoveryEmailAddressCode: Checks the 2-step verification recovery email address verification code
+type CheckRecoveryEmailAddressCodeRequest struct {
+	Code string `json:"code"` // Verification code
+}
+
+// MessageType return the string telegram-type of CheckRecoveryEmailAddressCodeRequest
+func (checkRecoveryEmailAddressCodeRequest *CheckRecoveryEmailAddressCodeRequest) MessageType() string {
+	return "checkRecoveryEmailAddressCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkRecoveryEmailAddressCodeRequest CheckRecoveryEmailAddressCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckRecoveryEmailAddressCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkRecoveryEmailAddressCode", alias(checkRecoveryEmailAddressCodeRequest)})
+}
+
+// ResendRecoveryEmailAddressCodeRequest is the request of ResendRecoveryEmailAddressCode: Resends the 2-step verification recovery email address verification code
+type ResendRecoveryEmailAddressCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of ResendRecoveryEmailAddressCodeRequest
+func (resendRecoveryEmailAddressCodeRequest *ResendRecoveryEmailAddressCodeRequest) MessageType() string {
+	return "resendRecoveryEmailAddressCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendRecoveryEmailAddressCodeRequest ResendRecoveryEmailAddressCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendRecoveryEmailAddressCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendRecoveryEmailAddressCode", alias(resendRecoveryEmailAddressCodeRequest)})
+}
+
+// RequestPasswordRecoveryRequest is the request of RequestPasswordRecovery: Requests to send a password recovery code to an email address that was previously set up
+type RequestPasswordRecoveryRequest struct {
+}
+
+// MessageType return the string telegram-type of RequestPasswordRecoveryRequest
+func (requestPasswordRecoveryRequest *RequestPasswordRecoveryRequest) MessageType() string {
+	return "requestPasswordRecovery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (requestPasswordRecoveryRequest RequestPasswordRecoveryRequest) MarshalJSON() ([]byte, error) {
+	type alias RequestPasswordRecoveryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"requestPasswordRecovery", alias(requestPasswordRecoveryRequest)})
+}
+
+// RecoverPasswordRequest is the request of RecoverPassword: Recovers the password using a recovery code sent to an email address that was previously set up
+type RecoverPasswordRequest struct {
+	RecoveryCode string `json:"recovery_code"` // Recovery code to check
+}
+
+// MessageType return the string telegram-type of RecoverPasswordRequest
+func (recoverPasswordRequest *RecoverPasswordRequest) MessageType() string {
+	return "recoverPassword"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (recoverPasswordRequest RecoverPasswordRequest) MarshalJSON() ([]byte, error) {
+	type alias RecoverPasswordRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"recoverPassword", alias(recoverPasswordRequest)})
+}
+
+// CreateTemporaryPasswordRequest is the request of CreateTemporaryPassword: Creates a new temporary password for processing payments
+type CreateTemporaryPasswordRequest struct {
+	Password string `json:"password"`  // Persistent user password
+	ValidFor int32  `json:"valid_for"` // Time during which the temporary password will be valid, in seconds; should be between 60 and 86400
+}
+
+// MessageType return the string telegram-type of CreateTemporaryPasswordRequest
+func (createTemporaryPasswordRequest *CreateTemporaryPasswordRequest) MessageType() string {
+	return "createTemporaryPassword"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createTemporaryPasswordRequest CreateTemporaryPasswordRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateTemporaryPasswordRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createTemporaryPassword", alias(createTemporaryPasswordRequest)})
+}
+
+// GetTemporaryPasswordStateRequest is the request of GetTemporaryPasswordState: Returns information about the current temporary password
+type GetTemporaryPasswordStateRequest struct {
+}
+
+// MessageType return the string telegram-type of GetTemporaryPasswordStateRequest
+func (getTemporaryPasswordStateRequest *GetTemporaryPasswordStateRequest) MessageType() string {
+	return "getTemporaryPasswordState"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getTemporaryPasswordStateRequest GetTemporaryPasswordStateRequest) MarshalJSON() ([]byte, error) {
+	type alias GetTemporaryPasswordStateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getTemporaryPasswordState", alias(getTemporaryPasswordStateRequest)})
+}
+
+// GetMeRequest is the request of GetMe: Returns the current user
+type GetMeRequest struct {
+}
+
+// MessageType return the string telegram-type of GetMeRequest
+func (getMeRequest *GetMeRequest) MessageType() string {
+	return "getMe"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMeRequest GetMeRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMe", alias(getMeRequest)})
+}
+
+// GetUserRequest is the request of GetUser: Returns information about a user by their identifier. This is an offline request if the current user is not a bot
+type GetUserRequest struct {
+	UserID int32 `json:"user_id"` // User identifier
+}
+
+// MessageType return the string telegram-type of GetUserRequest
+func (getUserRequest *GetUserRequest) MessageType() string {
+	return "getUser"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getUserRequest GetUserRequest) MarshalJSON() ([]byte, error) {
+	type alias GetUserRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getUser", alias(getUserRequest)})
+}
+
+// GetUserFullInfoRequest is the request of GetUserFullInfo: Returns full information about a user by their identifier
+type GetUserFullInfoRequest struct {
+	UserID int32 `json:"user_id"` // User identifier
+}
+
+// MessageType return the string telegram-type of GetUserFullInfoRequest
+func (getUserFullInfoRequest *GetUserFullInfoRequest) MessageType() string {
+	return "getUserFullInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getUserFullInfoRequest GetUserFullInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetUserFullInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getUserFullInfo", alias(getUserFullInfoRequest)})
+}
+
+// GetBasicGroupRequest is the request of GetBasicGroup: Returns information about a basic group by its identifier. This is an offline request if the current user is not a bot
+type GetBasicGroupRequest struct {
+	BasicGroupID int32 `json:"basic_group_id"` // Basic group identifier
+}
+
+// MessageType return the string telegram-type of GetBasicGroupRequest
+func (getBasicGroupRequest *GetBasicGroupRequest) MessageType() string {
+	return "getBasicGroup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getBasicGroupRequest GetBasicGroupRequest) MarshalJSON() ([]byte, error) {
+	type alias GetBasicGroupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getBasicGroup", alias(getBasicGroupRequest)})
+}
+
+// GetBasicGroupFullInfoRequest is the request of GetBasicGroupFullInfo: Returns full information about a basic group by its identifier
+type GetBasicGroupFullInfoRequest struct {
+	BasicGroupID int32 `json:"basic_group_id"` // Basic group identifier
+}
+
+// MessageType return the string telegram-type of GetBasicGroupFullInfoRequest
+func (getBasicGroupFullInfoRequest *GetBasicGroupFullInfoRequest) MessageType() string {
+	return "getBasicGroupFullInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getBasicGroupFullInfoRequest GetBasicGroupFullInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetBasicGroupFullInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getBasicGroupFullInfo", alias(getBasicGroupFullInfoRequest)})
+}
+
+// GetSupergroupRequest is the request of GetSupergroup: Returns information about a supergroup or a channel by its identifier. This is an offline request if the current user is not a bot
+type GetSupergroupRequest struct {
+	SupergroupID int32 `json:"supergroup_id"` // Supergroup or channel identifier
+}
+
+// MessageType return the string telegram-type of GetSupergroupRequest
+func (getSupergroupRequest *GetSupergroupRequest) MessageType() string {
+	return "getSupergroup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSupergroupRequest GetSupergroupRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSupergroupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSupergroup", alias(getSupergroupRequest)})
+}
+
+// GetSupergroupFullInfoRequest is the request of GetSupergroupFullInfo: Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute
+type GetSupergroupFullInfoRequest struct {
+	SupergroupID int32 `json:"supergroup_id"` // Supergroup or channel identifier
+}
+
+// MessageType return the string telegram-type of GetSupergroupFullInfoRequest
+func (getSupergroupFullInfoRequest *GetSupergroupFullInfoRequest) MessageType() string {
+	return "getSupergroupFullInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSupergroupFullInfoRequest GetSupergroupFullInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSupergroupFullInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSupergroupFullInfo", alias(getSupergroupFullInfoRequest)})
+}
+
+// GetSecretChatRequest is the request of GetSecretChat: Returns information about a secret chat by its identifier. This is an offline request
+type GetSecretChatRequest struct {
+	SecretChatID int32 `json:"secret_chat_id"` // Secret chat identifier
+}
+
+// MessageType return the string telegram-type of GetSecretChatRequest
+func (getSecretChatRequest *GetSecretChatRequest) MessageType() string {
+	return "getSecretChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSecretChatRequest GetSecretChatRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSecretChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSecretChat", alias(getSecretChatRequest)})
+}
+
+// GetChatRequest is the request of GetChat: Returns information about a chat by its identifier, this is an offline request if the current user is not a bot
+type GetChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of GetChatRequest
+func (getChatRequest *GetChatRequest) MessageType() string {
+	return "getChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatRequest GetChatRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChat", alias(getChatRequest)})
+}
+
+// GetMessageRequest is the request of GetMessage: Returns information about a message
+type GetMessageRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat the message belongs to
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message to get
+}
+
+// MessageType return the string telegram-type of GetMessageRequest
+func (getMessageRequest *GetMessageRequest) MessageType() string {
+	return "getMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageRequest GetMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessage", alias(getMessageRequest)})
+}
+
+// GetMessageLocallyRequest is the request of GetMessageLocally: Returns information about a message, if it is available locally without sending network request. This is an offline request
+type GetMessageLocallyRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat the message belongs to
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message to get
+}
+
+// MessageType return the string telegram-type of GetMessageLocallyRequest
+func (getMessageLocallyRequest *GetMessageLocallyRequest) MessageType() string {
+	return "getMessageLocally"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageLocallyRequest GetMessageLocallyRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageLocallyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageLocally", alias(getMessageLocallyRequest)})
+}
+
+// GetRepliedMessageRequest is the request of GetRepliedMessage: Returns information about a message that is replied by a given message. Also returns the pinned message, the game message, and the invoice message for messages of the types messagePinMessage, messageGameScore, and messagePaymentSuccessful respectively
+type GetRepliedMessageRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat the message belongs to
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message reply to which to get
+}
+
+// MessageType return the string telegram-type of GetRepliedMessageRequest
+func (getRepliedMessageRequest *GetRepliedMessageRequest) MessageType() string {
+	return "getRepliedMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRepliedMessageRequest GetRepliedMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRepliedMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRepliedMessage", alias(getRepliedMessageRequest)})
+}
+
+// GetChatPinnedMessageRequest is the request of GetChatPinnedMessage: Returns information about a newest pinned message in the chat
+type GetChatPinnedMessageRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat the message belongs to
+}
+
+// MessageType return the string telegram-type of GetChatPinnedMessageRequest
+func (getChatPinnedMessageRequest *GetChatPinnedMessageRequest) MessageType() string {
+	return "getChatPinnedMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatPinnedMessageRequest GetChatPinnedMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatPinnedMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatPinnedMessage", alias(getChatPinnedMessageRequest)})
+}
+
+// GetCallbackQueryMessageRequest is the request of GetCallbackQueryMessage: Returns information about a message with the callback button that originated a callback query; for bots only
+type GetCallbackQueryMessageRequest struct {
+	ChatID          JSONInt64 `json:"chat_id"`           // Identifier of the chat the message belongs to
+	MessageID       JSONInt64 `json:"message_id"`        // Message identifier
+	CallbackQueryID JSONInt64 `json:"callback_query_id"` // Identifier of the callback query
+}
+
+// MessageType return the string telegram-type of GetCallbackQueryMessageRequest
+func (getCallbackQueryMessageRequest *GetCallbackQueryMessageRequest) MessageType() string {
+	return "getCallbackQueryMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getCallbackQueryMessageRequest GetCallbackQueryMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias GetCallbackQueryMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getCallbackQueryMessage", alias(getCallbackQueryMessageRequest)})
+}
+
+// GetMessagesRequest is the request of GetMessages: Returns information about messages. If a message is not found, returns null on the corresponding position of the result
+type GetMessagesRequest struct {
+	ChatID     JSONInt64   `json:"chat_id"`     // Identifier of the chat the messages belong to
+	MessageIDs []JSONInt64 `json:"message_ids"` // Identifiers of the messages to get
+}
+
+// MessageType return the string telegram-type of GetMessagesRequest
+func (getMessagesRequest *GetMessagesRequest) MessageType() string {
+	return "getMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessagesRequest GetMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessages", alias(getMessagesRequest)})
+}
+
+// GetMessageThreadRequest is the request of GetMessageThread: Returns information about a message thread. Can be used only if message.can_get_message_thread == true
+type GetMessageThreadRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+}
+
+// MessageType return the string telegram-type of GetMessageThreadRequest
+func (getMessageThreadRequest *GetMessageThreadRequest) MessageType() string {
+	return "getMessageThread"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageThreadRequest GetMessageThreadRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageThreadRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageThread", alias(getMessageThreadRequest)})
+}
+
+// GetFileRequest is the request of GetFile: Returns information about a file; this is an offline request
+type GetFileRequest struct {
+	FileID int32 `json:"file_id"` // Identifier of the file to get
+}
+
+// MessageType return the string telegram-type of GetFileRequest
+func (getFileRequest *GetFileRequest) MessageType() string {
+	return "getFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getFileRequest GetFileRequest) MarshalJSON() ([]byte, error) {
+	type alias GetFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getFile", alias(getFileRequest)})
+}
+
+// GetRemoteFileRequest is the request of GetRemoteFile: Returns information about a file by its remote ID; this is an offline request. Can be used to register a URL as a file for further uploading, or sending as a message. Even the request succeeds, the file can be used only if it is still accessible to the user.
+type GetRemoteFileRequest struct {
+	RemoteFileID string   `json:"remote_file_id"` // Remote identifier of the file to get
+	FileType     FileType `json:"file_type"`      // File type, if known
+}
+
+// MessageType return the string telegram-type of GetRemoteFileRequest
+func (getRemoteFileRequest *GetRemoteFileRequest) MessageType() string {
+	return "getRemoteFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRemoteFileRequest GetRemoteFileRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRemoteFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRemoteFile", alias(getRemoteFileRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getRemoteFileRequest *GetRemoteFileRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		RemoteFileID string `json:"remote_file_id"` // Remote identifier of the file to get
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getRemoteFileRequest.RemoteFileID = tempObj.RemoteFileID
+
+	fieldFileType, _ := unmarshalFileType(objMap["file_type"])
+	getRemoteFileRequest.FileType = fieldFileType
+
+	return nil
+}
+
+// GetChatsRequest is the request of GetChats: Returns an ordered list of chats in a chat list. Chats are sorted by the pair (chat.position.order, chat.id) in descending order. (For example, to get a list of chats from the beginning, the offset_order should be equal to a biggest signed 64-bit number 9223372036854775807 == 2^63 - 1).
+type GetChatsRequest struct {
+	ChatList     ChatList  `json:"chat_list"`      // The chat list in which to return chats
+	OffsetOrder  JSONInt64 `json:"offset_order"`   // Chat order to return chats from
+	OffsetChatID JSONInt64 `json:"offset_chat_id"` // Chat identifier to return chats from
+	Limit        int32     `json:"limit"`          // The maximum number of chats to be returned. It is possible that fewer chats than the limit are returned even if the end of the list is not reached
+}
+
+// MessageType return the string telegram-type of GetChatsRequest
+func (getChatsRequest *GetChatsRequest) MessageType() string {
+	return "getChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatsRequest GetChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChats", alias(getChatsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getChatsRequest *GetChatsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		OffsetOrder  JSONInt64 `json:"offset_order"`   // Chat order to return chats from
+		OffsetChatID JSONInt64 `json:"offset_chat_id"` // Chat identifier to return chats from
+		Limit        int32     `json:"limit"`          // The maximum number of chats to be returned. It is possible that fewer chats than the limit are returned even if the end of the list is not reached
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getChatsRequest.OffsetOrder = tempObj.OffsetOrder
+	getChatsRequest.OffsetChatID = tempObj.OffsetChatID
+	getChatsRequest.Limit = tempObj.Limit
+
+	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
+	getChatsRequest.ChatList = fieldChatList
+
+	return nil
+}
+
+// SearchPublicChatRequest is the request of SearchPublicChat: Searches a public chat by its username. Currently only private chats, supergroups and channels can be public. Returns the chat if found; otherwise an error is returned
+type SearchPublicChatRequest struct {
+	Username string `json:"username"` // Username to be resolved
+}
+
+// MessageType return the string telegram-type of SearchPublicChatRequest
+func (searchPublicChatRequest *SearchPublicChatRequest) MessageType() string {
+	return "searchPublicChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchPublicChatRequest SearchPublicChatRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchPublicChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchPublicChat", alias(searchPublicChatRequest)})
+}
+
+// SearchPublicChatsRequest is the request of SearchPublicChats: Searches public chats by looking for specified query in their username and title. Currently only private chats, supergroups and channels can be public. Returns a meaningful number of results. Returns nothing if the length of the searched username prefix is less than 5. Excludes private chats with contacts and chats from the chat list from the results
+type SearchPublicChatsRequest struct {
+	Query string `json:"query"` // Query to search for
+}
+
+// MessageType return the string telegram-type of SearchPublicChatsRequest
+func (searchPublicChatsRequest *SearchPublicChatsRequest) MessageType() string {
+	return "searchPublicChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchPublicChatsRequest SearchPublicChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchPublicChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchPublicChats", alias(searchPublicChatsRequest)})
+}
+
+// SearchChatsRequest is the request of SearchChats: Searches for the specified query in the title and username of already known chats, this is an offline request. Returns chats in the order seen in the main chat list
+type SearchChatsRequest struct {
+	Query string `json:"query"` // Query to search for. If the query is empty, returns up to 20 recently found chats
+	Limit int32  `json:"limit"` // The maximum number of chats to be returned
+}
+
+// MessageType return the string telegram-type of SearchChatsRequest
+func (searchChatsRequest *SearchChatsRequest) MessageType() string {
+	return "searchChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchChatsRequest SearchChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchChats", alias(searchChatsRequest)})
+}
+
+// SearchChatsOnServerRequest is the request of SearchChatsOnServer: Searches for the specified query in the title and username of already known chats via request to the server. Returns chats in the order seen in the main chat list
+type SearchChatsOnServerRequest struct {
+	Query string `json:"query"` // Query to search for
+	Limit int32  `json:"limit"` // The maximum number of chats to be returned
+}
+
+// MessageType return the string telegram-type of SearchChatsOnServerRequest
+func (searchChatsOnServerRequest *SearchChatsOnServerRequest) MessageType() string {
+	return "searchChatsOnServer"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchChatsOnServerRequest SearchChatsOnServerRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchChatsOnServerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchChatsOnServer", alias(searchChatsOnServerRequest)})
+}
+
+// SearchChatsNearbyRequest is the request of SearchChatsNearby: Returns a list of users and location-based supergroups nearby. The list of users nearby will be updated for 60 seconds after the request by the updates updateUsersNearby. The request should be sent again every 25 seconds with adjusted location to not miss new chats
+type SearchChatsNearbyRequest struct {
+	Location *Location `json:"location"` // Current user location
+}
+
+// MessageType return the string telegram-type of SearchChatsNearbyRequest
+func (searchChatsNearbyRequest *SearchChatsNearbyRequest) MessageType() string {
+	return "searchChatsNearby"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchChatsNearbyRequest SearchChatsNearbyRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchChatsNearbyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchChatsNearby", alias(searchChatsNearbyRequest)})
+}
+
+// GetTopChatsRequest is the request of GetTopChats: Returns a list of frequently used chats. Supported only if the chat info database is enabled
+type GetTopChatsRequest struct {
+	Category TopChatCategory `json:"category"` // Category of chats to be returned
+	Limit    int32           `json:"limit"`    // The maximum number of chats to be returned; up to 30
+}
+
+// MessageType return the string telegram-type of GetTopChatsRequest
+func (getTopChatsRequest *GetTopChatsRequest) MessageType() string {
+	return "getTopChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getTopChatsRequest GetTopChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetTopChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getTopChats", alias(getTopChatsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getTopChatsRequest *GetTopChatsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Limit int32 `json:"limit"` // The maximum number of chats to be returned; up to 30
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getTopChatsRequest.Limit = tempObj.Limit
+
+	fieldCategory, _ := unmarshalTopChatCategory(objMap["category"])
+	getTopChatsRequest.Category = fieldCategory
+
+	return nil
+}
+
+// RemoveTopChatRequest is the request of RemoveTopChat: Removes a chat from the list of frequently used chats. Supported only if the chat info database is enabled
+type RemoveTopChatRequest struct {
+	Category TopChatCategory `json:"category"` // Category of frequently used chats
+	ChatID   JSONInt64       `json:"chat_id"`  // Chat identifier
+}
+
+// MessageType return the string telegram-type of RemoveTopChatRequest
+func (removeTopChatRequest *RemoveTopChatRequest) MessageType() string {
+	return "removeTopChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeTopChatRequest RemoveTopChatRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveTopChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeTopChat", alias(removeTopChatRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (removeTopChatRequest *RemoveTopChatRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	removeTopChatRequest.ChatID = tempObj.ChatID
+
+	fieldCategory, _ := unmarshalTopChatCategory(objMap["category"])
+	removeTopChatRequest.Category = fieldCategory
+
+	return nil
+}
+
+// AddRecentlyFoundChatRequest is the request of AddRecentlyFoundChat: Adds a chat to the list of recently found chats. The chat is added to the beginning of the list. If the chat is already in the list, it will be removed from the list first
+type AddRecentlyFoundChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat to add
+}
+
+// MessageType return the string telegram-type of AddRecentlyFoundChatRequest
+func (addRecentlyFoundChatRequest *AddRecentlyFoundChatRequest) MessageType() string {
+	return "addRecentlyFoundChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addRecentlyFoundChatRequest AddRecentlyFoundChatRequest) MarshalJSON() ([]byte, error) {
+	type alias AddRecentlyFoundChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addRecentlyFoundChat", alias(addRecentlyFoundChatRequest)})
+}
+
+// RemoveRecentlyFoundChatRequest is the request of RemoveRecentlyFoundChat: Removes a chat from the list of recently found chats
+type RemoveRecentlyFoundChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat to be removed
+}
+
+// MessageType return the string telegram-type of RemoveRecentlyFoundChatRequest
+func (removeRecentlyFoundChatRequest *RemoveRecentlyFoundChatRequest) MessageType() string {
+	return "removeRecentlyFoundChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeRecentlyFoundChatRequest RemoveRecentlyFoundChatRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveRecentlyFoundChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeRecentlyFoundChat", alias(removeRecentlyFoundChatRequest)})
+}
+
+// ClearRecentlyFoundChatsRequest is the request of ClearRecentlyFoundChats: Clears the list of recently found chats
+type ClearRecentlyFoundChatsRequest struct {
+}
+
+// MessageType return the string telegram-type of ClearRecentlyFoundChatsRequest
+func (clearRecentlyFoundChatsRequest *ClearRecentlyFoundChatsRequest) MessageType() string {
+	return "clearRecentlyFoundChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (clearRecentlyFoundChatsRequest ClearRecentlyFoundChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias ClearRecentlyFoundChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"clearRecentlyFoundChats", alias(clearRecentlyFoundChatsRequest)})
+}
+
+// CheckChatUsernameRequest is the request of CheckChatUsername: Checks whether a username can be set for a chat
+type CheckChatUsernameRequest struct {
+	ChatID   JSONInt64 `json:"chat_id"`  // Chat identifier; should be identifier of a supergroup chat, or a channel chat, or a private chat with self, or zero if chat is being created
+	Username string    `json:"username"` // Username to be checked
+}
+
+// MessageType return the string telegram-type of CheckChatUsernameRequest
+func (checkChatUsernameRequest *CheckChatUsernameRequest) MessageType() string {
+	return "checkChatUsername"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkChatUsernameRequest CheckChatUsernameRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckChatUsernameRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkChatUsername", alias(checkChatUsernameRequest)})
+}
+
+// GetCreatedPublicChatsRequest is the request of GetCreatedPublicChats: Returns a list of public chats of the specified type, owned by the user
+type GetCreatedPublicChatsRequest struct {
+	Type PublicChatType `json:"type"` // Type of the public chats to return
+}
+
+// MessageType return the string telegram-type of GetCreatedPublicChatsRequest
+func (getCreatedPublicChatsRequest *GetCreatedPublicChatsRequest) MessageType() string {
+	return "getCreatedPublicChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getCreatedPublicChatsRequest GetCreatedPublicChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetCreatedPublicChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getCreatedPublicChats", alias(getCreatedPublicChatsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getCreatedPublicChatsRequest *GetCreatedPublicChatsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldType, _ := unmarshalPublicChatType(objMap["type"])
+	getCreatedPublicChatsRequest.Type = fieldType
+
+	return nil
+}
+
+// CheckCreatedPublicChatsLimitRequest is the request of CheckCreatedPublicChatsLimit: Checks whether the maximum number of owned public chats has been reached. Returns corresponding error if the limit was reached
+type CheckCreatedPublicChatsLimitRequest struct {
+	Type PublicChatType `json:"type"` // Type of the public chats, for which to check the limit
+}
+
+// MessageType return the string telegram-type of CheckCreatedPublicChatsLimitRequest
+func (checkCreatedPublicChatsLimitRequest *CheckCreatedPublicChatsLimitRequest) MessageType() string {
+	return "checkCreatedPublicChatsLimit"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkCreatedPublicChatsLimitRequest CheckCreatedPublicChatsLimitRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckCreatedPublicChatsLimitRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkCreatedPublicChatsLimit", alias(checkCreatedPublicChatsLimitRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (checkCreatedPublicChatsLimitRequest *CheckCreatedPublicChatsLimitRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldType, _ := unmarshalPublicChatType(objMap["type"])
+	checkCreatedPublicChatsLimitRequest.Type = fieldType
+
+	return nil
+}
+
+// GetSuitableDiscussionChatsRequest is the request of GetSuitableDiscussionChats: Returns a list of basic group and supergroup chats, which can be used as a discussion group for a channel. Returned basic group chats must be first upgraded to supergroups before they can be set as a discussion group. To set a returned supergroup as a discussion group, access to its old messages must be enabled using toggleSupergroupIsAllHistoryAvailable first
+type GetSuitableDiscussionChatsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetSuitableDiscussionChatsRequest
+func (getSuitableDiscussionChatsRequest *GetSuitableDiscussionChatsRequest) MessageType() string {
+	return "getSuitableDiscussionChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSuitableDiscussionChatsRequest GetSuitableDiscussionChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSuitableDiscussionChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSuitableDiscussionChats", alias(getSuitableDiscussionChatsRequest)})
+}
+
+// GetInactiveSupergroupChatsRequest is the request of GetInactiveSupergroupChats: Returns a list of recently inactive supergroups and channels. Can be used when user reaches limit on the number of joined supergroups and channels and receives CHANNELS_TOO_MUCH error
+type GetInactiveSupergroupChatsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetInactiveSupergroupChatsRequest
+func (getInactiveSupergroupChatsRequest *GetInactiveSupergroupChatsRequest) MessageType() string {
+	return "getInactiveSupergroupChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getInactiveSupergroupChatsRequest GetInactiveSupergroupChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetInactiveSupergroupChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getInactiveSupergroupChats", alias(getInactiveSupergroupChatsRequest)})
+}
+
+// GetGroupsInCommonRequest is the request of GetGroupsInCommon: Returns a list of common group chats with a given user. Chats are sorted by their type and creation date
+type GetGroupsInCommonRequest struct {
+	UserID       int32     `json:"user_id"`        // User identifier
+	OffsetChatID JSONInt64 `json:"offset_chat_id"` // Chat identifier starting from which to return chats; use 0 for the first request
+	Limit        int32     `json:"limit"`          // The maximum number of chats to be returned; up to 100
+}
+
+// MessageType return the string telegram-type of GetGroupsInCommonRequest
+func (getGroupsInCommonRequest *GetGroupsInCommonRequest) MessageType() string {
+	return "getGroupsInCommon"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getGroupsInCommonRequest GetGroupsInCommonRequest) MarshalJSON() ([]byte, error) {
+	type alias GetGroupsInCommonRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getGroupsInCommon", alias(getGroupsInCommonRequest)})
+}
+
+// GetChatHistoryRequest is the request of GetChatHistory: Returns messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id).
+type GetChatHistoryRequest struct {
+	ChatID        JSONInt64 `json:"chat_id"`         // Chat identifier
+	FromMessageID JSONInt64 `json:"from_message_id"` // Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+	Offset        int32     `json:"offset"`          // Specify 0 to get results from exactly the from_message_id or a negative offset up to 99 to get additionally some newer messages
+	Limit         int32     `json:"limit"`           // The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+	OnlyLocal     bool      `json:"only_local"`      // If true, returns only messages that are available locally without sending network requests
+}
+
+// MessageType return the string telegram-type of GetChatHistoryRequest
+func (getChatHistoryRequest *GetChatHistoryRequest) MessageType() string {
+	return "getChatHistory"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatHistoryRequest GetChatHistoryRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatHistoryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatHistory", alias(getChatHistoryRequest)})
+}
+
+// GetMessageThreadHistoryRequest is the request of GetMessageThreadHistory: Returns messages in a message thread of a message. Can be used only if message.can_get_message_thread == true. Message thread of a channel message is in the channel's linked supergroup.
+type GetMessageThreadHistoryRequest struct {
+	ChatID        JSONInt64 `json:"chat_id"`         // Chat identifier
+	MessageID     JSONInt64 `json:"message_id"`      // Message identifier, which thread history needs to be returned
+	FromMessageID JSONInt64 `json:"from_message_id"` // Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+	Offset        int32     `json:"offset"`          // Specify 0 to get results from exactly the from_message_id or a negative offset up to 99 to get additionally some newer messages
+	Limit         int32     `json:"limit"`           // The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than or equal to -offset. Fewer messages may be returned than specified by the limit, even if the end of the message thread history has not been reached
+}
+
+// MessageType return the string telegram-type of GetMessageThreadHistoryRequest
+func (getMessageThreadHistoryRequest *GetMessageThreadHistoryRequest) MessageType() string {
+	return "getMessageThreadHistory"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageThreadHistoryRequest GetMessageThreadHistoryRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageThreadHistoryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageThreadHistory", alias(getMessageThreadHistoryRequest)})
+}
+
+// DeleteChatHistoryRequest is the request of DeleteChatHistory: Deletes all messages in the chat. Use Chat.can_be_deleted_only_for_self and Chat.can_be_deleted_for_all_users fields to find whether and how the method can be applied to the chat
+type DeleteChatHistoryRequest struct {
+	ChatID             JSONInt64 `json:"chat_id"`               // Chat identifier
+	RemoveFromChatList bool      `json:"remove_from_chat_list"` // Pass true if the chat should be removed from the chat list
+	Revoke             bool      `json:"revoke"`                // Pass true to try to delete chat history for all users
+}
+
+// MessageType return the string telegram-type of DeleteChatHistoryRequest
+func (deleteChatHistoryRequest *DeleteChatHistoryRequest) MessageType() string {
+	return "deleteChatHistory"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteChatHistoryRequest DeleteChatHistoryRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteChatHistoryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteChatHistory", alias(deleteChatHistoryRequest)})
+}
+
+// DeleteChatRequest is the request of DeleteChat: Deletes a chat along with all messages in the corresponding chat for all chat members; requires owner privileges. For group chats this will release the username and remove all members. Chats with more than 1000 members can't be deleted using this method
+type DeleteChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of DeleteChatRequest
+func (deleteChatRequest *DeleteChatRequest) MessageType() string {
+	return "deleteChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteChatRequest DeleteChatRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteChat", alias(deleteChatRequest)})
+}
+
+// SearchChatMessagesRequest is the request of SearchChatMessages: Searches for messages with given words in the chat. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. Cannot be used in secret chats with a non-empty query
+type SearchChatMessagesRequest struct {
+	ChatID          JSONInt64            `json:"chat_id"`           // Identifier of the chat in which to search messages
+	Query           string               `json:"query"`             // Query to search for
+	Sender          MessageSender        `json:"sender"`            // If not null, only messages sent by the specified sender will be returned. Not supported in secret chats
+	FromMessageID   JSONInt64            `json:"from_message_id"`   // Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+	Offset          int32                `json:"offset"`            // Specify 0 to get results from exactly the from_message_id or a negative offset to get the specified message and some newer messages
+	Limit           int32                `json:"limit"`             // The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than -offset. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+	Filter          SearchMessagesFilter `json:"filter"`            // Filter for message content in the search results
+	MessageThreadID JSONInt64            `json:"message_thread_id"` // If not 0, only messages in the specified thread will be returned; supergroups only
+}
+
+// MessageType return the string telegram-type of SearchChatMessagesRequest
+func (searchChatMessagesRequest *SearchChatMessagesRequest) MessageType() string {
+	return "searchChatMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchChatMessagesRequest SearchChatMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchChatMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchChatMessages", alias(searchChatMessagesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchChatMessagesRequest *SearchChatMessagesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID          JSONInt64 `json:"chat_id"`           // Identifier of the chat in which to search messages
+		Query           string    `json:"query"`             // Query to search for
+		FromMessageID   JSONInt64 `json:"from_message_id"`   // Identifier of the message starting from which history must be fetched; use 0 to get results from the last message
+		Offset          int32     `json:"offset"`            // Specify 0 to get results from exactly the from_message_id or a negative offset to get the specified message and some newer messages
+		Limit           int32     `json:"limit"`             // The maximum number of messages to be returned; must be positive and can't be greater than 100. If the offset is negative, the limit must be greater than -offset. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+		MessageThreadID JSONInt64 `json:"message_thread_id"` // If not 0, only messages in the specified thread will be returned; supergroups only
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	searchChatMessagesRequest.ChatID = tempObj.ChatID
+	searchChatMessagesRequest.Query = tempObj.Query
+	searchChatMessagesRequest.FromMessageID = tempObj.FromMessageID
+	searchChatMessagesRequest.Offset = tempObj.Offset
+	searchChatMessagesRequest.Limit = tempObj.Limit
+	searchChatMessagesRequest.MessageThreadID = tempObj.MessageThreadID
+
+	fieldSender, _ := unmarshalMessageSender(objMap["sender"])
+	searchChatMessagesRequest.Sender = fieldSender
+
+	fieldFilter, _ := unmarshalSearchMessagesFilter(objMap["filter"])
+	searchChatMessagesRequest.Filter = fieldFilter
+
+	return nil
+}
+
+// SearchMessagesRequest is the request of SearchMessages: Searches for messages in all chats except secret chats. Returns the results in reverse chronological order (i.e., in order of decreasing (date, chat_id, message_id)).
+type SearchMessagesRequest struct {
+	ChatList        ChatList             `json:"chat_list"`         // Chat list in which to search messages; pass null to search in all chats regardless of their chat list
+	Query           string               `json:"query"`             // Query to search for
+	OffsetDate      int32                `json:"offset_date"`       // The date of the message starting from which the results should be fetched. Use 0 or any date in the future to get results from the last message
+	OffsetChatID    JSONInt64            `json:"offset_chat_id"`    // The chat identifier of the last found message, or 0 for the first request
+	OffsetMessageID JSONInt64            `json:"offset_message_id"` // The message identifier of the last found message, or 0 for the first request
+	Limit           int32                `json:"limit"`             // The maximum number of messages to be returned; up to 100. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+	Filter          SearchMessagesFilter `json:"filter"`            // Filter for message content in the search results; searchMessagesFilterCall, searchMessagesFilterMissedCall, searchMessagesFilterMention, searchMessagesFilterUnreadMention, searchMessagesFilterFailedToSend and searchMessagesFilterPinned are unsupported in this function
+	MinDate         int32                `json:"min_date"`          // If not 0, the minimum date of the messages to return
+	MaxDate         int32                `json:"max_date"`          // If not 0, the maximum date of the messages to return
+}
+
+// MessageType return the string telegram-type of SearchMessagesRequest
+func (searchMessagesRequest *SearchMessagesRequest) MessageType() string {
+	return "searchMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchMessagesRequest SearchMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchMessages", alias(searchMessagesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchMessagesRequest *SearchMessagesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Query           string    `json:"query"`             // Query to search for
+		OffsetDate      int32     `json:"offset_date"`       // The date of the message starting from which the results should be fetched. Use 0 or any date in the future to get results from the last message
+		OffsetChatID    JSONInt64 `json:"offset_chat_id"`    // The chat identifier of the last found message, or 0 for the first request
+		OffsetMessageID JSONInt64 `json:"offset_message_id"` // The message identifier of the last found message, or 0 for the first request
+		Limit           int32     `json:"limit"`             // The maximum number of messages to be returned; up to 100. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+		MinDate         int32     `json:"min_date"`          // If not 0, the minimum date of the messages to return
+		MaxDate         int32     `json:"max_date"`          // If not 0, the maximum date of the messages to return
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	searchMessagesRequest.Query = tempObj.Query
+	searchMessagesRequest.OffsetDate = tempObj.OffsetDate
+	searchMessagesRequest.OffsetChatID = tempObj.OffsetChatID
+	searchMessagesRequest.OffsetMessageID = tempObj.OffsetMessageID
+	searchMessagesRequest.Limit = tempObj.Limit
+	searchMessagesRequest.MinDate = tempObj.MinDate
+	searchMessagesRequest.MaxDate = tempObj.MaxDate
+
+	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
+	searchMessagesRequest.ChatList = fieldChatList
+
+	fieldFilter, _ := unmarshalSearchMessagesFilter(objMap["filter"])
+	searchMessagesRequest.Filter = fieldFilter
+
+	return nil
+}
+
+// SearchSecretMessagesRequest is the request of SearchSecretMessages: Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance the number of returned messages is chosen by the library
+type SearchSecretMessagesRequest struct {
+	ChatID JSONInt64            `json:"chat_id"` // Identifier of the chat in which to search. Specify 0 to search in all secret chats
+	Query  string               `json:"query"`   // Query to search for. If empty, searchChatMessages should be used instead
+	Offset string               `json:"offset"`  // Offset of the first entry to return as received from the previous request; use empty string to get first chunk of results
+	Limit  int32                `json:"limit"`   // The maximum number of messages to be returned; up to 100. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+	Filter SearchMessagesFilter `json:"filter"`  // A filter for message content in the search results
+}
+
+// MessageType return the string telegram-type of SearchSecretMessagesRequest
+func (searchSecretMessagesRequest *SearchSecretMessagesRequest) MessageType() string {
+	return "searchSecretMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchSecretMessagesRequest SearchSecretMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchSecretMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchSecretMessages", alias(searchSecretMessagesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchSecretMessagesRequest *SearchSecretMessagesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat in which to search. Specify 0 to search in all secret chats
+		Query  string    `json:"query"`   // Query to search for. If empty, searchChatMessages should be used instead
+		Offset string    `json:"offset"`  // Offset of the first entry to return as received from the previous request; use empty string to get first chunk of results
+		Limit  int32     `json:"limit"`   // The maximum number of messages to be returned; up to 100. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	searchSecretMessagesRequest.ChatID = tempObj.ChatID
+	searchSecretMessagesRequest.Query = tempObj.Query
+	searchSecretMessagesRequest.Offset = tempObj.Offset
+	searchSecretMessagesRequest.Limit = tempObj.Limit
+
+	fieldFilter, _ := unmarshalSearchMessagesFilter(objMap["filter"])
+	searchSecretMessagesRequest.Filter = fieldFilter
+
+	return nil
+}
+
+// SearchCallMessagesRequest is the request of SearchCallMessages: Searches for call messages. Returns the results in reverse chronological order (i. e., in order of decreasing message_id). For optimal performance the number of returned messages is chosen by the library
+type SearchCallMessagesRequest struct {
+	FromMessageID JSONInt64 `json:"from_message_id"` // Identifier of the message from which to search; use 0 to get results from the last message
+	Limit         int32     `json:"limit"`           // The maximum number of messages to be returned; up to 100. Fewer messages may be returned than specified by the limit, even if the end of the message history has not been reached
+	OnlyMissed    bool      `json:"only_missed"`     // If true, returns only messages with missed calls
+}
+
+// MessageType return the string telegram-type of SearchCallMessagesRequest
+func (searchCallMessagesRequest *SearchCallMessagesRequest) MessageType() string {
+	return "searchCallMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchCallMessagesRequest SearchCallMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchCallMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchCallMessages", alias(searchCallMessagesRequest)})
+}
+
+// DeleteAllCallMessagesRequest is the request of DeleteAllCallMessages: Deletes all call messages
+type DeleteAllCallMessagesRequest struct {
+	Revoke bool `json:"revoke"` // Pass true to delete the messages for all users
+}
+
+// MessageType return the string telegram-type of DeleteAllCallMessagesRequest
+func (deleteAllCallMessagesRequest *DeleteAllCallMessagesRequest) MessageType() string {
+	return "deleteAllCallMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteAllCallMessagesRequest DeleteAllCallMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteAllCallMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteAllCallMessages", alias(deleteAllCallMessagesRequest)})
+}
+
+// SearchChatRecentLocationMessagesRequest is the request of SearchChatRecentLocationMessages: Returns information about the recent locations of chat members that were sent to the chat. Returns up to 1 location message per user
+type SearchChatRecentLocationMessagesRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	Limit  int32     `json:"limit"`   // The maximum number of messages to be returned
+}
+
+// MessageType return the string telegram-type of SearchChatRecentLocationMessagesRequest
+func (searchChatRecentLocationMessagesRequest *SearchChatRecentLocationMessagesRequest) MessageType() string {
+	return "searchChatRecentLocationMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchChatRecentLocationMessagesRequest SearchChatRecentLocationMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchChatRecentLocationMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchChatRecentLocationMessages", alias(searchChatRecentLocationMessagesRequest)})
+}
+
+// GetActiveLiveLocationMessagesRequest is the request of GetActiveLiveLocationMessages: Returns all active live locations that should be updated by the application. The list is persistent across application restarts only if the message database is used
+type GetActiveLiveLocationMessagesRequest struct {
+}
+
+// MessageType return the string telegram-type of GetActiveLiveLocationMessagesRequest
+func (getActiveLiveLocationMessagesRequest *GetActiveLiveLocationMessagesRequest) MessageType() string {
+	return "getActiveLiveLocationMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getActiveLiveLocationMessagesRequest GetActiveLiveLocationMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetActiveLiveLocationMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getActiveLiveLocationMessages", alias(getActiveLiveLocationMessagesRequest)})
+}
+
+// GetChatMessageByDateRequest is the request of GetChatMessageByDate: Returns the last message sent in a chat no later than the specified date
+type GetChatMessageByDateRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	Date   int32     `json:"date"`    // Point in time (Unix timestamp) relative to which to search for messages
+}
+
+// MessageType return the string telegram-type of GetChatMessageByDateRequest
+func (getChatMessageByDateRequest *GetChatMessageByDateRequest) MessageType() string {
+	return "getChatMessageByDate"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatMessageByDateRequest GetChatMessageByDateRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatMessageByDateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatMessageByDate", alias(getChatMessageByDateRequest)})
+}
+
+// GetChatMessageCountRequest is the request of GetChatMessageCount: Returns approximate number of messages of the specified type in the chat
+type GetChatMessageCountRequest struct {
+	ChatID      JSONInt64            `json:"chat_id"`      // Identifier of the chat in which to count messages
+	Filter      SearchMessagesFilter `json:"filter"`       // Filter for message content; searchMessagesFilterEmpty is unsupported in this function
+	ReturnLocal bool                 `json:"return_local"` // If true, returns count that is available locally without sending network requests, returning -1 if the number of messages is unknown
+}
+
+// MessageType return the string telegram-type of GetChatMessageCountRequest
+func (getChatMessageCountRequest *GetChatMessageCountRequest) MessageType() string {
+	return "getChatMessageCount"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatMessageCountRequest GetChatMessageCountRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatMessageCountRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatMessageCount", alias(getChatMessageCountRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getChatMessageCountRequest *GetChatMessageCountRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID      JSONInt64 `json:"chat_id"`      // Identifier of the chat in which to count messages
+		ReturnLocal bool      `json:"return_local"` // If true, returns count that is available locally without sending network requests, returning -1 if the number of messages is unknown
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getChatMessageCountRequest.ChatID = tempObj.ChatID
+	getChatMessageCountRequest.ReturnLocal = tempObj.ReturnLocal
+
+	fieldFilter, _ := unmarshalSearchMessagesFilter(objMap["filter"])
+	getChatMessageCountRequest.Filter = fieldFilter
+
+	return nil
+}
+
+// GetChatScheduledMessagesRequest is the request of GetChatScheduledMessages: Returns all scheduled messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)
+type GetChatScheduledMessagesRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of GetChatScheduledMessagesRequest
+func (getChatScheduledMessagesRequest *GetChatScheduledMessagesRequest) MessageType() string {
+	return "getChatScheduledMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatScheduledMessagesRequest GetChatScheduledMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatScheduledMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatScheduledMessages", alias(getChatScheduledMessagesRequest)})
+}
+
+// GetMessagePublicForwardsRequest is the request of GetMessagePublicForwards: Returns forwarded copies of a channel message to different public channels. For optimal performance the number of returned messages is chosen by the library
+type GetMessagePublicForwardsRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier of the message
+	MessageID JSONInt64 `json:"message_id"` // Message identifier
+	Offset    string    `json:"offset"`     // Offset of the first entry to return as received from the previous request; use empty string to get first chunk of results
+	Limit     int32     `json:"limit"`      // The maximum number of messages to be returned; must be positive and can't be greater than 100. Fewer messages may be returned than specified by the limit, even if the end of the list has not been reached
+}
+
+// MessageType return the string telegram-type of GetMessagePublicForwardsRequest
+func (getMessagePublicForwardsRequest *GetMessagePublicForwardsRequest) MessageType() string {
+	return "getMessagePublicForwards"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessagePublicForwardsRequest GetMessagePublicForwardsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessagePublicForwardsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessagePublicForwards", alias(getMessagePublicForwardsRequest)})
+}
+
+// RemoveNotificationRequest is the request of RemoveNotification: Removes an active notification from notification list. Needs to be called only if the notification is removed by the current user
+type RemoveNotificationRequest struct {
+	NotificationGroupID int32 `json:"notification_group_id"` // Identifier of notification group to which the notification belongs
+	NotificationID      int32 `json:"notification_id"`       // Identifier of removed notification
+}
+
+// MessageType return the string telegram-type of RemoveNotificationRequest
+func (removeNotificationRequest *RemoveNotificationRequest) MessageType() string {
+	return "removeNotification"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeNotificationRequest RemoveNotificationRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveNotificationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeNotification", alias(removeNotificationRequest)})
+}
+
+// RemoveNotificationGroupRequest is the request of RemoveNotificationGroup: Removes a group of active notifications. Needs to be called only if the notification group is removed by the current user
+type RemoveNotificationGroupRequest struct {
+	NotificationGroupID int32 `json:"notification_group_id"` // Notification group identifier
+	MaxNotificationID   int32 `json:"max_notification_id"`   // The maximum identifier of removed notifications
+}
+
+// MessageType return the string telegram-type of RemoveNotificationGroupRequest
+func (removeNotificationGroupRequest *RemoveNotificationGroupRequest) MessageType() string {
+	return "removeNotificationGroup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeNotificationGroupRequest RemoveNotificationGroupRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveNotificationGroupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeNotificationGroup", alias(removeNotificationGroupRequest)})
+}
+
+// GetMessageLinkRequest is the request of GetMessageLink: Returns an HTTPS link to a message in a chat. Available only for already sent messages in supergroups and channels. This is an offline request
+type GetMessageLinkRequest struct {
+	ChatID     JSONInt64 `json:"chat_id"`     // Identifier of the chat to which the message belongs
+	MessageID  JSONInt64 `json:"message_id"`  // Identifier of the message
+	ForAlbum   bool      `json:"for_album"`   // Pass true to create a link for the whole media album
+	ForComment bool      `json:"for_comment"` // Pass true to create a link to the message as a channel post comment, or from a message thread
+}
+
+// MessageType return the string telegram-type of GetMessageLinkRequest
+func (getMessageLinkRequest *GetMessageLinkRequest) MessageType() string {
+	return "getMessageLink"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageLinkRequest GetMessageLinkRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageLinkRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageLink", alias(getMessageLinkRequest)})
+}
+
+// GetMessageEmbeddingCodeRequest is the request of GetMessageEmbeddingCode: Returns an HTML code for embedding the message. Available only for messages in supergroups and channels with a username
+type GetMessageEmbeddingCodeRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat to which the message belongs
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+	ForAlbum  bool      `json:"for_album"`  // Pass true to return an HTML code for embedding of the whole media album
+}
+
+// MessageType return the string telegram-type of GetMessageEmbeddingCodeRequest
+func (getMessageEmbeddingCodeRequest *GetMessageEmbeddingCodeRequest) MessageType() string {
+	return "getMessageEmbeddingCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageEmbeddingCodeRequest GetMessageEmbeddingCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageEmbeddingCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageEmbeddingCode", alias(getMessageEmbeddingCodeRequest)})
+}
+
+// GetMessageLinkInfoRequest is the request of GetMessageLinkInfo: Returns information about a public or private message link
+type GetMessageLinkInfoRequest struct {
+	URL string `json:"url"` // The message link in the format "https://t.me/c/...", or "tg://privatepost?...", or "https://t.me/username/...", or "tg://resolve?..."
+}
+
+// MessageType return the string telegram-type of GetMessageLinkInfoRequest
+func (getMessageLinkInfoRequest *GetMessageLinkInfoRequest) MessageType() string {
+	return "getMessageLinkInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageLinkInfoRequest GetMessageLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageLinkInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageLinkInfo", alias(getMessageLinkInfoRequest)})
+}
+
+// SendMessageRequest is the request of SendMessage: Sends a message. Returns the sent message
+type SendMessageRequest struct {
+	ChatID              JSONInt64           `json:"chat_id"`               // Target chat
+	MessageThreadID     JSONInt64           `json:"message_thread_id"`     // If not 0, a message thread identifier in which the message will be sent
+	ReplyToMessageID    JSONInt64           `json:"reply_to_message_id"`   // Identifier of the message to reply to or 0
+	Options             *MessageSendOptions `json:"options"`               // Options to be used to send the message
+	ReplyMarkup         ReplyMarkup         `json:"reply_markup"`          // Markup for replying to the message; for bots only
+	InputMessageContent InputMessageContent `json:"input_message_content"` // The content of the message to be sent
+}
+
+// MessageType return the string telegram-type of SendMessageRequest
+func (sendMessageRequest *SendMessageRequest) MessageType() string {
+	return "sendMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendMessageRequest SendMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias SendMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendMessage", alias(sendMessageRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (sendMessageRequest *SendMessageRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID           JSONInt64           `json:"chat_id"`             // Target chat
+		MessageThreadID  JSONInt64           `json:"message_thread_id"`   // If not 0, a message thread identifier in which the message will be sent
+		ReplyToMessageID JSONInt64           `json:"reply_to_message_id"` // Identifier of the message to reply to or 0
+		Options          *MessageSendOptions `json:"options"`             // Options to be used to send the message
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	sendMessageRequest.ChatID = tempObj.ChatID
+	sendMessageRequest.MessageThreadID = tempObj.MessageThreadID
+	sendMessageRequest.ReplyToMessageID = tempObj.ReplyToMessageID
+	sendMessageRequest.Options = tempObj.Options
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	sendMessageRequest.ReplyMarkup = fieldReplyMarkup
+
+	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
+	sendMessageRequest.InputMessageContent = fieldInputMessageContent
+
+	return nil
+}
+
+// SendMessageAlbumRequest is the request of SendMessageAlbum: Sends 2-10 messages grouped together into an album. Currently only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+type SendMessageAlbumRequest struct {
+	ChatID               JSONInt64             `json:"chat_id"`                // Target chat
+	MessageThreadID      JSONInt64             `json:"message_thread_id"`      // If not 0, a message thread identifier in which the messages will be sent
+	ReplyToMessageID     JSONInt64             `json:"reply_to_message_id"`    // Identifier of a message to reply to or 0
+	Options              *MessageSendOptions   `json:"options"`                // Options to be used to send the messages
+	InputMessageContents []InputMessageContent `json:"input_message_contents"` // Contents of messages to be sent. At most 10 messages can be added to an album
+}
+
+// MessageType return the string telegram-type of SendMessageAlbumRequest
+func (sendMessageAlbumRequest *SendMessageAlbumRequest) MessageType() string {
+	return "sendMessageAlbum"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendMessageAlbumRequest SendMessageAlbumRequest) MarshalJSON() ([]byte, error) {
+	type alias SendMessageAlbumRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendMessageAlbum", alias(sendMessageAlbumRequest)})
+}
+
+// SendBotStartMessageRequest is the request of SendBotStartMessage: Invites a bot to a chat (if it is not yet a member) and sends it the /start command. Bots can't be invited to a private chat other than the chat with the bot. Bots can't be invited to channels (although they can be added as admins) and secret chats. Returns the sent message
+type SendBotStartMessageRequest struct {
+	BotUserID int32     `json:"bot_user_id"` // Identifier of the bot
+	ChatID    JSONInt64 `json:"chat_id"`     // Identifier of the target chat
+	Parameter string    `json:"parameter"`   // A hidden parameter sent to the bot for deep linking purposes (https://core.telegram.org/bots#deep-linking)
+}
+
+// MessageType return the string telegram-type of SendBotStartMessageRequest
+func (sendBotStartMessageRequest *SendBotStartMessageRequest) MessageType() string {
+	return "sendBotStartMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendBotStartMessageRequest SendBotStartMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias SendBotStartMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendBotStartMessage", alias(sendBotStartMessageRequest)})
+}
+
+// SendInlineQueryResultMessageRequest is the request of SendInlineQueryResultMessage: Sends the result of an inline query as a message. Returns the sent message. Always clears a chat draft message
+type SendInlineQueryResultMessageRequest struct {
+	ChatID           JSONInt64           `json:"chat_id"`             // Target chat
+	MessageThreadID  JSONInt64           `json:"message_thread_id"`   // If not 0, a message thread identifier in which the message will be sent
+	ReplyToMessageID JSONInt64           `json:"reply_to_message_id"` // Identifier of a message to reply to or 0
+	Options          *MessageSendOptions `json:"options"`             // Options to be used to send the message
+	QueryID          JSONInt64           `json:"query_id"`            // Identifier of the inline query
+	ResultID         string              `json:"result_id"`           // Identifier of the inline result
+	HideViaBot       bool                `json:"hide_via_bot"`        // If true, there will be no mention of a bot, via which the message is sent. Can be used only for bots GetOption("animation_search_bot_username"), GetOption("photo_search_bot_username") and GetOption("venue_search_bot_username")
+}
+
+// MessageType return the string telegram-type of SendInlineQueryResultMessageRequest
+func (sendInlineQueryResultMessageRequest *SendInlineQueryResultMessageRequest) MessageType() string {
+	return "sendInlineQueryResultMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendInlineQueryResultMessageRequest SendInlineQueryResultMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias SendInlineQueryResultMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendInlineQueryResultMessage", alias(sendInlineQueryResultMessageRequest)})
+}
+
+// ForwardMessagesRequest is the request of ForwardMessages: Forwards previously sent messages. Returns the forwarded messages in the same order as the message identifiers passed in message_ids. If a message can't be forwarded, null will be returned instead of the message
+type ForwardMessagesRequest struct {
+	ChatID        JSONInt64           `json:"chat_id"`        // Identifier of the chat to which to forward messages
+	FromChatID    JSONInt64           `json:"from_chat_id"`   // Identifier of the chat from which to forward messages
+	MessageIDs    []JSONInt64         `json:"message_ids"`    // Identifiers of the messages to forward. Message identifiers must be in a strictly increasing order. At most 100 messages can be forwarded simultaneously
+	Options       *MessageSendOptions `json:"options"`        // Options to be used to send the messages
+	SendCopy      bool                `json:"send_copy"`      // True, if content of the messages needs to be copied without links to the original messages. Always true if the messages are forwarded to a secret chat
+	RemoveCaption bool                `json:"remove_caption"` // True, if media caption of message copies needs to be removed. Ignored if send_copy is false
+}
+
+// MessageType return the string telegram-type of ForwardMessagesRequest
+func (forwardMessagesRequest *ForwardMessagesRequest) MessageType() string {
+	return "forwardMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (forwardMessagesRequest ForwardMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias ForwardMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"forwardMessages", alias(forwardMessagesRequest)})
+}
+
+// ResendMessagesRequest is the request of ResendMessages: Resends messages which failed to send. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed.
+type ResendMessagesRequest struct {
+	ChatID     JSONInt64   `json:"chat_id"`     // Identifier of the chat to send messages
+	MessageIDs []JSONInt64 `json:"message_ids"` // Identifiers of the messages to resend. Message identifiers must be in a strictly increasing order
+}
+
+// MessageType return the string telegram-type of ResendMessagesRequest
+func (resendMessagesRequest *ResendMessagesRequest) MessageType() string {
+	return "resendMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendMessagesRequest ResendMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendMessages", alias(resendMessagesRequest)})
+}
+
+// SendChatSetTTLMessageRequest is the request of SendChatSetTTLMessage: Changes the current TTL setting (sets a new self-destruct timer) in a secret chat and sends the corresponding message
+type SendChatSetTTLMessageRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	TTL    int32     `json:"ttl"`     // New TTL value, in seconds
+}
+
+// MessageType return the string telegram-type of SendChatSetTTLMessageRequest
+func (sendChatSetTTLMessageRequest *SendChatSetTTLMessageRequest) MessageType() string {
+	return "sendChatSetTtlMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendChatSetTTLMessageRequest SendChatSetTTLMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias SendChatSetTTLMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendChatSetTtlMessage", alias(sendChatSetTTLMessageRequest)})
+}
+
+// SendChatScreenshotTakenNotificationRequest is the request of SendChatScreenshotTakenNotification: Sends a notification about a screenshot taken in a chat. Supported only in private and secret chats
+type SendChatScreenshotTakenNotificationRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of SendChatScreenshotTakenNotificationRequest
+func (sendChatScreenshotTakenNotificationRequest *SendChatScreenshotTakenNotificationRequest) MessageType() string {
+	return "sendChatScreenshotTakenNotification"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendChatScreenshotTakenNotificationRequest SendChatScreenshotTakenNotificationRequest) MarshalJSON() ([]byte, error) {
+	type alias SendChatScreenshotTakenNotificationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendChatScreenshotTakenNotification", alias(sendChatScreenshotTakenNotificationRequest)})
+}
+
+// AddLocalMessageRequest is the request of AddLocalMessage: Adds a local message to a chat. The message is persistent across application restarts only if the message database is used. Returns the added message
+type AddLocalMessageRequest struct {
+	ChatID              JSONInt64           `json:"chat_id"`               // Target chat
+	Sender              MessageSender       `json:"sender"`                // The sender sender of the message
+	ReplyToMessageID    JSONInt64           `json:"reply_to_message_id"`   // Identifier of the message to reply to or 0
+	DisableNotification bool                `json:"disable_notification"`  // Pass true to disable notification for the message
+	InputMessageContent InputMessageContent `json:"input_message_content"` // The content of the message to be added
+}
+
+// MessageType return the string telegram-type of AddLocalMessageRequest
+func (addLocalMessageRequest *AddLocalMessageRequest) MessageType() string {
+	return "addLocalMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addLocalMessageRequest AddLocalMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias AddLocalMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addLocalMessage", alias(addLocalMessageRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addLocalMessageRequest *AddLocalMessageRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID              JSONInt64 `json:"chat_id"`              // Target chat
+		ReplyToMessageID    JSONInt64 `json:"reply_to_message_id"`  // Identifier of the message to reply to or 0
+		DisableNotification bool      `json:"disable_notification"` // Pass true to disable notification for the message
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	addLocalMessageRequest.ChatID = tempObj.ChatID
+	addLocalMessageRequest.ReplyToMessageID = tempObj.ReplyToMessageID
+	addLocalMessageRequest.DisableNotification = tempObj.DisableNotification
+
+	fieldSender, _ := unmarshalMessageSender(objMap["sender"])
+	addLocalMessageRequest.Sender = fieldSender
+
+	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
+	addLocalMessageRequest.InputMessageContent = fieldInputMessageContent
+
+	return nil
+}
+
+// DeleteMessagesRequest is the request of DeleteMessages: Deletes messages
+type DeleteMessagesRequest struct {
+	ChatID     JSONInt64   `json:"chat_id"`     // Chat identifier
+	MessageIDs []JSONInt64 `json:"message_ids"` // Identifiers of the messages to be deleted
+	Revoke     bool        `json:"revoke"`      // Pass true to try to delete messages for all chat members. Always true for supergroups, channels and secret chats
+}
+
+// MessageType return the string telegram-type of DeleteMessagesRequest
+func (deleteMessagesRequest *DeleteMessagesRequest) MessageType() string {
+	return "deleteMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteMessagesRequest DeleteMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteMessages", alias(deleteMessagesRequest)})
+}
+
+// DeleteChatMessagesFromUserRequest is the request of DeleteChatMessagesFromUser: Deletes all messages sent by the specified user to a chat. Supported only for supergroups; requires can_delete_messages administrator privileges
+type DeleteChatMessagesFromUserRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	UserID int32     `json:"user_id"` // User identifier
+}
+
+// MessageType return the string telegram-type of DeleteChatMessagesFromUserRequest
+func (deleteChatMessagesFromUserRequest *DeleteChatMessagesFromUserRequest) MessageType() string {
+	return "deleteChatMessagesFromUser"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteChatMessagesFromUserRequest DeleteChatMessagesFromUserRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteChatMessagesFromUserRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteChatMessagesFromUser", alias(deleteChatMessagesFromUserRequest)})
+}
+
+// EditMessageTextRequest is the request of EditMessageText: Edits the text of a message (or a text of a game message). Returns the edited message after the edit is completed on the server side
+type EditMessageTextRequest struct {
+	ChatID              JSONInt64           `json:"chat_id"`               // The chat the message belongs to
+	MessageID           JSONInt64           `json:"message_id"`            // Identifier of the message
+	ReplyMarkup         ReplyMarkup         `json:"reply_markup"`          // The new message reply markup; for bots only
+	InputMessageContent InputMessageContent `json:"input_message_content"` // New text content of the message. Should be of type InputMessageText
+}
+
+// MessageType return the string telegram-type of EditMessageTextRequest
+func (editMessageTextRequest *EditMessageTextRequest) MessageType() string {
+	return "editMessageText"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editMessageTextRequest EditMessageTextRequest) MarshalJSON() ([]byte, error) {
+	type alias EditMessageTextRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editMessageText", alias(editMessageTextRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editMessageTextRequest *EditMessageTextRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64 `json:"chat_id"`    // The chat the message belongs to
+		MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editMessageTextRequest.ChatID = tempObj.ChatID
+	editMessageTextRequest.MessageID = tempObj.MessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editMessageTextRequest.ReplyMarkup = fieldReplyMarkup
+
+	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
+	editMessageTextRequest.InputMessageContent = fieldInputMessageContent
+
+	return nil
+}
+
+// EditMessageLiveLocationRequest is the request of EditMessageLiveLocation: Edits the message content of a live location. Messages can be edited for a limited period of time specified in the live location. Returns the edited message after the edit is completed on the server side
+type EditMessageLiveLocationRequest struct {
+	ChatID               JSONInt64   `json:"chat_id"`                // The chat the message belongs to
+	MessageID            JSONInt64   `json:"message_id"`             // Identifier of the message
+	ReplyMarkup          ReplyMarkup `json:"reply_markup"`           // The new message reply markup; for bots only
+	Location             *Location   `json:"location"`               // New location content of the message; may be null. Pass null to stop sharing the live location
+	Heading              int32       `json:"heading"`                // The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+	ProximityAlertRadius int32       `json:"proximity_alert_radius"` // The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+}
+
+// MessageType return the string telegram-type of EditMessageLiveLocationRequest
+func (editMessageLiveLocationRequest *EditMessageLiveLocationRequest) MessageType() string {
+	return "editMessageLiveLocation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editMessageLiveLocationRequest EditMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	type alias EditMessageLiveLocationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editMessageLiveLocation", alias(editMessageLiveLocationRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editMessageLiveLocationRequest *EditMessageLiveLocationRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID               JSONInt64 `json:"chat_id"`                // The chat the message belongs to
+		MessageID            JSONInt64 `json:"message_id"`             // Identifier of the message
+		Location             *Location `json:"location"`               // New location content of the message; may be null. Pass null to stop sharing the live location
+		Heading              int32     `json:"heading"`                // The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+		ProximityAlertRadius int32     `json:"proximity_alert_radius"` // The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editMessageLiveLocationRequest.ChatID = tempObj.ChatID
+	editMessageLiveLocationRequest.MessageID = tempObj.MessageID
+	editMessageLiveLocationRequest.Location = tempObj.Location
+	editMessageLiveLocationRequest.Heading = tempObj.Heading
+	editMessageLiveLocationRequest.ProximityAlertRadius = tempObj.ProximityAlertRadius
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editMessageLiveLocationRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// EditMessageMediaRequest is the request of EditMessageMedia: Edits the content of a message with an animation, an audio, a document, a photo or a video. The media in the message can't be replaced if the message was set to self-destruct. Media can't be replaced by self-destructing media. Media in an album can be edited only to contain a photo or a video. Returns the edited message after the edit is completed on the server side
+type EditMessageMediaRequest struct {
+	ChatID              JSONInt64           `json:"chat_id"`               // The chat the message belongs to
+	MessageID           JSONInt64           `json:"message_id"`            // Identifier of the message
+	ReplyMarkup         ReplyMarkup         `json:"reply_markup"`          // The new message reply markup; for bots only
+	InputMessageContent InputMessageContent `json:"input_message_content"` // New content of the message. Must be one of the following types: InputMessageAnimation, InputMessageAudio, InputMessageDocument, InputMessagePhoto or InputMessageVideo
+}
+
+// MessageType return the string telegram-type of EditMessageMediaRequest
+func (editMessageMediaRequest *EditMessageMediaRequest) MessageType() string {
+	return "editMessageMedia"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editMessageMediaRequest EditMessageMediaRequest) MarshalJSON() ([]byte, error) {
+	type alias EditMessageMediaRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editMessageMedia", alias(editMessageMediaRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editMessageMediaRequest *EditMessageMediaRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64 `json:"chat_id"`    // The chat the message belongs to
+		MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editMessageMediaRequest.ChatID = tempObj.ChatID
+	editMessageMediaRequest.MessageID = tempObj.MessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editMessageMediaRequest.ReplyMarkup = fieldReplyMarkup
+
+	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
+	editMessageMediaRequest.InputMessageContent = fieldInputMessageContent
+
+	return nil
+}
+
+// EditMessageCaptionRequest is the request of EditMessageCaption: Edits the message content caption. Returns the edited message after the edit is completed on the server side
+type EditMessageCaptionRequest struct {
+	ChatID      JSONInt64      `json:"chat_id"`      // The chat the message belongs to
+	MessageID   JSONInt64      `json:"message_id"`   // Identifier of the message
+	ReplyMarkup ReplyMarkup    `json:"reply_markup"` // The new message reply markup; for bots only
+	Caption     *FormattedText `json:"caption"`      // New message content caption; 0-GetOption("message_caption_length_max") characters
+}
+
+// MessageType return the string telegram-type of EditMessageCaptionRequest
+func (editMessageCaptionRequest *EditMessageCaptionRequest) MessageType() string {
+	return "editMessageCaption"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editMessageCaptionRequest EditMessageCaptionRequest) MarshalJSON() ([]byte, error) {
+	type alias EditMessageCaptionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editMessageCaption", alias(editMessageCaptionRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editMessageCaptionRequest *EditMessageCaptionRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64      `json:"chat_id"`    // The chat the message belongs to
+		MessageID JSONInt64      `json:"message_id"` // Identifier of the message
+		Caption   *FormattedText `json:"caption"`    // New message content caption; 0-GetOption("message_caption_length_max") characters
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editMessageCaptionRequest.ChatID = tempObj.ChatID
+	editMessageCaptionRequest.MessageID = tempObj.MessageID
+	editMessageCaptionRequest.Caption = tempObj.Caption
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editMessageCaptionRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// EditMessageReplyMarkupRequest is the request of EditMessageReplyMarkup: Edits the message reply markup; for bots only. Returns the edited message after the edit is completed on the server side
+type EditMessageReplyMarkupRequest struct {
+	ChatID      JSONInt64   `json:"chat_id"`      // The chat the message belongs to
+	MessageID   JSONInt64   `json:"message_id"`   // Identifier of the message
+	ReplyMarkup ReplyMarkup `json:"reply_markup"` // The new message reply markup
+}
+
+// MessageType return the string telegram-type of EditMessageReplyMarkupRequest
+func (editMessageReplyMarkupRequest *EditMessageReplyMarkupRequest) MessageType() string {
+	return "editMessageReplyMarkup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editMessageReplyMarkupRequest EditMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	type alias EditMessageReplyMarkupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editMessageReplyMarkup", alias(editMessageReplyMarkupRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editMessageReplyMarkupRequest *EditMessageReplyMarkupRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64 `json:"chat_id"`    // The chat the message belongs to
+		MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editMessageReplyMarkupRequest.ChatID = tempObj.ChatID
+	editMessageReplyMarkupRequest.MessageID = tempObj.MessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editMessageReplyMarkupRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// EditInlineMessageTextRequest is the request of EditInlineMessageText: Edits the text of an inline text or game message sent via a bot; for bots only
+type EditInlineMessageTextRequest struct {
+	InlineMessageID     string              `json:"inline_message_id"`     // Inline message identifier
+	ReplyMarkup         ReplyMarkup         `json:"reply_markup"`          // The new message reply markup
+	InputMessageContent InputMessageContent `json:"input_message_content"` // New text content of the message. Should be of type InputMessageText
+}
+
+// MessageType return the string telegram-type of EditInlineMessageTextRequest
+func (editInlineMessageTextRequest *EditInlineMessageTextRequest) MessageType() string {
+	return "editInlineMessageText"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editInlineMessageTextRequest EditInlineMessageTextRequest) MarshalJSON() ([]byte, error) {
+	type alias EditInlineMessageTextRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editInlineMessageText", alias(editInlineMessageTextRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editInlineMessageTextRequest *EditInlineMessageTextRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		InlineMessageID string `json:"inline_message_id"` // Inline message identifier
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editInlineMessageTextRequest.InlineMessageID = tempObj.InlineMessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editInlineMessageTextRequest.ReplyMarkup = fieldReplyMarkup
+
+	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
+	editInlineMessageTextRequest.InputMessageContent = fieldInputMessageContent
+
+	return nil
+}
+
+// EditInlineMessageLiveLocationRequest is the request of EditInlineMessageLiveLocation: Edits the content of a live location in an inline message sent via a bot; for bots only
+type EditInlineMessageLiveLocationRequest struct {
+	InlineMessageID      string      `json:"inline_message_id"`      // Inline message identifier
+	ReplyMarkup          ReplyMarkup `json:"reply_markup"`           // The new message reply markup
+	Location             *Location   `json:"location"`               // New location content of the message; may be null. Pass null to stop sharing the live location
+	Heading              int32       `json:"heading"`                // The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+	ProximityAlertRadius int32       `json:"proximity_alert_radius"` // The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+}
+
+// MessageType return the string telegram-type of EditInlineMessageLiveLocationRequest
+func (editInlineMessageLiveLocationRequest *EditInlineMessageLiveLocationRequest) MessageType() string {
+	return "editInlineMessageLiveLocation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editInlineMessageLiveLocationRequest EditInlineMessageLiveLocationRequest) MarshalJSON() ([]byte, error) {
+	type alias EditInlineMessageLiveLocationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editInlineMessageLiveLocation", alias(editInlineMessageLiveLocationRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editInlineMessageLiveLocationRequest *EditInlineMessageLiveLocationRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		InlineMessageID      string    `json:"inline_message_id"`      // Inline message identifier
+		Location             *Location `json:"location"`               // New location content of the message; may be null. Pass null to stop sharing the live location
+		Heading              int32     `json:"heading"`                // The new direction in which the location moves, in degrees; 1-360. Pass 0 if unknown
+		ProximityAlertRadius int32     `json:"proximity_alert_radius"` // The new maximum distance for proximity alerts, in meters (0-100000). Pass 0 if the notification is disabled
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editInlineMessageLiveLocationRequest.InlineMessageID = tempObj.InlineMessageID
+	editInlineMessageLiveLocationRequest.Location = tempObj.Location
+	editInlineMessageLiveLocationRequest.Heading = tempObj.Heading
+	editInlineMessageLiveLocationRequest.ProximityAlertRadius = tempObj.ProximityAlertRadius
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editInlineMessageLiveLocationRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// EditInlineMessageMediaRequest is the request of EditInlineMessageMedia: Edits the content of a message with an animation, an audio, a document, a photo or a video in an inline message sent via a bot; for bots only
+type EditInlineMessageMediaRequest struct {
+	InlineMessageID     string              `json:"inline_message_id"`     // Inline message identifier
+	ReplyMarkup         ReplyMarkup         `json:"reply_markup"`          // The new message reply markup; for bots only
+	InputMessageContent InputMessageContent `json:"input_message_content"` // New content of the message. Must be one of the following types: InputMessageAnimation, InputMessageAudio, InputMessageDocument, InputMessagePhoto or InputMessageVideo
+}
+
+// MessageType return the string telegram-type of EditInlineMessageMediaRequest
+func (editInlineMessageMediaRequest *EditInlineMessageMediaRequest) MessageType() string {
+	return "editInlineMessageMedia"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editInlineMessageMediaRequest EditInlineMessageMediaRequest) MarshalJSON() ([]byte, error) {
+	type alias EditInlineMessageMediaRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editInlineMessageMedia", alias(editInlineMessageMediaRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editInlineMessageMediaRequest *EditInlineMessageMediaRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		InlineMessageID string `json:"inline_message_id"` // Inline message identifier
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editInlineMessageMediaRequest.InlineMessageID = tempObj.InlineMessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editInlineMessageMediaRequest.ReplyMarkup = fieldReplyMarkup
+
+	fieldInputMessageContent, _ := unmarshalInputMessageContent(objMap["input_message_content"])
+	editInlineMessageMediaRequest.InputMessageContent = fieldInputMessageContent
+
+	return nil
+}
+
+// EditInlineMessageCaptionRequest is the request of EditInlineMessageCaption: Edits the caption of an inline message sent via a bot; for bots only
+type EditInlineMessageCaptionRequest struct {
+	InlineMessageID string         `json:"inline_message_id"` // Inline message identifier
+	ReplyMarkup     ReplyMarkup    `json:"reply_markup"`      // The new message reply markup
+	Caption         *FormattedText `json:"caption"`           // New message content caption; 0-GetOption("message_caption_length_max") characters
+}
+
+// MessageType return the string telegram-type of EditInlineMessageCaptionRequest
+func (editInlineMessageCaptionRequest *EditInlineMessageCaptionRequest) MessageType() string {
+	return "editInlineMessageCaption"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editInlineMessageCaptionRequest EditInlineMessageCaptionRequest) MarshalJSON() ([]byte, error) {
+	type alias EditInlineMessageCaptionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editInlineMessageCaption", alias(editInlineMessageCaptionRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editInlineMessageCaptionRequest *EditInlineMessageCaptionRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		InlineMessageID string         `json:"inline_message_id"` // Inline message identifier
+		Caption         *FormattedText `json:"caption"`           // New message content caption; 0-GetOption("message_caption_length_max") characters
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editInlineMessageCaptionRequest.InlineMessageID = tempObj.InlineMessageID
+	editInlineMessageCaptionRequest.Caption = tempObj.Caption
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editInlineMessageCaptionRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// EditInlineMessageReplyMarkupRequest is the request of EditInlineMessageReplyMarkup: Edits the reply markup of an inline message sent via a bot; for bots only
+type EditInlineMessageReplyMarkupRequest struct {
+	InlineMessageID string      `json:"inline_message_id"` // Inline message identifier
+	ReplyMarkup     ReplyMarkup `json:"reply_markup"`      // The new message reply markup
+}
+
+// MessageType return the string telegram-type of EditInlineMessageReplyMarkupRequest
+func (editInlineMessageReplyMarkupRequest *EditInlineMessageReplyMarkupRequest) MessageType() string {
+	return "editInlineMessageReplyMarkup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editInlineMessageReplyMarkupRequest EditInlineMessageReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	type alias EditInlineMessageReplyMarkupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editInlineMessageReplyMarkup", alias(editInlineMessageReplyMarkupRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editInlineMessageReplyMarkupRequest *EditInlineMessageReplyMarkupRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		InlineMessageID string `json:"inline_message_id"` // Inline message identifier
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editInlineMessageReplyMarkupRequest.InlineMessageID = tempObj.InlineMessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	editInlineMessageReplyMarkupRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// EditMessageSchedulingStateRequest is the request of EditMessageSchedulingState: Edits the time when a scheduled message will be sent. Scheduling state of all messages in the same album or forwarded together with the message will be also changed
+type EditMessageSchedulingStateRequest struct {
+	ChatID          JSONInt64              `json:"chat_id"`          // The chat the message belongs to
+	MessageID       JSONInt64              `json:"message_id"`       // Identifier of the message
+	SchedulingState MessageSchedulingState `json:"scheduling_state"` // The new message scheduling state. Pass null to send the message immediately
+}
+
+// MessageType return the string telegram-type of EditMessageSchedulingStateRequest
+func (editMessageSchedulingStateRequest *EditMessageSchedulingStateRequest) MessageType() string {
+	return "editMessageSchedulingState"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editMessageSchedulingStateRequest EditMessageSchedulingStateRequest) MarshalJSON() ([]byte, error) {
+	type alias EditMessageSchedulingStateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editMessageSchedulingState", alias(editMessageSchedulingStateRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editMessageSchedulingStateRequest *EditMessageSchedulingStateRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64 `json:"chat_id"`    // The chat the message belongs to
+		MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editMessageSchedulingStateRequest.ChatID = tempObj.ChatID
+	editMessageSchedulingStateRequest.MessageID = tempObj.MessageID
+
+	fieldSchedulingState, _ := unmarshalMessageSchedulingState(objMap["scheduling_state"])
+	editMessageSchedulingStateRequest.SchedulingState = fieldSchedulingState
+
+	return nil
+}
+
+// GetTextEntitiesRequest is the request of GetTextEntities: Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) contained in the text. Can be called synchronously
+type GetTextEntitiesRequest struct {
+	Text string `json:"text"` // The text in which to look for entites
+}
+
+// MessageType return the string telegram-type of GetTextEntitiesRequest
+func (getTextEntitiesRequest *GetTextEntitiesRequest) MessageType() string {
+	return "getTextEntities"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getTextEntitiesRequest GetTextEntitiesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetTextEntitiesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getTextEntities", alias(getTextEntitiesRequest)})
+}
+
+// ParseTextEntitiesRequest is the request of ParseTextEntities: Parses Bold, Italic, Underline, Strikethrough, Code, Pre, PreCode, TextUrl and MentionName entities contained in the text. Can be called synchronously
+type ParseTextEntitiesRequest struct {
+	Text      string        `json:"text"`       // The text to parse
+	ParseMode TextParseMode `json:"parse_mode"` // Text parse mode
+}
+
+// MessageType return the string telegram-type of ParseTextEntitiesRequest
+func (parseTextEntitiesRequest *ParseTextEntitiesRequest) MessageType() string {
+	return "parseTextEntities"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (parseTextEntitiesRequest ParseTextEntitiesRequest) MarshalJSON() ([]byte, error) {
+	type alias ParseTextEntitiesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"parseTextEntities", alias(parseTextEntitiesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (parseTextEntitiesRequest *ParseTextEntitiesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Text string `json:"text"` // The text to parse
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	parseTextEntitiesRequest.Text = tempObj.Text
+
+	fieldParseMode, _ := unmarshalTextParseMode(objMap["parse_mode"])
+	parseTextEntitiesRequest.ParseMode = fieldParseMode
+
+	return nil
+}
+
+// ParseMarkdownRequest is the request of ParseMarkdown: Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously
+type ParseMarkdownRequest struct {
+	Text *FormattedText `json:"text"` // The text to parse. For example, "__italic__ ~~strikethrough~~ **bold** `code` ```pre``` __[italic__ text_url](telegram.org) __italic**bold italic__bold**"
+}
+
+// MessageType return the string telegram-type of ParseMarkdownRequest
+func (parseMarkdownRequest *ParseMarkdownRequest) MessageType() string {
+	return "parseMarkdown"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (parseMarkdownRequest ParseMarkdownRequest) MarshalJSON() ([]byte, error) {
+	type alias ParseMarkdownRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"parseMarkdown", alias(parseMarkdownRequest)})
+}
+
+// GetMarkdownTextRequest is the request of GetMarkdownText: Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously
+type GetMarkdownTextRequest struct {
+	Text *FormattedText `json:"text"` // The text
+}
+
+// MessageType return the string telegram-type of GetMarkdownTextRequest
+func (getMarkdownTextRequest *GetMarkdownTextRequest) MessageType() string {
+	return "getMarkdownText"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMarkdownTextRequest GetMarkdownTextRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMarkdownTextRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMarkdownText", alias(getMarkdownTextRequest)})
+}
+
+// GetFileMimeTypeRequest is the request of GetFileMimeType: Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously
+type GetFileMimeTypeRequest struct {
+	FileName string `json:"file_name"` // The name of the file or path to the file
+}
+
+// MessageType return the string telegram-type of GetFileMimeTypeRequest
+func (getFileMimeTypeRequest *GetFileMimeTypeRequest) MessageType() string {
+	return "getFileMimeType"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getFileMimeTypeRequest GetFileMimeTypeRequest) MarshalJSON() ([]byte, error) {
+	type alias GetFileMimeTypeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getFileMimeType", alias(getFileMimeTypeRequest)})
+}
+
+// GetFileExtensionRequest is the request of GetFileExtension: Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously
+type GetFileExtensionRequest struct {
+	MimeType string `json:"mime_type"` // The MIME type of the file
+}
+
+// MessageType return the string telegram-type of GetFileExtensionRequest
+func (getFileExtensionRequest *GetFileExtensionRequest) MessageType() string {
+	return "getFileExtension"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getFileExtensionRequest GetFileExtensionRequest) MarshalJSON() ([]byte, error) {
+	type alias GetFileExtensionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getFileExtension", alias(getFileExtensionRequest)})
+}
+
+// CleanFileNameRequest is the request of CleanFileName: Removes potentially dangerous characters from the name of a file. The encoding of the file name is supposed to be UTF-8. Returns an empty string on failure. Can be called synchronously
+type CleanFileNameRequest struct {
+	FileName string `json:"file_name"` // File name or path to the file
+}
+
+// MessageType return the string telegram-type of CleanFileNameRequest
+func (cleanFileNameRequest *CleanFileNameRequest) MessageType() string {
+	return "cleanFileName"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (cleanFileNameRequest CleanFileNameRequest) MarshalJSON() ([]byte, error) {
+	type alias CleanFileNameRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"cleanFileName", alias(cleanFileNameRequest)})
+}
+
+// GetLanguagePackStringRequest is the request of GetLanguagePackString: Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
+type GetLanguagePackStringRequest struct {
+	LanguagePackDatabasePath string `json:"language_pack_database_path"` // Path to the language pack database in which strings are stored
+	LocalizationTarget       string `json:"localization_target"`         // Localization target to which the language pack belongs
+	LanguagePackID           string `json:"language_pack_id"`            // Language pack identifier
+	Key                      string `json:"key"`                         // Language pack key of the string to be returned
+}
+
+// MessageType return the string telegram-type of GetLanguagePackStringRequest
+func (getLanguagePackStringRequest *GetLanguagePackStringRequest) MessageType() string {
+	return "getLanguagePackString"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLanguagePackStringRequest GetLanguagePackStringRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLanguagePackStringRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLanguagePackString", alias(getLanguagePackStringRequest)})
+}
+
+// GetJsonValueRequest is the request of GetJsonValue: Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
+type GetJsonValueRequest struct {
+	Json string `json:"json"` // The JSON-serialized string
+}
+
+// MessageType return the string telegram-type of GetJsonValueRequest
+func (getJsonValueRequest *GetJsonValueRequest) MessageType() string {
+	return "getJsonValue"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getJsonValueRequest GetJsonValueRequest) MarshalJSON() ([]byte, error) {
+	type alias GetJsonValueRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getJsonValue", alias(getJsonValueRequest)})
+}
+
+// GetJsonStringRequest is the request of GetJsonString: Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously
+type GetJsonStringRequest struct {
+	JsonValue JsonValue `json:"json_value"` // The JsonValue object
+}
+
+// MessageType return the string telegram-type of GetJsonStringRequest
+func (getJsonStringRequest *GetJsonStringRequest) MessageType() string {
+	return "getJsonString"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getJsonStringRequest GetJsonStringRequest) MarshalJSON() ([]byte, error) {
+	type alias GetJsonStringRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getJsonString", alias(getJsonStringRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getJsonStringRequest *GetJsonStringRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldJsonValue, _ := unmarshalJsonValue(objMap["json_value"])
+	getJsonStringRequest.JsonValue = fieldJsonValue
+
+	return nil
+}
+
+// SetPollAnswerRequest is the request of SetPollAnswer: Changes the user answer to a poll. A poll in quiz mode can be answered only once
+type SetPollAnswerRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat to which the poll belongs
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message containing the poll
+	OptionIDs []int32   `json:"option_ids"` // 0-based identifiers of answer options, chosen by the user. User can choose more than 1 answer option only is the poll allows multiple answers
+}
+
+// MessageType return the string telegram-type of SetPollAnswerRequest
+func (setPollAnswerRequest *SetPollAnswerRequest) MessageType() string {
+	return "setPollAnswer"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setPollAnswerRequest SetPollAnswerRequest) MarshalJSON() ([]byte, error) {
+	type alias SetPollAnswerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setPollAnswer", alias(setPollAnswerRequest)})
+}
+
+// GetPollVotersRequest is the request of GetPollVoters: Returns users voted for the specified option in a non-anonymous polls. For the optimal performance the number of returned users is chosen by the library
+type GetPollVotersRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat to which the poll belongs
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message containing the poll
+	OptionID  int32     `json:"option_id"`  // 0-based identifier of the answer option
+	Offset    int32     `json:"offset"`     // Number of users to skip in the result; must be non-negative
+	Limit     int32     `json:"limit"`      // The maximum number of users to be returned; must be positive and can't be greater than 50. Fewer users may be returned than specified by the limit, even if the end of the voter list has not been reached
+}
+
+// MessageType return the string telegram-type of GetPollVotersRequest
+func (getPollVotersRequest *GetPollVotersRequest) MessageType() string {
+	return "getPollVoters"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPollVotersRequest GetPollVotersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPollVotersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPollVoters", alias(getPollVotersRequest)})
+}
+
+// StopPollRequest is the request of StopPoll: Stops a poll. A poll in a message can be stopped when the message has can_be_edited flag set
+type StopPollRequest struct {
+	ChatID      JSONInt64   `json:"chat_id"`      // Identifier of the chat to which the poll belongs
+	MessageID   JSONInt64   `json:"message_id"`   // Identifier of the message containing the poll
+	ReplyMarkup ReplyMarkup `json:"reply_markup"` // The new message reply markup; for bots only
+}
+
+// MessageType return the string telegram-type of StopPollRequest
+func (stopPollRequest *StopPollRequest) MessageType() string {
+	return "stopPoll"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (stopPollRequest StopPollRequest) MarshalJSON() ([]byte, error) {
+	type alias StopPollRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"stopPoll", alias(stopPollRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (stopPollRequest *StopPollRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat to which the poll belongs
+		MessageID JSONInt64 `json:"message_id"` // Identifier of the message containing the poll
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	stopPollRequest.ChatID = tempObj.ChatID
+	stopPollRequest.MessageID = tempObj.MessageID
+
+	fieldReplyMarkup, _ := unmarshalReplyMarkup(objMap["reply_markup"])
+	stopPollRequest.ReplyMarkup = fieldReplyMarkup
+
+	return nil
+}
+
+// HideSuggestedActionRequest is the request of HideSuggestedAction: Hides a suggested action
+type HideSuggestedActionRequest struct {
+	Action SuggestedAction `json:"action"` // Suggested action to hide
+}
+
+// MessageType return the string telegram-type of HideSuggestedActionRequest
+func (hideSuggestedActionRequest *HideSuggestedActionRequest) MessageType() string {
+	return "hideSuggestedAction"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (hideSuggestedActionRequest HideSuggestedActionRequest) MarshalJSON() ([]byte, error) {
+	type alias HideSuggestedActionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"hideSuggestedAction", alias(hideSuggestedActionRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (hideSuggestedActionRequest *HideSuggestedActionRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldAction, _ := unmarshalSuggestedAction(objMap["action"])
+	hideSuggestedActionRequest.Action = fieldAction
+
+	return nil
+}
+
+// GetLoginURLInfoRequest is the request of GetLoginURLInfo: Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button
+type GetLoginURLInfoRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier of the message with the button
+	MessageID JSONInt64 `json:"message_id"` // Message identifier of the message with the button
+	ButtonID  int32     `json:"button_id"`  // Button identifier
+}
+
+// MessageType return the string telegram-type of GetLoginURLInfoRequest
+func (getLoginURLInfoRequest *GetLoginURLInfoRequest) MessageType() string {
+	return "getLoginUrlInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLoginURLInfoRequest GetLoginURLInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLoginURLInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLoginUrlInfo", alias(getLoginURLInfoRequest)})
+}
+
+// GetLoginURLRequest is the request of GetLoginURL: Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl.
+type GetLoginURLRequest struct {
+	ChatID           JSONInt64 `json:"chat_id"`            // Chat identifier of the message with the button
+	MessageID        JSONInt64 `json:"message_id"`         // Message identifier of the message with the button
+	ButtonID         int32     `json:"button_id"`          // Button identifier
+	AllowWriteAccess bool      `json:"allow_write_access"` // True, if the user allowed the bot to send them messages
+}
+
+// MessageType return the string telegram-type of GetLoginURLRequest
+func (getLoginURLRequest *GetLoginURLRequest) MessageType() string {
+	return "getLoginUrl"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLoginURLRequest GetLoginURLRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLoginURLRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLoginUrl", alias(getLoginURLRequest)})
+}
+
+// GetInlineQueryResultsRequest is the request of GetInlineQueryResults: Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+type GetInlineQueryResultsRequest struct {
+	BotUserID    int32     `json:"bot_user_id"`   // The identifier of the target bot
+	ChatID       JSONInt64 `json:"chat_id"`       // Identifier of the chat where the query was sent
+	UserLocation *Location `json:"user_location"` // Location of the user, only if needed
+	Query        string    `json:"query"`         // Text of the query
+	Offset       string    `json:"offset"`        // Offset of the first entry to return
+}
+
+// MessageType return the string telegram-type of GetInlineQueryResultsRequest
+func (getInlineQueryResultsRequest *GetInlineQueryResultsRequest) MessageType() string {
+	return "getInlineQueryResults"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getInlineQueryResultsRequest GetInlineQueryResultsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetInlineQueryResultsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getInlineQueryResults", alias(getInlineQueryResultsRequest)})
+}
+
+// AnswerInlineQueryRequest is the request of AnswerInlineQuery: Sets the result of an inline query; for bots only
+type AnswerInlineQueryRequest struct {
+	InlineQueryID     JSONInt64                `json:"inline_query_id"`     // Identifier of the inline query
+	IsPersonal        bool                     `json:"is_personal"`         // True, if the result of the query can be cached for the specified user
+	Results           []InputInlineQueryResult `json:"results"`             // The results of the query
+	CacheTime         int32                    `json:"cache_time"`          // Allowed time to cache the results of the query, in seconds
+	NextOffset        string                   `json:"next_offset"`         // Offset for the next inline query; pass an empty string if there are no more results
+	SwitchPmText      string                   `json:"switch_pm_text"`      // If non-empty, this text should be shown on the button that opens a private chat with the bot and sends a start message to the bot with the parameter switch_pm_parameter
+	SwitchPmParameter string                   `json:"switch_pm_parameter"` // The parameter for the bot start message
+}
+
+// MessageType return the string telegram-type of AnswerInlineQueryRequest
+func (answerInlineQueryRequest *AnswerInlineQueryRequest) MessageType() string {
+	return "answerInlineQuery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (answerInlineQueryRequest AnswerInlineQueryRequest) MarshalJSON() ([]byte, error) {
+	type alias AnswerInlineQueryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"answerInlineQuery", alias(answerInlineQueryRequest)})
+}
+
+// GetCallbackQueryAnswerRequest is the request of GetCallbackQueryAnswer: Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+type GetCallbackQueryAnswerRequest struct {
+	ChatID    JSONInt64            `json:"chat_id"`    // Identifier of the chat with the message
+	MessageID JSONInt64            `json:"message_id"` // Identifier of the message from which the query originated
+	Payload   CallbackQueryPayload `json:"payload"`    // Query payload
+}
+
+// MessageType return the string telegram-type of GetCallbackQueryAnswerRequest
+func (getCallbackQueryAnswerRequest *GetCallbackQueryAnswerRequest) MessageType() string {
+	return "getCallbackQueryAnswer"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getCallbackQueryAnswerRequest GetCallbackQueryAnswerRequest) MarshalJSON() ([]byte, error) {
+	type alias GetCallbackQueryAnswerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getCallbackQueryAnswer", alias(getCallbackQueryAnswerRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getCallbackQueryAnswerRequest *GetCallbackQueryAnswerRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat with the message
+		MessageID JSONInt64 `json:"message_id"` // Identifier of the message from which the query originated
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getCallbackQueryAnswerRequest.ChatID = tempObj.ChatID
+	getCallbackQueryAnswerRequest.MessageID = tempObj.MessageID
+
+	fieldPayload, _ := unmarshalCallbackQueryPayload(objMap["payload"])
+	getCallbackQueryAnswerRequest.Payload = fieldPayload
+
+	return nil
+}
+
+// AnswerCallbackQueryRequest is the request of AnswerCallbackQuery: Sets the result of a callback query; for bots only
+type AnswerCallbackQueryRequest struct {
+	CallbackQueryID JSONInt64 `json:"callback_query_id"` // Identifier of the callback query
+	Text            string    `json:"text"`              // Text of the answer
+	ShowAlert       bool      `json:"show_alert"`        // If true, an alert should be shown to the user instead of a toast notification
+	URL             string    `json:"url"`               // URL to be opened
+	CacheTime       int32     `json:"cache_time"`        // Time during which the result of the query can be cached, in seconds
+}
+
+// MessageType return the string telegram-type of AnswerCallbackQueryRequest
+func (answerCallbackQueryRequest *AnswerCallbackQueryRequest) MessageType() string {
+	return "answerCallbackQuery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (answerCallbackQueryRequest AnswerCallbackQueryRequest) MarshalJSON() ([]byte, error) {
+	type alias AnswerCallbackQueryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"answerCallbackQuery", alias(answerCallbackQueryRequest)})
+}
+
+// AnswerShippingQueryRequest is the request of AnswerShippingQuery: Sets the result of a shipping query; for bots only
+type AnswerShippingQueryRequest struct {
+	ShippingQueryID JSONInt64        `json:"shipping_query_id"` // Identifier of the shipping query
+	ShippingOptions []ShippingOption `json:"shipping_options"`  // Available shipping options
+	ErrorMessage    string           `json:"error_message"`     // An error message, empty on success
+}
+
+// MessageType return the string telegram-type of AnswerShippingQueryRequest
+func (answerShippingQueryRequest *AnswerShippingQueryRequest) MessageType() string {
+	return "answerShippingQuery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (answerShippingQueryRequest AnswerShippingQueryRequest) MarshalJSON() ([]byte, error) {
+	type alias AnswerShippingQueryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"answerShippingQuery", alias(answerShippingQueryRequest)})
+}
+
+// AnswerPreCheckoutQueryRequest is the request of AnswerPreCheckoutQuery: Sets the result of a pre-checkout query; for bots only
+type AnswerPreCheckoutQueryRequest struct {
+	PreCheckoutQueryID JSONInt64 `json:"pre_checkout_query_id"` // Identifier of the pre-checkout query
+	ErrorMessage       string    `json:"error_message"`         // An error message, empty on success
+}
+
+// MessageType return the string telegram-type of AnswerPreCheckoutQueryRequest
+func (answerPreCheckoutQueryRequest *AnswerPreCheckoutQueryRequest) MessageType() string {
+	return "answerPreCheckoutQuery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (answerPreCheckoutQueryRequest AnswerPreCheckoutQueryRequest) MarshalJSON() ([]byte, error) {
+	type alias AnswerPreCheckoutQueryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"answerPreCheckoutQuery", alias(answerPreCheckoutQueryRequest)})
+}
+
+// SetGameScoreRequest is the request of SetGameScore: Updates the game score of the specified user in the game; for bots only
+type SetGameScoreRequest struct {
+	ChatID      JSONInt64 `json:"chat_id"`      // The chat to which the message with the game belongs
+	MessageID   JSONInt64 `json:"message_id"`   // Identifier of the message
+	EditMessage bool      `json:"edit_message"` // True, if the message should be edited
+	UserID      int32     `json:"user_id"`      // User identifier
+	Score       int32     `json:"score"`        // The new score
+	Force       bool      `json:"force"`        // Pass true to update the score even if it decreases. If the score is 0, the user will be deleted from the high score table
+}
+
+// MessageType return the string telegram-type of SetGameScoreRequest
+func (setGameScoreRequest *SetGameScoreRequest) MessageType() string {
+	return "setGameScore"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setGameScoreRequest SetGameScoreRequest) MarshalJSON() ([]byte, error) {
+	type alias SetGameScoreRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setGameScore", alias(setGameScoreRequest)})
+}
+
+// SetInlineGameScoreRequest is the request of SetInlineGameScore: Updates the game score of the specified user in a game; for bots only
+type SetInlineGameScoreRequest struct {
+	InlineMessageID string `json:"inline_message_id"` // Inline message identifier
+	EditMessage     bool   `json:"edit_message"`      // True, if the message should be edited
+	UserID          int32  `json:"user_id"`           // User identifier
+	Score           int32  `json:"score"`             // The new score
+	Force           bool   `json:"force"`             // Pass true to update the score even if it decreases. If the score is 0, the user will be deleted from the high score table
+}
+
+// MessageType return the string telegram-type of SetInlineGameScoreRequest
+func (setInlineGameScoreRequest *SetInlineGameScoreRequest) MessageType() string {
+	return "setInlineGameScore"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setInlineGameScoreRequest SetInlineGameScoreRequest) MarshalJSON() ([]byte, error) {
+	type alias SetInlineGameScoreRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setInlineGameScore", alias(setInlineGameScoreRequest)})
+}
+
+// GetGameHighScoresRequest is the request of GetGameHighScores: Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only
+type GetGameHighScoresRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // The chat that contains the message with the game
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message
+	UserID    int32     `json:"user_id"`    // User identifier
+}
+
+// MessageType return the string telegram-type of GetGameHighScoresRequest
+func (getGameHighScoresRequest *GetGameHighScoresRequest) MessageType() string {
+	return "getGameHighScores"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getGameHighScoresRequest GetGameHighScoresRequest) MarshalJSON() ([]byte, error) {
+	type alias GetGameHighScoresRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getGameHighScores", alias(getGameHighScoresRequest)})
+}
+
+// GetInlineGameHighScoresRequest is the request of GetInlineGameHighScores: Returns game high scores and some part of the high score table in the range of the specified user; for bots only
+type GetInlineGameHighScoresRequest struct {
+	InlineMessageID string `json:"inline_message_id"` // Inline message identifier
+	UserID          int32  `json:"user_id"`           // User identifier
+}
+
+// MessageType return the string telegram-type of GetInlineGameHighScoresRequest
+func (getInlineGameHighScoresRequest *GetInlineGameHighScoresRequest) MessageType() string {
+	return "getInlineGameHighScores"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getInlineGameHighScoresRequest GetInlineGameHighScoresRequest) MarshalJSON() ([]byte, error) {
+	type alias GetInlineGameHighScoresRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getInlineGameHighScores", alias(getInlineGameHighScoresRequest)})
+}
+
+// DeleteChatReplyMarkupRequest is the request of DeleteChatReplyMarkup: Deletes the default reply markup from a chat. Must be called after a one-time keyboard or a ForceReply reply markup has been used. UpdateChatReplyMarkup will be sent if the reply markup will be changed
+type DeleteChatReplyMarkupRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+	MessageID JSONInt64 `json:"message_id"` // The message identifier of the used keyboard
+}
+
+// MessageType return the string telegram-type of DeleteChatReplyMarkupRequest
+func (deleteChatReplyMarkupRequest *DeleteChatReplyMarkupRequest) MessageType() string {
+	return "deleteChatReplyMarkup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteChatReplyMarkupRequest DeleteChatReplyMarkupRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteChatReplyMarkupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteChatReplyMarkup", alias(deleteChatReplyMarkupRequest)})
+}
+
+// SendChatActionRequest is the request of SendChatAction: Sends a notification about user activity in a chat
+type SendChatActionRequest struct {
+	ChatID          JSONInt64  `json:"chat_id"`           // Chat identifier
+	MessageThreadID JSONInt64  `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
+	Action          ChatAction `json:"action"`            // The action description
+}
+
+// MessageType return the string telegram-type of SendChatActionRequest
+func (sendChatActionRequest *SendChatActionRequest) MessageType() string {
+	return "sendChatAction"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendChatActionRequest SendChatActionRequest) MarshalJSON() ([]byte, error) {
+	type alias SendChatActionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendChatAction", alias(sendChatActionRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (sendChatActionRequest *SendChatActionRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID          JSONInt64 `json:"chat_id"`           // Chat identifier
+		MessageThreadID JSONInt64 `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	sendChatActionRequest.ChatID = tempObj.ChatID
+	sendChatActionRequest.MessageThreadID = tempObj.MessageThreadID
+
+	fieldAction, _ := unmarshalChatAction(objMap["action"])
+	sendChatActionRequest.Action = fieldAction
+
+	return nil
+}
+
+// OpenChatRequest is the request of OpenChat: Informs TDLib that the chat is opened by the user. Many useful activities depend on the chat being opened or closed (e.g., in supergroups and channels all updates are received only for opened chats)
+type OpenChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of OpenChatRequest
+func (openChatRequest *OpenChatRequest) MessageType() string {
+	return "openChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (openChatRequest OpenChatRequest) MarshalJSON() ([]byte, error) {
+	type alias OpenChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"openChat", alias(openChatRequest)})
+}
+
+// CloseChatRequest is the request of CloseChat: Informs TDLib that the chat is closed by the user. Many useful activities depend on the chat being opened or closed
+type CloseChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of CloseChatRequest
+func (closeChatRequest *CloseChatRequest) MessageType() string {
+	return "closeChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (closeChatRequest CloseChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CloseChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"closeChat", alias(closeChatRequest)})
+}
+
+// ViewMessagesRequest is the request of ViewMessages: Informs TDLib that messages are being viewed by the user. Many useful activities depend on whether the messages are currently being viewed or not (e.g., marking messages as read, incrementing a view counter, updating a view counter, removing deleted messages in supergroups and channels)
+type ViewMessagesRequest struct {
+	ChatID          JSONInt64   `json:"chat_id"`           // Chat identifier
+	MessageThreadID JSONInt64   `json:"message_thread_id"` // If not 0, a message thread identifier in which the messages are being viewed
+	MessageIDs      []JSONInt64 `json:"message_ids"`       // The identifiers of the messages being viewed
+	ForceRead       bool        `json:"force_read"`        // True, if messages in closed chats should be marked as read by the request
+}
+
+// MessageType return the string telegram-type of ViewMessagesRequest
+func (viewMessagesRequest *ViewMessagesRequest) MessageType() string {
+	return "viewMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (viewMessagesRequest ViewMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias ViewMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"viewMessages", alias(viewMessagesRequest)})
+}
+
+// OpenMessageContentRequest is the request of OpenMessageContent: Informs TDLib that the message content has been opened (e.g., the user has opened a photo, video, document, location or venue, or has listened to an audio file or voice note message). An updateMessageContentOpened update will be generated if something has changed
+type OpenMessageContentRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier of the message
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message with the opened content
+}
+
+// MessageType return the string telegram-type of OpenMessageContentRequest
+func (openMessageContentRequest *OpenMessageContentRequest) MessageType() string {
+	return "openMessageContent"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (openMessageContentRequest OpenMessageContentRequest) MarshalJSON() ([]byte, error) {
+	type alias OpenMessageContentRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"openMessageContent", alias(openMessageContentRequest)})
+}
+
+// ReadAllChatMentionsRequest is the request of ReadAllChatMentions: Marks all mentions in a chat as read
+type ReadAllChatMentionsRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of ReadAllChatMentionsRequest
+func (readAllChatMentionsRequest *ReadAllChatMentionsRequest) MessageType() string {
+	return "readAllChatMentions"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (readAllChatMentionsRequest ReadAllChatMentionsRequest) MarshalJSON() ([]byte, error) {
+	type alias ReadAllChatMentionsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"readAllChatMentions", alias(readAllChatMentionsRequest)})
+}
+
+// CreatePrivateChatRequest is the request of CreatePrivateChat: Returns an existing chat corresponding to a given user
+type CreatePrivateChatRequest struct {
+	UserID int32 `json:"user_id"` // User identifier
+	Force  bool  `json:"force"`   // If true, the chat will be created without network request. In this case all information about the chat except its type, title and photo can be incorrect
+}
+
+// MessageType return the string telegram-type of CreatePrivateChatRequest
+func (createPrivateChatRequest *CreatePrivateChatRequest) MessageType() string {
+	return "createPrivateChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createPrivateChatRequest CreatePrivateChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreatePrivateChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createPrivateChat", alias(createPrivateChatRequest)})
+}
+
+// CreateBasicGroupChatRequest is the request of CreateBasicGroupChat: Returns an existing chat corresponding to a known basic group
+type CreateBasicGroupChatRequest struct {
+	BasicGroupID int32 `json:"basic_group_id"` // Basic group identifier
+	Force        bool  `json:"force"`          // If true, the chat will be created without network request. In this case all information about the chat except its type, title and photo can be incorrect
+}
+
+// MessageType return the string telegram-type of CreateBasicGroupChatRequest
+func (createBasicGroupChatRequest *CreateBasicGroupChatRequest) MessageType() string {
+	return "createBasicGroupChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createBasicGroupChatRequest CreateBasicGroupChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateBasicGroupChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createBasicGroupChat", alias(createBasicGroupChatRequest)})
+}
+
+// CreateSupergroupChatRequest is the request of CreateSupergroupChat: Returns an existing chat corresponding to a known supergroup or channel
+type CreateSupergroupChatRequest struct {
+	SupergroupID int32 `json:"supergroup_id"` // Supergroup or channel identifier
+	Force        bool  `json:"force"`         // If true, the chat will be created without network request. In this case all information about the chat except its type, title and photo can be incorrect
+}
+
+// MessageType return the string telegram-type of CreateSupergroupChatRequest
+func (createSupergroupChatRequest *CreateSupergroupChatRequest) MessageType() string {
+	return "createSupergroupChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createSupergroupChatRequest CreateSupergroupChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateSupergroupChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createSupergroupChat", alias(createSupergroupChatRequest)})
+}
+
+// CreateSecretChatRequest is the request of CreateSecretChat: Returns an existing chat corresponding to a known secret chat
+type CreateSecretChatRequest struct {
+	SecretChatID int32 `json:"secret_chat_id"` // Secret chat identifier
+}
+
+// MessageType return the string telegram-type of CreateSecretChatRequest
+func (createSecretChatRequest *CreateSecretChatRequest) MessageType() string {
+	return "createSecretChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createSecretChatRequest CreateSecretChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateSecretChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createSecretChat", alias(createSecretChatRequest)})
+}
+
+// CreateNewBasicGroupChatRequest is the request of CreateNewBasicGroupChat: Creates a new basic group and sends a corresponding messageBasicGroupChatCreate. Returns the newly created chat
+type CreateNewBasicGroupChatRequest struct {
+	UserIDs []int32 `json:"user_ids"` // Identifiers of users to be added to the basic group
+	Title   string  `json:"title"`    // Title of the new basic group; 1-128 characters
+}
+
+// MessageType return the string telegram-type of CreateNewBasicGroupChatRequest
+func (createNewBasicGroupChatRequest *CreateNewBasicGroupChatRequest) MessageType() string {
+	return "createNewBasicGroupChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createNewBasicGroupChatRequest CreateNewBasicGroupChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateNewBasicGroupChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createNewBasicGroupChat", alias(createNewBasicGroupChatRequest)})
+}
+
+// CreateNewSupergroupChatRequest is the request of CreateNewSupergroupChat: Creates a new supergroup or channel and sends a corresponding messageSupergroupChatCreate. Returns the newly created chat
+type CreateNewSupergroupChatRequest struct {
+	Title       string        `json:"title"`       // Title of the new chat; 1-128 characters
+	IsChannel   bool          `json:"is_channel"`  // True, if a channel chat needs to be created
+	Description string        `json:"description"` //
+	Location    *ChatLocation `json:"location"`    // Chat location if a location-based supergroup is being created
+	ForImport   bool          `json:"for_import"`  // True, if the supergroup is created for importing messages using importMessage
+}
+
+// MessageType return the string telegram-type of CreateNewSupergroupChatRequest
+func (createNewSupergroupChatRequest *CreateNewSupergroupChatRequest) MessageType() string {
+	return "createNewSupergroupChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createNewSupergroupChatRequest CreateNewSupergroupChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateNewSupergroupChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createNewSupergroupChat", alias(createNewSupergroupChatRequest)})
+}
+
+// CreateNewSecretChatRequest is the request of CreateNewSecretChat: Creates a new secret chat. Returns the newly created chat
+type CreateNewSecretChatRequest struct {
+	UserID int32 `json:"user_id"` // Identifier of the target user
+}
+
+// MessageType return the string telegram-type of CreateNewSecretChatRequest
+func (createNewSecretChatRequest *CreateNewSecretChatRequest) MessageType() string {
+	return "createNewSecretChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createNewSecretChatRequest CreateNewSecretChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateNewSecretChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createNewSecretChat", alias(createNewSecretChatRequest)})
+}
+
+// UpgradeBasicGroupChatToSupergroupChatRequest is the request of UpgradeBasicGroupChatToSupergroupChat: Creates a new supergroup from an existing basic group and sends a corresponding messageChatUpgradeTo and messageChatUpgradeFrom; requires creator privileges. Deactivates the original basic group
+type UpgradeBasicGroupChatToSupergroupChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat to upgrade
+}
+
+// MessageType return the string telegram-type of UpgradeBasicGroupChatToSupergroupChatRequest
+func (upgradeBasicGroupChatToSupergroupChatRequest *UpgradeBasicGroupChatToSupergroupChatRequest) MessageType() string {
+	return "upgradeBasicGroupChatToSupergroupChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (upgradeBasicGroupChatToSupergroupChatRequest UpgradeBasicGroupChatToSupergroupChatRequest) MarshalJSON() ([]byte, error) {
+	type alias UpgradeBasicGroupChatToSupergroupChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"upgradeBasicGroupChatToSupergroupChat", alias(upgradeBasicGroupChatToSupergroupChatRequest)})
+}
+
+// GetChatListsToAddChatRequest is the request of GetChatListsToAddChat: Returns chat lists to which the chat can be added. This is an offline request
+type GetChatListsToAddChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of GetChatListsToAddChatRequest
+func (getChatListsToAddChatRequest *GetChatListsToAddChatRequest) MessageType() string {
+	return "getChatListsToAddChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatListsToAddChatRequest GetChatListsToAddChatRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatListsToAddChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatListsToAddChat", alias(getChatListsToAddChatRequest)})
+}
+
+// AddChatToListRequest is the request of AddChatToList: Adds a chat to a chat list. A chat can't be simultaneously in Main and Archive chat lists, so it is automatically removed from another one if needed
+type AddChatToListRequest struct {
+	ChatID   JSONInt64 `json:"chat_id"`   // Chat identifier
+	ChatList ChatList  `json:"chat_list"` // The chat list. Use getChatListsToAddChat to get suitable chat lists
+}
+
+// MessageType return the string telegram-type of AddChatToListRequest
+func (addChatToListRequest *AddChatToListRequest) MessageType() string {
+	return "addChatToList"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addChatToListRequest AddChatToListRequest) MarshalJSON() ([]byte, error) {
+	type alias AddChatToListRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addChatToList", alias(addChatToListRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addChatToListRequest *AddChatToListRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	addChatToListRequest.ChatID = tempObj.ChatID
+
+	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
+	addChatToListRequest.ChatList = fieldChatList
+
+	return nil
+}
+
+// GetChatFilterRequest is the request of GetChatFilter: Returns information about a chat filter by its identifier
+type GetChatFilterRequest struct {
+	ChatFilterID int32 `json:"chat_filter_id"` // Chat filter identifier
+}
+
+// MessageType return the string telegram-type of GetChatFilterRequest
+func (getChatFilterRequest *GetChatFilterRequest) MessageType() string {
+	return "getChatFilter"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatFilterRequest GetChatFilterRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatFilterRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatFilter", alias(getChatFilterRequest)})
+}
+
+// CreateChatFilterRequest is the request of CreateChatFilter: Creates new chat filter. Returns information about the created chat filter
+type CreateChatFilterRequest struct {
+	Filter *ChatFilter `json:"filter"` // Chat filter
+}
+
+// MessageType return the string telegram-type of CreateChatFilterRequest
+func (createChatFilterRequest *CreateChatFilterRequest) MessageType() string {
+	return "createChatFilter"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createChatFilterRequest CreateChatFilterRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateChatFilterRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createChatFilter", alias(createChatFilterRequest)})
+}
+
+// EditChatFilterRequest is the request of EditChatFilter: Edits existing chat filter. Returns information about the edited chat filter
+type EditChatFilterRequest struct {
+	ChatFilterID int32       `json:"chat_filter_id"` // Chat filter identifier
+	Filter       *ChatFilter `json:"filter"`         // The edited chat filter
+}
+
+// MessageType return the string telegram-type of EditChatFilterRequest
+func (editChatFilterRequest *EditChatFilterRequest) MessageType() string {
+	return "editChatFilter"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editChatFilterRequest EditChatFilterRequest) MarshalJSON() ([]byte, error) {
+	type alias EditChatFilterRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editChatFilter", alias(editChatFilterRequest)})
+}
+
+// DeleteChatFilterRequest is the request of DeleteChatFilter: Deletes existing chat filter
+type DeleteChatFilterRequest struct {
+	ChatFilterID int32 `json:"chat_filter_id"` // Chat filter identifier
+}
+
+// MessageType return the string telegram-type of DeleteChatFilterRequest
+func (deleteChatFilterRequest *DeleteChatFilterRequest) MessageType() string {
+	return "deleteChatFilter"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteChatFilterRequest DeleteChatFilterRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteChatFilterRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteChatFilter", alias(deleteChatFilterRequest)})
+}
+
+// ReorderChatFiltersRequest is the request of ReorderChatFilters: Changes the order of chat filters
+type ReorderChatFiltersRequest struct {
+	ChatFilterIDs []int32 `json:"chat_filter_ids"` // Identifiers of chat filters in the new correct order
+}
+
+// MessageType return the string telegram-type of ReorderChatFiltersRequest
+func (reorderChatFiltersRequest *ReorderChatFiltersRequest) MessageType() string {
+	return "reorderChatFilters"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (reorderChatFiltersRequest ReorderChatFiltersRequest) MarshalJSON() ([]byte, error) {
+	type alias ReorderChatFiltersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"reorderChatFilters", alias(reorderChatFiltersRequest)})
+}
+
+// GetRecommendedChatFiltersRequest is the request of GetRecommendedChatFilters: Returns recommended chat filters for the current user
+type GetRecommendedChatFiltersRequest struct {
+}
+
+// MessageType return the string telegram-type of GetRecommendedChatFiltersRequest
+func (getRecommendedChatFiltersRequest *GetRecommendedChatFiltersRequest) MessageType() string {
+	return "getRecommendedChatFilters"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRecommendedChatFiltersRequest GetRecommendedChatFiltersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRecommendedChatFiltersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRecommendedChatFilters", alias(getRecommendedChatFiltersRequest)})
+}
+
+// GetChatFilterDefaultIconNameRequest is the request of GetChatFilterDefaultIconName: Returns default icon name for a filter. Can be called synchronously
+type GetChatFilterDefaultIconNameRequest struct {
+	Filter *ChatFilter `json:"filter"` // Chat filter
+}
+
+// MessageType return the string telegram-type of GetChatFilterDefaultIconNameRequest
+func (getChatFilterDefaultIconNameRequest *GetChatFilterDefaultIconNameRequest) MessageType() string {
+	return "getChatFilterDefaultIconName"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatFilterDefaultIconNameRequest GetChatFilterDefaultIconNameRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatFilterDefaultIconNameRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatFilterDefaultIconName", alias(getChatFilterDefaultIconNameRequest)})
+}
+
+// SetChatTitleRequest is the request of SetChatTitle: Changes the chat title. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right
+type SetChatTitleRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	Title  string    `json:"title"`   // New title of the chat; 1-128 characters
+}
+
+// MessageType return the string telegram-type of SetChatTitleRequest
+func (setChatTitleRequest *SetChatTitleRequest) MessageType() string {
+	return "setChatTitle"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatTitleRequest SetChatTitleRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatTitleRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatTitle", alias(setChatTitleRequest)})
+}
+
+// SetChatPhotoRequest is the request of SetChatPhoto: Changes the photo of a chat. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right
+type SetChatPhotoRequest struct {
+	ChatID JSONInt64      `json:"chat_id"` // Chat identifier
+	Photo  InputChatPhoto `json:"photo"`   // New chat photo. Pass null to delete the chat photo
+}
+
+// MessageType return the string telegram-type of SetChatPhotoRequest
+func (setChatPhotoRequest *SetChatPhotoRequest) MessageType() string {
+	return "setChatPhoto"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatPhotoRequest SetChatPhotoRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatPhotoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatPhoto", alias(setChatPhotoRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setChatPhotoRequest *SetChatPhotoRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setChatPhotoRequest.ChatID = tempObj.ChatID
+
+	fieldPhoto, _ := unmarshalInputChatPhoto(objMap["photo"])
+	setChatPhotoRequest.Photo = fieldPhoto
+
+	return nil
+}
+
+// SetChatPermissionsRequest is the request of SetChatPermissions: Changes the chat members permissions. Supported only for basic groups and supergroups. Requires can_restrict_members administrator right
+type SetChatPermissionsRequest struct {
+	ChatID      JSONInt64        `json:"chat_id"`     // Chat identifier
+	Permissions *ChatPermissions `json:"permissions"` // New non-administrator members permissions in the chat
+}
+
+// MessageType return the string telegram-type of SetChatPermissionsRequest
+func (setChatPermissionsRequest *SetChatPermissionsRequest) MessageType() string {
+	return "setChatPermissions"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatPermissionsRequest SetChatPermissionsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatPermissionsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatPermissions", alias(setChatPermissionsRequest)})
+}
+
+// SetChatDraftMessageRequest is the request of SetChatDraftMessage: Changes the draft message in a chat
+type SetChatDraftMessageRequest struct {
+	ChatID          JSONInt64     `json:"chat_id"`           // Chat identifier
+	MessageThreadID JSONInt64     `json:"message_thread_id"` // If not 0, a message thread identifier in which the draft was changed
+	DraftMessage    *DraftMessage `json:"draft_message"`     // New draft message; may be null
+}
+
+// MessageType return the string telegram-type of SetChatDraftMessageRequest
+func (setChatDraftMessageRequest *SetChatDraftMessageRequest) MessageType() string {
+	return "setChatDraftMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatDraftMessageRequest SetChatDraftMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatDraftMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatDraftMessage", alias(setChatDraftMessageRequest)})
+}
+
+// SetChatNotificationSettingsRequest is the request of SetChatNotificationSettings: Changes the notification settings of a chat. Notification settings of a chat with the current user (Saved Messages) can't be changed
+type SetChatNotificationSettingsRequest struct {
+	ChatID               JSONInt64                 `json:"chat_id"`               // Chat identifier
+	NotificationSettings *ChatNotificationSettings `json:"notification_settings"` // New notification settings for the chat. If the chat is muted for more than 1 week, it is considered to be muted forever
+}
+
+// MessageType return the string telegram-type of SetChatNotificationSettingsRequest
+func (setChatNotificationSettingsRequest *SetChatNotificationSettingsRequest) MessageType() string {
+	return "setChatNotificationSettings"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatNotificationSettingsRequest SetChatNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatNotificationSettingsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatNotificationSettings", alias(setChatNotificationSettingsRequest)})
+}
+
+// ToggleChatIsMarkedAsUnreadRequest is the request of ToggleChatIsMarkedAsUnread: Changes the marked as unread state of a chat
+type ToggleChatIsMarkedAsUnreadRequest struct {
+	ChatID           JSONInt64 `json:"chat_id"`             // Chat identifier
+	IsMarkedAsUnread bool      `json:"is_marked_as_unread"` // New value of is_marked_as_unread
+}
+
+// MessageType return the string telegram-type of ToggleChatIsMarkedAsUnreadRequest
+func (toggleChatIsMarkedAsUnreadRequest *ToggleChatIsMarkedAsUnreadRequest) MessageType() string {
+	return "toggleChatIsMarkedAsUnread"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleChatIsMarkedAsUnreadRequest ToggleChatIsMarkedAsUnreadRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleChatIsMarkedAsUnreadRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleChatIsMarkedAsUnread", alias(toggleChatIsMarkedAsUnreadRequest)})
+}
+
+// ToggleChatDefaultDisableNotificationRequest is the request of ToggleChatDefaultDisableNotification: Changes the value of the default disable_notification parameter, used when a message is sent to a chat
+type ToggleChatDefaultDisableNotificationRequest struct {
+	ChatID                     JSONInt64 `json:"chat_id"`                      // Chat identifier
+	DefaultDisableNotification bool      `json:"default_disable_notification"` // New value of default_disable_notification
+}
+
+// MessageType return the string telegram-type of ToggleChatDefaultDisableNotificationRequest
+func (toggleChatDefaultDisableNotificationRequest *ToggleChatDefaultDisableNotificationRequest) MessageType() string {
+	return "toggleChatDefaultDisableNotification"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleChatDefaultDisableNotificationRequest ToggleChatDefaultDisableNotificationRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleChatDefaultDisableNotificationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleChatDefaultDisableNotification", alias(toggleChatDefaultDisableNotificationRequest)})
+}
+
+// SetChatClientDataRequest is the request of SetChatClientData: Changes application-specific data associated with a chat
+type SetChatClientDataRequest struct {
+	ChatID     JSONInt64 `json:"chat_id"`     // Chat identifier
+	ClientData string    `json:"client_data"` // New value of client_data
+}
+
+// MessageType return the string telegram-type of SetChatClientDataRequest
+func (setChatClientDataRequest *SetChatClientDataRequest) MessageType() string {
+	return "setChatClientData"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatClientDataRequest SetChatClientDataRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatClientDataRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatClientData", alias(setChatClientDataRequest)})
+}
+
+// SetChatDescriptionRequest is the request of SetChatDescription: Changes information about a chat. Available for basic groups, supergroups, and channels. Requires can_change_info administrator right
+type SetChatDescriptionRequest struct {
+	ChatID      JSONInt64 `json:"chat_id"`     // Identifier of the chat
+	Description string    `json:"description"` //
+}
+
+// MessageType return the string telegram-type of SetChatDescriptionRequest
+func (setChatDescriptionRequest *SetChatDescriptionRequest) MessageType() string {
+	return "setChatDescription"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatDescriptionRequest SetChatDescriptionRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatDescriptionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatDescription", alias(setChatDescriptionRequest)})
+}
+
+// SetChatDiscussionGroupRequest is the request of SetChatDiscussionGroup: Changes the discussion group of a channel chat; requires can_change_info administrator right in the channel if it is specified
+type SetChatDiscussionGroupRequest struct {
+	ChatID           JSONInt64 `json:"chat_id"`            // Identifier of the channel chat. Pass 0 to remove a link from the supergroup passed in the second argument to a linked channel chat (requires can_pin_messages rights in the supergroup)
+	DiscussionChatID JSONInt64 `json:"discussion_chat_id"` // Identifier of a new channel's discussion group. Use 0 to remove the discussion group.
+}
+
+// MessageType return the string telegram-type of SetChatDiscussionGroupRequest
+func (setChatDiscussionGroupRequest *SetChatDiscussionGroupRequest) MessageType() string {
+	return "setChatDiscussionGroup"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatDiscussionGroupRequest SetChatDiscussionGroupRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatDiscussionGroupRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatDiscussionGroup", alias(setChatDiscussionGroupRequest)})
+}
+
+// SetChatLocationRequest is the request of SetChatLocation: Changes the location of a chat. Available only for some location-based supergroups, use supergroupFullInfo.can_set_location to check whether the method is allowed to use
+type SetChatLocationRequest struct {
+	ChatID   JSONInt64     `json:"chat_id"`  // Chat identifier
+	Location *ChatLocation `json:"location"` // New location for the chat; must be valid and not null
+}
+
+// MessageType return the string telegram-type of SetChatLocationRequest
+func (setChatLocationRequest *SetChatLocationRequest) MessageType() string {
+	return "setChatLocation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatLocationRequest SetChatLocationRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatLocationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatLocation", alias(setChatLocationRequest)})
+}
+
+// SetChatSlowModeDelayRequest is the request of SetChatSlowModeDelay: Changes the slow mode delay of a chat. Available only for supergroups; requires can_restrict_members rights
+type SetChatSlowModeDelayRequest struct {
+	ChatID        JSONInt64 `json:"chat_id"`         // Chat identifier
+	SlowModeDelay int32     `json:"slow_mode_delay"` // New slow mode delay for the chat; must be one of 0, 10, 30, 60, 300, 900, 3600
+}
+
+// MessageType return the string telegram-type of SetChatSlowModeDelayRequest
+func (setChatSlowModeDelayRequest *SetChatSlowModeDelayRequest) MessageType() string {
+	return "setChatSlowModeDelay"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatSlowModeDelayRequest SetChatSlowModeDelayRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatSlowModeDelayRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatSlowModeDelay", alias(setChatSlowModeDelayRequest)})
+}
+
+// PinChatMessageRequest is the request of PinChatMessage: Pins a message in a chat; requires can_pin_messages rights or can_edit_messages rights in the channel
+type PinChatMessageRequest struct {
+	ChatID              JSONInt64 `json:"chat_id"`              // Identifier of the chat
+	MessageID           JSONInt64 `json:"message_id"`           // Identifier of the new pinned message
+	DisableNotification bool      `json:"disable_notification"` // True, if there should be no notification about the pinned message. Notifications are always disabled in channels and private chats
+	OnlyForSelf         bool      `json:"only_for_self"`        // True, if the message needs to be pinned for one side only; private chats only
+}
+
+// MessageType return the string telegram-type of PinChatMessageRequest
+func (pinChatMessageRequest *PinChatMessageRequest) MessageType() string {
+	return "pinChatMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (pinChatMessageRequest PinChatMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias PinChatMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"pinChatMessage", alias(pinChatMessageRequest)})
+}
+
+// UnpinChatMessageRequest is the request of UnpinChatMessage: Removes a pinned message from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel
+type UnpinChatMessageRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the removed pinned message
+}
+
+// MessageType return the string telegram-type of UnpinChatMessageRequest
+func (unpinChatMessageRequest *UnpinChatMessageRequest) MessageType() string {
+	return "unpinChatMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (unpinChatMessageRequest UnpinChatMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias UnpinChatMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"unpinChatMessage", alias(unpinChatMessageRequest)})
+}
+
+// UnpinAllChatMessagesRequest is the request of UnpinAllChatMessages: Removes all pinned messages from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel
+type UnpinAllChatMessagesRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat
+}
+
+// MessageType return the string telegram-type of UnpinAllChatMessagesRequest
+func (unpinAllChatMessagesRequest *UnpinAllChatMessagesRequest) MessageType() string {
+	return "unpinAllChatMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (unpinAllChatMessagesRequest UnpinAllChatMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias UnpinAllChatMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"unpinAllChatMessages", alias(unpinAllChatMessagesRequest)})
+}
+
+// JoinChatRequest is the request of JoinChat: Adds the current user as a new member to a chat. Private and secret chats can't be joined using this method
+type JoinChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of JoinChatRequest
+func (joinChatRequest *JoinChatRequest) MessageType() string {
+	return "joinChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (joinChatRequest JoinChatRequest) MarshalJSON() ([]byte, error) {
+	type alias JoinChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"joinChat", alias(joinChatRequest)})
+}
+
+// LeaveChatRequest is the request of LeaveChat: Removes the current user from chat members. Private and secret chats can't be left using this method
+type LeaveChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of LeaveChatRequest
+func (leaveChatRequest *LeaveChatRequest) MessageType() string {
+	return "leaveChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (leaveChatRequest LeaveChatRequest) MarshalJSON() ([]byte, error) {
+	type alias LeaveChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"leaveChat", alias(leaveChatRequest)})
+}
+
+// AddChatMemberRequest is the request of AddChatMember: Adds a new member to a chat. Members can't be added to private or secret chats
+type AddChatMemberRequest struct {
+	ChatID       JSONInt64 `json:"chat_id"`       // Chat identifier
+	UserID       int32     `json:"user_id"`       // Identifier of the user
+	ForwardLimit int32     `json:"forward_limit"` // The number of earlier messages from the chat to be forwarded to the new member; up to 100. Ignored for supergroups and channels
+}
+
+// MessageType return the string telegram-type of AddChatMemberRequest
+func (addChatMemberRequest *AddChatMemberRequest) MessageType() string {
+	return "addChatMember"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addChatMemberRequest AddChatMemberRequest) MarshalJSON() ([]byte, error) {
+	type alias AddChatMemberRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addChatMember", alias(addChatMemberRequest)})
+}
+
+// AddChatMembersRequest is the request of AddChatMembers: Adds multiple new members to a chat. Currently this method is only available for supergroups and channels. This method can't be used to join a chat. Members can't be added to a channel if it has more than 200 members
+type AddChatMembersRequest struct {
+	ChatID  JSONInt64 `json:"chat_id"`  // Chat identifier
+	UserIDs []int32   `json:"user_ids"` // Identifiers of the users to be added to the chat. The maximum number of added users is 20 for supergroups and 100 for channels
+}
+
+// MessageType return the string telegram-type of AddChatMembersRequest
+func (addChatMembersRequest *AddChatMembersRequest) MessageType() string {
+	return "addChatMembers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addChatMembersRequest AddChatMembersRequest) MarshalJSON() ([]byte, error) {
+	type alias AddChatMembersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addChatMembers", alias(addChatMembersRequest)})
+}
+
+// SetChatMemberStatusRequest is the request of SetChatMemberStatus: Changes the status of a chat member, needs appropriate privileges. This function is currently not suitable for adding new members to the chat and transferring chat ownership; instead, use addChatMember or transferChatOwnership
+type SetChatMemberStatusRequest struct {
+	ChatID JSONInt64        `json:"chat_id"` // Chat identifier
+	UserID int32            `json:"user_id"` // User identifier
+	Status ChatMemberStatus `json:"status"`  // The new status of the member in the chat
+}
+
+// MessageType return the string telegram-type of SetChatMemberStatusRequest
+func (setChatMemberStatusRequest *SetChatMemberStatusRequest) MessageType() string {
+	return "setChatMemberStatus"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setChatMemberStatusRequest SetChatMemberStatusRequest) MarshalJSON() ([]byte, error) {
+	type alias SetChatMemberStatusRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setChatMemberStatus", alias(setChatMemberStatusRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setChatMemberStatusRequest *SetChatMemberStatusRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+		UserID int32     `json:"user_id"` // User identifier
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setChatMemberStatusRequest.ChatID = tempObj.ChatID
+	setChatMemberStatusRequest.UserID = tempObj.UserID
+
+	fieldStatus, _ := unmarshalChatMemberStatus(objMap["status"])
+	setChatMemberStatusRequest.Status = fieldStatus
+
+	return nil
+}
+
+// BanChatMemberRequest is the request of BanChatMember: Bans a member in a chat. Members can't be banned in private or secret chats. In supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first
+type BanChatMemberRequest struct {
+	ChatID          JSONInt64 `json:"chat_id"`           // Chat identifier
+	UserID          int32     `json:"user_id"`           // Identifier of the user
+	BannedUntilDate int32     `json:"banned_until_date"` // Point in time (Unix timestamp) when the user will be unbanned; 0 if never. If the user is banned for more than 366 days or for less than 30 seconds from the current time, the user is considered to be banned forever. Ignored in basic groups
+	RevokeMessages  bool      `json:"revoke_messages"`   // Pass true to delete all messages in the chat for the user. Always true for supergroups and channels
+}
+
+// MessageType return the string telegram-type of BanChatMemberRequest
+func (banChatMemberRequest *BanChatMemberRequest) MessageType() string {
+	return "banChatMember"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (banChatMemberRequest BanChatMemberRequest) MarshalJSON() ([]byte, error) {
+	type alias BanChatMemberRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"banChatMember", alias(banChatMemberRequest)})
+}
+
+// CanTransferOwnershipRequest is the request of CanTransferOwnership: Checks whether the current session can be used to transfer a chat ownership to another user
+type CanTransferOwnershipRequest struct {
+}
+
+// MessageType return the string telegram-type of CanTransferOwnershipRequest
+func (canTransferOwnershipRequest *CanTransferOwnershipRequest) MessageType() string {
+	return "canTransferOwnership"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (canTransferOwnershipRequest CanTransferOwnershipRequest) MarshalJSON() ([]byte, error) {
+	type alias CanTransferOwnershipRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"canTransferOwnership", alias(canTransferOwnershipRequest)})
+}
+
+// TransferChatOwnershipRequest is the request of TransferChatOwnership: Changes the owner of a chat. The current user must be a current owner of the chat. Use the method canTransferOwnership to check whether the ownership can be transferred from the current session. Available only for supergroups and channel chats
+type TransferChatOwnershipRequest struct {
+	ChatID   JSONInt64 `json:"chat_id"`  // Chat identifier
+	UserID   int32     `json:"user_id"`  // Identifier of the user to which transfer the ownership. The ownership can't be transferred to a bot or to a deleted user
+	Password string    `json:"password"` // The password of the current user
+}
+
+// MessageType return the string telegram-type of TransferChatOwnershipRequest
+func (transferChatOwnershipRequest *TransferChatOwnershipRequest) MessageType() string {
+	return "transferChatOwnership"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (transferChatOwnershipRequest TransferChatOwnershipRequest) MarshalJSON() ([]byte, error) {
+	type alias TransferChatOwnershipRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"transferChatOwnership", alias(transferChatOwnershipRequest)})
+}
+
+// GetChatMemberRequest is the request of GetChatMember: Returns information about a single member of a chat
+type GetChatMemberRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	UserID int32     `json:"user_id"` // User identifier
+}
+
+// MessageType return the string telegram-type of GetChatMemberRequest
+func (getChatMemberRequest *GetChatMemberRequest) MessageType() string {
+	return "getChatMember"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatMemberRequest GetChatMemberRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatMemberRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatMember", alias(getChatMemberRequest)})
+}
+
+// SearchChatMembersRequest is the request of SearchChatMembers: Searches for a specified query in the first name, last name and username of the members of a specified chat. Requires administrator rights in channels
+type SearchChatMembersRequest struct {
+	ChatID JSONInt64         `json:"chat_id"` // Chat identifier
+	Query  string            `json:"query"`   // Query to search for
+	Limit  int32             `json:"limit"`   // The maximum number of users to be returned
+	Filter ChatMembersFilter `json:"filter"`  // The type of users to return. By default, chatMembersFilterMembers
+}
+
+// MessageType return the string telegram-type of SearchChatMembersRequest
+func (searchChatMembersRequest *SearchChatMembersRequest) MessageType() string {
+	return "searchChatMembers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchChatMembersRequest SearchChatMembersRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchChatMembersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchChatMembers", alias(searchChatMembersRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (searchChatMembersRequest *SearchChatMembersRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+		Query  string    `json:"query"`   // Query to search for
+		Limit  int32     `json:"limit"`   // The maximum number of users to be returned
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	searchChatMembersRequest.ChatID = tempObj.ChatID
+	searchChatMembersRequest.Query = tempObj.Query
+	searchChatMembersRequest.Limit = tempObj.Limit
+
+	fieldFilter, _ := unmarshalChatMembersFilter(objMap["filter"])
+	searchChatMembersRequest.Filter = fieldFilter
+
+	return nil
+}
+
+// GetChatAdministratorsRequest is the request of GetChatAdministrators: Returns a list of administrators of the chat with their custom titles
+type GetChatAdministratorsRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of GetChatAdministratorsRequest
+func (getChatAdministratorsRequest *GetChatAdministratorsRequest) MessageType() string {
+	return "getChatAdministrators"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatAdministratorsRequest GetChatAdministratorsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatAdministratorsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatAdministrators", alias(getChatAdministratorsRequest)})
+}
+
+// ClearAllDraftMessagesRequest is the request of ClearAllDraftMessages: Clears draft messages in all chats
+type ClearAllDraftMessagesRequest struct {
+	ExcludeSecretChats bool `json:"exclude_secret_chats"` // If true, local draft messages in secret chats will not be cleared
+}
+
+// MessageType return the string telegram-type of ClearAllDraftMessagesRequest
+func (clearAllDraftMessagesRequest *ClearAllDraftMessagesRequest) MessageType() string {
+	return "clearAllDraftMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (clearAllDraftMessagesRequest ClearAllDraftMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias ClearAllDraftMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"clearAllDraftMessages", alias(clearAllDraftMessagesRequest)})
+}
+
+// GetChatNotificationSettingsExceptionsRequest is the request of GetChatNotificationSettingsExceptions: Returns list of chats with non-default notification settings
+type GetChatNotificationSettingsExceptionsRequest struct {
+	Scope        NotificationSettingsScope `json:"scope"`         // If specified, only chats from the specified scope will be returned
+	CompareSound bool                      `json:"compare_sound"` // If true, also chats with non-default sound will be returned
+}
+
+// MessageType return the string telegram-type of GetChatNotificationSettingsExceptionsRequest
+func (getChatNotificationSettingsExceptionsRequest *GetChatNotificationSettingsExceptionsRequest) MessageType() string {
+	return "getChatNotificationSettingsExceptions"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatNotificationSettingsExceptionsRequest GetChatNotificationSettingsExceptionsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatNotificationSettingsExceptionsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatNotificationSettingsExceptions", alias(getChatNotificationSettingsExceptionsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getChatNotificationSettingsExceptionsRequest *GetChatNotificationSettingsExceptionsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		CompareSound bool `json:"compare_sound"` // If true, also chats with non-default sound will be returned
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getChatNotificationSettingsExceptionsRequest.CompareSound = tempObj.CompareSound
+
+	fieldScope, _ := unmarshalNotificationSettingsScope(objMap["scope"])
+	getChatNotificationSettingsExceptionsRequest.Scope = fieldScope
+
+	return nil
+}
+
+// GetScopeNotificationSettingsRequest is the request of GetScopeNotificationSettings: Returns the notification settings for chats of a given type
+type GetScopeNotificationSettingsRequest struct {
+	Scope NotificationSettingsScope `json:"scope"` // Types of chats for which to return the notification settings information
+}
+
+// MessageType return the string telegram-type of GetScopeNotificationSettingsRequest
+func (getScopeNotificationSettingsRequest *GetScopeNotificationSettingsRequest) MessageType() string {
+	return "getScopeNotificationSettings"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getScopeNotificationSettingsRequest GetScopeNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetScopeNotificationSettingsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getScopeNotificationSettings", alias(getScopeNotificationSettingsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getScopeNotificationSettingsRequest *GetScopeNotificationSettingsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldScope, _ := unmarshalNotificationSettingsScope(objMap["scope"])
+	getScopeNotificationSettingsRequest.Scope = fieldScope
+
+	return nil
+}
+
+// SetScopeNotificationSettingsRequest is the request of SetScopeNotificationSettings: Changes notification settings for chats of a given type
+type SetScopeNotificationSettingsRequest struct {
+	Scope                NotificationSettingsScope  `json:"scope"`                 // Types of chats for which to change the notification settings
+	NotificationSettings *ScopeNotificationSettings `json:"notification_settings"` // The new notification settings for the given scope
+}
+
+// MessageType return the string telegram-type of SetScopeNotificationSettingsRequest
+func (setScopeNotificationSettingsRequest *SetScopeNotificationSettingsRequest) MessageType() string {
+	return "setScopeNotificationSettings"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setScopeNotificationSettingsRequest SetScopeNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetScopeNotificationSettingsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setScopeNotificationSettings", alias(setScopeNotificationSettingsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setScopeNotificationSettingsRequest *SetScopeNotificationSettingsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		NotificationSettings *ScopeNotificationSettings `json:"notification_settings"` // The new notification settings for the given scope
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setScopeNotificationSettingsRequest.NotificationSettings = tempObj.NotificationSettings
+
+	fieldScope, _ := unmarshalNotificationSettingsScope(objMap["scope"])
+	setScopeNotificationSettingsRequest.Scope = fieldScope
+
+	return nil
+}
+
+// ResetAllNotificationSettingsRequest is the request of ResetAllNotificationSettings: Resets all notification settings to their default values. By default, all chats are unmuted, the sound is set to "default" and message previews are shown
+type ResetAllNotificationSettingsRequest struct {
+}
+
+// MessageType return the string telegram-type of ResetAllNotificationSettingsRequest
+func (resetAllNotificationSettingsRequest *ResetAllNotificationSettingsRequest) MessageType() string {
+	return "resetAllNotificationSettings"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resetAllNotificationSettingsRequest ResetAllNotificationSettingsRequest) MarshalJSON() ([]byte, error) {
+	type alias ResetAllNotificationSettingsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resetAllNotificationSettings", alias(resetAllNotificationSettingsRequest)})
+}
+
+// ToggleChatIsPinnedRequest is the request of ToggleChatIsPinned: Changes the pinned state of a chat. There can be up to GetOption("pinned_chat_count_max")/GetOption("pinned_archived_chat_count_max") pinned non-secret chats and the same number of secret chats in the main/arhive chat list
+type ToggleChatIsPinnedRequest struct {
+	ChatList ChatList  `json:"chat_list"` // Chat list in which to change the pinned state of the chat
+	ChatID   JSONInt64 `json:"chat_id"`   // Chat identifier
+	IsPinned bool      `json:"is_pinned"` // True, if the chat is pinned
+}
+
+// MessageType return the string telegram-type of ToggleChatIsPinnedRequest
+func (toggleChatIsPinnedRequest *ToggleChatIsPinnedRequest) MessageType() string {
+	return "toggleChatIsPinned"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleChatIsPinnedRequest ToggleChatIsPinnedRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleChatIsPinnedRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleChatIsPinned", alias(toggleChatIsPinnedRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (toggleChatIsPinnedRequest *ToggleChatIsPinnedRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID   JSONInt64 `json:"chat_id"`   // Chat identifier
+		IsPinned bool      `json:"is_pinned"` // True, if the chat is pinned
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	toggleChatIsPinnedRequest.ChatID = tempObj.ChatID
+	toggleChatIsPinnedRequest.IsPinned = tempObj.IsPinned
+
+	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
+	toggleChatIsPinnedRequest.ChatList = fieldChatList
+
+	return nil
+}
+
+// SetPinnedChatsRequest is the request of SetPinnedChats: Changes the order of pinned chats
+type SetPinnedChatsRequest struct {
+	ChatList ChatList    `json:"chat_list"` // Chat list in which to change the order of pinned chats
+	ChatIDs  []JSONInt64 `json:"chat_ids"`  // The new list of pinned chats
+}
+
+// MessageType return the string telegram-type of SetPinnedChatsRequest
+func (setPinnedChatsRequest *SetPinnedChatsRequest) MessageType() string {
+	return "setPinnedChats"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setPinnedChatsRequest SetPinnedChatsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetPinnedChatsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setPinnedChats", alias(setPinnedChatsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setPinnedChatsRequest *SetPinnedChatsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatIDs []JSONInt64 `json:"chat_ids"` // The new list of pinned chats
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setPinnedChatsRequest.ChatIDs = tempObj.ChatIDs
+
+	fieldChatList, _ := unmarshalChatList(objMap["chat_list"])
+	setPinnedChatsRequest.ChatList = fieldChatList
+
+	return nil
+}
+
+// DownloadFileRequest is the request of DownloadFile: Downloads a file from the cloud. Download progress and completion of the download will be notified through updateFile updates
+type DownloadFileRequest struct {
+	FileID      int32 `json:"file_id"`     // Identifier of the file to download
+	Priority    int32 `json:"priority"`    // Priority of the download (1-32). The higher the priority, the earlier the file will be downloaded. If the priorities of two files are equal, then the last one for which downloadFile was called will be downloaded first
+	Offset      int32 `json:"offset"`      // The starting position from which the file should be downloaded
+	Limit       int32 `json:"limit"`       // Number of bytes which should be downloaded starting from the "offset" position before the download will be automatically cancelled; use 0 to download without a limit
+	Synchronous bool  `json:"synchronous"` // If false, this request returns file state just after the download has been started. If true, this request returns file state only after
+}
+
+// MessageType return the string telegram-type of DownloadFileRequest
+func (downloadFileRequest *DownloadFileRequest) MessageType() string {
+	return "downloadFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (downloadFileRequest DownloadFileRequest) MarshalJSON() ([]byte, error) {
+	type alias DownloadFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"downloadFile", alias(downloadFileRequest)})
+}
+
+// GetFileDownloadedPrefixSizeRequest is the request of GetFileDownloadedPrefixSize: Returns file downloaded prefix size from a given offset
+type GetFileDownloadedPrefixSizeRequest struct {
+	FileID int32 `json:"file_id"` // Identifier of the file
+	Offset int32 `json:"offset"`  // Offset from which downloaded prefix size should be calculated
+}
+
+// MessageType return the string telegram-type of GetFileDownloadedPrefixSizeRequest
+func (getFileDownloadedPrefixSizeRequest *GetFileDownloadedPrefixSizeRequest) MessageType() string {
+	return "getFileDownloadedPrefixSize"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getFileDownloadedPrefixSizeRequest GetFileDownloadedPrefixSizeRequest) MarshalJSON() ([]byte, error) {
+	type alias GetFileDownloadedPrefixSizeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getFileDownloadedPrefixSize", alias(getFileDownloadedPrefixSizeRequest)})
+}
+
+// CancelDownloadFileRequest is the request of CancelDownloadFile: Stops the downloading of a file. If a file has already been downloaded, does nothing
+type CancelDownloadFileRequest struct {
+	FileID        int32 `json:"file_id"`         // Identifier of a file to stop downloading
+	OnlyIfPending bool  `json:"only_if_pending"` // Pass true to stop downloading only if it hasn't been started, i.e. request hasn't been sent to server
+}
+
+// MessageType return the string telegram-type of CancelDownloadFileRequest
+func (cancelDownloadFileRequest *CancelDownloadFileRequest) MessageType() string {
+	return "cancelDownloadFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (cancelDownloadFileRequest CancelDownloadFileRequest) MarshalJSON() ([]byte, error) {
+	type alias CancelDownloadFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"cancelDownloadFile", alias(cancelDownloadFileRequest)})
+}
+
+// UploadFileRequest is the request of UploadFile: Asynchronously uploads a file to the cloud without sending it in a message. updateFile will be used to notify about upload progress and successful completion of the upload. The file will not have a persistent remote identifier until it will be sent in a message
+type UploadFileRequest struct {
+	File     InputFile `json:"file"`      // File to upload
+	FileType FileType  `json:"file_type"` // File type
+	Priority int32     `json:"priority"`  // Priority of the upload (1-32). The higher the priority, the earlier the file will be uploaded. If the priorities of two files are equal, then the first one for which uploadFile was called will be uploaded first
+}
+
+// MessageType return the string telegram-type of UploadFileRequest
+func (uploadFileRequest *UploadFileRequest) MessageType() string {
+	return "uploadFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (uploadFileRequest UploadFileRequest) MarshalJSON() ([]byte, error) {
+	type alias UploadFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"uploadFile", alias(uploadFileRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (uploadFileRequest *UploadFileRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Priority int32 `json:"priority"` // Priority of the upload (1-32). The higher the priority, the earlier the file will be uploaded. If the priorities of two files are equal, then the first one for which uploadFile was called will be uploaded first
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	uploadFileRequest.Priority = tempObj.Priority
+
+	fieldFile, _ := unmarshalInputFile(objMap["file"])
+	uploadFileRequest.File = fieldFile
+
+	fieldFileType, _ := unmarshalFileType(objMap["file_type"])
+	uploadFileRequest.FileType = fieldFileType
+
+	return nil
+}
+
+// CancelUploadFileRequest is the request of CancelUploadFile: Stops the uploading of a file. Supported only for files uploaded by using uploadFile. For other files the behavior is undefined
+type CancelUploadFileRequest struct {
+	FileID int32 `json:"file_id"` // Identifier of the file to stop uploading
+}
+
+// MessageType return the string telegram-type of CancelUploadFileRequest
+func (cancelUploadFileRequest *CancelUploadFileRequest) MessageType() string {
+	return "cancelUploadFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (cancelUploadFileRequest CancelUploadFileRequest) MarshalJSON() ([]byte, error) {
+	type alias CancelUploadFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"cancelUploadFile", alias(cancelUploadFileRequest)})
+}
+
+// WriteGeneratedFilePartRequest is the request of WriteGeneratedFilePart: Writes a part of a generated file. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct write to the destination file
+type WriteGeneratedFilePartRequest struct {
+	GenerationID JSONInt64 `json:"generation_id"` // The identifier of the generation process
+	Offset       int32     `json:"offset"`        // The offset from which to write the data to the file
+	Data         []byte    `json:"data"`          // The data to write
+}
+
+// MessageType return the string telegram-type of WriteGeneratedFilePartRequest
+func (writeGeneratedFilePartRequest *WriteGeneratedFilePartRequest) MessageType() string {
+	return "writeGeneratedFilePart"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (writeGeneratedFilePartRequest WriteGeneratedFilePartRequest) MarshalJSON() ([]byte, error) {
+	type alias WriteGeneratedFilePartRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"writeGeneratedFilePart", alias(writeGeneratedFilePartRequest)})
+}
+
+// SetFileGenerationProgressRequest is the request of SetFileGenerationProgress: Informs TDLib on a file generation progress
+type SetFileGenerationProgressRequest struct {
+	GenerationID    JSONInt64 `json:"generation_id"`     // The identifier of the generation process
+	ExpectedSize    int32     `json:"expected_size"`     // Expected size of the generated file, in bytes; 0 if unknown
+	LocalPrefixSize int32     `json:"local_prefix_size"` // The number of bytes already generated
+}
+
+// MessageType return the string telegram-type of SetFileGenerationProgressRequest
+func (setFileGenerationProgressRequest *SetFileGenerationProgressRequest) MessageType() string {
+	return "setFileGenerationProgress"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setFileGenerationProgressRequest SetFileGenerationProgressRequest) MarshalJSON() ([]byte, error) {
+	type alias SetFileGenerationProgressRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setFileGenerationProgress", alias(setFileGenerationProgressRequest)})
+}
+
+// FinishFileGenerationRequest is the request of FinishFileGeneration: Finishes the file generation
+type FinishFileGenerationRequest struct {
+	GenerationID JSONInt64 `json:"generation_id"` // The identifier of the generation process
+	Error        *Error    `json:"error"`         // If set, means that file generation has failed and should be terminated
+}
+
+// MessageType return the string telegram-type of FinishFileGenerationRequest
+func (finishFileGenerationRequest *FinishFileGenerationRequest) MessageType() string {
+	return "finishFileGeneration"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (finishFileGenerationRequest FinishFileGenerationRequest) MarshalJSON() ([]byte, error) {
+	type alias FinishFileGenerationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"finishFileGeneration", alias(finishFileGenerationRequest)})
+}
+
+// ReadFilePartRequest is the request of ReadFilePart: Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file
+type ReadFilePartRequest struct {
+	FileID int32 `json:"file_id"` // Identifier of the file. The file must be located in the TDLib file cache
+	Offset int32 `json:"offset"`  // The offset from which to read the file
+	Count  int32 `json:"count"`   // Number of bytes to read. An error will be returned if there are not enough bytes available in the file from the specified position. Pass 0 to read all available data from the specified position
+}
+
+// MessageType return the string telegram-type of ReadFilePartRequest
+func (readFilePartRequest *ReadFilePartRequest) MessageType() string {
+	return "readFilePart"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (readFilePartRequest ReadFilePartRequest) MarshalJSON() ([]byte, error) {
+	type alias ReadFilePartRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"readFilePart", alias(readFilePartRequest)})
+}
+
+// DeleteFileRequest is the request of DeleteFile: Deletes a file from the TDLib file cache
+type DeleteFileRequest struct {
+	FileID int32 `json:"file_id"` // Identifier of the file to delete
+}
+
+// MessageType return the string telegram-type of DeleteFileRequest
+func (deleteFileRequest *DeleteFileRequest) MessageType() string {
+	return "deleteFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteFileRequest DeleteFileRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteFile", alias(deleteFileRequest)})
+}
+
+// GetMessageFileTypeRequest is the request of GetMessageFileType: Returns information about a file with messages exported from another app
+type GetMessageFileTypeRequest struct {
+	MessageFileHead string `json:"message_file_head"` // Beginning of the message file; up to 100 first lines
+}
+
+// MessageType return the string telegram-type of GetMessageFileTypeRequest
+func (getMessageFileTypeRequest *GetMessageFileTypeRequest) MessageType() string {
+	return "getMessageFileType"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageFileTypeRequest GetMessageFileTypeRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageFileTypeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageFileType", alias(getMessageFileTypeRequest)})
+}
+
+// ImportMessagesRequest is the request of ImportMessages: Imports messages exported from another app
+type ImportMessagesRequest struct {
+	ChatID        JSONInt64   `json:"chat_id"`        // Identifier of a chat to which the messages will be imported. It must be an identifier of a private chat with a mutual contact or an identifier of a supergroup chat with can_change_info administrator right
+	MessageFile   InputFile   `json:"message_file"`   // File with messages to import. Only inputFileLocal and inputFileGenerated are supported. The file must not be previously uploaded
+	AttachedFiles []InputFile `json:"attached_files"` // Files used in the imported messages. Only inputFileLocal and inputFileGenerated are supported. The files must not be previously uploaded
+}
+
+// MessageType return the string telegram-type of ImportMessagesRequest
+func (importMessagesRequest *ImportMessagesRequest) MessageType() string {
+	return "importMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (importMessagesRequest ImportMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias ImportMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"importMessages", alias(importMessagesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (importMessagesRequest *ImportMessagesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID        JSONInt64   `json:"chat_id"`        // Identifier of a chat to which the messages will be imported. It must be an identifier of a private chat with a mutual contact or an identifier of a supergroup chat with can_change_info administrator right
+		AttachedFiles []InputFile `json:"attached_files"` // Files used in the imported messages. Only inputFileLocal and inputFileGenerated are supported. The files must not be previously uploaded
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	importMessagesRequest.ChatID = tempObj.ChatID
+	importMessagesRequest.AttachedFiles = tempObj.AttachedFiles
+
+	fieldMessageFile, _ := unmarshalInputFile(objMap["message_file"])
+	importMessagesRequest.MessageFile = fieldMessageFile
+
+	return nil
+}
+
+// ReplacePermanentChatInviteLinkRequest is the request of ReplacePermanentChatInviteLink: Replaces current permanent invite link for a chat with a new permanent invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right
+type ReplacePermanentChatInviteLinkRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of ReplacePermanentChatInviteLinkRequest
+func (replacePermanentChatInviteLinkRequest *ReplacePermanentChatInviteLinkRequest) MessageType() string {
+	return "replacePermanentChatInviteLink"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (replacePermanentChatInviteLinkRequest ReplacePermanentChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	type alias ReplacePermanentChatInviteLinkRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"replacePermanentChatInviteLink", alias(replacePermanentChatInviteLinkRequest)})
+}
+
+// CheckChatInviteLinkRequest is the request of CheckChatInviteLink: Checks the validity of an invite link for a chat and returns information about the corresponding chat
+type CheckChatInviteLinkRequest struct {
+	InviteLink string `json:"invite_link"` // Invite link to be checked; must begin with "https://t.me/joinchat/", "https://telegram.me/joinchat/", or "https://telegram.dog/joinchat/"
+}
+
+// MessageType return the string telegram-type of CheckChatInviteLinkRequest
+func (checkChatInviteLinkRequest *CheckChatInviteLinkRequest) MessageType() string {
+	return "checkChatInviteLink"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkChatInviteLinkRequest CheckChatInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckChatInviteLinkRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkChatInviteLink", alias(checkChatInviteLinkRequest)})
+}
+
+// JoinChatByInviteLinkRequest is the request of JoinChatByInviteLink: Uses an invite link to add the current user to the chat if possible
+type JoinChatByInviteLinkRequest struct {
+	InviteLink string `json:"invite_link"` // Invite link to import; must begin with "https://t.me/joinchat/", "https://telegram.me/joinchat/", or "https://telegram.dog/joinchat/"
+}
+
+// MessageType return the string telegram-type of JoinChatByInviteLinkRequest
+func (joinChatByInviteLinkRequest *JoinChatByInviteLinkRequest) MessageType() string {
+	return "joinChatByInviteLink"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (joinChatByInviteLinkRequest JoinChatByInviteLinkRequest) MarshalJSON() ([]byte, error) {
+	type alias JoinChatByInviteLinkRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"joinChatByInviteLink", alias(joinChatByInviteLinkRequest)})
+}
+
+// CreateCallRequest is the request of CreateCall: Creates a new call
+type CreateCallRequest struct {
+	UserID   int32         `json:"user_id"`  // Identifier of the user to be called
+	Protocol *CallProtocol `json:"protocol"` // Description of the call protocols supported by the application
+	IsVideo  bool          `json:"is_video"` // True, if a video call needs to be created
+}
+
+// MessageType return the string telegram-type of CreateCallRequest
+func (createCallRequest *CreateCallRequest) MessageType() string {
+	return "createCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createCallRequest CreateCallRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createCall", alias(createCallRequest)})
+}
+
+// AcceptCallRequest is the request of AcceptCall: Accepts an incoming call
+type AcceptCallRequest struct {
+	CallID   int32         `json:"call_id"`  // Call identifier
+	Protocol *CallProtocol `json:"protocol"` // Description of the call protocols supported by the application
+}
+
+// MessageType return the string telegram-type of AcceptCallRequest
+func (acceptCallRequest *AcceptCallRequest) MessageType() string {
+	return "acceptCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (acceptCallRequest AcceptCallRequest) MarshalJSON() ([]byte, error) {
+	type alias AcceptCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"acceptCall", alias(acceptCallRequest)})
+}
+
+// SendCallSignalingDataRequest is the request of SendCallSignalingData: Sends call signaling data
+type SendCallSignalingDataRequest struct {
+	CallID int32  `json:"call_id"` // Call identifier
+	Data   []byte `json:"data"`    // The data
+}
+
+// MessageType return the string telegram-type of SendCallSignalingDataRequest
+func (sendCallSignalingDataRequest *SendCallSignalingDataRequest) MessageType() string {
+	return "sendCallSignalingData"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendCallSignalingDataRequest SendCallSignalingDataRequest) MarshalJSON() ([]byte, error) {
+	type alias SendCallSignalingDataRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendCallSignalingData", alias(sendCallSignalingDataRequest)})
+}
+
+// DiscardCallRequest is the request of DiscardCall: Discards a call
+type DiscardCallRequest struct {
+	CallID         int32     `json:"call_id"`         // Call identifier
+	IsDisconnected bool      `json:"is_disconnected"` // True, if the user was disconnected
+	Duration       int32     `json:"duration"`        // The call duration, in seconds
+	IsVideo        bool      `json:"is_video"`        // True, if the call was a video call
+	ConnectionID   JSONInt64 `json:"connection_id"`   // Identifier of the connection used during the call
+}
+
+// MessageType return the string telegram-type of DiscardCallRequest
+func (discardCallRequest *DiscardCallRequest) MessageType() string {
+	return "discardCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (discardCallRequest DiscardCallRequest) MarshalJSON() ([]byte, error) {
+	type alias DiscardCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"discardCall", alias(discardCallRequest)})
+}
+
+// SendCallRatingRequest is the request of SendCallRating: Sends a call rating
+type SendCallRatingRequest struct {
+	CallID   int32         `json:"call_id"`  // Call identifier
+	Rating   int32         `json:"rating"`   // Call rating; 1-5
+	Comment  string        `json:"comment"`  // An optional user comment if the rating is less than 5
+	Problems []CallProblem `json:"problems"` // List of the exact types of problems with the call, specified by the user
+}
+
+// MessageType return the string telegram-type of SendCallRatingRequest
+func (sendCallRatingRequest *SendCallRatingRequest) MessageType() string {
+	return "sendCallRating"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendCallRatingRequest SendCallRatingRequest) MarshalJSON() ([]byte, error) {
+	type alias SendCallRatingRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendCallRating", alias(sendCallRatingRequest)})
+}
+
+// SendCallDebugInformationRequest is the request of SendCallDebugInformation: Sends debug information for a call
+type SendCallDebugInformationRequest struct {
+	CallID           int32  `json:"call_id"`           // Call identifier
+	DebugInformation string `json:"debug_information"` // Debug information in application-specific format
+}
+
+// MessageType return the string telegram-type of SendCallDebugInformationRequest
+func (sendCallDebugInformationRequest *SendCallDebugInformationRequest) MessageType() string {
+	return "sendCallDebugInformation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendCallDebugInformationRequest SendCallDebugInformationRequest) MarshalJSON() ([]byte, error) {
+	type alias SendCallDebugInformationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendCallDebugInformation", alias(sendCallDebugInformationRequest)})
+}
+
+// CreateVoiceChatRequest is the request of CreateVoiceChat: Creates a voice chat (a group call bound to a chat). Available only for basic groups and supergroups; requires can_manage_voice_chats rights
+type CreateVoiceChatRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of CreateVoiceChatRequest
+func (createVoiceChatRequest *CreateVoiceChatRequest) MessageType() string {
+	return "createVoiceChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createVoiceChatRequest CreateVoiceChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateVoiceChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createVoiceChat", alias(createVoiceChatRequest)})
+}
+
+// GetGroupCallRequest is the request of GetGroupCall: Returns information about a group call
+type GetGroupCallRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier
+}
+
+// MessageType return the string telegram-type of GetGroupCallRequest
+func (getGroupCallRequest *GetGroupCallRequest) MessageType() string {
+	return "getGroupCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getGroupCallRequest GetGroupCallRequest) MarshalJSON() ([]byte, error) {
+	type alias GetGroupCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getGroupCall", alias(getGroupCallRequest)})
+}
+
+// JoinGroupCallRequest is the request of JoinGroupCall: Joins a group call
+type JoinGroupCallRequest struct {
+	GroupCallID int32             `json:"group_call_id"` // Group call identifier
+	Payload     *GroupCallPayload `json:"payload"`       // Group join payload, received from tgcalls. Use null to cancel previous joinGroupCall request
+	Source      int32             `json:"source"`        // Caller synchronization source identifier; received from tgcalls
+	IsMuted     bool              `json:"is_muted"`      // True, if the user's microphone is muted
+}
+
+// MessageType return the string telegram-type of JoinGroupCallRequest
+func (joinGroupCallRequest *JoinGroupCallRequest) MessageType() string {
+	return "joinGroupCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (joinGroupCallRequest JoinGroupCallRequest) MarshalJSON() ([]byte, error) {
+	type alias JoinGroupCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"joinGroupCall", alias(joinGroupCallRequest)})
+}
+
+// ToggleGroupCallMuteNewParticipantsRequest is the request of ToggleGroupCallMuteNewParticipants: Toggles whether new participants of a group call can be unmuted only by administrators of the group call. Requires groupCall.can_change_mute_new_participants group call flag
+type ToggleGroupCallMuteNewParticipantsRequest struct {
+	GroupCallID         int32 `json:"group_call_id"`         // Group call identifier
+	MuteNewParticipants bool  `json:"mute_new_participants"` // New value of the mute_new_participants setting
+}
+
+// MessageType return the string telegram-type of ToggleGroupCallMuteNewParticipantsRequest
+func (toggleGroupCallMuteNewParticipantsRequest *ToggleGroupCallMuteNewParticipantsRequest) MessageType() string {
+	return "toggleGroupCallMuteNewParticipants"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleGroupCallMuteNewParticipantsRequest ToggleGroupCallMuteNewParticipantsRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleGroupCallMuteNewParticipantsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleGroupCallMuteNewParticipants", alias(toggleGroupCallMuteNewParticipantsRequest)})
+}
+
+// InviteGroupCallParticipantsRequest is the request of InviteGroupCallParticipants: Invites users to a group call. Sends a service message of type messageInviteToGroupCall for voice chats
+type InviteGroupCallParticipantsRequest struct {
+	GroupCallID int32   `json:"group_call_id"` // Group call identifier
+	UserIDs     []int32 `json:"user_ids"`      // User identifiers. At most 10 users can be invited simultaneously
+}
+
+// MessageType return the string telegram-type of InviteGroupCallParticipantsRequest
+func (inviteGroupCallParticipantsRequest *InviteGroupCallParticipantsRequest) MessageType() string {
+	return "inviteGroupCallParticipants"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (inviteGroupCallParticipantsRequest InviteGroupCallParticipantsRequest) MarshalJSON() ([]byte, error) {
+	type alias InviteGroupCallParticipantsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"inviteGroupCallParticipants", alias(inviteGroupCallParticipantsRequest)})
+}
+
+// SetGroupCallParticipantIsSpeakingRequest is the request of SetGroupCallParticipantIsSpeaking: Informs TDLib that a group call participant speaking state has changed
+type SetGroupCallParticipantIsSpeakingRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier
+	Source      int32 `json:"source"`        // Group call participant's synchronization source identifier, or 0 for the current user
+	IsSpeaking  bool  `json:"is_speaking"`   // True, if the user is speaking
+}
+
+// MessageType return the string telegram-type of SetGroupCallParticipantIsSpeakingRequest
+func (setGroupCallParticipantIsSpeakingRequest *SetGroupCallParticipantIsSpeakingRequest) MessageType() string {
+	return "setGroupCallParticipantIsSpeaking"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setGroupCallParticipantIsSpeakingRequest SetGroupCallParticipantIsSpeakingRequest) MarshalJSON() ([]byte, error) {
+	type alias SetGroupCallParticipantIsSpeakingRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setGroupCallParticipantIsSpeaking", alias(setGroupCallParticipantIsSpeakingRequest)})
+}
+
+// ToggleGroupCallParticipantIsMutedRequest is the request of ToggleGroupCallParticipantIsMuted: Toggles whether a group call participant is muted, unmuted, or allowed to unmute themself
+type ToggleGroupCallParticipantIsMutedRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier
+	UserID      int32 `json:"user_id"`       // User identifier
+	IsMuted     bool  `json:"is_muted"`      // Pass true if the user must be muted and false otherwise
+}
+
+// MessageType return the string telegram-type of ToggleGroupCallParticipantIsMutedRequest
+func (toggleGroupCallParticipantIsMutedRequest *ToggleGroupCallParticipantIsMutedRequest) MessageType() string {
+	return "toggleGroupCallParticipantIsMuted"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleGroupCallParticipantIsMutedRequest ToggleGroupCallParticipantIsMutedRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleGroupCallParticipantIsMutedRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleGroupCallParticipantIsMuted", alias(toggleGroupCallParticipantIsMutedRequest)})
+}
+
+// SetGroupCallParticipantVolumeLevelRequest is the request of SetGroupCallParticipantVolumeLevel: Changes a group call participant's volume level. If the current user can manage the group call, then the participant's volume level will be changed for all users with default volume level
+type SetGroupCallParticipantVolumeLevelRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier
+	UserID      int32 `json:"user_id"`       // User identifier
+	VolumeLevel int32 `json:"volume_level"`  // New participant's volume level; 1-20000 in hundreds of percents
+}
+
+// MessageType return the string telegram-type of SetGroupCallParticipantVolumeLevelRequest
+func (setGroupCallParticipantVolumeLevelRequest *SetGroupCallParticipantVolumeLevelRequest) MessageType() string {
+	return "setGroupCallParticipantVolumeLevel"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setGroupCallParticipantVolumeLevelRequest SetGroupCallParticipantVolumeLevelRequest) MarshalJSON() ([]byte, error) {
+	type alias SetGroupCallParticipantVolumeLevelRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setGroupCallParticipantVolumeLevel", alias(setGroupCallParticipantVolumeLevelRequest)})
+}
+
+// LoadGroupCallParticipantsRequest is the request of LoadGroupCallParticipants: Loads more group call participants. The loaded participants will be received through updates. Use the field groupCall.loaded_all_participants to check whether all participants has already been loaded
+type LoadGroupCallParticipantsRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier. The group call must be previously received through getGroupCall and must be joined or being joined
+	Limit       int32 `json:"limit"`         // Maximum number of participants to load
+}
+
+// MessageType return the string telegram-type of LoadGroupCallParticipantsRequest
+func (loadGroupCallParticipantsRequest *LoadGroupCallParticipantsRequest) MessageType() string {
+	return "loadGroupCallParticipants"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (loadGroupCallParticipantsRequest LoadGroupCallParticipantsRequest) MarshalJSON() ([]byte, error) {
+	type alias LoadGroupCallParticipantsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"loadGroupCallParticipants", alias(loadGroupCallParticipantsRequest)})
+}
+
+// LeaveGroupCallRequest is the request of LeaveGroupCall: Leaves a group call
+type LeaveGroupCallRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier
+}
+
+// MessageType return the string telegram-type of LeaveGroupCallRequest
+func (leaveGroupCallRequest *LeaveGroupCallRequest) MessageType() string {
+	return "leaveGroupCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (leaveGroupCallRequest LeaveGroupCallRequest) MarshalJSON() ([]byte, error) {
+	type alias LeaveGroupCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"leaveGroupCall", alias(leaveGroupCallRequest)})
+}
+
+// DiscardGroupCallRequest is the request of DiscardGroupCall: Discards a group call. Requires groupCall.can_be_managed
+type DiscardGroupCallRequest struct {
+	GroupCallID int32 `json:"group_call_id"` // Group call identifier
+}
+
+// MessageType return the string telegram-type of DiscardGroupCallRequest
+func (discardGroupCallRequest *DiscardGroupCallRequest) MessageType() string {
+	return "discardGroupCall"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (discardGroupCallRequest DiscardGroupCallRequest) MarshalJSON() ([]byte, error) {
+	type alias DiscardGroupCallRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"discardGroupCall", alias(discardGroupCallRequest)})
+}
+
+// ToggleMessageSenderIsBlockedRequest is the request of ToggleMessageSenderIsBlocked: Changes the block state of a message sender. Currently, only users and supergroup chats can be blocked
+type ToggleMessageSenderIsBlockedRequest struct {
+	Sender    MessageSender `json:"sender"`     // Message Sender
+	IsBlocked bool          `json:"is_blocked"` // New value of is_blocked
+}
+
+// MessageType return the string telegram-type of ToggleMessageSenderIsBlockedRequest
+func (toggleMessageSenderIsBlockedRequest *ToggleMessageSenderIsBlockedRequest) MessageType() string {
+	return "toggleMessageSenderIsBlocked"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleMessageSenderIsBlockedRequest ToggleMessageSenderIsBlockedRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleMessageSenderIsBlockedRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleMessageSenderIsBlocked", alias(toggleMessageSenderIsBlockedRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (toggleMessageSenderIsBlockedRequest *ToggleMessageSenderIsBlockedRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		IsBlocked bool `json:"is_blocked"` // New value of is_blocked
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	toggleMessageSenderIsBlockedRequest.IsBlocked = tempObj.IsBlocked
+
+	fieldSender, _ := unmarshalMessageSender(objMap["sender"])
+	toggleMessageSenderIsBlockedRequest.Sender = fieldSender
+
+	return nil
+}
+
+// BlockMessageSenderFromRepliesRequest is the request of BlockMessageSenderFromReplies: Blocks an original sender of a message in the Replies chat
+type BlockMessageSenderFromRepliesRequest struct {
+	MessageID         JSONInt64 `json:"message_id"`          // The identifier of an incoming message in the Replies chat
+	DeleteMessage     bool      `json:"delete_message"`      // Pass true if the message must be deleted
+	DeleteAllMessages bool      `json:"delete_all_messages"` // Pass true if all messages from the same sender must be deleted
+	ReportSpam        bool      `json:"report_spam"`         // Pass true if the sender must be reported to the Telegram moderators
+}
+
+// MessageType return the string telegram-type of BlockMessageSenderFromRepliesRequest
+func (blockMessageSenderFromRepliesRequest *BlockMessageSenderFromRepliesRequest) MessageType() string {
+	return "blockMessageSenderFromReplies"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (blockMessageSenderFromRepliesRequest BlockMessageSenderFromRepliesRequest) MarshalJSON() ([]byte, error) {
+	type alias BlockMessageSenderFromRepliesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"blockMessageSenderFromReplies", alias(blockMessageSenderFromRepliesRequest)})
+}
+
+// GetBlockedMessageSendersRequest is the request of GetBlockedMessageSenders: Returns users and chats that were blocked by the current user
+type GetBlockedMessageSendersRequest struct {
+	Offset int32 `json:"offset"` // Number of users and chats to skip in the result; must be non-negative
+	Limit  int32 `json:"limit"`  // The maximum number of users and chats to return; up to 100
+}
+
+// MessageType return the string telegram-type of GetBlockedMessageSendersRequest
+func (getBlockedMessageSendersRequest *GetBlockedMessageSendersRequest) MessageType() string {
+	return "getBlockedMessageSenders"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getBlockedMessageSendersRequest GetBlockedMessageSendersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetBlockedMessageSendersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getBlockedMessageSenders", alias(getBlockedMessageSendersRequest)})
+}
+
+// AddContactRequest is the request of AddContact: Adds a user to the contact list or edits an existing contact by their user identifier
+type AddContactRequest struct {
+	Contact          *Contact `json:"contact"`            // The contact to add or edit; phone number can be empty and needs to be specified only if known, vCard is ignored
+	SharePhoneNumber bool     `json:"share_phone_number"` // True, if the new contact needs to be allowed to see current user's phone number. A corresponding rule to userPrivacySettingShowPhoneNumber will be added if needed. Use the field UserFullInfo.need_phone_number_privacy_exception to check whether the current user needs to be asked to share their phone number
+}
+
+// MessageType return the string telegram-type of AddContactRequest
+func (addContactRequest *AddContactRequest) MessageType() string {
+	return "addContact"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addContactRequest AddContactRequest) MarshalJSON() ([]byte, error) {
+	type alias AddContactRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addContact", alias(addContactRequest)})
+}
+
+// ImportContactsRequest is the request of ImportContacts: Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored
+type ImportContactsRequest struct {
+	Contacts []Contact `json:"contacts"` // The list of contacts to import or edit; contacts' vCard are ignored and are not imported
+}
+
+// MessageType return the string telegram-type of ImportContactsRequest
+func (importContactsRequest *ImportContactsRequest) MessageType() string {
+	return "importContacts"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (importContactsRequest ImportContactsRequest) MarshalJSON() ([]byte, error) {
+	type alias ImportContactsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"importContacts", alias(importContactsRequest)})
+}
+
+// GetContactsRequest is the request of GetContacts: Returns all user contacts
+type GetContactsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetContactsRequest
+func (getContactsRequest *GetContactsRequest) MessageType() string {
+	return "getContacts"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getContactsRequest GetContactsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetContactsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getContacts", alias(getContactsRequest)})
+}
+
+// SearchContactsRequest is the request of SearchContacts: Searches for the specified query in the first names, last names and usernames of the known user contacts
+type SearchContactsRequest struct {
+	Query string `json:"query"` // Query to search for; may be empty to return all contacts
+	Limit int32  `json:"limit"` // The maximum number of users to be returned
+}
+
+// MessageType return the string telegram-type of SearchContactsRequest
+func (searchContactsRequest *SearchContactsRequest) MessageType() string {
+	return "searchContacts"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchContactsRequest SearchContactsRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchContactsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchContacts", alias(searchContactsRequest)})
+}
+
+// RemoveContactsRequest is the request of RemoveContacts: Removes users from the contact list
+type RemoveContactsRequest struct {
+	UserIDs []int32 `json:"user_ids"` // Identifiers of users to be deleted
+}
+
+// MessageType return the string telegram-type of RemoveContactsRequest
+func (removeContactsRequest *RemoveContactsRequest) MessageType() string {
+	return "removeContacts"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeContactsRequest RemoveContactsRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveContactsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeContacts", alias(removeContactsRequest)})
+}
+
+// GetImportedContactCountRequest is the request of GetImportedContactCount: Returns the total number of imported contacts
+type GetImportedContactCountRequest struct {
+}
+
+// MessageType return the string telegram-type of GetImportedContactCountRequest
+func (getImportedContactCountRequest *GetImportedContactCountRequest) MessageType() string {
+	return "getImportedContactCount"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getImportedContactCountRequest GetImportedContactCountRequest) MarshalJSON() ([]byte, error) {
+	type alias GetImportedContactCountRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getImportedContactCount", alias(getImportedContactCountRequest)})
+}
+
+// ChangeImportedContactsRequest is the request of ChangeImportedContacts: Changes imported contacts using the list of contacts saved on the device. Imports newly added contacts and, if at least the file database is enabled, deletes recently deleted contacts.
+type ChangeImportedContactsRequest struct {
+	Contacts []Contact `json:"contacts"` //
+}
+
+// MessageType return the string telegram-type of ChangeImportedContactsRequest
+func (changeImportedContactsRequest *ChangeImportedContactsRequest) MessageType() string {
+	return "changeImportedContacts"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (changeImportedContactsRequest ChangeImportedContactsRequest) MarshalJSON() ([]byte, error) {
+	type alias ChangeImportedContactsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"changeImportedContacts", alias(changeImportedContactsRequest)})
+}
+
+// ClearImportedContactsRequest is the request of ClearImportedContacts: Clears all imported contacts, contact list remains unchanged
+type ClearImportedContactsRequest struct {
+}
+
+// MessageType return the string telegram-type of ClearImportedContactsRequest
+func (clearImportedContactsRequest *ClearImportedContactsRequest) MessageType() string {
+	return "clearImportedContacts"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (clearImportedContactsRequest ClearImportedContactsRequest) MarshalJSON() ([]byte, error) {
+	type alias ClearImportedContactsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"clearImportedContacts", alias(clearImportedContactsRequest)})
+}
+
+// SharePhoneNumberRequest is the request of SharePhoneNumber: Shares the phone number of the current user with a mutual contact. Supposed to be called when the user clicks on chatActionBarSharePhoneNumber
+type SharePhoneNumberRequest struct {
+	UserID int32 `json:"user_id"` // Identifier of the user with whom to share the phone number. The user must be a mutual contact
+}
+
+// MessageType return the string telegram-type of SharePhoneNumberRequest
+func (sharePhoneNumberRequest *SharePhoneNumberRequest) MessageType() string {
+	return "sharePhoneNumber"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sharePhoneNumberRequest SharePhoneNumberRequest) MarshalJSON() ([]byte, error) {
+	type alias SharePhoneNumberRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sharePhoneNumber", alias(sharePhoneNumberRequest)})
+}
+
+// GetUserProfilePhotosRequest is the request of GetUserProfilePhotos: Returns the profile photos of a user. The result of this query may be outdated: some photos might have been deleted already
+type GetUserProfilePhotosRequest struct {
+	UserID int32 `json:"user_id"` // User identifier
+	Offset int32 `json:"offset"`  // The number of photos to skip; must be non-negative
+	Limit  int32 `json:"limit"`   // The maximum number of photos to be returned; up to 100
+}
+
+// MessageType return the string telegram-type of GetUserProfilePhotosRequest
+func (getUserProfilePhotosRequest *GetUserProfilePhotosRequest) MessageType() string {
+	return "getUserProfilePhotos"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getUserProfilePhotosRequest GetUserProfilePhotosRequest) MarshalJSON() ([]byte, error) {
+	type alias GetUserProfilePhotosRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getUserProfilePhotos", alias(getUserProfilePhotosRequest)})
+}
+
+// GetStickersRequest is the request of GetStickers: Returns stickers from the installed sticker sets that correspond to a given emoji. If the emoji is not empty, favorite and recently used stickers may also be returned
+type GetStickersRequest struct {
+	Emoji string `json:"emoji"` // String representation of emoji. If empty, returns all known installed stickers
+	Limit int32  `json:"limit"` // The maximum number of stickers to be returned
+}
+
+// MessageType return the string telegram-type of GetStickersRequest
+func (getStickersRequest *GetStickersRequest) MessageType() string {
+	return "getStickers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getStickersRequest GetStickersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetStickersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getStickers", alias(getStickersRequest)})
+}
+
+// SearchStickersRequest is the request of SearchStickers: Searches for stickers from public sticker sets that correspond to a given emoji
+type SearchStickersRequest struct {
+	Emoji string `json:"emoji"` // String representation of emoji; must be non-empty
+	Limit int32  `json:"limit"` // The maximum number of stickers to be returned
+}
+
+// MessageType return the string telegram-type of SearchStickersRequest
+func (searchStickersRequest *SearchStickersRequest) MessageType() string {
+	return "searchStickers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchStickersRequest SearchStickersRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchStickersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchStickers", alias(searchStickersRequest)})
+}
+
+// GetInstalledStickerSetsRequest is the request of GetInstalledStickerSets: Returns a list of installed sticker sets
+type GetInstalledStickerSetsRequest struct {
+	IsMasks bool `json:"is_masks"` // Pass true to return mask sticker sets; pass false to return ordinary sticker sets
+}
+
+// MessageType return the string telegram-type of GetInstalledStickerSetsRequest
+func (getInstalledStickerSetsRequest *GetInstalledStickerSetsRequest) MessageType() string {
+	return "getInstalledStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getInstalledStickerSetsRequest GetInstalledStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetInstalledStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getInstalledStickerSets", alias(getInstalledStickerSetsRequest)})
+}
+
+// GetArchivedStickerSetsRequest is the request of GetArchivedStickerSets: Returns a list of archived sticker sets
+type GetArchivedStickerSetsRequest struct {
+	IsMasks            bool      `json:"is_masks"`              // Pass true to return mask stickers sets; pass false to return ordinary sticker sets
+	OffsetStickerSetID JSONInt64 `json:"offset_sticker_set_id"` // Identifier of the sticker set from which to return the result
+	Limit              int32     `json:"limit"`                 // The maximum number of sticker sets to return
+}
+
+// MessageType return the string telegram-type of GetArchivedStickerSetsRequest
+func (getArchivedStickerSetsRequest *GetArchivedStickerSetsRequest) MessageType() string {
+	return "getArchivedStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getArchivedStickerSetsRequest GetArchivedStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetArchivedStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getArchivedStickerSets", alias(getArchivedStickerSetsRequest)})
+}
+
+// GetTrendingStickerSetsRequest is the request of GetTrendingStickerSets: Returns a list of trending sticker sets. For the optimal performance the number of returned sticker sets is chosen by the library
+type GetTrendingStickerSetsRequest struct {
+	Offset int32 `json:"offset"` // The offset from which to return the sticker sets; must be non-negative
+	Limit  int32 `json:"limit"`  // The maximum number of sticker sets to be returned; must be non-negative. Fewer sticker sets may be returned than specified by the limit, even if the end of the list has not been reached
+}
+
+// MessageType return the string telegram-type of GetTrendingStickerSetsRequest
+func (getTrendingStickerSetsRequest *GetTrendingStickerSetsRequest) MessageType() string {
+	return "getTrendingStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getTrendingStickerSetsRequest GetTrendingStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetTrendingStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getTrendingStickerSets", alias(getTrendingStickerSetsRequest)})
+}
+
+// GetAttachedStickerSetsRequest is the request of GetAttachedStickerSets: Returns a list of sticker sets attached to a file. Currently only photos and videos can have attached sticker sets
+type GetAttachedStickerSetsRequest struct {
+	FileID int32 `json:"file_id"` // File identifier
+}
+
+// MessageType return the string telegram-type of GetAttachedStickerSetsRequest
+func (getAttachedStickerSetsRequest *GetAttachedStickerSetsRequest) MessageType() string {
+	return "getAttachedStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getAttachedStickerSetsRequest GetAttachedStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetAttachedStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getAttachedStickerSets", alias(getAttachedStickerSetsRequest)})
+}
+
+// GetStickerSetRequest is the request of GetStickerSet: Returns information about a sticker set by its identifier
+type GetStickerSetRequest struct {
+	SetID JSONInt64 `json:"set_id"` // Identifier of the sticker set
+}
+
+// MessageType return the string telegram-type of GetStickerSetRequest
+func (getStickerSetRequest *GetStickerSetRequest) MessageType() string {
+	return "getStickerSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getStickerSetRequest GetStickerSetRequest) MarshalJSON() ([]byte, error) {
+	type alias GetStickerSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getStickerSet", alias(getStickerSetRequest)})
+}
+
+// SearchStickerSetRequest is the request of SearchStickerSet: Searches for a sticker set by its name
+type SearchStickerSetRequest struct {
+	Name string `json:"name"` // Name of the sticker set
+}
+
+// MessageType return the string telegram-type of SearchStickerSetRequest
+func (searchStickerSetRequest *SearchStickerSetRequest) MessageType() string {
+	return "searchStickerSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchStickerSetRequest SearchStickerSetRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchStickerSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchStickerSet", alias(searchStickerSetRequest)})
+}
+
+// SearchInstalledStickerSetsRequest is the request of SearchInstalledStickerSets: Searches for installed sticker sets by looking for specified query in their title and name
+type SearchInstalledStickerSetsRequest struct {
+	IsMasks bool   `json:"is_masks"` // Pass true to return mask sticker sets; pass false to return ordinary sticker sets
+	Query   string `json:"query"`    // Query to search for
+	Limit   int32  `json:"limit"`    // The maximum number of sticker sets to return
+}
+
+// MessageType return the string telegram-type of SearchInstalledStickerSetsRequest
+func (searchInstalledStickerSetsRequest *SearchInstalledStickerSetsRequest) MessageType() string {
+	return "searchInstalledStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchInstalledStickerSetsRequest SearchInstalledStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchInstalledStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchInstalledStickerSets", alias(searchInstalledStickerSetsRequest)})
+}
+
+// SearchStickerSetsRequest is the request of SearchStickerSets: Searches for ordinary sticker sets by looking for specified query in their title and name. Excludes installed sticker sets from the results
+type SearchStickerSetsRequest struct {
+	Query string `json:"query"` // Query to search for
+}
+
+// MessageType return the string telegram-type of SearchStickerSetsRequest
+func (searchStickerSetsRequest *SearchStickerSetsRequest) MessageType() string {
+	return "searchStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchStickerSetsRequest SearchStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchStickerSets", alias(searchStickerSetsRequest)})
+}
+
+// ChangeStickerSetRequest is the request of ChangeStickerSet: Installs/uninstalls or activates/archives a sticker set
+type ChangeStickerSetRequest struct {
+	SetID       JSONInt64 `json:"set_id"`       // Identifier of the sticker set
+	IsInstalled bool      `json:"is_installed"` // The new value of is_installed
+	IsArchived  bool      `json:"is_archived"`  // The new value of is_archived. A sticker set can't be installed and archived simultaneously
+}
+
+// MessageType return the string telegram-type of ChangeStickerSetRequest
+func (changeStickerSetRequest *ChangeStickerSetRequest) MessageType() string {
+	return "changeStickerSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (changeStickerSetRequest ChangeStickerSetRequest) MarshalJSON() ([]byte, error) {
+	type alias ChangeStickerSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"changeStickerSet", alias(changeStickerSetRequest)})
+}
+
+// ViewTrendingStickerSetsRequest is the request of ViewTrendingStickerSets: Informs the server that some trending sticker sets have been viewed by the user
+type ViewTrendingStickerSetsRequest struct {
+	StickerSetIDs []JSONInt64 `json:"sticker_set_ids"` // Identifiers of viewed trending sticker sets
+}
+
+// MessageType return the string telegram-type of ViewTrendingStickerSetsRequest
+func (viewTrendingStickerSetsRequest *ViewTrendingStickerSetsRequest) MessageType() string {
+	return "viewTrendingStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (viewTrendingStickerSetsRequest ViewTrendingStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias ViewTrendingStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"viewTrendingStickerSets", alias(viewTrendingStickerSetsRequest)})
+}
+
+// ReorderInstalledStickerSetsRequest is the request of ReorderInstalledStickerSets: Changes the order of installed sticker sets
+type ReorderInstalledStickerSetsRequest struct {
+	IsMasks       bool        `json:"is_masks"`        // Pass true to change the order of mask sticker sets; pass false to change the order of ordinary sticker sets
+	StickerSetIDs []JSONInt64 `json:"sticker_set_ids"` // Identifiers of installed sticker sets in the new correct order
+}
+
+// MessageType return the string telegram-type of ReorderInstalledStickerSetsRequest
+func (reorderInstalledStickerSetsRequest *ReorderInstalledStickerSetsRequest) MessageType() string {
+	return "reorderInstalledStickerSets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (reorderInstalledStickerSetsRequest ReorderInstalledStickerSetsRequest) MarshalJSON() ([]byte, error) {
+	type alias ReorderInstalledStickerSetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"reorderInstalledStickerSets", alias(reorderInstalledStickerSetsRequest)})
+}
+
+// GetRecentStickersRequest is the request of GetRecentStickers: Returns a list of recently used stickers
+type GetRecentStickersRequest struct {
+	IsAttached bool `json:"is_attached"` // Pass true to return stickers and masks that were recently attached to photos or video files; pass false to return recently sent stickers
+}
+
+// MessageType return the string telegram-type of GetRecentStickersRequest
+func (getRecentStickersRequest *GetRecentStickersRequest) MessageType() string {
+	return "getRecentStickers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRecentStickersRequest GetRecentStickersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRecentStickersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRecentStickers", alias(getRecentStickersRequest)})
+}
+
+// AddRecentStickerRequest is the request of AddRecentSticker: Manually adds a new sticker to the list of recently used stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list
+type AddRecentStickerRequest struct {
+	IsAttached bool      `json:"is_attached"` // Pass true to add the sticker to the list of stickers recently attached to photo or video files; pass false to add the sticker to the list of recently sent stickers
+	Sticker    InputFile `json:"sticker"`     // Sticker file to add
+}
+
+// MessageType return the string telegram-type of AddRecentStickerRequest
+func (addRecentStickerRequest *AddRecentStickerRequest) MessageType() string {
+	return "addRecentSticker"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addRecentStickerRequest AddRecentStickerRequest) MarshalJSON() ([]byte, error) {
+	type alias AddRecentStickerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addRecentSticker", alias(addRecentStickerRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addRecentStickerRequest *AddRecentStickerRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		IsAttached bool `json:"is_attached"` // Pass true to add the sticker to the list of stickers recently attached to photo or video files; pass false to add the sticker to the list of recently sent stickers
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	addRecentStickerRequest.IsAttached = tempObj.IsAttached
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	addRecentStickerRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// RemoveRecentStickerRequest is the request of RemoveRecentSticker: Removes a sticker from the list of recently used stickers
+type RemoveRecentStickerRequest struct {
+	IsAttached bool      `json:"is_attached"` // Pass true to remove the sticker from the list of stickers recently attached to photo or video files; pass false to remove the sticker from the list of recently sent stickers
+	Sticker    InputFile `json:"sticker"`     // Sticker file to delete
+}
+
+// MessageType return the string telegram-type of RemoveRecentStickerRequest
+func (removeRecentStickerRequest *RemoveRecentStickerRequest) MessageType() string {
+	return "removeRecentSticker"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeRecentStickerRequest RemoveRecentStickerRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveRecentStickerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeRecentSticker", alias(removeRecentStickerRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (removeRecentStickerRequest *RemoveRecentStickerRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		IsAttached bool `json:"is_attached"` // Pass true to remove the sticker from the list of stickers recently attached to photo or video files; pass false to remove the sticker from the list of recently sent stickers
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	removeRecentStickerRequest.IsAttached = tempObj.IsAttached
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	removeRecentStickerRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// ClearRecentStickersRequest is the request of ClearRecentStickers: Clears the list of recently used stickers
+type ClearRecentStickersRequest struct {
+	IsAttached bool `json:"is_attached"` // Pass true to clear the list of stickers recently attached to photo or video files; pass false to clear the list of recently sent stickers
+}
+
+// MessageType return the string telegram-type of ClearRecentStickersRequest
+func (clearRecentStickersRequest *ClearRecentStickersRequest) MessageType() string {
+	return "clearRecentStickers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (clearRecentStickersRequest ClearRecentStickersRequest) MarshalJSON() ([]byte, error) {
+	type alias ClearRecentStickersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"clearRecentStickers", alias(clearRecentStickersRequest)})
+}
+
+// GetFavoriteStickersRequest is the request of GetFavoriteStickers: Returns favorite stickers
+type GetFavoriteStickersRequest struct {
+}
+
+// MessageType return the string telegram-type of GetFavoriteStickersRequest
+func (getFavoriteStickersRequest *GetFavoriteStickersRequest) MessageType() string {
+	return "getFavoriteStickers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getFavoriteStickersRequest GetFavoriteStickersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetFavoriteStickersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getFavoriteStickers", alias(getFavoriteStickersRequest)})
+}
+
+// AddFavoriteStickerRequest is the request of AddFavoriteSticker: Adds a new sticker to the list of favorite stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list
+type AddFavoriteStickerRequest struct {
+	Sticker InputFile `json:"sticker"` // Sticker file to add
+}
+
+// MessageType return the string telegram-type of AddFavoriteStickerRequest
+func (addFavoriteStickerRequest *AddFavoriteStickerRequest) MessageType() string {
+	return "addFavoriteSticker"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addFavoriteStickerRequest AddFavoriteStickerRequest) MarshalJSON() ([]byte, error) {
+	type alias AddFavoriteStickerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addFavoriteSticker", alias(addFavoriteStickerRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addFavoriteStickerRequest *AddFavoriteStickerRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	addFavoriteStickerRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// RemoveFavoriteStickerRequest is the request of RemoveFavoriteSticker: Removes a sticker from the list of favorite stickers
+type RemoveFavoriteStickerRequest struct {
+	Sticker InputFile `json:"sticker"` // Sticker file to delete from the list
+}
+
+// MessageType return the string telegram-type of RemoveFavoriteStickerRequest
+func (removeFavoriteStickerRequest *RemoveFavoriteStickerRequest) MessageType() string {
+	return "removeFavoriteSticker"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeFavoriteStickerRequest RemoveFavoriteStickerRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveFavoriteStickerRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeFavoriteSticker", alias(removeFavoriteStickerRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (removeFavoriteStickerRequest *RemoveFavoriteStickerRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	removeFavoriteStickerRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// GetStickerEmojisRequest is the request of GetStickerEmojis: Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
+type GetStickerEmojisRequest struct {
+	Sticker InputFile `json:"sticker"` // Sticker file identifier
+}
+
+// MessageType return the string telegram-type of GetStickerEmojisRequest
+func (getStickerEmojisRequest *GetStickerEmojisRequest) MessageType() string {
+	return "getStickerEmojis"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getStickerEmojisRequest GetStickerEmojisRequest) MarshalJSON() ([]byte, error) {
+	type alias GetStickerEmojisRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getStickerEmojis", alias(getStickerEmojisRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getStickerEmojisRequest *GetStickerEmojisRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	getStickerEmojisRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// SearchEmojisRequest is the request of SearchEmojis: Searches for emojis by keywords. Supported only if the file database is enabled
+type SearchEmojisRequest struct {
+	Text               string   `json:"text"`                 // Text to search for
+	ExactMatch         bool     `json:"exact_match"`          // True, if only emojis, which exactly match text needs to be returned
+	InputLanguageCodes []string `json:"input_language_codes"` // List of possible IETF language tags of the user's input language; may be empty if unknown
+}
+
+// MessageType return the string telegram-type of SearchEmojisRequest
+func (searchEmojisRequest *SearchEmojisRequest) MessageType() string {
+	return "searchEmojis"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchEmojisRequest SearchEmojisRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchEmojisRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchEmojis", alias(searchEmojisRequest)})
+}
+
+// GetEmojiSuggestionsURLRequest is the request of GetEmojiSuggestionsURL: Returns an HTTP URL which can be used to automatically log in to the translation platform and suggest new emoji replacements. The URL will be valid for 30 seconds after generation
+type GetEmojiSuggestionsURLRequest struct {
+	LanguageCode string `json:"language_code"` // Language code for which the emoji replacements will be suggested
+}
+
+// MessageType return the string telegram-type of GetEmojiSuggestionsURLRequest
+func (getEmojiSuggestionsURLRequest *GetEmojiSuggestionsURLRequest) MessageType() string {
+	return "getEmojiSuggestionsUrl"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getEmojiSuggestionsURLRequest GetEmojiSuggestionsURLRequest) MarshalJSON() ([]byte, error) {
+	type alias GetEmojiSuggestionsURLRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getEmojiSuggestionsUrl", alias(getEmojiSuggestionsURLRequest)})
+}
+
+// GetSavedAnimationsRequest is the request of GetSavedAnimations: Returns saved animations
+type GetSavedAnimationsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetSavedAnimationsRequest
+func (getSavedAnimationsRequest *GetSavedAnimationsRequest) MessageType() string {
+	return "getSavedAnimations"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSavedAnimationsRequest GetSavedAnimationsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSavedAnimationsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSavedAnimations", alias(getSavedAnimationsRequest)})
+}
+
+// AddSavedAnimationRequest is the request of AddSavedAnimation: Manually adds a new animation to the list of saved animations. The new animation is added to the beginning of the list. If the animation was already in the list, it is removed first. Only non-secret video animations with MIME type "video/mp4" can be added to the list
+type AddSavedAnimationRequest struct {
+	Animation InputFile `json:"animation"` // The animation file to be added. Only animations known to the server (i.e. successfully sent via a message) can be added to the list
+}
+
+// MessageType return the string telegram-type of AddSavedAnimationRequest
+func (addSavedAnimationRequest *AddSavedAnimationRequest) MessageType() string {
+	return "addSavedAnimation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addSavedAnimationRequest AddSavedAnimationRequest) MarshalJSON() ([]byte, error) {
+	type alias AddSavedAnimationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addSavedAnimation", alias(addSavedAnimationRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addSavedAnimationRequest *AddSavedAnimationRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldAnimation, _ := unmarshalInputFile(objMap["animation"])
+	addSavedAnimationRequest.Animation = fieldAnimation
+
+	return nil
+}
+
+// RemoveSavedAnimationRequest is the request of RemoveSavedAnimation: Removes an animation from the list of saved animations
+type RemoveSavedAnimationRequest struct {
+	Animation InputFile `json:"animation"` // Animation file to be removed
+}
+
+// MessageType return the string telegram-type of RemoveSavedAnimationRequest
+func (removeSavedAnimationRequest *RemoveSavedAnimationRequest) MessageType() string {
+	return "removeSavedAnimation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeSavedAnimationRequest RemoveSavedAnimationRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveSavedAnimationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeSavedAnimation", alias(removeSavedAnimationRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (removeSavedAnimationRequest *RemoveSavedAnimationRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldAnimation, _ := unmarshalInputFile(objMap["animation"])
+	removeSavedAnimationRequest.Animation = fieldAnimation
+
+	return nil
+}
+
+// GetRecentInlineBotsRequest is the request of GetRecentInlineBots: Returns up to 20 recently used inline bots in the order of their last usage
+type GetRecentInlineBotsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetRecentInlineBotsRequest
+func (getRecentInlineBotsRequest *GetRecentInlineBotsRequest) MessageType() string {
+	return "getRecentInlineBots"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRecentInlineBotsRequest GetRecentInlineBotsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRecentInlineBotsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRecentInlineBots", alias(getRecentInlineBotsRequest)})
+}
+
+// SearchHashtagsRequest is the request of SearchHashtags: Searches for recently used hashtags by their prefix
+type SearchHashtagsRequest struct {
+	Prefix string `json:"prefix"` // Hashtag prefix to search for
+	Limit  int32  `json:"limit"`  // The maximum number of hashtags to be returned
+}
+
+// MessageType return the string telegram-type of SearchHashtagsRequest
+func (searchHashtagsRequest *SearchHashtagsRequest) MessageType() string {
+	return "searchHashtags"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchHashtagsRequest SearchHashtagsRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchHashtagsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchHashtags", alias(searchHashtagsRequest)})
+}
+
+// RemoveRecentHashtagRequest is the request of RemoveRecentHashtag: Removes a hashtag from the list of recently used hashtags
+type RemoveRecentHashtagRequest struct {
+	Hashtag string `json:"hashtag"` // Hashtag to delete
+}
+
+// MessageType return the string telegram-type of RemoveRecentHashtagRequest
+func (removeRecentHashtagRequest *RemoveRecentHashtagRequest) MessageType() string {
+	return "removeRecentHashtag"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeRecentHashtagRequest RemoveRecentHashtagRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveRecentHashtagRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeRecentHashtag", alias(removeRecentHashtagRequest)})
+}
+
+// GetWebPagePreviewRequest is the request of GetWebPagePreview: Returns a web page preview by the text of the message. Do not call this function too often. Returns a 404 error if the web page has no preview
+type GetWebPagePreviewRequest struct {
+	Text *FormattedText `json:"text"` // Message text with formatting
+}
+
+// MessageType return the string telegram-type of GetWebPagePreviewRequest
+func (getWebPagePreviewRequest *GetWebPagePreviewRequest) MessageType() string {
+	return "getWebPagePreview"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getWebPagePreviewRequest GetWebPagePreviewRequest) MarshalJSON() ([]byte, error) {
+	type alias GetWebPagePreviewRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getWebPagePreview", alias(getWebPagePreviewRequest)})
+}
+
+// GetWebPageInstantViewRequest is the request of GetWebPageInstantView: Returns an instant view version of a web page if available. Returns a 404 error if the web page has no instant view page
+type GetWebPageInstantViewRequest struct {
+	URL       string `json:"url"`        // The web page URL
+	ForceFull bool   `json:"force_full"` // If true, the full instant view for the web page will be returned
+}
+
+// MessageType return the string telegram-type of GetWebPageInstantViewRequest
+func (getWebPageInstantViewRequest *GetWebPageInstantViewRequest) MessageType() string {
+	return "getWebPageInstantView"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getWebPageInstantViewRequest GetWebPageInstantViewRequest) MarshalJSON() ([]byte, error) {
+	type alias GetWebPageInstantViewRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getWebPageInstantView", alias(getWebPageInstantViewRequest)})
+}
+
+// SetProfilePhotoRequest is the request of SetProfilePhoto: Changes a profile photo for the current user
+type SetProfilePhotoRequest struct {
+	Photo InputChatPhoto `json:"photo"` // Profile photo to set
+}
+
+// MessageType return the string telegram-type of SetProfilePhotoRequest
+func (setProfilePhotoRequest *SetProfilePhotoRequest) MessageType() string {
+	return "setProfilePhoto"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setProfilePhotoRequest SetProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	type alias SetProfilePhotoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setProfilePhoto", alias(setProfilePhotoRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setProfilePhotoRequest *SetProfilePhotoRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldPhoto, _ := unmarshalInputChatPhoto(objMap["photo"])
+	setProfilePhotoRequest.Photo = fieldPhoto
+
+	return nil
+}
+
+// DeleteProfilePhotoRequest is the request of DeleteProfilePhoto: Deletes a profile photo
+type DeleteProfilePhotoRequest struct {
+	ProfilePhotoID JSONInt64 `json:"profile_photo_id"` // Identifier of the profile photo to delete
+}
+
+// MessageType return the string telegram-type of DeleteProfilePhotoRequest
+func (deleteProfilePhotoRequest *DeleteProfilePhotoRequest) MessageType() string {
+	return "deleteProfilePhoto"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteProfilePhotoRequest DeleteProfilePhotoRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteProfilePhotoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteProfilePhoto", alias(deleteProfilePhotoRequest)})
+}
+
+// SetNameRequest is the request of SetName: Changes the first and last name of the current user
+type SetNameRequest struct {
+	FirstName string `json:"first_name"` // The new value of the first name for the user; 1-64 characters
+	LastName  string `json:"last_name"`  // The new value of the optional last name for the user; 0-64 characters
+}
+
+// MessageType return the string telegram-type of SetNameRequest
+func (setNameRequest *SetNameRequest) MessageType() string {
+	return "setName"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setNameRequest SetNameRequest) MarshalJSON() ([]byte, error) {
+	type alias SetNameRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setName", alias(setNameRequest)})
+}
+
+// SetBioRequest is the request of SetBio: Changes the bio of the current user
+type SetBioRequest struct {
+	Bio string `json:"bio"` // The new value of the user bio; 0-70 characters without line feeds
+}
+
+// MessageType return the string telegram-type of SetBioRequest
+func (setBioRequest *SetBioRequest) MessageType() string {
+	return "setBio"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setBioRequest SetBioRequest) MarshalJSON() ([]byte, error) {
+	type alias SetBioRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setBio", alias(setBioRequest)})
+}
+
+// SetUsernameRequest is the request of SetUsername: Changes the username of the current user
+type SetUsernameRequest struct {
+	Username string `json:"username"` // The new value of the username. Use an empty string to remove the username
+}
+
+// MessageType return the string telegram-type of SetUsernameRequest
+func (setUsernameRequest *SetUsernameRequest) MessageType() string {
+	return "setUsername"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setUsernameRequest SetUsernameRequest) MarshalJSON() ([]byte, error) {
+	type alias SetUsernameRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setUsername", alias(setUsernameRequest)})
+}
+
+// SetLocationRequest is the request of SetLocation: Changes the location of the current user. Needs to be called if GetOption("is_location_visible") is true and location changes for more than 1 kilometer
+type SetLocationRequest struct {
+	Location *Location `json:"location"` // The new location of the user
+}
+
+// MessageType return the string telegram-type of SetLocationRequest
+func (setLocationRequest *SetLocationRequest) MessageType() string {
+	return "setLocation"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setLocationRequest SetLocationRequest) MarshalJSON() ([]byte, error) {
+	type alias SetLocationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setLocation", alias(setLocationRequest)})
+}
+
+// ChangePhoneNumberRequest is the request of ChangePhoneNumber: Changes the phone number of the user and sends an authentication code to the user's new phone number. On success, returns information about the sent code
+type ChangePhoneNumberRequest struct {
+	PhoneNumber string                             `json:"phone_number"` // The new phone number of the user in international format
+	Settings    *PhoneNumberAuthenticationSettings `json:"settings"`     // Settings for the authentication of the user's phone number
+}
+
+// MessageType return the string telegram-type of ChangePhoneNumberRequest
+func (changePhoneNumberRequest *ChangePhoneNumberRequest) MessageType() string {
+	return "changePhoneNumber"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (changePhoneNumberRequest ChangePhoneNumberRequest) MarshalJSON() ([]byte, error) {
+	type alias ChangePhoneNumberRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"changePhoneNumber", alias(changePhoneNumberRequest)})
+}
+
+// ResendChangePhoneNumberCodeRequest is the request of ResendChangePhoneNumberCode: Re-sends the authentication code sent to confirm a new phone number for the user. Works only if the previously received authenticationCodeInfo next_code_type was not null
+type ResendChangePhoneNumberCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of ResendChangePhoneNumberCodeRequest
+func (resendChangePhoneNumberCodeRequest *ResendChangePhoneNumberCodeRequest) MessageType() string {
+	return "resendChangePhoneNumberCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendChangePhoneNumberCodeRequest ResendChangePhoneNumberCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendChangePhoneNumberCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendChangePhoneNumberCode", alias(resendChangePhoneNumberCodeRequest)})
+}
+
+// CheckChangePhoneNumberCodeRequest is the request of CheckChangePhoneNumberCode: Checks the authentication code sent to confirm a new phone number of the user
+type CheckChangePhoneNumberCodeRequest struct {
+	Code string `json:"code"` // Verification code received by SMS, phone call or flash call
+}
+
+// MessageType return the string telegram-type of CheckChangePhoneNumberCodeRequest
+func (checkChangePhoneNumberCodeRequest *CheckChangePhoneNumberCodeRequest) MessageType() string {
+	return "checkChangePhoneNumberCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkChangePhoneNumberCodeRequest CheckChangePhoneNumberCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckChangePhoneNumberCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkChangePhoneNumberCode", alias(checkChangePhoneNumberCodeRequest)})
+}
+
+// SetCommandsRequest is the request of SetCommands: Sets the list of commands supported by the bot; for bots only
+type SetCommandsRequest struct {
+	Commands []BotCommand `json:"commands"` // List of the bot's commands
+}
+
+// MessageType return the string telegram-type of SetCommandsRequest
+func (setCommandsRequest *SetCommandsRequest) MessageType() string {
+	return "setCommands"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setCommandsRequest SetCommandsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetCommandsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setCommands", alias(setCommandsRequest)})
+}
+
+// GetActiveSessionsRequest is the request of GetActiveSessions: Returns all active sessions of the current user
+type GetActiveSessionsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetActiveSessionsRequest
+func (getActiveSessionsRequest *GetActiveSessionsRequest) MessageType() string {
+	return "getActiveSessions"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getActiveSessionsRequest GetActiveSessionsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetActiveSessionsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getActiveSessions", alias(getActiveSessionsRequest)})
+}
+
+// TerminateSessionRequest is the request of TerminateSession: Terminates a session of the current user
+type TerminateSessionRequest struct {
+	SessionID JSONInt64 `json:"session_id"` // Session identifier
+}
+
+// MessageType return the string telegram-type of TerminateSessionRequest
+func (terminateSessionRequest *TerminateSessionRequest) MessageType() string {
+	return "terminateSession"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (terminateSessionRequest TerminateSessionRequest) MarshalJSON() ([]byte, error) {
+	type alias TerminateSessionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"terminateSession", alias(terminateSessionRequest)})
+}
+
+// TerminateAllOtherSessionsRequest is the request of TerminateAllOtherSessions: Terminates all other sessions of the current user
+type TerminateAllOtherSessionsRequest struct {
+}
+
+// MessageType return the string telegram-type of TerminateAllOtherSessionsRequest
+func (terminateAllOtherSessionsRequest *TerminateAllOtherSessionsRequest) MessageType() string {
+	return "terminateAllOtherSessions"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (terminateAllOtherSessionsRequest TerminateAllOtherSessionsRequest) MarshalJSON() ([]byte, error) {
+	type alias TerminateAllOtherSessionsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"terminateAllOtherSessions", alias(terminateAllOtherSessionsRequest)})
+}
+
+// GetConnectedWebsitesRequest is the request of GetConnectedWebsites: Returns all website where the current user used Telegram to log in
+type GetConnectedWebsitesRequest struct {
+}
+
+// MessageType return the string telegram-type of GetConnectedWebsitesRequest
+func (getConnectedWebsitesRequest *GetConnectedWebsitesRequest) MessageType() string {
+	return "getConnectedWebsites"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getConnectedWebsitesRequest GetConnectedWebsitesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetConnectedWebsitesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getConnectedWebsites", alias(getConnectedWebsitesRequest)})
+}
+
+// DisconnectWebsiteRequest is the request of DisconnectWebsite: Disconnects website from the current user's Telegram account
+type DisconnectWebsiteRequest struct {
+	WebsiteID JSONInt64 `json:"website_id"` // Website identifier
+}
+
+// MessageType return the string telegram-type of DisconnectWebsiteRequest
+func (disconnectWebsiteRequest *DisconnectWebsiteRequest) MessageType() string {
+	return "disconnectWebsite"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (disconnectWebsiteRequest DisconnectWebsiteRequest) MarshalJSON() ([]byte, error) {
+	type alias DisconnectWebsiteRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"disconnectWebsite", alias(disconnectWebsiteRequest)})
+}
+
+// DisconnectAllWebsitesRequest is the request of DisconnectAllWebsites: Disconnects all websites from the current user's Telegram account
+type DisconnectAllWebsitesRequest struct {
+}
+
+// MessageType return the string telegram-type of DisconnectAllWebsitesRequest
+func (disconnectAllWebsitesRequest *DisconnectAllWebsitesRequest) MessageType() string {
+	return "disconnectAllWebsites"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (disconnectAllWebsitesRequest DisconnectAllWebsitesRequest) MarshalJSON() ([]byte, error) {
+	type alias DisconnectAllWebsitesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"disconnectAllWebsites", alias(disconnectAllWebsitesRequest)})
+}
+
+// SetSupergroupUsernameRequest is the request of SetSupergroupUsername: Changes the username of a supergroup or channel, requires owner privileges in the supergroup or channel
+type SetSupergroupUsernameRequest struct {
+	SupergroupID int32  `json:"supergroup_id"` // Identifier of the supergroup or channel
+	Username     string `json:"username"`      // New value of the username. Use an empty string to remove the username
+}
+
+// MessageType return the string telegram-type of SetSupergroupUsernameRequest
+func (setSupergroupUsernameRequest *SetSupergroupUsernameRequest) MessageType() string {
+	return "setSupergroupUsername"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setSupergroupUsernameRequest SetSupergroupUsernameRequest) MarshalJSON() ([]byte, error) {
+	type alias SetSupergroupUsernameRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setSupergroupUsername", alias(setSupergroupUsernameRequest)})
+}
+
+// SetSupergroupStickerSetRequest is the request of SetSupergroupStickerSet: Changes the sticker set of a supergroup; requires can_change_info administrator right
+type SetSupergroupStickerSetRequest struct {
+	SupergroupID int32     `json:"supergroup_id"`  // Identifier of the supergroup
+	StickerSetID JSONInt64 `json:"sticker_set_id"` // New value of the supergroup sticker set identifier. Use 0 to remove the supergroup sticker set
+}
+
+// MessageType return the string telegram-type of SetSupergroupStickerSetRequest
+func (setSupergroupStickerSetRequest *SetSupergroupStickerSetRequest) MessageType() string {
+	return "setSupergroupStickerSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setSupergroupStickerSetRequest SetSupergroupStickerSetRequest) MarshalJSON() ([]byte, error) {
+	type alias SetSupergroupStickerSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setSupergroupStickerSet", alias(setSupergroupStickerSetRequest)})
+}
+
+// ToggleSupergroupSignMessagesRequest is the request of ToggleSupergroupSignMessages: Toggles sender signatures messages sent in a channel; requires can_change_info administrator right
+type ToggleSupergroupSignMessagesRequest struct {
+	SupergroupID int32 `json:"supergroup_id"` // Identifier of the channel
+	SignMessages bool  `json:"sign_messages"` // New value of sign_messages
+}
+
+// MessageType return the string telegram-type of ToggleSupergroupSignMessagesRequest
+func (toggleSupergroupSignMessagesRequest *ToggleSupergroupSignMessagesRequest) MessageType() string {
+	return "toggleSupergroupSignMessages"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleSupergroupSignMessagesRequest ToggleSupergroupSignMessagesRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleSupergroupSignMessagesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleSupergroupSignMessages", alias(toggleSupergroupSignMessagesRequest)})
+}
+
+// ToggleSupergroupIsAllHistoryAvailableRequest is the request of ToggleSupergroupIsAllHistoryAvailable: Toggles whether the message history of a supergroup is available to new members; requires can_change_info administrator right
+type ToggleSupergroupIsAllHistoryAvailableRequest struct {
+	SupergroupID          int32 `json:"supergroup_id"`            // The identifier of the supergroup
+	IsAllHistoryAvailable bool  `json:"is_all_history_available"` // The new value of is_all_history_available
+}
+
+// MessageType return the string telegram-type of ToggleSupergroupIsAllHistoryAvailableRequest
+func (toggleSupergroupIsAllHistoryAvailableRequest *ToggleSupergroupIsAllHistoryAvailableRequest) MessageType() string {
+	return "toggleSupergroupIsAllHistoryAvailable"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (toggleSupergroupIsAllHistoryAvailableRequest ToggleSupergroupIsAllHistoryAvailableRequest) MarshalJSON() ([]byte, error) {
+	type alias ToggleSupergroupIsAllHistoryAvailableRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"toggleSupergroupIsAllHistoryAvailable", alias(toggleSupergroupIsAllHistoryAvailableRequest)})
+}
+
+// ReportSupergroupSpamRequest is the request of ReportSupergroupSpam: Reports some messages from a user in a supergroup as spam; requires administrator rights in the supergroup
+type ReportSupergroupSpamRequest struct {
+	SupergroupID int32       `json:"supergroup_id"` // Supergroup identifier
+	UserID       int32       `json:"user_id"`       // User identifier
+	MessageIDs   []JSONInt64 `json:"message_ids"`   // Identifiers of messages sent in the supergroup by the user. This list must be non-empty
+}
+
+// MessageType return the string telegram-type of ReportSupergroupSpamRequest
+func (reportSupergroupSpamRequest *ReportSupergroupSpamRequest) MessageType() string {
+	return "reportSupergroupSpam"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (reportSupergroupSpamRequest ReportSupergroupSpamRequest) MarshalJSON() ([]byte, error) {
+	type alias ReportSupergroupSpamRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"reportSupergroupSpam", alias(reportSupergroupSpamRequest)})
+}
+
+// GetSupergroupMembersRequest is the request of GetSupergroupMembers: Returns information about members or banned users in a supergroup or channel. Can be used only if SupergroupFullInfo.can_get_members == true; additionally, administrator privileges may be required for some filters
+type GetSupergroupMembersRequest struct {
+	SupergroupID int32                   `json:"supergroup_id"` // Identifier of the supergroup or channel
+	Filter       SupergroupMembersFilter `json:"filter"`        // The type of users to return. By default, supergroupMembersFilterRecent
+	Offset       int32                   `json:"offset"`        // Number of users to skip
+	Limit        int32                   `json:"limit"`         // The maximum number of users be returned; up to 200
+}
+
+// MessageType return the string telegram-type of GetSupergroupMembersRequest
+func (getSupergroupMembersRequest *GetSupergroupMembersRequest) MessageType() string {
+	return "getSupergroupMembers"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSupergroupMembersRequest GetSupergroupMembersRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSupergroupMembersRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSupergroupMembers", alias(getSupergroupMembersRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getSupergroupMembersRequest *GetSupergroupMembersRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		SupergroupID int32 `json:"supergroup_id"` // Identifier of the supergroup or channel
+		Offset       int32 `json:"offset"`        // Number of users to skip
+		Limit        int32 `json:"limit"`         // The maximum number of users be returned; up to 200
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getSupergroupMembersRequest.SupergroupID = tempObj.SupergroupID
+	getSupergroupMembersRequest.Offset = tempObj.Offset
+	getSupergroupMembersRequest.Limit = tempObj.Limit
+
+	fieldFilter, _ := unmarshalSupergroupMembersFilter(objMap["filter"])
+	getSupergroupMembersRequest.Filter = fieldFilter
+
+	return nil
+}
+
+// CloseSecretChatRequest is the request of CloseSecretChat: Closes a secret chat, effectively transferring its state to secretChatStateClosed
+type CloseSecretChatRequest struct {
+	SecretChatID int32 `json:"secret_chat_id"` // Secret chat identifier
+}
+
+// MessageType return the string telegram-type of CloseSecretChatRequest
+func (closeSecretChatRequest *CloseSecretChatRequest) MessageType() string {
+	return "closeSecretChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (closeSecretChatRequest CloseSecretChatRequest) MarshalJSON() ([]byte, error) {
+	type alias CloseSecretChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"closeSecretChat", alias(closeSecretChatRequest)})
+}
+
+// GetChatEventLogRequest is the request of GetChatEventLog: Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i. e., in order of decreasing event_id)
+type GetChatEventLogRequest struct {
+	ChatID      JSONInt64            `json:"chat_id"`       // Chat identifier
+	Query       string               `json:"query"`         // Search query by which to filter events
+	FromEventID JSONInt64            `json:"from_event_id"` // Identifier of an event from which to return results. Use 0 to get results from the latest events
+	Limit       int32                `json:"limit"`         // The maximum number of events to return; up to 100
+	Filters     *ChatEventLogFilters `json:"filters"`       // The types of events to return. By default, all types will be returned
+	UserIDs     []int32              `json:"user_ids"`      // User identifiers by which to filter events. By default, events relating to all users will be returned
+}
+
+// MessageType return the string telegram-type of GetChatEventLogRequest
+func (getChatEventLogRequest *GetChatEventLogRequest) MessageType() string {
+	return "getChatEventLog"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatEventLogRequest GetChatEventLogRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatEventLogRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatEventLog", alias(getChatEventLogRequest)})
+}
+
+// GetPaymentFormRequest is the request of GetPaymentForm: Returns an invoice payment form. This method should be called when the user presses inlineKeyboardButtonBuy
+type GetPaymentFormRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier of the Invoice message
+	MessageID JSONInt64 `json:"message_id"` // Message identifier
+}
+
+// MessageType return the string telegram-type of GetPaymentFormRequest
+func (getPaymentFormRequest *GetPaymentFormRequest) MessageType() string {
+	return "getPaymentForm"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPaymentFormRequest GetPaymentFormRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPaymentFormRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPaymentForm", alias(getPaymentFormRequest)})
+}
+
+// ValidateOrderInfoRequest is the request of ValidateOrderInfo: Validates the order information provided by a user and returns the available shipping options for a flexible invoice
+type ValidateOrderInfoRequest struct {
+	ChatID    JSONInt64  `json:"chat_id"`    // Chat identifier of the Invoice message
+	MessageID JSONInt64  `json:"message_id"` // Message identifier
+	OrderInfo *OrderInfo `json:"order_info"` // The order information, provided by the user
+	AllowSave bool       `json:"allow_save"` // True, if the order information can be saved
+}
+
+// MessageType return the string telegram-type of ValidateOrderInfoRequest
+func (validateOrderInfoRequest *ValidateOrderInfoRequest) MessageType() string {
+	return "validateOrderInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (validateOrderInfoRequest ValidateOrderInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias ValidateOrderInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"validateOrderInfo", alias(validateOrderInfoRequest)})
+}
+
+// SendPaymentFormRequest is the request of SendPaymentForm: Sends a filled-out payment form to the bot for final verification
+type SendPaymentFormRequest struct {
+	ChatID           JSONInt64        `json:"chat_id"`            // Chat identifier of the Invoice message
+	MessageID        JSONInt64        `json:"message_id"`         // Message identifier
+	OrderInfoID      string           `json:"order_info_id"`      // Identifier returned by ValidateOrderInfo, or an empty string
+	ShippingOptionID string           `json:"shipping_option_id"` // Identifier of a chosen shipping option, if applicable
+	Credentials      InputCredentials `json:"credentials"`        // The credentials chosen by user for payment
+}
+
+// MessageType return the string telegram-type of SendPaymentFormRequest
+func (sendPaymentFormRequest *SendPaymentFormRequest) MessageType() string {
+	return "sendPaymentForm"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendPaymentFormRequest SendPaymentFormRequest) MarshalJSON() ([]byte, error) {
+	type alias SendPaymentFormRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendPaymentForm", alias(sendPaymentFormRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (sendPaymentFormRequest *SendPaymentFormRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID           JSONInt64 `json:"chat_id"`            // Chat identifier of the Invoice message
+		MessageID        JSONInt64 `json:"message_id"`         // Message identifier
+		OrderInfoID      string    `json:"order_info_id"`      // Identifier returned by ValidateOrderInfo, or an empty string
+		ShippingOptionID string    `json:"shipping_option_id"` // Identifier of a chosen shipping option, if applicable
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	sendPaymentFormRequest.ChatID = tempObj.ChatID
+	sendPaymentFormRequest.MessageID = tempObj.MessageID
+	sendPaymentFormRequest.OrderInfoID = tempObj.OrderInfoID
+	sendPaymentFormRequest.ShippingOptionID = tempObj.ShippingOptionID
+
+	fieldCredentials, _ := unmarshalInputCredentials(objMap["credentials"])
+	sendPaymentFormRequest.Credentials = fieldCredentials
+
+	return nil
+}
+
+// GetPaymentReceiptRequest is the request of GetPaymentReceipt: Returns information about a successful payment
+type GetPaymentReceiptRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier of the PaymentSuccessful message
+	MessageID JSONInt64 `json:"message_id"` // Message identifier
+}
+
+// MessageType return the string telegram-type of GetPaymentReceiptRequest
+func (getPaymentReceiptRequest *GetPaymentReceiptRequest) MessageType() string {
+	return "getPaymentReceipt"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPaymentReceiptRequest GetPaymentReceiptRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPaymentReceiptRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPaymentReceipt", alias(getPaymentReceiptRequest)})
+}
+
+// GetSavedOrderInfoRequest is the request of GetSavedOrderInfo: Returns saved order info, if any
+type GetSavedOrderInfoRequest struct {
+}
+
+// MessageType return the string telegram-type of GetSavedOrderInfoRequest
+func (getSavedOrderInfoRequest *GetSavedOrderInfoRequest) MessageType() string {
+	return "getSavedOrderInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSavedOrderInfoRequest GetSavedOrderInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSavedOrderInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSavedOrderInfo", alias(getSavedOrderInfoRequest)})
+}
+
+// DeleteSavedOrderInfoRequest is the request of DeleteSavedOrderInfo: Deletes saved order info
+type DeleteSavedOrderInfoRequest struct {
+}
+
+// MessageType return the string telegram-type of DeleteSavedOrderInfoRequest
+func (deleteSavedOrderInfoRequest *DeleteSavedOrderInfoRequest) MessageType() string {
+	return "deleteSavedOrderInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteSavedOrderInfoRequest DeleteSavedOrderInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteSavedOrderInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteSavedOrderInfo", alias(deleteSavedOrderInfoRequest)})
+}
+
+// DeleteSavedCredentialsRequest is the request of DeleteSavedCredentials: Deletes saved credentials for all payment provider bots
+type DeleteSavedCredentialsRequest struct {
+}
+
+// MessageType return the string telegram-type of DeleteSavedCredentialsRequest
+func (deleteSavedCredentialsRequest *DeleteSavedCredentialsRequest) MessageType() string {
+	return "deleteSavedCredentials"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteSavedCredentialsRequest DeleteSavedCredentialsRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteSavedCredentialsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteSavedCredentials", alias(deleteSavedCredentialsRequest)})
+}
+
+// GetSupportUserRequest is the request of GetSupportUser: Returns a user that can be contacted to get support
+type GetSupportUserRequest struct {
+}
+
+// MessageType return the string telegram-type of GetSupportUserRequest
+func (getSupportUserRequest *GetSupportUserRequest) MessageType() string {
+	return "getSupportUser"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getSupportUserRequest GetSupportUserRequest) MarshalJSON() ([]byte, error) {
+	type alias GetSupportUserRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getSupportUser", alias(getSupportUserRequest)})
+}
+
+// GetBackgroundsRequest is the request of GetBackgrounds: Returns backgrounds installed by the user
+type GetBackgroundsRequest struct {
+	ForDarkTheme bool `json:"for_dark_theme"` // True, if the backgrounds must be ordered for dark theme
+}
+
+// MessageType return the string telegram-type of GetBackgroundsRequest
+func (getBackgroundsRequest *GetBackgroundsRequest) MessageType() string {
+	return "getBackgrounds"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getBackgroundsRequest GetBackgroundsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetBackgroundsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getBackgrounds", alias(getBackgroundsRequest)})
+}
+
+// GetBackgroundURLRequest is the request of GetBackgroundURL: Constructs a persistent HTTP URL for a background
+type GetBackgroundURLRequest struct {
+	Name string         `json:"name"` // Background name
+	Type BackgroundType `json:"type"` // Background type
+}
+
+// MessageType return the string telegram-type of GetBackgroundURLRequest
+func (getBackgroundURLRequest *GetBackgroundURLRequest) MessageType() string {
+	return "getBackgroundUrl"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getBackgroundURLRequest GetBackgroundURLRequest) MarshalJSON() ([]byte, error) {
+	type alias GetBackgroundURLRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getBackgroundUrl", alias(getBackgroundURLRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getBackgroundURLRequest *GetBackgroundURLRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Name string `json:"name"` // Background name
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getBackgroundURLRequest.Name = tempObj.Name
+
+	fieldType, _ := unmarshalBackgroundType(objMap["type"])
+	getBackgroundURLRequest.Type = fieldType
+
+	return nil
+}
+
+// SearchBackgroundRequest is the request of SearchBackground: Searches for a background by its name
+type SearchBackgroundRequest struct {
+	Name string `json:"name"` // The name of the background
+}
+
+// MessageType return the string telegram-type of SearchBackgroundRequest
+func (searchBackgroundRequest *SearchBackgroundRequest) MessageType() string {
+	return "searchBackground"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (searchBackgroundRequest SearchBackgroundRequest) MarshalJSON() ([]byte, error) {
+	type alias SearchBackgroundRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"searchBackground", alias(searchBackgroundRequest)})
+}
+
+// SetBackgroundRequest is the request of SetBackground: Changes the background selected by the user; adds background to the list of installed backgrounds
+type SetBackgroundRequest struct {
+	Background   InputBackground `json:"background"`     // The input background to use, null for filled backgrounds
+	Type         BackgroundType  `json:"type"`           // Background type; null for default background. The method will return error 404 if type is null
+	ForDarkTheme bool            `json:"for_dark_theme"` // True, if the background is chosen for dark theme
+}
+
+// MessageType return the string telegram-type of SetBackgroundRequest
+func (setBackgroundRequest *SetBackgroundRequest) MessageType() string {
+	return "setBackground"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setBackgroundRequest SetBackgroundRequest) MarshalJSON() ([]byte, error) {
+	type alias SetBackgroundRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setBackground", alias(setBackgroundRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setBackgroundRequest *SetBackgroundRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ForDarkTheme bool `json:"for_dark_theme"` // True, if the background is chosen for dark theme
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setBackgroundRequest.ForDarkTheme = tempObj.ForDarkTheme
+
+	fieldBackground, _ := unmarshalInputBackground(objMap["background"])
+	setBackgroundRequest.Background = fieldBackground
+
+	fieldType, _ := unmarshalBackgroundType(objMap["type"])
+	setBackgroundRequest.Type = fieldType
+
+	return nil
+}
+
+// RemoveBackgroundRequest is the request of RemoveBackground: Removes background from the list of installed backgrounds
+type RemoveBackgroundRequest struct {
+	BackgroundID JSONInt64 `json:"background_id"` // The background identifier
+}
+
+// MessageType return the string telegram-type of RemoveBackgroundRequest
+func (removeBackgroundRequest *RemoveBackgroundRequest) MessageType() string {
+	return "removeBackground"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeBackgroundRequest RemoveBackgroundRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveBackgroundRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeBackground", alias(removeBackgroundRequest)})
+}
+
+// ResetBackgroundsRequest is the request of ResetBackgrounds: Resets list of installed backgrounds to its default value
+type ResetBackgroundsRequest struct {
+}
+
+// MessageType return the string telegram-type of ResetBackgroundsRequest
+func (resetBackgroundsRequest *ResetBackgroundsRequest) MessageType() string {
+	return "resetBackgrounds"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resetBackgroundsRequest ResetBackgroundsRequest) MarshalJSON() ([]byte, error) {
+	type alias ResetBackgroundsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resetBackgrounds", alias(resetBackgroundsRequest)})
+}
+
+// GetLocalizationTargetInfoRequest is the request of GetLocalizationTargetInfo: Returns information about the current localization target. This is an offline request if only_local is true. Can be called before authorization
+type GetLocalizationTargetInfoRequest struct {
+	OnlyLocal bool `json:"only_local"` // If true, returns only locally available information without sending network requests
+}
+
+// MessageType return the string telegram-type of GetLocalizationTargetInfoRequest
+func (getLocalizationTargetInfoRequest *GetLocalizationTargetInfoRequest) MessageType() string {
+	return "getLocalizationTargetInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLocalizationTargetInfoRequest GetLocalizationTargetInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLocalizationTargetInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLocalizationTargetInfo", alias(getLocalizationTargetInfoRequest)})
+}
+
+// GetLanguagePackInfoRequest is the request of GetLanguagePackInfo: Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization
+type GetLanguagePackInfoRequest struct {
+	LanguagePackID string `json:"language_pack_id"` // Language pack identifier
+}
+
+// MessageType return the string telegram-type of GetLanguagePackInfoRequest
+func (getLanguagePackInfoRequest *GetLanguagePackInfoRequest) MessageType() string {
+	return "getLanguagePackInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLanguagePackInfoRequest GetLanguagePackInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLanguagePackInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLanguagePackInfo", alias(getLanguagePackInfoRequest)})
+}
+
+// GetLanguagePackStringsRequest is the request of GetLanguagePackStrings: Returns strings from a language pack in the current localization target by their keys. Can be called before authorization
+type GetLanguagePackStringsRequest struct {
+	LanguagePackID string   `json:"language_pack_id"` // Language pack identifier of the strings to be returned
+	Keys           []string `json:"keys"`             // Language pack keys of the strings to be returned; leave empty to request all available strings
+}
+
+// MessageType return the string telegram-type of GetLanguagePackStringsRequest
+func (getLanguagePackStringsRequest *GetLanguagePackStringsRequest) MessageType() string {
+	return "getLanguagePackStrings"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLanguagePackStringsRequest GetLanguagePackStringsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLanguagePackStringsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLanguagePackStrings", alias(getLanguagePackStringsRequest)})
+}
+
+// SynchronizeLanguagePackRequest is the request of SynchronizeLanguagePack: Fetches the latest versions of all strings from a language pack in the current localization target from the server. This method shouldn't be called explicitly for the current used/base language packs. Can be called before authorization
+type SynchronizeLanguagePackRequest struct {
+	LanguagePackID string `json:"language_pack_id"` // Language pack identifier
+}
+
+// MessageType return the string telegram-type of SynchronizeLanguagePackRequest
+func (synchronizeLanguagePackRequest *SynchronizeLanguagePackRequest) MessageType() string {
+	return "synchronizeLanguagePack"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (synchronizeLanguagePackRequest SynchronizeLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	type alias SynchronizeLanguagePackRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"synchronizeLanguagePack", alias(synchronizeLanguagePackRequest)})
+}
+
+// AddCustomServerLanguagePackRequest is the request of AddCustomServerLanguagePack: Adds a custom server language pack to the list of installed language packs in current localization target. Can be called before authorization
+type AddCustomServerLanguagePackRequest struct {
+	LanguagePackID string `json:"language_pack_id"` // Identifier of a language pack to be added; may be different from a name that is used in an "https://t.me/setlanguage/" link
+}
+
+// MessageType return the string telegram-type of AddCustomServerLanguagePackRequest
+func (addCustomServerLanguagePackRequest *AddCustomServerLanguagePackRequest) MessageType() string {
+	return "addCustomServerLanguagePack"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addCustomServerLanguagePackRequest AddCustomServerLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	type alias AddCustomServerLanguagePackRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addCustomServerLanguagePack", alias(addCustomServerLanguagePackRequest)})
+}
+
+// SetCustomLanguagePackRequest is the request of SetCustomLanguagePack: Adds or changes a custom local language pack to the current localization target
+type SetCustomLanguagePackRequest struct {
+	Info    *LanguagePackInfo    `json:"info"`    // Information about the language pack. Language pack ID must start with 'X', consist only of English letters, digits and hyphens, and must not exceed 64 characters. Can be called before authorization
+	Strings []LanguagePackString `json:"strings"` // Strings of the new language pack
+}
+
+// MessageType return the string telegram-type of SetCustomLanguagePackRequest
+func (setCustomLanguagePackRequest *SetCustomLanguagePackRequest) MessageType() string {
+	return "setCustomLanguagePack"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setCustomLanguagePackRequest SetCustomLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	type alias SetCustomLanguagePackRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setCustomLanguagePack", alias(setCustomLanguagePackRequest)})
+}
+
+// EditCustomLanguagePackInfoRequest is the request of EditCustomLanguagePackInfo: Edits information about a custom local language pack in the current localization target. Can be called before authorization
+type EditCustomLanguagePackInfoRequest struct {
+	Info *LanguagePackInfo `json:"info"` // New information about the custom local language pack
+}
+
+// MessageType return the string telegram-type of EditCustomLanguagePackInfoRequest
+func (editCustomLanguagePackInfoRequest *EditCustomLanguagePackInfoRequest) MessageType() string {
+	return "editCustomLanguagePackInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editCustomLanguagePackInfoRequest EditCustomLanguagePackInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias EditCustomLanguagePackInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editCustomLanguagePackInfo", alias(editCustomLanguagePackInfoRequest)})
+}
+
+// SetCustomLanguagePackStringRequest is the request of SetCustomLanguagePackString: Adds, edits or deletes a string in a custom local language pack. Can be called before authorization
+type SetCustomLanguagePackStringRequest struct {
+	LanguagePackID string              `json:"language_pack_id"` // Identifier of a previously added custom local language pack in the current localization target
+	NewString      *LanguagePackString `json:"new_string"`       // New language pack string
+}
+
+// MessageType return the string telegram-type of SetCustomLanguagePackStringRequest
+func (setCustomLanguagePackStringRequest *SetCustomLanguagePackStringRequest) MessageType() string {
+	return "setCustomLanguagePackString"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setCustomLanguagePackStringRequest SetCustomLanguagePackStringRequest) MarshalJSON() ([]byte, error) {
+	type alias SetCustomLanguagePackStringRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setCustomLanguagePackString", alias(setCustomLanguagePackStringRequest)})
+}
+
+// DeleteLanguagePackRequest is the request of DeleteLanguagePack: Deletes all information about a language pack in the current localization target. The language pack which is currently in use (including base language pack) or is being synchronized can't be deleted. Can be called before authorization
+type DeleteLanguagePackRequest struct {
+	LanguagePackID string `json:"language_pack_id"` // Identifier of the language pack to delete
+}
+
+// MessageType return the string telegram-type of DeleteLanguagePackRequest
+func (deleteLanguagePackRequest *DeleteLanguagePackRequest) MessageType() string {
+	return "deleteLanguagePack"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteLanguagePackRequest DeleteLanguagePackRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteLanguagePackRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteLanguagePack", alias(deleteLanguagePackRequest)})
+}
+
+// RegisterDeviceRequest is the request of RegisterDevice: Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription
+type RegisterDeviceRequest struct {
+	DeviceToken  DeviceToken `json:"device_token"`   // Device token
+	OtherUserIDs []int32     `json:"other_user_ids"` // List of user identifiers of other users currently using the application
+}
+
+// MessageType return the string telegram-type of RegisterDeviceRequest
+func (registerDeviceRequest *RegisterDeviceRequest) MessageType() string {
+	return "registerDevice"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (registerDeviceRequest RegisterDeviceRequest) MarshalJSON() ([]byte, error) {
+	type alias RegisterDeviceRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"registerDevice", alias(registerDeviceRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (registerDeviceRequest *RegisterDeviceRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		OtherUserIDs []int32 `json:"other_user_ids"` // List of user identifiers of other users currently using the application
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	registerDeviceRequest.OtherUserIDs = tempObj.OtherUserIDs
+
+	fieldDeviceToken, _ := unmarshalDeviceToken(objMap["device_token"])
+	registerDeviceRequest.DeviceToken = fieldDeviceToken
+
+	return nil
+}
+
+// ProcessPushNotificationRequest is the request of ProcessPushNotification: Handles a push notification. Returns error with code 406 if the push notification is not supported and connection to the server is required to fetch new data. Can be called before authorization
+type ProcessPushNotificationRequest struct {
+	Payload string `json:"payload"` // JSON-encoded push notification payload with all fields sent by the server, and "google.sent_time" and "google.notification.sound" fields added
+}
+
+// MessageType return the string telegram-type of ProcessPushNotificationRequest
+func (processPushNotificationRequest *ProcessPushNotificationRequest) MessageType() string {
+	return "processPushNotification"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (processPushNotificationRequest ProcessPushNotificationRequest) MarshalJSON() ([]byte, error) {
+	type alias ProcessPushNotificationRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"processPushNotification", alias(processPushNotificationRequest)})
+}
+
+// GetPushReceiverIDRequest is the request of GetPushReceiverID: Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously
+type GetPushReceiverIDRequest struct {
+	Payload string `json:"payload"` // JSON-encoded push notification payload
+}
+
+// MessageType return the string telegram-type of GetPushReceiverIDRequest
+func (getPushReceiverIDRequest *GetPushReceiverIDRequest) MessageType() string {
+	return "getPushReceiverId"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPushReceiverIDRequest GetPushReceiverIDRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPushReceiverIDRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPushReceiverId", alias(getPushReceiverIDRequest)})
+}
+
+// GetRecentlyVisitedTMeURLsRequest is the request of GetRecentlyVisitedTMeURLs: Returns t.me URLs recently visited by a newly registered user
+type GetRecentlyVisitedTMeURLsRequest struct {
+	Referrer string `json:"referrer"` // Google Play referrer to identify the user
+}
+
+// MessageType return the string telegram-type of GetRecentlyVisitedTMeURLsRequest
+func (getRecentlyVisitedTMeURLsRequest *GetRecentlyVisitedTMeURLsRequest) MessageType() string {
+	return "getRecentlyVisitedTMeUrls"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getRecentlyVisitedTMeURLsRequest GetRecentlyVisitedTMeURLsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetRecentlyVisitedTMeURLsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getRecentlyVisitedTMeUrls", alias(getRecentlyVisitedTMeURLsRequest)})
+}
+
+// SetUserPrivacySettingRulesRequest is the request of SetUserPrivacySettingRules: Changes user privacy settings
+type SetUserPrivacySettingRulesRequest struct {
+	Setting UserPrivacySetting       `json:"setting"` // The privacy setting
+	Rules   *UserPrivacySettingRules `json:"rules"`   // The new privacy rules
+}
+
+// MessageType return the string telegram-type of SetUserPrivacySettingRulesRequest
+func (setUserPrivacySettingRulesRequest *SetUserPrivacySettingRulesRequest) MessageType() string {
+	return "setUserPrivacySettingRules"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setUserPrivacySettingRulesRequest SetUserPrivacySettingRulesRequest) MarshalJSON() ([]byte, error) {
+	type alias SetUserPrivacySettingRulesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setUserPrivacySettingRules", alias(setUserPrivacySettingRulesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setUserPrivacySettingRulesRequest *SetUserPrivacySettingRulesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Rules *UserPrivacySettingRules `json:"rules"` // The new privacy rules
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setUserPrivacySettingRulesRequest.Rules = tempObj.Rules
+
+	fieldSetting, _ := unmarshalUserPrivacySetting(objMap["setting"])
+	setUserPrivacySettingRulesRequest.Setting = fieldSetting
+
+	return nil
+}
+
+// GetUserPrivacySettingRulesRequest is the request of GetUserPrivacySettingRules: Returns the current privacy settings
+type GetUserPrivacySettingRulesRequest struct {
+	Setting UserPrivacySetting `json:"setting"` // The privacy setting
+}
+
+// MessageType return the string telegram-type of GetUserPrivacySettingRulesRequest
+func (getUserPrivacySettingRulesRequest *GetUserPrivacySettingRulesRequest) MessageType() string {
+	return "getUserPrivacySettingRules"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getUserPrivacySettingRulesRequest GetUserPrivacySettingRulesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetUserPrivacySettingRulesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getUserPrivacySettingRules", alias(getUserPrivacySettingRulesRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getUserPrivacySettingRulesRequest *GetUserPrivacySettingRulesRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldSetting, _ := unmarshalUserPrivacySetting(objMap["setting"])
+	getUserPrivacySettingRulesRequest.Setting = fieldSetting
+
+	return nil
+}
+
+// GetOptionRequest is the request of GetOption: Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization
+type GetOptionRequest struct {
+	Name string `json:"name"` // The name of the option
+}
+
+// MessageType return the string telegram-type of GetOptionRequest
+func (getOptionRequest *GetOptionRequest) MessageType() string {
+	return "getOption"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getOptionRequest GetOptionRequest) MarshalJSON() ([]byte, error) {
+	type alias GetOptionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getOption", alias(getOptionRequest)})
+}
+
+// SetOptionRequest is the request of SetOption: Sets the value of an option. (Check the list of available options on https://core.telegram.org/tdlib/options.) Only writable options can be set. Can be called before authorization
+type SetOptionRequest struct {
+	Name  string      `json:"name"`  // The name of the option
+	Value OptionValue `json:"value"` // The new value of the option
+}
+
+// MessageType return the string telegram-type of SetOptionRequest
+func (setOptionRequest *SetOptionRequest) MessageType() string {
+	return "setOption"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setOptionRequest SetOptionRequest) MarshalJSON() ([]byte, error) {
+	type alias SetOptionRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setOption", alias(setOptionRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setOptionRequest *SetOptionRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Name string `json:"name"` // The name of the option
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setOptionRequest.Name = tempObj.Name
+
+	fieldValue, _ := unmarshalOptionValue(objMap["value"])
+	setOptionRequest.Value = fieldValue
+
+	return nil
+}
+
+// SetAccountTTLRequest is the request of SetAccountTTL: Changes the period of inactivity after which the account of the current user will automatically be deleted
+type SetAccountTTLRequest struct {
+	TTL *AccountTTL `json:"ttl"` // New account TTL
+}
+
+// MessageType return the string telegram-type of SetAccountTTLRequest
+func (setAccountTTLRequest *SetAccountTTLRequest) MessageType() string {
+	return "setAccountTtl"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setAccountTTLRequest SetAccountTTLRequest) MarshalJSON() ([]byte, error) {
+	type alias SetAccountTTLRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setAccountTtl", alias(setAccountTTLRequest)})
+}
+
+// GetAccountTTLRequest is the request of GetAccountTTL: Returns the period of inactivity after which the account of the current user will automatically be deleted
+type GetAccountTTLRequest struct {
+}
+
+// MessageType return the string telegram-type of GetAccountTTLRequest
+func (getAccountTTLRequest *GetAccountTTLRequest) MessageType() string {
+	return "getAccountTtl"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getAccountTTLRequest GetAccountTTLRequest) MarshalJSON() ([]byte, error) {
+	type alias GetAccountTTLRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getAccountTtl", alias(getAccountTTLRequest)})
+}
+
+// DeleteAccountRequest is the request of DeleteAccount: Deletes the account of the current user, deleting all information associated with the user from the server. The phone number of the account can be used to create a new account. Can be called before authorization when the current authorization state is authorizationStateWaitPassword
+type DeleteAccountRequest struct {
+	Reason string `json:"reason"` // The reason why the account was deleted; optional
+}
+
+// MessageType return the string telegram-type of DeleteAccountRequest
+func (deleteAccountRequest *DeleteAccountRequest) MessageType() string {
+	return "deleteAccount"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deleteAccountRequest DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+	type alias DeleteAccountRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deleteAccount", alias(deleteAccountRequest)})
+}
+
+// RemoveChatActionBarRequest is the request of RemoveChatActionBar: Removes a chat action bar without any other action
+type RemoveChatActionBarRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+}
+
+// MessageType return the string telegram-type of RemoveChatActionBarRequest
+func (removeChatActionBarRequest *RemoveChatActionBarRequest) MessageType() string {
+	return "removeChatActionBar"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeChatActionBarRequest RemoveChatActionBarRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveChatActionBarRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeChatActionBar", alias(removeChatActionBarRequest)})
+}
+
+// ReportChatRequest is the request of ReportChat: Reports a chat to the Telegram moderators. A chat can be reported only from the chat action bar, or if this is a private chat with a bot, a private chat with a user sharing their location, a supergroup, or a channel, since other chats can't be checked by moderators
+type ReportChatRequest struct {
+	ChatID     JSONInt64        `json:"chat_id"`     // Chat identifier
+	Reason     ChatReportReason `json:"reason"`      // The reason for reporting the chat
+	MessageIDs []JSONInt64      `json:"message_ids"` // Identifiers of reported messages, if any
+}
+
+// MessageType return the string telegram-type of ReportChatRequest
+func (reportChatRequest *ReportChatRequest) MessageType() string {
+	return "reportChat"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (reportChatRequest ReportChatRequest) MarshalJSON() ([]byte, error) {
+	type alias ReportChatRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"reportChat", alias(reportChatRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (reportChatRequest *ReportChatRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ChatID     JSONInt64   `json:"chat_id"`     // Chat identifier
+		MessageIDs []JSONInt64 `json:"message_ids"` // Identifiers of reported messages, if any
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	reportChatRequest.ChatID = tempObj.ChatID
+	reportChatRequest.MessageIDs = tempObj.MessageIDs
+
+	fieldReason, _ := unmarshalChatReportReason(objMap["reason"])
+	reportChatRequest.Reason = fieldReason
+
+	return nil
+}
+
+// GetChatStatisticsURLRequest is the request of GetChatStatisticsURL: Returns an HTTP URL with the chat statistics. Currently this method of getting the statistics are disabled and can be deleted in the future
+type GetChatStatisticsURLRequest struct {
+	ChatID     JSONInt64 `json:"chat_id"`    // Chat identifier
+	Parameters string    `json:"parameters"` // Parameters from "tg://statsrefresh?params=******" link
+	IsDark     bool      `json:"is_dark"`    // Pass true if a URL with the dark theme must be returned
+}
+
+// MessageType return the string telegram-type of GetChatStatisticsURLRequest
+func (getChatStatisticsURLRequest *GetChatStatisticsURLRequest) MessageType() string {
+	return "getChatStatisticsUrl"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatStatisticsURLRequest GetChatStatisticsURLRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatStatisticsURLRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatStatisticsUrl", alias(getChatStatisticsURLRequest)})
+}
+
+// GetChatStatisticsRequest is the request of GetChatStatistics: Returns detailed statistics about a chat. Currently this method can be used only for supergroups and channels. Can be used only if SupergroupFullInfo.can_get_statistics == true
+type GetChatStatisticsRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	IsDark bool      `json:"is_dark"` // Pass true if a dark theme is used by the application
+}
+
+// MessageType return the string telegram-type of GetChatStatisticsRequest
+func (getChatStatisticsRequest *GetChatStatisticsRequest) MessageType() string {
+	return "getChatStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getChatStatisticsRequest GetChatStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetChatStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getChatStatistics", alias(getChatStatisticsRequest)})
+}
+
+// GetMessageStatisticsRequest is the request of GetMessageStatistics: Returns detailed statistics about a message. Can be used only if Message.can_get_statistics == true
+type GetMessageStatisticsRequest struct {
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+	MessageID JSONInt64 `json:"message_id"` // Message identifier
+	IsDark    bool      `json:"is_dark"`    // Pass true if a dark theme is used by the application
+}
+
+// MessageType return the string telegram-type of GetMessageStatisticsRequest
+func (getMessageStatisticsRequest *GetMessageStatisticsRequest) MessageType() string {
+	return "getMessageStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMessageStatisticsRequest GetMessageStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMessageStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMessageStatistics", alias(getMessageStatisticsRequest)})
+}
+
+// GetStatisticalGraphRequest is the request of GetStatisticalGraph: Loads an asynchronous or a zoomed in statistical graph
+type GetStatisticalGraphRequest struct {
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	Token  string    `json:"token"`   // The token for graph loading
+	X      JSONInt64 `json:"x"`       // X-value for zoomed in graph or 0 otherwise
+}
+
+// MessageType return the string telegram-type of GetStatisticalGraphRequest
+func (getStatisticalGraphRequest *GetStatisticalGraphRequest) MessageType() string {
+	return "getStatisticalGraph"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getStatisticalGraphRequest GetStatisticalGraphRequest) MarshalJSON() ([]byte, error) {
+	type alias GetStatisticalGraphRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getStatisticalGraph", alias(getStatisticalGraphRequest)})
+}
+
+// GetStorageStatisticsRequest is the request of GetStorageStatistics: Returns storage usage statistics. Can be called before authorization
+type GetStorageStatisticsRequest struct {
+	ChatLimit int32 `json:"chat_limit"` // The maximum number of chats with the largest storage usage for which separate statistics should be returned. All other chats will be grouped in entries with chat_id == 0. If the chat info database is not used, the chat_limit is ignored and is always set to 0
+}
+
+// MessageType return the string telegram-type of GetStorageStatisticsRequest
+func (getStorageStatisticsRequest *GetStorageStatisticsRequest) MessageType() string {
+	return "getStorageStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getStorageStatisticsRequest GetStorageStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetStorageStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getStorageStatistics", alias(getStorageStatisticsRequest)})
+}
+
+// GetStorageStatisticsFastRequest is the request of GetStorageStatisticsFast: Quickly returns approximate storage usage statistics. Can be called before authorization
+type GetStorageStatisticsFastRequest struct {
+}
+
+// MessageType return the string telegram-type of GetStorageStatisticsFastRequest
+func (getStorageStatisticsFastRequest *GetStorageStatisticsFastRequest) MessageType() string {
+	return "getStorageStatisticsFast"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getStorageStatisticsFastRequest GetStorageStatisticsFastRequest) MarshalJSON() ([]byte, error) {
+	type alias GetStorageStatisticsFastRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getStorageStatisticsFast", alias(getStorageStatisticsFastRequest)})
+}
+
+// GetDatabaseStatisticsRequest is the request of GetDatabaseStatistics: Returns database statistics
+type GetDatabaseStatisticsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetDatabaseStatisticsRequest
+func (getDatabaseStatisticsRequest *GetDatabaseStatisticsRequest) MessageType() string {
+	return "getDatabaseStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getDatabaseStatisticsRequest GetDatabaseStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetDatabaseStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getDatabaseStatistics", alias(getDatabaseStatisticsRequest)})
+}
+
+// OptimizeStorageRequest is the request of OptimizeStorage: Optimizes storage usage, i.e. deletes some files and returns new storage usage statistics. Secret thumbnails can't be deleted
+type OptimizeStorageRequest struct {
+	Size                        JSONInt64   `json:"size"`                           // Limit on the total size of files after deletion. Pass -1 to use the default limit
+	TTL                         int32       `json:"ttl"`                            // Limit on the time that has passed since the last time a file was accessed (or creation time for some filesystems). Pass -1 to use the default limit
+	Count                       int32       `json:"count"`                          // Limit on the total count of files after deletion. Pass -1 to use the default limit
+	ImmunityDelay               int32       `json:"immunity_delay"`                 // The amount of time after the creation of a file during which it can't be deleted, in seconds. Pass -1 to use the default value
+	FileTypes                   []FileType  `json:"file_types"`                     // If not empty, only files with the given type(s) are considered. By default, all types except thumbnails, profile photos, stickers and wallpapers are deleted
+	ChatIDs                     []JSONInt64 `json:"chat_ids"`                       // If not empty, only files from the given chats are considered. Use 0 as chat identifier to delete files not belonging to any chat (e.g., profile photos)
+	ExcludeChatIDs              []JSONInt64 `json:"exclude_chat_ids"`               // If not empty, files from the given chats are excluded. Use 0 as chat identifier to exclude all files not belonging to any chat (e.g., profile photos)
+	ReturnDeletedFileStatistics bool        `json:"return_deleted_file_statistics"` // Pass true if statistics about the files that were deleted must be returned instead of the whole storage usage statistics. Affects only returned statistics
+	ChatLimit                   int32       `json:"chat_limit"`                     // Same as in getStorageStatistics. Affects only returned statistics
+}
+
+// MessageType return the string telegram-type of OptimizeStorageRequest
+func (optimizeStorageRequest *OptimizeStorageRequest) MessageType() string {
+	return "optimizeStorage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (optimizeStorageRequest OptimizeStorageRequest) MarshalJSON() ([]byte, error) {
+	type alias OptimizeStorageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"optimizeStorage", alias(optimizeStorageRequest)})
+}
+
+// SetNetworkTypeRequest is the request of SetNetworkType: Sets the current network type. Can be called before authorization. Calling this method forces all network connections to reopen, mitigating the delay in switching between different networks, so it should be called whenever the network is changed, even if the network type remains the same.
+type SetNetworkTypeRequest struct {
+	Type NetworkType `json:"type"` //
+}
+
+// MessageType return the string telegram-type of SetNetworkTypeRequest
+func (setNetworkTypeRequest *SetNetworkTypeRequest) MessageType() string {
+	return "setNetworkType"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setNetworkTypeRequest SetNetworkTypeRequest) MarshalJSON() ([]byte, error) {
+	type alias SetNetworkTypeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setNetworkType", alias(setNetworkTypeRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setNetworkTypeRequest *SetNetworkTypeRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldType, _ := unmarshalNetworkType(objMap["type"])
+	setNetworkTypeRequest.Type = fieldType
+
+	return nil
+}
+
+// GetNetworkStatisticsRequest is the request of GetNetworkStatistics: Returns network data usage statistics. Can be called before authorization
+type GetNetworkStatisticsRequest struct {
+	OnlyCurrent bool `json:"only_current"` // If true, returns only data for the current library launch
+}
+
+// MessageType return the string telegram-type of GetNetworkStatisticsRequest
+func (getNetworkStatisticsRequest *GetNetworkStatisticsRequest) MessageType() string {
+	return "getNetworkStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getNetworkStatisticsRequest GetNetworkStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetNetworkStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getNetworkStatistics", alias(getNetworkStatisticsRequest)})
+}
+
+// AddNetworkStatisticsRequest is the request of AddNetworkStatistics: Adds the specified data to data usage statistics. Can be called before authorization
+type AddNetworkStatisticsRequest struct {
+	Entry NetworkStatisticsEntry `json:"entry"` // The network statistics entry with the data to be added to statistics
+}
+
+// MessageType return the string telegram-type of AddNetworkStatisticsRequest
+func (addNetworkStatisticsRequest *AddNetworkStatisticsRequest) MessageType() string {
+	return "addNetworkStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addNetworkStatisticsRequest AddNetworkStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias AddNetworkStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addNetworkStatistics", alias(addNetworkStatisticsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addNetworkStatisticsRequest *AddNetworkStatisticsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldEntry, _ := unmarshalNetworkStatisticsEntry(objMap["entry"])
+	addNetworkStatisticsRequest.Entry = fieldEntry
+
+	return nil
+}
+
+// ResetNetworkStatisticsRequest is the request of ResetNetworkStatistics: Resets all network data usage statistics to zero. Can be called before authorization
+type ResetNetworkStatisticsRequest struct {
+}
+
+// MessageType return the string telegram-type of ResetNetworkStatisticsRequest
+func (resetNetworkStatisticsRequest *ResetNetworkStatisticsRequest) MessageType() string {
+	return "resetNetworkStatistics"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resetNetworkStatisticsRequest ResetNetworkStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type alias ResetNetworkStatisticsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resetNetworkStatistics", alias(resetNetworkStatisticsRequest)})
+}
+
+// GetAutoDownloadSettingsPresetsRequest is the request of GetAutoDownloadSettingsPresets: Returns auto-download settings presets for the current user
+type GetAutoDownloadSettingsPresetsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetAutoDownloadSettingsPresetsRequest
+func (getAutoDownloadSettingsPresetsRequest *GetAutoDownloadSettingsPresetsRequest) MessageType() string {
+	return "getAutoDownloadSettingsPresets"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getAutoDownloadSettingsPresetsRequest GetAutoDownloadSettingsPresetsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetAutoDownloadSettingsPresetsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getAutoDownloadSettingsPresets", alias(getAutoDownloadSettingsPresetsRequest)})
+}
+
+// SetAutoDownloadSettingsRequest is the request of SetAutoDownloadSettings: Sets auto-download settings
+type SetAutoDownloadSettingsRequest struct {
+	Settings *AutoDownloadSettings `json:"settings"` // New user auto-download settings
+	Type     NetworkType           `json:"type"`     // Type of the network for which the new settings are applied
+}
+
+// MessageType return the string telegram-type of SetAutoDownloadSettingsRequest
+func (setAutoDownloadSettingsRequest *SetAutoDownloadSettingsRequest) MessageType() string {
+	return "setAutoDownloadSettings"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setAutoDownloadSettingsRequest SetAutoDownloadSettingsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetAutoDownloadSettingsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setAutoDownloadSettings", alias(setAutoDownloadSettingsRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setAutoDownloadSettingsRequest *SetAutoDownloadSettingsRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Settings *AutoDownloadSettings `json:"settings"` // New user auto-download settings
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setAutoDownloadSettingsRequest.Settings = tempObj.Settings
+
+	fieldType, _ := unmarshalNetworkType(objMap["type"])
+	setAutoDownloadSettingsRequest.Type = fieldType
+
+	return nil
+}
+
+// GetBankCardInfoRequest is the request of GetBankCardInfo: Returns information about a bank card
+type GetBankCardInfoRequest struct {
+	BankCardNumber string `json:"bank_card_number"` // The bank card number
+}
+
+// MessageType return the string telegram-type of GetBankCardInfoRequest
+func (getBankCardInfoRequest *GetBankCardInfoRequest) MessageType() string {
+	return "getBankCardInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getBankCardInfoRequest GetBankCardInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetBankCardInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getBankCardInfo", alias(getBankCardInfoRequest)})
+}
+
+// GetPassportElementRequest is the request of GetPassportElement: Returns one of the available Telegram Passport elements
+type GetPassportElementRequest struct {
+	Type     PassportElementType `json:"type"`     // Telegram Passport element type
+	Password string              `json:"password"` // Password of the current user
+}
+
+// MessageType return the string telegram-type of GetPassportElementRequest
+func (getPassportElementRequest *GetPassportElementRequest) MessageType() string {
+	return "getPassportElement"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPassportElementRequest GetPassportElementRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPassportElementRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPassportElement", alias(getPassportElementRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (getPassportElementRequest *GetPassportElementRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Password string `json:"password"` // Password of the current user
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	getPassportElementRequest.Password = tempObj.Password
+
+	fieldType, _ := unmarshalPassportElementType(objMap["type"])
+	getPassportElementRequest.Type = fieldType
+
+	return nil
+}
+
+// GetAllPassportElementsRequest is the request of GetAllPassportElements: Returns all available Telegram Passport elements
+type GetAllPassportElementsRequest struct {
+	Password string `json:"password"` // Password of the current user
+}
+
+// MessageType return the string telegram-type of GetAllPassportElementsRequest
+func (getAllPassportElementsRequest *GetAllPassportElementsRequest) MessageType() string {
+	return "getAllPassportElements"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getAllPassportElementsRequest GetAllPassportElementsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetAllPassportElementsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getAllPassportElements", alias(getAllPassportElementsRequest)})
+}
+
+// SetPassportElementRequest is the request of SetPassportElement: Adds an element to the user's Telegram Passport. May return an error with a message "PHONE_VERIFICATION_NEEDED" or "EMAIL_VERIFICATION_NEEDED" if the chosen phone number or the chosen email address must be verified first
+type SetPassportElementRequest struct {
+	Element  InputPassportElement `json:"element"`  // Input Telegram Passport element
+	Password string               `json:"password"` // Password of the current user
+}
+
+// MessageType return the string telegram-type of SetPassportElementRequest
+func (setPassportElementRequest *SetPassportElementRequest) MessageType() string {
+	return "setPassportElement"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setPassportElementRequest SetPassportElementRequest) MarshalJSON() ([]byte, error) {
+	type alias SetPassportElementRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setPassportElement", alias(setPassportElementRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setPassportElementRequest *SetPassportElementRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Password string `json:"password"` // Password of the current user
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setPassportElementRequest.Password = tempObj.Password
+
+	fieldElement, _ := unmarshalInputPassportElement(objMap["element"])
+	setPassportElementRequest.Element = fieldElement
+
+	return nil
+}
+
+// DeletePassportElementRequest is the request of DeletePassportElement: Deletes a Telegram Passport element
+type DeletePassportElementRequest struct {
+	Type PassportElementType `json:"type"` // Element type
+}
+
+// MessageType return the string telegram-type of DeletePassportElementRequest
+func (deletePassportElementRequest *DeletePassportElementRequest) MessageType() string {
+	return "deletePassportElement"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (deletePassportElementRequest DeletePassportElementRequest) MarshalJSON() ([]byte, error) {
+	type alias DeletePassportElementRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"deletePassportElement", alias(deletePassportElementRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (deletePassportElementRequest *DeletePassportElementRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldType, _ := unmarshalPassportElementType(objMap["type"])
+	deletePassportElementRequest.Type = fieldType
+
+	return nil
+}
+
+// SetPassportElementErrorsRequest is the request of SetPassportElementErrors: Informs the user that some of the elements in their Telegram Passport contain errors; for bots only. The user will not be able to resend the elements, until the errors are fixed
+type SetPassportElementErrorsRequest struct {
+	UserID int32                       `json:"user_id"` // User identifier
+	Errors []InputPassportElementError `json:"errors"`  // The errors
+}
+
+// MessageType return the string telegram-type of SetPassportElementErrorsRequest
+func (setPassportElementErrorsRequest *SetPassportElementErrorsRequest) MessageType() string {
+	return "setPassportElementErrors"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setPassportElementErrorsRequest SetPassportElementErrorsRequest) MarshalJSON() ([]byte, error) {
+	type alias SetPassportElementErrorsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setPassportElementErrors", alias(setPassportElementErrorsRequest)})
+}
+
+// GetPreferredCountryLanguageRequest is the request of GetPreferredCountryLanguage: Returns an IETF language tag of the language preferred in the country, which should be used to fill native fields in Telegram Passport personal details. Returns a 404 error if unknown
+type GetPreferredCountryLanguageRequest struct {
+	CountryCode string `json:"country_code"` // A two-letter ISO 3166-1 alpha-2 country code
+}
+
+// MessageType return the string telegram-type of GetPreferredCountryLanguageRequest
+func (getPreferredCountryLanguageRequest *GetPreferredCountryLanguageRequest) MessageType() string {
+	return "getPreferredCountryLanguage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPreferredCountryLanguageRequest GetPreferredCountryLanguageRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPreferredCountryLanguageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPreferredCountryLanguage", alias(getPreferredCountryLanguageRequest)})
+}
+
+// SendPhoneNumberVerificationCodeRequest is the request of SendPhoneNumberVerificationCode: Sends a code to verify a phone number to be added to a user's Telegram Passport
+type SendPhoneNumberVerificationCodeRequest struct {
+	PhoneNumber string                             `json:"phone_number"` // The phone number of the user, in international format
+	Settings    *PhoneNumberAuthenticationSettings `json:"settings"`     // Settings for the authentication of the user's phone number
+}
+
+// MessageType return the string telegram-type of SendPhoneNumberVerificationCodeRequest
+func (sendPhoneNumberVerificationCodeRequest *SendPhoneNumberVerificationCodeRequest) MessageType() string {
+	return "sendPhoneNumberVerificationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendPhoneNumberVerificationCodeRequest SendPhoneNumberVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias SendPhoneNumberVerificationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendPhoneNumberVerificationCode", alias(sendPhoneNumberVerificationCodeRequest)})
+}
+
+// ResendPhoneNumberVerificationCodeRequest is the request of ResendPhoneNumberVerificationCode: Re-sends the code to verify a phone number to be added to a user's Telegram Passport
+type ResendPhoneNumberVerificationCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of ResendPhoneNumberVerificationCodeRequest
+func (resendPhoneNumberVerificationCodeRequest *ResendPhoneNumberVerificationCodeRequest) MessageType() string {
+	return "resendPhoneNumberVerificationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendPhoneNumberVerificationCodeRequest ResendPhoneNumberVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendPhoneNumberVerificationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendPhoneNumberVerificationCode", alias(resendPhoneNumberVerificationCodeRequest)})
+}
+
+// CheckPhoneNumberVerificationCodeRequest is the request of CheckPhoneNumberVerificationCode: Checks the phone number verification code for Telegram Passport
+type CheckPhoneNumberVerificationCodeRequest struct {
+	Code string `json:"code"` // Verification code
+}
+
+// MessageType return the string telegram-type of CheckPhoneNumberVerificationCodeRequest
+func (checkPhoneNumberVerificationCodeRequest *CheckPhoneNumberVerificationCodeRequest) MessageType() string {
+	return "checkPhoneNumberVerificationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkPhoneNumberVerificationCodeRequest CheckPhoneNumberVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckPhoneNumberVerificationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkPhoneNumberVerificationCode", alias(checkPhoneNumberVerificationCodeRequest)})
+}
+
+// SendEmailAddressVerificationCodeRequest is the request of SendEmailAddressVerificationCode: Sends a code to verify an email address to be added to a user's Telegram Passport
+type SendEmailAddressVerificationCodeRequest struct {
+	EmailAddress string `json:"email_address"` // Email address
+}
+
+// MessageType return the string telegram-type of SendEmailAddressVerificationCodeRequest
+func (sendEmailAddressVerificationCodeRequest *SendEmailAddressVerificationCodeRequest) MessageType() string {
+	return "sendEmailAddressVerificationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendEmailAddressVerificationCodeRequest SendEmailAddressVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias SendEmailAddressVerificationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendEmailAddressVerificationCode", alias(sendEmailAddressVerificationCodeRequest)})
+}
+
+// ResendEmailAddressVerificationCodeRequest is the request of ResendEmailAddressVerificationCode: Re-sends the code to verify an email address to be added to a user's Telegram Passport
+type ResendEmailAddressVerificationCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of ResendEmailAddressVerificationCodeRequest
+func (resendEmailAddressVerificationCodeRequest *ResendEmailAddressVerificationCodeRequest) MessageType() string {
+	return "resendEmailAddressVerificationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendEmailAddressVerificationCodeRequest ResendEmailAddressVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendEmailAddressVerificationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendEmailAddressVerificationCode", alias(resendEmailAddressVerificationCodeRequest)})
+}
+
+// CheckEmailAddressVerificationCodeRequest is the request of CheckEmailAddressVerificationCode: Checks the email address verification code for Telegram Passport
+type CheckEmailAddressVerificationCodeRequest struct {
+	Code string `json:"code"` // Verification code
+}
+
+// MessageType return the string telegram-type of CheckEmailAddressVerificationCodeRequest
+func (checkEmailAddressVerificationCodeRequest *CheckEmailAddressVerificationCodeRequest) MessageType() string {
+	return "checkEmailAddressVerificationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkEmailAddressVerificationCodeRequest CheckEmailAddressVerificationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckEmailAddressVerificationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkEmailAddressVerificationCode", alias(checkEmailAddressVerificationCodeRequest)})
+}
+
+// GetPassportAuthorizationFormRequest is the request of GetPassportAuthorizationForm: Returns a Telegram Passport authorization form for sharing data with a service
+type GetPassportAuthorizationFormRequest struct {
+	BotUserID int32  `json:"bot_user_id"` // User identifier of the service's bot
+	Scope     string `json:"scope"`       // Telegram Passport element types requested by the service
+	PublicKey string `json:"public_key"`  // Service's public_key
+	Nonce     string `json:"nonce"`       // Authorization form nonce provided by the service
+}
+
+// MessageType return the string telegram-type of GetPassportAuthorizationFormRequest
+func (getPassportAuthorizationFormRequest *GetPassportAuthorizationFormRequest) MessageType() string {
+	return "getPassportAuthorizationForm"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPassportAuthorizationFormRequest GetPassportAuthorizationFormRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPassportAuthorizationFormRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPassportAuthorizationForm", alias(getPassportAuthorizationFormRequest)})
+}
+
+// GetPassportAuthorizationFormAvailableElementsRequest is the request of GetPassportAuthorizationFormAvailableElements: Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form
+type GetPassportAuthorizationFormAvailableElementsRequest struct {
+	AutorizationFormID int32  `json:"autorization_form_id"` // Authorization form identifier
+	Password           string `json:"password"`             // Password of the current user
+}
+
+// MessageType return the string telegram-type of GetPassportAuthorizationFormAvailableElementsRequest
+func (getPassportAuthorizationFormAvailableElementsRequest *GetPassportAuthorizationFormAvailableElementsRequest) MessageType() string {
+	return "getPassportAuthorizationFormAvailableElements"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPassportAuthorizationFormAvailableElementsRequest GetPassportAuthorizationFormAvailableElementsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPassportAuthorizationFormAvailableElementsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPassportAuthorizationFormAvailableElements", alias(getPassportAuthorizationFormAvailableElementsRequest)})
+}
+
+// SendPassportAuthorizationFormRequest is the request of SendPassportAuthorizationForm: Sends a Telegram Passport authorization form, effectively sharing data with the service. This method must be called after getPassportAuthorizationFormAvailableElements if some previously available elements are going to be reused
+type SendPassportAuthorizationFormRequest struct {
+	AutorizationFormID int32                 `json:"autorization_form_id"` // Authorization form identifier
+	Types              []PassportElementType `json:"types"`                // Types of Telegram Passport elements chosen by user to complete the authorization form
+}
+
+// MessageType return the string telegram-type of SendPassportAuthorizationFormRequest
+func (sendPassportAuthorizationFormRequest *SendPassportAuthorizationFormRequest) MessageType() string {
+	return "sendPassportAuthorizationForm"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendPassportAuthorizationFormRequest SendPassportAuthorizationFormRequest) MarshalJSON() ([]byte, error) {
+	type alias SendPassportAuthorizationFormRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendPassportAuthorizationForm", alias(sendPassportAuthorizationFormRequest)})
+}
+
+// SendPhoneNumberConfirmationCodeRequest is the request of SendPhoneNumberConfirmationCode: Sends phone number confirmation code. Should be called when user presses "https://t.me/confirmphone?phone=*******&hash=**********" or "tg://confirmphone?phone=*******&hash=**********" link
+type SendPhoneNumberConfirmationCodeRequest struct {
+	Hash        string                             `json:"hash"`         // Value of the "hash" parameter from the link
+	PhoneNumber string                             `json:"phone_number"` // Value of the "phone" parameter from the link
+	Settings    *PhoneNumberAuthenticationSettings `json:"settings"`     // Settings for the authentication of the user's phone number
+}
+
+// MessageType return the string telegram-type of SendPhoneNumberConfirmationCodeRequest
+func (sendPhoneNumberConfirmationCodeRequest *SendPhoneNumberConfirmationCodeRequest) MessageType() string {
+	return "sendPhoneNumberConfirmationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendPhoneNumberConfirmationCodeRequest SendPhoneNumberConfirmationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias SendPhoneNumberConfirmationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendPhoneNumberConfirmationCode", alias(sendPhoneNumberConfirmationCodeRequest)})
+}
+
+// ResendPhoneNumberConfirmationCodeRequest is the request of ResendPhoneNumberConfirmationCode: Resends phone number confirmation code
+type ResendPhoneNumberConfirmationCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of ResendPhoneNumberConfirmationCodeRequest
+func (resendPhoneNumberConfirmationCodeRequest *ResendPhoneNumberConfirmationCodeRequest) MessageType() string {
+	return "resendPhoneNumberConfirmationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (resendPhoneNumberConfirmationCodeRequest ResendPhoneNumberConfirmationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias ResendPhoneNumberConfirmationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"resendPhoneNumberConfirmationCode", alias(resendPhoneNumberConfirmationCodeRequest)})
+}
+
+// CheckPhoneNumberConfirmationCodeRequest is the request of CheckPhoneNumberConfirmationCode: Checks phone number confirmation code
+type CheckPhoneNumberConfirmationCodeRequest struct {
+	Code string `json:"code"` // The phone number confirmation code
+}
+
+// MessageType return the string telegram-type of CheckPhoneNumberConfirmationCodeRequest
+func (checkPhoneNumberConfirmationCodeRequest *CheckPhoneNumberConfirmationCodeRequest) MessageType() string {
+	return "checkPhoneNumberConfirmationCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (checkPhoneNumberConfirmationCodeRequest CheckPhoneNumberConfirmationCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias CheckPhoneNumberConfirmationCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"checkPhoneNumberConfirmationCode", alias(checkPhoneNumberConfirmationCodeRequest)})
+}
+
+// SetBotUpdatesStatusRequest is the request of SetBotUpdatesStatus: Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only
+type SetBotUpdatesStatusRequest struct {
+	PendingUpdateCount int32  `json:"pending_update_count"` // The number of pending updates
+	ErrorMessage       string `json:"error_message"`        // The last error message
+}
+
+// MessageType return the string telegram-type of SetBotUpdatesStatusRequest
+func (setBotUpdatesStatusRequest *SetBotUpdatesStatusRequest) MessageType() string {
+	return "setBotUpdatesStatus"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setBotUpdatesStatusRequest SetBotUpdatesStatusRequest) MarshalJSON() ([]byte, error) {
+	type alias SetBotUpdatesStatusRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setBotUpdatesStatus", alias(setBotUpdatesStatusRequest)})
+}
+
+// UploadStickerFileRequest is the request of UploadStickerFile: Uploads a PNG image with a sticker; for bots only; returns the uploaded file
+type UploadStickerFileRequest struct {
+	UserID     int32     `json:"user_id"`     // Sticker file owner
+	PngSticker InputFile `json:"png_sticker"` // PNG image with the sticker; must be up to 512 KB in size and fit in 512x512 square
+}
+
+// MessageType return the string telegram-type of UploadStickerFileRequest
+func (uploadStickerFileRequest *UploadStickerFileRequest) MessageType() string {
+	return "uploadStickerFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (uploadStickerFileRequest UploadStickerFileRequest) MarshalJSON() ([]byte, error) {
+	type alias UploadStickerFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"uploadStickerFile", alias(uploadStickerFileRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (uploadStickerFileRequest *UploadStickerFileRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		UserID int32 `json:"user_id"` // Sticker file owner
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	uploadStickerFileRequest.UserID = tempObj.UserID
+
+	fieldPngSticker, _ := unmarshalInputFile(objMap["png_sticker"])
+	uploadStickerFileRequest.PngSticker = fieldPngSticker
+
+	return nil
+}
+
+// CreateNewStickerSetRequest is the request of CreateNewStickerSet: Creates a new sticker set; for bots only. Returns the newly created sticker set
+type CreateNewStickerSetRequest struct {
+	UserID   int32          `json:"user_id"`  // Sticker set owner
+	Title    string         `json:"title"`    // Sticker set title; 1-64 characters
+	Name     string         `json:"name"`     // Sticker set name. Can contain only English letters, digits and underscores. Must end with *"_by_<bot username>"* (*<bot_username>* is case insensitive); 1-64 characters
+	IsMasks  bool           `json:"is_masks"` // True, if stickers are masks. Animated stickers can't be masks
+	Stickers []InputSticker `json:"stickers"` // List of stickers to be added to the set; must be non-empty. All stickers must be of the same type
+}
+
+// MessageType return the string telegram-type of CreateNewStickerSetRequest
+func (createNewStickerSetRequest *CreateNewStickerSetRequest) MessageType() string {
+	return "createNewStickerSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (createNewStickerSetRequest CreateNewStickerSetRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateNewStickerSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"createNewStickerSet", alias(createNewStickerSetRequest)})
+}
+
+// AddStickerToSetRequest is the request of AddStickerToSet: Adds a new sticker to a set; for bots only. Returns the sticker set
+type AddStickerToSetRequest struct {
+	UserID  int32        `json:"user_id"` // Sticker set owner
+	Name    string       `json:"name"`    // Sticker set name
+	Sticker InputSticker `json:"sticker"` // Sticker to add to the set
+}
+
+// MessageType return the string telegram-type of AddStickerToSetRequest
+func (addStickerToSetRequest *AddStickerToSetRequest) MessageType() string {
+	return "addStickerToSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addStickerToSetRequest AddStickerToSetRequest) MarshalJSON() ([]byte, error) {
+	type alias AddStickerToSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addStickerToSet", alias(addStickerToSetRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addStickerToSetRequest *AddStickerToSetRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		UserID int32  `json:"user_id"` // Sticker set owner
+		Name   string `json:"name"`    // Sticker set name
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	addStickerToSetRequest.UserID = tempObj.UserID
+	addStickerToSetRequest.Name = tempObj.Name
+
+	fieldSticker, _ := unmarshalInputSticker(objMap["sticker"])
+	addStickerToSetRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// SetStickerSetThumbnailRequest is the request of SetStickerSetThumbnail: Sets a sticker set thumbnail; for bots only. Returns the sticker set
+type SetStickerSetThumbnailRequest struct {
+	UserID    int32     `json:"user_id"`   // Sticker set owner
+	Name      string    `json:"name"`      // Sticker set name
+	Thumbnail InputFile `json:"thumbnail"` // Thumbnail to set in PNG or TGS format. Animated thumbnail must be set for animated sticker sets and only for them. Pass a zero InputFileId to delete the thumbnail
+}
+
+// MessageType return the string telegram-type of SetStickerSetThumbnailRequest
+func (setStickerSetThumbnailRequest *SetStickerSetThumbnailRequest) MessageType() string {
+	return "setStickerSetThumbnail"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setStickerSetThumbnailRequest SetStickerSetThumbnailRequest) MarshalJSON() ([]byte, error) {
+	type alias SetStickerSetThumbnailRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setStickerSetThumbnail", alias(setStickerSetThumbnailRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setStickerSetThumbnailRequest *SetStickerSetThumbnailRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		UserID int32  `json:"user_id"` // Sticker set owner
+		Name   string `json:"name"`    // Sticker set name
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setStickerSetThumbnailRequest.UserID = tempObj.UserID
+	setStickerSetThumbnailRequest.Name = tempObj.Name
+
+	fieldThumbnail, _ := unmarshalInputFile(objMap["thumbnail"])
+	setStickerSetThumbnailRequest.Thumbnail = fieldThumbnail
+
+	return nil
+}
+
+// SetStickerPositionInSetRequest is the request of SetStickerPositionInSet: Changes the position of a sticker in the set to which it belongs; for bots only. The sticker set must have been created by the bot
+type SetStickerPositionInSetRequest struct {
+	Sticker  InputFile `json:"sticker"`  // Sticker
+	Position int32     `json:"position"` // New position of the sticker in the set, zero-based
+}
+
+// MessageType return the string telegram-type of SetStickerPositionInSetRequest
+func (setStickerPositionInSetRequest *SetStickerPositionInSetRequest) MessageType() string {
+	return "setStickerPositionInSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setStickerPositionInSetRequest SetStickerPositionInSetRequest) MarshalJSON() ([]byte, error) {
+	type alias SetStickerPositionInSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setStickerPositionInSet", alias(setStickerPositionInSetRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setStickerPositionInSetRequest *SetStickerPositionInSetRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Position int32 `json:"position"` // New position of the sticker in the set, zero-based
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	setStickerPositionInSetRequest.Position = tempObj.Position
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	setStickerPositionInSetRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// RemoveStickerFromSetRequest is the request of RemoveStickerFromSet: Removes a sticker from the set to which it belongs; for bots only. The sticker set must have been created by the bot
+type RemoveStickerFromSetRequest struct {
+	Sticker InputFile `json:"sticker"` // Sticker
+}
+
+// MessageType return the string telegram-type of RemoveStickerFromSetRequest
+func (removeStickerFromSetRequest *RemoveStickerFromSetRequest) MessageType() string {
+	return "removeStickerFromSet"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeStickerFromSetRequest RemoveStickerFromSetRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveStickerFromSetRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeStickerFromSet", alias(removeStickerFromSetRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (removeStickerFromSetRequest *RemoveStickerFromSetRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldSticker, _ := unmarshalInputFile(objMap["sticker"])
+	removeStickerFromSetRequest.Sticker = fieldSticker
+
+	return nil
+}
+
+// GetMapThumbnailFileRequest is the request of GetMapThumbnailFile: Returns information about a file with a map thumbnail in PNG format. Only map thumbnail files with size less than 1MB can be downloaded
+type GetMapThumbnailFileRequest struct {
+	Location *Location `json:"location"` // Location of the map center
+	Zoom     int32     `json:"zoom"`     // Map zoom level; 13-20
+	Width    int32     `json:"width"`    // Map width in pixels before applying scale; 16-1024
+	Height   int32     `json:"height"`   // Map height in pixels before applying scale; 16-1024
+	Scale    int32     `json:"scale"`    // Map scale; 1-3
+	ChatID   JSONInt64 `json:"chat_id"`  // Identifier of a chat, in which the thumbnail will be shown. Use 0 if unknown
+}
+
+// MessageType return the string telegram-type of GetMapThumbnailFileRequest
+func (getMapThumbnailFileRequest *GetMapThumbnailFileRequest) MessageType() string {
+	return "getMapThumbnailFile"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getMapThumbnailFileRequest GetMapThumbnailFileRequest) MarshalJSON() ([]byte, error) {
+	type alias GetMapThumbnailFileRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getMapThumbnailFile", alias(getMapThumbnailFileRequest)})
+}
+
+// AcceptTermsOfServiceRequest is the request of AcceptTermsOfService: Accepts Telegram terms of services
+type AcceptTermsOfServiceRequest struct {
+	TermsOfServiceID string `json:"terms_of_service_id"` // Terms of service identifier
+}
+
+// MessageType return the string telegram-type of AcceptTermsOfServiceRequest
+func (acceptTermsOfServiceRequest *AcceptTermsOfServiceRequest) MessageType() string {
+	return "acceptTermsOfService"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (acceptTermsOfServiceRequest AcceptTermsOfServiceRequest) MarshalJSON() ([]byte, error) {
+	type alias AcceptTermsOfServiceRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"acceptTermsOfService", alias(acceptTermsOfServiceRequest)})
+}
+
+// SendCustomRequestRequest is the request of SendCustomRequest: Sends a custom request; for bots only
+type SendCustomRequestRequest struct {
+	Method     string `json:"method"`     // The method name
+	Parameters string `json:"parameters"` // JSON-serialized method parameters
+}
+
+// MessageType return the string telegram-type of SendCustomRequestRequest
+func (sendCustomRequestRequest *SendCustomRequestRequest) MessageType() string {
+	return "sendCustomRequest"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (sendCustomRequestRequest SendCustomRequestRequest) MarshalJSON() ([]byte, error) {
+	type alias SendCustomRequestRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"sendCustomRequest", alias(sendCustomRequestRequest)})
+}
+
+// AnswerCustomQueryRequest is the request of AnswerCustomQuery: Answers a custom query; for bots only
+type AnswerCustomQueryRequest struct {
+	CustomQueryID JSONInt64 `json:"custom_query_id"` // Identifier of a custom query
+	Data          string    `json:"data"`            // JSON-serialized answer to the query
+}
+
+// MessageType return the string telegram-type of AnswerCustomQueryRequest
+func (answerCustomQueryRequest *AnswerCustomQueryRequest) MessageType() string {
+	return "answerCustomQuery"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (answerCustomQueryRequest AnswerCustomQueryRequest) MarshalJSON() ([]byte, error) {
+	type alias AnswerCustomQueryRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"answerCustomQuery", alias(answerCustomQueryRequest)})
+}
+
+// SetAlarmRequest is the request of SetAlarm: Succeeds after a specified amount of time has passed. Can be called before initialization
+type SetAlarmRequest struct {
+	Seconds float64 `json:"seconds"` // Number of seconds before the function returns
+}
+
+// MessageType return the string telegram-type of SetAlarmRequest
+func (setAlarmRequest *SetAlarmRequest) MessageType() string {
+	return "setAlarm"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setAlarmRequest SetAlarmRequest) MarshalJSON() ([]byte, error) {
+	type alias SetAlarmRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setAlarm", alias(setAlarmRequest)})
+}
+
+// GetCountriesRequest is the request of GetCountries: Returns information about existing countries. Can be called before authorization
+type GetCountriesRequest struct {
+}
+
+// MessageType return the string telegram-type of GetCountriesRequest
+func (getCountriesRequest *GetCountriesRequest) MessageType() string {
+	return "getCountries"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getCountriesRequest GetCountriesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetCountriesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getCountries", alias(getCountriesRequest)})
+}
+
+// GetCountryCodeRequest is the request of GetCountryCode: Uses the current IP address to find the current country. Returns two-letter ISO 3166-1 alpha-2 country code. Can be called before authorization
+type GetCountryCodeRequest struct {
+}
+
+// MessageType return the string telegram-type of GetCountryCodeRequest
+func (getCountryCodeRequest *GetCountryCodeRequest) MessageType() string {
+	return "getCountryCode"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getCountryCodeRequest GetCountryCodeRequest) MarshalJSON() ([]byte, error) {
+	type alias GetCountryCodeRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getCountryCode", alias(getCountryCodeRequest)})
+}
+
+// GetPhoneNumberInfoRequest is the request of GetPhoneNumberInfo: Returns information about a phone number by its prefix. Can be called before authorization
+type GetPhoneNumberInfoRequest struct {
+	PhoneNumberPrefix string `json:"phone_number_prefix"` // The phone number prefix
+}
+
+// MessageType return the string telegram-type of GetPhoneNumberInfoRequest
+func (getPhoneNumberInfoRequest *GetPhoneNumberInfoRequest) MessageType() string {
+	return "getPhoneNumberInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getPhoneNumberInfoRequest GetPhoneNumberInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetPhoneNumberInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getPhoneNumberInfo", alias(getPhoneNumberInfoRequest)})
+}
+
+// GetInviteTextRequest is the request of GetInviteText: Returns the default text for invitation messages to be used as a placeholder when the current user invites friends to Telegram
+type GetInviteTextRequest struct {
+}
+
+// MessageType return the string telegram-type of GetInviteTextRequest
+func (getInviteTextRequest *GetInviteTextRequest) MessageType() string {
+	return "getInviteText"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getInviteTextRequest GetInviteTextRequest) MarshalJSON() ([]byte, error) {
+	type alias GetInviteTextRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getInviteText", alias(getInviteTextRequest)})
+}
+
+// GetDeepLinkInfoRequest is the request of GetDeepLinkInfo: Returns information about a tg:// deep link. Use "tg://need_update_for_some_feature" or "tg:some_unsupported_feature" for testing. Returns a 404 error for unknown links. Can be called before authorization
+type GetDeepLinkInfoRequest struct {
+	Link string `json:"link"` // The link
+}
+
+// MessageType return the string telegram-type of GetDeepLinkInfoRequest
+func (getDeepLinkInfoRequest *GetDeepLinkInfoRequest) MessageType() string {
+	return "getDeepLinkInfo"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getDeepLinkInfoRequest GetDeepLinkInfoRequest) MarshalJSON() ([]byte, error) {
+	type alias GetDeepLinkInfoRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getDeepLinkInfo", alias(getDeepLinkInfoRequest)})
+}
+
+// GetApplicationConfigRequest is the request of GetApplicationConfig: Returns application config, provided by the server. Can be called before authorization
+type GetApplicationConfigRequest struct {
+}
+
+// MessageType return the string telegram-type of GetApplicationConfigRequest
+func (getApplicationConfigRequest *GetApplicationConfigRequest) MessageType() string {
+	return "getApplicationConfig"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getApplicationConfigRequest GetApplicationConfigRequest) MarshalJSON() ([]byte, error) {
+	type alias GetApplicationConfigRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getApplicationConfig", alias(getApplicationConfigRequest)})
+}
+
+// SaveApplicationLogEventRequest is the request of SaveApplicationLogEvent: Saves application log event on the server. Can be called before authorization
+type SaveApplicationLogEventRequest struct {
+	Type   string    `json:"type"`    // Event type
+	ChatID JSONInt64 `json:"chat_id"` // Optional chat identifier, associated with the event
+	Data   JsonValue `json:"data"`    // The log event data
+}
+
+// MessageType return the string telegram-type of SaveApplicationLogEventRequest
+func (saveApplicationLogEventRequest *SaveApplicationLogEventRequest) MessageType() string {
+	return "saveApplicationLogEvent"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (saveApplicationLogEventRequest SaveApplicationLogEventRequest) MarshalJSON() ([]byte, error) {
+	type alias SaveApplicationLogEventRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"saveApplicationLogEvent", alias(saveApplicationLogEventRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (saveApplicationLogEventRequest *SaveApplicationLogEventRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Type   string    `json:"type"`    // Event type
+		ChatID JSONInt64 `json:"chat_id"` // Optional chat identifier, associated with the event
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	saveApplicationLogEventRequest.Type = tempObj.Type
+	saveApplicationLogEventRequest.ChatID = tempObj.ChatID
+
+	fieldData, _ := unmarshalJsonValue(objMap["data"])
+	saveApplicationLogEventRequest.Data = fieldData
+
+	return nil
+}
+
+// AddProxyRequest is the request of AddProxy: Adds a proxy server for network requests. Can be called before authorization
+type AddProxyRequest struct {
+	Server string    `json:"server"` // Proxy server IP address
+	Port   int32     `json:"port"`   // Proxy server port
+	Enable bool      `json:"enable"` // True, if the proxy should be enabled
+	Type   ProxyType `json:"type"`   // Proxy type
+}
+
+// MessageType return the string telegram-type of AddProxyRequest
+func (addProxyRequest *AddProxyRequest) MessageType() string {
+	return "addProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addProxyRequest AddProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias AddProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addProxy", alias(addProxyRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (addProxyRequest *AddProxyRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Server string `json:"server"` // Proxy server IP address
+		Port   int32  `json:"port"`   // Proxy server port
+		Enable bool   `json:"enable"` // True, if the proxy should be enabled
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	addProxyRequest.Server = tempObj.Server
+	addProxyRequest.Port = tempObj.Port
+	addProxyRequest.Enable = tempObj.Enable
+
+	fieldType, _ := unmarshalProxyType(objMap["type"])
+	addProxyRequest.Type = fieldType
+
+	return nil
+}
+
+// EditProxyRequest is the request of EditProxy: Edits an existing proxy server for network requests. Can be called before authorization
+type EditProxyRequest struct {
+	ProxyID int32     `json:"proxy_id"` // Proxy identifier
+	Server  string    `json:"server"`   // Proxy server IP address
+	Port    int32     `json:"port"`     // Proxy server port
+	Enable  bool      `json:"enable"`   // True, if the proxy should be enabled
+	Type    ProxyType `json:"type"`     // Proxy type
+}
+
+// MessageType return the string telegram-type of EditProxyRequest
+func (editProxyRequest *EditProxyRequest) MessageType() string {
+	return "editProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (editProxyRequest EditProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias EditProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"editProxy", alias(editProxyRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (editProxyRequest *EditProxyRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		ProxyID int32  `json:"proxy_id"` // Proxy identifier
+		Server  string `json:"server"`   // Proxy server IP address
+		Port    int32  `json:"port"`     // Proxy server port
+		Enable  bool   `json:"enable"`   // True, if the proxy should be enabled
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	editProxyRequest.ProxyID = tempObj.ProxyID
+	editProxyRequest.Server = tempObj.Server
+	editProxyRequest.Port = tempObj.Port
+	editProxyRequest.Enable = tempObj.Enable
+
+	fieldType, _ := unmarshalProxyType(objMap["type"])
+	editProxyRequest.Type = fieldType
+
+	return nil
+}
+
+// EnableProxyRequest is the request of EnableProxy: Enables a proxy. Only one proxy can be enabled at a time. Can be called before authorization
+type EnableProxyRequest struct {
+	ProxyID int32 `json:"proxy_id"` // Proxy identifier
+}
+
+// MessageType return the string telegram-type of EnableProxyRequest
+func (enableProxyRequest *EnableProxyRequest) MessageType() string {
+	return "enableProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (enableProxyRequest EnableProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias EnableProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"enableProxy", alias(enableProxyRequest)})
+}
+
+// DisableProxyRequest is the request of DisableProxy: Disables the currently enabled proxy. Can be called before authorization
+type DisableProxyRequest struct {
+}
+
+// MessageType return the string telegram-type of DisableProxyRequest
+func (disableProxyRequest *DisableProxyRequest) MessageType() string {
+	return "disableProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (disableProxyRequest DisableProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias DisableProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"disableProxy", alias(disableProxyRequest)})
+}
+
+// RemoveProxyRequest is the request of RemoveProxy: Removes a proxy server. Can be called before authorization
+type RemoveProxyRequest struct {
+	ProxyID int32 `json:"proxy_id"` // Proxy identifier
+}
+
+// MessageType return the string telegram-type of RemoveProxyRequest
+func (removeProxyRequest *RemoveProxyRequest) MessageType() string {
+	return "removeProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (removeProxyRequest RemoveProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias RemoveProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"removeProxy", alias(removeProxyRequest)})
+}
+
+// GetProxiesRequest is the request of GetProxies: Returns list of proxies that are currently set up. Can be called before authorization
+type GetProxiesRequest struct {
+}
+
+// MessageType return the string telegram-type of GetProxiesRequest
+func (getProxiesRequest *GetProxiesRequest) MessageType() string {
+	return "getProxies"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getProxiesRequest GetProxiesRequest) MarshalJSON() ([]byte, error) {
+	type alias GetProxiesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getProxies", alias(getProxiesRequest)})
+}
+
+// GetProxyLinkRequest is the request of GetProxyLink: Returns an HTTPS link, which can be used to add a proxy. Available only for SOCKS5 and MTProto proxies. Can be called before authorization
+type GetProxyLinkRequest struct {
+	ProxyID int32 `json:"proxy_id"` // Proxy identifier
+}
+
+// MessageType return the string telegram-type of GetProxyLinkRequest
+func (getProxyLinkRequest *GetProxyLinkRequest) MessageType() string {
+	return "getProxyLink"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getProxyLinkRequest GetProxyLinkRequest) MarshalJSON() ([]byte, error) {
+	type alias GetProxyLinkRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getProxyLink", alias(getProxyLinkRequest)})
+}
+
+// PingProxyRequest is the request of PingProxy: Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization
+type PingProxyRequest struct {
+	ProxyID int32 `json:"proxy_id"` // Proxy identifier. Use 0 to ping a Telegram server without a proxy
+}
+
+// MessageType return the string telegram-type of PingProxyRequest
+func (pingProxyRequest *PingProxyRequest) MessageType() string {
+	return "pingProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (pingProxyRequest PingProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias PingProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"pingProxy", alias(pingProxyRequest)})
+}
+
+// SetLogStreamRequest is the request of SetLogStream: Sets new log stream for internal logging of TDLib. Can be called synchronously
+type SetLogStreamRequest struct {
+	LogStream LogStream `json:"log_stream"` // New log stream
+}
+
+// MessageType return the string telegram-type of SetLogStreamRequest
+func (setLogStreamRequest *SetLogStreamRequest) MessageType() string {
+	return "setLogStream"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setLogStreamRequest SetLogStreamRequest) MarshalJSON() ([]byte, error) {
+	type alias SetLogStreamRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setLogStream", alias(setLogStreamRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (setLogStreamRequest *SetLogStreamRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fieldLogStream, _ := unmarshalLogStream(objMap["log_stream"])
+	setLogStreamRequest.LogStream = fieldLogStream
+
+	return nil
+}
+
+// GetLogStreamRequest is the request of GetLogStream: Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
+type GetLogStreamRequest struct {
+}
+
+// MessageType return the string telegram-type of GetLogStreamRequest
+func (getLogStreamRequest *GetLogStreamRequest) MessageType() string {
+	return "getLogStream"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLogStreamRequest GetLogStreamRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLogStreamRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLogStream", alias(getLogStreamRequest)})
+}
+
+// SetLogVerbosityLevelRequest is the request of SetLogVerbosityLevel: Sets the verbosity level of the internal logging of TDLib. Can be called synchronously
+type SetLogVerbosityLevelRequest struct {
+	NewVerbosityLevel int32 `json:"new_verbosity_level"` // New value of the verbosity level for logging. Value 0 corresponds to fatal errors, value 1 corresponds to errors, value 2 corresponds to warnings and debug warnings, value 3 corresponds to informational, value 4 corresponds to debug, value 5 corresponds to verbose debug, value greater than 5 and up to 1023 can be used to enable even more logging
+}
+
+// MessageType return the string telegram-type of SetLogVerbosityLevelRequest
+func (setLogVerbosityLevelRequest *SetLogVerbosityLevelRequest) MessageType() string {
+	return "setLogVerbosityLevel"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setLogVerbosityLevelRequest SetLogVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type alias SetLogVerbosityLevelRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setLogVerbosityLevel", alias(setLogVerbosityLevelRequest)})
+}
+
+// GetLogVerbosityLevelRequest is the request of GetLogVerbosityLevel: Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
+type GetLogVerbosityLevelRequest struct {
+}
+
+// MessageType return the string telegram-type of GetLogVerbosityLevelRequest
+func (getLogVerbosityLevelRequest *GetLogVerbosityLevelRequest) MessageType() string {
+	return "getLogVerbosityLevel"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLogVerbosityLevelRequest GetLogVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLogVerbosityLevelRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLogVerbosityLevel", alias(getLogVerbosityLevelRequest)})
+}
+
+// GetLogTagsRequest is the request of GetLogTags: Returns list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
+type GetLogTagsRequest struct {
+}
+
+// MessageType return the string telegram-type of GetLogTagsRequest
+func (getLogTagsRequest *GetLogTagsRequest) MessageType() string {
+	return "getLogTags"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLogTagsRequest GetLogTagsRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLogTagsRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLogTags", alias(getLogTagsRequest)})
+}
+
+// SetLogTagVerbosityLevelRequest is the request of SetLogTagVerbosityLevel: Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously
+type SetLogTagVerbosityLevelRequest struct {
+	Tag               string `json:"tag"`                 // Logging tag to change verbosity level
+	NewVerbosityLevel int32  `json:"new_verbosity_level"` // New verbosity level; 1-1024
+}
+
+// MessageType return the string telegram-type of SetLogTagVerbosityLevelRequest
+func (setLogTagVerbosityLevelRequest *SetLogTagVerbosityLevelRequest) MessageType() string {
+	return "setLogTagVerbosityLevel"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (setLogTagVerbosityLevelRequest SetLogTagVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type alias SetLogTagVerbosityLevelRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"setLogTagVerbosityLevel", alias(setLogTagVerbosityLevelRequest)})
+}
+
+// GetLogTagVerbosityLevelRequest is the request of GetLogTagVerbosityLevel: Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously
+type GetLogTagVerbosityLevelRequest struct {
+	Tag string `json:"tag"` // Logging tag to change verbosity level
+}
+
+// MessageType return the string telegram-type of GetLogTagVerbosityLevelRequest
+func (getLogTagVerbosityLevelRequest *GetLogTagVerbosityLevelRequest) MessageType() string {
+	return "getLogTagVerbosityLevel"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (getLogTagVerbosityLevelRequest GetLogTagVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type alias GetLogTagVerbosityLevelRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"getLogTagVerbosityLevel", alias(getLogTagVerbosityLevelRequest)})
+}
+
+// AddLogMessageRequest is the request of AddLogMessage: Adds a message to TDLib internal log. Can be called synchronously
+type AddLogMessageRequest struct {
+	VerbosityLevel int32  `json:"verbosity_level"` // The minimum verbosity level needed for the message to be logged; 0-1023
+	Text           string `json:"text"`            // Text of a message to log
+}
+
+// MessageType return the string telegram-type of AddLogMessageRequest
+func (addLogMessageRequest *AddLogMessageRequest) MessageType() string {
+	return "addLogMessage"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (addLogMessageRequest AddLogMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias AddLogMessageRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"addLogMessage", alias(addLogMessageRequest)})
+}
+
+// TestCallEmptyRequest is the request of TestCallEmpty: Does nothing; for testing only. This is an offline method. Can be called before authorization
+type TestCallEmptyRequest struct {
+}
+
+// MessageType return the string telegram-type of TestCallEmptyRequest
+func (testCallEmptyRequest *TestCallEmptyRequest) MessageType() string {
+	return "testCallEmpty"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallEmptyRequest TestCallEmptyRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallEmptyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallEmpty", alias(testCallEmptyRequest)})
+}
+
+// TestCallStringRequest is the request of TestCallString: Returns the received string; for testing only. This is an offline method. Can be called before authorization
+type TestCallStringRequest struct {
+	X string `json:"x"` // String to return
+}
+
+// MessageType return the string telegram-type of TestCallStringRequest
+func (testCallStringRequest *TestCallStringRequest) MessageType() string {
+	return "testCallString"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallStringRequest TestCallStringRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallStringRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallString", alias(testCallStringRequest)})
+}
+
+// TestCallBytesRequest is the request of TestCallBytes: Returns the received bytes; for testing only. This is an offline method. Can be called before authorization
+type TestCallBytesRequest struct {
+	X []byte `json:"x"` // Bytes to return
+}
+
+// MessageType return the string telegram-type of TestCallBytesRequest
+func (testCallBytesRequest *TestCallBytesRequest) MessageType() string {
+	return "testCallBytes"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallBytesRequest TestCallBytesRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallBytesRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallBytes", alias(testCallBytesRequest)})
+}
+
+// TestCallVectorIntRequest is the request of TestCallVectorInt: Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization
+type TestCallVectorIntRequest struct {
+	X []int32 `json:"x"` // Vector of numbers to return
+}
+
+// MessageType return the string telegram-type of TestCallVectorIntRequest
+func (testCallVectorIntRequest *TestCallVectorIntRequest) MessageType() string {
+	return "testCallVectorInt"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallVectorIntRequest TestCallVectorIntRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallVectorIntRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallVectorInt", alias(testCallVectorIntRequest)})
+}
+
+// TestCallVectorIntObjectRequest is the request of TestCallVectorIntObject: Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization
+type TestCallVectorIntObjectRequest struct {
+	X []TestInt `json:"x"` // Vector of objects to return
+}
+
+// MessageType return the string telegram-type of TestCallVectorIntObjectRequest
+func (testCallVectorIntObjectRequest *TestCallVectorIntObjectRequest) MessageType() string {
+	return "testCallVectorIntObject"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallVectorIntObjectRequest TestCallVectorIntObjectRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallVectorIntObjectRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallVectorIntObject", alias(testCallVectorIntObjectRequest)})
+}
+
+// TestCallVectorStringRequest is the request of TestCallVectorString: Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization
+type TestCallVectorStringRequest struct {
+	X []string `json:"x"` // Vector of strings to return
+}
+
+// MessageType return the string telegram-type of TestCallVectorStringRequest
+func (testCallVectorStringRequest *TestCallVectorStringRequest) MessageType() string {
+	return "testCallVectorString"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallVectorStringRequest TestCallVectorStringRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallVectorStringRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallVectorString", alias(testCallVectorStringRequest)})
+}
+
+// TestCallVectorStringObjectRequest is the request of TestCallVectorStringObject: Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization
+type TestCallVectorStringObjectRequest struct {
+	X []TestString `json:"x"` // Vector of objects to return
+}
+
+// MessageType return the string telegram-type of TestCallVectorStringObjectRequest
+func (testCallVectorStringObjectRequest *TestCallVectorStringObjectRequest) MessageType() string {
+	return "testCallVectorStringObject"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testCallVectorStringObjectRequest TestCallVectorStringObjectRequest) MarshalJSON() ([]byte, error) {
+	type alias TestCallVectorStringObjectRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testCallVectorStringObject", alias(testCallVectorStringObjectRequest)})
+}
+
+// TestSquareIntRequest is the request of TestSquareInt: Returns the squared received number; for testing only. This is an offline method. Can be called before authorization
+type TestSquareIntRequest struct {
+	X int32 `json:"x"` // Number to square
+}
+
+// MessageType return the string telegram-type of TestSquareIntRequest
+func (testSquareIntRequest *TestSquareIntRequest) MessageType() string {
+	return "testSquareInt"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testSquareIntRequest TestSquareIntRequest) MarshalJSON() ([]byte, error) {
+	type alias TestSquareIntRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testSquareInt", alias(testSquareIntRequest)})
+}
+
+// TestNetworkRequest is the request of TestNetwork: Sends a simple network request to the Telegram servers; for testing only. Can be called before authorization
+type TestNetworkRequest struct {
+}
+
+// MessageType return the string telegram-type of TestNetworkRequest
+func (testNetworkRequest *TestNetworkRequest) MessageType() string {
+	return "testNetwork"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testNetworkRequest TestNetworkRequest) MarshalJSON() ([]byte, error) {
+	type alias TestNetworkRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testNetwork", alias(testNetworkRequest)})
+}
+
+// TestProxyRequest is the request of TestProxy: Sends a simple network request to the Telegram servers via proxy; for testing only. Can be called before authorization
+type TestProxyRequest struct {
+	Server  string    `json:"server"`  // Proxy server IP address
+	Port    int32     `json:"port"`    // Proxy server port
+	Type    ProxyType `json:"type"`    // Proxy type
+	DcID    int32     `json:"dc_id"`   // Identifier of a datacenter, with which to test connection
+	Timeout float64   `json:"timeout"` // The maximum overall timeout for the request
+}
+
+// MessageType return the string telegram-type of TestProxyRequest
+func (testProxyRequest *TestProxyRequest) MessageType() string {
+	return "testProxy"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testProxyRequest TestProxyRequest) MarshalJSON() ([]byte, error) {
+	type alias TestProxyRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testProxy", alias(testProxyRequest)})
+}
+
+// UnmarshalJSON unmarshal to json
+func (testProxyRequest *TestProxyRequest) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		Server  string  `json:"server"`  // Proxy server IP address
+		Port    int32   `json:"port"`    // Proxy server port
+		DcID    int32   `json:"dc_id"`   // Identifier of a datacenter, with which to test connection
+		Timeout float64 `json:"timeout"` // The maximum overall timeout for the request
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	testProxyRequest.Server = tempObj.Server
+	testProxyRequest.Port = tempObj.Port
+	testProxyRequest.DcID = tempObj.DcID
+	testProxyRequest.Timeout = tempObj.Timeout
+
+	fieldType, _ := unmarshalProxyType(objMap["type"])
+	testProxyRequest.Type = fieldType
+
+	return nil
+}
+
+// TestGetDifferenceRequest is the request of TestGetDifference: Forces an updates.getDifference call to the Telegram servers; for testing only
+type TestGetDifferenceRequest struct {
+}
+
+// MessageType return the string telegram-type of TestGetDifferenceRequest
+func (testGetDifferenceRequest *TestGetDifferenceRequest) MessageType() string {
+	return "testGetDifference"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testGetDifferenceRequest TestGetDifferenceRequest) MarshalJSON() ([]byte, error) {
+	type alias TestGetDifferenceRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testGetDifference", alias(testGetDifferenceRequest)})
+}
+
+// TestUseUpdateRequest is the request of TestUseUpdate: Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization
+type TestUseUpdateRequest struct {
+}
+
+// MessageType return the string telegram-type of TestUseUpdateRequest
+func (testUseUpdateRequest *TestUseUpdateRequest) MessageType() string {
+	return "testUseUpdate"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testUseUpdateRequest TestUseUpdateRequest) MarshalJSON() ([]byte, error) {
+	type alias TestUseUpdateRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testUseUpdate", alias(testUseUpdateRequest)})
+}
+
+// TestReturnErrorRequest is the request of TestReturnError: Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
+type TestReturnErrorRequest struct {
+	Error *Error `json:"error"` // The error to be returned
+}
+
+// MessageType return the string telegram-type of TestReturnErrorRequest
+func (testReturnErrorRequest *TestReturnErrorRequest) MessageType() string {
+	return "testReturnError"
+}
+
+// MarshalJSON marshals to json, with @type set
+func (testReturnErrorRequest TestReturnErrorRequest) MarshalJSON() ([]byte, error) {
+	type alias TestReturnErrorRequest
+	return json.Marshal(struct {
+		Type string `json:"@type"`
+		alias
+	}{"testReturnError", alias(testReturnErrorRequest)})
+}
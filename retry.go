@@ -0,0 +1,98 @@
+package tdlib
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// RetryPolicy makes the client repeat requests failing with a 429 FLOOD_WAIT error
+// after sleeping for the period requested by the server
+type RetryPolicy struct {
+	MaxAttempts    int                           // Attempts per request including the first one; values below 1 mean 1
+	MaxWait        time.Duration                 // Longest flood wait the client sleeps through, longer waits are returned to the caller; 0 means no limit
+	MethodAttempts map[string]int                // Per @type overrides of MaxAttempts, a listed method is retried even if Retryable rejects it
+	Retryable      func(requestType string) bool // Reports whether a request is safe to repeat; IsIdempotent is used when nil
+}
+
+// SetRetryPolicy enables retrying of flood-waited requests for every method, nil disables it.
+// It should be called before sending any request.
+func (client *Client) SetRetryPolicy(policy *RetryPolicy) {
+	client.retryPolicy = policy
+}
+
+// IsIdempotent reports whether the request only reads data (get* and search* methods),
+// these are the requests retried by default
+func IsIdempotent(requestType string) bool {
+	return strings.HasPrefix(requestType, "get") || strings.HasPrefix(requestType, "search")
+}
+
+// maxAttempts returns how many times a request of the given @type may be sent
+func (policy *RetryPolicy) maxAttempts(requestType string) int {
+	if attempts, found := policy.MethodAttempts[requestType]; found {
+		return attempts
+	}
+
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsIdempotent
+	}
+	if !retryable(requestType) {
+		return 1
+	}
+
+	return policy.MaxAttempts
+}
+
+// sendWithRetry sends the request and repeats it according to the retry policy while it gets flood waits
+func (client *Client) sendWithRetry(ctx context.Context, update UpdateData) (UpdateMsg, error) {
+	// nothing waits for the response of Send, so there is nothing to retry either
+	if IsSendOnly(ctx) {
+		client.transport.Send(marshalQuery(update))
+		return UpdateMsg{}, nil
+	}
+
+	policy := client.retryPolicy
+	if policy == nil {
+		return client.roundTrip(ctx, update)
+	}
+
+	requestType, _ := update["@type"].(string)
+	maxAttempts := policy.maxAttempts(requestType)
+
+	for attempt := 1; ; attempt++ {
+		response, err := client.roundTrip(ctx, update)
+		if err != nil || attempt >= maxAttempts || response.Data["@type"] != "error" {
+			return response, err
+		}
+
+		var tdErr *Error
+		if !errors.As(responseError(response), &tdErr) || !errors.Is(tdErr, ErrFloodWait) {
+			return response, nil
+		}
+
+		wait := tdErr.RetryAfter()
+		if wait <= 0 {
+			wait = time.Second
+		}
+		if policy.MaxWait > 0 && wait > policy.MaxWait {
+			return response, nil
+		}
+		// no point in sleeping if the caller gives up before the wait is over
+		if deadline, hasDeadline := ctx.Deadline(); hasDeadline && time.Until(deadline) < wait {
+			return response, nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			if ctx.Err() == context.DeadlineExceeded {
+				return UpdateMsg{}, ErrTimeout
+			}
+			return UpdateMsg{}, ctx.Err()
+		}
+	}
+}
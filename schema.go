@@ -0,0 +1,9 @@
+package tdlib
+
+// SchemaVersion is the TDLib version the types and methods were generated for,
+// it is compared with the version option of the connected TDLib on startup
+const SchemaVersion = "1.7.0"
+
+// SchemaCommitHash is the TDLib commit the schema was taken from, it is compared with the commit_hash option
+// of the connected TDLib on startup; empty if unknown
+const SchemaCommitHash = ""
@@ -0,0 +1,94 @@
+package tdlib_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+// shutdownWithin calls Shutdown with a ctx timing out after timeout and fails the test if it outlasts limit
+func shutdownWithin(t *testing.T, client *tdlib.Client, timeout time.Duration, limit time.Duration) error {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- client.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(limit):
+		t.Fatalf("Shutdown still blocked after %v", limit)
+		return nil
+	}
+}
+
+func TestShutdownWithBlockedReceiver(t *testing.T) {
+	server := tdlibtest.NewServer()
+	client := server.NewClient(tdlib.Config{})
+
+	// nobody reads the unbuffered channel, the receive loop blocks delivering the update
+	client.AddEventReceiver(&tdlib.UpdateNewMessage{}, func(msg *tdlib.TdMessage) bool { return true }, 0)
+	if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{})); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	err := shutdownWithin(t, client, 300*time.Millisecond, 3*time.Second)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown returned %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestShutdownWithFullRawUpdatesChannel(t *testing.T) {
+	server := tdlibtest.NewServer()
+	client := server.NewClient(tdlib.Config{})
+
+	rawUpdates := client.GetRawUpdatesChannel(1)
+	for i := 0; i < 3; i++ {
+		if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	shutdownWithin(t, client, 300*time.Millisecond, 3*time.Second)
+
+	// the channel is closed once the receive loop has returned
+	deadline := time.After(3 * time.Second)
+	for {
+		select {
+		case _, ok := <-rawUpdates:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatal("raw updates channel not closed")
+		}
+	}
+}
+
+func TestShutdownAfterClose(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.HandleFunc("close", func(request tdlib.UpdateData) interface{} {
+		server.PushUpdate(tdlib.NewUpdateAuthorizationState(tdlib.NewAuthorizationStateClosed()))
+		return tdlib.NewOk()
+	})
+	client := server.NewClient(tdlib.Config{})
+
+	if err := shutdownWithin(t, client, 3*time.Second, 5*time.Second); err != nil {
+		t.Fatalf("Shutdown returned %v", err)
+	}
+
+	if _, err := client.GetAuthorizationState(); !errors.Is(err, tdlib.ErrClosed) {
+		t.Fatalf("request after Shutdown returned %v, want ErrClosed", err)
+	}
+}
@@ -1,23 +1,14 @@
 package tdlib
 
-//#cgo linux CFLAGS: -I/usr/local/include
-//#cgo darwin CFLAGS: -I/usr/local/include
-//#cgo windows CFLAGS: -IC:/src/td -IC:/src/td/build
-//#cgo linux LDFLAGS: -L/usr/local/lib -ltdjson_static -ltdjson_private -ltdclient -ltdcore -ltdapi -ltdactor -ltddb -ltdsqlite -ltdnet -ltdutils -lc++ -lssl -lcrypto -ldl -lz -lm
-//#cgo darwin LDFLAGS: -L/usr/local/lib -L/usr/local/opt/openssl/lib -ltdjson_static -ltdjson_private -ltdclient -ltdcore -ltdapi -ltdactor -ltddb -ltdsqlite -ltdnet -ltdutils -lc++ -lssl -lcrypto -ldl -lz -lm
-//#cgo windows LDFLAGS: -LC:/src/td/build/Debug -ltdjson
-//#include <stdlib.h>
-//#include <td/telegram/td_json_client.h>
-//#include <td/telegram/td_log.h>
-import "C"
+//go:generate go run ./cmd/tdlib-gen -schema schema/td_api.tl -out . -version 1.7.0
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"math/rand"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 )
@@ -31,6 +22,12 @@ type UpdateMsg struct {
 	Raw  []byte
 }
 
+// defaultRequestTimeout is how long SendAndCatch waits for a response when the context has no deadline
+const defaultRequestTimeout = 10 * time.Second
+
+// receiveTimeout is how many seconds the receive loop blocks in Receive before checking whether it was stopped
+const receiveTimeout = 1
+
 // EventFilterFunc used to filter out unwanted messages in receiver channels
 type EventFilterFunc func(msg *TdMessage) bool
 
@@ -39,106 +36,216 @@ type EventReceiver struct {
 	Instance   TdMessage
 	Chan       chan TdMessage
 	FilterFunc EventFilterFunc
+	state      *receiverState
 }
 
 // Client is the Telegram TdLib client
 type Client struct {
+	// Client is the td_json_client_create handle of a client created with NewClient, nil with any other Transport.
+	//
+	// Deprecated: use the Client methods or a Transport instead, the handle is invalid once the client is destroyed.
 	Client       unsafe.Pointer
 	Config       Config
+	transport    Transport
 	rawUpdates   chan UpdateMsg
 	receivers    []EventReceiver
 	waiters      map[string]chan UpdateMsg
 	receiverLock *sync.Mutex
 	waitersLock  *sync.RWMutex
+	retryPolicy  *RetryPolicy
+	stop         chan struct{} // closed to ask the receive loop to stop
+	loopDone     chan struct{} // closed once the receive loop has returned
+	authClosed   chan struct{} // closed once authorizationStateClosed has been received
+	stopOnce     sync.Once
+	destroyOnce  sync.Once
+	handlers     map[UpdateEnum][]updateHandler
+	handlersLock *sync.RWMutex
+	handlerQueue chan Update
+	middlewares  []Middleware
+	chain        RoundTripFunc                 // middlewares wrapped around sendWithRetry
+	metrics      atomic.Pointer[metricsTarget] // set by EnableMetrics while the receive loop may be running
+	options      *OptionStore
+
+	schemaMismatchLogged atomic.Bool // set once observeSchemaVersion has logged a mismatch
 }
 
 // Config holds tdlibParameters
 type Config struct {
-	APIID              string // Application identifier for Telegram API access, which can be obtained at https://my.telegram.org   --- must be non-empty..
-	APIHash            string // Application identifier hash for Telegram API access, which can be obtained at https://my.telegram.org  --- must be non-empty..
-	SystemLanguageCode string // IETF language tag of the user's operating system language; must be non-empty.
-	DeviceModel        string // Model of the device the application is being run on; must be non-empty.
-	SystemVersion      string // Version of the operating system the application is being run on; must be non-empty.
-	ApplicationVersion string // Application version; must be non-empty.
+	APIID              string `json:"api_id" yaml:"api_id" toml:"api_id"`                                           // Application identifier for Telegram API access, which can be obtained at https://my.telegram.org   --- must be non-empty..
+	APIHash            string `json:"api_hash" yaml:"api_hash" toml:"api_hash"`                                     // Application identifier hash for Telegram API access, which can be obtained at https://my.telegram.org  --- must be non-empty..
+	SystemLanguageCode string `json:"system_language_code" yaml:"system_language_code" toml:"system_language_code"` // IETF language tag of the user's operating system language; must be non-empty.
+	DeviceModel        string `json:"device_model" yaml:"device_model" toml:"device_model"`                         // Model of the device the application is being run on; must be non-empty.
+	SystemVersion      string `json:"system_version" yaml:"system_version" toml:"system_version"`                   // Version of the operating system the application is being run on; must be non-empty.
+	ApplicationVersion string `json:"application_version" yaml:"application_version" toml:"application_version"`    // Application version; must be non-empty.
 	// Optional fields
-	UseTestDataCenter      bool   // if set to true, the Telegram test environment will be used instead of the production environment.
-	DatabaseDirectory      string // The path to the directory for the persistent database; if empty, the current working directory will be used.
-	FileDirectory          string // The path to the directory for storing files; if empty, database_directory will be used.
-	UseFileDatabase        bool   // If set to true, information about downloaded and uploaded files will be saved between application restarts.
-	UseChatInfoDatabase    bool   // If set to true, the library will maintain a cache of users, basic groups, supergroups, channels and secret chats. Implies use_file_database.
-	UseMessageDatabase     bool   // If set to true, the library will maintain a cache of chats and messages. Implies use_chat_info_database.
-	UseSecretChats         bool   // If set to true, support for secret chats will be enabled.
-	EnableStorageOptimizer bool   // If set to true, old files will automatically be deleted.
-	IgnoreFileNames        bool   // If set to true, original file names will be ignored. Otherwise, downloaded files will be saved under names as close as possible to the original name.
+	UseTestDataCenter      bool   `json:"use_test_dc" yaml:"use_test_dc" toml:"use_test_dc"`                                        // if set to true, the Telegram test environment will be used instead of the production environment.
+	DatabaseDirectory      string `json:"database_directory" yaml:"database_directory" toml:"database_directory"`                   // The path to the directory for the persistent database; if empty, the current working directory will be used.
+	FileDirectory          string `json:"files_directory" yaml:"files_directory" toml:"files_directory"`                            // The path to the directory for storing files; if empty, database_directory will be used.
+	UseFileDatabase        bool   `json:"use_file_database" yaml:"use_file_database" toml:"use_file_database"`                      // If set to true, information about downloaded and uploaded files will be saved between application restarts.
+	UseChatInfoDatabase    bool   `json:"use_chat_info_database" yaml:"use_chat_info_database" toml:"use_chat_info_database"`       // If set to true, the library will maintain a cache of users, basic groups, supergroups, channels and secret chats. Implies use_file_database.
+	UseMessageDatabase     bool   `json:"use_message_database" yaml:"use_message_database" toml:"use_message_database"`             // If set to true, the library will maintain a cache of chats and messages. Implies use_chat_info_database.
+	UseSecretChats         bool   `json:"use_secret_chats" yaml:"use_secret_chats" toml:"use_secret_chats"`                         // If set to true, support for secret chats will be enabled.
+	EnableStorageOptimizer bool   `json:"enable_storage_optimizer" yaml:"enable_storage_optimizer" toml:"enable_storage_optimizer"` // If set to true, old files will automatically be deleted.
+	IgnoreFileNames        bool   `json:"ignore_file_names" yaml:"ignore_file_names" toml:"ignore_file_names"`                      // If set to true, original file names will be ignored. Otherwise, downloaded files will be saved under names as close as possible to the original name.
+	DatabaseEncryptionKey  []byte `json:"-" yaml:"-" toml:"-"`                                                                      // Encryption key of the local database, used on authorizationStateWaitEncryptionKey; if empty, the database isn't encrypted.
+	// DatabaseEncryptionKeyProvider supplies the encryption key instead of DatabaseEncryptionKey, e.g. EncryptionKeyFromEnv or EncryptionKeyFromFile.
+	DatabaseEncryptionKeyProvider EncryptionKeyProvider `json:"-" yaml:"-" toml:"-"`
+	// Options are set with SetOption right after tdlib parameters are accepted, e.g. {"online": true, "prefer_ipv6": false}.
+	Options Options `json:"options" yaml:"options" toml:"options"`
+	// SchemaMismatch decides what happens when the connected TDLib isn't SchemaVersion: warn (default), error or ignore.
+	SchemaMismatch SchemaMismatchPolicy `json:"schema_mismatch" yaml:"schema_mismatch" toml:"schema_mismatch"`
 }
 
 // NewClient Creates a new instance of TDLib.
 // Has two public fields:
 // Client itself and RawUpdates channel
 func NewClient(config Config) *Client {
+	return NewClientWithTransport(config, newTdJSONTransport())
+}
+
+// NewClientWithTransport Creates a new client on top of the given transport instead of libtdjson.
+// Useful for running the client against an in-process fake TDLib.
+func NewClientWithTransport(config Config, transport Transport) *Client {
 	// Seed rand with time
 	rand.Seed(time.Now().UnixNano())
 
-	client := Client{Client: C.td_json_client_create()}
+	client := Client{Client: tdJSONClient(transport), transport: transport}
 	client.receivers = make([]EventReceiver, 0, 1)
 	client.receiverLock = &sync.Mutex{}
 	client.waitersLock = &sync.RWMutex{}
 	client.Config = config
 	client.waiters = make(map[string]chan UpdateMsg)
 
-	go func() {
-		for {
-			// get update
-			updateBytes := client.Receive(10)
-			var updateData UpdateData
-			json.Unmarshal(updateBytes, &updateData)
+	client.stop = make(chan struct{})
+	client.loopDone = make(chan struct{})
+	client.authClosed = make(chan struct{})
+	client.handlers = make(map[UpdateEnum][]updateHandler)
+	client.handlersLock = &sync.RWMutex{}
+	client.handlerQueue = make(chan Update, handlerQueueSize)
+	client.chain = client.sendWithRetry
+	client.options = newOptionStore(&client)
+
+	go client.receiveLoop()
+	go client.runHandlers()
+
+	return &client
+}
+
+// receiveLoop dispatches responses to waiters and updates to receivers until the client is stopped
+// or TDLib reports authorizationStateClosed, then it closes every receiver channel
+func (client *Client) receiveLoop() {
+	defer func() {
+		client.receiverLock.Lock()
+		for _, receiver := range client.receivers {
+			receiver.close()
+		}
+		client.receivers = nil
+		client.receiverLock.Unlock()
+
+		if client.rawUpdates != nil {
+			close(client.rawUpdates)
+		}
+		close(client.handlerQueue)
+
+		// fails every pending waiter
+		close(client.loopDone)
+	}()
+
+	for {
+		select {
+		case <-client.stop:
+			return
+		default:
+		}
 
-			// does new update has @extra field?
-			if extra, hasExtra := updateData["@extra"].(string); hasExtra {
+		// get update
+		var updateBytes []byte
+		if transport, ok := client.transport.(stoppableTransport); ok {
+			updateBytes = transport.receiveUntil(client.stop)
+		} else {
+			updateBytes = client.Receive(receiveTimeout)
+		}
+		var updateData UpdateData
+		json.Unmarshal(updateBytes, &updateData)
 
-				client.waitersLock.RLock()
-				waiter, found := client.waiters[extra]
-				client.waitersLock.RUnlock()
+		// does new update has @extra field?
+		if extra, hasExtra := updateData["@extra"].(string); hasExtra {
 
-				// trying to load update with this salt
-				if found {
-					// found? send it to waiter channel
-					waiter <- UpdateMsg{Data: updateData, Raw: updateBytes}
+			client.waitersLock.RLock()
+			waiter, found := client.waiters[extra]
+			client.waitersLock.RUnlock()
+
+			// trying to load update with this salt
+			if found {
+				// found? send it to waiter channel
+				waiter <- UpdateMsg{Data: updateData, Raw: updateBytes}
+
+				// trying to prevent memory leak
+				close(waiter)
+			}
+		} else {
+			// does new updates has @type field?
+			if msgType, hasType := updateData["@type"].(string); hasType {
 
-					// trying to prevent memory leak
-					close(waiter)
+				if target := client.metrics.Load(); target != nil {
+					target.metrics.observeUpdate(target.name, msgType)
 				}
-			} else {
-				// does new updates has @type field?
-				if msgType, hasType := updateData["@type"]; hasType {
 
-					if client.rawUpdates != nil {
-						// if rawUpdates is initialized, send the update in rawUpdates channel
-						client.rawUpdates <- UpdateMsg{Data: updateData, Raw: updateBytes}
+				// keep the option store current before anyone else sees the update
+				if msgType == string(UpdateOptionType) {
+					client.options.observe(updateBytes)
+				}
+
+				if client.rawUpdates != nil {
+					// if rawUpdates is initialized, send the update in rawUpdates channel
+					select {
+					case client.rawUpdates <- UpdateMsg{Data: updateData, Raw: updateBytes}:
+					case <-client.stop:
+						return
 					}
+				}
 
-					client.receiverLock.Lock()
-					for _, receiver := range client.receivers {
-						if msgType == receiver.Instance.MessageType() {
-							var newMsg TdMessage
-							newMsg = reflect.New(reflect.ValueOf(receiver.Instance).Elem().Type()).Interface().(TdMessage)
-
-							err := json.Unmarshal(updateBytes, &newMsg)
-							if err != nil {
-								fmt.Printf("Error unmarhaling to type %v", err)
-							}
-							if receiver.FilterFunc(&newMsg) {
-								receiver.Chan <- newMsg
-							}
+				// don't hold the lock while delivering, so receivers can be added and removed meanwhile
+				client.receiverLock.Lock()
+				receivers := make([]EventReceiver, len(client.receivers))
+				copy(receivers, client.receivers)
+				client.receiverLock.Unlock()
+
+				for _, receiver := range receivers {
+					if msgType == receiver.Instance.MessageType() {
+						var newMsg TdMessage
+						newMsg = reflect.New(reflect.ValueOf(receiver.Instance).Elem().Type()).Interface().(TdMessage)
+
+						err := json.Unmarshal(updateBytes, &newMsg)
+						if err != nil {
+							getLogger().Error("tdlib: failed to unmarshal update", "type", msgType, "error", err)
+						}
+						if receiver.FilterFunc(&newMsg) {
+							receiver.deliver(newMsg, client.stop)
 						}
 					}
-					client.receiverLock.Unlock()
+				}
+
+				client.dispatchUpdate(msgType, updateBytes)
+
+				// TDLib won't send anything else after authorizationStateClosed
+				if isAuthorizationStateClosed(updateData) {
+					close(client.authClosed)
+					return
 				}
 			}
 		}
-	}()
+	}
+}
 
-	return &client
+// isAuthorizationStateClosed reports whether the update is updateAuthorizationState with authorizationStateClosed
+func isAuthorizationStateClosed(updateData UpdateData) bool {
+	if updateData["@type"] != "updateAuthorizationState" {
+		return false
+	}
+
+	state, _ := updateData["authorization_state"].(map[string]interface{})
+	return state["@type"] == string(AuthorizationStateClosedType)
 }
 
 // GetRawUpdatesChannel creates a general channel that fetches every update comming from tdlib
@@ -149,87 +256,122 @@ func (client *Client) GetRawUpdatesChannel(capacity int) chan UpdateMsg {
 
 // AddEventReceiver adds a new receiver to be subscribed in receiver channels
 func (client *Client) AddEventReceiver(msgInstance TdMessage, filterFunc EventFilterFunc, channelCapacity int) EventReceiver {
-	receiver := EventReceiver{
-		Instance:   msgInstance,
-		Chan:       make(chan TdMessage, channelCapacity),
-		FilterFunc: filterFunc,
-	}
-
-	client.receiverLock.Lock()
-	defer client.receiverLock.Unlock()
-	client.receivers = append(client.receivers, receiver)
-
-	return receiver
+	return client.AddEventReceiverWithPolicy(msgInstance, filterFunc, channelCapacity, OverflowBlock)
 }
 
 // DestroyInstance Destroys the TDLib client instance.
 // After this is called the client instance shouldn't be used anymore.
+// Prefer Shutdown, which lets TDLib close properly before destroying the instance.
 func (client *Client) DestroyInstance() {
-	C.td_json_client_destroy(client.Client)
+	client.stopReceiving()
+	client.destroyOnce.Do(client.transport.Destroy)
 }
 
-// Send Sends request to the TDLib client.
-// You can provide string or UpdateData.
-func (client *Client) Send(jsonQuery interface{}) {
-	var query *C.char
+// Shutdown gracefully closes the client: it sends close to TDLib and waits for authorizationStateClosed,
+// stops the receive loop, closes every receiver channel and the raw updates channel, fails all pending
+// requests with ErrClosed and finally destroys the TDLib instance.
+// If ctx is done before TDLib has closed, the client is torn down anyway and ctx's error is returned.
+// Shutdown never outlasts ctx: if the receive loop hasn't returned by then, the instance is destroyed
+// in the background as soon as it has.
+// Unlike Close, which only sends the close request, the client can't be used anymore afterwards.
+func (client *Client) Shutdown(ctx context.Context) error {
+	var err error
 
-	switch jsonQuery.(type) {
-	case string:
-		query = C.CString(jsonQuery.(string))
-	case UpdateData:
-		jsonBytes, _ := json.Marshal(jsonQuery.(UpdateData))
-		query = C.CString(string(jsonBytes))
+	select {
+	case <-client.loopDone:
+	case <-client.authClosed:
+	default:
+		client.Send(UpdateData{"@type": "close"})
+
+		select {
+		case <-client.authClosed:
+		case <-client.loopDone:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+
+	client.stopOnce.Do(func() {
+		close(client.stop)
+	})
+
+	select {
+	case <-client.loopDone:
+		client.destroyOnce.Do(client.transport.Destroy)
+	case <-ctx.Done():
+		if err == nil {
+			err = ctx.Err()
+		}
+		// the instance can't be destroyed while the receive loop may still be calling Receive
+		go client.DestroyInstance()
 	}
 
-	defer C.free(unsafe.Pointer(query))
-	C.td_json_client_send(client.Client, query)
+	return err
+}
+
+// stopReceiving stops the receive loop and waits until it has returned
+func (client *Client) stopReceiving() {
+	client.stopOnce.Do(func() {
+		close(client.stop)
+	})
+	<-client.loopDone
+}
+
+// Send Sends request to the TDLib client without waiting for its response, through the middlewares.
+// You can provide string or UpdateData.
+func (client *Client) Send(jsonQuery interface{}) {
+	client.chain(context.WithValue(context.Background(), sendOnlyKey{}, true), queryData(jsonQuery))
 }
 
 // Receive Receives incoming updates and request responses from the TDLib client.
 // You can provide string or UpdateData.
 func (client *Client) Receive(timeout float64) []byte {
-	result := C.td_json_client_receive(client.Client, C.double(timeout))
-
-	return []byte(C.GoString(result))
+	return client.transport.Receive(timeout)
 }
 
-// Execute Synchronously executes TDLib request.
+// Execute Synchronously executes TDLib request, bypassing the middlewares.
 // Only a few requests can be executed synchronously.
 func (client *Client) Execute(jsonQuery interface{}) UpdateMsg {
-	var query *C.char
+	result := client.transport.Execute(marshalQuery(jsonQuery))
+
+	var update UpdateData
+	json.Unmarshal(result, &update)
+	return UpdateMsg{Data: update, Raw: result}
+}
 
+// marshalQuery converts a string or UpdateData query to raw JSON
+func marshalQuery(jsonQuery interface{}) []byte {
 	switch jsonQuery.(type) {
 	case string:
-		query = C.CString(jsonQuery.(string))
+		return []byte(jsonQuery.(string))
 	case UpdateData:
 		jsonBytes, _ := json.Marshal(jsonQuery.(UpdateData))
-		query = C.CString(string(jsonBytes))
+		return jsonBytes
 	}
 
-	defer C.free(unsafe.Pointer(query))
-	result := C.td_json_client_execute(client.Client, query)
-
-	var update UpdateData
-	json.Unmarshal([]byte(C.GoString(result)), &update)
-	return UpdateMsg{Data: update, Raw: []byte(C.GoString(result))}
+	return nil
 }
 
 // SetFilePath Sets the path to the file to where the internal TDLib log will be written.
 // By default TDLib writes logs to stderr or an OS specific log.
 // Use this method to write the log to a file instead.
 func SetFilePath(path string) {
+	SetLogFile(path, 10485760)
+}
+
+// SetLogFile Sets the path to the file to where the internal TDLib log will be written
+// and the maximum size of the file before it's rotated, in bytes.
+func SetLogFile(path string, maxFileSize int64) {
 	bytes, _ := json.Marshal(UpdateData{
 		"@type": "setLogStream",
 		"log_stream": UpdateData{
 			"@type":         "logStreamFile",
 			"path":          path,
-			"max_file_size": 10485760,
+			"max_file_size": maxFileSize,
 		},
 	})
 
-	query := C.CString(string(bytes))
-	C.td_json_client_execute(nil, query)
-	C.free(unsafe.Pointer(query))
+	tdExecute(bytes)
 }
 
 // SetLogVerbosityLevel Sets the verbosity level of the internal logging of TDLib.
@@ -240,14 +382,24 @@ func SetLogVerbosityLevel(level int) {
 		"new_verbosity_level": level,
 	})
 
-	query := C.CString(string(bytes))
-	C.td_json_client_execute(nil, query)
-	C.free(unsafe.Pointer(query))
+	tdExecute(bytes)
 }
 
 // SendAndCatch Sends request to the TDLib client and catches the result in updates channel.
 // You can provide string or UpdateData.
 func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
+	return client.SendAndCatchContext(context.Background(), jsonQuery)
+}
+
+// SendAndCatchContext Sends request to the TDLib client and catches the result in updates channel.
+// The request is abandoned as soon as ctx is done, if ctx has no deadline the default 10 seconds timeout is applied.
+// You can provide string or UpdateData.
+func (client *Client) SendAndCatchContext(ctx context.Context, jsonQuery interface{}) (UpdateMsg, error) {
+	return client.chain(ctx, queryData(jsonQuery))
+}
+
+// queryData converts a string or UpdateData query to UpdateData
+func queryData(jsonQuery interface{}) UpdateData {
 	var update UpdateData
 
 	switch jsonQuery.(type) {
@@ -258,6 +410,17 @@ func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
 		update = jsonQuery.(UpdateData)
 	}
 
+	return update
+}
+
+// roundTrip sends a single request and waits for its response
+func (client *Client) roundTrip(ctx context.Context, update UpdateData) (UpdateMsg, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+	}
+
 	// letters for generating random string
 	letterBytes := "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
@@ -278,8 +441,18 @@ func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
 	client.waiters[randomString] = waiter
 	client.waitersLock.Unlock()
 
-	// send it through already implemented method
-	client.Send(update)
+	// no one would ever deliver the response
+	select {
+	case <-client.loopDone:
+		client.waitersLock.Lock()
+		delete(client.waiters, randomString)
+		client.waitersLock.Unlock()
+
+		return UpdateMsg{}, ErrClosed
+	default:
+	}
+
+	client.transport.Send(marshalQuery(update))
 
 	select {
 	// wait response from main loop in NewClient()
@@ -289,13 +462,31 @@ func (client *Client) SendAndCatch(jsonQuery interface{}) (UpdateMsg, error) {
 		client.waitersLock.Unlock()
 
 		return response, nil
-		// or timeout
-	case <-time.After(10 * time.Second):
+		// or the client got closed
+	case <-client.loopDone:
 		client.waitersLock.Lock()
 		delete(client.waiters, randomString)
 		client.waitersLock.Unlock()
 
-		return UpdateMsg{}, errors.New("timeout")
+		// the response may have been delivered right before the loop returned
+		select {
+		case response, ok := <-waiter:
+			if ok {
+				return response, nil
+			}
+		default:
+		}
+		return UpdateMsg{}, ErrClosed
+		// or timeout/cancellation
+	case <-ctx.Done():
+		client.waitersLock.Lock()
+		delete(client.waiters, randomString)
+		client.waitersLock.Unlock()
+
+		if ctx.Err() == context.DeadlineExceeded {
+			return UpdateMsg{}, ErrTimeout
+		}
+		return UpdateMsg{}, ctx.Err()
 	}
 }
 
@@ -307,21 +498,29 @@ func (client *Client) Authorize() (AuthorizationState, error) {
 	}
 
 	if state.GetAuthorizationStateEnum() == AuthorizationStateWaitEncryptionKeyType {
-		ok, err := client.CheckDatabaseEncryptionKey(nil)
-
-		if ok == nil || err != nil {
+		if err := client.checkDatabaseEncryptionKey(context.Background()); err != nil {
 			return nil, err
 		}
 	} else if state.GetAuthorizationStateEnum() == AuthorizationStateWaitTdlibParametersType {
-		client.sendTdLibParams()
+		if err := client.sendTdLibParams(context.Background()); err != nil {
+			return nil, err
+		}
 	}
 
 	authState, err := client.GetAuthorizationState()
 	return authState, err
 }
 
-func (client *Client) sendTdLibParams() {
-	client.Send(UpdateData{
+func (client *Client) sendTdLibParams(ctx context.Context) error {
+	if err := client.Config.Validate(); err != nil {
+		return err
+	}
+
+	if err := client.checkSchemaVersion(); err != nil {
+		return err
+	}
+
+	result, err := client.SendAndCatchContext(ctx, UpdateData{
 		"@type": "setTdlibParameters",
 		"parameters": UpdateData{
 			"@type":                    "tdlibParameters",
@@ -342,6 +541,16 @@ func (client *Client) sendTdLibParams() {
 			"ignore_file_names":        client.Config.IgnoreFileNames,
 		},
 	})
+
+	if err != nil {
+		return err
+	}
+
+	if result.Data["@type"].(string) == "error" {
+		return responseError(result)
+	}
+
+	return client.applyOptions(ctx)
 }
 
 // SendPhoneNumber sends phone number to tdlib
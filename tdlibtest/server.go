@@ -0,0 +1,219 @@
+// Package tdlibtest provides an in-process fake TDLib for testing code built on go-tdlib
+// without libtdjson or a Telegram account.
+//
+// A Server implements tdlib.Transport: it answers requests by their @type with canned or
+// callback-generated responses and lets tests push arbitrary updates to the client.
+// A HandlerFunc returning nil leaves its request unanswered, e.g. to exercise timeouts:
+//
+//	server := tdlibtest.NewServer()
+//	server.Handle("getMe", tdlib.NewUser(...))
+//	client := server.NewClient(tdlib.Config{})
+//	server.PushUpdate(tdlib.NewUpdateNewMessage(...))
+package tdlibtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+)
+
+// HandlerFunc generates the response for a request, the returned value is serialized
+// to JSON, so it can be any generated type (e.g. *tdlib.Ok or *tdlib.Error) or tdlib.UpdateData.
+// Returning nil leaves the request unanswered, e.g. to exercise timeouts and cancellation.
+type HandlerFunc func(request tdlib.UpdateData) interface{}
+
+// Server is a scriptable fake TDLib backend speaking the TDLib JSON protocol
+type Server struct {
+	handlers map[string]HandlerFunc
+	requests []tdlib.UpdateData
+	lock     sync.Mutex
+
+	queue     [][]byte
+	queueLock sync.Mutex
+	signal    chan struct{}
+	destroyed chan struct{}
+	destroy   sync.Once
+}
+
+// NewServer creates a new Server without any handler, unhandled requests are answered with an error
+func NewServer() *Server {
+	return &Server{
+		handlers:  make(map[string]HandlerFunc),
+		signal:    make(chan struct{}, 1),
+		destroyed: make(chan struct{}),
+	}
+}
+
+// NewClient creates a new tdlib.Client running on top of the server
+func (server *Server) NewClient(config tdlib.Config) *tdlib.Client {
+	return tdlib.NewClientWithTransport(config, server)
+}
+
+// Handle answers every request of the given @type with the same response
+func (server *Server) Handle(requestType string, response interface{}) {
+	server.HandleFunc(requestType, func(request tdlib.UpdateData) interface{} {
+		return response
+	})
+}
+
+// HandleFunc answers every request of the given @type with the result of handler
+func (server *Server) HandleFunc(requestType string, handler HandlerFunc) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.handlers[requestType] = handler
+}
+
+// Requests returns every request received so far, in order of arrival
+func (server *Server) Requests() []tdlib.UpdateData {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	requests := make([]tdlib.UpdateData, len(server.requests))
+	copy(requests, server.requests)
+	return requests
+}
+
+// PushUpdate sends an update (e.g. tdlib.NewUpdateNewMessage(...)) to the client
+func (server *Server) PushUpdate(update interface{}) error {
+	updateData, err := toUpdateData(update)
+	if err != nil {
+		return err
+	}
+
+	// updates never carry @extra, the client would take them for a response otherwise
+	delete(updateData, "@extra")
+
+	updateBytes, err := json.Marshal(updateData)
+	if err != nil {
+		return err
+	}
+
+	server.enqueue(updateBytes)
+	return nil
+}
+
+// Send handles a request and queues its response
+func (server *Server) Send(query []byte) {
+	if response := server.handle(query); response != nil {
+		server.enqueue(response)
+	}
+}
+
+// Receive returns the next queued response or update, or nil after timeout seconds
+func (server *Server) Receive(timeout float64) []byte {
+	timer := time.NewTimer(time.Duration(timeout * float64(time.Second)))
+	defer timer.Stop()
+
+	for {
+		server.queueLock.Lock()
+		if len(server.queue) > 0 {
+			next := server.queue[0]
+			server.queue = server.queue[1:]
+			server.queueLock.Unlock()
+			return next
+		}
+		server.queueLock.Unlock()
+
+		select {
+		case <-server.signal:
+		case <-timer.C:
+			return nil
+		case <-server.destroyed:
+			return nil
+		}
+	}
+}
+
+// Execute handles a request synchronously
+func (server *Server) Execute(query []byte) []byte {
+	return server.handle(query)
+}
+
+// Destroy stops the server, pending and future Receive calls return nil
+func (server *Server) Destroy() {
+	server.destroy.Do(func() {
+		close(server.destroyed)
+	})
+}
+
+func (server *Server) enqueue(message []byte) {
+	server.queueLock.Lock()
+	server.queue = append(server.queue, message)
+	server.queueLock.Unlock()
+
+	select {
+	case server.signal <- struct{}{}:
+	default:
+	}
+}
+
+// handle runs the handler registered for the request's @type and returns the response with @extra echoed
+func (server *Server) handle(query []byte) []byte {
+	var request tdlib.UpdateData
+	decoder := json.NewDecoder(bytes.NewReader(query))
+	decoder.UseNumber()
+	if err := decoder.Decode(&request); err != nil {
+		return mustMarshal(tdlib.NewError(400, "tdlibtest: invalid request: "+err.Error()))
+	}
+
+	requestType, _ := request["@type"].(string)
+
+	server.lock.Lock()
+	server.requests = append(server.requests, request)
+	handler, found := server.handlers[requestType]
+	server.lock.Unlock()
+
+	var response interface{}
+	if found {
+		response = handler(request)
+		if response == nil {
+			return nil
+		}
+	} else {
+		response = tdlib.NewError(400, "tdlibtest: no handler for "+requestType)
+	}
+
+	responseData, err := toUpdateData(response)
+	if err != nil {
+		responseData, _ = toUpdateData(tdlib.NewError(500, "tdlibtest: invalid response: "+err.Error()))
+	}
+
+	if extra, hasExtra := request["@extra"]; hasExtra {
+		responseData["@extra"] = extra
+	} else {
+		delete(responseData, "@extra")
+	}
+
+	return mustMarshal(responseData)
+}
+
+// toUpdateData converts any JSON-serializable value into a generic JSON object keeping number precision
+func toUpdateData(value interface{}) (tdlib.UpdateData, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var updateData tdlib.UpdateData
+	decoder := json.NewDecoder(bytes.NewReader(valueBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&updateData); err != nil {
+		return nil, err
+	}
+	if updateData == nil {
+		updateData = tdlib.UpdateData{}
+	}
+
+	return updateData, nil
+}
+
+func mustMarshal(value interface{}) []byte {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return valueBytes
+}
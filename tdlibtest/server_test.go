@@ -0,0 +1,158 @@
+package tdlibtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+func TestHandle(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.Handle("getMe", &tdlib.User{ID: 42, FirstName: "Test"})
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	user, err := client.GetMe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 42 || user.FirstName != "Test" {
+		t.Errorf("GetMe returned %+v", user)
+	}
+}
+
+func TestHandleFunc(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.HandleFunc("getChat", func(request tdlib.UpdateData) interface{} {
+		// JSONInt64 values are sent as strings
+		if request["chat_id"] != "7" {
+			return tdlib.NewError(400, "CHAT_NOT_FOUND")
+		}
+		return &tdlib.Chat{ID: 7, Title: "seven"}
+	})
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	chat, err := client.GetChat(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chat.Title != "seven" {
+		t.Errorf("GetChat returned %+v", chat)
+	}
+
+	if _, err := client.GetChat(8); !errors.Is(err, tdlib.ErrBadRequest) {
+		t.Errorf("GetChat of an unknown chat returned %v", err)
+	}
+}
+
+func TestUnhandledRequest(t *testing.T) {
+	server := tdlibtest.NewServer()
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	if _, err := client.GetMe(); !errors.Is(err, tdlib.ErrBadRequest) {
+		t.Errorf("unhandled GetMe returned %v", err)
+	}
+}
+
+func TestHandlerReturningNilLeavesRequestUnanswered(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.HandleFunc("getMe", func(request tdlib.UpdateData) interface{} { return nil })
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetMeContext(ctx); !errors.Is(err, tdlib.ErrTimeout) {
+		t.Errorf("unanswered GetMe returned %v, want ErrTimeout", err)
+	}
+}
+
+func TestExtraIsEchoed(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.Handle("testCallEmpty", tdlib.NewOk())
+	defer server.Destroy()
+
+	server.Send([]byte(`{"@type":"testCallEmpty","@extra":"abc"}`))
+	var response tdlib.UpdateData
+	if err := json.Unmarshal(server.Receive(1), &response); err != nil {
+		t.Fatal(err)
+	}
+	if response["@type"] != "ok" || response["@extra"] != "abc" {
+		t.Errorf("response is %v", response)
+	}
+
+	// without @extra in the request, none is sent back
+	server.Send([]byte(`{"@type":"testCallEmpty"}`))
+	response = nil
+	if err := json.Unmarshal(server.Receive(1), &response); err != nil {
+		t.Fatal(err)
+	}
+	if _, hasExtra := response["@extra"]; hasExtra {
+		t.Errorf("response is %v", response)
+	}
+}
+
+func TestRequests(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.Handle("testCallEmpty", tdlib.NewOk())
+	defer server.Destroy()
+
+	server.Send([]byte(`{"@type":"testCallEmpty"}`))
+	server.Execute([]byte(`{"@type":"getTextEntities","text":"@test"}`))
+
+	requests := server.Requests()
+	if len(requests) != 2 || requests[0]["@type"] != "testCallEmpty" || requests[1]["@type"] != "getTextEntities" {
+		t.Errorf("Requests returned %v", requests)
+	}
+}
+
+func TestPushUpdate(t *testing.T) {
+	server := tdlibtest.NewServer()
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	receiver := client.AddEventReceiver(&tdlib.UpdateNewMessage{}, func(msg *tdlib.TdMessage) bool { return true }, 1)
+	if err := server.PushUpdate(tdlib.NewUpdateNewMessage(&tdlib.Message{ID: 5})); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-receiver.Chan:
+		if id := msg.(*tdlib.UpdateNewMessage).Message.ID; id != 5 {
+			t.Errorf("received message %d", id)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("update not received")
+	}
+}
+
+func TestDestroy(t *testing.T) {
+	server := tdlibtest.NewServer()
+
+	received := make(chan []byte)
+	go func() {
+		received <- server.Receive(10)
+	}()
+	server.Destroy()
+
+	select {
+	case message := <-received:
+		if message != nil {
+			t.Errorf("Receive returned %s", message)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Receive still blocked after Destroy")
+	}
+
+	if message := server.Receive(10); message != nil {
+		t.Errorf("Receive after Destroy returned %s", message)
+	}
+}
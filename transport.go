@@ -0,0 +1,23 @@
+package tdlib
+
+// Transport is the raw JSON channel between a Client and a TDLib instance.
+// The default transport is backed by libtdjson through cgo, any other implementation
+// (e.g. an in-process fake for tests) can be passed to NewClientWithTransport.
+type Transport interface {
+	// Send sends a JSON-serialized request to TDLib, it must not block on the response
+	Send(query []byte)
+	// Receive waits at most timeout seconds for an incoming update or request response,
+	// returns nil if nothing arrived in time
+	Receive(timeout float64) []byte
+	// Execute synchronously executes a JSON-serialized request and returns the JSON-serialized result
+	Execute(query []byte) []byte
+	// Destroy releases the TDLib instance, the transport shouldn't be used anymore afterwards
+	Destroy()
+}
+
+// stoppableTransport is a Transport whose Receive can wait for the client to stop,
+// so the receive loop blocks until a message arrives instead of polling with a timeout
+type stoppableTransport interface {
+	// receiveUntil waits for an incoming update or request response, returns nil once stop is closed
+	receiveUntil(stop <-chan struct{}) []byte
+}
@@ -0,0 +1,120 @@
+package tdlib
+
+//#cgo linux CFLAGS: -I/usr/local/include
+//#cgo darwin CFLAGS: -I/usr/local/include
+//#cgo windows CFLAGS: -IC:/src/td -IC:/src/td/build
+//#cgo linux LDFLAGS: -L/usr/local/lib -ltdjson_static -ltdjson_private -ltdclient -ltdcore -ltdapi -ltdactor -ltddb -ltdsqlite -ltdnet -ltdutils -lc++ -lssl -lcrypto -ldl -lz -lm
+//#cgo darwin LDFLAGS: -L/usr/local/lib -L/usr/local/opt/openssl/lib -ltdjson_static -ltdjson_private -ltdclient -ltdcore -ltdapi -ltdactor -ltddb -ltdsqlite -ltdnet -ltdutils -lc++ -lssl -lcrypto -ldl -lz -lm
+//#cgo windows LDFLAGS: -LC:/src/td/build/Debug -ltdjson
+//#include <stdlib.h>
+//#include <td/telegram/td_json_client.h>
+//#include <td/telegram/td_log.h>
+import "C"
+
+import (
+	"unsafe"
+)
+
+// tdJSONTransport is the default Transport, talking to libtdjson through td_json_client_* calls
+type tdJSONTransport struct {
+	client unsafe.Pointer
+}
+
+// tdJSONClient returns the td_json_client_create handle behind transport, nil if it isn't a tdJSONTransport
+func tdJSONClient(transport Transport) unsafe.Pointer {
+	if transport, ok := transport.(*tdJSONTransport); ok {
+		return transport.client
+	}
+	return nil
+}
+
+// newTdJSONTransport creates a new TDLib instance with td_json_client_create
+func newTdJSONTransport() Transport {
+	return &tdJSONTransport{client: C.td_json_client_create()}
+}
+
+// Send Sends request to the TDLib client.
+func (transport *tdJSONTransport) Send(query []byte) {
+	cQuery := C.CString(string(query))
+	defer C.free(unsafe.Pointer(cQuery))
+
+	C.td_json_client_send(transport.client, cQuery)
+}
+
+// Receive Receives incoming updates and request responses from the TDLib client.
+func (transport *tdJSONTransport) Receive(timeout float64) []byte {
+	result := C.td_json_client_receive(transport.client, C.double(timeout))
+	if result == nil {
+		return nil
+	}
+
+	return []byte(C.GoString(result))
+}
+
+// Execute Synchronously executes TDLib request.
+func (transport *tdJSONTransport) Execute(query []byte) []byte {
+	cQuery := C.CString(string(query))
+	defer C.free(unsafe.Pointer(cQuery))
+
+	result := C.td_json_client_execute(transport.client, cQuery)
+	if result == nil {
+		return nil
+	}
+
+	return []byte(C.GoString(result))
+}
+
+// Destroy Destroys the TDLib client instance.
+func (transport *tdJSONTransport) Destroy() {
+	C.td_json_client_destroy(transport.client)
+}
+
+// tdExecute synchronously executes a TDLib request that doesn't need a client instance
+func tdExecute(query []byte) []byte {
+	return (&tdJSONTransport{}).Execute(query)
+}
+
+// tdMultiplexTransport is the default MultiplexTransport, talking to libtdjson through
+// td_create_client_id, td_send, td_receive and td_execute calls
+type tdMultiplexTransport struct{}
+
+// newTdMultiplexTransport returns the libtdjson multiplexed interface, which is a process-wide singleton
+func newTdMultiplexTransport() MultiplexTransport {
+	return tdMultiplexTransport{}
+}
+
+// CreateClientID Creates a new TDLib instance and returns its identifier.
+func (tdMultiplexTransport) CreateClientID() int {
+	return int(C.td_create_client_id())
+}
+
+// Send Sends request to the TDLib instance with the given identifier.
+func (tdMultiplexTransport) Send(clientID int, query []byte) {
+	cQuery := C.CString(string(query))
+	defer C.free(unsafe.Pointer(cQuery))
+
+	C.td_send(C.int(clientID), cQuery)
+}
+
+// Receive Receives incoming updates and request responses of all TDLib instances.
+func (tdMultiplexTransport) Receive(timeout float64) []byte {
+	result := C.td_receive(C.double(timeout))
+	if result == nil {
+		return nil
+	}
+
+	return []byte(C.GoString(result))
+}
+
+// Execute Synchronously executes TDLib request.
+func (tdMultiplexTransport) Execute(query []byte) []byte {
+	cQuery := C.CString(string(query))
+	defer C.free(unsafe.Pointer(cQuery))
+
+	result := C.td_execute(cQuery)
+	if result == nil {
+		return nil
+	}
+
+	return []byte(C.GoString(result))
+}
@@ -0,0 +1,27 @@
+//go:build !cgo
+
+package tdlib
+
+import "unsafe"
+
+// newTdJSONTransport panics, libtdjson can't be linked without cgo.
+// Use NewClientWithTransport to provide a Transport instead.
+func newTdJSONTransport() Transport {
+	panic("tdlib: built without cgo, libtdjson is not available; use NewClientWithTransport")
+}
+
+// newTdMultiplexTransport panics, libtdjson can't be linked without cgo.
+// Use NewClientManagerWithTransport to provide a MultiplexTransport instead.
+func newTdMultiplexTransport() MultiplexTransport {
+	panic("tdlib: built without cgo, libtdjson is not available; use NewClientManagerWithTransport")
+}
+
+// tdExecute answers every request with an error, libtdjson can't be linked without cgo
+func tdExecute(query []byte) []byte {
+	return []byte(`{"@type":"error","code":500,"message":"tdlib: built without cgo, libtdjson is not available"}`)
+}
+
+// tdJSONClient returns nil, there is no td_json_client_create handle without cgo
+func tdJSONClient(transport Transport) unsafe.Pointer {
+	return nil
+}
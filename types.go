@@ -2,7 +2,6 @@ package tdlib
 
 import (
 	"encoding/json"
-	"fmt"
 	"strconv"
 	"strings"
 )
@@ -12,18 +11,21 @@ type tdCommon struct {
 	Extra string `json:"@extra"`
 }
 
-// JSONInt64 alias for int64, in order to deal with json big number problem
+// JSONInt64 alias for int64, in order to deal with json big number problem.
+// Every 64-bit integer is a JSONInt64, it is encoded as a string like TDLib does and decoded from a string or a number.
 type JSONInt64 int64
 
-// MarshalJSON marshals to json
-func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
-	intStr := strconv.FormatInt(int64(*jsonInt), 10)
-	return []byte(intStr), nil
+// MarshalJSON marshals to json, as a string so that values above 2^53 don't lose precision
+func (jsonInt JSONInt64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatInt(int64(jsonInt), 10))), nil
 }
 
-// UnmarshalJSON unmarshals from json
+// UnmarshalJSON unmarshals from json, either a string or a number
 func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
 	intStr := string(b)
+	if intStr == "null" {
+		return nil
+	}
 	intStr = strings.Replace(intStr, "\"", "", 2)
 	jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
 	if err != nil {
@@ -1691,6 +1693,13 @@ func (error *Error) MessageType() string {
 	return "error"
 }
 
+// MarshalJSON marshals to json, with @type set even if Error wasn't created by NewError
+func (error Error) MarshalJSON() ([]byte, error) {
+	type alias Error
+	error.tdCommon.Type = "error"
+	return json.Marshal(alias(error))
+}
+
 // NewError creates a new Error
 //
 // @param code Error code; subject to future changes. If the error code is 406, the error message must not be processed in any way and must not be displayed to the user
@@ -1715,6 +1724,13 @@ func (ok *Ok) MessageType() string {
 	return "ok"
 }
 
+// MarshalJSON marshals to json, with @type set even if Ok wasn't created by NewOk
+func (ok Ok) MarshalJSON() ([]byte, error) {
+	type alias Ok
+	ok.tdCommon.Type = "ok"
+	return json.Marshal(alias(ok))
+}
+
 // NewOk creates a new Ok
 //
 func NewOk() *Ok {
@@ -1750,6 +1766,13 @@ func (tdlibParameters *TdlibParameters) MessageType() string {
 	return "tdlibParameters"
 }
 
+// MarshalJSON marshals to json, with @type set even if TdlibParameters wasn't created by NewTdlibParameters
+func (tdlibParameters TdlibParameters) MarshalJSON() ([]byte, error) {
+	type alias TdlibParameters
+	tdlibParameters.tdCommon.Type = "tdlibParameters"
+	return json.Marshal(alias(tdlibParameters))
+}
+
 // NewTdlibParameters creates a new TdlibParameters
 //
 // @param useTestDc If set to true, the Telegram test environment will be used instead of the production environment
@@ -1801,6 +1824,13 @@ func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessa
 	return "authenticationCodeTypeTelegramMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthenticationCodeTypeTelegramMessage wasn't created by NewAuthenticationCodeTypeTelegramMessage
+func (authenticationCodeTypeTelegramMessage AuthenticationCodeTypeTelegramMessage) MarshalJSON() ([]byte, error) {
+	type alias AuthenticationCodeTypeTelegramMessage
+	authenticationCodeTypeTelegramMessage.tdCommon.Type = "authenticationCodeTypeTelegramMessage"
+	return json.Marshal(alias(authenticationCodeTypeTelegramMessage))
+}
+
 // NewAuthenticationCodeTypeTelegramMessage creates a new AuthenticationCodeTypeTelegramMessage
 //
 // @param length Length of the code
@@ -1829,6 +1859,13 @@ func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) MessageType() string
 	return "authenticationCodeTypeSms"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthenticationCodeTypeSms wasn't created by NewAuthenticationCodeTypeSms
+func (authenticationCodeTypeSms AuthenticationCodeTypeSms) MarshalJSON() ([]byte, error) {
+	type alias AuthenticationCodeTypeSms
+	authenticationCodeTypeSms.tdCommon.Type = "authenticationCodeTypeSms"
+	return json.Marshal(alias(authenticationCodeTypeSms))
+}
+
 // NewAuthenticationCodeTypeSms creates a new AuthenticationCodeTypeSms
 //
 // @param length Length of the code
@@ -1857,6 +1894,13 @@ func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) MessageType() stri
 	return "authenticationCodeTypeCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthenticationCodeTypeCall wasn't created by NewAuthenticationCodeTypeCall
+func (authenticationCodeTypeCall AuthenticationCodeTypeCall) MarshalJSON() ([]byte, error) {
+	type alias AuthenticationCodeTypeCall
+	authenticationCodeTypeCall.tdCommon.Type = "authenticationCodeTypeCall"
+	return json.Marshal(alias(authenticationCodeTypeCall))
+}
+
 // NewAuthenticationCodeTypeCall creates a new AuthenticationCodeTypeCall
 //
 // @param length Length of the code
@@ -1885,6 +1929,13 @@ func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) MessageT
 	return "authenticationCodeTypeFlashCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthenticationCodeTypeFlashCall wasn't created by NewAuthenticationCodeTypeFlashCall
+func (authenticationCodeTypeFlashCall AuthenticationCodeTypeFlashCall) MarshalJSON() ([]byte, error) {
+	type alias AuthenticationCodeTypeFlashCall
+	authenticationCodeTypeFlashCall.tdCommon.Type = "authenticationCodeTypeFlashCall"
+	return json.Marshal(alias(authenticationCodeTypeFlashCall))
+}
+
 // NewAuthenticationCodeTypeFlashCall creates a new AuthenticationCodeTypeFlashCall
 //
 // @param pattern Pattern of the phone number from which the call will be made
@@ -1916,6 +1967,13 @@ func (authenticationCodeInfo *AuthenticationCodeInfo) MessageType() string {
 	return "authenticationCodeInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthenticationCodeInfo wasn't created by NewAuthenticationCodeInfo
+func (authenticationCodeInfo AuthenticationCodeInfo) MarshalJSON() ([]byte, error) {
+	type alias AuthenticationCodeInfo
+	authenticationCodeInfo.tdCommon.Type = "authenticationCodeInfo"
+	return json.Marshal(alias(authenticationCodeInfo))
+}
+
 // NewAuthenticationCodeInfo creates a new AuthenticationCodeInfo
 //
 // @param phoneNumber A phone number that is being authenticated
@@ -1976,6 +2034,13 @@ func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) Me
 	return "emailAddressAuthenticationCodeInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if EmailAddressAuthenticationCodeInfo wasn't created by NewEmailAddressAuthenticationCodeInfo
+func (emailAddressAuthenticationCodeInfo EmailAddressAuthenticationCodeInfo) MarshalJSON() ([]byte, error) {
+	type alias EmailAddressAuthenticationCodeInfo
+	emailAddressAuthenticationCodeInfo.tdCommon.Type = "emailAddressAuthenticationCodeInfo"
+	return json.Marshal(alias(emailAddressAuthenticationCodeInfo))
+}
+
 // NewEmailAddressAuthenticationCodeInfo creates a new EmailAddressAuthenticationCodeInfo
 //
 // @param emailAddressPattern Pattern of the email address to which an authentication code was sent
@@ -2003,6 +2068,13 @@ func (textEntity *TextEntity) MessageType() string {
 	return "textEntity"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntity wasn't created by NewTextEntity
+func (textEntity TextEntity) MarshalJSON() ([]byte, error) {
+	type alias TextEntity
+	textEntity.tdCommon.Type = "textEntity"
+	return json.Marshal(alias(textEntity))
+}
+
 // NewTextEntity creates a new TextEntity
 //
 // @param offset Offset of the entity, in UTF-16 code units
@@ -2058,6 +2130,13 @@ func (textEntities *TextEntities) MessageType() string {
 	return "textEntities"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntities wasn't created by NewTextEntities
+func (textEntities TextEntities) MarshalJSON() ([]byte, error) {
+	type alias TextEntities
+	textEntities.tdCommon.Type = "textEntities"
+	return json.Marshal(alias(textEntities))
+}
+
 // NewTextEntities creates a new TextEntities
 //
 // @param entities List of text entities
@@ -2082,6 +2161,13 @@ func (formattedText *FormattedText) MessageType() string {
 	return "formattedText"
 }
 
+// MarshalJSON marshals to json, with @type set even if FormattedText wasn't created by NewFormattedText
+func (formattedText FormattedText) MarshalJSON() ([]byte, error) {
+	type alias FormattedText
+	formattedText.tdCommon.Type = "formattedText"
+	return json.Marshal(alias(formattedText))
+}
+
 // NewFormattedText creates a new FormattedText
 //
 // @param text The text
@@ -2109,6 +2195,13 @@ func (termsOfService *TermsOfService) MessageType() string {
 	return "termsOfService"
 }
 
+// MarshalJSON marshals to json, with @type set even if TermsOfService wasn't created by NewTermsOfService
+func (termsOfService TermsOfService) MarshalJSON() ([]byte, error) {
+	type alias TermsOfService
+	termsOfService.tdCommon.Type = "termsOfService"
+	return json.Marshal(alias(termsOfService))
+}
+
 // NewTermsOfService creates a new TermsOfService
 //
 // @param text Text of the terms of service
@@ -2135,6 +2228,13 @@ func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParamete
 	return "authorizationStateWaitTdlibParameters"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitTdlibParameters wasn't created by NewAuthorizationStateWaitTdlibParameters
+func (authorizationStateWaitTdlibParameters AuthorizationStateWaitTdlibParameters) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitTdlibParameters
+	authorizationStateWaitTdlibParameters.tdCommon.Type = "authorizationStateWaitTdlibParameters"
+	return json.Marshal(alias(authorizationStateWaitTdlibParameters))
+}
+
 // NewAuthorizationStateWaitTdlibParameters creates a new AuthorizationStateWaitTdlibParameters
 //
 func NewAuthorizationStateWaitTdlibParameters() *AuthorizationStateWaitTdlibParameters {
@@ -2161,6 +2261,13 @@ func (authorizationStateWaitEncryptionKey *AuthorizationStateWaitEncryptionKey)
 	return "authorizationStateWaitEncryptionKey"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitEncryptionKey wasn't created by NewAuthorizationStateWaitEncryptionKey
+func (authorizationStateWaitEncryptionKey AuthorizationStateWaitEncryptionKey) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitEncryptionKey
+	authorizationStateWaitEncryptionKey.tdCommon.Type = "authorizationStateWaitEncryptionKey"
+	return json.Marshal(alias(authorizationStateWaitEncryptionKey))
+}
+
 // NewAuthorizationStateWaitEncryptionKey creates a new AuthorizationStateWaitEncryptionKey
 //
 // @param isEncrypted True, if the database is currently encrypted
@@ -2188,6 +2295,13 @@ func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) Mess
 	return "authorizationStateWaitPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitPhoneNumber wasn't created by NewAuthorizationStateWaitPhoneNumber
+func (authorizationStateWaitPhoneNumber AuthorizationStateWaitPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitPhoneNumber
+	authorizationStateWaitPhoneNumber.tdCommon.Type = "authorizationStateWaitPhoneNumber"
+	return json.Marshal(alias(authorizationStateWaitPhoneNumber))
+}
+
 // NewAuthorizationStateWaitPhoneNumber creates a new AuthorizationStateWaitPhoneNumber
 //
 func NewAuthorizationStateWaitPhoneNumber() *AuthorizationStateWaitPhoneNumber {
@@ -2214,6 +2328,13 @@ func (authorizationStateWaitCode *AuthorizationStateWaitCode) MessageType() stri
 	return "authorizationStateWaitCode"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitCode wasn't created by NewAuthorizationStateWaitCode
+func (authorizationStateWaitCode AuthorizationStateWaitCode) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitCode
+	authorizationStateWaitCode.tdCommon.Type = "authorizationStateWaitCode"
+	return json.Marshal(alias(authorizationStateWaitCode))
+}
+
 // NewAuthorizationStateWaitCode creates a new AuthorizationStateWaitCode
 //
 // @param codeInfo Information about the authorization code that was sent
@@ -2242,6 +2363,13 @@ func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOther
 	return "authorizationStateWaitOtherDeviceConfirmation"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitOtherDeviceConfirmation wasn't created by NewAuthorizationStateWaitOtherDeviceConfirmation
+func (authorizationStateWaitOtherDeviceConfirmation AuthorizationStateWaitOtherDeviceConfirmation) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitOtherDeviceConfirmation
+	authorizationStateWaitOtherDeviceConfirmation.tdCommon.Type = "authorizationStateWaitOtherDeviceConfirmation"
+	return json.Marshal(alias(authorizationStateWaitOtherDeviceConfirmation))
+}
+
 // NewAuthorizationStateWaitOtherDeviceConfirmation creates a new AuthorizationStateWaitOtherDeviceConfirmation
 //
 // @param link A tg:// URL for the QR code. The link will be updated frequently
@@ -2270,6 +2398,13 @@ func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) Me
 	return "authorizationStateWaitRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitRegistration wasn't created by NewAuthorizationStateWaitRegistration
+func (authorizationStateWaitRegistration AuthorizationStateWaitRegistration) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitRegistration
+	authorizationStateWaitRegistration.tdCommon.Type = "authorizationStateWaitRegistration"
+	return json.Marshal(alias(authorizationStateWaitRegistration))
+}
+
 // NewAuthorizationStateWaitRegistration creates a new AuthorizationStateWaitRegistration
 //
 // @param termsOfService Telegram terms of service
@@ -2300,6 +2435,13 @@ func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) MessageTyp
 	return "authorizationStateWaitPassword"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateWaitPassword wasn't created by NewAuthorizationStateWaitPassword
+func (authorizationStateWaitPassword AuthorizationStateWaitPassword) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateWaitPassword
+	authorizationStateWaitPassword.tdCommon.Type = "authorizationStateWaitPassword"
+	return json.Marshal(alias(authorizationStateWaitPassword))
+}
+
 // NewAuthorizationStateWaitPassword creates a new AuthorizationStateWaitPassword
 //
 // @param passwordHint Hint for the password; may be empty
@@ -2331,6 +2473,13 @@ func (authorizationStateReady *AuthorizationStateReady) MessageType() string {
 	return "authorizationStateReady"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateReady wasn't created by NewAuthorizationStateReady
+func (authorizationStateReady AuthorizationStateReady) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateReady
+	authorizationStateReady.tdCommon.Type = "authorizationStateReady"
+	return json.Marshal(alias(authorizationStateReady))
+}
+
 // NewAuthorizationStateReady creates a new AuthorizationStateReady
 //
 func NewAuthorizationStateReady() *AuthorizationStateReady {
@@ -2356,6 +2505,13 @@ func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) MessageType()
 	return "authorizationStateLoggingOut"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateLoggingOut wasn't created by NewAuthorizationStateLoggingOut
+func (authorizationStateLoggingOut AuthorizationStateLoggingOut) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateLoggingOut
+	authorizationStateLoggingOut.tdCommon.Type = "authorizationStateLoggingOut"
+	return json.Marshal(alias(authorizationStateLoggingOut))
+}
+
 // NewAuthorizationStateLoggingOut creates a new AuthorizationStateLoggingOut
 //
 func NewAuthorizationStateLoggingOut() *AuthorizationStateLoggingOut {
@@ -2381,6 +2537,13 @@ func (authorizationStateClosing *AuthorizationStateClosing) MessageType() string
 	return "authorizationStateClosing"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateClosing wasn't created by NewAuthorizationStateClosing
+func (authorizationStateClosing AuthorizationStateClosing) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateClosing
+	authorizationStateClosing.tdCommon.Type = "authorizationStateClosing"
+	return json.Marshal(alias(authorizationStateClosing))
+}
+
 // NewAuthorizationStateClosing creates a new AuthorizationStateClosing
 //
 func NewAuthorizationStateClosing() *AuthorizationStateClosing {
@@ -2406,6 +2569,13 @@ func (authorizationStateClosed *AuthorizationStateClosed) MessageType() string {
 	return "authorizationStateClosed"
 }
 
+// MarshalJSON marshals to json, with @type set even if AuthorizationStateClosed wasn't created by NewAuthorizationStateClosed
+func (authorizationStateClosed AuthorizationStateClosed) MarshalJSON() ([]byte, error) {
+	type alias AuthorizationStateClosed
+	authorizationStateClosed.tdCommon.Type = "authorizationStateClosed"
+	return json.Marshal(alias(authorizationStateClosed))
+}
+
 // NewAuthorizationStateClosed creates a new AuthorizationStateClosed
 //
 func NewAuthorizationStateClosed() *AuthorizationStateClosed {
@@ -2436,6 +2606,13 @@ func (passwordState *PasswordState) MessageType() string {
 	return "passwordState"
 }
 
+// MarshalJSON marshals to json, with @type set even if PasswordState wasn't created by NewPasswordState
+func (passwordState PasswordState) MarshalJSON() ([]byte, error) {
+	type alias PasswordState
+	passwordState.tdCommon.Type = "passwordState"
+	return json.Marshal(alias(passwordState))
+}
+
 // NewPasswordState creates a new PasswordState
 //
 // @param hasPassword True, if a 2-step verification password is set
@@ -2467,6 +2644,13 @@ func (recoveryEmailAddress *RecoveryEmailAddress) MessageType() string {
 	return "recoveryEmailAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if RecoveryEmailAddress wasn't created by NewRecoveryEmailAddress
+func (recoveryEmailAddress RecoveryEmailAddress) MarshalJSON() ([]byte, error) {
+	type alias RecoveryEmailAddress
+	recoveryEmailAddress.tdCommon.Type = "recoveryEmailAddress"
+	return json.Marshal(alias(recoveryEmailAddress))
+}
+
 // NewRecoveryEmailAddress creates a new RecoveryEmailAddress
 //
 // @param recoveryEmailAddress Recovery email address
@@ -2491,6 +2675,13 @@ func (temporaryPasswordState *TemporaryPasswordState) MessageType() string {
 	return "temporaryPasswordState"
 }
 
+// MarshalJSON marshals to json, with @type set even if TemporaryPasswordState wasn't created by NewTemporaryPasswordState
+func (temporaryPasswordState TemporaryPasswordState) MarshalJSON() ([]byte, error) {
+	type alias TemporaryPasswordState
+	temporaryPasswordState.tdCommon.Type = "temporaryPasswordState"
+	return json.Marshal(alias(temporaryPasswordState))
+}
+
 // NewTemporaryPasswordState creates a new TemporaryPasswordState
 //
 // @param hasPassword True, if a temporary password is available
@@ -2523,6 +2714,13 @@ func (localFile *LocalFile) MessageType() string {
 	return "localFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if LocalFile wasn't created by NewLocalFile
+func (localFile LocalFile) MarshalJSON() ([]byte, error) {
+	type alias LocalFile
+	localFile.tdCommon.Type = "localFile"
+	return json.Marshal(alias(localFile))
+}
+
 // NewLocalFile creates a new LocalFile
 //
 // @param path Local path to the locally available file part; may be empty
@@ -2564,6 +2762,13 @@ func (remoteFile *RemoteFile) MessageType() string {
 	return "remoteFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if RemoteFile wasn't created by NewRemoteFile
+func (remoteFile RemoteFile) MarshalJSON() ([]byte, error) {
+	type alias RemoteFile
+	remoteFile.tdCommon.Type = "remoteFile"
+	return json.Marshal(alias(remoteFile))
+}
+
 // NewRemoteFile creates a new RemoteFile
 //
 // @param iD Remote file identifier; may be empty. Can be used by the current user across application restarts or even from other devices. Uniquely identifies a file, but a file can have a lot of different valid identifiers.
@@ -2599,6 +2804,13 @@ func (file *File) MessageType() string {
 	return "file"
 }
 
+// MarshalJSON marshals to json, with @type set even if File wasn't created by NewFile
+func (file File) MarshalJSON() ([]byte, error) {
+	type alias File
+	file.tdCommon.Type = "file"
+	return json.Marshal(alias(file))
+}
+
 // NewFile creates a new File
 //
 // @param iD Unique file identifier
@@ -2630,6 +2842,13 @@ func (inputFileID *InputFileID) MessageType() string {
 	return "inputFileId"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputFileID wasn't created by NewInputFileID
+func (inputFileID InputFileID) MarshalJSON() ([]byte, error) {
+	type alias InputFileID
+	inputFileID.tdCommon.Type = "inputFileId"
+	return json.Marshal(alias(inputFileID))
+}
+
 // NewInputFileID creates a new InputFileID
 //
 // @param iD Unique file identifier
@@ -2658,6 +2877,13 @@ func (inputFileRemote *InputFileRemote) MessageType() string {
 	return "inputFileRemote"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputFileRemote wasn't created by NewInputFileRemote
+func (inputFileRemote InputFileRemote) MarshalJSON() ([]byte, error) {
+	type alias InputFileRemote
+	inputFileRemote.tdCommon.Type = "inputFileRemote"
+	return json.Marshal(alias(inputFileRemote))
+}
+
 // NewInputFileRemote creates a new InputFileRemote
 //
 // @param iD Remote file identifier
@@ -2686,6 +2912,13 @@ func (inputFileLocal *InputFileLocal) MessageType() string {
 	return "inputFileLocal"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputFileLocal wasn't created by NewInputFileLocal
+func (inputFileLocal InputFileLocal) MarshalJSON() ([]byte, error) {
+	type alias InputFileLocal
+	inputFileLocal.tdCommon.Type = "inputFileLocal"
+	return json.Marshal(alias(inputFileLocal))
+}
+
 // NewInputFileLocal creates a new InputFileLocal
 //
 // @param path Local path to the file
@@ -2716,6 +2949,13 @@ func (inputFileGenerated *InputFileGenerated) MessageType() string {
 	return "inputFileGenerated"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputFileGenerated wasn't created by NewInputFileGenerated
+func (inputFileGenerated InputFileGenerated) MarshalJSON() ([]byte, error) {
+	type alias InputFileGenerated
+	inputFileGenerated.tdCommon.Type = "inputFileGenerated"
+	return json.Marshal(alias(inputFileGenerated))
+}
+
 // NewInputFileGenerated creates a new InputFileGenerated
 //
 // @param originalPath Local path to a file from which the file is generated; may be empty if there is no such file
@@ -2752,6 +2992,13 @@ func (photoSize *PhotoSize) MessageType() string {
 	return "photoSize"
 }
 
+// MarshalJSON marshals to json, with @type set even if PhotoSize wasn't created by NewPhotoSize
+func (photoSize PhotoSize) MarshalJSON() ([]byte, error) {
+	type alias PhotoSize
+	photoSize.tdCommon.Type = "photoSize"
+	return json.Marshal(alias(photoSize))
+}
+
 // NewPhotoSize creates a new PhotoSize
 //
 // @param typeParam Image type (see https://core.telegram.org/constructor/photoSize)
@@ -2785,6 +3032,13 @@ func (minithumbnail *Minithumbnail) MessageType() string {
 	return "minithumbnail"
 }
 
+// MarshalJSON marshals to json, with @type set even if Minithumbnail wasn't created by NewMinithumbnail
+func (minithumbnail Minithumbnail) MarshalJSON() ([]byte, error) {
+	type alias Minithumbnail
+	minithumbnail.tdCommon.Type = "minithumbnail"
+	return json.Marshal(alias(minithumbnail))
+}
+
 // NewMinithumbnail creates a new Minithumbnail
 //
 // @param width Thumbnail width, usually doesn't exceed 40
@@ -2811,6 +3065,13 @@ func (thumbnailFormatJpeg *ThumbnailFormatJpeg) MessageType() string {
 	return "thumbnailFormatJpeg"
 }
 
+// MarshalJSON marshals to json, with @type set even if ThumbnailFormatJpeg wasn't created by NewThumbnailFormatJpeg
+func (thumbnailFormatJpeg ThumbnailFormatJpeg) MarshalJSON() ([]byte, error) {
+	type alias ThumbnailFormatJpeg
+	thumbnailFormatJpeg.tdCommon.Type = "thumbnailFormatJpeg"
+	return json.Marshal(alias(thumbnailFormatJpeg))
+}
+
 // NewThumbnailFormatJpeg creates a new ThumbnailFormatJpeg
 //
 func NewThumbnailFormatJpeg() *ThumbnailFormatJpeg {
@@ -2836,6 +3097,13 @@ func (thumbnailFormatPng *ThumbnailFormatPng) MessageType() string {
 	return "thumbnailFormatPng"
 }
 
+// MarshalJSON marshals to json, with @type set even if ThumbnailFormatPng wasn't created by NewThumbnailFormatPng
+func (thumbnailFormatPng ThumbnailFormatPng) MarshalJSON() ([]byte, error) {
+	type alias ThumbnailFormatPng
+	thumbnailFormatPng.tdCommon.Type = "thumbnailFormatPng"
+	return json.Marshal(alias(thumbnailFormatPng))
+}
+
 // NewThumbnailFormatPng creates a new ThumbnailFormatPng
 //
 func NewThumbnailFormatPng() *ThumbnailFormatPng {
@@ -2861,6 +3129,13 @@ func (thumbnailFormatWebp *ThumbnailFormatWebp) MessageType() string {
 	return "thumbnailFormatWebp"
 }
 
+// MarshalJSON marshals to json, with @type set even if ThumbnailFormatWebp wasn't created by NewThumbnailFormatWebp
+func (thumbnailFormatWebp ThumbnailFormatWebp) MarshalJSON() ([]byte, error) {
+	type alias ThumbnailFormatWebp
+	thumbnailFormatWebp.tdCommon.Type = "thumbnailFormatWebp"
+	return json.Marshal(alias(thumbnailFormatWebp))
+}
+
 // NewThumbnailFormatWebp creates a new ThumbnailFormatWebp
 //
 func NewThumbnailFormatWebp() *ThumbnailFormatWebp {
@@ -2886,6 +3161,13 @@ func (thumbnailFormatGif *ThumbnailFormatGif) MessageType() string {
 	return "thumbnailFormatGif"
 }
 
+// MarshalJSON marshals to json, with @type set even if ThumbnailFormatGif wasn't created by NewThumbnailFormatGif
+func (thumbnailFormatGif ThumbnailFormatGif) MarshalJSON() ([]byte, error) {
+	type alias ThumbnailFormatGif
+	thumbnailFormatGif.tdCommon.Type = "thumbnailFormatGif"
+	return json.Marshal(alias(thumbnailFormatGif))
+}
+
 // NewThumbnailFormatGif creates a new ThumbnailFormatGif
 //
 func NewThumbnailFormatGif() *ThumbnailFormatGif {
@@ -2911,6 +3193,13 @@ func (thumbnailFormatTgs *ThumbnailFormatTgs) MessageType() string {
 	return "thumbnailFormatTgs"
 }
 
+// MarshalJSON marshals to json, with @type set even if ThumbnailFormatTgs wasn't created by NewThumbnailFormatTgs
+func (thumbnailFormatTgs ThumbnailFormatTgs) MarshalJSON() ([]byte, error) {
+	type alias ThumbnailFormatTgs
+	thumbnailFormatTgs.tdCommon.Type = "thumbnailFormatTgs"
+	return json.Marshal(alias(thumbnailFormatTgs))
+}
+
 // NewThumbnailFormatTgs creates a new ThumbnailFormatTgs
 //
 func NewThumbnailFormatTgs() *ThumbnailFormatTgs {
@@ -2936,6 +3225,13 @@ func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) MessageType() string {
 	return "thumbnailFormatMpeg4"
 }
 
+// MarshalJSON marshals to json, with @type set even if ThumbnailFormatMpeg4 wasn't created by NewThumbnailFormatMpeg4
+func (thumbnailFormatMpeg4 ThumbnailFormatMpeg4) MarshalJSON() ([]byte, error) {
+	type alias ThumbnailFormatMpeg4
+	thumbnailFormatMpeg4.tdCommon.Type = "thumbnailFormatMpeg4"
+	return json.Marshal(alias(thumbnailFormatMpeg4))
+}
+
 // NewThumbnailFormatMpeg4 creates a new ThumbnailFormatMpeg4
 //
 func NewThumbnailFormatMpeg4() *ThumbnailFormatMpeg4 {
@@ -2965,6 +3261,13 @@ func (thumbnail *Thumbnail) MessageType() string {
 	return "thumbnail"
 }
 
+// MarshalJSON marshals to json, with @type set even if Thumbnail wasn't created by NewThumbnail
+func (thumbnail Thumbnail) MarshalJSON() ([]byte, error) {
+	type alias Thumbnail
+	thumbnail.tdCommon.Type = "thumbnail"
+	return json.Marshal(alias(thumbnail))
+}
+
 // NewThumbnail creates a new Thumbnail
 //
 // @param format Thumbnail format
@@ -3022,6 +3325,13 @@ func (maskPointForehead *MaskPointForehead) MessageType() string {
 	return "maskPointForehead"
 }
 
+// MarshalJSON marshals to json, with @type set even if MaskPointForehead wasn't created by NewMaskPointForehead
+func (maskPointForehead MaskPointForehead) MarshalJSON() ([]byte, error) {
+	type alias MaskPointForehead
+	maskPointForehead.tdCommon.Type = "maskPointForehead"
+	return json.Marshal(alias(maskPointForehead))
+}
+
 // NewMaskPointForehead creates a new MaskPointForehead
 //
 func NewMaskPointForehead() *MaskPointForehead {
@@ -3047,6 +3357,13 @@ func (maskPointEyes *MaskPointEyes) MessageType() string {
 	return "maskPointEyes"
 }
 
+// MarshalJSON marshals to json, with @type set even if MaskPointEyes wasn't created by NewMaskPointEyes
+func (maskPointEyes MaskPointEyes) MarshalJSON() ([]byte, error) {
+	type alias MaskPointEyes
+	maskPointEyes.tdCommon.Type = "maskPointEyes"
+	return json.Marshal(alias(maskPointEyes))
+}
+
 // NewMaskPointEyes creates a new MaskPointEyes
 //
 func NewMaskPointEyes() *MaskPointEyes {
@@ -3072,6 +3389,13 @@ func (maskPointMouth *MaskPointMouth) MessageType() string {
 	return "maskPointMouth"
 }
 
+// MarshalJSON marshals to json, with @type set even if MaskPointMouth wasn't created by NewMaskPointMouth
+func (maskPointMouth MaskPointMouth) MarshalJSON() ([]byte, error) {
+	type alias MaskPointMouth
+	maskPointMouth.tdCommon.Type = "maskPointMouth"
+	return json.Marshal(alias(maskPointMouth))
+}
+
 // NewMaskPointMouth creates a new MaskPointMouth
 //
 func NewMaskPointMouth() *MaskPointMouth {
@@ -3097,6 +3421,13 @@ func (maskPointChin *MaskPointChin) MessageType() string {
 	return "maskPointChin"
 }
 
+// MarshalJSON marshals to json, with @type set even if MaskPointChin wasn't created by NewMaskPointChin
+func (maskPointChin MaskPointChin) MarshalJSON() ([]byte, error) {
+	type alias MaskPointChin
+	maskPointChin.tdCommon.Type = "maskPointChin"
+	return json.Marshal(alias(maskPointChin))
+}
+
 // NewMaskPointChin creates a new MaskPointChin
 //
 func NewMaskPointChin() *MaskPointChin {
@@ -3126,6 +3457,13 @@ func (maskPosition *MaskPosition) MessageType() string {
 	return "maskPosition"
 }
 
+// MarshalJSON marshals to json, with @type set even if MaskPosition wasn't created by NewMaskPosition
+func (maskPosition MaskPosition) MarshalJSON() ([]byte, error) {
+	type alias MaskPosition
+	maskPosition.tdCommon.Type = "maskPosition"
+	return json.Marshal(alias(maskPosition))
+}
+
 // NewMaskPosition creates a new MaskPosition
 //
 // @param point Part of the face, relative to which the mask should be placed
@@ -3184,6 +3522,13 @@ func (closedVectorPath *ClosedVectorPath) MessageType() string {
 	return "closedVectorPath"
 }
 
+// MarshalJSON marshals to json, with @type set even if ClosedVectorPath wasn't created by NewClosedVectorPath
+func (closedVectorPath ClosedVectorPath) MarshalJSON() ([]byte, error) {
+	type alias ClosedVectorPath
+	closedVectorPath.tdCommon.Type = "closedVectorPath"
+	return json.Marshal(alias(closedVectorPath))
+}
+
 // NewClosedVectorPath creates a new ClosedVectorPath
 //
 // @param commands List of vector path commands
@@ -3211,6 +3556,13 @@ func (pollOption *PollOption) MessageType() string {
 	return "pollOption"
 }
 
+// MarshalJSON marshals to json, with @type set even if PollOption wasn't created by NewPollOption
+func (pollOption PollOption) MarshalJSON() ([]byte, error) {
+	type alias PollOption
+	pollOption.tdCommon.Type = "pollOption"
+	return json.Marshal(alias(pollOption))
+}
+
 // NewPollOption creates a new PollOption
 //
 // @param text Option text; 1-100 characters
@@ -3242,6 +3594,13 @@ func (pollTypeRegular *PollTypeRegular) MessageType() string {
 	return "pollTypeRegular"
 }
 
+// MarshalJSON marshals to json, with @type set even if PollTypeRegular wasn't created by NewPollTypeRegular
+func (pollTypeRegular PollTypeRegular) MarshalJSON() ([]byte, error) {
+	type alias PollTypeRegular
+	pollTypeRegular.tdCommon.Type = "pollTypeRegular"
+	return json.Marshal(alias(pollTypeRegular))
+}
+
 // NewPollTypeRegular creates a new PollTypeRegular
 //
 // @param allowMultipleAnswers True, if multiple answer options can be chosen simultaneously
@@ -3271,6 +3630,13 @@ func (pollTypeQuiz *PollTypeQuiz) MessageType() string {
 	return "pollTypeQuiz"
 }
 
+// MarshalJSON marshals to json, with @type set even if PollTypeQuiz wasn't created by NewPollTypeQuiz
+func (pollTypeQuiz PollTypeQuiz) MarshalJSON() ([]byte, error) {
+	type alias PollTypeQuiz
+	pollTypeQuiz.tdCommon.Type = "pollTypeQuiz"
+	return json.Marshal(alias(pollTypeQuiz))
+}
+
 // NewPollTypeQuiz creates a new PollTypeQuiz
 //
 // @param correctOptionID 0-based identifier of the correct answer option; -1 for a yet unanswered poll
@@ -3309,6 +3675,13 @@ func (animation *Animation) MessageType() string {
 	return "animation"
 }
 
+// MarshalJSON marshals to json, with @type set even if Animation wasn't created by NewAnimation
+func (animation Animation) MarshalJSON() ([]byte, error) {
+	type alias Animation
+	animation.tdCommon.Type = "animation"
+	return json.Marshal(alias(animation))
+}
+
 // NewAnimation creates a new Animation
 //
 // @param duration Duration of the animation, in seconds; as defined by the sender
@@ -3355,6 +3728,13 @@ func (audio *Audio) MessageType() string {
 	return "audio"
 }
 
+// MarshalJSON marshals to json, with @type set even if Audio wasn't created by NewAudio
+func (audio Audio) MarshalJSON() ([]byte, error) {
+	type alias Audio
+	audio.tdCommon.Type = "audio"
+	return json.Marshal(alias(audio))
+}
+
 // NewAudio creates a new Audio
 //
 // @param duration Duration of the audio, in seconds; as defined by the sender
@@ -3396,6 +3776,13 @@ func (document *Document) MessageType() string {
 	return "document"
 }
 
+// MarshalJSON marshals to json, with @type set even if Document wasn't created by NewDocument
+func (document Document) MarshalJSON() ([]byte, error) {
+	type alias Document
+	document.tdCommon.Type = "document"
+	return json.Marshal(alias(document))
+}
+
 // NewDocument creates a new Document
 //
 // @param fileName Original name of the file; as defined by the sender
@@ -3429,6 +3816,13 @@ func (photo *Photo) MessageType() string {
 	return "photo"
 }
 
+// MarshalJSON marshals to json, with @type set even if Photo wasn't created by NewPhoto
+func (photo Photo) MarshalJSON() ([]byte, error) {
+	type alias Photo
+	photo.tdCommon.Type = "photo"
+	return json.Marshal(alias(photo))
+}
+
 // NewPhoto creates a new Photo
 //
 // @param hasStickers True, if stickers were added to the photo. The list of corresponding sticker sets can be received using getAttachedStickerSets
@@ -3465,6 +3859,13 @@ func (sticker *Sticker) MessageType() string {
 	return "sticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if Sticker wasn't created by NewSticker
+func (sticker Sticker) MarshalJSON() ([]byte, error) {
+	type alias Sticker
+	sticker.tdCommon.Type = "sticker"
+	return json.Marshal(alias(sticker))
+}
+
 // NewSticker creates a new Sticker
 //
 // @param setID The identifier of the sticker set to which the sticker belongs; 0 if none
@@ -3515,6 +3916,13 @@ func (video *Video) MessageType() string {
 	return "video"
 }
 
+// MarshalJSON marshals to json, with @type set even if Video wasn't created by NewVideo
+func (video Video) MarshalJSON() ([]byte, error) {
+	type alias Video
+	video.tdCommon.Type = "video"
+	return json.Marshal(alias(video))
+}
+
 // NewVideo creates a new Video
 //
 // @param duration Duration of the video, in seconds; as defined by the sender
@@ -3560,6 +3968,13 @@ func (videoNote *VideoNote) MessageType() string {
 	return "videoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if VideoNote wasn't created by NewVideoNote
+func (videoNote VideoNote) MarshalJSON() ([]byte, error) {
+	type alias VideoNote
+	videoNote.tdCommon.Type = "videoNote"
+	return json.Marshal(alias(videoNote))
+}
+
 // NewVideoNote creates a new VideoNote
 //
 // @param duration Duration of the video, in seconds; as defined by the sender
@@ -3594,6 +4009,13 @@ func (voiceNote *VoiceNote) MessageType() string {
 	return "voiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if VoiceNote wasn't created by NewVoiceNote
+func (voiceNote VoiceNote) MarshalJSON() ([]byte, error) {
+	type alias VoiceNote
+	voiceNote.tdCommon.Type = "voiceNote"
+	return json.Marshal(alias(voiceNote))
+}
+
 // NewVoiceNote creates a new VoiceNote
 //
 // @param duration Duration of the voice note, in seconds; as defined by the sender
@@ -3627,6 +4049,13 @@ func (contact *Contact) MessageType() string {
 	return "contact"
 }
 
+// MarshalJSON marshals to json, with @type set even if Contact wasn't created by NewContact
+func (contact Contact) MarshalJSON() ([]byte, error) {
+	type alias Contact
+	contact.tdCommon.Type = "contact"
+	return json.Marshal(alias(contact))
+}
+
 // NewContact creates a new Contact
 //
 // @param phoneNumber Phone number of the user
@@ -3660,6 +4089,13 @@ func (location *Location) MessageType() string {
 	return "location"
 }
 
+// MarshalJSON marshals to json, with @type set even if Location wasn't created by NewLocation
+func (location Location) MarshalJSON() ([]byte, error) {
+	type alias Location
+	location.tdCommon.Type = "location"
+	return json.Marshal(alias(location))
+}
+
 // NewLocation creates a new Location
 //
 // @param latitude Latitude of the location in degrees; as defined by the sender
@@ -3692,6 +4128,13 @@ func (venue *Venue) MessageType() string {
 	return "venue"
 }
 
+// MarshalJSON marshals to json, with @type set even if Venue wasn't created by NewVenue
+func (venue Venue) MarshalJSON() ([]byte, error) {
+	type alias Venue
+	venue.tdCommon.Type = "venue"
+	return json.Marshal(alias(venue))
+}
+
 // NewVenue creates a new Venue
 //
 // @param location Venue location; as defined by the sender
@@ -3731,6 +4174,13 @@ func (game *Game) MessageType() string {
 	return "game"
 }
 
+// MarshalJSON marshals to json, with @type set even if Game wasn't created by NewGame
+func (game Game) MarshalJSON() ([]byte, error) {
+	type alias Game
+	game.tdCommon.Type = "game"
+	return json.Marshal(alias(game))
+}
+
 // NewGame creates a new Game
 //
 // @param iD Game ID
@@ -3775,6 +4225,13 @@ func (poll *Poll) MessageType() string {
 	return "poll"
 }
 
+// MarshalJSON marshals to json, with @type set even if Poll wasn't created by NewPoll
+func (poll Poll) MarshalJSON() ([]byte, error) {
+	type alias Poll
+	poll.tdCommon.Type = "poll"
+	return json.Marshal(alias(poll))
+}
+
 // NewPoll creates a new Poll
 //
 // @param iD Unique poll identifier
@@ -3860,6 +4317,13 @@ func (profilePhoto *ProfilePhoto) MessageType() string {
 	return "profilePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if ProfilePhoto wasn't created by NewProfilePhoto
+func (profilePhoto ProfilePhoto) MarshalJSON() ([]byte, error) {
+	type alias ProfilePhoto
+	profilePhoto.tdCommon.Type = "profilePhoto"
+	return json.Marshal(alias(profilePhoto))
+}
+
 // NewProfilePhoto creates a new ProfilePhoto
 //
 // @param iD Photo identifier; 0 for an empty photo. Can be used to find a photo in a list of user profile photos
@@ -3891,6 +4355,13 @@ func (chatPhotoInfo *ChatPhotoInfo) MessageType() string {
 	return "chatPhotoInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatPhotoInfo wasn't created by NewChatPhotoInfo
+func (chatPhotoInfo ChatPhotoInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatPhotoInfo
+	chatPhotoInfo.tdCommon.Type = "chatPhotoInfo"
+	return json.Marshal(alias(chatPhotoInfo))
+}
+
 // NewChatPhotoInfo creates a new ChatPhotoInfo
 //
 // @param small A small (160x160) chat photo variant in JPEG format. The file can be downloaded only before the photo is changed
@@ -3917,6 +4388,13 @@ func (userTypeRegular *UserTypeRegular) MessageType() string {
 	return "userTypeRegular"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserTypeRegular wasn't created by NewUserTypeRegular
+func (userTypeRegular UserTypeRegular) MarshalJSON() ([]byte, error) {
+	type alias UserTypeRegular
+	userTypeRegular.tdCommon.Type = "userTypeRegular"
+	return json.Marshal(alias(userTypeRegular))
+}
+
 // NewUserTypeRegular creates a new UserTypeRegular
 //
 func NewUserTypeRegular() *UserTypeRegular {
@@ -3942,6 +4420,13 @@ func (userTypeDeleted *UserTypeDeleted) MessageType() string {
 	return "userTypeDeleted"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserTypeDeleted wasn't created by NewUserTypeDeleted
+func (userTypeDeleted UserTypeDeleted) MarshalJSON() ([]byte, error) {
+	type alias UserTypeDeleted
+	userTypeDeleted.tdCommon.Type = "userTypeDeleted"
+	return json.Marshal(alias(userTypeDeleted))
+}
+
 // NewUserTypeDeleted creates a new UserTypeDeleted
 //
 func NewUserTypeDeleted() *UserTypeDeleted {
@@ -3972,6 +4457,13 @@ func (userTypeBot *UserTypeBot) MessageType() string {
 	return "userTypeBot"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserTypeBot wasn't created by NewUserTypeBot
+func (userTypeBot UserTypeBot) MarshalJSON() ([]byte, error) {
+	type alias UserTypeBot
+	userTypeBot.tdCommon.Type = "userTypeBot"
+	return json.Marshal(alias(userTypeBot))
+}
+
 // NewUserTypeBot creates a new UserTypeBot
 //
 // @param canJoinGroups True, if the bot can be invited to basic group and supergroup chats
@@ -4007,6 +4499,13 @@ func (userTypeUnknown *UserTypeUnknown) MessageType() string {
 	return "userTypeUnknown"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserTypeUnknown wasn't created by NewUserTypeUnknown
+func (userTypeUnknown UserTypeUnknown) MarshalJSON() ([]byte, error) {
+	type alias UserTypeUnknown
+	userTypeUnknown.tdCommon.Type = "userTypeUnknown"
+	return json.Marshal(alias(userTypeUnknown))
+}
+
 // NewUserTypeUnknown creates a new UserTypeUnknown
 //
 func NewUserTypeUnknown() *UserTypeUnknown {
@@ -4034,6 +4533,13 @@ func (botCommand *BotCommand) MessageType() string {
 	return "botCommand"
 }
 
+// MarshalJSON marshals to json, with @type set even if BotCommand wasn't created by NewBotCommand
+func (botCommand BotCommand) MarshalJSON() ([]byte, error) {
+	type alias BotCommand
+	botCommand.tdCommon.Type = "botCommand"
+	return json.Marshal(alias(botCommand))
+}
+
 // NewBotCommand creates a new BotCommand
 //
 // @param command Text of the bot command
@@ -4060,6 +4566,13 @@ func (botInfo *BotInfo) MessageType() string {
 	return "botInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if BotInfo wasn't created by NewBotInfo
+func (botInfo BotInfo) MarshalJSON() ([]byte, error) {
+	type alias BotInfo
+	botInfo.tdCommon.Type = "botInfo"
+	return json.Marshal(alias(botInfo))
+}
+
 // NewBotInfo creates a new BotInfo
 //
 // @param description
@@ -4086,6 +4599,13 @@ func (chatLocation *ChatLocation) MessageType() string {
 	return "chatLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatLocation wasn't created by NewChatLocation
+func (chatLocation ChatLocation) MarshalJSON() ([]byte, error) {
+	type alias ChatLocation
+	chatLocation.tdCommon.Type = "chatLocation"
+	return json.Marshal(alias(chatLocation))
+}
+
 // NewChatLocation creates a new ChatLocation
 //
 // @param location The location
@@ -4113,6 +4633,13 @@ func (animatedChatPhoto *AnimatedChatPhoto) MessageType() string {
 	return "animatedChatPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if AnimatedChatPhoto wasn't created by NewAnimatedChatPhoto
+func (animatedChatPhoto AnimatedChatPhoto) MarshalJSON() ([]byte, error) {
+	type alias AnimatedChatPhoto
+	animatedChatPhoto.tdCommon.Type = "animatedChatPhoto"
+	return json.Marshal(alias(animatedChatPhoto))
+}
+
 // NewAnimatedChatPhoto creates a new AnimatedChatPhoto
 //
 // @param length Animation width and height
@@ -4144,6 +4671,13 @@ func (chatPhoto *ChatPhoto) MessageType() string {
 	return "chatPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatPhoto wasn't created by NewChatPhoto
+func (chatPhoto ChatPhoto) MarshalJSON() ([]byte, error) {
+	type alias ChatPhoto
+	chatPhoto.tdCommon.Type = "chatPhoto"
+	return json.Marshal(alias(chatPhoto))
+}
+
 // NewChatPhoto creates a new ChatPhoto
 //
 // @param iD Unique photo identifier
@@ -4176,6 +4710,13 @@ func (chatPhotos *ChatPhotos) MessageType() string {
 	return "chatPhotos"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatPhotos wasn't created by NewChatPhotos
+func (chatPhotos ChatPhotos) MarshalJSON() ([]byte, error) {
+	type alias ChatPhotos
+	chatPhotos.tdCommon.Type = "chatPhotos"
+	return json.Marshal(alias(chatPhotos))
+}
+
 // NewChatPhotos creates a new ChatPhotos
 //
 // @param totalCount Total number of photos
@@ -4201,6 +4742,13 @@ func (inputChatPhotoPrevious *InputChatPhotoPrevious) MessageType() string {
 	return "inputChatPhotoPrevious"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputChatPhotoPrevious wasn't created by NewInputChatPhotoPrevious
+func (inputChatPhotoPrevious InputChatPhotoPrevious) MarshalJSON() ([]byte, error) {
+	type alias InputChatPhotoPrevious
+	inputChatPhotoPrevious.tdCommon.Type = "inputChatPhotoPrevious"
+	return json.Marshal(alias(inputChatPhotoPrevious))
+}
+
 // NewInputChatPhotoPrevious creates a new InputChatPhotoPrevious
 //
 // @param chatPhotoID Identifier of the profile photo to reuse
@@ -4229,6 +4777,13 @@ func (inputChatPhotoStatic *InputChatPhotoStatic) MessageType() string {
 	return "inputChatPhotoStatic"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputChatPhotoStatic wasn't created by NewInputChatPhotoStatic
+func (inputChatPhotoStatic InputChatPhotoStatic) MarshalJSON() ([]byte, error) {
+	type alias InputChatPhotoStatic
+	inputChatPhotoStatic.tdCommon.Type = "inputChatPhotoStatic"
+	return json.Marshal(alias(inputChatPhotoStatic))
+}
+
 // NewInputChatPhotoStatic creates a new InputChatPhotoStatic
 //
 // @param photo Photo to be set as profile photo. Only inputFileLocal and inputFileGenerated are allowed
@@ -4281,6 +4836,13 @@ func (inputChatPhotoAnimation *InputChatPhotoAnimation) MessageType() string {
 	return "inputChatPhotoAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputChatPhotoAnimation wasn't created by NewInputChatPhotoAnimation
+func (inputChatPhotoAnimation InputChatPhotoAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputChatPhotoAnimation
+	inputChatPhotoAnimation.tdCommon.Type = "inputChatPhotoAnimation"
+	return json.Marshal(alias(inputChatPhotoAnimation))
+}
+
 // NewInputChatPhotoAnimation creates a new InputChatPhotoAnimation
 //
 // @param animation Animation to be set as profile photo. Only inputFileLocal and inputFileGenerated are allowed
@@ -4352,6 +4914,13 @@ func (user *User) MessageType() string {
 	return "user"
 }
 
+// MarshalJSON marshals to json, with @type set even if User wasn't created by NewUser
+func (user User) MarshalJSON() ([]byte, error) {
+	type alias User
+	user.tdCommon.Type = "user"
+	return json.Marshal(alias(user))
+}
+
 // NewUser creates a new User
 //
 // @param iD User identifier
@@ -4472,6 +5041,13 @@ func (userFullInfo *UserFullInfo) MessageType() string {
 	return "userFullInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserFullInfo wasn't created by NewUserFullInfo
+func (userFullInfo UserFullInfo) MarshalJSON() ([]byte, error) {
+	type alias UserFullInfo
+	userFullInfo.tdCommon.Type = "userFullInfo"
+	return json.Marshal(alias(userFullInfo))
+}
+
 // NewUserFullInfo creates a new UserFullInfo
 //
 // @param photo User profile photo; may be null
@@ -4514,6 +5090,13 @@ func (users *Users) MessageType() string {
 	return "users"
 }
 
+// MarshalJSON marshals to json, with @type set even if Users wasn't created by NewUsers
+func (users Users) MarshalJSON() ([]byte, error) {
+	type alias Users
+	users.tdCommon.Type = "users"
+	return json.Marshal(alias(users))
+}
+
 // NewUsers creates a new Users
 //
 // @param totalCount Approximate total count of users found
@@ -4541,6 +5124,13 @@ func (chatAdministrator *ChatAdministrator) MessageType() string {
 	return "chatAdministrator"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatAdministrator wasn't created by NewChatAdministrator
+func (chatAdministrator ChatAdministrator) MarshalJSON() ([]byte, error) {
+	type alias ChatAdministrator
+	chatAdministrator.tdCommon.Type = "chatAdministrator"
+	return json.Marshal(alias(chatAdministrator))
+}
+
 // NewChatAdministrator creates a new ChatAdministrator
 //
 // @param userID User identifier of the administrator
@@ -4568,6 +5158,13 @@ func (chatAdministrators *ChatAdministrators) MessageType() string {
 	return "chatAdministrators"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatAdministrators wasn't created by NewChatAdministrators
+func (chatAdministrators ChatAdministrators) MarshalJSON() ([]byte, error) {
+	type alias ChatAdministrators
+	chatAdministrators.tdCommon.Type = "chatAdministrators"
+	return json.Marshal(alias(chatAdministrators))
+}
+
 // NewChatAdministrators creates a new ChatAdministrators
 //
 // @param administrators A list of chat administrators
@@ -4598,6 +5195,13 @@ func (chatPermissions *ChatPermissions) MessageType() string {
 	return "chatPermissions"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatPermissions wasn't created by NewChatPermissions
+func (chatPermissions ChatPermissions) MarshalJSON() ([]byte, error) {
+	type alias ChatPermissions
+	chatPermissions.tdCommon.Type = "chatPermissions"
+	return json.Marshal(alias(chatPermissions))
+}
+
 // NewChatPermissions creates a new ChatPermissions
 //
 // @param canSendMessages True, if the user can send text messages, contacts, locations, and venues
@@ -4637,6 +5241,13 @@ func (chatMemberStatusCreator *ChatMemberStatusCreator) MessageType() string {
 	return "chatMemberStatusCreator"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMemberStatusCreator wasn't created by NewChatMemberStatusCreator
+func (chatMemberStatusCreator ChatMemberStatusCreator) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberStatusCreator
+	chatMemberStatusCreator.tdCommon.Type = "chatMemberStatusCreator"
+	return json.Marshal(alias(chatMemberStatusCreator))
+}
+
 // NewChatMemberStatusCreator creates a new ChatMemberStatusCreator
 //
 // @param customTitle A custom title of the owner; 0-16 characters without emojis; applicable to supergroups only
@@ -4680,6 +5291,13 @@ func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) MessageType(
 	return "chatMemberStatusAdministrator"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMemberStatusAdministrator wasn't created by NewChatMemberStatusAdministrator
+func (chatMemberStatusAdministrator ChatMemberStatusAdministrator) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberStatusAdministrator
+	chatMemberStatusAdministrator.tdCommon.Type = "chatMemberStatusAdministrator"
+	return json.Marshal(alias(chatMemberStatusAdministrator))
+}
+
 // NewChatMemberStatusAdministrator creates a new ChatMemberStatusAdministrator
 //
 // @param customTitle A custom title of the administrator; 0-16 characters without emojis; applicable to supergroups only
@@ -4729,6 +5347,13 @@ func (chatMemberStatusMember *ChatMemberStatusMember) MessageType() string {
 	return "chatMemberStatusMember"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMemberStatusMember wasn't created by NewChatMemberStatusMember
+func (chatMemberStatusMember ChatMemberStatusMember) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberStatusMember
+	chatMemberStatusMember.tdCommon.Type = "chatMemberStatusMember"
+	return json.Marshal(alias(chatMemberStatusMember))
+}
+
 // NewChatMemberStatusMember creates a new ChatMemberStatusMember
 //
 func NewChatMemberStatusMember() *ChatMemberStatusMember {
@@ -4757,6 +5382,13 @@ func (chatMemberStatusRestricted *ChatMemberStatusRestricted) MessageType() stri
 	return "chatMemberStatusRestricted"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMemberStatusRestricted wasn't created by NewChatMemberStatusRestricted
+func (chatMemberStatusRestricted ChatMemberStatusRestricted) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberStatusRestricted
+	chatMemberStatusRestricted.tdCommon.Type = "chatMemberStatusRestricted"
+	return json.Marshal(alias(chatMemberStatusRestricted))
+}
+
 // NewChatMemberStatusRestricted creates a new ChatMemberStatusRestricted
 //
 // @param isMember True, if the user is a member of the chat
@@ -4788,6 +5420,13 @@ func (chatMemberStatusLeft *ChatMemberStatusLeft) MessageType() string {
 	return "chatMemberStatusLeft"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMemberStatusLeft wasn't created by NewChatMemberStatusLeft
+func (chatMemberStatusLeft ChatMemberStatusLeft) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberStatusLeft
+	chatMemberStatusLeft.tdCommon.Type = "chatMemberStatusLeft"
+	return json.Marshal(alias(chatMemberStatusLeft))
+}
+
 // NewChatMemberStatusLeft creates a new ChatMemberStatusLeft
 //
 func NewChatMemberStatusLeft() *ChatMemberStatusLeft {
@@ -4814,6 +5453,13 @@ func (chatMemberStatusBanned *ChatMemberStatusBanned) MessageType() string {
 	return "chatMemberStatusBanned"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMemberStatusBanned wasn't created by NewChatMemberStatusBanned
+func (chatMemberStatusBanned ChatMemberStatusBanned) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberStatusBanned
+	chatMemberStatusBanned.tdCommon.Type = "chatMemberStatusBanned"
+	return json.Marshal(alias(chatMemberStatusBanned))
+}
+
 // NewChatMemberStatusBanned creates a new ChatMemberStatusBanned
 //
 // @param bannedUntilDate Point in time (Unix timestamp) when the user will be unbanned; 0 if never. If the user is banned for more than 366 days or for less than 30 seconds from the current time, the user is considered to be banned forever. Always 0 in basic groups
@@ -4846,6 +5492,13 @@ func (chatMember *ChatMember) MessageType() string {
 	return "chatMember"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMember wasn't created by NewChatMember
+func (chatMember ChatMember) MarshalJSON() ([]byte, error) {
+	type alias ChatMember
+	chatMember.tdCommon.Type = "chatMember"
+	return json.Marshal(alias(chatMember))
+}
+
 // NewChatMember creates a new ChatMember
 //
 // @param userID User identifier of the chat member
@@ -4909,6 +5562,13 @@ func (chatMembers *ChatMembers) MessageType() string {
 	return "chatMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembers wasn't created by NewChatMembers
+func (chatMembers ChatMembers) MarshalJSON() ([]byte, error) {
+	type alias ChatMembers
+	chatMembers.tdCommon.Type = "chatMembers"
+	return json.Marshal(alias(chatMembers))
+}
+
 // NewChatMembers creates a new ChatMembers
 //
 // @param totalCount Approximate total count of chat members found
@@ -4933,6 +5593,13 @@ func (chatMembersFilterContacts *ChatMembersFilterContacts) MessageType() string
 	return "chatMembersFilterContacts"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterContacts wasn't created by NewChatMembersFilterContacts
+func (chatMembersFilterContacts ChatMembersFilterContacts) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterContacts
+	chatMembersFilterContacts.tdCommon.Type = "chatMembersFilterContacts"
+	return json.Marshal(alias(chatMembersFilterContacts))
+}
+
 // NewChatMembersFilterContacts creates a new ChatMembersFilterContacts
 //
 func NewChatMembersFilterContacts() *ChatMembersFilterContacts {
@@ -4958,6 +5625,13 @@ func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) MessageT
 	return "chatMembersFilterAdministrators"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterAdministrators wasn't created by NewChatMembersFilterAdministrators
+func (chatMembersFilterAdministrators ChatMembersFilterAdministrators) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterAdministrators
+	chatMembersFilterAdministrators.tdCommon.Type = "chatMembersFilterAdministrators"
+	return json.Marshal(alias(chatMembersFilterAdministrators))
+}
+
 // NewChatMembersFilterAdministrators creates a new ChatMembersFilterAdministrators
 //
 func NewChatMembersFilterAdministrators() *ChatMembersFilterAdministrators {
@@ -4983,6 +5657,13 @@ func (chatMembersFilterMembers *ChatMembersFilterMembers) MessageType() string {
 	return "chatMembersFilterMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterMembers wasn't created by NewChatMembersFilterMembers
+func (chatMembersFilterMembers ChatMembersFilterMembers) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterMembers
+	chatMembersFilterMembers.tdCommon.Type = "chatMembersFilterMembers"
+	return json.Marshal(alias(chatMembersFilterMembers))
+}
+
 // NewChatMembersFilterMembers creates a new ChatMembersFilterMembers
 //
 func NewChatMembersFilterMembers() *ChatMembersFilterMembers {
@@ -5001,7 +5682,7 @@ func (chatMembersFilterMembers *ChatMembersFilterMembers) GetChatMembersFilterEn
 // ChatMembersFilterMention Returns users which can be mentioned in the chat
 type ChatMembersFilterMention struct {
 	tdCommon
-	MessageThreadID int64 `json:"message_thread_id"` // If non-zero, the identifier of the current message thread
+	MessageThreadID JSONInt64 `json:"message_thread_id"` // If non-zero, the identifier of the current message thread
 }
 
 // MessageType return the string telegram-type of ChatMembersFilterMention
@@ -5009,10 +5690,17 @@ func (chatMembersFilterMention *ChatMembersFilterMention) MessageType() string {
 	return "chatMembersFilterMention"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterMention wasn't created by NewChatMembersFilterMention
+func (chatMembersFilterMention ChatMembersFilterMention) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterMention
+	chatMembersFilterMention.tdCommon.Type = "chatMembersFilterMention"
+	return json.Marshal(alias(chatMembersFilterMention))
+}
+
 // NewChatMembersFilterMention creates a new ChatMembersFilterMention
 //
 // @param messageThreadID If non-zero, the identifier of the current message thread
-func NewChatMembersFilterMention(messageThreadID int64) *ChatMembersFilterMention {
+func NewChatMembersFilterMention(messageThreadID JSONInt64) *ChatMembersFilterMention {
 	chatMembersFilterMentionTemp := ChatMembersFilterMention{
 		tdCommon:        tdCommon{Type: "chatMembersFilterMention"},
 		MessageThreadID: messageThreadID,
@@ -5036,6 +5724,13 @@ func (chatMembersFilterRestricted *ChatMembersFilterRestricted) MessageType() st
 	return "chatMembersFilterRestricted"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterRestricted wasn't created by NewChatMembersFilterRestricted
+func (chatMembersFilterRestricted ChatMembersFilterRestricted) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterRestricted
+	chatMembersFilterRestricted.tdCommon.Type = "chatMembersFilterRestricted"
+	return json.Marshal(alias(chatMembersFilterRestricted))
+}
+
 // NewChatMembersFilterRestricted creates a new ChatMembersFilterRestricted
 //
 func NewChatMembersFilterRestricted() *ChatMembersFilterRestricted {
@@ -5061,6 +5756,13 @@ func (chatMembersFilterBanned *ChatMembersFilterBanned) MessageType() string {
 	return "chatMembersFilterBanned"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterBanned wasn't created by NewChatMembersFilterBanned
+func (chatMembersFilterBanned ChatMembersFilterBanned) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterBanned
+	chatMembersFilterBanned.tdCommon.Type = "chatMembersFilterBanned"
+	return json.Marshal(alias(chatMembersFilterBanned))
+}
+
 // NewChatMembersFilterBanned creates a new ChatMembersFilterBanned
 //
 func NewChatMembersFilterBanned() *ChatMembersFilterBanned {
@@ -5086,6 +5788,13 @@ func (chatMembersFilterBots *ChatMembersFilterBots) MessageType() string {
 	return "chatMembersFilterBots"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatMembersFilterBots wasn't created by NewChatMembersFilterBots
+func (chatMembersFilterBots ChatMembersFilterBots) MarshalJSON() ([]byte, error) {
+	type alias ChatMembersFilterBots
+	chatMembersFilterBots.tdCommon.Type = "chatMembersFilterBots"
+	return json.Marshal(alias(chatMembersFilterBots))
+}
+
 // NewChatMembersFilterBots creates a new ChatMembersFilterBots
 //
 func NewChatMembersFilterBots() *ChatMembersFilterBots {
@@ -5111,6 +5820,13 @@ func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) MessageType(
 	return "supergroupMembersFilterRecent"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterRecent wasn't created by NewSupergroupMembersFilterRecent
+func (supergroupMembersFilterRecent SupergroupMembersFilterRecent) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterRecent
+	supergroupMembersFilterRecent.tdCommon.Type = "supergroupMembersFilterRecent"
+	return json.Marshal(alias(supergroupMembersFilterRecent))
+}
+
 // NewSupergroupMembersFilterRecent creates a new SupergroupMembersFilterRecent
 //
 func NewSupergroupMembersFilterRecent() *SupergroupMembersFilterRecent {
@@ -5137,6 +5853,13 @@ func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) MessageT
 	return "supergroupMembersFilterContacts"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterContacts wasn't created by NewSupergroupMembersFilterContacts
+func (supergroupMembersFilterContacts SupergroupMembersFilterContacts) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterContacts
+	supergroupMembersFilterContacts.tdCommon.Type = "supergroupMembersFilterContacts"
+	return json.Marshal(alias(supergroupMembersFilterContacts))
+}
+
 // NewSupergroupMembersFilterContacts creates a new SupergroupMembersFilterContacts
 //
 // @param query Query to search for
@@ -5164,6 +5887,13 @@ func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrato
 	return "supergroupMembersFilterAdministrators"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterAdministrators wasn't created by NewSupergroupMembersFilterAdministrators
+func (supergroupMembersFilterAdministrators SupergroupMembersFilterAdministrators) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterAdministrators
+	supergroupMembersFilterAdministrators.tdCommon.Type = "supergroupMembersFilterAdministrators"
+	return json.Marshal(alias(supergroupMembersFilterAdministrators))
+}
+
 // NewSupergroupMembersFilterAdministrators creates a new SupergroupMembersFilterAdministrators
 //
 func NewSupergroupMembersFilterAdministrators() *SupergroupMembersFilterAdministrators {
@@ -5190,6 +5920,13 @@ func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) MessageType(
 	return "supergroupMembersFilterSearch"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterSearch wasn't created by NewSupergroupMembersFilterSearch
+func (supergroupMembersFilterSearch SupergroupMembersFilterSearch) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterSearch
+	supergroupMembersFilterSearch.tdCommon.Type = "supergroupMembersFilterSearch"
+	return json.Marshal(alias(supergroupMembersFilterSearch))
+}
+
 // NewSupergroupMembersFilterSearch creates a new SupergroupMembersFilterSearch
 //
 // @param query Query to search for
@@ -5218,6 +5955,13 @@ func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) Mess
 	return "supergroupMembersFilterRestricted"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterRestricted wasn't created by NewSupergroupMembersFilterRestricted
+func (supergroupMembersFilterRestricted SupergroupMembersFilterRestricted) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterRestricted
+	supergroupMembersFilterRestricted.tdCommon.Type = "supergroupMembersFilterRestricted"
+	return json.Marshal(alias(supergroupMembersFilterRestricted))
+}
+
 // NewSupergroupMembersFilterRestricted creates a new SupergroupMembersFilterRestricted
 //
 // @param query Query to search for
@@ -5246,6 +5990,13 @@ func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) MessageType(
 	return "supergroupMembersFilterBanned"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterBanned wasn't created by NewSupergroupMembersFilterBanned
+func (supergroupMembersFilterBanned SupergroupMembersFilterBanned) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterBanned
+	supergroupMembersFilterBanned.tdCommon.Type = "supergroupMembersFilterBanned"
+	return json.Marshal(alias(supergroupMembersFilterBanned))
+}
+
 // NewSupergroupMembersFilterBanned creates a new SupergroupMembersFilterBanned
 //
 // @param query Query to search for
@@ -5266,8 +6017,8 @@ func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) GetSupergrou
 // SupergroupMembersFilterMention Returns users which can be mentioned in the supergroup
 type SupergroupMembersFilterMention struct {
 	tdCommon
-	Query           string `json:"query"`             // Query to search for
-	MessageThreadID int64  `json:"message_thread_id"` // If non-zero, the identifier of the current message thread
+	Query           string    `json:"query"`             // Query to search for
+	MessageThreadID JSONInt64 `json:"message_thread_id"` // If non-zero, the identifier of the current message thread
 }
 
 // MessageType return the string telegram-type of SupergroupMembersFilterMention
@@ -5275,11 +6026,18 @@ func (supergroupMembersFilterMention *SupergroupMembersFilterMention) MessageTyp
 	return "supergroupMembersFilterMention"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterMention wasn't created by NewSupergroupMembersFilterMention
+func (supergroupMembersFilterMention SupergroupMembersFilterMention) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterMention
+	supergroupMembersFilterMention.tdCommon.Type = "supergroupMembersFilterMention"
+	return json.Marshal(alias(supergroupMembersFilterMention))
+}
+
 // NewSupergroupMembersFilterMention creates a new SupergroupMembersFilterMention
 //
 // @param query Query to search for
 // @param messageThreadID If non-zero, the identifier of the current message thread
-func NewSupergroupMembersFilterMention(query string, messageThreadID int64) *SupergroupMembersFilterMention {
+func NewSupergroupMembersFilterMention(query string, messageThreadID JSONInt64) *SupergroupMembersFilterMention {
 	supergroupMembersFilterMentionTemp := SupergroupMembersFilterMention{
 		tdCommon:        tdCommon{Type: "supergroupMembersFilterMention"},
 		Query:           query,
@@ -5304,6 +6062,13 @@ func (supergroupMembersFilterBots *SupergroupMembersFilterBots) MessageType() st
 	return "supergroupMembersFilterBots"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupMembersFilterBots wasn't created by NewSupergroupMembersFilterBots
+func (supergroupMembersFilterBots SupergroupMembersFilterBots) MarshalJSON() ([]byte, error) {
+	type alias SupergroupMembersFilterBots
+	supergroupMembersFilterBots.tdCommon.Type = "supergroupMembersFilterBots"
+	return json.Marshal(alias(supergroupMembersFilterBots))
+}
+
 // NewSupergroupMembersFilterBots creates a new SupergroupMembersFilterBots
 //
 func NewSupergroupMembersFilterBots() *SupergroupMembersFilterBots {
@@ -5339,6 +6104,13 @@ func (chatInviteLink *ChatInviteLink) MessageType() string {
 	return "chatInviteLink"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatInviteLink wasn't created by NewChatInviteLink
+func (chatInviteLink ChatInviteLink) MarshalJSON() ([]byte, error) {
+	type alias ChatInviteLink
+	chatInviteLink.tdCommon.Type = "chatInviteLink"
+	return json.Marshal(alias(chatInviteLink))
+}
+
 // NewChatInviteLink creates a new ChatInviteLink
 //
 // @param inviteLink Chat invite link
@@ -5381,6 +6153,13 @@ func (chatInviteLinks *ChatInviteLinks) MessageType() string {
 	return "chatInviteLinks"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatInviteLinks wasn't created by NewChatInviteLinks
+func (chatInviteLinks ChatInviteLinks) MarshalJSON() ([]byte, error) {
+	type alias ChatInviteLinks
+	chatInviteLinks.tdCommon.Type = "chatInviteLinks"
+	return json.Marshal(alias(chatInviteLinks))
+}
+
 // NewChatInviteLinks creates a new ChatInviteLinks
 //
 // @param totalCount Approximate total count of chat invite links found
@@ -5407,6 +6186,13 @@ func (chatInviteLinkMember *ChatInviteLinkMember) MessageType() string {
 	return "chatInviteLinkMember"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatInviteLinkMember wasn't created by NewChatInviteLinkMember
+func (chatInviteLinkMember ChatInviteLinkMember) MarshalJSON() ([]byte, error) {
+	type alias ChatInviteLinkMember
+	chatInviteLinkMember.tdCommon.Type = "chatInviteLinkMember"
+	return json.Marshal(alias(chatInviteLinkMember))
+}
+
 // NewChatInviteLinkMember creates a new ChatInviteLinkMember
 //
 // @param userID User identifier
@@ -5433,6 +6219,13 @@ func (chatInviteLinkMembers *ChatInviteLinkMembers) MessageType() string {
 	return "chatInviteLinkMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatInviteLinkMembers wasn't created by NewChatInviteLinkMembers
+func (chatInviteLinkMembers ChatInviteLinkMembers) MarshalJSON() ([]byte, error) {
+	type alias ChatInviteLinkMembers
+	chatInviteLinkMembers.tdCommon.Type = "chatInviteLinkMembers"
+	return json.Marshal(alias(chatInviteLinkMembers))
+}
+
 // NewChatInviteLinkMembers creates a new ChatInviteLinkMembers
 //
 // @param totalCount Approximate total count of chat members found
@@ -5450,7 +6243,7 @@ func NewChatInviteLinkMembers(totalCount int32, members []ChatInviteLinkMember)
 // ChatInviteLinkInfo Contains information about a chat invite link
 type ChatInviteLinkInfo struct {
 	tdCommon
-	ChatID        int64          `json:"chat_id"`         // Chat identifier of the invite link; 0 if the user has no access to the chat before joining
+	ChatID        JSONInt64      `json:"chat_id"`         // Chat identifier of the invite link; 0 if the user has no access to the chat before joining
 	AccessibleFor int32          `json:"accessible_for"`  // If non-zero, the amount of time for which read access to the chat will remain available, in seconds
 	Type          ChatType       `json:"type"`            // Contains information about the type of the chat
 	Title         string         `json:"title"`           // Title of the chat
@@ -5465,6 +6258,13 @@ func (chatInviteLinkInfo *ChatInviteLinkInfo) MessageType() string {
 	return "chatInviteLinkInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatInviteLinkInfo wasn't created by NewChatInviteLinkInfo
+func (chatInviteLinkInfo ChatInviteLinkInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatInviteLinkInfo
+	chatInviteLinkInfo.tdCommon.Type = "chatInviteLinkInfo"
+	return json.Marshal(alias(chatInviteLinkInfo))
+}
+
 // NewChatInviteLinkInfo creates a new ChatInviteLinkInfo
 //
 // @param chatID Chat identifier of the invite link; 0 if the user has no access to the chat before joining
@@ -5475,7 +6275,7 @@ func (chatInviteLinkInfo *ChatInviteLinkInfo) MessageType() string {
 // @param memberCount Number of members in the chat
 // @param memberUserIDs User identifiers of some chat members that may be known to the current user
 // @param isPublic True, if the chat is a public supergroup or channel, i.e. it has a username or it is a location-based supergroup
-func NewChatInviteLinkInfo(chatID int64, accessibleFor int32, typeParam ChatType, title string, photo *ChatPhotoInfo, memberCount int32, memberUserIDs []int32, isPublic bool) *ChatInviteLinkInfo {
+func NewChatInviteLinkInfo(chatID JSONInt64, accessibleFor int32, typeParam ChatType, title string, photo *ChatPhotoInfo, memberCount int32, memberUserIDs []int32, isPublic bool) *ChatInviteLinkInfo {
 	chatInviteLinkInfoTemp := ChatInviteLinkInfo{
 		tdCommon:      tdCommon{Type: "chatInviteLinkInfo"},
 		ChatID:        chatID,
@@ -5500,7 +6300,7 @@ func (chatInviteLinkInfo *ChatInviteLinkInfo) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		ChatID        int64          `json:"chat_id"`         // Chat identifier of the invite link; 0 if the user has no access to the chat before joining
+		ChatID        JSONInt64      `json:"chat_id"`         // Chat identifier of the invite link; 0 if the user has no access to the chat before joining
 		AccessibleFor int32          `json:"accessible_for"`  // If non-zero, the amount of time for which read access to the chat will remain available, in seconds
 		Title         string         `json:"title"`           // Title of the chat
 		Photo         *ChatPhotoInfo `json:"photo"`           // Chat photo; may be null
@@ -5543,6 +6343,13 @@ func (basicGroup *BasicGroup) MessageType() string {
 	return "basicGroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if BasicGroup wasn't created by NewBasicGroup
+func (basicGroup BasicGroup) MarshalJSON() ([]byte, error) {
+	type alias BasicGroup
+	basicGroup.tdCommon.Type = "basicGroup"
+	return json.Marshal(alias(basicGroup))
+}
+
 // NewBasicGroup creates a new BasicGroup
 //
 // @param iD Group identifier
@@ -5609,6 +6416,13 @@ func (basicGroupFullInfo *BasicGroupFullInfo) MessageType() string {
 	return "basicGroupFullInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if BasicGroupFullInfo wasn't created by NewBasicGroupFullInfo
+func (basicGroupFullInfo BasicGroupFullInfo) MarshalJSON() ([]byte, error) {
+	type alias BasicGroupFullInfo
+	basicGroupFullInfo.tdCommon.Type = "basicGroupFullInfo"
+	return json.Marshal(alias(basicGroupFullInfo))
+}
+
 // NewBasicGroupFullInfo creates a new BasicGroupFullInfo
 //
 // @param photo Chat photo; may be null
@@ -5653,6 +6467,13 @@ func (supergroup *Supergroup) MessageType() string {
 	return "supergroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if Supergroup wasn't created by NewSupergroup
+func (supergroup Supergroup) MarshalJSON() ([]byte, error) {
+	type alias Supergroup
+	supergroup.tdCommon.Type = "supergroup"
+	return json.Marshal(alias(supergroup))
+}
+
 // NewSupergroup creates a new Supergroup
 //
 // @param iD Supergroup or channel identifier
@@ -5749,7 +6570,7 @@ type SupergroupFullInfo struct {
 	AdministratorCount       int32           `json:"administrator_count"`          // Number of privileged users in the supergroup or channel; 0 if unknown
 	RestrictedCount          int32           `json:"restricted_count"`             // Number of restricted users in the supergroup; 0 if unknown
 	BannedCount              int32           `json:"banned_count"`                 // Number of users banned from chat; 0 if unknown
-	LinkedChatID             int64           `json:"linked_chat_id"`               // Chat identifier of a discussion group for the channel, or a channel, for which the supergroup is the designated discussion group; 0 if none or unknown
+	LinkedChatID             JSONInt64       `json:"linked_chat_id"`               // Chat identifier of a discussion group for the channel, or a channel, for which the supergroup is the designated discussion group; 0 if none or unknown
 	SlowModeDelay            int32           `json:"slow_mode_delay"`              // Delay between consecutive sent messages for non-administrator supergroup members, in seconds
 	SlowModeDelayExpiresIn   float64         `json:"slow_mode_delay_expires_in"`   // Time left before next message can be sent in the supergroup, in seconds. An updateSupergroupFullInfo update is not triggered when value of this field changes, but both new and old values are non-zero
 	CanGetMembers            bool            `json:"can_get_members"`              // True, if members of the chat can be retrieved
@@ -5762,7 +6583,7 @@ type SupergroupFullInfo struct {
 	Location                 *ChatLocation   `json:"location"`                     // Location to which the supergroup is connected; may be null
 	InviteLink               *ChatInviteLink `json:"invite_link"`                  // Permanent invite link for this chat; may be null. For chat administrators with can_invite_users right only
 	UpgradedFromBasicGroupID int32           `json:"upgraded_from_basic_group_id"` // Identifier of the basic group from which supergroup was upgraded; 0 if none
-	UpgradedFromMaxMessageID int64           `json:"upgraded_from_max_message_id"` // Identifier of the last message in the basic group from which supergroup was upgraded; 0 if none
+	UpgradedFromMaxMessageID JSONInt64       `json:"upgraded_from_max_message_id"` // Identifier of the last message in the basic group from which supergroup was upgraded; 0 if none
 }
 
 // MessageType return the string telegram-type of SupergroupFullInfo
@@ -5770,6 +6591,13 @@ func (supergroupFullInfo *SupergroupFullInfo) MessageType() string {
 	return "supergroupFullInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if SupergroupFullInfo wasn't created by NewSupergroupFullInfo
+func (supergroupFullInfo SupergroupFullInfo) MarshalJSON() ([]byte, error) {
+	type alias SupergroupFullInfo
+	supergroupFullInfo.tdCommon.Type = "supergroupFullInfo"
+	return json.Marshal(alias(supergroupFullInfo))
+}
+
 // NewSupergroupFullInfo creates a new SupergroupFullInfo
 //
 // @param photo Chat photo; may be null
@@ -5792,7 +6620,7 @@ func (supergroupFullInfo *SupergroupFullInfo) MessageType() string {
 // @param inviteLink Permanent invite link for this chat; may be null. For chat administrators with can_invite_users right only
 // @param upgradedFromBasicGroupID Identifier of the basic group from which supergroup was upgraded; 0 if none
 // @param upgradedFromMaxMessageID Identifier of the last message in the basic group from which supergroup was upgraded; 0 if none
-func NewSupergroupFullInfo(photo *ChatPhoto, description string, memberCount int32, administratorCount int32, restrictedCount int32, bannedCount int32, linkedChatID int64, slowModeDelay int32, slowModeDelayExpiresIn float64, canGetMembers bool, canSetUsername bool, canSetStickerSet bool, canSetLocation bool, canGetStatistics bool, isAllHistoryAvailable bool, stickerSetID JSONInt64, location *ChatLocation, inviteLink *ChatInviteLink, upgradedFromBasicGroupID int32, upgradedFromMaxMessageID int64) *SupergroupFullInfo {
+func NewSupergroupFullInfo(photo *ChatPhoto, description string, memberCount int32, administratorCount int32, restrictedCount int32, bannedCount int32, linkedChatID JSONInt64, slowModeDelay int32, slowModeDelayExpiresIn float64, canGetMembers bool, canSetUsername bool, canSetStickerSet bool, canSetLocation bool, canGetStatistics bool, isAllHistoryAvailable bool, stickerSetID JSONInt64, location *ChatLocation, inviteLink *ChatInviteLink, upgradedFromBasicGroupID int32, upgradedFromMaxMessageID JSONInt64) *SupergroupFullInfo {
 	supergroupFullInfoTemp := SupergroupFullInfo{
 		tdCommon:                 tdCommon{Type: "supergroupFullInfo"},
 		Photo:                    photo,
@@ -5830,6 +6658,13 @@ func (secretChatStatePending *SecretChatStatePending) MessageType() string {
 	return "secretChatStatePending"
 }
 
+// MarshalJSON marshals to json, with @type set even if SecretChatStatePending wasn't created by NewSecretChatStatePending
+func (secretChatStatePending SecretChatStatePending) MarshalJSON() ([]byte, error) {
+	type alias SecretChatStatePending
+	secretChatStatePending.tdCommon.Type = "secretChatStatePending"
+	return json.Marshal(alias(secretChatStatePending))
+}
+
 // NewSecretChatStatePending creates a new SecretChatStatePending
 //
 func NewSecretChatStatePending() *SecretChatStatePending {
@@ -5855,6 +6690,13 @@ func (secretChatStateReady *SecretChatStateReady) MessageType() string {
 	return "secretChatStateReady"
 }
 
+// MarshalJSON marshals to json, with @type set even if SecretChatStateReady wasn't created by NewSecretChatStateReady
+func (secretChatStateReady SecretChatStateReady) MarshalJSON() ([]byte, error) {
+	type alias SecretChatStateReady
+	secretChatStateReady.tdCommon.Type = "secretChatStateReady"
+	return json.Marshal(alias(secretChatStateReady))
+}
+
 // NewSecretChatStateReady creates a new SecretChatStateReady
 //
 func NewSecretChatStateReady() *SecretChatStateReady {
@@ -5880,6 +6722,13 @@ func (secretChatStateClosed *SecretChatStateClosed) MessageType() string {
 	return "secretChatStateClosed"
 }
 
+// MarshalJSON marshals to json, with @type set even if SecretChatStateClosed wasn't created by NewSecretChatStateClosed
+func (secretChatStateClosed SecretChatStateClosed) MarshalJSON() ([]byte, error) {
+	type alias SecretChatStateClosed
+	secretChatStateClosed.tdCommon.Type = "secretChatStateClosed"
+	return json.Marshal(alias(secretChatStateClosed))
+}
+
 // NewSecretChatStateClosed creates a new SecretChatStateClosed
 //
 func NewSecretChatStateClosed() *SecretChatStateClosed {
@@ -5912,6 +6761,13 @@ func (secretChat *SecretChat) MessageType() string {
 	return "secretChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if SecretChat wasn't created by NewSecretChat
+func (secretChat SecretChat) MarshalJSON() ([]byte, error) {
+	type alias SecretChat
+	secretChat.tdCommon.Type = "secretChat"
+	return json.Marshal(alias(secretChat))
+}
+
 // NewSecretChat creates a new SecretChat
 //
 // @param iD Secret chat identifier
@@ -5982,6 +6838,13 @@ func (messageSenderUser *MessageSenderUser) MessageType() string {
 	return "messageSenderUser"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSenderUser wasn't created by NewMessageSenderUser
+func (messageSenderUser MessageSenderUser) MarshalJSON() ([]byte, error) {
+	type alias MessageSenderUser
+	messageSenderUser.tdCommon.Type = "messageSenderUser"
+	return json.Marshal(alias(messageSenderUser))
+}
+
 // NewMessageSenderUser creates a new MessageSenderUser
 //
 // @param userID Identifier of the user that sent the message
@@ -6002,7 +6865,7 @@ func (messageSenderUser *MessageSenderUser) GetMessageSenderEnum() MessageSender
 // MessageSenderChat The message was sent on behalf of a chat
 type MessageSenderChat struct {
 	tdCommon
-	ChatID int64 `json:"chat_id"` // Identifier of the chat that sent the message
+	ChatID JSONInt64 `json:"chat_id"` // Identifier of the chat that sent the message
 }
 
 // MessageType return the string telegram-type of MessageSenderChat
@@ -6010,10 +6873,17 @@ func (messageSenderChat *MessageSenderChat) MessageType() string {
 	return "messageSenderChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSenderChat wasn't created by NewMessageSenderChat
+func (messageSenderChat MessageSenderChat) MarshalJSON() ([]byte, error) {
+	type alias MessageSenderChat
+	messageSenderChat.tdCommon.Type = "messageSenderChat"
+	return json.Marshal(alias(messageSenderChat))
+}
+
 // NewMessageSenderChat creates a new MessageSenderChat
 //
 // @param chatID Identifier of the chat that sent the message
-func NewMessageSenderChat(chatID int64) *MessageSenderChat {
+func NewMessageSenderChat(chatID JSONInt64) *MessageSenderChat {
 	messageSenderChatTemp := MessageSenderChat{
 		tdCommon: tdCommon{Type: "messageSenderChat"},
 		ChatID:   chatID,
@@ -6039,6 +6909,13 @@ func (messageSenders *MessageSenders) MessageType() string {
 	return "messageSenders"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSenders wasn't created by NewMessageSenders
+func (messageSenders MessageSenders) MarshalJSON() ([]byte, error) {
+	type alias MessageSenders
+	messageSenders.tdCommon.Type = "messageSenders"
+	return json.Marshal(alias(messageSenders))
+}
+
 // NewMessageSenders creates a new MessageSenders
 //
 // @param totalCount Approximate total count of messages senders found
@@ -6064,6 +6941,13 @@ func (messageForwardOriginUser *MessageForwardOriginUser) MessageType() string {
 	return "messageForwardOriginUser"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageForwardOriginUser wasn't created by NewMessageForwardOriginUser
+func (messageForwardOriginUser MessageForwardOriginUser) MarshalJSON() ([]byte, error) {
+	type alias MessageForwardOriginUser
+	messageForwardOriginUser.tdCommon.Type = "messageForwardOriginUser"
+	return json.Marshal(alias(messageForwardOriginUser))
+}
+
 // NewMessageForwardOriginUser creates a new MessageForwardOriginUser
 //
 // @param senderUserID Identifier of the user that originally sent the message
@@ -6084,8 +6968,8 @@ func (messageForwardOriginUser *MessageForwardOriginUser) GetMessageForwardOrigi
 // MessageForwardOriginChat The message was originally sent by an anonymous chat administrator on behalf of the chat
 type MessageForwardOriginChat struct {
 	tdCommon
-	SenderChatID    int64  `json:"sender_chat_id"`   // Identifier of the chat that originally sent the message
-	AuthorSignature string `json:"author_signature"` // Original message author signature
+	SenderChatID    JSONInt64 `json:"sender_chat_id"`   // Identifier of the chat that originally sent the message
+	AuthorSignature string    `json:"author_signature"` // Original message author signature
 }
 
 // MessageType return the string telegram-type of MessageForwardOriginChat
@@ -6093,11 +6977,18 @@ func (messageForwardOriginChat *MessageForwardOriginChat) MessageType() string {
 	return "messageForwardOriginChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageForwardOriginChat wasn't created by NewMessageForwardOriginChat
+func (messageForwardOriginChat MessageForwardOriginChat) MarshalJSON() ([]byte, error) {
+	type alias MessageForwardOriginChat
+	messageForwardOriginChat.tdCommon.Type = "messageForwardOriginChat"
+	return json.Marshal(alias(messageForwardOriginChat))
+}
+
 // NewMessageForwardOriginChat creates a new MessageForwardOriginChat
 //
 // @param senderChatID Identifier of the chat that originally sent the message
 // @param authorSignature Original message author signature
-func NewMessageForwardOriginChat(senderChatID int64, authorSignature string) *MessageForwardOriginChat {
+func NewMessageForwardOriginChat(senderChatID JSONInt64, authorSignature string) *MessageForwardOriginChat {
 	messageForwardOriginChatTemp := MessageForwardOriginChat{
 		tdCommon:        tdCommon{Type: "messageForwardOriginChat"},
 		SenderChatID:    senderChatID,
@@ -6123,6 +7014,13 @@ func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) MessageTyp
 	return "messageForwardOriginHiddenUser"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageForwardOriginHiddenUser wasn't created by NewMessageForwardOriginHiddenUser
+func (messageForwardOriginHiddenUser MessageForwardOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	type alias MessageForwardOriginHiddenUser
+	messageForwardOriginHiddenUser.tdCommon.Type = "messageForwardOriginHiddenUser"
+	return json.Marshal(alias(messageForwardOriginHiddenUser))
+}
+
 // NewMessageForwardOriginHiddenUser creates a new MessageForwardOriginHiddenUser
 //
 // @param senderName Name of the sender
@@ -6143,9 +7041,9 @@ func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) GetMessage
 // MessageForwardOriginChannel The message was originally a post in a channel
 type MessageForwardOriginChannel struct {
 	tdCommon
-	ChatID          int64  `json:"chat_id"`          // Identifier of the chat from which the message was originally forwarded
-	MessageID       int64  `json:"message_id"`       // Message identifier of the original message
-	AuthorSignature string `json:"author_signature"` // Original post author signature
+	ChatID          JSONInt64 `json:"chat_id"`          // Identifier of the chat from which the message was originally forwarded
+	MessageID       JSONInt64 `json:"message_id"`       // Message identifier of the original message
+	AuthorSignature string    `json:"author_signature"` // Original post author signature
 }
 
 // MessageType return the string telegram-type of MessageForwardOriginChannel
@@ -6153,12 +7051,19 @@ func (messageForwardOriginChannel *MessageForwardOriginChannel) MessageType() st
 	return "messageForwardOriginChannel"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageForwardOriginChannel wasn't created by NewMessageForwardOriginChannel
+func (messageForwardOriginChannel MessageForwardOriginChannel) MarshalJSON() ([]byte, error) {
+	type alias MessageForwardOriginChannel
+	messageForwardOriginChannel.tdCommon.Type = "messageForwardOriginChannel"
+	return json.Marshal(alias(messageForwardOriginChannel))
+}
+
 // NewMessageForwardOriginChannel creates a new MessageForwardOriginChannel
 //
 // @param chatID Identifier of the chat from which the message was originally forwarded
 // @param messageID Message identifier of the original message
 // @param authorSignature Original post author signature
-func NewMessageForwardOriginChannel(chatID int64, messageID int64, authorSignature string) *MessageForwardOriginChannel {
+func NewMessageForwardOriginChannel(chatID JSONInt64, messageID JSONInt64, authorSignature string) *MessageForwardOriginChannel {
 	messageForwardOriginChannelTemp := MessageForwardOriginChannel{
 		tdCommon:        tdCommon{Type: "messageForwardOriginChannel"},
 		ChatID:          chatID,
@@ -6185,6 +7090,13 @@ func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) Mess
 	return "messageForwardOriginMessageImport"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageForwardOriginMessageImport wasn't created by NewMessageForwardOriginMessageImport
+func (messageForwardOriginMessageImport MessageForwardOriginMessageImport) MarshalJSON() ([]byte, error) {
+	type alias MessageForwardOriginMessageImport
+	messageForwardOriginMessageImport.tdCommon.Type = "messageForwardOriginMessageImport"
+	return json.Marshal(alias(messageForwardOriginMessageImport))
+}
+
 // NewMessageForwardOriginMessageImport creates a new MessageForwardOriginMessageImport
 //
 // @param senderName Name of the sender
@@ -6208,8 +7120,8 @@ type MessageForwardInfo struct {
 	Origin                        MessageForwardOrigin `json:"origin"`                           // Origin of a forwarded message
 	Date                          int32                `json:"date"`                             // Point in time (Unix timestamp) when the message was originally sent
 	PublicServiceAnnouncementType string               `json:"public_service_announcement_type"` // The type of a public service announcement for the forwarded message
-	FromChatID                    int64                `json:"from_chat_id"`                     // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown
-	FromMessageID                 int64                `json:"from_message_id"`                  // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown
+	FromChatID                    JSONInt64            `json:"from_chat_id"`                     // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown
+	FromMessageID                 JSONInt64            `json:"from_message_id"`                  // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown
 }
 
 // MessageType return the string telegram-type of MessageForwardInfo
@@ -6217,6 +7129,13 @@ func (messageForwardInfo *MessageForwardInfo) MessageType() string {
 	return "messageForwardInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageForwardInfo wasn't created by NewMessageForwardInfo
+func (messageForwardInfo MessageForwardInfo) MarshalJSON() ([]byte, error) {
+	type alias MessageForwardInfo
+	messageForwardInfo.tdCommon.Type = "messageForwardInfo"
+	return json.Marshal(alias(messageForwardInfo))
+}
+
 // NewMessageForwardInfo creates a new MessageForwardInfo
 //
 // @param origin Origin of a forwarded message
@@ -6224,7 +7143,7 @@ func (messageForwardInfo *MessageForwardInfo) MessageType() string {
 // @param publicServiceAnnouncementType The type of a public service announcement for the forwarded message
 // @param fromChatID For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown
 // @param fromMessageID For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown
-func NewMessageForwardInfo(origin MessageForwardOrigin, date int32, publicServiceAnnouncementType string, fromChatID int64, fromMessageID int64) *MessageForwardInfo {
+func NewMessageForwardInfo(origin MessageForwardOrigin, date int32, publicServiceAnnouncementType string, fromChatID JSONInt64, fromMessageID JSONInt64) *MessageForwardInfo {
 	messageForwardInfoTemp := MessageForwardInfo{
 		tdCommon:                      tdCommon{Type: "messageForwardInfo"},
 		Origin:                        origin,
@@ -6246,10 +7165,10 @@ func (messageForwardInfo *MessageForwardInfo) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		Date                          int32  `json:"date"`                             // Point in time (Unix timestamp) when the message was originally sent
-		PublicServiceAnnouncementType string `json:"public_service_announcement_type"` // The type of a public service announcement for the forwarded message
-		FromChatID                    int64  `json:"from_chat_id"`                     // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown
-		FromMessageID                 int64  `json:"from_message_id"`                  // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown
+		Date                          int32     `json:"date"`                             // Point in time (Unix timestamp) when the message was originally sent
+		PublicServiceAnnouncementType string    `json:"public_service_announcement_type"` // The type of a public service announcement for the forwarded message
+		FromChatID                    JSONInt64 `json:"from_chat_id"`                     // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the chat from which the message was forwarded last time; 0 if unknown
+		FromMessageID                 JSONInt64 `json:"from_message_id"`                  // For messages forwarded to the chat with the current user (Saved Messages), to the Replies bot chat, or to the channel's discussion group, the identifier of the original message from which the new message was forwarded last time; 0 if unknown
 	}{}
 	err = json.Unmarshal(b, &tempObj)
 	if err != nil {
@@ -6273,9 +7192,9 @@ type MessageReplyInfo struct {
 	tdCommon
 	ReplyCount              int32           `json:"reply_count"`                 // Number of times the message was directly or indirectly replied
 	RecentRepliers          []MessageSender `json:"recent_repliers"`             // Recent repliers to the message; available in channels with a discussion supergroup
-	LastReadInboxMessageID  int64           `json:"last_read_inbox_message_id"`  // Identifier of the last read incoming reply to the message
-	LastReadOutboxMessageID int64           `json:"last_read_outbox_message_id"` // Identifier of the last read outgoing reply to the message
-	LastMessageID           int64           `json:"last_message_id"`             // Identifier of the last reply to the message
+	LastReadInboxMessageID  JSONInt64       `json:"last_read_inbox_message_id"`  // Identifier of the last read incoming reply to the message
+	LastReadOutboxMessageID JSONInt64       `json:"last_read_outbox_message_id"` // Identifier of the last read outgoing reply to the message
+	LastMessageID           JSONInt64       `json:"last_message_id"`             // Identifier of the last reply to the message
 }
 
 // MessageType return the string telegram-type of MessageReplyInfo
@@ -6283,6 +7202,13 @@ func (messageReplyInfo *MessageReplyInfo) MessageType() string {
 	return "messageReplyInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageReplyInfo wasn't created by NewMessageReplyInfo
+func (messageReplyInfo MessageReplyInfo) MarshalJSON() ([]byte, error) {
+	type alias MessageReplyInfo
+	messageReplyInfo.tdCommon.Type = "messageReplyInfo"
+	return json.Marshal(alias(messageReplyInfo))
+}
+
 // NewMessageReplyInfo creates a new MessageReplyInfo
 //
 // @param replyCount Number of times the message was directly or indirectly replied
@@ -6290,7 +7216,7 @@ func (messageReplyInfo *MessageReplyInfo) MessageType() string {
 // @param lastReadInboxMessageID Identifier of the last read incoming reply to the message
 // @param lastReadOutboxMessageID Identifier of the last read outgoing reply to the message
 // @param lastMessageID Identifier of the last reply to the message
-func NewMessageReplyInfo(replyCount int32, recentRepliers []MessageSender, lastReadInboxMessageID int64, lastReadOutboxMessageID int64, lastMessageID int64) *MessageReplyInfo {
+func NewMessageReplyInfo(replyCount int32, recentRepliers []MessageSender, lastReadInboxMessageID JSONInt64, lastReadOutboxMessageID JSONInt64, lastMessageID JSONInt64) *MessageReplyInfo {
 	messageReplyInfoTemp := MessageReplyInfo{
 		tdCommon:                tdCommon{Type: "messageReplyInfo"},
 		ReplyCount:              replyCount,
@@ -6316,30 +7242,11 @@ func (messageInteractionInfo *MessageInteractionInfo) MessageType() string {
 	return "messageInteractionInfo"
 }
 
-// UnmarshalJSON unmarshal to json
-func (messageReplyInfo *MessageReplyInfo) UnmarshalJSON(data []byte) error {
-	var tmp struct {
-		ReplyCount              int32             `json:"reply_count"`
-		RecentRepliers          []*json.RawMessage `json:"recent_repliers"`
-		LastReadInboxMessageId  int64             `json:"last_read_inbox_message_id"`
-		LastReadOutboxMessageId int64             `json:"last_read_outbox_message_id"`
-		LastMessageId           int64             `json:"last_message_id"`
-	}
-
-	err := json.Unmarshal(data, &tmp)
-	if err != nil {
-		return err
-	}
-
-	messageReplyInfo.ReplyCount = tmp.ReplyCount
-	messageReplyInfo.LastReadInboxMessageID = tmp.LastReadInboxMessageId
-	messageReplyInfo.LastReadOutboxMessageID = tmp.LastReadOutboxMessageId
-	messageReplyInfo.LastMessageID = tmp.LastMessageId
-
-	fieldRecentRepliers, _ := unmarshalListOfMessageSender(tmp.RecentRepliers)
-	messageReplyInfo.RecentRepliers = fieldRecentRepliers
-
-	return nil
+// MarshalJSON marshals to json, with @type set even if MessageInteractionInfo wasn't created by NewMessageInteractionInfo
+func (messageInteractionInfo MessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	type alias MessageInteractionInfo
+	messageInteractionInfo.tdCommon.Type = "messageInteractionInfo"
+	return json.Marshal(alias(messageInteractionInfo))
 }
 
 // NewMessageInteractionInfo creates a new MessageInteractionInfo
@@ -6368,6 +7275,13 @@ func (messageSendingStatePending *MessageSendingStatePending) MessageType() stri
 	return "messageSendingStatePending"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSendingStatePending wasn't created by NewMessageSendingStatePending
+func (messageSendingStatePending MessageSendingStatePending) MarshalJSON() ([]byte, error) {
+	type alias MessageSendingStatePending
+	messageSendingStatePending.tdCommon.Type = "messageSendingStatePending"
+	return json.Marshal(alias(messageSendingStatePending))
+}
+
 // NewMessageSendingStatePending creates a new MessageSendingStatePending
 //
 func NewMessageSendingStatePending() *MessageSendingStatePending {
@@ -6397,6 +7311,13 @@ func (messageSendingStateFailed *MessageSendingStateFailed) MessageType() string
 	return "messageSendingStateFailed"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSendingStateFailed wasn't created by NewMessageSendingStateFailed
+func (messageSendingStateFailed MessageSendingStateFailed) MarshalJSON() ([]byte, error) {
+	type alias MessageSendingStateFailed
+	messageSendingStateFailed.tdCommon.Type = "messageSendingStateFailed"
+	return json.Marshal(alias(messageSendingStateFailed))
+}
+
 // NewMessageSendingStateFailed creates a new MessageSendingStateFailed
 //
 // @param errorCode An error code; 0 if unknown
@@ -6423,9 +7344,9 @@ func (messageSendingStateFailed *MessageSendingStateFailed) GetMessageSendingSta
 // Message Describes a message
 type Message struct {
 	tdCommon
-	ID                      int64                   `json:"id"`                           // Message identifier; unique for the chat to which the message belongs
+	ID                      JSONInt64               `json:"id"`                           // Message identifier; unique for the chat to which the message belongs
 	Sender                  MessageSender           `json:"sender"`                       // The sender of the message
-	ChatID                  int64                   `json:"chat_id"`                      // Chat identifier
+	ChatID                  JSONInt64               `json:"chat_id"`                      // Chat identifier
 	SendingState            MessageSendingState     `json:"sending_state"`                // Information about the sending state of the message; may be null
 	SchedulingState         MessageSchedulingState  `json:"scheduling_state"`             // Information about the scheduling state of the message; may be null
 	IsOutgoing              bool                    `json:"is_outgoing"`                  // True, if the message is outgoing
@@ -6442,9 +7363,9 @@ type Message struct {
 	EditDate                int32                   `json:"edit_date"`                    // Point in time (Unix timestamp) when the message was last edited
 	ForwardInfo             *MessageForwardInfo     `json:"forward_info"`                 // Information about the initial message sender; may be null
 	InteractionInfo         *MessageInteractionInfo `json:"interaction_info"`             // Information about interactions with the message; may be null
-	ReplyInChatID           int64                   `json:"reply_in_chat_id"`             // If non-zero, the identifier of the chat to which the replied message belongs; Currently, only messages in the Replies chat can have different reply_in_chat_id and chat_id
-	ReplyToMessageID        int64                   `json:"reply_to_message_id"`          // If non-zero, the identifier of the message this message is replying to; can be the identifier of a deleted message
-	MessageThreadID         int64                   `json:"message_thread_id"`            // If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs
+	ReplyInChatID           JSONInt64               `json:"reply_in_chat_id"`             // If non-zero, the identifier of the chat to which the replied message belongs; Currently, only messages in the Replies chat can have different reply_in_chat_id and chat_id
+	ReplyToMessageID        JSONInt64               `json:"reply_to_message_id"`          // If non-zero, the identifier of the message this message is replying to; can be the identifier of a deleted message
+	MessageThreadID         JSONInt64               `json:"message_thread_id"`            // If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs
 	TTL                     int32                   `json:"ttl"`                          // For self-destructing messages, the message's TTL (Time To Live), in seconds; 0 if none. TDLib will send updateDeleteMessages or updateMessageContent once the TTL expires
 	TTLExpiresIn            float64                 `json:"ttl_expires_in"`               // Time left before the message expires, in seconds
 	ViaBotUserID            int32                   `json:"via_bot_user_id"`              // If non-zero, the user identifier of the bot through which this message was sent
@@ -6460,6 +7381,13 @@ func (message *Message) MessageType() string {
 	return "message"
 }
 
+// MarshalJSON marshals to json, with @type set even if Message wasn't created by NewMessage
+func (message Message) MarshalJSON() ([]byte, error) {
+	type alias Message
+	message.tdCommon.Type = "message"
+	return json.Marshal(alias(message))
+}
+
 // NewMessage creates a new Message
 //
 // @param iD Message identifier; unique for the chat to which the message belongs
@@ -6492,7 +7420,7 @@ func (message *Message) MessageType() string {
 // @param restrictionReason If non-empty, contains a human-readable description of the reason why access to this message must be restricted
 // @param content Content of the message
 // @param replyMarkup Reply markup for the message; may be null
-func NewMessage(iD int64, sender MessageSender, chatID int64, sendingState MessageSendingState, schedulingState MessageSchedulingState, isOutgoing bool, isPinned bool, canBeEdited bool, canBeForwarded bool, canBeDeletedOnlyForSelf bool, canBeDeletedForAllUsers bool, canGetStatistics bool, canGetMessageThread bool, isChannelPost bool, containsUnreadMention bool, date int32, editDate int32, forwardInfo *MessageForwardInfo, interactionInfo *MessageInteractionInfo, replyInChatID int64, replyToMessageID int64, messageThreadID int64, tTL int32, tTLExpiresIn float64, viaBotUserID int32, authorSignature string, mediaAlbumID JSONInt64, restrictionReason string, content MessageContent, replyMarkup ReplyMarkup) *Message {
+func NewMessage(iD JSONInt64, sender MessageSender, chatID JSONInt64, sendingState MessageSendingState, schedulingState MessageSchedulingState, isOutgoing bool, isPinned bool, canBeEdited bool, canBeForwarded bool, canBeDeletedOnlyForSelf bool, canBeDeletedForAllUsers bool, canGetStatistics bool, canGetMessageThread bool, isChannelPost bool, containsUnreadMention bool, date int32, editDate int32, forwardInfo *MessageForwardInfo, interactionInfo *MessageInteractionInfo, replyInChatID JSONInt64, replyToMessageID JSONInt64, messageThreadID JSONInt64, tTL int32, tTLExpiresIn float64, viaBotUserID int32, authorSignature string, mediaAlbumID JSONInt64, restrictionReason string, content MessageContent, replyMarkup ReplyMarkup) *Message {
 	messageTemp := Message{
 		tdCommon:                tdCommon{Type: "message"},
 		ID:                      iD,
@@ -6539,8 +7467,8 @@ func (message *Message) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		ID                      int64                   `json:"id"`                           // Message identifier; unique for the chat to which the message belongs
-		ChatID                  int64                   `json:"chat_id"`                      // Chat identifier
+		ID                      JSONInt64               `json:"id"`                           // Message identifier; unique for the chat to which the message belongs
+		ChatID                  JSONInt64               `json:"chat_id"`                      // Chat identifier
 		IsOutgoing              bool                    `json:"is_outgoing"`                  // True, if the message is outgoing
 		IsPinned                bool                    `json:"is_pinned"`                    // True, if the message is pinned
 		CanBeEdited             bool                    `json:"can_be_edited"`                // True, if the message can be edited. For live location and poll messages this fields shows whether editMessageLiveLocation or stopPoll can be used with this message by the application
@@ -6555,9 +7483,9 @@ func (message *Message) UnmarshalJSON(b []byte) error {
 		EditDate                int32                   `json:"edit_date"`                    // Point in time (Unix timestamp) when the message was last edited
 		ForwardInfo             *MessageForwardInfo     `json:"forward_info"`                 // Information about the initial message sender; may be null
 		InteractionInfo         *MessageInteractionInfo `json:"interaction_info"`             // Information about interactions with the message; may be null
-		ReplyInChatID           int64                   `json:"reply_in_chat_id"`             // If non-zero, the identifier of the chat to which the replied message belongs; Currently, only messages in the Replies chat can have different reply_in_chat_id and chat_id
-		ReplyToMessageID        int64                   `json:"reply_to_message_id"`          // If non-zero, the identifier of the message this message is replying to; can be the identifier of a deleted message
-		MessageThreadID         int64                   `json:"message_thread_id"`            // If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs
+		ReplyInChatID           JSONInt64               `json:"reply_in_chat_id"`             // If non-zero, the identifier of the chat to which the replied message belongs; Currently, only messages in the Replies chat can have different reply_in_chat_id and chat_id
+		ReplyToMessageID        JSONInt64               `json:"reply_to_message_id"`          // If non-zero, the identifier of the message this message is replying to; can be the identifier of a deleted message
+		MessageThreadID         JSONInt64               `json:"message_thread_id"`            // If non-zero, the identifier of the message thread the message belongs to; unique within the chat to which the message belongs
 		TTL                     int32                   `json:"ttl"`                          // For self-destructing messages, the message's TTL (Time To Live), in seconds; 0 if none. TDLib will send updateDeleteMessages or updateMessageContent once the TTL expires
 		TTLExpiresIn            float64                 `json:"ttl_expires_in"`               // Time left before the message expires, in seconds
 		ViaBotUserID            int32                   `json:"via_bot_user_id"`              // If non-zero, the user identifier of the bot through which this message was sent
@@ -6628,6 +7556,13 @@ func (messages *Messages) MessageType() string {
 	return "messages"
 }
 
+// MarshalJSON marshals to json, with @type set even if Messages wasn't created by NewMessages
+func (messages Messages) MarshalJSON() ([]byte, error) {
+	type alias Messages
+	messages.tdCommon.Type = "messages"
+	return json.Marshal(alias(messages))
+}
+
 // NewMessages creates a new Messages
 //
 // @param totalCount Approximate total count of messages found
@@ -6655,6 +7590,13 @@ func (foundMessages *FoundMessages) MessageType() string {
 	return "foundMessages"
 }
 
+// MarshalJSON marshals to json, with @type set even if FoundMessages wasn't created by NewFoundMessages
+func (foundMessages FoundMessages) MarshalJSON() ([]byte, error) {
+	type alias FoundMessages
+	foundMessages.tdCommon.Type = "foundMessages"
+	return json.Marshal(alias(foundMessages))
+}
+
 // NewFoundMessages creates a new FoundMessages
 //
 // @param totalCount Approximate total count of messages found; -1 if unknown
@@ -6681,6 +7623,13 @@ func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateCha
 	return "notificationSettingsScopePrivateChats"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationSettingsScopePrivateChats wasn't created by NewNotificationSettingsScopePrivateChats
+func (notificationSettingsScopePrivateChats NotificationSettingsScopePrivateChats) MarshalJSON() ([]byte, error) {
+	type alias NotificationSettingsScopePrivateChats
+	notificationSettingsScopePrivateChats.tdCommon.Type = "notificationSettingsScopePrivateChats"
+	return json.Marshal(alias(notificationSettingsScopePrivateChats))
+}
+
 // NewNotificationSettingsScopePrivateChats creates a new NotificationSettingsScopePrivateChats
 //
 func NewNotificationSettingsScopePrivateChats() *NotificationSettingsScopePrivateChats {
@@ -6706,6 +7655,13 @@ func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats)
 	return "notificationSettingsScopeGroupChats"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationSettingsScopeGroupChats wasn't created by NewNotificationSettingsScopeGroupChats
+func (notificationSettingsScopeGroupChats NotificationSettingsScopeGroupChats) MarshalJSON() ([]byte, error) {
+	type alias NotificationSettingsScopeGroupChats
+	notificationSettingsScopeGroupChats.tdCommon.Type = "notificationSettingsScopeGroupChats"
+	return json.Marshal(alias(notificationSettingsScopeGroupChats))
+}
+
 // NewNotificationSettingsScopeGroupChats creates a new NotificationSettingsScopeGroupChats
 //
 func NewNotificationSettingsScopeGroupChats() *NotificationSettingsScopeGroupChats {
@@ -6731,6 +7687,13 @@ func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelCha
 	return "notificationSettingsScopeChannelChats"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationSettingsScopeChannelChats wasn't created by NewNotificationSettingsScopeChannelChats
+func (notificationSettingsScopeChannelChats NotificationSettingsScopeChannelChats) MarshalJSON() ([]byte, error) {
+	type alias NotificationSettingsScopeChannelChats
+	notificationSettingsScopeChannelChats.tdCommon.Type = "notificationSettingsScopeChannelChats"
+	return json.Marshal(alias(notificationSettingsScopeChannelChats))
+}
+
 // NewNotificationSettingsScopeChannelChats creates a new NotificationSettingsScopeChannelChats
 //
 func NewNotificationSettingsScopeChannelChats() *NotificationSettingsScopeChannelChats {
@@ -6766,6 +7729,13 @@ func (chatNotificationSettings *ChatNotificationSettings) MessageType() string {
 	return "chatNotificationSettings"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatNotificationSettings wasn't created by NewChatNotificationSettings
+func (chatNotificationSettings ChatNotificationSettings) MarshalJSON() ([]byte, error) {
+	type alias ChatNotificationSettings
+	chatNotificationSettings.tdCommon.Type = "chatNotificationSettings"
+	return json.Marshal(alias(chatNotificationSettings))
+}
+
 // NewChatNotificationSettings creates a new ChatNotificationSettings
 //
 // @param useDefaultMuteFor If true, mute_for is ignored and the value for the relevant type of chat is used instead
@@ -6811,6 +7781,13 @@ func (scopeNotificationSettings *ScopeNotificationSettings) MessageType() string
 	return "scopeNotificationSettings"
 }
 
+// MarshalJSON marshals to json, with @type set even if ScopeNotificationSettings wasn't created by NewScopeNotificationSettings
+func (scopeNotificationSettings ScopeNotificationSettings) MarshalJSON() ([]byte, error) {
+	type alias ScopeNotificationSettings
+	scopeNotificationSettings.tdCommon.Type = "scopeNotificationSettings"
+	return json.Marshal(alias(scopeNotificationSettings))
+}
+
 // NewScopeNotificationSettings creates a new ScopeNotificationSettings
 //
 // @param muteFor Time left before notifications will be unmuted, in seconds
@@ -6834,7 +7811,7 @@ func NewScopeNotificationSettings(muteFor int32, sound string, showPreview bool,
 // DraftMessage Contains information about a message draft
 type DraftMessage struct {
 	tdCommon
-	ReplyToMessageID int64               `json:"reply_to_message_id"` // Identifier of the message to reply to; 0 if none
+	ReplyToMessageID JSONInt64           `json:"reply_to_message_id"` // Identifier of the message to reply to; 0 if none
 	Date             int32               `json:"date"`                // Point in time (Unix timestamp) when the draft was created
 	InputMessageText InputMessageContent `json:"input_message_text"`  // Content of the message draft; this should always be of type inputMessageText
 }
@@ -6844,12 +7821,19 @@ func (draftMessage *DraftMessage) MessageType() string {
 	return "draftMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if DraftMessage wasn't created by NewDraftMessage
+func (draftMessage DraftMessage) MarshalJSON() ([]byte, error) {
+	type alias DraftMessage
+	draftMessage.tdCommon.Type = "draftMessage"
+	return json.Marshal(alias(draftMessage))
+}
+
 // NewDraftMessage creates a new DraftMessage
 //
 // @param replyToMessageID Identifier of the message to reply to; 0 if none
 // @param date Point in time (Unix timestamp) when the draft was created
 // @param inputMessageText Content of the message draft; this should always be of type inputMessageText
-func NewDraftMessage(replyToMessageID int64, date int32, inputMessageText InputMessageContent) *DraftMessage {
+func NewDraftMessage(replyToMessageID JSONInt64, date int32, inputMessageText InputMessageContent) *DraftMessage {
 	draftMessageTemp := DraftMessage{
 		tdCommon:         tdCommon{Type: "draftMessage"},
 		ReplyToMessageID: replyToMessageID,
@@ -6869,8 +7853,8 @@ func (draftMessage *DraftMessage) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		ReplyToMessageID int64 `json:"reply_to_message_id"` // Identifier of the message to reply to; 0 if none
-		Date             int32 `json:"date"`                // Point in time (Unix timestamp) when the draft was created
+		ReplyToMessageID JSONInt64 `json:"reply_to_message_id"` // Identifier of the message to reply to; 0 if none
+		Date             int32     `json:"date"`                // Point in time (Unix timestamp) when the draft was created
 
 	}{}
 	err = json.Unmarshal(b, &tempObj)
@@ -6899,6 +7883,13 @@ func (chatTypePrivate *ChatTypePrivate) MessageType() string {
 	return "chatTypePrivate"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatTypePrivate wasn't created by NewChatTypePrivate
+func (chatTypePrivate ChatTypePrivate) MarshalJSON() ([]byte, error) {
+	type alias ChatTypePrivate
+	chatTypePrivate.tdCommon.Type = "chatTypePrivate"
+	return json.Marshal(alias(chatTypePrivate))
+}
+
 // NewChatTypePrivate creates a new ChatTypePrivate
 //
 // @param userID User identifier
@@ -6927,6 +7918,13 @@ func (chatTypeBasicGroup *ChatTypeBasicGroup) MessageType() string {
 	return "chatTypeBasicGroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatTypeBasicGroup wasn't created by NewChatTypeBasicGroup
+func (chatTypeBasicGroup ChatTypeBasicGroup) MarshalJSON() ([]byte, error) {
+	type alias ChatTypeBasicGroup
+	chatTypeBasicGroup.tdCommon.Type = "chatTypeBasicGroup"
+	return json.Marshal(alias(chatTypeBasicGroup))
+}
+
 // NewChatTypeBasicGroup creates a new ChatTypeBasicGroup
 //
 // @param basicGroupID Basic group identifier
@@ -6956,6 +7954,13 @@ func (chatTypeSupergroup *ChatTypeSupergroup) MessageType() string {
 	return "chatTypeSupergroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatTypeSupergroup wasn't created by NewChatTypeSupergroup
+func (chatTypeSupergroup ChatTypeSupergroup) MarshalJSON() ([]byte, error) {
+	type alias ChatTypeSupergroup
+	chatTypeSupergroup.tdCommon.Type = "chatTypeSupergroup"
+	return json.Marshal(alias(chatTypeSupergroup))
+}
+
 // NewChatTypeSupergroup creates a new ChatTypeSupergroup
 //
 // @param supergroupID Supergroup or channel identifier
@@ -6987,6 +7992,13 @@ func (chatTypeSecret *ChatTypeSecret) MessageType() string {
 	return "chatTypeSecret"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatTypeSecret wasn't created by NewChatTypeSecret
+func (chatTypeSecret ChatTypeSecret) MarshalJSON() ([]byte, error) {
+	type alias ChatTypeSecret
+	chatTypeSecret.tdCommon.Type = "chatTypeSecret"
+	return json.Marshal(alias(chatTypeSecret))
+}
+
 // NewChatTypeSecret creates a new ChatTypeSecret
 //
 // @param secretChatID Secret chat identifier
@@ -7009,19 +8021,19 @@ func (chatTypeSecret *ChatTypeSecret) GetChatTypeEnum() ChatTypeEnum {
 // ChatFilter Represents a filter of user chats
 type ChatFilter struct {
 	tdCommon
-	Title              string  `json:"title"`                // The title of the filter; 1-12 characters without line feeds
-	IconName           string  `json:"icon_name"`            // The icon name for short filter representation. If non-empty, must be one of "All", "Unread", "Unmuted", "Bots", "Channels", "Groups", "Private", "Custom", "Setup", "Cat", "Crown", "Favorite", "Flower", "Game", "Home", "Love", "Mask", "Party", "Sport", "Study", "Trade", "Travel", "Work".
-	PinnedChatIDs      []int64 `json:"pinned_chat_ids"`      // The chat identifiers of pinned chats in the filtered chat list
-	IncludedChatIDs    []int64 `json:"included_chat_ids"`    // The chat identifiers of always included chats in the filtered chat list
-	ExcludedChatIDs    []int64 `json:"excluded_chat_ids"`    // The chat identifiers of always excluded chats in the filtered chat list
-	ExcludeMuted       bool    `json:"exclude_muted"`        // True, if muted chats need to be excluded
-	ExcludeRead        bool    `json:"exclude_read"`         // True, if read chats need to be excluded
-	ExcludeArchived    bool    `json:"exclude_archived"`     // True, if archived chats need to be excluded
-	IncludeContacts    bool    `json:"include_contacts"`     // True, if contacts need to be included
-	IncludeNonContacts bool    `json:"include_non_contacts"` // True, if non-contact users need to be included
-	IncludeBots        bool    `json:"include_bots"`         // True, if bots need to be included
-	IncludeGroups      bool    `json:"include_groups"`       // True, if basic groups and supergroups need to be included
-	IncludeChannels    bool    `json:"include_channels"`     // True, if channels need to be included
+	Title              string      `json:"title"`                // The title of the filter; 1-12 characters without line feeds
+	IconName           string      `json:"icon_name"`            // The icon name for short filter representation. If non-empty, must be one of "All", "Unread", "Unmuted", "Bots", "Channels", "Groups", "Private", "Custom", "Setup", "Cat", "Crown", "Favorite", "Flower", "Game", "Home", "Love", "Mask", "Party", "Sport", "Study", "Trade", "Travel", "Work".
+	PinnedChatIDs      []JSONInt64 `json:"pinned_chat_ids"`      // The chat identifiers of pinned chats in the filtered chat list
+	IncludedChatIDs    []JSONInt64 `json:"included_chat_ids"`    // The chat identifiers of always included chats in the filtered chat list
+	ExcludedChatIDs    []JSONInt64 `json:"excluded_chat_ids"`    // The chat identifiers of always excluded chats in the filtered chat list
+	ExcludeMuted       bool        `json:"exclude_muted"`        // True, if muted chats need to be excluded
+	ExcludeRead        bool        `json:"exclude_read"`         // True, if read chats need to be excluded
+	ExcludeArchived    bool        `json:"exclude_archived"`     // True, if archived chats need to be excluded
+	IncludeContacts    bool        `json:"include_contacts"`     // True, if contacts need to be included
+	IncludeNonContacts bool        `json:"include_non_contacts"` // True, if non-contact users need to be included
+	IncludeBots        bool        `json:"include_bots"`         // True, if bots need to be included
+	IncludeGroups      bool        `json:"include_groups"`       // True, if basic groups and supergroups need to be included
+	IncludeChannels    bool        `json:"include_channels"`     // True, if channels need to be included
 }
 
 // MessageType return the string telegram-type of ChatFilter
@@ -7029,6 +8041,13 @@ func (chatFilter *ChatFilter) MessageType() string {
 	return "chatFilter"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatFilter wasn't created by NewChatFilter
+func (chatFilter ChatFilter) MarshalJSON() ([]byte, error) {
+	type alias ChatFilter
+	chatFilter.tdCommon.Type = "chatFilter"
+	return json.Marshal(alias(chatFilter))
+}
+
 // NewChatFilter creates a new ChatFilter
 //
 // @param title The title of the filter; 1-12 characters without line feeds
@@ -7044,7 +8063,7 @@ func (chatFilter *ChatFilter) MessageType() string {
 // @param includeBots True, if bots need to be included
 // @param includeGroups True, if basic groups and supergroups need to be included
 // @param includeChannels True, if channels need to be included
-func NewChatFilter(title string, iconName string, pinnedChatIDs []int64, includedChatIDs []int64, excludedChatIDs []int64, excludeMuted bool, excludeRead bool, excludeArchived bool, includeContacts bool, includeNonContacts bool, includeBots bool, includeGroups bool, includeChannels bool) *ChatFilter {
+func NewChatFilter(title string, iconName string, pinnedChatIDs []JSONInt64, includedChatIDs []JSONInt64, excludedChatIDs []JSONInt64, excludeMuted bool, excludeRead bool, excludeArchived bool, includeContacts bool, includeNonContacts bool, includeBots bool, includeGroups bool, includeChannels bool) *ChatFilter {
 	chatFilterTemp := ChatFilter{
 		tdCommon:           tdCommon{Type: "chatFilter"},
 		Title:              title,
@@ -7078,6 +8097,13 @@ func (chatFilterInfo *ChatFilterInfo) MessageType() string {
 	return "chatFilterInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatFilterInfo wasn't created by NewChatFilterInfo
+func (chatFilterInfo ChatFilterInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatFilterInfo
+	chatFilterInfo.tdCommon.Type = "chatFilterInfo"
+	return json.Marshal(alias(chatFilterInfo))
+}
+
 // NewChatFilterInfo creates a new ChatFilterInfo
 //
 // @param iD Unique chat filter identifier
@@ -7106,6 +8132,13 @@ func (recommendedChatFilter *RecommendedChatFilter) MessageType() string {
 	return "recommendedChatFilter"
 }
 
+// MarshalJSON marshals to json, with @type set even if RecommendedChatFilter wasn't created by NewRecommendedChatFilter
+func (recommendedChatFilter RecommendedChatFilter) MarshalJSON() ([]byte, error) {
+	type alias RecommendedChatFilter
+	recommendedChatFilter.tdCommon.Type = "recommendedChatFilter"
+	return json.Marshal(alias(recommendedChatFilter))
+}
+
 // NewRecommendedChatFilter creates a new RecommendedChatFilter
 //
 // @param filter The chat filter
@@ -7131,6 +8164,13 @@ func (recommendedChatFilters *RecommendedChatFilters) MessageType() string {
 	return "recommendedChatFilters"
 }
 
+// MarshalJSON marshals to json, with @type set even if RecommendedChatFilters wasn't created by NewRecommendedChatFilters
+func (recommendedChatFilters RecommendedChatFilters) MarshalJSON() ([]byte, error) {
+	type alias RecommendedChatFilters
+	recommendedChatFilters.tdCommon.Type = "recommendedChatFilters"
+	return json.Marshal(alias(recommendedChatFilters))
+}
+
 // NewRecommendedChatFilters creates a new RecommendedChatFilters
 //
 // @param chatFilters List of recommended chat filters
@@ -7153,6 +8193,13 @@ func (chatListMain *ChatListMain) MessageType() string {
 	return "chatListMain"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatListMain wasn't created by NewChatListMain
+func (chatListMain ChatListMain) MarshalJSON() ([]byte, error) {
+	type alias ChatListMain
+	chatListMain.tdCommon.Type = "chatListMain"
+	return json.Marshal(alias(chatListMain))
+}
+
 // NewChatListMain creates a new ChatListMain
 //
 func NewChatListMain() *ChatListMain {
@@ -7178,6 +8225,13 @@ func (chatListArchive *ChatListArchive) MessageType() string {
 	return "chatListArchive"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatListArchive wasn't created by NewChatListArchive
+func (chatListArchive ChatListArchive) MarshalJSON() ([]byte, error) {
+	type alias ChatListArchive
+	chatListArchive.tdCommon.Type = "chatListArchive"
+	return json.Marshal(alias(chatListArchive))
+}
+
 // NewChatListArchive creates a new ChatListArchive
 //
 func NewChatListArchive() *ChatListArchive {
@@ -7204,6 +8258,13 @@ func (chatListFilter *ChatListFilter) MessageType() string {
 	return "chatListFilter"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatListFilter wasn't created by NewChatListFilter
+func (chatListFilter ChatListFilter) MarshalJSON() ([]byte, error) {
+	type alias ChatListFilter
+	chatListFilter.tdCommon.Type = "chatListFilter"
+	return json.Marshal(alias(chatListFilter))
+}
+
 // NewChatListFilter creates a new ChatListFilter
 //
 // @param chatFilterID Chat filter identifier
@@ -7232,6 +8293,13 @@ func (chatLists *ChatLists) MessageType() string {
 	return "chatLists"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatLists wasn't created by NewChatLists
+func (chatLists ChatLists) MarshalJSON() ([]byte, error) {
+	type alias ChatLists
+	chatLists.tdCommon.Type = "chatLists"
+	return json.Marshal(alias(chatLists))
+}
+
 // NewChatLists creates a new ChatLists
 //
 // @param chatLists List of chat lists
@@ -7254,6 +8322,13 @@ func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) MessageType() string {
 	return "chatSourceMtprotoProxy"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatSourceMtprotoProxy wasn't created by NewChatSourceMtprotoProxy
+func (chatSourceMtprotoProxy ChatSourceMtprotoProxy) MarshalJSON() ([]byte, error) {
+	type alias ChatSourceMtprotoProxy
+	chatSourceMtprotoProxy.tdCommon.Type = "chatSourceMtprotoProxy"
+	return json.Marshal(alias(chatSourceMtprotoProxy))
+}
+
 // NewChatSourceMtprotoProxy creates a new ChatSourceMtprotoProxy
 //
 func NewChatSourceMtprotoProxy() *ChatSourceMtprotoProxy {
@@ -7281,6 +8356,13 @@ func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement)
 	return "chatSourcePublicServiceAnnouncement"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatSourcePublicServiceAnnouncement wasn't created by NewChatSourcePublicServiceAnnouncement
+func (chatSourcePublicServiceAnnouncement ChatSourcePublicServiceAnnouncement) MarshalJSON() ([]byte, error) {
+	type alias ChatSourcePublicServiceAnnouncement
+	chatSourcePublicServiceAnnouncement.tdCommon.Type = "chatSourcePublicServiceAnnouncement"
+	return json.Marshal(alias(chatSourcePublicServiceAnnouncement))
+}
+
 // NewChatSourcePublicServiceAnnouncement creates a new ChatSourcePublicServiceAnnouncement
 //
 // @param typeParam The type of the announcement
@@ -7314,6 +8396,13 @@ func (chatPosition *ChatPosition) MessageType() string {
 	return "chatPosition"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatPosition wasn't created by NewChatPosition
+func (chatPosition ChatPosition) MarshalJSON() ([]byte, error) {
+	type alias ChatPosition
+	chatPosition.tdCommon.Type = "chatPosition"
+	return json.Marshal(alias(chatPosition))
+}
+
 // NewChatPosition creates a new ChatPosition
 //
 // @param list The chat list
@@ -7366,7 +8455,7 @@ func (chatPosition *ChatPosition) UnmarshalJSON(b []byte) error {
 // Chat A chat. (Can be a private chat, basic group, supergroup, or secret chat)
 type Chat struct {
 	tdCommon
-	ID                         int64                     `json:"id"`                           // Chat unique identifier
+	ID                         JSONInt64                 `json:"id"`                           // Chat unique identifier
 	Type                       ChatType                  `json:"type"`                         // Type of the chat
 	Title                      string                    `json:"title"`                        // Chat title
 	Photo                      *ChatPhotoInfo            `json:"photo"`                        // Chat photo; may be null
@@ -7381,14 +8470,14 @@ type Chat struct {
 	CanBeReported              bool                      `json:"can_be_reported"`              // True, if the chat can be reported to Telegram moderators through reportChat
 	DefaultDisableNotification bool                      `json:"default_disable_notification"` // Default value of the disable_notification parameter, used when a message is sent to the chat
 	UnreadCount                int32                     `json:"unread_count"`                 // Number of unread messages in the chat
-	LastReadInboxMessageID     int64                     `json:"last_read_inbox_message_id"`   // Identifier of the last read incoming message
-	LastReadOutboxMessageID    int64                     `json:"last_read_outbox_message_id"`  // Identifier of the last read outgoing message
+	LastReadInboxMessageID     JSONInt64                 `json:"last_read_inbox_message_id"`   // Identifier of the last read incoming message
+	LastReadOutboxMessageID    JSONInt64                 `json:"last_read_outbox_message_id"`  // Identifier of the last read outgoing message
 	UnreadMentionCount         int32                     `json:"unread_mention_count"`         // Number of unread messages with a mention/reply in the chat
 	NotificationSettings       *ChatNotificationSettings `json:"notification_settings"`        // Notification settings for this chat
 	ActionBar                  ChatActionBar             `json:"action_bar"`                   // Describes actions which should be possible to do through a chat action bar; may be null
 	VoiceChatGroupCallID       int32                     `json:"voice_chat_group_call_id"`     // Group call identifier of an active voice chat; 0 if none or unknown. The voice chat can be received through the method getGroupCall
 	IsVoiceChatEmpty           bool                      `json:"is_voice_chat_empty"`          // True, if an active voice chat is empty
-	ReplyMarkupMessageID       int64                     `json:"reply_markup_message_id"`      // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
+	ReplyMarkupMessageID       JSONInt64                 `json:"reply_markup_message_id"`      // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
 	DraftMessage               *DraftMessage             `json:"draft_message"`                // A draft of a message in the chat; may be null
 	ClientData                 string                    `json:"client_data"`                  // Contains application-specific data associated with the chat. (For example, the chat scroll position or local chat notification settings can be stored here.) Persistent if the message database is used
 }
@@ -7398,6 +8487,13 @@ func (chat *Chat) MessageType() string {
 	return "chat"
 }
 
+// MarshalJSON marshals to json, with @type set even if Chat wasn't created by NewChat
+func (chat Chat) MarshalJSON() ([]byte, error) {
+	type alias Chat
+	chat.tdCommon.Type = "chat"
+	return json.Marshal(alias(chat))
+}
+
 // NewChat creates a new Chat
 //
 // @param iD Chat unique identifier
@@ -7425,7 +8521,7 @@ func (chat *Chat) MessageType() string {
 // @param replyMarkupMessageID Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
 // @param draftMessage A draft of a message in the chat; may be null
 // @param clientData Contains application-specific data associated with the chat. (For example, the chat scroll position or local chat notification settings can be stored here.) Persistent if the message database is used
-func NewChat(iD int64, typeParam ChatType, title string, photo *ChatPhotoInfo, permissions *ChatPermissions, lastMessage *Message, positions []ChatPosition, isMarkedAsUnread bool, isBlocked bool, hasScheduledMessages bool, canBeDeletedOnlyForSelf bool, canBeDeletedForAllUsers bool, canBeReported bool, defaultDisableNotification bool, unreadCount int32, lastReadInboxMessageID int64, lastReadOutboxMessageID int64, unreadMentionCount int32, notificationSettings *ChatNotificationSettings, actionBar ChatActionBar, voiceChatGroupCallID int32, isVoiceChatEmpty bool, replyMarkupMessageID int64, draftMessage *DraftMessage, clientData string) *Chat {
+func NewChat(iD JSONInt64, typeParam ChatType, title string, photo *ChatPhotoInfo, permissions *ChatPermissions, lastMessage *Message, positions []ChatPosition, isMarkedAsUnread bool, isBlocked bool, hasScheduledMessages bool, canBeDeletedOnlyForSelf bool, canBeDeletedForAllUsers bool, canBeReported bool, defaultDisableNotification bool, unreadCount int32, lastReadInboxMessageID JSONInt64, lastReadOutboxMessageID JSONInt64, unreadMentionCount int32, notificationSettings *ChatNotificationSettings, actionBar ChatActionBar, voiceChatGroupCallID int32, isVoiceChatEmpty bool, replyMarkupMessageID JSONInt64, draftMessage *DraftMessage, clientData string) *Chat {
 	chatTemp := Chat{
 		tdCommon:                   tdCommon{Type: "chat"},
 		ID:                         iD,
@@ -7467,7 +8563,7 @@ func (chat *Chat) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		ID                         int64                     `json:"id"`                           // Chat unique identifier
+		ID                         JSONInt64                 `json:"id"`                           // Chat unique identifier
 		Title                      string                    `json:"title"`                        // Chat title
 		Photo                      *ChatPhotoInfo            `json:"photo"`                        // Chat photo; may be null
 		Permissions                *ChatPermissions          `json:"permissions"`                  // Actions that non-administrator chat members are allowed to take in the chat
@@ -7481,13 +8577,13 @@ func (chat *Chat) UnmarshalJSON(b []byte) error {
 		CanBeReported              bool                      `json:"can_be_reported"`              // True, if the chat can be reported to Telegram moderators through reportChat
 		DefaultDisableNotification bool                      `json:"default_disable_notification"` // Default value of the disable_notification parameter, used when a message is sent to the chat
 		UnreadCount                int32                     `json:"unread_count"`                 // Number of unread messages in the chat
-		LastReadInboxMessageID     int64                     `json:"last_read_inbox_message_id"`   // Identifier of the last read incoming message
-		LastReadOutboxMessageID    int64                     `json:"last_read_outbox_message_id"`  // Identifier of the last read outgoing message
+		LastReadInboxMessageID     JSONInt64                 `json:"last_read_inbox_message_id"`   // Identifier of the last read incoming message
+		LastReadOutboxMessageID    JSONInt64                 `json:"last_read_outbox_message_id"`  // Identifier of the last read outgoing message
 		UnreadMentionCount         int32                     `json:"unread_mention_count"`         // Number of unread messages with a mention/reply in the chat
 		NotificationSettings       *ChatNotificationSettings `json:"notification_settings"`        // Notification settings for this chat
 		VoiceChatGroupCallID       int32                     `json:"voice_chat_group_call_id"`     // Group call identifier of an active voice chat; 0 if none or unknown. The voice chat can be received through the method getGroupCall
 		IsVoiceChatEmpty           bool                      `json:"is_voice_chat_empty"`          // True, if an active voice chat is empty
-		ReplyMarkupMessageID       int64                     `json:"reply_markup_message_id"`      // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
+		ReplyMarkupMessageID       JSONInt64                 `json:"reply_markup_message_id"`      // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
 		DraftMessage               *DraftMessage             `json:"draft_message"`                // A draft of a message in the chat; may be null
 		ClientData                 string                    `json:"client_data"`                  // Contains application-specific data associated with the chat. (For example, the chat scroll position or local chat notification settings can be stored here.) Persistent if the message database is used
 	}{}
@@ -7533,8 +8629,8 @@ func (chat *Chat) UnmarshalJSON(b []byte) error {
 // Chats Represents a list of chats
 type Chats struct {
 	tdCommon
-	TotalCount int32   `json:"total_count"` // Approximate total count of chats found
-	ChatIDs    []int64 `json:"chat_ids"`    // List of chat identifiers
+	TotalCount int32       `json:"total_count"` // Approximate total count of chats found
+	ChatIDs    []JSONInt64 `json:"chat_ids"`    // List of chat identifiers
 }
 
 // MessageType return the string telegram-type of Chats
@@ -7542,11 +8638,18 @@ func (chats *Chats) MessageType() string {
 	return "chats"
 }
 
+// MarshalJSON marshals to json, with @type set even if Chats wasn't created by NewChats
+func (chats Chats) MarshalJSON() ([]byte, error) {
+	type alias Chats
+	chats.tdCommon.Type = "chats"
+	return json.Marshal(alias(chats))
+}
+
 // NewChats creates a new Chats
 //
 // @param totalCount Approximate total count of chats found
 // @param chatIDs List of chat identifiers
-func NewChats(totalCount int32, chatIDs []int64) *Chats {
+func NewChats(totalCount int32, chatIDs []JSONInt64) *Chats {
 	chatsTemp := Chats{
 		tdCommon:   tdCommon{Type: "chats"},
 		TotalCount: totalCount,
@@ -7559,8 +8662,8 @@ func NewChats(totalCount int32, chatIDs []int64) *Chats {
 // ChatNearby Describes a chat located nearby
 type ChatNearby struct {
 	tdCommon
-	ChatID   int64 `json:"chat_id"`  // Chat identifier
-	Distance int32 `json:"distance"` // Distance to the chat location, in meters
+	ChatID   JSONInt64 `json:"chat_id"`  // Chat identifier
+	Distance int32     `json:"distance"` // Distance to the chat location, in meters
 }
 
 // MessageType return the string telegram-type of ChatNearby
@@ -7568,11 +8671,18 @@ func (chatNearby *ChatNearby) MessageType() string {
 	return "chatNearby"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatNearby wasn't created by NewChatNearby
+func (chatNearby ChatNearby) MarshalJSON() ([]byte, error) {
+	type alias ChatNearby
+	chatNearby.tdCommon.Type = "chatNearby"
+	return json.Marshal(alias(chatNearby))
+}
+
 // NewChatNearby creates a new ChatNearby
 //
 // @param chatID Chat identifier
 // @param distance Distance to the chat location, in meters
-func NewChatNearby(chatID int64, distance int32) *ChatNearby {
+func NewChatNearby(chatID JSONInt64, distance int32) *ChatNearby {
 	chatNearbyTemp := ChatNearby{
 		tdCommon: tdCommon{Type: "chatNearby"},
 		ChatID:   chatID,
@@ -7594,6 +8704,13 @@ func (chatsNearby *ChatsNearby) MessageType() string {
 	return "chatsNearby"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatsNearby wasn't created by NewChatsNearby
+func (chatsNearby ChatsNearby) MarshalJSON() ([]byte, error) {
+	type alias ChatsNearby
+	chatsNearby.tdCommon.Type = "chatsNearby"
+	return json.Marshal(alias(chatsNearby))
+}
+
 // NewChatsNearby creates a new ChatsNearby
 //
 // @param usersNearby List of users nearby
@@ -7618,6 +8735,13 @@ func (publicChatTypeHasUsername *PublicChatTypeHasUsername) MessageType() string
 	return "publicChatTypeHasUsername"
 }
 
+// MarshalJSON marshals to json, with @type set even if PublicChatTypeHasUsername wasn't created by NewPublicChatTypeHasUsername
+func (publicChatTypeHasUsername PublicChatTypeHasUsername) MarshalJSON() ([]byte, error) {
+	type alias PublicChatTypeHasUsername
+	publicChatTypeHasUsername.tdCommon.Type = "publicChatTypeHasUsername"
+	return json.Marshal(alias(publicChatTypeHasUsername))
+}
+
 // NewPublicChatTypeHasUsername creates a new PublicChatTypeHasUsername
 //
 func NewPublicChatTypeHasUsername() *PublicChatTypeHasUsername {
@@ -7643,6 +8767,13 @@ func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) MessageType(
 	return "publicChatTypeIsLocationBased"
 }
 
+// MarshalJSON marshals to json, with @type set even if PublicChatTypeIsLocationBased wasn't created by NewPublicChatTypeIsLocationBased
+func (publicChatTypeIsLocationBased PublicChatTypeIsLocationBased) MarshalJSON() ([]byte, error) {
+	type alias PublicChatTypeIsLocationBased
+	publicChatTypeIsLocationBased.tdCommon.Type = "publicChatTypeIsLocationBased"
+	return json.Marshal(alias(publicChatTypeIsLocationBased))
+}
+
 // NewPublicChatTypeIsLocationBased creates a new PublicChatTypeIsLocationBased
 //
 func NewPublicChatTypeIsLocationBased() *PublicChatTypeIsLocationBased {
@@ -7669,6 +8800,13 @@ func (chatActionBarReportSpam *ChatActionBarReportSpam) MessageType() string {
 	return "chatActionBarReportSpam"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionBarReportSpam wasn't created by NewChatActionBarReportSpam
+func (chatActionBarReportSpam ChatActionBarReportSpam) MarshalJSON() ([]byte, error) {
+	type alias ChatActionBarReportSpam
+	chatActionBarReportSpam.tdCommon.Type = "chatActionBarReportSpam"
+	return json.Marshal(alias(chatActionBarReportSpam))
+}
+
 // NewChatActionBarReportSpam creates a new ChatActionBarReportSpam
 //
 // @param canUnarchive If true, the chat was automatically archived and can be moved back to the main chat list using addChatToList simultaneously with setting chat notification settings to default using setChatNotificationSettings
@@ -7696,6 +8834,13 @@ func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation
 	return "chatActionBarReportUnrelatedLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionBarReportUnrelatedLocation wasn't created by NewChatActionBarReportUnrelatedLocation
+func (chatActionBarReportUnrelatedLocation ChatActionBarReportUnrelatedLocation) MarshalJSON() ([]byte, error) {
+	type alias ChatActionBarReportUnrelatedLocation
+	chatActionBarReportUnrelatedLocation.tdCommon.Type = "chatActionBarReportUnrelatedLocation"
+	return json.Marshal(alias(chatActionBarReportUnrelatedLocation))
+}
+
 // NewChatActionBarReportUnrelatedLocation creates a new ChatActionBarReportUnrelatedLocation
 //
 func NewChatActionBarReportUnrelatedLocation() *ChatActionBarReportUnrelatedLocation {
@@ -7721,6 +8866,13 @@ func (chatActionBarInviteMembers *ChatActionBarInviteMembers) MessageType() stri
 	return "chatActionBarInviteMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionBarInviteMembers wasn't created by NewChatActionBarInviteMembers
+func (chatActionBarInviteMembers ChatActionBarInviteMembers) MarshalJSON() ([]byte, error) {
+	type alias ChatActionBarInviteMembers
+	chatActionBarInviteMembers.tdCommon.Type = "chatActionBarInviteMembers"
+	return json.Marshal(alias(chatActionBarInviteMembers))
+}
+
 // NewChatActionBarInviteMembers creates a new ChatActionBarInviteMembers
 //
 func NewChatActionBarInviteMembers() *ChatActionBarInviteMembers {
@@ -7748,6 +8900,13 @@ func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) MessageType() st
 	return "chatActionBarReportAddBlock"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionBarReportAddBlock wasn't created by NewChatActionBarReportAddBlock
+func (chatActionBarReportAddBlock ChatActionBarReportAddBlock) MarshalJSON() ([]byte, error) {
+	type alias ChatActionBarReportAddBlock
+	chatActionBarReportAddBlock.tdCommon.Type = "chatActionBarReportAddBlock"
+	return json.Marshal(alias(chatActionBarReportAddBlock))
+}
+
 // NewChatActionBarReportAddBlock creates a new ChatActionBarReportAddBlock
 //
 // @param canUnarchive If true, the chat was automatically archived and can be moved back to the main chat list using addChatToList simultaneously with setting chat notification settings to default using setChatNotificationSettings
@@ -7777,6 +8936,13 @@ func (chatActionBarAddContact *ChatActionBarAddContact) MessageType() string {
 	return "chatActionBarAddContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionBarAddContact wasn't created by NewChatActionBarAddContact
+func (chatActionBarAddContact ChatActionBarAddContact) MarshalJSON() ([]byte, error) {
+	type alias ChatActionBarAddContact
+	chatActionBarAddContact.tdCommon.Type = "chatActionBarAddContact"
+	return json.Marshal(alias(chatActionBarAddContact))
+}
+
 // NewChatActionBarAddContact creates a new ChatActionBarAddContact
 //
 func NewChatActionBarAddContact() *ChatActionBarAddContact {
@@ -7802,6 +8968,13 @@ func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) MessageType(
 	return "chatActionBarSharePhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionBarSharePhoneNumber wasn't created by NewChatActionBarSharePhoneNumber
+func (chatActionBarSharePhoneNumber ChatActionBarSharePhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias ChatActionBarSharePhoneNumber
+	chatActionBarSharePhoneNumber.tdCommon.Type = "chatActionBarSharePhoneNumber"
+	return json.Marshal(alias(chatActionBarSharePhoneNumber))
+}
+
 // NewChatActionBarSharePhoneNumber creates a new ChatActionBarSharePhoneNumber
 //
 func NewChatActionBarSharePhoneNumber() *ChatActionBarSharePhoneNumber {
@@ -7827,6 +9000,13 @@ func (keyboardButtonTypeText *KeyboardButtonTypeText) MessageType() string {
 	return "keyboardButtonTypeText"
 }
 
+// MarshalJSON marshals to json, with @type set even if KeyboardButtonTypeText wasn't created by NewKeyboardButtonTypeText
+func (keyboardButtonTypeText KeyboardButtonTypeText) MarshalJSON() ([]byte, error) {
+	type alias KeyboardButtonTypeText
+	keyboardButtonTypeText.tdCommon.Type = "keyboardButtonTypeText"
+	return json.Marshal(alias(keyboardButtonTypeText))
+}
+
 // NewKeyboardButtonTypeText creates a new KeyboardButtonTypeText
 //
 func NewKeyboardButtonTypeText() *KeyboardButtonTypeText {
@@ -7852,6 +9032,13 @@ func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber
 	return "keyboardButtonTypeRequestPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if KeyboardButtonTypeRequestPhoneNumber wasn't created by NewKeyboardButtonTypeRequestPhoneNumber
+func (keyboardButtonTypeRequestPhoneNumber KeyboardButtonTypeRequestPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias KeyboardButtonTypeRequestPhoneNumber
+	keyboardButtonTypeRequestPhoneNumber.tdCommon.Type = "keyboardButtonTypeRequestPhoneNumber"
+	return json.Marshal(alias(keyboardButtonTypeRequestPhoneNumber))
+}
+
 // NewKeyboardButtonTypeRequestPhoneNumber creates a new KeyboardButtonTypeRequestPhoneNumber
 //
 func NewKeyboardButtonTypeRequestPhoneNumber() *KeyboardButtonTypeRequestPhoneNumber {
@@ -7877,6 +9064,13 @@ func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) Mess
 	return "keyboardButtonTypeRequestLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if KeyboardButtonTypeRequestLocation wasn't created by NewKeyboardButtonTypeRequestLocation
+func (keyboardButtonTypeRequestLocation KeyboardButtonTypeRequestLocation) MarshalJSON() ([]byte, error) {
+	type alias KeyboardButtonTypeRequestLocation
+	keyboardButtonTypeRequestLocation.tdCommon.Type = "keyboardButtonTypeRequestLocation"
+	return json.Marshal(alias(keyboardButtonTypeRequestLocation))
+}
+
 // NewKeyboardButtonTypeRequestLocation creates a new KeyboardButtonTypeRequestLocation
 //
 func NewKeyboardButtonTypeRequestLocation() *KeyboardButtonTypeRequestLocation {
@@ -7904,6 +9098,13 @@ func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) MessageType(
 	return "keyboardButtonTypeRequestPoll"
 }
 
+// MarshalJSON marshals to json, with @type set even if KeyboardButtonTypeRequestPoll wasn't created by NewKeyboardButtonTypeRequestPoll
+func (keyboardButtonTypeRequestPoll KeyboardButtonTypeRequestPoll) MarshalJSON() ([]byte, error) {
+	type alias KeyboardButtonTypeRequestPoll
+	keyboardButtonTypeRequestPoll.tdCommon.Type = "keyboardButtonTypeRequestPoll"
+	return json.Marshal(alias(keyboardButtonTypeRequestPoll))
+}
+
 // NewKeyboardButtonTypeRequestPoll creates a new KeyboardButtonTypeRequestPoll
 //
 // @param forceRegular If true, only regular polls must be allowed to create
@@ -7935,6 +9136,13 @@ func (keyboardButton *KeyboardButton) MessageType() string {
 	return "keyboardButton"
 }
 
+// MarshalJSON marshals to json, with @type set even if KeyboardButton wasn't created by NewKeyboardButton
+func (keyboardButton KeyboardButton) MarshalJSON() ([]byte, error) {
+	type alias KeyboardButton
+	keyboardButton.tdCommon.Type = "keyboardButton"
+	return json.Marshal(alias(keyboardButton))
+}
+
 // NewKeyboardButton creates a new KeyboardButton
 //
 // @param text Text of the button
@@ -7986,6 +9194,13 @@ func (inlineKeyboardButtonTypeURL *InlineKeyboardButtonTypeURL) MessageType() st
 	return "inlineKeyboardButtonTypeUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeURL wasn't created by NewInlineKeyboardButtonTypeURL
+func (inlineKeyboardButtonTypeURL InlineKeyboardButtonTypeURL) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeURL
+	inlineKeyboardButtonTypeURL.tdCommon.Type = "inlineKeyboardButtonTypeUrl"
+	return json.Marshal(alias(inlineKeyboardButtonTypeURL))
+}
+
 // NewInlineKeyboardButtonTypeURL creates a new InlineKeyboardButtonTypeURL
 //
 // @param uRL HTTP or tg:// URL to open
@@ -8016,6 +9231,13 @@ func (inlineKeyboardButtonTypeLoginURL *InlineKeyboardButtonTypeLoginURL) Messag
 	return "inlineKeyboardButtonTypeLoginUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeLoginURL wasn't created by NewInlineKeyboardButtonTypeLoginURL
+func (inlineKeyboardButtonTypeLoginURL InlineKeyboardButtonTypeLoginURL) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeLoginURL
+	inlineKeyboardButtonTypeLoginURL.tdCommon.Type = "inlineKeyboardButtonTypeLoginUrl"
+	return json.Marshal(alias(inlineKeyboardButtonTypeLoginURL))
+}
+
 // NewInlineKeyboardButtonTypeLoginURL creates a new InlineKeyboardButtonTypeLoginURL
 //
 // @param uRL An HTTP URL to open
@@ -8048,6 +9270,13 @@ func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) Messag
 	return "inlineKeyboardButtonTypeCallback"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeCallback wasn't created by NewInlineKeyboardButtonTypeCallback
+func (inlineKeyboardButtonTypeCallback InlineKeyboardButtonTypeCallback) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeCallback
+	inlineKeyboardButtonTypeCallback.tdCommon.Type = "inlineKeyboardButtonTypeCallback"
+	return json.Marshal(alias(inlineKeyboardButtonTypeCallback))
+}
+
 // NewInlineKeyboardButtonTypeCallback creates a new InlineKeyboardButtonTypeCallback
 //
 // @param data Data to be sent to the bot via a callback query
@@ -8076,6 +9305,13 @@ func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCall
 	return "inlineKeyboardButtonTypeCallbackWithPassword"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeCallbackWithPassword wasn't created by NewInlineKeyboardButtonTypeCallbackWithPassword
+func (inlineKeyboardButtonTypeCallbackWithPassword InlineKeyboardButtonTypeCallbackWithPassword) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeCallbackWithPassword
+	inlineKeyboardButtonTypeCallbackWithPassword.tdCommon.Type = "inlineKeyboardButtonTypeCallbackWithPassword"
+	return json.Marshal(alias(inlineKeyboardButtonTypeCallbackWithPassword))
+}
+
 // NewInlineKeyboardButtonTypeCallbackWithPassword creates a new InlineKeyboardButtonTypeCallbackWithPassword
 //
 // @param data Data to be sent to the bot via a callback query
@@ -8103,6 +9339,13 @@ func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame
 	return "inlineKeyboardButtonTypeCallbackGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeCallbackGame wasn't created by NewInlineKeyboardButtonTypeCallbackGame
+func (inlineKeyboardButtonTypeCallbackGame InlineKeyboardButtonTypeCallbackGame) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeCallbackGame
+	inlineKeyboardButtonTypeCallbackGame.tdCommon.Type = "inlineKeyboardButtonTypeCallbackGame"
+	return json.Marshal(alias(inlineKeyboardButtonTypeCallbackGame))
+}
+
 // NewInlineKeyboardButtonTypeCallbackGame creates a new InlineKeyboardButtonTypeCallbackGame
 //
 func NewInlineKeyboardButtonTypeCallbackGame() *InlineKeyboardButtonTypeCallbackGame {
@@ -8130,6 +9373,13 @@ func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline
 	return "inlineKeyboardButtonTypeSwitchInline"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeSwitchInline wasn't created by NewInlineKeyboardButtonTypeSwitchInline
+func (inlineKeyboardButtonTypeSwitchInline InlineKeyboardButtonTypeSwitchInline) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeSwitchInline
+	inlineKeyboardButtonTypeSwitchInline.tdCommon.Type = "inlineKeyboardButtonTypeSwitchInline"
+	return json.Marshal(alias(inlineKeyboardButtonTypeSwitchInline))
+}
+
 // NewInlineKeyboardButtonTypeSwitchInline creates a new InlineKeyboardButtonTypeSwitchInline
 //
 // @param query Inline query to be sent to the bot
@@ -8159,6 +9409,13 @@ func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) MessageType() st
 	return "inlineKeyboardButtonTypeBuy"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButtonTypeBuy wasn't created by NewInlineKeyboardButtonTypeBuy
+func (inlineKeyboardButtonTypeBuy InlineKeyboardButtonTypeBuy) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButtonTypeBuy
+	inlineKeyboardButtonTypeBuy.tdCommon.Type = "inlineKeyboardButtonTypeBuy"
+	return json.Marshal(alias(inlineKeyboardButtonTypeBuy))
+}
+
 // NewInlineKeyboardButtonTypeBuy creates a new InlineKeyboardButtonTypeBuy
 //
 func NewInlineKeyboardButtonTypeBuy() *InlineKeyboardButtonTypeBuy {
@@ -8186,6 +9443,13 @@ func (inlineKeyboardButton *InlineKeyboardButton) MessageType() string {
 	return "inlineKeyboardButton"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineKeyboardButton wasn't created by NewInlineKeyboardButton
+func (inlineKeyboardButton InlineKeyboardButton) MarshalJSON() ([]byte, error) {
+	type alias InlineKeyboardButton
+	inlineKeyboardButton.tdCommon.Type = "inlineKeyboardButton"
+	return json.Marshal(alias(inlineKeyboardButton))
+}
+
 // NewInlineKeyboardButton creates a new InlineKeyboardButton
 //
 // @param text Text of the button
@@ -8237,6 +9501,13 @@ func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) MessageType() string
 	return "replyMarkupRemoveKeyboard"
 }
 
+// MarshalJSON marshals to json, with @type set even if ReplyMarkupRemoveKeyboard wasn't created by NewReplyMarkupRemoveKeyboard
+func (replyMarkupRemoveKeyboard ReplyMarkupRemoveKeyboard) MarshalJSON() ([]byte, error) {
+	type alias ReplyMarkupRemoveKeyboard
+	replyMarkupRemoveKeyboard.tdCommon.Type = "replyMarkupRemoveKeyboard"
+	return json.Marshal(alias(replyMarkupRemoveKeyboard))
+}
+
 // NewReplyMarkupRemoveKeyboard creates a new ReplyMarkupRemoveKeyboard
 //
 // @param isPersonal True, if the keyboard is removed only for the mentioned users or the target user of a reply
@@ -8265,6 +9536,13 @@ func (replyMarkupForceReply *ReplyMarkupForceReply) MessageType() string {
 	return "replyMarkupForceReply"
 }
 
+// MarshalJSON marshals to json, with @type set even if ReplyMarkupForceReply wasn't created by NewReplyMarkupForceReply
+func (replyMarkupForceReply ReplyMarkupForceReply) MarshalJSON() ([]byte, error) {
+	type alias ReplyMarkupForceReply
+	replyMarkupForceReply.tdCommon.Type = "replyMarkupForceReply"
+	return json.Marshal(alias(replyMarkupForceReply))
+}
+
 // NewReplyMarkupForceReply creates a new ReplyMarkupForceReply
 //
 // @param isPersonal True, if a forced reply must automatically be shown to the current user. For outgoing messages, specify true to show the forced reply only for the mentioned users and for the target user of a reply
@@ -8296,6 +9574,13 @@ func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) MessageType() string {
 	return "replyMarkupShowKeyboard"
 }
 
+// MarshalJSON marshals to json, with @type set even if ReplyMarkupShowKeyboard wasn't created by NewReplyMarkupShowKeyboard
+func (replyMarkupShowKeyboard ReplyMarkupShowKeyboard) MarshalJSON() ([]byte, error) {
+	type alias ReplyMarkupShowKeyboard
+	replyMarkupShowKeyboard.tdCommon.Type = "replyMarkupShowKeyboard"
+	return json.Marshal(alias(replyMarkupShowKeyboard))
+}
+
 // NewReplyMarkupShowKeyboard creates a new ReplyMarkupShowKeyboard
 //
 // @param rows A list of rows of bot keyboard buttons
@@ -8330,6 +9615,13 @@ func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) MessageType() string
 	return "replyMarkupInlineKeyboard"
 }
 
+// MarshalJSON marshals to json, with @type set even if ReplyMarkupInlineKeyboard wasn't created by NewReplyMarkupInlineKeyboard
+func (replyMarkupInlineKeyboard ReplyMarkupInlineKeyboard) MarshalJSON() ([]byte, error) {
+	type alias ReplyMarkupInlineKeyboard
+	replyMarkupInlineKeyboard.tdCommon.Type = "replyMarkupInlineKeyboard"
+	return json.Marshal(alias(replyMarkupInlineKeyboard))
+}
+
 // NewReplyMarkupInlineKeyboard creates a new ReplyMarkupInlineKeyboard
 //
 // @param rows A list of rows of inline keyboard buttons
@@ -8359,6 +9651,13 @@ func (loginURLInfoOpen *LoginURLInfoOpen) MessageType() string {
 	return "loginUrlInfoOpen"
 }
 
+// MarshalJSON marshals to json, with @type set even if LoginURLInfoOpen wasn't created by NewLoginURLInfoOpen
+func (loginURLInfoOpen LoginURLInfoOpen) MarshalJSON() ([]byte, error) {
+	type alias LoginURLInfoOpen
+	loginURLInfoOpen.tdCommon.Type = "loginUrlInfoOpen"
+	return json.Marshal(alias(loginURLInfoOpen))
+}
+
 // NewLoginURLInfoOpen creates a new LoginURLInfoOpen
 //
 // @param uRL The URL to open
@@ -8392,6 +9691,13 @@ func (loginURLInfoRequestConfirmation *LoginURLInfoRequestConfirmation) MessageT
 	return "loginUrlInfoRequestConfirmation"
 }
 
+// MarshalJSON marshals to json, with @type set even if LoginURLInfoRequestConfirmation wasn't created by NewLoginURLInfoRequestConfirmation
+func (loginURLInfoRequestConfirmation LoginURLInfoRequestConfirmation) MarshalJSON() ([]byte, error) {
+	type alias LoginURLInfoRequestConfirmation
+	loginURLInfoRequestConfirmation.tdCommon.Type = "loginUrlInfoRequestConfirmation"
+	return json.Marshal(alias(loginURLInfoRequestConfirmation))
+}
+
 // NewLoginURLInfoRequestConfirmation creates a new LoginURLInfoRequestConfirmation
 //
 // @param uRL An HTTP URL to be opened
@@ -8418,8 +9724,8 @@ func (loginURLInfoRequestConfirmation *LoginURLInfoRequestConfirmation) GetLogin
 // MessageThreadInfo Contains information about a message thread
 type MessageThreadInfo struct {
 	tdCommon
-	ChatID          int64             `json:"chat_id"`           // Identifier of the chat to which the message thread belongs
-	MessageThreadID int64             `json:"message_thread_id"` // Message thread identifier, unique within the chat
+	ChatID          JSONInt64         `json:"chat_id"`           // Identifier of the chat to which the message thread belongs
+	MessageThreadID JSONInt64         `json:"message_thread_id"` // Message thread identifier, unique within the chat
 	ReplyInfo       *MessageReplyInfo `json:"reply_info"`        // Contains information about the message thread
 	Messages        []Message         `json:"messages"`          // The messages from which the thread starts. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)
 	DraftMessage    *DraftMessage     `json:"draft_message"`     // A draft of a message in the message thread; may be null
@@ -8430,6 +9736,13 @@ func (messageThreadInfo *MessageThreadInfo) MessageType() string {
 	return "messageThreadInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageThreadInfo wasn't created by NewMessageThreadInfo
+func (messageThreadInfo MessageThreadInfo) MarshalJSON() ([]byte, error) {
+	type alias MessageThreadInfo
+	messageThreadInfo.tdCommon.Type = "messageThreadInfo"
+	return json.Marshal(alias(messageThreadInfo))
+}
+
 // NewMessageThreadInfo creates a new MessageThreadInfo
 //
 // @param chatID Identifier of the chat to which the message thread belongs
@@ -8437,7 +9750,7 @@ func (messageThreadInfo *MessageThreadInfo) MessageType() string {
 // @param replyInfo Contains information about the message thread
 // @param messages The messages from which the thread starts. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)
 // @param draftMessage A draft of a message in the message thread; may be null
-func NewMessageThreadInfo(chatID int64, messageThreadID int64, replyInfo *MessageReplyInfo, messages []Message, draftMessage *DraftMessage) *MessageThreadInfo {
+func NewMessageThreadInfo(chatID JSONInt64, messageThreadID JSONInt64, replyInfo *MessageReplyInfo, messages []Message, draftMessage *DraftMessage) *MessageThreadInfo {
 	messageThreadInfoTemp := MessageThreadInfo{
 		tdCommon:        tdCommon{Type: "messageThreadInfo"},
 		ChatID:          chatID,
@@ -8461,6 +9774,13 @@ func (richTextPlain *RichTextPlain) MessageType() string {
 	return "richTextPlain"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextPlain wasn't created by NewRichTextPlain
+func (richTextPlain RichTextPlain) MarshalJSON() ([]byte, error) {
+	type alias RichTextPlain
+	richTextPlain.tdCommon.Type = "richTextPlain"
+	return json.Marshal(alias(richTextPlain))
+}
+
 // NewRichTextPlain creates a new RichTextPlain
 //
 // @param text Text
@@ -8489,6 +9809,13 @@ func (richTextBold *RichTextBold) MessageType() string {
 	return "richTextBold"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextBold wasn't created by NewRichTextBold
+func (richTextBold RichTextBold) MarshalJSON() ([]byte, error) {
+	type alias RichTextBold
+	richTextBold.tdCommon.Type = "richTextBold"
+	return json.Marshal(alias(richTextBold))
+}
+
 // NewRichTextBold creates a new RichTextBold
 //
 // @param text Text
@@ -8540,6 +9867,13 @@ func (richTextItalic *RichTextItalic) MessageType() string {
 	return "richTextItalic"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextItalic wasn't created by NewRichTextItalic
+func (richTextItalic RichTextItalic) MarshalJSON() ([]byte, error) {
+	type alias RichTextItalic
+	richTextItalic.tdCommon.Type = "richTextItalic"
+	return json.Marshal(alias(richTextItalic))
+}
+
 // NewRichTextItalic creates a new RichTextItalic
 //
 // @param text Text
@@ -8591,6 +9925,13 @@ func (richTextUnderline *RichTextUnderline) MessageType() string {
 	return "richTextUnderline"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextUnderline wasn't created by NewRichTextUnderline
+func (richTextUnderline RichTextUnderline) MarshalJSON() ([]byte, error) {
+	type alias RichTextUnderline
+	richTextUnderline.tdCommon.Type = "richTextUnderline"
+	return json.Marshal(alias(richTextUnderline))
+}
+
 // NewRichTextUnderline creates a new RichTextUnderline
 //
 // @param text Text
@@ -8642,6 +9983,13 @@ func (richTextStrikethrough *RichTextStrikethrough) MessageType() string {
 	return "richTextStrikethrough"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextStrikethrough wasn't created by NewRichTextStrikethrough
+func (richTextStrikethrough RichTextStrikethrough) MarshalJSON() ([]byte, error) {
+	type alias RichTextStrikethrough
+	richTextStrikethrough.tdCommon.Type = "richTextStrikethrough"
+	return json.Marshal(alias(richTextStrikethrough))
+}
+
 // NewRichTextStrikethrough creates a new RichTextStrikethrough
 //
 // @param text Text
@@ -8693,6 +10041,13 @@ func (richTextFixed *RichTextFixed) MessageType() string {
 	return "richTextFixed"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextFixed wasn't created by NewRichTextFixed
+func (richTextFixed RichTextFixed) MarshalJSON() ([]byte, error) {
+	type alias RichTextFixed
+	richTextFixed.tdCommon.Type = "richTextFixed"
+	return json.Marshal(alias(richTextFixed))
+}
+
 // NewRichTextFixed creates a new RichTextFixed
 //
 // @param text Text
@@ -8746,6 +10101,13 @@ func (richTextURL *RichTextURL) MessageType() string {
 	return "richTextUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextURL wasn't created by NewRichTextURL
+func (richTextURL RichTextURL) MarshalJSON() ([]byte, error) {
+	type alias RichTextURL
+	richTextURL.tdCommon.Type = "richTextUrl"
+	return json.Marshal(alias(richTextURL))
+}
+
 // NewRichTextURL creates a new RichTextURL
 //
 // @param text Text
@@ -8806,6 +10168,13 @@ func (richTextEmailAddress *RichTextEmailAddress) MessageType() string {
 	return "richTextEmailAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextEmailAddress wasn't created by NewRichTextEmailAddress
+func (richTextEmailAddress RichTextEmailAddress) MarshalJSON() ([]byte, error) {
+	type alias RichTextEmailAddress
+	richTextEmailAddress.tdCommon.Type = "richTextEmailAddress"
+	return json.Marshal(alias(richTextEmailAddress))
+}
+
 // NewRichTextEmailAddress creates a new RichTextEmailAddress
 //
 // @param text Text
@@ -8861,6 +10230,13 @@ func (richTextSubscript *RichTextSubscript) MessageType() string {
 	return "richTextSubscript"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextSubscript wasn't created by NewRichTextSubscript
+func (richTextSubscript RichTextSubscript) MarshalJSON() ([]byte, error) {
+	type alias RichTextSubscript
+	richTextSubscript.tdCommon.Type = "richTextSubscript"
+	return json.Marshal(alias(richTextSubscript))
+}
+
 // NewRichTextSubscript creates a new RichTextSubscript
 //
 // @param text Text
@@ -8912,6 +10288,13 @@ func (richTextSuperscript *RichTextSuperscript) MessageType() string {
 	return "richTextSuperscript"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextSuperscript wasn't created by NewRichTextSuperscript
+func (richTextSuperscript RichTextSuperscript) MarshalJSON() ([]byte, error) {
+	type alias RichTextSuperscript
+	richTextSuperscript.tdCommon.Type = "richTextSuperscript"
+	return json.Marshal(alias(richTextSuperscript))
+}
+
 // NewRichTextSuperscript creates a new RichTextSuperscript
 //
 // @param text Text
@@ -8963,6 +10346,13 @@ func (richTextMarked *RichTextMarked) MessageType() string {
 	return "richTextMarked"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextMarked wasn't created by NewRichTextMarked
+func (richTextMarked RichTextMarked) MarshalJSON() ([]byte, error) {
+	type alias RichTextMarked
+	richTextMarked.tdCommon.Type = "richTextMarked"
+	return json.Marshal(alias(richTextMarked))
+}
+
 // NewRichTextMarked creates a new RichTextMarked
 //
 // @param text Text
@@ -9015,6 +10405,13 @@ func (richTextPhoneNumber *RichTextPhoneNumber) MessageType() string {
 	return "richTextPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextPhoneNumber wasn't created by NewRichTextPhoneNumber
+func (richTextPhoneNumber RichTextPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias RichTextPhoneNumber
+	richTextPhoneNumber.tdCommon.Type = "richTextPhoneNumber"
+	return json.Marshal(alias(richTextPhoneNumber))
+}
+
 // NewRichTextPhoneNumber creates a new RichTextPhoneNumber
 //
 // @param text Text
@@ -9072,6 +10469,13 @@ func (richTextIcon *RichTextIcon) MessageType() string {
 	return "richTextIcon"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextIcon wasn't created by NewRichTextIcon
+func (richTextIcon RichTextIcon) MarshalJSON() ([]byte, error) {
+	type alias RichTextIcon
+	richTextIcon.tdCommon.Type = "richTextIcon"
+	return json.Marshal(alias(richTextIcon))
+}
+
 // NewRichTextIcon creates a new RichTextIcon
 //
 // @param document The image represented as a document. The image can be in GIF, JPEG or PNG format
@@ -9106,6 +10510,13 @@ func (richTextReference *RichTextReference) MessageType() string {
 	return "richTextReference"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextReference wasn't created by NewRichTextReference
+func (richTextReference RichTextReference) MarshalJSON() ([]byte, error) {
+	type alias RichTextReference
+	richTextReference.tdCommon.Type = "richTextReference"
+	return json.Marshal(alias(richTextReference))
+}
+
 // NewRichTextReference creates a new RichTextReference
 //
 // @param text The text
@@ -9165,6 +10576,13 @@ func (richTextAnchor *RichTextAnchor) MessageType() string {
 	return "richTextAnchor"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextAnchor wasn't created by NewRichTextAnchor
+func (richTextAnchor RichTextAnchor) MarshalJSON() ([]byte, error) {
+	type alias RichTextAnchor
+	richTextAnchor.tdCommon.Type = "richTextAnchor"
+	return json.Marshal(alias(richTextAnchor))
+}
+
 // NewRichTextAnchor creates a new RichTextAnchor
 //
 // @param name Anchor name
@@ -9195,6 +10613,13 @@ func (richTextAnchorLink *RichTextAnchorLink) MessageType() string {
 	return "richTextAnchorLink"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTextAnchorLink wasn't created by NewRichTextAnchorLink
+func (richTextAnchorLink RichTextAnchorLink) MarshalJSON() ([]byte, error) {
+	type alias RichTextAnchorLink
+	richTextAnchorLink.tdCommon.Type = "richTextAnchorLink"
+	return json.Marshal(alias(richTextAnchorLink))
+}
+
 // NewRichTextAnchorLink creates a new RichTextAnchorLink
 //
 // @param text The link text
@@ -9254,6 +10679,13 @@ func (richTexts *RichTexts) MessageType() string {
 	return "richTexts"
 }
 
+// MarshalJSON marshals to json, with @type set even if RichTexts wasn't created by NewRichTexts
+func (richTexts RichTexts) MarshalJSON() ([]byte, error) {
+	type alias RichTexts
+	richTexts.tdCommon.Type = "richTexts"
+	return json.Marshal(alias(richTexts))
+}
+
 // NewRichTexts creates a new RichTexts
 //
 // @param texts Texts
@@ -9283,6 +10715,13 @@ func (pageBlockCaption *PageBlockCaption) MessageType() string {
 	return "pageBlockCaption"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockCaption wasn't created by NewPageBlockCaption
+func (pageBlockCaption PageBlockCaption) MarshalJSON() ([]byte, error) {
+	type alias PageBlockCaption
+	pageBlockCaption.tdCommon.Type = "pageBlockCaption"
+	return json.Marshal(alias(pageBlockCaption))
+}
+
 // NewPageBlockCaption creates a new PageBlockCaption
 //
 // @param text Content of the caption
@@ -9335,6 +10774,13 @@ func (pageBlockListItem *PageBlockListItem) MessageType() string {
 	return "pageBlockListItem"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockListItem wasn't created by NewPageBlockListItem
+func (pageBlockListItem PageBlockListItem) MarshalJSON() ([]byte, error) {
+	type alias PageBlockListItem
+	pageBlockListItem.tdCommon.Type = "pageBlockListItem"
+	return json.Marshal(alias(pageBlockListItem))
+}
+
 // NewPageBlockListItem creates a new PageBlockListItem
 //
 // @param label Item label
@@ -9359,6 +10805,13 @@ func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) Messag
 	return "pageBlockHorizontalAlignmentLeft"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockHorizontalAlignmentLeft wasn't created by NewPageBlockHorizontalAlignmentLeft
+func (pageBlockHorizontalAlignmentLeft PageBlockHorizontalAlignmentLeft) MarshalJSON() ([]byte, error) {
+	type alias PageBlockHorizontalAlignmentLeft
+	pageBlockHorizontalAlignmentLeft.tdCommon.Type = "pageBlockHorizontalAlignmentLeft"
+	return json.Marshal(alias(pageBlockHorizontalAlignmentLeft))
+}
+
 // NewPageBlockHorizontalAlignmentLeft creates a new PageBlockHorizontalAlignmentLeft
 //
 func NewPageBlockHorizontalAlignmentLeft() *PageBlockHorizontalAlignmentLeft {
@@ -9384,6 +10837,13 @@ func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) Me
 	return "pageBlockHorizontalAlignmentCenter"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockHorizontalAlignmentCenter wasn't created by NewPageBlockHorizontalAlignmentCenter
+func (pageBlockHorizontalAlignmentCenter PageBlockHorizontalAlignmentCenter) MarshalJSON() ([]byte, error) {
+	type alias PageBlockHorizontalAlignmentCenter
+	pageBlockHorizontalAlignmentCenter.tdCommon.Type = "pageBlockHorizontalAlignmentCenter"
+	return json.Marshal(alias(pageBlockHorizontalAlignmentCenter))
+}
+
 // NewPageBlockHorizontalAlignmentCenter creates a new PageBlockHorizontalAlignmentCenter
 //
 func NewPageBlockHorizontalAlignmentCenter() *PageBlockHorizontalAlignmentCenter {
@@ -9409,6 +10869,13 @@ func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) Mess
 	return "pageBlockHorizontalAlignmentRight"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockHorizontalAlignmentRight wasn't created by NewPageBlockHorizontalAlignmentRight
+func (pageBlockHorizontalAlignmentRight PageBlockHorizontalAlignmentRight) MarshalJSON() ([]byte, error) {
+	type alias PageBlockHorizontalAlignmentRight
+	pageBlockHorizontalAlignmentRight.tdCommon.Type = "pageBlockHorizontalAlignmentRight"
+	return json.Marshal(alias(pageBlockHorizontalAlignmentRight))
+}
+
 // NewPageBlockHorizontalAlignmentRight creates a new PageBlockHorizontalAlignmentRight
 //
 func NewPageBlockHorizontalAlignmentRight() *PageBlockHorizontalAlignmentRight {
@@ -9434,6 +10901,13 @@ func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) MessageType(
 	return "pageBlockVerticalAlignmentTop"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockVerticalAlignmentTop wasn't created by NewPageBlockVerticalAlignmentTop
+func (pageBlockVerticalAlignmentTop PageBlockVerticalAlignmentTop) MarshalJSON() ([]byte, error) {
+	type alias PageBlockVerticalAlignmentTop
+	pageBlockVerticalAlignmentTop.tdCommon.Type = "pageBlockVerticalAlignmentTop"
+	return json.Marshal(alias(pageBlockVerticalAlignmentTop))
+}
+
 // NewPageBlockVerticalAlignmentTop creates a new PageBlockVerticalAlignmentTop
 //
 func NewPageBlockVerticalAlignmentTop() *PageBlockVerticalAlignmentTop {
@@ -9459,6 +10933,13 @@ func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) Messag
 	return "pageBlockVerticalAlignmentMiddle"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockVerticalAlignmentMiddle wasn't created by NewPageBlockVerticalAlignmentMiddle
+func (pageBlockVerticalAlignmentMiddle PageBlockVerticalAlignmentMiddle) MarshalJSON() ([]byte, error) {
+	type alias PageBlockVerticalAlignmentMiddle
+	pageBlockVerticalAlignmentMiddle.tdCommon.Type = "pageBlockVerticalAlignmentMiddle"
+	return json.Marshal(alias(pageBlockVerticalAlignmentMiddle))
+}
+
 // NewPageBlockVerticalAlignmentMiddle creates a new PageBlockVerticalAlignmentMiddle
 //
 func NewPageBlockVerticalAlignmentMiddle() *PageBlockVerticalAlignmentMiddle {
@@ -9484,6 +10965,13 @@ func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) Messag
 	return "pageBlockVerticalAlignmentBottom"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockVerticalAlignmentBottom wasn't created by NewPageBlockVerticalAlignmentBottom
+func (pageBlockVerticalAlignmentBottom PageBlockVerticalAlignmentBottom) MarshalJSON() ([]byte, error) {
+	type alias PageBlockVerticalAlignmentBottom
+	pageBlockVerticalAlignmentBottom.tdCommon.Type = "pageBlockVerticalAlignmentBottom"
+	return json.Marshal(alias(pageBlockVerticalAlignmentBottom))
+}
+
 // NewPageBlockVerticalAlignmentBottom creates a new PageBlockVerticalAlignmentBottom
 //
 func NewPageBlockVerticalAlignmentBottom() *PageBlockVerticalAlignmentBottom {
@@ -9515,6 +11003,13 @@ func (pageBlockTableCell *PageBlockTableCell) MessageType() string {
 	return "pageBlockTableCell"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockTableCell wasn't created by NewPageBlockTableCell
+func (pageBlockTableCell PageBlockTableCell) MarshalJSON() ([]byte, error) {
+	type alias PageBlockTableCell
+	pageBlockTableCell.tdCommon.Type = "pageBlockTableCell"
+	return json.Marshal(alias(pageBlockTableCell))
+}
+
 // NewPageBlockTableCell creates a new PageBlockTableCell
 //
 // @param text Cell text; may be null. If the text is null, then the cell should be invisible
@@ -9589,6 +11084,13 @@ func (pageBlockRelatedArticle *PageBlockRelatedArticle) MessageType() string {
 	return "pageBlockRelatedArticle"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockRelatedArticle wasn't created by NewPageBlockRelatedArticle
+func (pageBlockRelatedArticle PageBlockRelatedArticle) MarshalJSON() ([]byte, error) {
+	type alias PageBlockRelatedArticle
+	pageBlockRelatedArticle.tdCommon.Type = "pageBlockRelatedArticle"
+	return json.Marshal(alias(pageBlockRelatedArticle))
+}
+
 // NewPageBlockRelatedArticle creates a new PageBlockRelatedArticle
 //
 // @param uRL Related article URL
@@ -9622,6 +11124,13 @@ func (pageBlockTitle *PageBlockTitle) MessageType() string {
 	return "pageBlockTitle"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockTitle wasn't created by NewPageBlockTitle
+func (pageBlockTitle PageBlockTitle) MarshalJSON() ([]byte, error) {
+	type alias PageBlockTitle
+	pageBlockTitle.tdCommon.Type = "pageBlockTitle"
+	return json.Marshal(alias(pageBlockTitle))
+}
+
 // NewPageBlockTitle creates a new PageBlockTitle
 //
 // @param title Title
@@ -9673,6 +11182,13 @@ func (pageBlockSubtitle *PageBlockSubtitle) MessageType() string {
 	return "pageBlockSubtitle"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockSubtitle wasn't created by NewPageBlockSubtitle
+func (pageBlockSubtitle PageBlockSubtitle) MarshalJSON() ([]byte, error) {
+	type alias PageBlockSubtitle
+	pageBlockSubtitle.tdCommon.Type = "pageBlockSubtitle"
+	return json.Marshal(alias(pageBlockSubtitle))
+}
+
 // NewPageBlockSubtitle creates a new PageBlockSubtitle
 //
 // @param subtitle Subtitle
@@ -9725,6 +11241,13 @@ func (pageBlockAuthorDate *PageBlockAuthorDate) MessageType() string {
 	return "pageBlockAuthorDate"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockAuthorDate wasn't created by NewPageBlockAuthorDate
+func (pageBlockAuthorDate PageBlockAuthorDate) MarshalJSON() ([]byte, error) {
+	type alias PageBlockAuthorDate
+	pageBlockAuthorDate.tdCommon.Type = "pageBlockAuthorDate"
+	return json.Marshal(alias(pageBlockAuthorDate))
+}
+
 // NewPageBlockAuthorDate creates a new PageBlockAuthorDate
 //
 // @param author Author
@@ -9780,6 +11303,13 @@ func (pageBlockHeader *PageBlockHeader) MessageType() string {
 	return "pageBlockHeader"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockHeader wasn't created by NewPageBlockHeader
+func (pageBlockHeader PageBlockHeader) MarshalJSON() ([]byte, error) {
+	type alias PageBlockHeader
+	pageBlockHeader.tdCommon.Type = "pageBlockHeader"
+	return json.Marshal(alias(pageBlockHeader))
+}
+
 // NewPageBlockHeader creates a new PageBlockHeader
 //
 // @param header Header
@@ -9831,6 +11361,13 @@ func (pageBlockSubheader *PageBlockSubheader) MessageType() string {
 	return "pageBlockSubheader"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockSubheader wasn't created by NewPageBlockSubheader
+func (pageBlockSubheader PageBlockSubheader) MarshalJSON() ([]byte, error) {
+	type alias PageBlockSubheader
+	pageBlockSubheader.tdCommon.Type = "pageBlockSubheader"
+	return json.Marshal(alias(pageBlockSubheader))
+}
+
 // NewPageBlockSubheader creates a new PageBlockSubheader
 //
 // @param subheader Subheader
@@ -9882,6 +11419,13 @@ func (pageBlockKicker *PageBlockKicker) MessageType() string {
 	return "pageBlockKicker"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockKicker wasn't created by NewPageBlockKicker
+func (pageBlockKicker PageBlockKicker) MarshalJSON() ([]byte, error) {
+	type alias PageBlockKicker
+	pageBlockKicker.tdCommon.Type = "pageBlockKicker"
+	return json.Marshal(alias(pageBlockKicker))
+}
+
 // NewPageBlockKicker creates a new PageBlockKicker
 //
 // @param kicker Kicker
@@ -9933,6 +11477,13 @@ func (pageBlockParagraph *PageBlockParagraph) MessageType() string {
 	return "pageBlockParagraph"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockParagraph wasn't created by NewPageBlockParagraph
+func (pageBlockParagraph PageBlockParagraph) MarshalJSON() ([]byte, error) {
+	type alias PageBlockParagraph
+	pageBlockParagraph.tdCommon.Type = "pageBlockParagraph"
+	return json.Marshal(alias(pageBlockParagraph))
+}
+
 // NewPageBlockParagraph creates a new PageBlockParagraph
 //
 // @param text Paragraph text
@@ -9985,6 +11536,13 @@ func (pageBlockPreformatted *PageBlockPreformatted) MessageType() string {
 	return "pageBlockPreformatted"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockPreformatted wasn't created by NewPageBlockPreformatted
+func (pageBlockPreformatted PageBlockPreformatted) MarshalJSON() ([]byte, error) {
+	type alias PageBlockPreformatted
+	pageBlockPreformatted.tdCommon.Type = "pageBlockPreformatted"
+	return json.Marshal(alias(pageBlockPreformatted))
+}
+
 // NewPageBlockPreformatted creates a new PageBlockPreformatted
 //
 // @param text Paragraph text
@@ -10040,6 +11598,13 @@ func (pageBlockFooter *PageBlockFooter) MessageType() string {
 	return "pageBlockFooter"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockFooter wasn't created by NewPageBlockFooter
+func (pageBlockFooter PageBlockFooter) MarshalJSON() ([]byte, error) {
+	type alias PageBlockFooter
+	pageBlockFooter.tdCommon.Type = "pageBlockFooter"
+	return json.Marshal(alias(pageBlockFooter))
+}
+
 // NewPageBlockFooter creates a new PageBlockFooter
 //
 // @param footer Footer
@@ -10090,6 +11655,13 @@ func (pageBlockDivider *PageBlockDivider) MessageType() string {
 	return "pageBlockDivider"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockDivider wasn't created by NewPageBlockDivider
+func (pageBlockDivider PageBlockDivider) MarshalJSON() ([]byte, error) {
+	type alias PageBlockDivider
+	pageBlockDivider.tdCommon.Type = "pageBlockDivider"
+	return json.Marshal(alias(pageBlockDivider))
+}
+
 // NewPageBlockDivider creates a new PageBlockDivider
 //
 func NewPageBlockDivider() *PageBlockDivider {
@@ -10116,6 +11688,13 @@ func (pageBlockAnchor *PageBlockAnchor) MessageType() string {
 	return "pageBlockAnchor"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockAnchor wasn't created by NewPageBlockAnchor
+func (pageBlockAnchor PageBlockAnchor) MarshalJSON() ([]byte, error) {
+	type alias PageBlockAnchor
+	pageBlockAnchor.tdCommon.Type = "pageBlockAnchor"
+	return json.Marshal(alias(pageBlockAnchor))
+}
+
 // NewPageBlockAnchor creates a new PageBlockAnchor
 //
 // @param name Name of the anchor
@@ -10144,6 +11723,13 @@ func (pageBlockList *PageBlockList) MessageType() string {
 	return "pageBlockList"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockList wasn't created by NewPageBlockList
+func (pageBlockList PageBlockList) MarshalJSON() ([]byte, error) {
+	type alias PageBlockList
+	pageBlockList.tdCommon.Type = "pageBlockList"
+	return json.Marshal(alias(pageBlockList))
+}
+
 // NewPageBlockList creates a new PageBlockList
 //
 // @param items The items of the list
@@ -10173,6 +11759,13 @@ func (pageBlockBlockQuote *PageBlockBlockQuote) MessageType() string {
 	return "pageBlockBlockQuote"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockBlockQuote wasn't created by NewPageBlockBlockQuote
+func (pageBlockBlockQuote PageBlockBlockQuote) MarshalJSON() ([]byte, error) {
+	type alias PageBlockBlockQuote
+	pageBlockBlockQuote.tdCommon.Type = "pageBlockBlockQuote"
+	return json.Marshal(alias(pageBlockBlockQuote))
+}
+
 // NewPageBlockBlockQuote creates a new PageBlockBlockQuote
 //
 // @param text Quote text
@@ -10230,6 +11823,13 @@ func (pageBlockPullQuote *PageBlockPullQuote) MessageType() string {
 	return "pageBlockPullQuote"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockPullQuote wasn't created by NewPageBlockPullQuote
+func (pageBlockPullQuote PageBlockPullQuote) MarshalJSON() ([]byte, error) {
+	type alias PageBlockPullQuote
+	pageBlockPullQuote.tdCommon.Type = "pageBlockPullQuote"
+	return json.Marshal(alias(pageBlockPullQuote))
+}
+
 // NewPageBlockPullQuote creates a new PageBlockPullQuote
 //
 // @param text Quote text
@@ -10288,6 +11888,13 @@ func (pageBlockAnimation *PageBlockAnimation) MessageType() string {
 	return "pageBlockAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockAnimation wasn't created by NewPageBlockAnimation
+func (pageBlockAnimation PageBlockAnimation) MarshalJSON() ([]byte, error) {
+	type alias PageBlockAnimation
+	pageBlockAnimation.tdCommon.Type = "pageBlockAnimation"
+	return json.Marshal(alias(pageBlockAnimation))
+}
+
 // NewPageBlockAnimation creates a new PageBlockAnimation
 //
 // @param animation Animation file; may be null
@@ -10321,6 +11928,13 @@ func (pageBlockAudio *PageBlockAudio) MessageType() string {
 	return "pageBlockAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockAudio wasn't created by NewPageBlockAudio
+func (pageBlockAudio PageBlockAudio) MarshalJSON() ([]byte, error) {
+	type alias PageBlockAudio
+	pageBlockAudio.tdCommon.Type = "pageBlockAudio"
+	return json.Marshal(alias(pageBlockAudio))
+}
+
 // NewPageBlockAudio creates a new PageBlockAudio
 //
 // @param audio Audio file; may be null
@@ -10353,6 +11967,13 @@ func (pageBlockPhoto *PageBlockPhoto) MessageType() string {
 	return "pageBlockPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockPhoto wasn't created by NewPageBlockPhoto
+func (pageBlockPhoto PageBlockPhoto) MarshalJSON() ([]byte, error) {
+	type alias PageBlockPhoto
+	pageBlockPhoto.tdCommon.Type = "pageBlockPhoto"
+	return json.Marshal(alias(pageBlockPhoto))
+}
+
 // NewPageBlockPhoto creates a new PageBlockPhoto
 //
 // @param photo Photo file; may be null
@@ -10388,6 +12009,13 @@ func (pageBlockVideo *PageBlockVideo) MessageType() string {
 	return "pageBlockVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockVideo wasn't created by NewPageBlockVideo
+func (pageBlockVideo PageBlockVideo) MarshalJSON() ([]byte, error) {
+	type alias PageBlockVideo
+	pageBlockVideo.tdCommon.Type = "pageBlockVideo"
+	return json.Marshal(alias(pageBlockVideo))
+}
+
 // NewPageBlockVideo creates a new PageBlockVideo
 //
 // @param video Video file; may be null
@@ -10423,6 +12051,13 @@ func (pageBlockVoiceNote *PageBlockVoiceNote) MessageType() string {
 	return "pageBlockVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockVoiceNote wasn't created by NewPageBlockVoiceNote
+func (pageBlockVoiceNote PageBlockVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias PageBlockVoiceNote
+	pageBlockVoiceNote.tdCommon.Type = "pageBlockVoiceNote"
+	return json.Marshal(alias(pageBlockVoiceNote))
+}
+
 // NewPageBlockVoiceNote creates a new PageBlockVoiceNote
 //
 // @param voiceNote Voice note; may be null
@@ -10453,6 +12088,13 @@ func (pageBlockCover *PageBlockCover) MessageType() string {
 	return "pageBlockCover"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockCover wasn't created by NewPageBlockCover
+func (pageBlockCover PageBlockCover) MarshalJSON() ([]byte, error) {
+	type alias PageBlockCover
+	pageBlockCover.tdCommon.Type = "pageBlockCover"
+	return json.Marshal(alias(pageBlockCover))
+}
+
 // NewPageBlockCover creates a new PageBlockCover
 //
 // @param cover Cover
@@ -10511,6 +12153,13 @@ func (pageBlockEmbedded *PageBlockEmbedded) MessageType() string {
 	return "pageBlockEmbedded"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockEmbedded wasn't created by NewPageBlockEmbedded
+func (pageBlockEmbedded PageBlockEmbedded) MarshalJSON() ([]byte, error) {
+	type alias PageBlockEmbedded
+	pageBlockEmbedded.tdCommon.Type = "pageBlockEmbedded"
+	return json.Marshal(alias(pageBlockEmbedded))
+}
+
 // NewPageBlockEmbedded creates a new PageBlockEmbedded
 //
 // @param uRL Web page URL, if available
@@ -10558,6 +12207,13 @@ func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) MessageType() string {
 	return "pageBlockEmbeddedPost"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockEmbeddedPost wasn't created by NewPageBlockEmbeddedPost
+func (pageBlockEmbeddedPost PageBlockEmbeddedPost) MarshalJSON() ([]byte, error) {
+	type alias PageBlockEmbeddedPost
+	pageBlockEmbeddedPost.tdCommon.Type = "pageBlockEmbeddedPost"
+	return json.Marshal(alias(pageBlockEmbeddedPost))
+}
+
 // NewPageBlockEmbeddedPost creates a new PageBlockEmbeddedPost
 //
 // @param uRL Web page URL
@@ -10597,6 +12253,13 @@ func (pageBlockCollage *PageBlockCollage) MessageType() string {
 	return "pageBlockCollage"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockCollage wasn't created by NewPageBlockCollage
+func (pageBlockCollage PageBlockCollage) MarshalJSON() ([]byte, error) {
+	type alias PageBlockCollage
+	pageBlockCollage.tdCommon.Type = "pageBlockCollage"
+	return json.Marshal(alias(pageBlockCollage))
+}
+
 // NewPageBlockCollage creates a new PageBlockCollage
 //
 // @param pageBlocks Collage item contents
@@ -10628,6 +12291,13 @@ func (pageBlockSlideshow *PageBlockSlideshow) MessageType() string {
 	return "pageBlockSlideshow"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockSlideshow wasn't created by NewPageBlockSlideshow
+func (pageBlockSlideshow PageBlockSlideshow) MarshalJSON() ([]byte, error) {
+	type alias PageBlockSlideshow
+	pageBlockSlideshow.tdCommon.Type = "pageBlockSlideshow"
+	return json.Marshal(alias(pageBlockSlideshow))
+}
+
 // NewPageBlockSlideshow creates a new PageBlockSlideshow
 //
 // @param pageBlocks Slideshow item contents
@@ -10660,6 +12330,13 @@ func (pageBlockChatLink *PageBlockChatLink) MessageType() string {
 	return "pageBlockChatLink"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockChatLink wasn't created by NewPageBlockChatLink
+func (pageBlockChatLink PageBlockChatLink) MarshalJSON() ([]byte, error) {
+	type alias PageBlockChatLink
+	pageBlockChatLink.tdCommon.Type = "pageBlockChatLink"
+	return json.Marshal(alias(pageBlockChatLink))
+}
+
 // NewPageBlockChatLink creates a new PageBlockChatLink
 //
 // @param title Chat title
@@ -10695,6 +12372,13 @@ func (pageBlockTable *PageBlockTable) MessageType() string {
 	return "pageBlockTable"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockTable wasn't created by NewPageBlockTable
+func (pageBlockTable PageBlockTable) MarshalJSON() ([]byte, error) {
+	type alias PageBlockTable
+	pageBlockTable.tdCommon.Type = "pageBlockTable"
+	return json.Marshal(alias(pageBlockTable))
+}
+
 // NewPageBlockTable creates a new PageBlockTable
 //
 // @param caption Table caption
@@ -10760,6 +12444,13 @@ func (pageBlockDetails *PageBlockDetails) MessageType() string {
 	return "pageBlockDetails"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockDetails wasn't created by NewPageBlockDetails
+func (pageBlockDetails PageBlockDetails) MarshalJSON() ([]byte, error) {
+	type alias PageBlockDetails
+	pageBlockDetails.tdCommon.Type = "pageBlockDetails"
+	return json.Marshal(alias(pageBlockDetails))
+}
+
 // NewPageBlockDetails creates a new PageBlockDetails
 //
 // @param header Always visible heading for the block
@@ -10820,6 +12511,13 @@ func (pageBlockRelatedArticles *PageBlockRelatedArticles) MessageType() string {
 	return "pageBlockRelatedArticles"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockRelatedArticles wasn't created by NewPageBlockRelatedArticles
+func (pageBlockRelatedArticles PageBlockRelatedArticles) MarshalJSON() ([]byte, error) {
+	type alias PageBlockRelatedArticles
+	pageBlockRelatedArticles.tdCommon.Type = "pageBlockRelatedArticles"
+	return json.Marshal(alias(pageBlockRelatedArticles))
+}
+
 // NewPageBlockRelatedArticles creates a new PageBlockRelatedArticles
 //
 // @param header Block header
@@ -10879,6 +12577,13 @@ func (pageBlockMap *PageBlockMap) MessageType() string {
 	return "pageBlockMap"
 }
 
+// MarshalJSON marshals to json, with @type set even if PageBlockMap wasn't created by NewPageBlockMap
+func (pageBlockMap PageBlockMap) MarshalJSON() ([]byte, error) {
+	type alias PageBlockMap
+	pageBlockMap.tdCommon.Type = "pageBlockMap"
+	return json.Marshal(alias(pageBlockMap))
+}
+
 // NewPageBlockMap creates a new PageBlockMap
 //
 // @param location Location of the map center
@@ -10919,6 +12624,13 @@ func (webPageInstantView *WebPageInstantView) MessageType() string {
 	return "webPageInstantView"
 }
 
+// MarshalJSON marshals to json, with @type set even if WebPageInstantView wasn't created by NewWebPageInstantView
+func (webPageInstantView WebPageInstantView) MarshalJSON() ([]byte, error) {
+	type alias WebPageInstantView
+	webPageInstantView.tdCommon.Type = "webPageInstantView"
+	return json.Marshal(alias(webPageInstantView))
+}
+
 // NewWebPageInstantView creates a new WebPageInstantView
 //
 // @param pageBlocks Content of the web page
@@ -10970,6 +12682,13 @@ func (webPage *WebPage) MessageType() string {
 	return "webPage"
 }
 
+// MarshalJSON marshals to json, with @type set even if WebPage wasn't created by NewWebPage
+func (webPage WebPage) MarshalJSON() ([]byte, error) {
+	type alias WebPage
+	webPage.tdCommon.Type = "webPage"
+	return json.Marshal(alias(webPage))
+}
+
 // NewWebPage creates a new WebPage
 //
 // @param uRL Original URL of the link
@@ -11037,6 +12756,13 @@ func (countryInfo *CountryInfo) MessageType() string {
 	return "countryInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if CountryInfo wasn't created by NewCountryInfo
+func (countryInfo CountryInfo) MarshalJSON() ([]byte, error) {
+	type alias CountryInfo
+	countryInfo.tdCommon.Type = "countryInfo"
+	return json.Marshal(alias(countryInfo))
+}
+
 // NewCountryInfo creates a new CountryInfo
 //
 // @param countryCode A two-letter ISO 3166-1 alpha-2 country code
@@ -11068,6 +12794,13 @@ func (countries *Countries) MessageType() string {
 	return "countries"
 }
 
+// MarshalJSON marshals to json, with @type set even if Countries wasn't created by NewCountries
+func (countries Countries) MarshalJSON() ([]byte, error) {
+	type alias Countries
+	countries.tdCommon.Type = "countries"
+	return json.Marshal(alias(countries))
+}
+
 // NewCountries creates a new Countries
 //
 // @param countries The list of countries
@@ -11093,6 +12826,13 @@ func (phoneNumberInfo *PhoneNumberInfo) MessageType() string {
 	return "phoneNumberInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if PhoneNumberInfo wasn't created by NewPhoneNumberInfo
+func (phoneNumberInfo PhoneNumberInfo) MarshalJSON() ([]byte, error) {
+	type alias PhoneNumberInfo
+	phoneNumberInfo.tdCommon.Type = "phoneNumberInfo"
+	return json.Marshal(alias(phoneNumberInfo))
+}
+
 // NewPhoneNumberInfo creates a new PhoneNumberInfo
 //
 // @param country Information about the country to which the phone number belongs; may be null
@@ -11121,6 +12861,13 @@ func (bankCardActionOpenURL *BankCardActionOpenURL) MessageType() string {
 	return "bankCardActionOpenUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if BankCardActionOpenURL wasn't created by NewBankCardActionOpenURL
+func (bankCardActionOpenURL BankCardActionOpenURL) MarshalJSON() ([]byte, error) {
+	type alias BankCardActionOpenURL
+	bankCardActionOpenURL.tdCommon.Type = "bankCardActionOpenUrl"
+	return json.Marshal(alias(bankCardActionOpenURL))
+}
+
 // NewBankCardActionOpenURL creates a new BankCardActionOpenURL
 //
 // @param text Action text
@@ -11147,6 +12894,13 @@ func (bankCardInfo *BankCardInfo) MessageType() string {
 	return "bankCardInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if BankCardInfo wasn't created by NewBankCardInfo
+func (bankCardInfo BankCardInfo) MarshalJSON() ([]byte, error) {
+	type alias BankCardInfo
+	bankCardInfo.tdCommon.Type = "bankCardInfo"
+	return json.Marshal(alias(bankCardInfo))
+}
+
 // NewBankCardInfo creates a new BankCardInfo
 //
 // @param title Title of the bank card description
@@ -11177,6 +12931,13 @@ func (address *Address) MessageType() string {
 	return "address"
 }
 
+// MarshalJSON marshals to json, with @type set even if Address wasn't created by NewAddress
+func (address Address) MarshalJSON() ([]byte, error) {
+	type alias Address
+	address.tdCommon.Type = "address"
+	return json.Marshal(alias(address))
+}
+
 // NewAddress creates a new Address
 //
 // @param countryCode A two-letter ISO 3166-1 alpha-2 country code
@@ -11202,8 +12963,8 @@ func NewAddress(countryCode string, state string, city string, streetLine1 strin
 // LabeledPricePart Portion of the price of a product (e.g., "delivery cost", "tax amount")
 type LabeledPricePart struct {
 	tdCommon
-	Label  string `json:"label"`  // Label for this portion of the product price
-	Amount int64  `json:"amount"` // Currency amount in minimal quantity of the currency
+	Label  string    `json:"label"`  // Label for this portion of the product price
+	Amount JSONInt64 `json:"amount"` // Currency amount in minimal quantity of the currency
 }
 
 // MessageType return the string telegram-type of LabeledPricePart
@@ -11211,11 +12972,18 @@ func (labeledPricePart *LabeledPricePart) MessageType() string {
 	return "labeledPricePart"
 }
 
+// MarshalJSON marshals to json, with @type set even if LabeledPricePart wasn't created by NewLabeledPricePart
+func (labeledPricePart LabeledPricePart) MarshalJSON() ([]byte, error) {
+	type alias LabeledPricePart
+	labeledPricePart.tdCommon.Type = "labeledPricePart"
+	return json.Marshal(alias(labeledPricePart))
+}
+
 // NewLabeledPricePart creates a new LabeledPricePart
 //
 // @param label Label for this portion of the product price
 // @param amount Currency amount in minimal quantity of the currency
-func NewLabeledPricePart(label string, amount int64) *LabeledPricePart {
+func NewLabeledPricePart(label string, amount JSONInt64) *LabeledPricePart {
 	labeledPricePartTemp := LabeledPricePart{
 		tdCommon: tdCommon{Type: "labeledPricePart"},
 		Label:    label,
@@ -11245,6 +13013,13 @@ func (invoice *Invoice) MessageType() string {
 	return "invoice"
 }
 
+// MarshalJSON marshals to json, with @type set even if Invoice wasn't created by NewInvoice
+func (invoice Invoice) MarshalJSON() ([]byte, error) {
+	type alias Invoice
+	invoice.tdCommon.Type = "invoice"
+	return json.Marshal(alias(invoice))
+}
+
 // NewInvoice creates a new Invoice
 //
 // @param currency ISO 4217 currency code
@@ -11289,6 +13064,13 @@ func (orderInfo *OrderInfo) MessageType() string {
 	return "orderInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if OrderInfo wasn't created by NewOrderInfo
+func (orderInfo OrderInfo) MarshalJSON() ([]byte, error) {
+	type alias OrderInfo
+	orderInfo.tdCommon.Type = "orderInfo"
+	return json.Marshal(alias(orderInfo))
+}
+
 // NewOrderInfo creates a new OrderInfo
 //
 // @param name Name of the user
@@ -11320,6 +13102,13 @@ func (shippingOption *ShippingOption) MessageType() string {
 	return "shippingOption"
 }
 
+// MarshalJSON marshals to json, with @type set even if ShippingOption wasn't created by NewShippingOption
+func (shippingOption ShippingOption) MarshalJSON() ([]byte, error) {
+	type alias ShippingOption
+	shippingOption.tdCommon.Type = "shippingOption"
+	return json.Marshal(alias(shippingOption))
+}
+
 // NewShippingOption creates a new ShippingOption
 //
 // @param iD Shipping option identifier
@@ -11348,6 +13137,13 @@ func (savedCredentials *SavedCredentials) MessageType() string {
 	return "savedCredentials"
 }
 
+// MarshalJSON marshals to json, with @type set even if SavedCredentials wasn't created by NewSavedCredentials
+func (savedCredentials SavedCredentials) MarshalJSON() ([]byte, error) {
+	type alias SavedCredentials
+	savedCredentials.tdCommon.Type = "savedCredentials"
+	return json.Marshal(alias(savedCredentials))
+}
+
 // NewSavedCredentials creates a new SavedCredentials
 //
 // @param iD Unique identifier of the saved credentials
@@ -11373,6 +13169,13 @@ func (inputCredentialsSaved *InputCredentialsSaved) MessageType() string {
 	return "inputCredentialsSaved"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputCredentialsSaved wasn't created by NewInputCredentialsSaved
+func (inputCredentialsSaved InputCredentialsSaved) MarshalJSON() ([]byte, error) {
+	type alias InputCredentialsSaved
+	inputCredentialsSaved.tdCommon.Type = "inputCredentialsSaved"
+	return json.Marshal(alias(inputCredentialsSaved))
+}
+
 // NewInputCredentialsSaved creates a new InputCredentialsSaved
 //
 // @param savedCredentialsID Identifier of the saved credentials
@@ -11402,6 +13205,13 @@ func (inputCredentialsNew *InputCredentialsNew) MessageType() string {
 	return "inputCredentialsNew"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputCredentialsNew wasn't created by NewInputCredentialsNew
+func (inputCredentialsNew InputCredentialsNew) MarshalJSON() ([]byte, error) {
+	type alias InputCredentialsNew
+	inputCredentialsNew.tdCommon.Type = "inputCredentialsNew"
+	return json.Marshal(alias(inputCredentialsNew))
+}
+
 // NewInputCredentialsNew creates a new InputCredentialsNew
 //
 // @param data Contains JSON-encoded data with a credential identifier from the payment provider
@@ -11432,6 +13242,13 @@ func (inputCredentialsApplePay *InputCredentialsApplePay) MessageType() string {
 	return "inputCredentialsApplePay"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputCredentialsApplePay wasn't created by NewInputCredentialsApplePay
+func (inputCredentialsApplePay InputCredentialsApplePay) MarshalJSON() ([]byte, error) {
+	type alias InputCredentialsApplePay
+	inputCredentialsApplePay.tdCommon.Type = "inputCredentialsApplePay"
+	return json.Marshal(alias(inputCredentialsApplePay))
+}
+
 // NewInputCredentialsApplePay creates a new InputCredentialsApplePay
 //
 // @param data JSON-encoded data with the credential identifier
@@ -11460,6 +13277,13 @@ func (inputCredentialsGooglePay *InputCredentialsGooglePay) MessageType() string
 	return "inputCredentialsGooglePay"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputCredentialsGooglePay wasn't created by NewInputCredentialsGooglePay
+func (inputCredentialsGooglePay InputCredentialsGooglePay) MarshalJSON() ([]byte, error) {
+	type alias InputCredentialsGooglePay
+	inputCredentialsGooglePay.tdCommon.Type = "inputCredentialsGooglePay"
+	return json.Marshal(alias(inputCredentialsGooglePay))
+}
+
 // NewInputCredentialsGooglePay creates a new InputCredentialsGooglePay
 //
 // @param data JSON-encoded data with the credential identifier
@@ -11491,6 +13315,13 @@ func (paymentsProviderStripe *PaymentsProviderStripe) MessageType() string {
 	return "paymentsProviderStripe"
 }
 
+// MarshalJSON marshals to json, with @type set even if PaymentsProviderStripe wasn't created by NewPaymentsProviderStripe
+func (paymentsProviderStripe PaymentsProviderStripe) MarshalJSON() ([]byte, error) {
+	type alias PaymentsProviderStripe
+	paymentsProviderStripe.tdCommon.Type = "paymentsProviderStripe"
+	return json.Marshal(alias(paymentsProviderStripe))
+}
+
 // NewPaymentsProviderStripe creates a new PaymentsProviderStripe
 //
 // @param publishableKey Stripe API publishable key
@@ -11526,6 +13357,13 @@ func (paymentForm *PaymentForm) MessageType() string {
 	return "paymentForm"
 }
 
+// MarshalJSON marshals to json, with @type set even if PaymentForm wasn't created by NewPaymentForm
+func (paymentForm PaymentForm) MarshalJSON() ([]byte, error) {
+	type alias PaymentForm
+	paymentForm.tdCommon.Type = "paymentForm"
+	return json.Marshal(alias(paymentForm))
+}
+
 // NewPaymentForm creates a new PaymentForm
 //
 // @param invoice Full information of the invoice
@@ -11562,6 +13400,13 @@ func (validatedOrderInfo *ValidatedOrderInfo) MessageType() string {
 	return "validatedOrderInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ValidatedOrderInfo wasn't created by NewValidatedOrderInfo
+func (validatedOrderInfo ValidatedOrderInfo) MarshalJSON() ([]byte, error) {
+	type alias ValidatedOrderInfo
+	validatedOrderInfo.tdCommon.Type = "validatedOrderInfo"
+	return json.Marshal(alias(validatedOrderInfo))
+}
+
 // NewValidatedOrderInfo creates a new ValidatedOrderInfo
 //
 // @param orderInfoID Temporary identifier of the order information
@@ -11588,6 +13433,13 @@ func (paymentResult *PaymentResult) MessageType() string {
 	return "paymentResult"
 }
 
+// MarshalJSON marshals to json, with @type set even if PaymentResult wasn't created by NewPaymentResult
+func (paymentResult PaymentResult) MarshalJSON() ([]byte, error) {
+	type alias PaymentResult
+	paymentResult.tdCommon.Type = "paymentResult"
+	return json.Marshal(alias(paymentResult))
+}
+
 // NewPaymentResult creates a new PaymentResult
 //
 // @param success True, if the payment request was successful; otherwise the verification_url will be not empty
@@ -11618,6 +13470,13 @@ func (paymentReceipt *PaymentReceipt) MessageType() string {
 	return "paymentReceipt"
 }
 
+// MarshalJSON marshals to json, with @type set even if PaymentReceipt wasn't created by NewPaymentReceipt
+func (paymentReceipt PaymentReceipt) MarshalJSON() ([]byte, error) {
+	type alias PaymentReceipt
+	paymentReceipt.tdCommon.Type = "paymentReceipt"
+	return json.Marshal(alias(paymentReceipt))
+}
+
 // NewPaymentReceipt creates a new PaymentReceipt
 //
 // @param date Point in time (Unix timestamp) when the payment was made
@@ -11652,6 +13511,13 @@ func (datedFile *DatedFile) MessageType() string {
 	return "datedFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if DatedFile wasn't created by NewDatedFile
+func (datedFile DatedFile) MarshalJSON() ([]byte, error) {
+	type alias DatedFile
+	datedFile.tdCommon.Type = "datedFile"
+	return json.Marshal(alias(datedFile))
+}
+
 // NewDatedFile creates a new DatedFile
 //
 // @param file The file
@@ -11676,6 +13542,13 @@ func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) Me
 	return "passportElementTypePersonalDetails"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypePersonalDetails wasn't created by NewPassportElementTypePersonalDetails
+func (passportElementTypePersonalDetails PassportElementTypePersonalDetails) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypePersonalDetails
+	passportElementTypePersonalDetails.tdCommon.Type = "passportElementTypePersonalDetails"
+	return json.Marshal(alias(passportElementTypePersonalDetails))
+}
+
 // NewPassportElementTypePersonalDetails creates a new PassportElementTypePersonalDetails
 //
 func NewPassportElementTypePersonalDetails() *PassportElementTypePersonalDetails {
@@ -11701,6 +13574,13 @@ func (passportElementTypePassport *PassportElementTypePassport) MessageType() st
 	return "passportElementTypePassport"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypePassport wasn't created by NewPassportElementTypePassport
+func (passportElementTypePassport PassportElementTypePassport) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypePassport
+	passportElementTypePassport.tdCommon.Type = "passportElementTypePassport"
+	return json.Marshal(alias(passportElementTypePassport))
+}
+
 // NewPassportElementTypePassport creates a new PassportElementTypePassport
 //
 func NewPassportElementTypePassport() *PassportElementTypePassport {
@@ -11726,6 +13606,13 @@ func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) Messag
 	return "passportElementTypeDriverLicense"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeDriverLicense wasn't created by NewPassportElementTypeDriverLicense
+func (passportElementTypeDriverLicense PassportElementTypeDriverLicense) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeDriverLicense
+	passportElementTypeDriverLicense.tdCommon.Type = "passportElementTypeDriverLicense"
+	return json.Marshal(alias(passportElementTypeDriverLicense))
+}
+
 // NewPassportElementTypeDriverLicense creates a new PassportElementTypeDriverLicense
 //
 func NewPassportElementTypeDriverLicense() *PassportElementTypeDriverLicense {
@@ -11751,6 +13638,13 @@ func (passportElementTypeIDentityCard *PassportElementTypeIDentityCard) MessageT
 	return "passportElementTypeIdentityCard"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeIDentityCard wasn't created by NewPassportElementTypeIDentityCard
+func (passportElementTypeIDentityCard PassportElementTypeIDentityCard) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeIDentityCard
+	passportElementTypeIDentityCard.tdCommon.Type = "passportElementTypeIdentityCard"
+	return json.Marshal(alias(passportElementTypeIDentityCard))
+}
+
 // NewPassportElementTypeIDentityCard creates a new PassportElementTypeIDentityCard
 //
 func NewPassportElementTypeIDentityCard() *PassportElementTypeIDentityCard {
@@ -11776,6 +13670,13 @@ func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport)
 	return "passportElementTypeInternalPassport"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeInternalPassport wasn't created by NewPassportElementTypeInternalPassport
+func (passportElementTypeInternalPassport PassportElementTypeInternalPassport) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeInternalPassport
+	passportElementTypeInternalPassport.tdCommon.Type = "passportElementTypeInternalPassport"
+	return json.Marshal(alias(passportElementTypeInternalPassport))
+}
+
 // NewPassportElementTypeInternalPassport creates a new PassportElementTypeInternalPassport
 //
 func NewPassportElementTypeInternalPassport() *PassportElementTypeInternalPassport {
@@ -11801,6 +13702,13 @@ func (passportElementTypeAddress *PassportElementTypeAddress) MessageType() stri
 	return "passportElementTypeAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeAddress wasn't created by NewPassportElementTypeAddress
+func (passportElementTypeAddress PassportElementTypeAddress) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeAddress
+	passportElementTypeAddress.tdCommon.Type = "passportElementTypeAddress"
+	return json.Marshal(alias(passportElementTypeAddress))
+}
+
 // NewPassportElementTypeAddress creates a new PassportElementTypeAddress
 //
 func NewPassportElementTypeAddress() *PassportElementTypeAddress {
@@ -11826,6 +13734,13 @@ func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) MessageTyp
 	return "passportElementTypeUtilityBill"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeUtilityBill wasn't created by NewPassportElementTypeUtilityBill
+func (passportElementTypeUtilityBill PassportElementTypeUtilityBill) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeUtilityBill
+	passportElementTypeUtilityBill.tdCommon.Type = "passportElementTypeUtilityBill"
+	return json.Marshal(alias(passportElementTypeUtilityBill))
+}
+
 // NewPassportElementTypeUtilityBill creates a new PassportElementTypeUtilityBill
 //
 func NewPassportElementTypeUtilityBill() *PassportElementTypeUtilityBill {
@@ -11851,6 +13766,13 @@ func (passportElementTypeBankStatement *PassportElementTypeBankStatement) Messag
 	return "passportElementTypeBankStatement"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeBankStatement wasn't created by NewPassportElementTypeBankStatement
+func (passportElementTypeBankStatement PassportElementTypeBankStatement) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeBankStatement
+	passportElementTypeBankStatement.tdCommon.Type = "passportElementTypeBankStatement"
+	return json.Marshal(alias(passportElementTypeBankStatement))
+}
+
 // NewPassportElementTypeBankStatement creates a new PassportElementTypeBankStatement
 //
 func NewPassportElementTypeBankStatement() *PassportElementTypeBankStatement {
@@ -11876,6 +13798,13 @@ func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) Me
 	return "passportElementTypeRentalAgreement"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeRentalAgreement wasn't created by NewPassportElementTypeRentalAgreement
+func (passportElementTypeRentalAgreement PassportElementTypeRentalAgreement) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeRentalAgreement
+	passportElementTypeRentalAgreement.tdCommon.Type = "passportElementTypeRentalAgreement"
+	return json.Marshal(alias(passportElementTypeRentalAgreement))
+}
+
 // NewPassportElementTypeRentalAgreement creates a new PassportElementTypeRentalAgreement
 //
 func NewPassportElementTypeRentalAgreement() *PassportElementTypeRentalAgreement {
@@ -11901,6 +13830,13 @@ func (passportElementTypePassportRegistration *PassportElementTypePassportRegist
 	return "passportElementTypePassportRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypePassportRegistration wasn't created by NewPassportElementTypePassportRegistration
+func (passportElementTypePassportRegistration PassportElementTypePassportRegistration) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypePassportRegistration
+	passportElementTypePassportRegistration.tdCommon.Type = "passportElementTypePassportRegistration"
+	return json.Marshal(alias(passportElementTypePassportRegistration))
+}
+
 // NewPassportElementTypePassportRegistration creates a new PassportElementTypePassportRegistration
 //
 func NewPassportElementTypePassportRegistration() *PassportElementTypePassportRegistration {
@@ -11926,6 +13862,13 @@ func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegi
 	return "passportElementTypeTemporaryRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeTemporaryRegistration wasn't created by NewPassportElementTypeTemporaryRegistration
+func (passportElementTypeTemporaryRegistration PassportElementTypeTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeTemporaryRegistration
+	passportElementTypeTemporaryRegistration.tdCommon.Type = "passportElementTypeTemporaryRegistration"
+	return json.Marshal(alias(passportElementTypeTemporaryRegistration))
+}
+
 // NewPassportElementTypeTemporaryRegistration creates a new PassportElementTypeTemporaryRegistration
 //
 func NewPassportElementTypeTemporaryRegistration() *PassportElementTypeTemporaryRegistration {
@@ -11951,6 +13894,13 @@ func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) MessageTyp
 	return "passportElementTypePhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypePhoneNumber wasn't created by NewPassportElementTypePhoneNumber
+func (passportElementTypePhoneNumber PassportElementTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypePhoneNumber
+	passportElementTypePhoneNumber.tdCommon.Type = "passportElementTypePhoneNumber"
+	return json.Marshal(alias(passportElementTypePhoneNumber))
+}
+
 // NewPassportElementTypePhoneNumber creates a new PassportElementTypePhoneNumber
 //
 func NewPassportElementTypePhoneNumber() *PassportElementTypePhoneNumber {
@@ -11976,6 +13926,13 @@ func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) MessageT
 	return "passportElementTypeEmailAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTypeEmailAddress wasn't created by NewPassportElementTypeEmailAddress
+func (passportElementTypeEmailAddress PassportElementTypeEmailAddress) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTypeEmailAddress
+	passportElementTypeEmailAddress.tdCommon.Type = "passportElementTypeEmailAddress"
+	return json.Marshal(alias(passportElementTypeEmailAddress))
+}
+
 // NewPassportElementTypeEmailAddress creates a new PassportElementTypeEmailAddress
 //
 func NewPassportElementTypeEmailAddress() *PassportElementTypeEmailAddress {
@@ -12004,6 +13961,13 @@ func (date *Date) MessageType() string {
 	return "date"
 }
 
+// MarshalJSON marshals to json, with @type set even if Date wasn't created by NewDate
+func (date Date) MarshalJSON() ([]byte, error) {
+	type alias Date
+	date.tdCommon.Type = "date"
+	return json.Marshal(alias(date))
+}
+
 // NewDate creates a new Date
 //
 // @param day Day of the month; 1-31
@@ -12040,6 +14004,13 @@ func (personalDetails *PersonalDetails) MessageType() string {
 	return "personalDetails"
 }
 
+// MarshalJSON marshals to json, with @type set even if PersonalDetails wasn't created by NewPersonalDetails
+func (personalDetails PersonalDetails) MarshalJSON() ([]byte, error) {
+	type alias PersonalDetails
+	personalDetails.tdCommon.Type = "personalDetails"
+	return json.Marshal(alias(personalDetails))
+}
+
 // NewPersonalDetails creates a new PersonalDetails
 //
 // @param firstName First name of the user written in English; 1-255 characters
@@ -12086,6 +14057,13 @@ func (iDentityDocument *IDentityDocument) MessageType() string {
 	return "identityDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if IDentityDocument wasn't created by NewIDentityDocument
+func (iDentityDocument IDentityDocument) MarshalJSON() ([]byte, error) {
+	type alias IDentityDocument
+	iDentityDocument.tdCommon.Type = "identityDocument"
+	return json.Marshal(alias(iDentityDocument))
+}
+
 // NewIDentityDocument creates a new IDentityDocument
 //
 // @param number Document number; 1-24 characters
@@ -12124,6 +14102,13 @@ func (inputIDentityDocument *InputIDentityDocument) MessageType() string {
 	return "inputIdentityDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputIDentityDocument wasn't created by NewInputIDentityDocument
+func (inputIDentityDocument InputIDentityDocument) MarshalJSON() ([]byte, error) {
+	type alias InputIDentityDocument
+	inputIDentityDocument.tdCommon.Type = "inputIdentityDocument"
+	return json.Marshal(alias(inputIDentityDocument))
+}
+
 // NewInputIDentityDocument creates a new InputIDentityDocument
 //
 // @param number Document number; 1-24 characters
@@ -12193,6 +14178,13 @@ func (personalDocument *PersonalDocument) MessageType() string {
 	return "personalDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if PersonalDocument wasn't created by NewPersonalDocument
+func (personalDocument PersonalDocument) MarshalJSON() ([]byte, error) {
+	type alias PersonalDocument
+	personalDocument.tdCommon.Type = "personalDocument"
+	return json.Marshal(alias(personalDocument))
+}
+
 // NewPersonalDocument creates a new PersonalDocument
 //
 // @param files List of files containing the pages of the document
@@ -12219,6 +14211,13 @@ func (inputPersonalDocument *InputPersonalDocument) MessageType() string {
 	return "inputPersonalDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPersonalDocument wasn't created by NewInputPersonalDocument
+func (inputPersonalDocument InputPersonalDocument) MarshalJSON() ([]byte, error) {
+	type alias InputPersonalDocument
+	inputPersonalDocument.tdCommon.Type = "inputPersonalDocument"
+	return json.Marshal(alias(inputPersonalDocument))
+}
+
 // NewInputPersonalDocument creates a new InputPersonalDocument
 //
 // @param files List of files containing the pages of the document
@@ -12244,6 +14243,13 @@ func (passportElementPersonalDetails *PassportElementPersonalDetails) MessageTyp
 	return "passportElementPersonalDetails"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementPersonalDetails wasn't created by NewPassportElementPersonalDetails
+func (passportElementPersonalDetails PassportElementPersonalDetails) MarshalJSON() ([]byte, error) {
+	type alias PassportElementPersonalDetails
+	passportElementPersonalDetails.tdCommon.Type = "passportElementPersonalDetails"
+	return json.Marshal(alias(passportElementPersonalDetails))
+}
+
 // NewPassportElementPersonalDetails creates a new PassportElementPersonalDetails
 //
 // @param personalDetails Personal details of the user
@@ -12272,6 +14278,13 @@ func (passportElementPassport *PassportElementPassport) MessageType() string {
 	return "passportElementPassport"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementPassport wasn't created by NewPassportElementPassport
+func (passportElementPassport PassportElementPassport) MarshalJSON() ([]byte, error) {
+	type alias PassportElementPassport
+	passportElementPassport.tdCommon.Type = "passportElementPassport"
+	return json.Marshal(alias(passportElementPassport))
+}
+
 // NewPassportElementPassport creates a new PassportElementPassport
 //
 // @param passport Passport
@@ -12300,6 +14313,13 @@ func (passportElementDriverLicense *PassportElementDriverLicense) MessageType()
 	return "passportElementDriverLicense"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementDriverLicense wasn't created by NewPassportElementDriverLicense
+func (passportElementDriverLicense PassportElementDriverLicense) MarshalJSON() ([]byte, error) {
+	type alias PassportElementDriverLicense
+	passportElementDriverLicense.tdCommon.Type = "passportElementDriverLicense"
+	return json.Marshal(alias(passportElementDriverLicense))
+}
+
 // NewPassportElementDriverLicense creates a new PassportElementDriverLicense
 //
 // @param driverLicense Driver license
@@ -12328,6 +14348,13 @@ func (passportElementIDentityCard *PassportElementIDentityCard) MessageType() st
 	return "passportElementIdentityCard"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementIDentityCard wasn't created by NewPassportElementIDentityCard
+func (passportElementIDentityCard PassportElementIDentityCard) MarshalJSON() ([]byte, error) {
+	type alias PassportElementIDentityCard
+	passportElementIDentityCard.tdCommon.Type = "passportElementIdentityCard"
+	return json.Marshal(alias(passportElementIDentityCard))
+}
+
 // NewPassportElementIDentityCard creates a new PassportElementIDentityCard
 //
 // @param iDentityCard Identity card
@@ -12356,6 +14383,13 @@ func (passportElementInternalPassport *PassportElementInternalPassport) MessageT
 	return "passportElementInternalPassport"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementInternalPassport wasn't created by NewPassportElementInternalPassport
+func (passportElementInternalPassport PassportElementInternalPassport) MarshalJSON() ([]byte, error) {
+	type alias PassportElementInternalPassport
+	passportElementInternalPassport.tdCommon.Type = "passportElementInternalPassport"
+	return json.Marshal(alias(passportElementInternalPassport))
+}
+
 // NewPassportElementInternalPassport creates a new PassportElementInternalPassport
 //
 // @param internalPassport Internal passport
@@ -12384,6 +14418,13 @@ func (passportElementAddress *PassportElementAddress) MessageType() string {
 	return "passportElementAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementAddress wasn't created by NewPassportElementAddress
+func (passportElementAddress PassportElementAddress) MarshalJSON() ([]byte, error) {
+	type alias PassportElementAddress
+	passportElementAddress.tdCommon.Type = "passportElementAddress"
+	return json.Marshal(alias(passportElementAddress))
+}
+
 // NewPassportElementAddress creates a new PassportElementAddress
 //
 // @param address Address
@@ -12412,6 +14453,13 @@ func (passportElementUtilityBill *PassportElementUtilityBill) MessageType() stri
 	return "passportElementUtilityBill"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementUtilityBill wasn't created by NewPassportElementUtilityBill
+func (passportElementUtilityBill PassportElementUtilityBill) MarshalJSON() ([]byte, error) {
+	type alias PassportElementUtilityBill
+	passportElementUtilityBill.tdCommon.Type = "passportElementUtilityBill"
+	return json.Marshal(alias(passportElementUtilityBill))
+}
+
 // NewPassportElementUtilityBill creates a new PassportElementUtilityBill
 //
 // @param utilityBill Utility bill
@@ -12440,6 +14488,13 @@ func (passportElementBankStatement *PassportElementBankStatement) MessageType()
 	return "passportElementBankStatement"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementBankStatement wasn't created by NewPassportElementBankStatement
+func (passportElementBankStatement PassportElementBankStatement) MarshalJSON() ([]byte, error) {
+	type alias PassportElementBankStatement
+	passportElementBankStatement.tdCommon.Type = "passportElementBankStatement"
+	return json.Marshal(alias(passportElementBankStatement))
+}
+
 // NewPassportElementBankStatement creates a new PassportElementBankStatement
 //
 // @param bankStatement Bank statement
@@ -12468,6 +14523,13 @@ func (passportElementRentalAgreement *PassportElementRentalAgreement) MessageTyp
 	return "passportElementRentalAgreement"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementRentalAgreement wasn't created by NewPassportElementRentalAgreement
+func (passportElementRentalAgreement PassportElementRentalAgreement) MarshalJSON() ([]byte, error) {
+	type alias PassportElementRentalAgreement
+	passportElementRentalAgreement.tdCommon.Type = "passportElementRentalAgreement"
+	return json.Marshal(alias(passportElementRentalAgreement))
+}
+
 // NewPassportElementRentalAgreement creates a new PassportElementRentalAgreement
 //
 // @param rentalAgreement Rental agreement
@@ -12496,6 +14558,13 @@ func (passportElementPassportRegistration *PassportElementPassportRegistration)
 	return "passportElementPassportRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementPassportRegistration wasn't created by NewPassportElementPassportRegistration
+func (passportElementPassportRegistration PassportElementPassportRegistration) MarshalJSON() ([]byte, error) {
+	type alias PassportElementPassportRegistration
+	passportElementPassportRegistration.tdCommon.Type = "passportElementPassportRegistration"
+	return json.Marshal(alias(passportElementPassportRegistration))
+}
+
 // NewPassportElementPassportRegistration creates a new PassportElementPassportRegistration
 //
 // @param passportRegistration Passport registration pages
@@ -12524,6 +14593,13 @@ func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration
 	return "passportElementTemporaryRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementTemporaryRegistration wasn't created by NewPassportElementTemporaryRegistration
+func (passportElementTemporaryRegistration PassportElementTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	type alias PassportElementTemporaryRegistration
+	passportElementTemporaryRegistration.tdCommon.Type = "passportElementTemporaryRegistration"
+	return json.Marshal(alias(passportElementTemporaryRegistration))
+}
+
 // NewPassportElementTemporaryRegistration creates a new PassportElementTemporaryRegistration
 //
 // @param temporaryRegistration Temporary registration
@@ -12552,6 +14628,13 @@ func (passportElementPhoneNumber *PassportElementPhoneNumber) MessageType() stri
 	return "passportElementPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementPhoneNumber wasn't created by NewPassportElementPhoneNumber
+func (passportElementPhoneNumber PassportElementPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias PassportElementPhoneNumber
+	passportElementPhoneNumber.tdCommon.Type = "passportElementPhoneNumber"
+	return json.Marshal(alias(passportElementPhoneNumber))
+}
+
 // NewPassportElementPhoneNumber creates a new PassportElementPhoneNumber
 //
 // @param phoneNumber Phone number
@@ -12580,6 +14663,13 @@ func (passportElementEmailAddress *PassportElementEmailAddress) MessageType() st
 	return "passportElementEmailAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementEmailAddress wasn't created by NewPassportElementEmailAddress
+func (passportElementEmailAddress PassportElementEmailAddress) MarshalJSON() ([]byte, error) {
+	type alias PassportElementEmailAddress
+	passportElementEmailAddress.tdCommon.Type = "passportElementEmailAddress"
+	return json.Marshal(alias(passportElementEmailAddress))
+}
+
 // NewPassportElementEmailAddress creates a new PassportElementEmailAddress
 //
 // @param emailAddress Email address
@@ -12608,6 +14698,13 @@ func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails)
 	return "inputPassportElementPersonalDetails"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementPersonalDetails wasn't created by NewInputPassportElementPersonalDetails
+func (inputPassportElementPersonalDetails InputPassportElementPersonalDetails) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementPersonalDetails
+	inputPassportElementPersonalDetails.tdCommon.Type = "inputPassportElementPersonalDetails"
+	return json.Marshal(alias(inputPassportElementPersonalDetails))
+}
+
 // NewInputPassportElementPersonalDetails creates a new InputPassportElementPersonalDetails
 //
 // @param personalDetails Personal details of the user
@@ -12636,6 +14733,13 @@ func (inputPassportElementPassport *InputPassportElementPassport) MessageType()
 	return "inputPassportElementPassport"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementPassport wasn't created by NewInputPassportElementPassport
+func (inputPassportElementPassport InputPassportElementPassport) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementPassport
+	inputPassportElementPassport.tdCommon.Type = "inputPassportElementPassport"
+	return json.Marshal(alias(inputPassportElementPassport))
+}
+
 // NewInputPassportElementPassport creates a new InputPassportElementPassport
 //
 // @param passport The passport to be saved
@@ -12664,6 +14768,13 @@ func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) Mess
 	return "inputPassportElementDriverLicense"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementDriverLicense wasn't created by NewInputPassportElementDriverLicense
+func (inputPassportElementDriverLicense InputPassportElementDriverLicense) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementDriverLicense
+	inputPassportElementDriverLicense.tdCommon.Type = "inputPassportElementDriverLicense"
+	return json.Marshal(alias(inputPassportElementDriverLicense))
+}
+
 // NewInputPassportElementDriverLicense creates a new InputPassportElementDriverLicense
 //
 // @param driverLicense The driver license to be saved
@@ -12692,6 +14803,13 @@ func (inputPassportElementIDentityCard *InputPassportElementIDentityCard) Messag
 	return "inputPassportElementIdentityCard"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementIDentityCard wasn't created by NewInputPassportElementIDentityCard
+func (inputPassportElementIDentityCard InputPassportElementIDentityCard) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementIDentityCard
+	inputPassportElementIDentityCard.tdCommon.Type = "inputPassportElementIdentityCard"
+	return json.Marshal(alias(inputPassportElementIDentityCard))
+}
+
 // NewInputPassportElementIDentityCard creates a new InputPassportElementIDentityCard
 //
 // @param iDentityCard The identity card to be saved
@@ -12720,6 +14838,13 @@ func (inputPassportElementInternalPassport *InputPassportElementInternalPassport
 	return "inputPassportElementInternalPassport"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementInternalPassport wasn't created by NewInputPassportElementInternalPassport
+func (inputPassportElementInternalPassport InputPassportElementInternalPassport) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementInternalPassport
+	inputPassportElementInternalPassport.tdCommon.Type = "inputPassportElementInternalPassport"
+	return json.Marshal(alias(inputPassportElementInternalPassport))
+}
+
 // NewInputPassportElementInternalPassport creates a new InputPassportElementInternalPassport
 //
 // @param internalPassport The internal passport to be saved
@@ -12748,6 +14873,13 @@ func (inputPassportElementAddress *InputPassportElementAddress) MessageType() st
 	return "inputPassportElementAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementAddress wasn't created by NewInputPassportElementAddress
+func (inputPassportElementAddress InputPassportElementAddress) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementAddress
+	inputPassportElementAddress.tdCommon.Type = "inputPassportElementAddress"
+	return json.Marshal(alias(inputPassportElementAddress))
+}
+
 // NewInputPassportElementAddress creates a new InputPassportElementAddress
 //
 // @param address The address to be saved
@@ -12776,6 +14908,13 @@ func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) MessageT
 	return "inputPassportElementUtilityBill"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementUtilityBill wasn't created by NewInputPassportElementUtilityBill
+func (inputPassportElementUtilityBill InputPassportElementUtilityBill) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementUtilityBill
+	inputPassportElementUtilityBill.tdCommon.Type = "inputPassportElementUtilityBill"
+	return json.Marshal(alias(inputPassportElementUtilityBill))
+}
+
 // NewInputPassportElementUtilityBill creates a new InputPassportElementUtilityBill
 //
 // @param utilityBill The utility bill to be saved
@@ -12804,6 +14943,13 @@ func (inputPassportElementBankStatement *InputPassportElementBankStatement) Mess
 	return "inputPassportElementBankStatement"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementBankStatement wasn't created by NewInputPassportElementBankStatement
+func (inputPassportElementBankStatement InputPassportElementBankStatement) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementBankStatement
+	inputPassportElementBankStatement.tdCommon.Type = "inputPassportElementBankStatement"
+	return json.Marshal(alias(inputPassportElementBankStatement))
+}
+
 // NewInputPassportElementBankStatement creates a new InputPassportElementBankStatement
 //
 // @param bankStatement The bank statement to be saved
@@ -12832,6 +14978,13 @@ func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement)
 	return "inputPassportElementRentalAgreement"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementRentalAgreement wasn't created by NewInputPassportElementRentalAgreement
+func (inputPassportElementRentalAgreement InputPassportElementRentalAgreement) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementRentalAgreement
+	inputPassportElementRentalAgreement.tdCommon.Type = "inputPassportElementRentalAgreement"
+	return json.Marshal(alias(inputPassportElementRentalAgreement))
+}
+
 // NewInputPassportElementRentalAgreement creates a new InputPassportElementRentalAgreement
 //
 // @param rentalAgreement The rental agreement to be saved
@@ -12860,6 +15013,13 @@ func (inputPassportElementPassportRegistration *InputPassportElementPassportRegi
 	return "inputPassportElementPassportRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementPassportRegistration wasn't created by NewInputPassportElementPassportRegistration
+func (inputPassportElementPassportRegistration InputPassportElementPassportRegistration) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementPassportRegistration
+	inputPassportElementPassportRegistration.tdCommon.Type = "inputPassportElementPassportRegistration"
+	return json.Marshal(alias(inputPassportElementPassportRegistration))
+}
+
 // NewInputPassportElementPassportRegistration creates a new InputPassportElementPassportRegistration
 //
 // @param passportRegistration The passport registration page to be saved
@@ -12888,6 +15048,13 @@ func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRe
 	return "inputPassportElementTemporaryRegistration"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementTemporaryRegistration wasn't created by NewInputPassportElementTemporaryRegistration
+func (inputPassportElementTemporaryRegistration InputPassportElementTemporaryRegistration) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementTemporaryRegistration
+	inputPassportElementTemporaryRegistration.tdCommon.Type = "inputPassportElementTemporaryRegistration"
+	return json.Marshal(alias(inputPassportElementTemporaryRegistration))
+}
+
 // NewInputPassportElementTemporaryRegistration creates a new InputPassportElementTemporaryRegistration
 //
 // @param temporaryRegistration The temporary registration document to be saved
@@ -12916,6 +15083,13 @@ func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) MessageT
 	return "inputPassportElementPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementPhoneNumber wasn't created by NewInputPassportElementPhoneNumber
+func (inputPassportElementPhoneNumber InputPassportElementPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementPhoneNumber
+	inputPassportElementPhoneNumber.tdCommon.Type = "inputPassportElementPhoneNumber"
+	return json.Marshal(alias(inputPassportElementPhoneNumber))
+}
+
 // NewInputPassportElementPhoneNumber creates a new InputPassportElementPhoneNumber
 //
 // @param phoneNumber The phone number to be saved
@@ -12944,6 +15118,13 @@ func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) Messag
 	return "inputPassportElementEmailAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementEmailAddress wasn't created by NewInputPassportElementEmailAddress
+func (inputPassportElementEmailAddress InputPassportElementEmailAddress) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementEmailAddress
+	inputPassportElementEmailAddress.tdCommon.Type = "inputPassportElementEmailAddress"
+	return json.Marshal(alias(inputPassportElementEmailAddress))
+}
+
 // NewInputPassportElementEmailAddress creates a new InputPassportElementEmailAddress
 //
 // @param emailAddress The email address to be saved
@@ -12972,6 +15153,13 @@ func (passportElements *PassportElements) MessageType() string {
 	return "passportElements"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElements wasn't created by NewPassportElements
+func (passportElements PassportElements) MarshalJSON() ([]byte, error) {
+	type alias PassportElements
+	passportElements.tdCommon.Type = "passportElements"
+	return json.Marshal(alias(passportElements))
+}
+
 // NewPassportElements creates a new PassportElements
 //
 // @param elements Telegram Passport elements
@@ -12994,6 +15182,13 @@ func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecifi
 	return "passportElementErrorSourceUnspecified"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceUnspecified wasn't created by NewPassportElementErrorSourceUnspecified
+func (passportElementErrorSourceUnspecified PassportElementErrorSourceUnspecified) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceUnspecified
+	passportElementErrorSourceUnspecified.tdCommon.Type = "passportElementErrorSourceUnspecified"
+	return json.Marshal(alias(passportElementErrorSourceUnspecified))
+}
+
 // NewPassportElementErrorSourceUnspecified creates a new PassportElementErrorSourceUnspecified
 //
 func NewPassportElementErrorSourceUnspecified() *PassportElementErrorSourceUnspecified {
@@ -13020,6 +15215,13 @@ func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField)
 	return "passportElementErrorSourceDataField"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceDataField wasn't created by NewPassportElementErrorSourceDataField
+func (passportElementErrorSourceDataField PassportElementErrorSourceDataField) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceDataField
+	passportElementErrorSourceDataField.tdCommon.Type = "passportElementErrorSourceDataField"
+	return json.Marshal(alias(passportElementErrorSourceDataField))
+}
+
 // NewPassportElementErrorSourceDataField creates a new PassportElementErrorSourceDataField
 //
 // @param fieldName Field name
@@ -13047,6 +15249,13 @@ func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide)
 	return "passportElementErrorSourceFrontSide"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceFrontSide wasn't created by NewPassportElementErrorSourceFrontSide
+func (passportElementErrorSourceFrontSide PassportElementErrorSourceFrontSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceFrontSide
+	passportElementErrorSourceFrontSide.tdCommon.Type = "passportElementErrorSourceFrontSide"
+	return json.Marshal(alias(passportElementErrorSourceFrontSide))
+}
+
 // NewPassportElementErrorSourceFrontSide creates a new PassportElementErrorSourceFrontSide
 //
 func NewPassportElementErrorSourceFrontSide() *PassportElementErrorSourceFrontSide {
@@ -13072,6 +15281,13 @@ func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSi
 	return "passportElementErrorSourceReverseSide"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceReverseSide wasn't created by NewPassportElementErrorSourceReverseSide
+func (passportElementErrorSourceReverseSide PassportElementErrorSourceReverseSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceReverseSide
+	passportElementErrorSourceReverseSide.tdCommon.Type = "passportElementErrorSourceReverseSide"
+	return json.Marshal(alias(passportElementErrorSourceReverseSide))
+}
+
 // NewPassportElementErrorSourceReverseSide creates a new PassportElementErrorSourceReverseSide
 //
 func NewPassportElementErrorSourceReverseSide() *PassportElementErrorSourceReverseSide {
@@ -13097,6 +15313,13 @@ func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) Messag
 	return "passportElementErrorSourceSelfie"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceSelfie wasn't created by NewPassportElementErrorSourceSelfie
+func (passportElementErrorSourceSelfie PassportElementErrorSourceSelfie) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceSelfie
+	passportElementErrorSourceSelfie.tdCommon.Type = "passportElementErrorSourceSelfie"
+	return json.Marshal(alias(passportElementErrorSourceSelfie))
+}
+
 // NewPassportElementErrorSourceSelfie creates a new PassportElementErrorSourceSelfie
 //
 func NewPassportElementErrorSourceSelfie() *PassportElementErrorSourceSelfie {
@@ -13123,6 +15346,13 @@ func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTrans
 	return "passportElementErrorSourceTranslationFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceTranslationFile wasn't created by NewPassportElementErrorSourceTranslationFile
+func (passportElementErrorSourceTranslationFile PassportElementErrorSourceTranslationFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceTranslationFile
+	passportElementErrorSourceTranslationFile.tdCommon.Type = "passportElementErrorSourceTranslationFile"
+	return json.Marshal(alias(passportElementErrorSourceTranslationFile))
+}
+
 // NewPassportElementErrorSourceTranslationFile creates a new PassportElementErrorSourceTranslationFile
 //
 // @param fileIndex Index of a file with the error
@@ -13150,6 +15380,13 @@ func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTran
 	return "passportElementErrorSourceTranslationFiles"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceTranslationFiles wasn't created by NewPassportElementErrorSourceTranslationFiles
+func (passportElementErrorSourceTranslationFiles PassportElementErrorSourceTranslationFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceTranslationFiles
+	passportElementErrorSourceTranslationFiles.tdCommon.Type = "passportElementErrorSourceTranslationFiles"
+	return json.Marshal(alias(passportElementErrorSourceTranslationFiles))
+}
+
 // NewPassportElementErrorSourceTranslationFiles creates a new PassportElementErrorSourceTranslationFiles
 //
 func NewPassportElementErrorSourceTranslationFiles() *PassportElementErrorSourceTranslationFiles {
@@ -13176,6 +15413,13 @@ func (passportElementErrorSourceFile *PassportElementErrorSourceFile) MessageTyp
 	return "passportElementErrorSourceFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceFile wasn't created by NewPassportElementErrorSourceFile
+func (passportElementErrorSourceFile PassportElementErrorSourceFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceFile
+	passportElementErrorSourceFile.tdCommon.Type = "passportElementErrorSourceFile"
+	return json.Marshal(alias(passportElementErrorSourceFile))
+}
+
 // NewPassportElementErrorSourceFile creates a new PassportElementErrorSourceFile
 //
 // @param fileIndex Index of a file with the error
@@ -13203,6 +15447,13 @@ func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) MessageT
 	return "passportElementErrorSourceFiles"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementErrorSourceFiles wasn't created by NewPassportElementErrorSourceFiles
+func (passportElementErrorSourceFiles PassportElementErrorSourceFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSourceFiles
+	passportElementErrorSourceFiles.tdCommon.Type = "passportElementErrorSourceFiles"
+	return json.Marshal(alias(passportElementErrorSourceFiles))
+}
+
 // NewPassportElementErrorSourceFiles creates a new PassportElementErrorSourceFiles
 //
 func NewPassportElementErrorSourceFiles() *PassportElementErrorSourceFiles {
@@ -13231,6 +15482,13 @@ func (passportElementError *PassportElementError) MessageType() string {
 	return "passportElementError"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementError wasn't created by NewPassportElementError
+func (passportElementError PassportElementError) MarshalJSON() ([]byte, error) {
+	type alias PassportElementError
+	passportElementError.tdCommon.Type = "passportElementError"
+	return json.Marshal(alias(passportElementError))
+}
+
 // NewPassportElementError creates a new PassportElementError
 //
 // @param typeParam Type of the Telegram Passport element which has the error
@@ -13290,6 +15548,13 @@ func (passportSuitableElement *PassportSuitableElement) MessageType() string {
 	return "passportSuitableElement"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportSuitableElement wasn't created by NewPassportSuitableElement
+func (passportSuitableElement PassportSuitableElement) MarshalJSON() ([]byte, error) {
+	type alias PassportSuitableElement
+	passportSuitableElement.tdCommon.Type = "passportSuitableElement"
+	return json.Marshal(alias(passportSuitableElement))
+}
+
 // NewPassportSuitableElement creates a new PassportSuitableElement
 //
 // @param typeParam Type of the element
@@ -13348,6 +15613,13 @@ func (passportRequiredElement *PassportRequiredElement) MessageType() string {
 	return "passportRequiredElement"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportRequiredElement wasn't created by NewPassportRequiredElement
+func (passportRequiredElement PassportRequiredElement) MarshalJSON() ([]byte, error) {
+	type alias PassportRequiredElement
+	passportRequiredElement.tdCommon.Type = "passportRequiredElement"
+	return json.Marshal(alias(passportRequiredElement))
+}
+
 // NewPassportRequiredElement creates a new PassportRequiredElement
 //
 // @param suitableElements List of Telegram Passport elements any of which is enough to provide
@@ -13373,6 +15645,13 @@ func (passportAuthorizationForm *PassportAuthorizationForm) MessageType() string
 	return "passportAuthorizationForm"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportAuthorizationForm wasn't created by NewPassportAuthorizationForm
+func (passportAuthorizationForm PassportAuthorizationForm) MarshalJSON() ([]byte, error) {
+	type alias PassportAuthorizationForm
+	passportAuthorizationForm.tdCommon.Type = "passportAuthorizationForm"
+	return json.Marshal(alias(passportAuthorizationForm))
+}
+
 // NewPassportAuthorizationForm creates a new PassportAuthorizationForm
 //
 // @param iD Unique identifier of the authorization form
@@ -13401,6 +15680,13 @@ func (passportElementsWithErrors *PassportElementsWithErrors) MessageType() stri
 	return "passportElementsWithErrors"
 }
 
+// MarshalJSON marshals to json, with @type set even if PassportElementsWithErrors wasn't created by NewPassportElementsWithErrors
+func (passportElementsWithErrors PassportElementsWithErrors) MarshalJSON() ([]byte, error) {
+	type alias PassportElementsWithErrors
+	passportElementsWithErrors.tdCommon.Type = "passportElementsWithErrors"
+	return json.Marshal(alias(passportElementsWithErrors))
+}
+
 // NewPassportElementsWithErrors creates a new PassportElementsWithErrors
 //
 // @param elements Telegram Passport elements
@@ -13428,6 +15714,13 @@ func (encryptedCredentials *EncryptedCredentials) MessageType() string {
 	return "encryptedCredentials"
 }
 
+// MarshalJSON marshals to json, with @type set even if EncryptedCredentials wasn't created by NewEncryptedCredentials
+func (encryptedCredentials EncryptedCredentials) MarshalJSON() ([]byte, error) {
+	type alias EncryptedCredentials
+	encryptedCredentials.tdCommon.Type = "encryptedCredentials"
+	return json.Marshal(alias(encryptedCredentials))
+}
+
 // NewEncryptedCredentials creates a new EncryptedCredentials
 //
 // @param data The encrypted credentials
@@ -13463,6 +15756,13 @@ func (encryptedPassportElement *EncryptedPassportElement) MessageType() string {
 	return "encryptedPassportElement"
 }
 
+// MarshalJSON marshals to json, with @type set even if EncryptedPassportElement wasn't created by NewEncryptedPassportElement
+func (encryptedPassportElement EncryptedPassportElement) MarshalJSON() ([]byte, error) {
+	type alias EncryptedPassportElement
+	encryptedPassportElement.tdCommon.Type = "encryptedPassportElement"
+	return json.Marshal(alias(encryptedPassportElement))
+}
+
 // NewEncryptedPassportElement creates a new EncryptedPassportElement
 //
 // @param typeParam Type of Telegram Passport element
@@ -13541,6 +15841,13 @@ func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourc
 	return "inputPassportElementErrorSourceUnspecified"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceUnspecified wasn't created by NewInputPassportElementErrorSourceUnspecified
+func (inputPassportElementErrorSourceUnspecified InputPassportElementErrorSourceUnspecified) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceUnspecified
+	inputPassportElementErrorSourceUnspecified.tdCommon.Type = "inputPassportElementErrorSourceUnspecified"
+	return json.Marshal(alias(inputPassportElementErrorSourceUnspecified))
+}
+
 // NewInputPassportElementErrorSourceUnspecified creates a new InputPassportElementErrorSourceUnspecified
 //
 // @param elementHash Current hash of the entire element
@@ -13570,6 +15877,13 @@ func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceD
 	return "inputPassportElementErrorSourceDataField"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceDataField wasn't created by NewInputPassportElementErrorSourceDataField
+func (inputPassportElementErrorSourceDataField InputPassportElementErrorSourceDataField) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceDataField
+	inputPassportElementErrorSourceDataField.tdCommon.Type = "inputPassportElementErrorSourceDataField"
+	return json.Marshal(alias(inputPassportElementErrorSourceDataField))
+}
+
 // NewInputPassportElementErrorSourceDataField creates a new InputPassportElementErrorSourceDataField
 //
 // @param fieldName Field name
@@ -13600,6 +15914,13 @@ func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceF
 	return "inputPassportElementErrorSourceFrontSide"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceFrontSide wasn't created by NewInputPassportElementErrorSourceFrontSide
+func (inputPassportElementErrorSourceFrontSide InputPassportElementErrorSourceFrontSide) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceFrontSide
+	inputPassportElementErrorSourceFrontSide.tdCommon.Type = "inputPassportElementErrorSourceFrontSide"
+	return json.Marshal(alias(inputPassportElementErrorSourceFrontSide))
+}
+
 // NewInputPassportElementErrorSourceFrontSide creates a new InputPassportElementErrorSourceFrontSide
 //
 // @param fileHash Current hash of the file containing the front side
@@ -13628,6 +15949,13 @@ func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourc
 	return "inputPassportElementErrorSourceReverseSide"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceReverseSide wasn't created by NewInputPassportElementErrorSourceReverseSide
+func (inputPassportElementErrorSourceReverseSide InputPassportElementErrorSourceReverseSide) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceReverseSide
+	inputPassportElementErrorSourceReverseSide.tdCommon.Type = "inputPassportElementErrorSourceReverseSide"
+	return json.Marshal(alias(inputPassportElementErrorSourceReverseSide))
+}
+
 // NewInputPassportElementErrorSourceReverseSide creates a new InputPassportElementErrorSourceReverseSide
 //
 // @param fileHash Current hash of the file containing the reverse side
@@ -13656,6 +15984,13 @@ func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelf
 	return "inputPassportElementErrorSourceSelfie"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceSelfie wasn't created by NewInputPassportElementErrorSourceSelfie
+func (inputPassportElementErrorSourceSelfie InputPassportElementErrorSourceSelfie) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceSelfie
+	inputPassportElementErrorSourceSelfie.tdCommon.Type = "inputPassportElementErrorSourceSelfie"
+	return json.Marshal(alias(inputPassportElementErrorSourceSelfie))
+}
+
 // NewInputPassportElementErrorSourceSelfie creates a new InputPassportElementErrorSourceSelfie
 //
 // @param fileHash Current hash of the file containing the selfie
@@ -13684,6 +16019,13 @@ func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorS
 	return "inputPassportElementErrorSourceTranslationFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceTranslationFile wasn't created by NewInputPassportElementErrorSourceTranslationFile
+func (inputPassportElementErrorSourceTranslationFile InputPassportElementErrorSourceTranslationFile) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceTranslationFile
+	inputPassportElementErrorSourceTranslationFile.tdCommon.Type = "inputPassportElementErrorSourceTranslationFile"
+	return json.Marshal(alias(inputPassportElementErrorSourceTranslationFile))
+}
+
 // NewInputPassportElementErrorSourceTranslationFile creates a new InputPassportElementErrorSourceTranslationFile
 //
 // @param fileHash Current hash of the file containing the translation
@@ -13712,6 +16054,13 @@ func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementError
 	return "inputPassportElementErrorSourceTranslationFiles"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceTranslationFiles wasn't created by NewInputPassportElementErrorSourceTranslationFiles
+func (inputPassportElementErrorSourceTranslationFiles InputPassportElementErrorSourceTranslationFiles) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceTranslationFiles
+	inputPassportElementErrorSourceTranslationFiles.tdCommon.Type = "inputPassportElementErrorSourceTranslationFiles"
+	return json.Marshal(alias(inputPassportElementErrorSourceTranslationFiles))
+}
+
 // NewInputPassportElementErrorSourceTranslationFiles creates a new InputPassportElementErrorSourceTranslationFiles
 //
 // @param fileHashes Current hashes of all files with the translation
@@ -13740,6 +16089,13 @@ func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile)
 	return "inputPassportElementErrorSourceFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceFile wasn't created by NewInputPassportElementErrorSourceFile
+func (inputPassportElementErrorSourceFile InputPassportElementErrorSourceFile) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceFile
+	inputPassportElementErrorSourceFile.tdCommon.Type = "inputPassportElementErrorSourceFile"
+	return json.Marshal(alias(inputPassportElementErrorSourceFile))
+}
+
 // NewInputPassportElementErrorSourceFile creates a new InputPassportElementErrorSourceFile
 //
 // @param fileHash Current hash of the file which has the error
@@ -13768,6 +16124,13 @@ func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles
 	return "inputPassportElementErrorSourceFiles"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementErrorSourceFiles wasn't created by NewInputPassportElementErrorSourceFiles
+func (inputPassportElementErrorSourceFiles InputPassportElementErrorSourceFiles) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementErrorSourceFiles
+	inputPassportElementErrorSourceFiles.tdCommon.Type = "inputPassportElementErrorSourceFiles"
+	return json.Marshal(alias(inputPassportElementErrorSourceFiles))
+}
+
 // NewInputPassportElementErrorSourceFiles creates a new InputPassportElementErrorSourceFiles
 //
 // @param fileHashes Current hashes of all attached files
@@ -13798,6 +16161,13 @@ func (inputPassportElementError *InputPassportElementError) MessageType() string
 	return "inputPassportElementError"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputPassportElementError wasn't created by NewInputPassportElementError
+func (inputPassportElementError InputPassportElementError) MarshalJSON() ([]byte, error) {
+	type alias InputPassportElementError
+	inputPassportElementError.tdCommon.Type = "inputPassportElementError"
+	return json.Marshal(alias(inputPassportElementError))
+}
+
 // NewInputPassportElementError creates a new InputPassportElementError
 //
 // @param typeParam Type of Telegram Passport element that has the error
@@ -13855,6 +16225,13 @@ func (messageText *MessageText) MessageType() string {
 	return "messageText"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageText wasn't created by NewMessageText
+func (messageText MessageText) MarshalJSON() ([]byte, error) {
+	type alias MessageText
+	messageText.tdCommon.Type = "messageText"
+	return json.Marshal(alias(messageText))
+}
+
 // NewMessageText creates a new MessageText
 //
 // @param text Text of the message
@@ -13887,6 +16264,13 @@ func (messageAnimation *MessageAnimation) MessageType() string {
 	return "messageAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageAnimation wasn't created by NewMessageAnimation
+func (messageAnimation MessageAnimation) MarshalJSON() ([]byte, error) {
+	type alias MessageAnimation
+	messageAnimation.tdCommon.Type = "messageAnimation"
+	return json.Marshal(alias(messageAnimation))
+}
+
 // NewMessageAnimation creates a new MessageAnimation
 //
 // @param animation The animation description
@@ -13920,6 +16304,13 @@ func (messageAudio *MessageAudio) MessageType() string {
 	return "messageAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageAudio wasn't created by NewMessageAudio
+func (messageAudio MessageAudio) MarshalJSON() ([]byte, error) {
+	type alias MessageAudio
+	messageAudio.tdCommon.Type = "messageAudio"
+	return json.Marshal(alias(messageAudio))
+}
+
 // NewMessageAudio creates a new MessageAudio
 //
 // @param audio The audio description
@@ -13951,6 +16342,13 @@ func (messageDocument *MessageDocument) MessageType() string {
 	return "messageDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageDocument wasn't created by NewMessageDocument
+func (messageDocument MessageDocument) MarshalJSON() ([]byte, error) {
+	type alias MessageDocument
+	messageDocument.tdCommon.Type = "messageDocument"
+	return json.Marshal(alias(messageDocument))
+}
+
 // NewMessageDocument creates a new MessageDocument
 //
 // @param document The document description
@@ -13983,6 +16381,13 @@ func (messagePhoto *MessagePhoto) MessageType() string {
 	return "messagePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePhoto wasn't created by NewMessagePhoto
+func (messagePhoto MessagePhoto) MarshalJSON() ([]byte, error) {
+	type alias MessagePhoto
+	messagePhoto.tdCommon.Type = "messagePhoto"
+	return json.Marshal(alias(messagePhoto))
+}
+
 // NewMessagePhoto creates a new MessagePhoto
 //
 // @param photo The photo description
@@ -14014,6 +16419,13 @@ func (messageExpiredPhoto *MessageExpiredPhoto) MessageType() string {
 	return "messageExpiredPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageExpiredPhoto wasn't created by NewMessageExpiredPhoto
+func (messageExpiredPhoto MessageExpiredPhoto) MarshalJSON() ([]byte, error) {
+	type alias MessageExpiredPhoto
+	messageExpiredPhoto.tdCommon.Type = "messageExpiredPhoto"
+	return json.Marshal(alias(messageExpiredPhoto))
+}
+
 // NewMessageExpiredPhoto creates a new MessageExpiredPhoto
 //
 func NewMessageExpiredPhoto() *MessageExpiredPhoto {
@@ -14040,6 +16452,13 @@ func (messageSticker *MessageSticker) MessageType() string {
 	return "messageSticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSticker wasn't created by NewMessageSticker
+func (messageSticker MessageSticker) MarshalJSON() ([]byte, error) {
+	type alias MessageSticker
+	messageSticker.tdCommon.Type = "messageSticker"
+	return json.Marshal(alias(messageSticker))
+}
+
 // NewMessageSticker creates a new MessageSticker
 //
 // @param sticker The sticker description
@@ -14070,6 +16489,13 @@ func (messageVideo *MessageVideo) MessageType() string {
 	return "messageVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageVideo wasn't created by NewMessageVideo
+func (messageVideo MessageVideo) MarshalJSON() ([]byte, error) {
+	type alias MessageVideo
+	messageVideo.tdCommon.Type = "messageVideo"
+	return json.Marshal(alias(messageVideo))
+}
+
 // NewMessageVideo creates a new MessageVideo
 //
 // @param video The video description
@@ -14101,6 +16527,13 @@ func (messageExpiredVideo *MessageExpiredVideo) MessageType() string {
 	return "messageExpiredVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageExpiredVideo wasn't created by NewMessageExpiredVideo
+func (messageExpiredVideo MessageExpiredVideo) MarshalJSON() ([]byte, error) {
+	type alias MessageExpiredVideo
+	messageExpiredVideo.tdCommon.Type = "messageExpiredVideo"
+	return json.Marshal(alias(messageExpiredVideo))
+}
+
 // NewMessageExpiredVideo creates a new MessageExpiredVideo
 //
 func NewMessageExpiredVideo() *MessageExpiredVideo {
@@ -14129,6 +16562,13 @@ func (messageVideoNote *MessageVideoNote) MessageType() string {
 	return "messageVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageVideoNote wasn't created by NewMessageVideoNote
+func (messageVideoNote MessageVideoNote) MarshalJSON() ([]byte, error) {
+	type alias MessageVideoNote
+	messageVideoNote.tdCommon.Type = "messageVideoNote"
+	return json.Marshal(alias(messageVideoNote))
+}
+
 // NewMessageVideoNote creates a new MessageVideoNote
 //
 // @param videoNote The video note description
@@ -14163,6 +16603,13 @@ func (messageVoiceNote *MessageVoiceNote) MessageType() string {
 	return "messageVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageVoiceNote wasn't created by NewMessageVoiceNote
+func (messageVoiceNote MessageVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias MessageVoiceNote
+	messageVoiceNote.tdCommon.Type = "messageVoiceNote"
+	return json.Marshal(alias(messageVoiceNote))
+}
+
 // NewMessageVoiceNote creates a new MessageVoiceNote
 //
 // @param voiceNote The voice note description
@@ -14199,6 +16646,13 @@ func (messageLocation *MessageLocation) MessageType() string {
 	return "messageLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageLocation wasn't created by NewMessageLocation
+func (messageLocation MessageLocation) MarshalJSON() ([]byte, error) {
+	type alias MessageLocation
+	messageLocation.tdCommon.Type = "messageLocation"
+	return json.Marshal(alias(messageLocation))
+}
+
 // NewMessageLocation creates a new MessageLocation
 //
 // @param location The location description
@@ -14235,6 +16689,13 @@ func (messageVenue *MessageVenue) MessageType() string {
 	return "messageVenue"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageVenue wasn't created by NewMessageVenue
+func (messageVenue MessageVenue) MarshalJSON() ([]byte, error) {
+	type alias MessageVenue
+	messageVenue.tdCommon.Type = "messageVenue"
+	return json.Marshal(alias(messageVenue))
+}
+
 // NewMessageVenue creates a new MessageVenue
 //
 // @param venue The venue description
@@ -14263,6 +16724,13 @@ func (messageContact *MessageContact) MessageType() string {
 	return "messageContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageContact wasn't created by NewMessageContact
+func (messageContact MessageContact) MarshalJSON() ([]byte, error) {
+	type alias MessageContact
+	messageContact.tdCommon.Type = "messageContact"
+	return json.Marshal(alias(messageContact))
+}
+
 // NewMessageContact creates a new MessageContact
 //
 // @param contact The contact description
@@ -14295,6 +16763,13 @@ func (messageDice *MessageDice) MessageType() string {
 	return "messageDice"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageDice wasn't created by NewMessageDice
+func (messageDice MessageDice) MarshalJSON() ([]byte, error) {
+	type alias MessageDice
+	messageDice.tdCommon.Type = "messageDice"
+	return json.Marshal(alias(messageDice))
+}
+
 // NewMessageDice creates a new MessageDice
 //
 // @param initialState The animated stickers with the initial dice animation; may be null if unknown. updateMessageContent will be sent when the sticker became known
@@ -14363,6 +16838,13 @@ func (messageGame *MessageGame) MessageType() string {
 	return "messageGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageGame wasn't created by NewMessageGame
+func (messageGame MessageGame) MarshalJSON() ([]byte, error) {
+	type alias MessageGame
+	messageGame.tdCommon.Type = "messageGame"
+	return json.Marshal(alias(messageGame))
+}
+
 // NewMessageGame creates a new MessageGame
 //
 // @param game The game description
@@ -14391,6 +16873,13 @@ func (messagePoll *MessagePoll) MessageType() string {
 	return "messagePoll"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePoll wasn't created by NewMessagePoll
+func (messagePoll MessagePoll) MarshalJSON() ([]byte, error) {
+	type alias MessagePoll
+	messagePoll.tdCommon.Type = "messagePoll"
+	return json.Marshal(alias(messagePoll))
+}
+
 // NewMessagePoll creates a new MessagePoll
 //
 // @param poll The poll description
@@ -14411,15 +16900,15 @@ func (messagePoll *MessagePoll) GetMessageContentEnum() MessageContentEnum {
 // MessageInvoice A message with an invoice from a bot
 type MessageInvoice struct {
 	tdCommon
-	Title               string `json:"title"`                 // Product title
-	Description         string `json:"description"`           //
-	Photo               *Photo `json:"photo"`                 // Product photo; may be null
-	Currency            string `json:"currency"`              // Currency for the product price
-	TotalAmount         int64  `json:"total_amount"`          // Product total price in the minimal quantity of the currency
-	StartParameter      string `json:"start_parameter"`       // Unique invoice bot start_parameter. To share an invoice use the URL https://t.me/{bot_username}?start={start_parameter}
-	IsTest              bool   `json:"is_test"`               // True, if the invoice is a test invoice
-	NeedShippingAddress bool   `json:"need_shipping_address"` // True, if the shipping address should be specified
-	ReceiptMessageID    int64  `json:"receipt_message_id"`    // The identifier of the message with the receipt, after the product has been purchased
+	Title               string    `json:"title"`                 // Product title
+	Description         string    `json:"description"`           //
+	Photo               *Photo    `json:"photo"`                 // Product photo; may be null
+	Currency            string    `json:"currency"`              // Currency for the product price
+	TotalAmount         JSONInt64 `json:"total_amount"`          // Product total price in the minimal quantity of the currency
+	StartParameter      string    `json:"start_parameter"`       // Unique invoice bot start_parameter. To share an invoice use the URL https://t.me/{bot_username}?start={start_parameter}
+	IsTest              bool      `json:"is_test"`               // True, if the invoice is a test invoice
+	NeedShippingAddress bool      `json:"need_shipping_address"` // True, if the shipping address should be specified
+	ReceiptMessageID    JSONInt64 `json:"receipt_message_id"`    // The identifier of the message with the receipt, after the product has been purchased
 }
 
 // MessageType return the string telegram-type of MessageInvoice
@@ -14427,6 +16916,13 @@ func (messageInvoice *MessageInvoice) MessageType() string {
 	return "messageInvoice"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageInvoice wasn't created by NewMessageInvoice
+func (messageInvoice MessageInvoice) MarshalJSON() ([]byte, error) {
+	type alias MessageInvoice
+	messageInvoice.tdCommon.Type = "messageInvoice"
+	return json.Marshal(alias(messageInvoice))
+}
+
 // NewMessageInvoice creates a new MessageInvoice
 //
 // @param title Product title
@@ -14438,7 +16934,7 @@ func (messageInvoice *MessageInvoice) MessageType() string {
 // @param isTest True, if the invoice is a test invoice
 // @param needShippingAddress True, if the shipping address should be specified
 // @param receiptMessageID The identifier of the message with the receipt, after the product has been purchased
-func NewMessageInvoice(title string, description string, photo *Photo, currency string, totalAmount int64, startParameter string, isTest bool, needShippingAddress bool, receiptMessageID int64) *MessageInvoice {
+func NewMessageInvoice(title string, description string, photo *Photo, currency string, totalAmount JSONInt64, startParameter string, isTest bool, needShippingAddress bool, receiptMessageID JSONInt64) *MessageInvoice {
 	messageInvoiceTemp := MessageInvoice{
 		tdCommon:            tdCommon{Type: "messageInvoice"},
 		Title:               title,
@@ -14473,6 +16969,13 @@ func (messageCall *MessageCall) MessageType() string {
 	return "messageCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageCall wasn't created by NewMessageCall
+func (messageCall MessageCall) MarshalJSON() ([]byte, error) {
+	type alias MessageCall
+	messageCall.tdCommon.Type = "messageCall"
+	return json.Marshal(alias(messageCall))
+}
+
 // NewMessageCall creates a new MessageCall
 //
 // @param isVideo True, if the call was a video call
@@ -14532,6 +17035,13 @@ func (messageVoiceChatStarted *MessageVoiceChatStarted) MessageType() string {
 	return "messageVoiceChatStarted"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageVoiceChatStarted wasn't created by NewMessageVoiceChatStarted
+func (messageVoiceChatStarted MessageVoiceChatStarted) MarshalJSON() ([]byte, error) {
+	type alias MessageVoiceChatStarted
+	messageVoiceChatStarted.tdCommon.Type = "messageVoiceChatStarted"
+	return json.Marshal(alias(messageVoiceChatStarted))
+}
+
 // NewMessageVoiceChatStarted creates a new MessageVoiceChatStarted
 //
 // @param groupCallID Identifier of the voice chat. The voice chat can be received through the method getGroupCall
@@ -14560,6 +17070,13 @@ func (messageVoiceChatEnded *MessageVoiceChatEnded) MessageType() string {
 	return "messageVoiceChatEnded"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageVoiceChatEnded wasn't created by NewMessageVoiceChatEnded
+func (messageVoiceChatEnded MessageVoiceChatEnded) MarshalJSON() ([]byte, error) {
+	type alias MessageVoiceChatEnded
+	messageVoiceChatEnded.tdCommon.Type = "messageVoiceChatEnded"
+	return json.Marshal(alias(messageVoiceChatEnded))
+}
+
 // NewMessageVoiceChatEnded creates a new MessageVoiceChatEnded
 //
 // @param duration Call duration
@@ -14589,6 +17106,13 @@ func (messageInviteVoiceChatParticipants *MessageInviteVoiceChatParticipants) Me
 	return "messageInviteVoiceChatParticipants"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageInviteVoiceChatParticipants wasn't created by NewMessageInviteVoiceChatParticipants
+func (messageInviteVoiceChatParticipants MessageInviteVoiceChatParticipants) MarshalJSON() ([]byte, error) {
+	type alias MessageInviteVoiceChatParticipants
+	messageInviteVoiceChatParticipants.tdCommon.Type = "messageInviteVoiceChatParticipants"
+	return json.Marshal(alias(messageInviteVoiceChatParticipants))
+}
+
 // NewMessageInviteVoiceChatParticipants creates a new MessageInviteVoiceChatParticipants
 //
 // @param groupCallID Identifier of the voice chat. The voice chat can be received through the method getGroupCall
@@ -14620,6 +17144,13 @@ func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) MessageType() st
 	return "messageBasicGroupChatCreate"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageBasicGroupChatCreate wasn't created by NewMessageBasicGroupChatCreate
+func (messageBasicGroupChatCreate MessageBasicGroupChatCreate) MarshalJSON() ([]byte, error) {
+	type alias MessageBasicGroupChatCreate
+	messageBasicGroupChatCreate.tdCommon.Type = "messageBasicGroupChatCreate"
+	return json.Marshal(alias(messageBasicGroupChatCreate))
+}
+
 // NewMessageBasicGroupChatCreate creates a new MessageBasicGroupChatCreate
 //
 // @param title Title of the basic group
@@ -14650,6 +17181,13 @@ func (messageSupergroupChatCreate *MessageSupergroupChatCreate) MessageType() st
 	return "messageSupergroupChatCreate"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSupergroupChatCreate wasn't created by NewMessageSupergroupChatCreate
+func (messageSupergroupChatCreate MessageSupergroupChatCreate) MarshalJSON() ([]byte, error) {
+	type alias MessageSupergroupChatCreate
+	messageSupergroupChatCreate.tdCommon.Type = "messageSupergroupChatCreate"
+	return json.Marshal(alias(messageSupergroupChatCreate))
+}
+
 // NewMessageSupergroupChatCreate creates a new MessageSupergroupChatCreate
 //
 // @param title Title of the supergroup or channel
@@ -14678,6 +17216,13 @@ func (messageChatChangeTitle *MessageChatChangeTitle) MessageType() string {
 	return "messageChatChangeTitle"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatChangeTitle wasn't created by NewMessageChatChangeTitle
+func (messageChatChangeTitle MessageChatChangeTitle) MarshalJSON() ([]byte, error) {
+	type alias MessageChatChangeTitle
+	messageChatChangeTitle.tdCommon.Type = "messageChatChangeTitle"
+	return json.Marshal(alias(messageChatChangeTitle))
+}
+
 // NewMessageChatChangeTitle creates a new MessageChatChangeTitle
 //
 // @param title New chat title
@@ -14706,6 +17251,13 @@ func (messageChatChangePhoto *MessageChatChangePhoto) MessageType() string {
 	return "messageChatChangePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatChangePhoto wasn't created by NewMessageChatChangePhoto
+func (messageChatChangePhoto MessageChatChangePhoto) MarshalJSON() ([]byte, error) {
+	type alias MessageChatChangePhoto
+	messageChatChangePhoto.tdCommon.Type = "messageChatChangePhoto"
+	return json.Marshal(alias(messageChatChangePhoto))
+}
+
 // NewMessageChatChangePhoto creates a new MessageChatChangePhoto
 //
 // @param photo New chat photo
@@ -14733,6 +17285,13 @@ func (messageChatDeletePhoto *MessageChatDeletePhoto) MessageType() string {
 	return "messageChatDeletePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatDeletePhoto wasn't created by NewMessageChatDeletePhoto
+func (messageChatDeletePhoto MessageChatDeletePhoto) MarshalJSON() ([]byte, error) {
+	type alias MessageChatDeletePhoto
+	messageChatDeletePhoto.tdCommon.Type = "messageChatDeletePhoto"
+	return json.Marshal(alias(messageChatDeletePhoto))
+}
+
 // NewMessageChatDeletePhoto creates a new MessageChatDeletePhoto
 //
 func NewMessageChatDeletePhoto() *MessageChatDeletePhoto {
@@ -14759,6 +17318,13 @@ func (messageChatAddMembers *MessageChatAddMembers) MessageType() string {
 	return "messageChatAddMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatAddMembers wasn't created by NewMessageChatAddMembers
+func (messageChatAddMembers MessageChatAddMembers) MarshalJSON() ([]byte, error) {
+	type alias MessageChatAddMembers
+	messageChatAddMembers.tdCommon.Type = "messageChatAddMembers"
+	return json.Marshal(alias(messageChatAddMembers))
+}
+
 // NewMessageChatAddMembers creates a new MessageChatAddMembers
 //
 // @param memberUserIDs User identifiers of the new members
@@ -14786,6 +17352,13 @@ func (messageChatJoinByLink *MessageChatJoinByLink) MessageType() string {
 	return "messageChatJoinByLink"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatJoinByLink wasn't created by NewMessageChatJoinByLink
+func (messageChatJoinByLink MessageChatJoinByLink) MarshalJSON() ([]byte, error) {
+	type alias MessageChatJoinByLink
+	messageChatJoinByLink.tdCommon.Type = "messageChatJoinByLink"
+	return json.Marshal(alias(messageChatJoinByLink))
+}
+
 // NewMessageChatJoinByLink creates a new MessageChatJoinByLink
 //
 func NewMessageChatJoinByLink() *MessageChatJoinByLink {
@@ -14812,6 +17385,13 @@ func (messageChatDeleteMember *MessageChatDeleteMember) MessageType() string {
 	return "messageChatDeleteMember"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatDeleteMember wasn't created by NewMessageChatDeleteMember
+func (messageChatDeleteMember MessageChatDeleteMember) MarshalJSON() ([]byte, error) {
+	type alias MessageChatDeleteMember
+	messageChatDeleteMember.tdCommon.Type = "messageChatDeleteMember"
+	return json.Marshal(alias(messageChatDeleteMember))
+}
+
 // NewMessageChatDeleteMember creates a new MessageChatDeleteMember
 //
 // @param userID User identifier of the deleted chat member
@@ -14840,6 +17420,13 @@ func (messageChatUpgradeTo *MessageChatUpgradeTo) MessageType() string {
 	return "messageChatUpgradeTo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatUpgradeTo wasn't created by NewMessageChatUpgradeTo
+func (messageChatUpgradeTo MessageChatUpgradeTo) MarshalJSON() ([]byte, error) {
+	type alias MessageChatUpgradeTo
+	messageChatUpgradeTo.tdCommon.Type = "messageChatUpgradeTo"
+	return json.Marshal(alias(messageChatUpgradeTo))
+}
+
 // NewMessageChatUpgradeTo creates a new MessageChatUpgradeTo
 //
 // @param supergroupID Identifier of the supergroup to which the basic group was upgraded
@@ -14869,6 +17456,13 @@ func (messageChatUpgradeFrom *MessageChatUpgradeFrom) MessageType() string {
 	return "messageChatUpgradeFrom"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatUpgradeFrom wasn't created by NewMessageChatUpgradeFrom
+func (messageChatUpgradeFrom MessageChatUpgradeFrom) MarshalJSON() ([]byte, error) {
+	type alias MessageChatUpgradeFrom
+	messageChatUpgradeFrom.tdCommon.Type = "messageChatUpgradeFrom"
+	return json.Marshal(alias(messageChatUpgradeFrom))
+}
+
 // NewMessageChatUpgradeFrom creates a new MessageChatUpgradeFrom
 //
 // @param title Title of the newly created supergroup
@@ -14891,7 +17485,7 @@ func (messageChatUpgradeFrom *MessageChatUpgradeFrom) GetMessageContentEnum() Me
 // MessagePinMessage A message has been pinned
 type MessagePinMessage struct {
 	tdCommon
-	MessageID int64 `json:"message_id"` // Identifier of the pinned message, can be an identifier of a deleted message or 0
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the pinned message, can be an identifier of a deleted message or 0
 }
 
 // MessageType return the string telegram-type of MessagePinMessage
@@ -14899,10 +17493,17 @@ func (messagePinMessage *MessagePinMessage) MessageType() string {
 	return "messagePinMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePinMessage wasn't created by NewMessagePinMessage
+func (messagePinMessage MessagePinMessage) MarshalJSON() ([]byte, error) {
+	type alias MessagePinMessage
+	messagePinMessage.tdCommon.Type = "messagePinMessage"
+	return json.Marshal(alias(messagePinMessage))
+}
+
 // NewMessagePinMessage creates a new MessagePinMessage
 //
 // @param messageID Identifier of the pinned message, can be an identifier of a deleted message or 0
-func NewMessagePinMessage(messageID int64) *MessagePinMessage {
+func NewMessagePinMessage(messageID JSONInt64) *MessagePinMessage {
 	messagePinMessageTemp := MessagePinMessage{
 		tdCommon:  tdCommon{Type: "messagePinMessage"},
 		MessageID: messageID,
@@ -14926,6 +17527,13 @@ func (messageScreenshotTaken *MessageScreenshotTaken) MessageType() string {
 	return "messageScreenshotTaken"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageScreenshotTaken wasn't created by NewMessageScreenshotTaken
+func (messageScreenshotTaken MessageScreenshotTaken) MarshalJSON() ([]byte, error) {
+	type alias MessageScreenshotTaken
+	messageScreenshotTaken.tdCommon.Type = "messageScreenshotTaken"
+	return json.Marshal(alias(messageScreenshotTaken))
+}
+
 // NewMessageScreenshotTaken creates a new MessageScreenshotTaken
 //
 func NewMessageScreenshotTaken() *MessageScreenshotTaken {
@@ -14952,6 +17560,13 @@ func (messageChatSetTTL *MessageChatSetTTL) MessageType() string {
 	return "messageChatSetTtl"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageChatSetTTL wasn't created by NewMessageChatSetTTL
+func (messageChatSetTTL MessageChatSetTTL) MarshalJSON() ([]byte, error) {
+	type alias MessageChatSetTTL
+	messageChatSetTTL.tdCommon.Type = "messageChatSetTtl"
+	return json.Marshal(alias(messageChatSetTTL))
+}
+
 // NewMessageChatSetTTL creates a new MessageChatSetTTL
 //
 // @param tTL New TTL
@@ -14980,6 +17595,13 @@ func (messageCustomServiceAction *MessageCustomServiceAction) MessageType() stri
 	return "messageCustomServiceAction"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageCustomServiceAction wasn't created by NewMessageCustomServiceAction
+func (messageCustomServiceAction MessageCustomServiceAction) MarshalJSON() ([]byte, error) {
+	type alias MessageCustomServiceAction
+	messageCustomServiceAction.tdCommon.Type = "messageCustomServiceAction"
+	return json.Marshal(alias(messageCustomServiceAction))
+}
+
 // NewMessageCustomServiceAction creates a new MessageCustomServiceAction
 //
 // @param text Message text to be shown in the chat
@@ -15000,7 +17622,7 @@ func (messageCustomServiceAction *MessageCustomServiceAction) GetMessageContentE
 // MessageGameScore A new high score was achieved in a game
 type MessageGameScore struct {
 	tdCommon
-	GameMessageID int64     `json:"game_message_id"` // Identifier of the message with the game, can be an identifier of a deleted message
+	GameMessageID JSONInt64 `json:"game_message_id"` // Identifier of the message with the game, can be an identifier of a deleted message
 	GameID        JSONInt64 `json:"game_id"`         // Identifier of the game; may be different from the games presented in the message with the game
 	Score         int32     `json:"score"`           // New score
 }
@@ -15010,12 +17632,19 @@ func (messageGameScore *MessageGameScore) MessageType() string {
 	return "messageGameScore"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageGameScore wasn't created by NewMessageGameScore
+func (messageGameScore MessageGameScore) MarshalJSON() ([]byte, error) {
+	type alias MessageGameScore
+	messageGameScore.tdCommon.Type = "messageGameScore"
+	return json.Marshal(alias(messageGameScore))
+}
+
 // NewMessageGameScore creates a new MessageGameScore
 //
 // @param gameMessageID Identifier of the message with the game, can be an identifier of a deleted message
 // @param gameID Identifier of the game; may be different from the games presented in the message with the game
 // @param score New score
-func NewMessageGameScore(gameMessageID int64, gameID JSONInt64, score int32) *MessageGameScore {
+func NewMessageGameScore(gameMessageID JSONInt64, gameID JSONInt64, score int32) *MessageGameScore {
 	messageGameScoreTemp := MessageGameScore{
 		tdCommon:      tdCommon{Type: "messageGameScore"},
 		GameMessageID: gameMessageID,
@@ -15034,9 +17663,9 @@ func (messageGameScore *MessageGameScore) GetMessageContentEnum() MessageContent
 // MessagePaymentSuccessful A payment has been completed
 type MessagePaymentSuccessful struct {
 	tdCommon
-	InvoiceMessageID int64  `json:"invoice_message_id"` // Identifier of the message with the corresponding invoice; can be an identifier of a deleted message
-	Currency         string `json:"currency"`           // Currency for the price of the product
-	TotalAmount      int64  `json:"total_amount"`       // Total price for the product, in the minimal quantity of the currency
+	InvoiceMessageID JSONInt64 `json:"invoice_message_id"` // Identifier of the message with the corresponding invoice; can be an identifier of a deleted message
+	Currency         string    `json:"currency"`           // Currency for the price of the product
+	TotalAmount      JSONInt64 `json:"total_amount"`       // Total price for the product, in the minimal quantity of the currency
 }
 
 // MessageType return the string telegram-type of MessagePaymentSuccessful
@@ -15044,12 +17673,19 @@ func (messagePaymentSuccessful *MessagePaymentSuccessful) MessageType() string {
 	return "messagePaymentSuccessful"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePaymentSuccessful wasn't created by NewMessagePaymentSuccessful
+func (messagePaymentSuccessful MessagePaymentSuccessful) MarshalJSON() ([]byte, error) {
+	type alias MessagePaymentSuccessful
+	messagePaymentSuccessful.tdCommon.Type = "messagePaymentSuccessful"
+	return json.Marshal(alias(messagePaymentSuccessful))
+}
+
 // NewMessagePaymentSuccessful creates a new MessagePaymentSuccessful
 //
 // @param invoiceMessageID Identifier of the message with the corresponding invoice; can be an identifier of a deleted message
 // @param currency Currency for the price of the product
 // @param totalAmount Total price for the product, in the minimal quantity of the currency
-func NewMessagePaymentSuccessful(invoiceMessageID int64, currency string, totalAmount int64) *MessagePaymentSuccessful {
+func NewMessagePaymentSuccessful(invoiceMessageID JSONInt64, currency string, totalAmount JSONInt64) *MessagePaymentSuccessful {
 	messagePaymentSuccessfulTemp := MessagePaymentSuccessful{
 		tdCommon:         tdCommon{Type: "messagePaymentSuccessful"},
 		InvoiceMessageID: invoiceMessageID,
@@ -15068,9 +17704,9 @@ func (messagePaymentSuccessful *MessagePaymentSuccessful) GetMessageContentEnum(
 // MessagePaymentSuccessfulBot A payment has been completed; for bots only
 type MessagePaymentSuccessfulBot struct {
 	tdCommon
-	InvoiceMessageID        int64      `json:"invoice_message_id"`         // Identifier of the message with the corresponding invoice; can be an identifier of a deleted message
+	InvoiceMessageID        JSONInt64  `json:"invoice_message_id"`         // Identifier of the message with the corresponding invoice; can be an identifier of a deleted message
 	Currency                string     `json:"currency"`                   // Currency for price of the product
-	TotalAmount             int64      `json:"total_amount"`               // Total price for the product, in the minimal quantity of the currency
+	TotalAmount             JSONInt64  `json:"total_amount"`               // Total price for the product, in the minimal quantity of the currency
 	InvoicePayload          []byte     `json:"invoice_payload"`            // Invoice payload
 	ShippingOptionID        string     `json:"shipping_option_id"`         // Identifier of the shipping option chosen by the user; may be empty if not applicable
 	OrderInfo               *OrderInfo `json:"order_info"`                 // Information about the order; may be null
@@ -15083,6 +17719,13 @@ func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) MessageType() st
 	return "messagePaymentSuccessfulBot"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePaymentSuccessfulBot wasn't created by NewMessagePaymentSuccessfulBot
+func (messagePaymentSuccessfulBot MessagePaymentSuccessfulBot) MarshalJSON() ([]byte, error) {
+	type alias MessagePaymentSuccessfulBot
+	messagePaymentSuccessfulBot.tdCommon.Type = "messagePaymentSuccessfulBot"
+	return json.Marshal(alias(messagePaymentSuccessfulBot))
+}
+
 // NewMessagePaymentSuccessfulBot creates a new MessagePaymentSuccessfulBot
 //
 // @param invoiceMessageID Identifier of the message with the corresponding invoice; can be an identifier of a deleted message
@@ -15093,7 +17736,7 @@ func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) MessageType() st
 // @param orderInfo Information about the order; may be null
 // @param telegramPaymentChargeID Telegram payment identifier
 // @param providerPaymentChargeID Provider payment identifier
-func NewMessagePaymentSuccessfulBot(invoiceMessageID int64, currency string, totalAmount int64, invoicePayload []byte, shippingOptionID string, orderInfo *OrderInfo, telegramPaymentChargeID string, providerPaymentChargeID string) *MessagePaymentSuccessfulBot {
+func NewMessagePaymentSuccessfulBot(invoiceMessageID JSONInt64, currency string, totalAmount JSONInt64, invoicePayload []byte, shippingOptionID string, orderInfo *OrderInfo, telegramPaymentChargeID string, providerPaymentChargeID string) *MessagePaymentSuccessfulBot {
 	messagePaymentSuccessfulBotTemp := MessagePaymentSuccessfulBot{
 		tdCommon:                tdCommon{Type: "messagePaymentSuccessfulBot"},
 		InvoiceMessageID:        invoiceMessageID,
@@ -15124,6 +17767,13 @@ func (messageContactRegistered *MessageContactRegistered) MessageType() string {
 	return "messageContactRegistered"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageContactRegistered wasn't created by NewMessageContactRegistered
+func (messageContactRegistered MessageContactRegistered) MarshalJSON() ([]byte, error) {
+	type alias MessageContactRegistered
+	messageContactRegistered.tdCommon.Type = "messageContactRegistered"
+	return json.Marshal(alias(messageContactRegistered))
+}
+
 // NewMessageContactRegistered creates a new MessageContactRegistered
 //
 func NewMessageContactRegistered() *MessageContactRegistered {
@@ -15150,6 +17800,13 @@ func (messageWebsiteConnected *MessageWebsiteConnected) MessageType() string {
 	return "messageWebsiteConnected"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageWebsiteConnected wasn't created by NewMessageWebsiteConnected
+func (messageWebsiteConnected MessageWebsiteConnected) MarshalJSON() ([]byte, error) {
+	type alias MessageWebsiteConnected
+	messageWebsiteConnected.tdCommon.Type = "messageWebsiteConnected"
+	return json.Marshal(alias(messageWebsiteConnected))
+}
+
 // NewMessageWebsiteConnected creates a new MessageWebsiteConnected
 //
 // @param domainName Domain name of the connected website
@@ -15178,6 +17835,13 @@ func (messagePassportDataSent *MessagePassportDataSent) MessageType() string {
 	return "messagePassportDataSent"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePassportDataSent wasn't created by NewMessagePassportDataSent
+func (messagePassportDataSent MessagePassportDataSent) MarshalJSON() ([]byte, error) {
+	type alias MessagePassportDataSent
+	messagePassportDataSent.tdCommon.Type = "messagePassportDataSent"
+	return json.Marshal(alias(messagePassportDataSent))
+}
+
 // NewMessagePassportDataSent creates a new MessagePassportDataSent
 //
 // @param typeParams List of Telegram Passport element types sent
@@ -15207,6 +17871,13 @@ func (messagePassportDataReceived *MessagePassportDataReceived) MessageType() st
 	return "messagePassportDataReceived"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessagePassportDataReceived wasn't created by NewMessagePassportDataReceived
+func (messagePassportDataReceived MessagePassportDataReceived) MarshalJSON() ([]byte, error) {
+	type alias MessagePassportDataReceived
+	messagePassportDataReceived.tdCommon.Type = "messagePassportDataReceived"
+	return json.Marshal(alias(messagePassportDataReceived))
+}
+
 // NewMessagePassportDataReceived creates a new MessagePassportDataReceived
 //
 // @param elements List of received Telegram Passport elements
@@ -15239,6 +17910,13 @@ func (messageProximityAlertTriggered *MessageProximityAlertTriggered) MessageTyp
 	return "messageProximityAlertTriggered"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageProximityAlertTriggered wasn't created by NewMessageProximityAlertTriggered
+func (messageProximityAlertTriggered MessageProximityAlertTriggered) MarshalJSON() ([]byte, error) {
+	type alias MessageProximityAlertTriggered
+	messageProximityAlertTriggered.tdCommon.Type = "messageProximityAlertTriggered"
+	return json.Marshal(alias(messageProximityAlertTriggered))
+}
+
 // NewMessageProximityAlertTriggered creates a new MessageProximityAlertTriggered
 //
 // @param traveler The user or chat, which triggered the proximity alert
@@ -15298,6 +17976,13 @@ func (messageUnsupported *MessageUnsupported) MessageType() string {
 	return "messageUnsupported"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageUnsupported wasn't created by NewMessageUnsupported
+func (messageUnsupported MessageUnsupported) MarshalJSON() ([]byte, error) {
+	type alias MessageUnsupported
+	messageUnsupported.tdCommon.Type = "messageUnsupported"
+	return json.Marshal(alias(messageUnsupported))
+}
+
 // NewMessageUnsupported creates a new MessageUnsupported
 //
 func NewMessageUnsupported() *MessageUnsupported {
@@ -15323,6 +18008,13 @@ func (textEntityTypeMention *TextEntityTypeMention) MessageType() string {
 	return "textEntityTypeMention"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeMention wasn't created by NewTextEntityTypeMention
+func (textEntityTypeMention TextEntityTypeMention) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeMention
+	textEntityTypeMention.tdCommon.Type = "textEntityTypeMention"
+	return json.Marshal(alias(textEntityTypeMention))
+}
+
 // NewTextEntityTypeMention creates a new TextEntityTypeMention
 //
 func NewTextEntityTypeMention() *TextEntityTypeMention {
@@ -15348,6 +18040,13 @@ func (textEntityTypeHashtag *TextEntityTypeHashtag) MessageType() string {
 	return "textEntityTypeHashtag"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeHashtag wasn't created by NewTextEntityTypeHashtag
+func (textEntityTypeHashtag TextEntityTypeHashtag) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeHashtag
+	textEntityTypeHashtag.tdCommon.Type = "textEntityTypeHashtag"
+	return json.Marshal(alias(textEntityTypeHashtag))
+}
+
 // NewTextEntityTypeHashtag creates a new TextEntityTypeHashtag
 //
 func NewTextEntityTypeHashtag() *TextEntityTypeHashtag {
@@ -15373,6 +18072,13 @@ func (textEntityTypeCashtag *TextEntityTypeCashtag) MessageType() string {
 	return "textEntityTypeCashtag"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeCashtag wasn't created by NewTextEntityTypeCashtag
+func (textEntityTypeCashtag TextEntityTypeCashtag) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeCashtag
+	textEntityTypeCashtag.tdCommon.Type = "textEntityTypeCashtag"
+	return json.Marshal(alias(textEntityTypeCashtag))
+}
+
 // NewTextEntityTypeCashtag creates a new TextEntityTypeCashtag
 //
 func NewTextEntityTypeCashtag() *TextEntityTypeCashtag {
@@ -15398,6 +18104,13 @@ func (textEntityTypeBotCommand *TextEntityTypeBotCommand) MessageType() string {
 	return "textEntityTypeBotCommand"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeBotCommand wasn't created by NewTextEntityTypeBotCommand
+func (textEntityTypeBotCommand TextEntityTypeBotCommand) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeBotCommand
+	textEntityTypeBotCommand.tdCommon.Type = "textEntityTypeBotCommand"
+	return json.Marshal(alias(textEntityTypeBotCommand))
+}
+
 // NewTextEntityTypeBotCommand creates a new TextEntityTypeBotCommand
 //
 func NewTextEntityTypeBotCommand() *TextEntityTypeBotCommand {
@@ -15423,6 +18136,13 @@ func (textEntityTypeURL *TextEntityTypeURL) MessageType() string {
 	return "textEntityTypeUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeURL wasn't created by NewTextEntityTypeURL
+func (textEntityTypeURL TextEntityTypeURL) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeURL
+	textEntityTypeURL.tdCommon.Type = "textEntityTypeUrl"
+	return json.Marshal(alias(textEntityTypeURL))
+}
+
 // NewTextEntityTypeURL creates a new TextEntityTypeURL
 //
 func NewTextEntityTypeURL() *TextEntityTypeURL {
@@ -15448,6 +18168,13 @@ func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) MessageType() stri
 	return "textEntityTypeEmailAddress"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeEmailAddress wasn't created by NewTextEntityTypeEmailAddress
+func (textEntityTypeEmailAddress TextEntityTypeEmailAddress) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeEmailAddress
+	textEntityTypeEmailAddress.tdCommon.Type = "textEntityTypeEmailAddress"
+	return json.Marshal(alias(textEntityTypeEmailAddress))
+}
+
 // NewTextEntityTypeEmailAddress creates a new TextEntityTypeEmailAddress
 //
 func NewTextEntityTypeEmailAddress() *TextEntityTypeEmailAddress {
@@ -15473,6 +18200,13 @@ func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) MessageType() string
 	return "textEntityTypePhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypePhoneNumber wasn't created by NewTextEntityTypePhoneNumber
+func (textEntityTypePhoneNumber TextEntityTypePhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypePhoneNumber
+	textEntityTypePhoneNumber.tdCommon.Type = "textEntityTypePhoneNumber"
+	return json.Marshal(alias(textEntityTypePhoneNumber))
+}
+
 // NewTextEntityTypePhoneNumber creates a new TextEntityTypePhoneNumber
 //
 func NewTextEntityTypePhoneNumber() *TextEntityTypePhoneNumber {
@@ -15498,6 +18232,13 @@ func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) MessageType()
 	return "textEntityTypeBankCardNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeBankCardNumber wasn't created by NewTextEntityTypeBankCardNumber
+func (textEntityTypeBankCardNumber TextEntityTypeBankCardNumber) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeBankCardNumber
+	textEntityTypeBankCardNumber.tdCommon.Type = "textEntityTypeBankCardNumber"
+	return json.Marshal(alias(textEntityTypeBankCardNumber))
+}
+
 // NewTextEntityTypeBankCardNumber creates a new TextEntityTypeBankCardNumber
 //
 func NewTextEntityTypeBankCardNumber() *TextEntityTypeBankCardNumber {
@@ -15523,6 +18264,13 @@ func (textEntityTypeBold *TextEntityTypeBold) MessageType() string {
 	return "textEntityTypeBold"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeBold wasn't created by NewTextEntityTypeBold
+func (textEntityTypeBold TextEntityTypeBold) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeBold
+	textEntityTypeBold.tdCommon.Type = "textEntityTypeBold"
+	return json.Marshal(alias(textEntityTypeBold))
+}
+
 // NewTextEntityTypeBold creates a new TextEntityTypeBold
 //
 func NewTextEntityTypeBold() *TextEntityTypeBold {
@@ -15548,6 +18296,13 @@ func (textEntityTypeItalic *TextEntityTypeItalic) MessageType() string {
 	return "textEntityTypeItalic"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeItalic wasn't created by NewTextEntityTypeItalic
+func (textEntityTypeItalic TextEntityTypeItalic) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeItalic
+	textEntityTypeItalic.tdCommon.Type = "textEntityTypeItalic"
+	return json.Marshal(alias(textEntityTypeItalic))
+}
+
 // NewTextEntityTypeItalic creates a new TextEntityTypeItalic
 //
 func NewTextEntityTypeItalic() *TextEntityTypeItalic {
@@ -15573,6 +18328,13 @@ func (textEntityTypeUnderline *TextEntityTypeUnderline) MessageType() string {
 	return "textEntityTypeUnderline"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeUnderline wasn't created by NewTextEntityTypeUnderline
+func (textEntityTypeUnderline TextEntityTypeUnderline) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeUnderline
+	textEntityTypeUnderline.tdCommon.Type = "textEntityTypeUnderline"
+	return json.Marshal(alias(textEntityTypeUnderline))
+}
+
 // NewTextEntityTypeUnderline creates a new TextEntityTypeUnderline
 //
 func NewTextEntityTypeUnderline() *TextEntityTypeUnderline {
@@ -15598,6 +18360,13 @@ func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) MessageType() st
 	return "textEntityTypeStrikethrough"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeStrikethrough wasn't created by NewTextEntityTypeStrikethrough
+func (textEntityTypeStrikethrough TextEntityTypeStrikethrough) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeStrikethrough
+	textEntityTypeStrikethrough.tdCommon.Type = "textEntityTypeStrikethrough"
+	return json.Marshal(alias(textEntityTypeStrikethrough))
+}
+
 // NewTextEntityTypeStrikethrough creates a new TextEntityTypeStrikethrough
 //
 func NewTextEntityTypeStrikethrough() *TextEntityTypeStrikethrough {
@@ -15623,6 +18392,13 @@ func (textEntityTypeCode *TextEntityTypeCode) MessageType() string {
 	return "textEntityTypeCode"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeCode wasn't created by NewTextEntityTypeCode
+func (textEntityTypeCode TextEntityTypeCode) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeCode
+	textEntityTypeCode.tdCommon.Type = "textEntityTypeCode"
+	return json.Marshal(alias(textEntityTypeCode))
+}
+
 // NewTextEntityTypeCode creates a new TextEntityTypeCode
 //
 func NewTextEntityTypeCode() *TextEntityTypeCode {
@@ -15648,6 +18424,13 @@ func (textEntityTypePre *TextEntityTypePre) MessageType() string {
 	return "textEntityTypePre"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypePre wasn't created by NewTextEntityTypePre
+func (textEntityTypePre TextEntityTypePre) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypePre
+	textEntityTypePre.tdCommon.Type = "textEntityTypePre"
+	return json.Marshal(alias(textEntityTypePre))
+}
+
 // NewTextEntityTypePre creates a new TextEntityTypePre
 //
 func NewTextEntityTypePre() *TextEntityTypePre {
@@ -15674,6 +18457,13 @@ func (textEntityTypePreCode *TextEntityTypePreCode) MessageType() string {
 	return "textEntityTypePreCode"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypePreCode wasn't created by NewTextEntityTypePreCode
+func (textEntityTypePreCode TextEntityTypePreCode) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypePreCode
+	textEntityTypePreCode.tdCommon.Type = "textEntityTypePreCode"
+	return json.Marshal(alias(textEntityTypePreCode))
+}
+
 // NewTextEntityTypePreCode creates a new TextEntityTypePreCode
 //
 // @param language Programming language of the code; as defined by the sender
@@ -15702,6 +18492,13 @@ func (textEntityTypeTextURL *TextEntityTypeTextURL) MessageType() string {
 	return "textEntityTypeTextUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeTextURL wasn't created by NewTextEntityTypeTextURL
+func (textEntityTypeTextURL TextEntityTypeTextURL) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeTextURL
+	textEntityTypeTextURL.tdCommon.Type = "textEntityTypeTextUrl"
+	return json.Marshal(alias(textEntityTypeTextURL))
+}
+
 // NewTextEntityTypeTextURL creates a new TextEntityTypeTextURL
 //
 // @param uRL HTTP or tg:// URL to be opened when the link is clicked
@@ -15730,6 +18527,13 @@ func (textEntityTypeMentionName *TextEntityTypeMentionName) MessageType() string
 	return "textEntityTypeMentionName"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextEntityTypeMentionName wasn't created by NewTextEntityTypeMentionName
+func (textEntityTypeMentionName TextEntityTypeMentionName) MarshalJSON() ([]byte, error) {
+	type alias TextEntityTypeMentionName
+	textEntityTypeMentionName.tdCommon.Type = "textEntityTypeMentionName"
+	return json.Marshal(alias(textEntityTypeMentionName))
+}
+
 // NewTextEntityTypeMentionName creates a new TextEntityTypeMentionName
 //
 // @param userID Identifier of the mentioned user
@@ -15760,6 +18564,13 @@ func (inputThumbnail *InputThumbnail) MessageType() string {
 	return "inputThumbnail"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputThumbnail wasn't created by NewInputThumbnail
+func (inputThumbnail InputThumbnail) MarshalJSON() ([]byte, error) {
+	type alias InputThumbnail
+	inputThumbnail.tdCommon.Type = "inputThumbnail"
+	return json.Marshal(alias(inputThumbnail))
+}
+
 // NewInputThumbnail creates a new InputThumbnail
 //
 // @param thumbnail Thumbnail file to send. Sending thumbnails by file_id is currently not supported
@@ -15814,6 +18625,13 @@ func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) Messag
 	return "messageSchedulingStateSendAtDate"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSchedulingStateSendAtDate wasn't created by NewMessageSchedulingStateSendAtDate
+func (messageSchedulingStateSendAtDate MessageSchedulingStateSendAtDate) MarshalJSON() ([]byte, error) {
+	type alias MessageSchedulingStateSendAtDate
+	messageSchedulingStateSendAtDate.tdCommon.Type = "messageSchedulingStateSendAtDate"
+	return json.Marshal(alias(messageSchedulingStateSendAtDate))
+}
+
 // NewMessageSchedulingStateSendAtDate creates a new MessageSchedulingStateSendAtDate
 //
 // @param sendDate Date the message will be sent. The date must be within 367 days in the future
@@ -15841,6 +18659,13 @@ func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline
 	return "messageSchedulingStateSendWhenOnline"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSchedulingStateSendWhenOnline wasn't created by NewMessageSchedulingStateSendWhenOnline
+func (messageSchedulingStateSendWhenOnline MessageSchedulingStateSendWhenOnline) MarshalJSON() ([]byte, error) {
+	type alias MessageSchedulingStateSendWhenOnline
+	messageSchedulingStateSendWhenOnline.tdCommon.Type = "messageSchedulingStateSendWhenOnline"
+	return json.Marshal(alias(messageSchedulingStateSendWhenOnline))
+}
+
 // NewMessageSchedulingStateSendWhenOnline creates a new MessageSchedulingStateSendWhenOnline
 //
 func NewMessageSchedulingStateSendWhenOnline() *MessageSchedulingStateSendWhenOnline {
@@ -15869,6 +18694,13 @@ func (messageSendOptions *MessageSendOptions) MessageType() string {
 	return "messageSendOptions"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageSendOptions wasn't created by NewMessageSendOptions
+func (messageSendOptions MessageSendOptions) MarshalJSON() ([]byte, error) {
+	type alias MessageSendOptions
+	messageSendOptions.tdCommon.Type = "messageSendOptions"
+	return json.Marshal(alias(messageSendOptions))
+}
+
 // NewMessageSendOptions creates a new MessageSendOptions
 //
 // @param disableNotification Pass true to disable notification for the message
@@ -15926,6 +18758,13 @@ func (messageCopyOptions *MessageCopyOptions) MessageType() string {
 	return "messageCopyOptions"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageCopyOptions wasn't created by NewMessageCopyOptions
+func (messageCopyOptions MessageCopyOptions) MarshalJSON() ([]byte, error) {
+	type alias MessageCopyOptions
+	messageCopyOptions.tdCommon.Type = "messageCopyOptions"
+	return json.Marshal(alias(messageCopyOptions))
+}
+
 // NewMessageCopyOptions creates a new MessageCopyOptions
 //
 // @param sendCopy True, if content of the message needs to be copied without a link to the original message. Always true if the message is forwarded to a secret chat
@@ -15955,6 +18794,13 @@ func (inputMessageText *InputMessageText) MessageType() string {
 	return "inputMessageText"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageText wasn't created by NewInputMessageText
+func (inputMessageText InputMessageText) MarshalJSON() ([]byte, error) {
+	type alias InputMessageText
+	inputMessageText.tdCommon.Type = "inputMessageText"
+	return json.Marshal(alias(inputMessageText))
+}
+
 // NewInputMessageText creates a new InputMessageText
 //
 // @param text Formatted text to be sent; 1-GetOption("message_text_length_max") characters. Only Bold, Italic, Underline, Strikethrough, Code, Pre, PreCode, TextUrl and MentionName entities are allowed to be specified manually
@@ -15993,6 +18839,13 @@ func (inputMessageAnimation *InputMessageAnimation) MessageType() string {
 	return "inputMessageAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageAnimation wasn't created by NewInputMessageAnimation
+func (inputMessageAnimation InputMessageAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputMessageAnimation
+	inputMessageAnimation.tdCommon.Type = "inputMessageAnimation"
+	return json.Marshal(alias(inputMessageAnimation))
+}
+
 // NewInputMessageAnimation creates a new InputMessageAnimation
 //
 // @param animation Animation file to be sent
@@ -16073,6 +18926,13 @@ func (inputMessageAudio *InputMessageAudio) MessageType() string {
 	return "inputMessageAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageAudio wasn't created by NewInputMessageAudio
+func (inputMessageAudio InputMessageAudio) MarshalJSON() ([]byte, error) {
+	type alias InputMessageAudio
+	inputMessageAudio.tdCommon.Type = "inputMessageAudio"
+	return json.Marshal(alias(inputMessageAudio))
+}
+
 // NewInputMessageAudio creates a new InputMessageAudio
 //
 // @param audio Audio file to be sent
@@ -16147,6 +19007,13 @@ func (inputMessageDocument *InputMessageDocument) MessageType() string {
 	return "inputMessageDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageDocument wasn't created by NewInputMessageDocument
+func (inputMessageDocument InputMessageDocument) MarshalJSON() ([]byte, error) {
+	type alias InputMessageDocument
+	inputMessageDocument.tdCommon.Type = "inputMessageDocument"
+	return json.Marshal(alias(inputMessageDocument))
+}
+
 // NewInputMessageDocument creates a new InputMessageDocument
 //
 // @param document Document to be sent
@@ -16216,6 +19083,13 @@ func (inputMessagePhoto *InputMessagePhoto) MessageType() string {
 	return "inputMessagePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessagePhoto wasn't created by NewInputMessagePhoto
+func (inputMessagePhoto InputMessagePhoto) MarshalJSON() ([]byte, error) {
+	type alias InputMessagePhoto
+	inputMessagePhoto.tdCommon.Type = "inputMessagePhoto"
+	return json.Marshal(alias(inputMessagePhoto))
+}
+
 // NewInputMessagePhoto creates a new InputMessagePhoto
 //
 // @param photo Photo to send
@@ -16295,6 +19169,13 @@ func (inputMessageSticker *InputMessageSticker) MessageType() string {
 	return "inputMessageSticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageSticker wasn't created by NewInputMessageSticker
+func (inputMessageSticker InputMessageSticker) MarshalJSON() ([]byte, error) {
+	type alias InputMessageSticker
+	inputMessageSticker.tdCommon.Type = "inputMessageSticker"
+	return json.Marshal(alias(inputMessageSticker))
+}
+
 // NewInputMessageSticker creates a new InputMessageSticker
 //
 // @param sticker Sticker to be sent
@@ -16370,6 +19251,13 @@ func (inputMessageVideo *InputMessageVideo) MessageType() string {
 	return "inputMessageVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageVideo wasn't created by NewInputMessageVideo
+func (inputMessageVideo InputMessageVideo) MarshalJSON() ([]byte, error) {
+	type alias InputMessageVideo
+	inputMessageVideo.tdCommon.Type = "inputMessageVideo"
+	return json.Marshal(alias(inputMessageVideo))
+}
+
 // NewInputMessageVideo creates a new InputMessageVideo
 //
 // @param video Video to be sent
@@ -16456,6 +19344,13 @@ func (inputMessageVideoNote *InputMessageVideoNote) MessageType() string {
 	return "inputMessageVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageVideoNote wasn't created by NewInputMessageVideoNote
+func (inputMessageVideoNote InputMessageVideoNote) MarshalJSON() ([]byte, error) {
+	type alias InputMessageVideoNote
+	inputMessageVideoNote.tdCommon.Type = "inputMessageVideoNote"
+	return json.Marshal(alias(inputMessageVideoNote))
+}
+
 // NewInputMessageVideoNote creates a new InputMessageVideoNote
 //
 // @param videoNote Video note to be sent
@@ -16522,6 +19417,13 @@ func (inputMessageVoiceNote *InputMessageVoiceNote) MessageType() string {
 	return "inputMessageVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageVoiceNote wasn't created by NewInputMessageVoiceNote
+func (inputMessageVoiceNote InputMessageVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias InputMessageVoiceNote
+	inputMessageVoiceNote.tdCommon.Type = "inputMessageVoiceNote"
+	return json.Marshal(alias(inputMessageVoiceNote))
+}
+
 // NewInputMessageVoiceNote creates a new InputMessageVoiceNote
 //
 // @param voiceNote Voice note to be sent
@@ -16588,6 +19490,13 @@ func (inputMessageLocation *InputMessageLocation) MessageType() string {
 	return "inputMessageLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageLocation wasn't created by NewInputMessageLocation
+func (inputMessageLocation InputMessageLocation) MarshalJSON() ([]byte, error) {
+	type alias InputMessageLocation
+	inputMessageLocation.tdCommon.Type = "inputMessageLocation"
+	return json.Marshal(alias(inputMessageLocation))
+}
+
 // NewInputMessageLocation creates a new InputMessageLocation
 //
 // @param location Location to be sent
@@ -16622,6 +19531,13 @@ func (inputMessageVenue *InputMessageVenue) MessageType() string {
 	return "inputMessageVenue"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageVenue wasn't created by NewInputMessageVenue
+func (inputMessageVenue InputMessageVenue) MarshalJSON() ([]byte, error) {
+	type alias InputMessageVenue
+	inputMessageVenue.tdCommon.Type = "inputMessageVenue"
+	return json.Marshal(alias(inputMessageVenue))
+}
+
 // NewInputMessageVenue creates a new InputMessageVenue
 //
 // @param venue Venue to send
@@ -16650,6 +19566,13 @@ func (inputMessageContact *InputMessageContact) MessageType() string {
 	return "inputMessageContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageContact wasn't created by NewInputMessageContact
+func (inputMessageContact InputMessageContact) MarshalJSON() ([]byte, error) {
+	type alias InputMessageContact
+	inputMessageContact.tdCommon.Type = "inputMessageContact"
+	return json.Marshal(alias(inputMessageContact))
+}
+
 // NewInputMessageContact creates a new InputMessageContact
 //
 // @param contact Contact to send
@@ -16679,6 +19602,13 @@ func (inputMessageDice *InputMessageDice) MessageType() string {
 	return "inputMessageDice"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageDice wasn't created by NewInputMessageDice
+func (inputMessageDice InputMessageDice) MarshalJSON() ([]byte, error) {
+	type alias InputMessageDice
+	inputMessageDice.tdCommon.Type = "inputMessageDice"
+	return json.Marshal(alias(inputMessageDice))
+}
+
 // NewInputMessageDice creates a new InputMessageDice
 //
 // @param emoji Emoji on which the dice throw animation is based
@@ -16710,6 +19640,13 @@ func (inputMessageGame *InputMessageGame) MessageType() string {
 	return "inputMessageGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageGame wasn't created by NewInputMessageGame
+func (inputMessageGame InputMessageGame) MarshalJSON() ([]byte, error) {
+	type alias InputMessageGame
+	inputMessageGame.tdCommon.Type = "inputMessageGame"
+	return json.Marshal(alias(inputMessageGame))
+}
+
 // NewInputMessageGame creates a new InputMessageGame
 //
 // @param botUserID User identifier of the bot that owns the game
@@ -16750,6 +19687,13 @@ func (inputMessageInvoice *InputMessageInvoice) MessageType() string {
 	return "inputMessageInvoice"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageInvoice wasn't created by NewInputMessageInvoice
+func (inputMessageInvoice InputMessageInvoice) MarshalJSON() ([]byte, error) {
+	type alias InputMessageInvoice
+	inputMessageInvoice.tdCommon.Type = "inputMessageInvoice"
+	return json.Marshal(alias(inputMessageInvoice))
+}
+
 // NewInputMessageInvoice creates a new InputMessageInvoice
 //
 // @param invoice Invoice
@@ -16804,6 +19748,13 @@ func (inputMessagePoll *InputMessagePoll) MessageType() string {
 	return "inputMessagePoll"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessagePoll wasn't created by NewInputMessagePoll
+func (inputMessagePoll InputMessagePoll) MarshalJSON() ([]byte, error) {
+	type alias InputMessagePoll
+	inputMessagePoll.tdCommon.Type = "inputMessagePoll"
+	return json.Marshal(alias(inputMessagePoll))
+}
+
 // NewInputMessagePoll creates a new InputMessagePoll
 //
 // @param question Poll question; 1-255 characters (up to 300 characters for bots)
@@ -16871,8 +19822,8 @@ func (inputMessagePoll *InputMessagePoll) GetInputMessageContentEnum() InputMess
 // InputMessageForwarded A forwarded message
 type InputMessageForwarded struct {
 	tdCommon
-	FromChatID  int64               `json:"from_chat_id"`  // Identifier for the chat this forwarded message came from
-	MessageID   int64               `json:"message_id"`    // Identifier of the message to forward
+	FromChatID  JSONInt64           `json:"from_chat_id"`  // Identifier for the chat this forwarded message came from
+	MessageID   JSONInt64           `json:"message_id"`    // Identifier of the message to forward
 	InGameShare bool                `json:"in_game_share"` // True, if a game message should be shared within a launched game; applies only to game messages
 	CopyOptions *MessageCopyOptions `json:"copy_options"`  // Options to be used to copy content of the message without a link to the original message
 }
@@ -16882,13 +19833,20 @@ func (inputMessageForwarded *InputMessageForwarded) MessageType() string {
 	return "inputMessageForwarded"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputMessageForwarded wasn't created by NewInputMessageForwarded
+func (inputMessageForwarded InputMessageForwarded) MarshalJSON() ([]byte, error) {
+	type alias InputMessageForwarded
+	inputMessageForwarded.tdCommon.Type = "inputMessageForwarded"
+	return json.Marshal(alias(inputMessageForwarded))
+}
+
 // NewInputMessageForwarded creates a new InputMessageForwarded
 //
 // @param fromChatID Identifier for the chat this forwarded message came from
 // @param messageID Identifier of the message to forward
 // @param inGameShare True, if a game message should be shared within a launched game; applies only to game messages
 // @param copyOptions Options to be used to copy content of the message without a link to the original message
-func NewInputMessageForwarded(fromChatID int64, messageID int64, inGameShare bool, copyOptions *MessageCopyOptions) *InputMessageForwarded {
+func NewInputMessageForwarded(fromChatID JSONInt64, messageID JSONInt64, inGameShare bool, copyOptions *MessageCopyOptions) *InputMessageForwarded {
 	inputMessageForwardedTemp := InputMessageForwarded{
 		tdCommon:    tdCommon{Type: "inputMessageForwarded"},
 		FromChatID:  fromChatID,
@@ -16915,6 +19873,13 @@ func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) MessageType() string
 	return "searchMessagesFilterEmpty"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterEmpty wasn't created by NewSearchMessagesFilterEmpty
+func (searchMessagesFilterEmpty SearchMessagesFilterEmpty) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterEmpty
+	searchMessagesFilterEmpty.tdCommon.Type = "searchMessagesFilterEmpty"
+	return json.Marshal(alias(searchMessagesFilterEmpty))
+}
+
 // NewSearchMessagesFilterEmpty creates a new SearchMessagesFilterEmpty
 //
 func NewSearchMessagesFilterEmpty() *SearchMessagesFilterEmpty {
@@ -16940,6 +19905,13 @@ func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) MessageType(
 	return "searchMessagesFilterAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterAnimation wasn't created by NewSearchMessagesFilterAnimation
+func (searchMessagesFilterAnimation SearchMessagesFilterAnimation) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterAnimation
+	searchMessagesFilterAnimation.tdCommon.Type = "searchMessagesFilterAnimation"
+	return json.Marshal(alias(searchMessagesFilterAnimation))
+}
+
 // NewSearchMessagesFilterAnimation creates a new SearchMessagesFilterAnimation
 //
 func NewSearchMessagesFilterAnimation() *SearchMessagesFilterAnimation {
@@ -16965,6 +19937,13 @@ func (searchMessagesFilterAudio *SearchMessagesFilterAudio) MessageType() string
 	return "searchMessagesFilterAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterAudio wasn't created by NewSearchMessagesFilterAudio
+func (searchMessagesFilterAudio SearchMessagesFilterAudio) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterAudio
+	searchMessagesFilterAudio.tdCommon.Type = "searchMessagesFilterAudio"
+	return json.Marshal(alias(searchMessagesFilterAudio))
+}
+
 // NewSearchMessagesFilterAudio creates a new SearchMessagesFilterAudio
 //
 func NewSearchMessagesFilterAudio() *SearchMessagesFilterAudio {
@@ -16990,6 +19969,13 @@ func (searchMessagesFilterDocument *SearchMessagesFilterDocument) MessageType()
 	return "searchMessagesFilterDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterDocument wasn't created by NewSearchMessagesFilterDocument
+func (searchMessagesFilterDocument SearchMessagesFilterDocument) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterDocument
+	searchMessagesFilterDocument.tdCommon.Type = "searchMessagesFilterDocument"
+	return json.Marshal(alias(searchMessagesFilterDocument))
+}
+
 // NewSearchMessagesFilterDocument creates a new SearchMessagesFilterDocument
 //
 func NewSearchMessagesFilterDocument() *SearchMessagesFilterDocument {
@@ -17015,6 +20001,13 @@ func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) MessageType() string
 	return "searchMessagesFilterPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterPhoto wasn't created by NewSearchMessagesFilterPhoto
+func (searchMessagesFilterPhoto SearchMessagesFilterPhoto) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterPhoto
+	searchMessagesFilterPhoto.tdCommon.Type = "searchMessagesFilterPhoto"
+	return json.Marshal(alias(searchMessagesFilterPhoto))
+}
+
 // NewSearchMessagesFilterPhoto creates a new SearchMessagesFilterPhoto
 //
 func NewSearchMessagesFilterPhoto() *SearchMessagesFilterPhoto {
@@ -17040,6 +20033,13 @@ func (searchMessagesFilterVideo *SearchMessagesFilterVideo) MessageType() string
 	return "searchMessagesFilterVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterVideo wasn't created by NewSearchMessagesFilterVideo
+func (searchMessagesFilterVideo SearchMessagesFilterVideo) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterVideo
+	searchMessagesFilterVideo.tdCommon.Type = "searchMessagesFilterVideo"
+	return json.Marshal(alias(searchMessagesFilterVideo))
+}
+
 // NewSearchMessagesFilterVideo creates a new SearchMessagesFilterVideo
 //
 func NewSearchMessagesFilterVideo() *SearchMessagesFilterVideo {
@@ -17065,6 +20065,13 @@ func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) MessageType(
 	return "searchMessagesFilterVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterVoiceNote wasn't created by NewSearchMessagesFilterVoiceNote
+func (searchMessagesFilterVoiceNote SearchMessagesFilterVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterVoiceNote
+	searchMessagesFilterVoiceNote.tdCommon.Type = "searchMessagesFilterVoiceNote"
+	return json.Marshal(alias(searchMessagesFilterVoiceNote))
+}
+
 // NewSearchMessagesFilterVoiceNote creates a new SearchMessagesFilterVoiceNote
 //
 func NewSearchMessagesFilterVoiceNote() *SearchMessagesFilterVoiceNote {
@@ -17090,6 +20097,13 @@ func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) Mess
 	return "searchMessagesFilterPhotoAndVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterPhotoAndVideo wasn't created by NewSearchMessagesFilterPhotoAndVideo
+func (searchMessagesFilterPhotoAndVideo SearchMessagesFilterPhotoAndVideo) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterPhotoAndVideo
+	searchMessagesFilterPhotoAndVideo.tdCommon.Type = "searchMessagesFilterPhotoAndVideo"
+	return json.Marshal(alias(searchMessagesFilterPhotoAndVideo))
+}
+
 // NewSearchMessagesFilterPhotoAndVideo creates a new SearchMessagesFilterPhotoAndVideo
 //
 func NewSearchMessagesFilterPhotoAndVideo() *SearchMessagesFilterPhotoAndVideo {
@@ -17115,6 +20129,13 @@ func (searchMessagesFilterURL *SearchMessagesFilterURL) MessageType() string {
 	return "searchMessagesFilterUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterURL wasn't created by NewSearchMessagesFilterURL
+func (searchMessagesFilterURL SearchMessagesFilterURL) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterURL
+	searchMessagesFilterURL.tdCommon.Type = "searchMessagesFilterUrl"
+	return json.Marshal(alias(searchMessagesFilterURL))
+}
+
 // NewSearchMessagesFilterURL creates a new SearchMessagesFilterURL
 //
 func NewSearchMessagesFilterURL() *SearchMessagesFilterURL {
@@ -17140,6 +20161,13 @@ func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) MessageType(
 	return "searchMessagesFilterChatPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterChatPhoto wasn't created by NewSearchMessagesFilterChatPhoto
+func (searchMessagesFilterChatPhoto SearchMessagesFilterChatPhoto) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterChatPhoto
+	searchMessagesFilterChatPhoto.tdCommon.Type = "searchMessagesFilterChatPhoto"
+	return json.Marshal(alias(searchMessagesFilterChatPhoto))
+}
+
 // NewSearchMessagesFilterChatPhoto creates a new SearchMessagesFilterChatPhoto
 //
 func NewSearchMessagesFilterChatPhoto() *SearchMessagesFilterChatPhoto {
@@ -17165,6 +20193,13 @@ func (searchMessagesFilterCall *SearchMessagesFilterCall) MessageType() string {
 	return "searchMessagesFilterCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterCall wasn't created by NewSearchMessagesFilterCall
+func (searchMessagesFilterCall SearchMessagesFilterCall) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterCall
+	searchMessagesFilterCall.tdCommon.Type = "searchMessagesFilterCall"
+	return json.Marshal(alias(searchMessagesFilterCall))
+}
+
 // NewSearchMessagesFilterCall creates a new SearchMessagesFilterCall
 //
 func NewSearchMessagesFilterCall() *SearchMessagesFilterCall {
@@ -17190,6 +20225,13 @@ func (searchMessagesFilterMissedCall *SearchMessagesFilterMissedCall) MessageTyp
 	return "searchMessagesFilterMissedCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterMissedCall wasn't created by NewSearchMessagesFilterMissedCall
+func (searchMessagesFilterMissedCall SearchMessagesFilterMissedCall) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterMissedCall
+	searchMessagesFilterMissedCall.tdCommon.Type = "searchMessagesFilterMissedCall"
+	return json.Marshal(alias(searchMessagesFilterMissedCall))
+}
+
 // NewSearchMessagesFilterMissedCall creates a new SearchMessagesFilterMissedCall
 //
 func NewSearchMessagesFilterMissedCall() *SearchMessagesFilterMissedCall {
@@ -17215,6 +20257,13 @@ func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) MessageType(
 	return "searchMessagesFilterVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterVideoNote wasn't created by NewSearchMessagesFilterVideoNote
+func (searchMessagesFilterVideoNote SearchMessagesFilterVideoNote) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterVideoNote
+	searchMessagesFilterVideoNote.tdCommon.Type = "searchMessagesFilterVideoNote"
+	return json.Marshal(alias(searchMessagesFilterVideoNote))
+}
+
 // NewSearchMessagesFilterVideoNote creates a new SearchMessagesFilterVideoNote
 //
 func NewSearchMessagesFilterVideoNote() *SearchMessagesFilterVideoNote {
@@ -17240,6 +20289,13 @@ func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNo
 	return "searchMessagesFilterVoiceAndVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterVoiceAndVideoNote wasn't created by NewSearchMessagesFilterVoiceAndVideoNote
+func (searchMessagesFilterVoiceAndVideoNote SearchMessagesFilterVoiceAndVideoNote) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterVoiceAndVideoNote
+	searchMessagesFilterVoiceAndVideoNote.tdCommon.Type = "searchMessagesFilterVoiceAndVideoNote"
+	return json.Marshal(alias(searchMessagesFilterVoiceAndVideoNote))
+}
+
 // NewSearchMessagesFilterVoiceAndVideoNote creates a new SearchMessagesFilterVoiceAndVideoNote
 //
 func NewSearchMessagesFilterVoiceAndVideoNote() *SearchMessagesFilterVoiceAndVideoNote {
@@ -17265,6 +20321,13 @@ func (searchMessagesFilterMention *SearchMessagesFilterMention) MessageType() st
 	return "searchMessagesFilterMention"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterMention wasn't created by NewSearchMessagesFilterMention
+func (searchMessagesFilterMention SearchMessagesFilterMention) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterMention
+	searchMessagesFilterMention.tdCommon.Type = "searchMessagesFilterMention"
+	return json.Marshal(alias(searchMessagesFilterMention))
+}
+
 // NewSearchMessagesFilterMention creates a new SearchMessagesFilterMention
 //
 func NewSearchMessagesFilterMention() *SearchMessagesFilterMention {
@@ -17290,6 +20353,13 @@ func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) Mess
 	return "searchMessagesFilterUnreadMention"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterUnreadMention wasn't created by NewSearchMessagesFilterUnreadMention
+func (searchMessagesFilterUnreadMention SearchMessagesFilterUnreadMention) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterUnreadMention
+	searchMessagesFilterUnreadMention.tdCommon.Type = "searchMessagesFilterUnreadMention"
+	return json.Marshal(alias(searchMessagesFilterUnreadMention))
+}
+
 // NewSearchMessagesFilterUnreadMention creates a new SearchMessagesFilterUnreadMention
 //
 func NewSearchMessagesFilterUnreadMention() *SearchMessagesFilterUnreadMention {
@@ -17315,6 +20385,13 @@ func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) Messag
 	return "searchMessagesFilterFailedToSend"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterFailedToSend wasn't created by NewSearchMessagesFilterFailedToSend
+func (searchMessagesFilterFailedToSend SearchMessagesFilterFailedToSend) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterFailedToSend
+	searchMessagesFilterFailedToSend.tdCommon.Type = "searchMessagesFilterFailedToSend"
+	return json.Marshal(alias(searchMessagesFilterFailedToSend))
+}
+
 // NewSearchMessagesFilterFailedToSend creates a new SearchMessagesFilterFailedToSend
 //
 func NewSearchMessagesFilterFailedToSend() *SearchMessagesFilterFailedToSend {
@@ -17340,6 +20417,13 @@ func (searchMessagesFilterPinned *SearchMessagesFilterPinned) MessageType() stri
 	return "searchMessagesFilterPinned"
 }
 
+// MarshalJSON marshals to json, with @type set even if SearchMessagesFilterPinned wasn't created by NewSearchMessagesFilterPinned
+func (searchMessagesFilterPinned SearchMessagesFilterPinned) MarshalJSON() ([]byte, error) {
+	type alias SearchMessagesFilterPinned
+	searchMessagesFilterPinned.tdCommon.Type = "searchMessagesFilterPinned"
+	return json.Marshal(alias(searchMessagesFilterPinned))
+}
+
 // NewSearchMessagesFilterPinned creates a new SearchMessagesFilterPinned
 //
 func NewSearchMessagesFilterPinned() *SearchMessagesFilterPinned {
@@ -17365,6 +20449,13 @@ func (chatActionTyping *ChatActionTyping) MessageType() string {
 	return "chatActionTyping"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionTyping wasn't created by NewChatActionTyping
+func (chatActionTyping ChatActionTyping) MarshalJSON() ([]byte, error) {
+	type alias ChatActionTyping
+	chatActionTyping.tdCommon.Type = "chatActionTyping"
+	return json.Marshal(alias(chatActionTyping))
+}
+
 // NewChatActionTyping creates a new ChatActionTyping
 //
 func NewChatActionTyping() *ChatActionTyping {
@@ -17390,6 +20481,13 @@ func (chatActionRecordingVideo *ChatActionRecordingVideo) MessageType() string {
 	return "chatActionRecordingVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionRecordingVideo wasn't created by NewChatActionRecordingVideo
+func (chatActionRecordingVideo ChatActionRecordingVideo) MarshalJSON() ([]byte, error) {
+	type alias ChatActionRecordingVideo
+	chatActionRecordingVideo.tdCommon.Type = "chatActionRecordingVideo"
+	return json.Marshal(alias(chatActionRecordingVideo))
+}
+
 // NewChatActionRecordingVideo creates a new ChatActionRecordingVideo
 //
 func NewChatActionRecordingVideo() *ChatActionRecordingVideo {
@@ -17416,6 +20514,13 @@ func (chatActionUploadingVideo *ChatActionUploadingVideo) MessageType() string {
 	return "chatActionUploadingVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionUploadingVideo wasn't created by NewChatActionUploadingVideo
+func (chatActionUploadingVideo ChatActionUploadingVideo) MarshalJSON() ([]byte, error) {
+	type alias ChatActionUploadingVideo
+	chatActionUploadingVideo.tdCommon.Type = "chatActionUploadingVideo"
+	return json.Marshal(alias(chatActionUploadingVideo))
+}
+
 // NewChatActionUploadingVideo creates a new ChatActionUploadingVideo
 //
 // @param progress Upload progress, as a percentage
@@ -17443,6 +20548,13 @@ func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) MessageType()
 	return "chatActionRecordingVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionRecordingVoiceNote wasn't created by NewChatActionRecordingVoiceNote
+func (chatActionRecordingVoiceNote ChatActionRecordingVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias ChatActionRecordingVoiceNote
+	chatActionRecordingVoiceNote.tdCommon.Type = "chatActionRecordingVoiceNote"
+	return json.Marshal(alias(chatActionRecordingVoiceNote))
+}
+
 // NewChatActionRecordingVoiceNote creates a new ChatActionRecordingVoiceNote
 //
 func NewChatActionRecordingVoiceNote() *ChatActionRecordingVoiceNote {
@@ -17469,6 +20581,13 @@ func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) MessageType()
 	return "chatActionUploadingVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionUploadingVoiceNote wasn't created by NewChatActionUploadingVoiceNote
+func (chatActionUploadingVoiceNote ChatActionUploadingVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias ChatActionUploadingVoiceNote
+	chatActionUploadingVoiceNote.tdCommon.Type = "chatActionUploadingVoiceNote"
+	return json.Marshal(alias(chatActionUploadingVoiceNote))
+}
+
 // NewChatActionUploadingVoiceNote creates a new ChatActionUploadingVoiceNote
 //
 // @param progress Upload progress, as a percentage
@@ -17497,6 +20616,13 @@ func (chatActionUploadingPhoto *ChatActionUploadingPhoto) MessageType() string {
 	return "chatActionUploadingPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionUploadingPhoto wasn't created by NewChatActionUploadingPhoto
+func (chatActionUploadingPhoto ChatActionUploadingPhoto) MarshalJSON() ([]byte, error) {
+	type alias ChatActionUploadingPhoto
+	chatActionUploadingPhoto.tdCommon.Type = "chatActionUploadingPhoto"
+	return json.Marshal(alias(chatActionUploadingPhoto))
+}
+
 // NewChatActionUploadingPhoto creates a new ChatActionUploadingPhoto
 //
 // @param progress Upload progress, as a percentage
@@ -17525,6 +20651,13 @@ func (chatActionUploadingDocument *ChatActionUploadingDocument) MessageType() st
 	return "chatActionUploadingDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionUploadingDocument wasn't created by NewChatActionUploadingDocument
+func (chatActionUploadingDocument ChatActionUploadingDocument) MarshalJSON() ([]byte, error) {
+	type alias ChatActionUploadingDocument
+	chatActionUploadingDocument.tdCommon.Type = "chatActionUploadingDocument"
+	return json.Marshal(alias(chatActionUploadingDocument))
+}
+
 // NewChatActionUploadingDocument creates a new ChatActionUploadingDocument
 //
 // @param progress Upload progress, as a percentage
@@ -17552,6 +20685,13 @@ func (chatActionChoosingLocation *ChatActionChoosingLocation) MessageType() stri
 	return "chatActionChoosingLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionChoosingLocation wasn't created by NewChatActionChoosingLocation
+func (chatActionChoosingLocation ChatActionChoosingLocation) MarshalJSON() ([]byte, error) {
+	type alias ChatActionChoosingLocation
+	chatActionChoosingLocation.tdCommon.Type = "chatActionChoosingLocation"
+	return json.Marshal(alias(chatActionChoosingLocation))
+}
+
 // NewChatActionChoosingLocation creates a new ChatActionChoosingLocation
 //
 func NewChatActionChoosingLocation() *ChatActionChoosingLocation {
@@ -17577,6 +20717,13 @@ func (chatActionChoosingContact *ChatActionChoosingContact) MessageType() string
 	return "chatActionChoosingContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionChoosingContact wasn't created by NewChatActionChoosingContact
+func (chatActionChoosingContact ChatActionChoosingContact) MarshalJSON() ([]byte, error) {
+	type alias ChatActionChoosingContact
+	chatActionChoosingContact.tdCommon.Type = "chatActionChoosingContact"
+	return json.Marshal(alias(chatActionChoosingContact))
+}
+
 // NewChatActionChoosingContact creates a new ChatActionChoosingContact
 //
 func NewChatActionChoosingContact() *ChatActionChoosingContact {
@@ -17602,6 +20749,13 @@ func (chatActionStartPlayingGame *ChatActionStartPlayingGame) MessageType() stri
 	return "chatActionStartPlayingGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionStartPlayingGame wasn't created by NewChatActionStartPlayingGame
+func (chatActionStartPlayingGame ChatActionStartPlayingGame) MarshalJSON() ([]byte, error) {
+	type alias ChatActionStartPlayingGame
+	chatActionStartPlayingGame.tdCommon.Type = "chatActionStartPlayingGame"
+	return json.Marshal(alias(chatActionStartPlayingGame))
+}
+
 // NewChatActionStartPlayingGame creates a new ChatActionStartPlayingGame
 //
 func NewChatActionStartPlayingGame() *ChatActionStartPlayingGame {
@@ -17627,6 +20781,13 @@ func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) MessageType()
 	return "chatActionRecordingVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionRecordingVideoNote wasn't created by NewChatActionRecordingVideoNote
+func (chatActionRecordingVideoNote ChatActionRecordingVideoNote) MarshalJSON() ([]byte, error) {
+	type alias ChatActionRecordingVideoNote
+	chatActionRecordingVideoNote.tdCommon.Type = "chatActionRecordingVideoNote"
+	return json.Marshal(alias(chatActionRecordingVideoNote))
+}
+
 // NewChatActionRecordingVideoNote creates a new ChatActionRecordingVideoNote
 //
 func NewChatActionRecordingVideoNote() *ChatActionRecordingVideoNote {
@@ -17653,6 +20814,13 @@ func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) MessageType()
 	return "chatActionUploadingVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionUploadingVideoNote wasn't created by NewChatActionUploadingVideoNote
+func (chatActionUploadingVideoNote ChatActionUploadingVideoNote) MarshalJSON() ([]byte, error) {
+	type alias ChatActionUploadingVideoNote
+	chatActionUploadingVideoNote.tdCommon.Type = "chatActionUploadingVideoNote"
+	return json.Marshal(alias(chatActionUploadingVideoNote))
+}
+
 // NewChatActionUploadingVideoNote creates a new ChatActionUploadingVideoNote
 //
 // @param progress Upload progress, as a percentage
@@ -17680,6 +20848,13 @@ func (chatActionCancel *ChatActionCancel) MessageType() string {
 	return "chatActionCancel"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatActionCancel wasn't created by NewChatActionCancel
+func (chatActionCancel ChatActionCancel) MarshalJSON() ([]byte, error) {
+	type alias ChatActionCancel
+	chatActionCancel.tdCommon.Type = "chatActionCancel"
+	return json.Marshal(alias(chatActionCancel))
+}
+
 // NewChatActionCancel creates a new ChatActionCancel
 //
 func NewChatActionCancel() *ChatActionCancel {
@@ -17705,6 +20880,13 @@ func (userStatusEmpty *UserStatusEmpty) MessageType() string {
 	return "userStatusEmpty"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserStatusEmpty wasn't created by NewUserStatusEmpty
+func (userStatusEmpty UserStatusEmpty) MarshalJSON() ([]byte, error) {
+	type alias UserStatusEmpty
+	userStatusEmpty.tdCommon.Type = "userStatusEmpty"
+	return json.Marshal(alias(userStatusEmpty))
+}
+
 // NewUserStatusEmpty creates a new UserStatusEmpty
 //
 func NewUserStatusEmpty() *UserStatusEmpty {
@@ -17731,6 +20913,13 @@ func (userStatusOnline *UserStatusOnline) MessageType() string {
 	return "userStatusOnline"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserStatusOnline wasn't created by NewUserStatusOnline
+func (userStatusOnline UserStatusOnline) MarshalJSON() ([]byte, error) {
+	type alias UserStatusOnline
+	userStatusOnline.tdCommon.Type = "userStatusOnline"
+	return json.Marshal(alias(userStatusOnline))
+}
+
 // NewUserStatusOnline creates a new UserStatusOnline
 //
 // @param expires Point in time (Unix timestamp) when the user's online status will expire
@@ -17759,6 +20948,13 @@ func (userStatusOffline *UserStatusOffline) MessageType() string {
 	return "userStatusOffline"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserStatusOffline wasn't created by NewUserStatusOffline
+func (userStatusOffline UserStatusOffline) MarshalJSON() ([]byte, error) {
+	type alias UserStatusOffline
+	userStatusOffline.tdCommon.Type = "userStatusOffline"
+	return json.Marshal(alias(userStatusOffline))
+}
+
 // NewUserStatusOffline creates a new UserStatusOffline
 //
 // @param wasOnline Point in time (Unix timestamp) when the user was last online
@@ -17786,6 +20982,13 @@ func (userStatusRecently *UserStatusRecently) MessageType() string {
 	return "userStatusRecently"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserStatusRecently wasn't created by NewUserStatusRecently
+func (userStatusRecently UserStatusRecently) MarshalJSON() ([]byte, error) {
+	type alias UserStatusRecently
+	userStatusRecently.tdCommon.Type = "userStatusRecently"
+	return json.Marshal(alias(userStatusRecently))
+}
+
 // NewUserStatusRecently creates a new UserStatusRecently
 //
 func NewUserStatusRecently() *UserStatusRecently {
@@ -17811,6 +21014,13 @@ func (userStatusLastWeek *UserStatusLastWeek) MessageType() string {
 	return "userStatusLastWeek"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserStatusLastWeek wasn't created by NewUserStatusLastWeek
+func (userStatusLastWeek UserStatusLastWeek) MarshalJSON() ([]byte, error) {
+	type alias UserStatusLastWeek
+	userStatusLastWeek.tdCommon.Type = "userStatusLastWeek"
+	return json.Marshal(alias(userStatusLastWeek))
+}
+
 // NewUserStatusLastWeek creates a new UserStatusLastWeek
 //
 func NewUserStatusLastWeek() *UserStatusLastWeek {
@@ -17836,6 +21046,13 @@ func (userStatusLastMonth *UserStatusLastMonth) MessageType() string {
 	return "userStatusLastMonth"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserStatusLastMonth wasn't created by NewUserStatusLastMonth
+func (userStatusLastMonth UserStatusLastMonth) MarshalJSON() ([]byte, error) {
+	type alias UserStatusLastMonth
+	userStatusLastMonth.tdCommon.Type = "userStatusLastMonth"
+	return json.Marshal(alias(userStatusLastMonth))
+}
+
 // NewUserStatusLastMonth creates a new UserStatusLastMonth
 //
 func NewUserStatusLastMonth() *UserStatusLastMonth {
@@ -17862,6 +21079,13 @@ func (stickers *Stickers) MessageType() string {
 	return "stickers"
 }
 
+// MarshalJSON marshals to json, with @type set even if Stickers wasn't created by NewStickers
+func (stickers Stickers) MarshalJSON() ([]byte, error) {
+	type alias Stickers
+	stickers.tdCommon.Type = "stickers"
+	return json.Marshal(alias(stickers))
+}
+
 // NewStickers creates a new Stickers
 //
 // @param stickers List of stickers
@@ -17885,6 +21109,13 @@ func (emojis *Emojis) MessageType() string {
 	return "emojis"
 }
 
+// MarshalJSON marshals to json, with @type set even if Emojis wasn't created by NewEmojis
+func (emojis Emojis) MarshalJSON() ([]byte, error) {
+	type alias Emojis
+	emojis.tdCommon.Type = "emojis"
+	return json.Marshal(alias(emojis))
+}
+
 // NewEmojis creates a new Emojis
 //
 // @param emojis List of emojis
@@ -17920,6 +21151,13 @@ func (stickerSet *StickerSet) MessageType() string {
 	return "stickerSet"
 }
 
+// MarshalJSON marshals to json, with @type set even if StickerSet wasn't created by NewStickerSet
+func (stickerSet StickerSet) MarshalJSON() ([]byte, error) {
+	type alias StickerSet
+	stickerSet.tdCommon.Type = "stickerSet"
+	return json.Marshal(alias(stickerSet))
+}
+
 // NewStickerSet creates a new StickerSet
 //
 // @param iD Identifier of the sticker set
@@ -17979,6 +21217,13 @@ func (stickerSetInfo *StickerSetInfo) MessageType() string {
 	return "stickerSetInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if StickerSetInfo wasn't created by NewStickerSetInfo
+func (stickerSetInfo StickerSetInfo) MarshalJSON() ([]byte, error) {
+	type alias StickerSetInfo
+	stickerSetInfo.tdCommon.Type = "stickerSetInfo"
+	return json.Marshal(alias(stickerSetInfo))
+}
+
 // NewStickerSetInfo creates a new StickerSetInfo
 //
 // @param iD Identifier of the sticker set
@@ -18027,6 +21272,13 @@ func (stickerSets *StickerSets) MessageType() string {
 	return "stickerSets"
 }
 
+// MarshalJSON marshals to json, with @type set even if StickerSets wasn't created by NewStickerSets
+func (stickerSets StickerSets) MarshalJSON() ([]byte, error) {
+	type alias StickerSets
+	stickerSets.tdCommon.Type = "stickerSets"
+	return json.Marshal(alias(stickerSets))
+}
+
 // NewStickerSets creates a new StickerSets
 //
 // @param totalCount Approximate total number of sticker sets found
@@ -18051,6 +21303,13 @@ func (callDiscardReasonEmpty *CallDiscardReasonEmpty) MessageType() string {
 	return "callDiscardReasonEmpty"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallDiscardReasonEmpty wasn't created by NewCallDiscardReasonEmpty
+func (callDiscardReasonEmpty CallDiscardReasonEmpty) MarshalJSON() ([]byte, error) {
+	type alias CallDiscardReasonEmpty
+	callDiscardReasonEmpty.tdCommon.Type = "callDiscardReasonEmpty"
+	return json.Marshal(alias(callDiscardReasonEmpty))
+}
+
 // NewCallDiscardReasonEmpty creates a new CallDiscardReasonEmpty
 //
 func NewCallDiscardReasonEmpty() *CallDiscardReasonEmpty {
@@ -18076,6 +21335,13 @@ func (callDiscardReasonMissed *CallDiscardReasonMissed) MessageType() string {
 	return "callDiscardReasonMissed"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallDiscardReasonMissed wasn't created by NewCallDiscardReasonMissed
+func (callDiscardReasonMissed CallDiscardReasonMissed) MarshalJSON() ([]byte, error) {
+	type alias CallDiscardReasonMissed
+	callDiscardReasonMissed.tdCommon.Type = "callDiscardReasonMissed"
+	return json.Marshal(alias(callDiscardReasonMissed))
+}
+
 // NewCallDiscardReasonMissed creates a new CallDiscardReasonMissed
 //
 func NewCallDiscardReasonMissed() *CallDiscardReasonMissed {
@@ -18101,6 +21367,13 @@ func (callDiscardReasonDeclined *CallDiscardReasonDeclined) MessageType() string
 	return "callDiscardReasonDeclined"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallDiscardReasonDeclined wasn't created by NewCallDiscardReasonDeclined
+func (callDiscardReasonDeclined CallDiscardReasonDeclined) MarshalJSON() ([]byte, error) {
+	type alias CallDiscardReasonDeclined
+	callDiscardReasonDeclined.tdCommon.Type = "callDiscardReasonDeclined"
+	return json.Marshal(alias(callDiscardReasonDeclined))
+}
+
 // NewCallDiscardReasonDeclined creates a new CallDiscardReasonDeclined
 //
 func NewCallDiscardReasonDeclined() *CallDiscardReasonDeclined {
@@ -18126,6 +21399,13 @@ func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) MessageType(
 	return "callDiscardReasonDisconnected"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallDiscardReasonDisconnected wasn't created by NewCallDiscardReasonDisconnected
+func (callDiscardReasonDisconnected CallDiscardReasonDisconnected) MarshalJSON() ([]byte, error) {
+	type alias CallDiscardReasonDisconnected
+	callDiscardReasonDisconnected.tdCommon.Type = "callDiscardReasonDisconnected"
+	return json.Marshal(alias(callDiscardReasonDisconnected))
+}
+
 // NewCallDiscardReasonDisconnected creates a new CallDiscardReasonDisconnected
 //
 func NewCallDiscardReasonDisconnected() *CallDiscardReasonDisconnected {
@@ -18151,6 +21431,13 @@ func (callDiscardReasonHungUp *CallDiscardReasonHungUp) MessageType() string {
 	return "callDiscardReasonHungUp"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallDiscardReasonHungUp wasn't created by NewCallDiscardReasonHungUp
+func (callDiscardReasonHungUp CallDiscardReasonHungUp) MarshalJSON() ([]byte, error) {
+	type alias CallDiscardReasonHungUp
+	callDiscardReasonHungUp.tdCommon.Type = "callDiscardReasonHungUp"
+	return json.Marshal(alias(callDiscardReasonHungUp))
+}
+
 // NewCallDiscardReasonHungUp creates a new CallDiscardReasonHungUp
 //
 func NewCallDiscardReasonHungUp() *CallDiscardReasonHungUp {
@@ -18181,6 +21468,13 @@ func (callProtocol *CallProtocol) MessageType() string {
 	return "callProtocol"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProtocol wasn't created by NewCallProtocol
+func (callProtocol CallProtocol) MarshalJSON() ([]byte, error) {
+	type alias CallProtocol
+	callProtocol.tdCommon.Type = "callProtocol"
+	return json.Marshal(alias(callProtocol))
+}
+
 // NewCallProtocol creates a new CallProtocol
 //
 // @param uDPP2p True, if UDP peer-to-peer connections are supported
@@ -18212,6 +21506,13 @@ func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) MessageT
 	return "callServerTypeTelegramReflector"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallServerTypeTelegramReflector wasn't created by NewCallServerTypeTelegramReflector
+func (callServerTypeTelegramReflector CallServerTypeTelegramReflector) MarshalJSON() ([]byte, error) {
+	type alias CallServerTypeTelegramReflector
+	callServerTypeTelegramReflector.tdCommon.Type = "callServerTypeTelegramReflector"
+	return json.Marshal(alias(callServerTypeTelegramReflector))
+}
+
 // NewCallServerTypeTelegramReflector creates a new CallServerTypeTelegramReflector
 //
 // @param peerTag A peer tag to be used with the reflector
@@ -18243,6 +21544,13 @@ func (callServerTypeWebrtc *CallServerTypeWebrtc) MessageType() string {
 	return "callServerTypeWebrtc"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallServerTypeWebrtc wasn't created by NewCallServerTypeWebrtc
+func (callServerTypeWebrtc CallServerTypeWebrtc) MarshalJSON() ([]byte, error) {
+	type alias CallServerTypeWebrtc
+	callServerTypeWebrtc.tdCommon.Type = "callServerTypeWebrtc"
+	return json.Marshal(alias(callServerTypeWebrtc))
+}
+
 // NewCallServerTypeWebrtc creates a new CallServerTypeWebrtc
 //
 // @param username Username to be used for authentication
@@ -18281,6 +21589,13 @@ func (callServer *CallServer) MessageType() string {
 	return "callServer"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallServer wasn't created by NewCallServer
+func (callServer CallServer) MarshalJSON() ([]byte, error) {
+	type alias CallServer
+	callServer.tdCommon.Type = "callServer"
+	return json.Marshal(alias(callServer))
+}
+
 // NewCallServer creates a new CallServer
 //
 // @param iD Server identifier
@@ -18344,6 +21659,13 @@ func (callID *CallID) MessageType() string {
 	return "callId"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallID wasn't created by NewCallID
+func (callID CallID) MarshalJSON() ([]byte, error) {
+	type alias CallID
+	callID.tdCommon.Type = "callId"
+	return json.Marshal(alias(callID))
+}
+
 // NewCallID creates a new CallID
 //
 // @param iD Call identifier
@@ -18367,6 +21689,13 @@ func (groupCallID *GroupCallID) MessageType() string {
 	return "groupCallId"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallID wasn't created by NewGroupCallID
+func (groupCallID GroupCallID) MarshalJSON() ([]byte, error) {
+	type alias GroupCallID
+	groupCallID.tdCommon.Type = "groupCallId"
+	return json.Marshal(alias(groupCallID))
+}
+
 // NewGroupCallID creates a new GroupCallID
 //
 // @param iD Group call identifier
@@ -18391,6 +21720,13 @@ func (callStatePending *CallStatePending) MessageType() string {
 	return "callStatePending"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallStatePending wasn't created by NewCallStatePending
+func (callStatePending CallStatePending) MarshalJSON() ([]byte, error) {
+	type alias CallStatePending
+	callStatePending.tdCommon.Type = "callStatePending"
+	return json.Marshal(alias(callStatePending))
+}
+
 // NewCallStatePending creates a new CallStatePending
 //
 // @param isCreated True, if the call has already been created by the server
@@ -18420,6 +21756,13 @@ func (callStateExchangingKeys *CallStateExchangingKeys) MessageType() string {
 	return "callStateExchangingKeys"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallStateExchangingKeys wasn't created by NewCallStateExchangingKeys
+func (callStateExchangingKeys CallStateExchangingKeys) MarshalJSON() ([]byte, error) {
+	type alias CallStateExchangingKeys
+	callStateExchangingKeys.tdCommon.Type = "callStateExchangingKeys"
+	return json.Marshal(alias(callStateExchangingKeys))
+}
+
 // NewCallStateExchangingKeys creates a new CallStateExchangingKeys
 //
 func NewCallStateExchangingKeys() *CallStateExchangingKeys {
@@ -18451,6 +21794,13 @@ func (callStateReady *CallStateReady) MessageType() string {
 	return "callStateReady"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallStateReady wasn't created by NewCallStateReady
+func (callStateReady CallStateReady) MarshalJSON() ([]byte, error) {
+	type alias CallStateReady
+	callStateReady.tdCommon.Type = "callStateReady"
+	return json.Marshal(alias(callStateReady))
+}
+
 // NewCallStateReady creates a new CallStateReady
 //
 // @param protocol Call protocols supported by the peer
@@ -18488,6 +21838,13 @@ func (callStateHangingUp *CallStateHangingUp) MessageType() string {
 	return "callStateHangingUp"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallStateHangingUp wasn't created by NewCallStateHangingUp
+func (callStateHangingUp CallStateHangingUp) MarshalJSON() ([]byte, error) {
+	type alias CallStateHangingUp
+	callStateHangingUp.tdCommon.Type = "callStateHangingUp"
+	return json.Marshal(alias(callStateHangingUp))
+}
+
 // NewCallStateHangingUp creates a new CallStateHangingUp
 //
 func NewCallStateHangingUp() *CallStateHangingUp {
@@ -18516,6 +21873,13 @@ func (callStateDiscarded *CallStateDiscarded) MessageType() string {
 	return "callStateDiscarded"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallStateDiscarded wasn't created by NewCallStateDiscarded
+func (callStateDiscarded CallStateDiscarded) MarshalJSON() ([]byte, error) {
+	type alias CallStateDiscarded
+	callStateDiscarded.tdCommon.Type = "callStateDiscarded"
+	return json.Marshal(alias(callStateDiscarded))
+}
+
 // NewCallStateDiscarded creates a new CallStateDiscarded
 //
 // @param reason The reason, why the call has ended
@@ -18575,6 +21939,13 @@ func (callStateError *CallStateError) MessageType() string {
 	return "callStateError"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallStateError wasn't created by NewCallStateError
+func (callStateError CallStateError) MarshalJSON() ([]byte, error) {
+	type alias CallStateError
+	callStateError.tdCommon.Type = "callStateError"
+	return json.Marshal(alias(callStateError))
+}
+
 // NewCallStateError creates a new CallStateError
 //
 // @param error Error. An error with the code 4005000 will be returned if an outgoing call is missed because of an expired timeout
@@ -18604,6 +21975,13 @@ func (groupCallRecentSpeaker *GroupCallRecentSpeaker) MessageType() string {
 	return "groupCallRecentSpeaker"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallRecentSpeaker wasn't created by NewGroupCallRecentSpeaker
+func (groupCallRecentSpeaker GroupCallRecentSpeaker) MarshalJSON() ([]byte, error) {
+	type alias GroupCallRecentSpeaker
+	groupCallRecentSpeaker.tdCommon.Type = "groupCallRecentSpeaker"
+	return json.Marshal(alias(groupCallRecentSpeaker))
+}
+
 // NewGroupCallRecentSpeaker creates a new GroupCallRecentSpeaker
 //
 // @param userID User identifier
@@ -18640,6 +22018,13 @@ func (groupCall *GroupCall) MessageType() string {
 	return "groupCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCall wasn't created by NewGroupCall
+func (groupCall GroupCall) MarshalJSON() ([]byte, error) {
+	type alias GroupCall
+	groupCall.tdCommon.Type = "groupCall"
+	return json.Marshal(alias(groupCall))
+}
+
 // NewGroupCall creates a new GroupCall
 //
 // @param iD Group call identifier
@@ -18687,6 +22072,13 @@ func (groupCallPayloadFingerprint *GroupCallPayloadFingerprint) MessageType() st
 	return "groupCallPayloadFingerprint"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallPayloadFingerprint wasn't created by NewGroupCallPayloadFingerprint
+func (groupCallPayloadFingerprint GroupCallPayloadFingerprint) MarshalJSON() ([]byte, error) {
+	type alias GroupCallPayloadFingerprint
+	groupCallPayloadFingerprint.tdCommon.Type = "groupCallPayloadFingerprint"
+	return json.Marshal(alias(groupCallPayloadFingerprint))
+}
+
 // NewGroupCallPayloadFingerprint creates a new GroupCallPayloadFingerprint
 //
 // @param hash Value of the field hash
@@ -18716,6 +22108,13 @@ func (groupCallPayload *GroupCallPayload) MessageType() string {
 	return "groupCallPayload"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallPayload wasn't created by NewGroupCallPayload
+func (groupCallPayload GroupCallPayload) MarshalJSON() ([]byte, error) {
+	type alias GroupCallPayload
+	groupCallPayload.tdCommon.Type = "groupCallPayload"
+	return json.Marshal(alias(groupCallPayload))
+}
+
 // NewGroupCallPayload creates a new GroupCallPayload
 //
 // @param ufrag Value of the field ufrag
@@ -18755,6 +22154,13 @@ func (groupCallJoinResponseCandidate *GroupCallJoinResponseCandidate) MessageTyp
 	return "groupCallJoinResponseCandidate"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallJoinResponseCandidate wasn't created by NewGroupCallJoinResponseCandidate
+func (groupCallJoinResponseCandidate GroupCallJoinResponseCandidate) MarshalJSON() ([]byte, error) {
+	type alias GroupCallJoinResponseCandidate
+	groupCallJoinResponseCandidate.tdCommon.Type = "groupCallJoinResponseCandidate"
+	return json.Marshal(alias(groupCallJoinResponseCandidate))
+}
+
 // NewGroupCallJoinResponseCandidate creates a new GroupCallJoinResponseCandidate
 //
 // @param port Value of the field port
@@ -18803,6 +22209,13 @@ func (groupCallJoinResponse *GroupCallJoinResponse) MessageType() string {
 	return "groupCallJoinResponse"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallJoinResponse wasn't created by NewGroupCallJoinResponse
+func (groupCallJoinResponse GroupCallJoinResponse) MarshalJSON() ([]byte, error) {
+	type alias GroupCallJoinResponse
+	groupCallJoinResponse.tdCommon.Type = "groupCallJoinResponse"
+	return json.Marshal(alias(groupCallJoinResponse))
+}
+
 // NewGroupCallJoinResponse creates a new GroupCallJoinResponse
 //
 // @param payload Join response payload to pass to tgcalls
@@ -18839,6 +22252,13 @@ func (groupCallParticipant *GroupCallParticipant) MessageType() string {
 	return "groupCallParticipant"
 }
 
+// MarshalJSON marshals to json, with @type set even if GroupCallParticipant wasn't created by NewGroupCallParticipant
+func (groupCallParticipant GroupCallParticipant) MarshalJSON() ([]byte, error) {
+	type alias GroupCallParticipant
+	groupCallParticipant.tdCommon.Type = "groupCallParticipant"
+	return json.Marshal(alias(groupCallParticipant))
+}
+
 // NewGroupCallParticipant creates a new GroupCallParticipant
 //
 // @param userID Identifier of the user
@@ -18883,6 +22303,13 @@ func (callProblemEcho *CallProblemEcho) MessageType() string {
 	return "callProblemEcho"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemEcho wasn't created by NewCallProblemEcho
+func (callProblemEcho CallProblemEcho) MarshalJSON() ([]byte, error) {
+	type alias CallProblemEcho
+	callProblemEcho.tdCommon.Type = "callProblemEcho"
+	return json.Marshal(alias(callProblemEcho))
+}
+
 // NewCallProblemEcho creates a new CallProblemEcho
 //
 func NewCallProblemEcho() *CallProblemEcho {
@@ -18908,6 +22335,13 @@ func (callProblemNoise *CallProblemNoise) MessageType() string {
 	return "callProblemNoise"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemNoise wasn't created by NewCallProblemNoise
+func (callProblemNoise CallProblemNoise) MarshalJSON() ([]byte, error) {
+	type alias CallProblemNoise
+	callProblemNoise.tdCommon.Type = "callProblemNoise"
+	return json.Marshal(alias(callProblemNoise))
+}
+
 // NewCallProblemNoise creates a new CallProblemNoise
 //
 func NewCallProblemNoise() *CallProblemNoise {
@@ -18933,6 +22367,13 @@ func (callProblemInterruptions *CallProblemInterruptions) MessageType() string {
 	return "callProblemInterruptions"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemInterruptions wasn't created by NewCallProblemInterruptions
+func (callProblemInterruptions CallProblemInterruptions) MarshalJSON() ([]byte, error) {
+	type alias CallProblemInterruptions
+	callProblemInterruptions.tdCommon.Type = "callProblemInterruptions"
+	return json.Marshal(alias(callProblemInterruptions))
+}
+
 // NewCallProblemInterruptions creates a new CallProblemInterruptions
 //
 func NewCallProblemInterruptions() *CallProblemInterruptions {
@@ -18958,6 +22399,13 @@ func (callProblemDistortedSpeech *CallProblemDistortedSpeech) MessageType() stri
 	return "callProblemDistortedSpeech"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemDistortedSpeech wasn't created by NewCallProblemDistortedSpeech
+func (callProblemDistortedSpeech CallProblemDistortedSpeech) MarshalJSON() ([]byte, error) {
+	type alias CallProblemDistortedSpeech
+	callProblemDistortedSpeech.tdCommon.Type = "callProblemDistortedSpeech"
+	return json.Marshal(alias(callProblemDistortedSpeech))
+}
+
 // NewCallProblemDistortedSpeech creates a new CallProblemDistortedSpeech
 //
 func NewCallProblemDistortedSpeech() *CallProblemDistortedSpeech {
@@ -18983,6 +22431,13 @@ func (callProblemSilentLocal *CallProblemSilentLocal) MessageType() string {
 	return "callProblemSilentLocal"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemSilentLocal wasn't created by NewCallProblemSilentLocal
+func (callProblemSilentLocal CallProblemSilentLocal) MarshalJSON() ([]byte, error) {
+	type alias CallProblemSilentLocal
+	callProblemSilentLocal.tdCommon.Type = "callProblemSilentLocal"
+	return json.Marshal(alias(callProblemSilentLocal))
+}
+
 // NewCallProblemSilentLocal creates a new CallProblemSilentLocal
 //
 func NewCallProblemSilentLocal() *CallProblemSilentLocal {
@@ -19008,6 +22463,13 @@ func (callProblemSilentRemote *CallProblemSilentRemote) MessageType() string {
 	return "callProblemSilentRemote"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemSilentRemote wasn't created by NewCallProblemSilentRemote
+func (callProblemSilentRemote CallProblemSilentRemote) MarshalJSON() ([]byte, error) {
+	type alias CallProblemSilentRemote
+	callProblemSilentRemote.tdCommon.Type = "callProblemSilentRemote"
+	return json.Marshal(alias(callProblemSilentRemote))
+}
+
 // NewCallProblemSilentRemote creates a new CallProblemSilentRemote
 //
 func NewCallProblemSilentRemote() *CallProblemSilentRemote {
@@ -19033,6 +22495,13 @@ func (callProblemDropped *CallProblemDropped) MessageType() string {
 	return "callProblemDropped"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemDropped wasn't created by NewCallProblemDropped
+func (callProblemDropped CallProblemDropped) MarshalJSON() ([]byte, error) {
+	type alias CallProblemDropped
+	callProblemDropped.tdCommon.Type = "callProblemDropped"
+	return json.Marshal(alias(callProblemDropped))
+}
+
 // NewCallProblemDropped creates a new CallProblemDropped
 //
 func NewCallProblemDropped() *CallProblemDropped {
@@ -19058,6 +22527,13 @@ func (callProblemDistortedVideo *CallProblemDistortedVideo) MessageType() string
 	return "callProblemDistortedVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemDistortedVideo wasn't created by NewCallProblemDistortedVideo
+func (callProblemDistortedVideo CallProblemDistortedVideo) MarshalJSON() ([]byte, error) {
+	type alias CallProblemDistortedVideo
+	callProblemDistortedVideo.tdCommon.Type = "callProblemDistortedVideo"
+	return json.Marshal(alias(callProblemDistortedVideo))
+}
+
 // NewCallProblemDistortedVideo creates a new CallProblemDistortedVideo
 //
 func NewCallProblemDistortedVideo() *CallProblemDistortedVideo {
@@ -19083,6 +22559,13 @@ func (callProblemPixelatedVideo *CallProblemPixelatedVideo) MessageType() string
 	return "callProblemPixelatedVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallProblemPixelatedVideo wasn't created by NewCallProblemPixelatedVideo
+func (callProblemPixelatedVideo CallProblemPixelatedVideo) MarshalJSON() ([]byte, error) {
+	type alias CallProblemPixelatedVideo
+	callProblemPixelatedVideo.tdCommon.Type = "callProblemPixelatedVideo"
+	return json.Marshal(alias(callProblemPixelatedVideo))
+}
+
 // NewCallProblemPixelatedVideo creates a new CallProblemPixelatedVideo
 //
 func NewCallProblemPixelatedVideo() *CallProblemPixelatedVideo {
@@ -19113,6 +22596,13 @@ func (call *Call) MessageType() string {
 	return "call"
 }
 
+// MarshalJSON marshals to json, with @type set even if Call wasn't created by NewCall
+func (call Call) MarshalJSON() ([]byte, error) {
+	type alias Call
+	call.tdCommon.Type = "call"
+	return json.Marshal(alias(call))
+}
+
 // NewCall creates a new Call
 //
 // @param iD Call identifier, not persistent
@@ -19178,6 +22668,13 @@ func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) Mess
 	return "phoneNumberAuthenticationSettings"
 }
 
+// MarshalJSON marshals to json, with @type set even if PhoneNumberAuthenticationSettings wasn't created by NewPhoneNumberAuthenticationSettings
+func (phoneNumberAuthenticationSettings PhoneNumberAuthenticationSettings) MarshalJSON() ([]byte, error) {
+	type alias PhoneNumberAuthenticationSettings
+	phoneNumberAuthenticationSettings.tdCommon.Type = "phoneNumberAuthenticationSettings"
+	return json.Marshal(alias(phoneNumberAuthenticationSettings))
+}
+
 // NewPhoneNumberAuthenticationSettings creates a new PhoneNumberAuthenticationSettings
 //
 // @param allowFlashCall Pass true if the authentication code may be sent via flash call to the specified phone number
@@ -19205,6 +22702,13 @@ func (animations *Animations) MessageType() string {
 	return "animations"
 }
 
+// MarshalJSON marshals to json, with @type set even if Animations wasn't created by NewAnimations
+func (animations Animations) MarshalJSON() ([]byte, error) {
+	type alias Animations
+	animations.tdCommon.Type = "animations"
+	return json.Marshal(alias(animations))
+}
+
 // NewAnimations creates a new Animations
 //
 // @param animations List of animations
@@ -19228,6 +22732,13 @@ func (diceStickersRegular *DiceStickersRegular) MessageType() string {
 	return "diceStickersRegular"
 }
 
+// MarshalJSON marshals to json, with @type set even if DiceStickersRegular wasn't created by NewDiceStickersRegular
+func (diceStickersRegular DiceStickersRegular) MarshalJSON() ([]byte, error) {
+	type alias DiceStickersRegular
+	diceStickersRegular.tdCommon.Type = "diceStickersRegular"
+	return json.Marshal(alias(diceStickersRegular))
+}
+
 // NewDiceStickersRegular creates a new DiceStickersRegular
 //
 // @param sticker The animated sticker with the dice animation
@@ -19260,6 +22771,13 @@ func (diceStickersSlotMachine *DiceStickersSlotMachine) MessageType() string {
 	return "diceStickersSlotMachine"
 }
 
+// MarshalJSON marshals to json, with @type set even if DiceStickersSlotMachine wasn't created by NewDiceStickersSlotMachine
+func (diceStickersSlotMachine DiceStickersSlotMachine) MarshalJSON() ([]byte, error) {
+	type alias DiceStickersSlotMachine
+	diceStickersSlotMachine.tdCommon.Type = "diceStickersSlotMachine"
+	return json.Marshal(alias(diceStickersSlotMachine))
+}
+
 // NewDiceStickersSlotMachine creates a new DiceStickersSlotMachine
 //
 // @param background The animated sticker with the slot machine background. The background animation must start playing after all reel animations finish
@@ -19297,6 +22815,13 @@ func (importedContacts *ImportedContacts) MessageType() string {
 	return "importedContacts"
 }
 
+// MarshalJSON marshals to json, with @type set even if ImportedContacts wasn't created by NewImportedContacts
+func (importedContacts ImportedContacts) MarshalJSON() ([]byte, error) {
+	type alias ImportedContacts
+	importedContacts.tdCommon.Type = "importedContacts"
+	return json.Marshal(alias(importedContacts))
+}
+
 // NewImportedContacts creates a new ImportedContacts
 //
 // @param userIDs User identifiers of the imported contacts in the same order as they were specified in the request; 0 if the contact is not yet a registered user
@@ -19322,6 +22847,13 @@ func (httpURL *HttpURL) MessageType() string {
 	return "httpUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if HttpURL wasn't created by NewHttpURL
+func (httpURL HttpURL) MarshalJSON() ([]byte, error) {
+	type alias HttpURL
+	httpURL.tdCommon.Type = "httpUrl"
+	return json.Marshal(alias(httpURL))
+}
+
 // NewHttpURL creates a new HttpURL
 //
 // @param uRL The URL
@@ -19355,6 +22887,13 @@ func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) MessageT
 	return "inputInlineQueryResultAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultAnimation wasn't created by NewInputInlineQueryResultAnimation
+func (inputInlineQueryResultAnimation InputInlineQueryResultAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultAnimation
+	inputInlineQueryResultAnimation.tdCommon.Type = "inputInlineQueryResultAnimation"
+	return json.Marshal(alias(inputInlineQueryResultAnimation))
+}
+
 // NewInputInlineQueryResultAnimation creates a new InputInlineQueryResultAnimation
 //
 // @param iD Unique identifier of the query result
@@ -19457,6 +22996,13 @@ func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) MessageType(
 	return "inputInlineQueryResultArticle"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultArticle wasn't created by NewInputInlineQueryResultArticle
+func (inputInlineQueryResultArticle InputInlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultArticle
+	inputInlineQueryResultArticle.tdCommon.Type = "inputInlineQueryResultArticle"
+	return json.Marshal(alias(inputInlineQueryResultArticle))
+}
+
 // NewInputInlineQueryResultArticle creates a new InputInlineQueryResultArticle
 //
 // @param iD Unique identifier of the query result
@@ -19552,6 +23098,13 @@ func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) MessageType() st
 	return "inputInlineQueryResultAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultAudio wasn't created by NewInputInlineQueryResultAudio
+func (inputInlineQueryResultAudio InputInlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultAudio
+	inputInlineQueryResultAudio.tdCommon.Type = "inputInlineQueryResultAudio"
+	return json.Marshal(alias(inputInlineQueryResultAudio))
+}
+
 // NewInputInlineQueryResultAudio creates a new InputInlineQueryResultAudio
 //
 // @param iD Unique identifier of the query result
@@ -19635,6 +23188,13 @@ func (inputInlineQueryResultContact *InputInlineQueryResultContact) MessageType(
 	return "inputInlineQueryResultContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultContact wasn't created by NewInputInlineQueryResultContact
+func (inputInlineQueryResultContact InputInlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultContact
+	inputInlineQueryResultContact.tdCommon.Type = "inputInlineQueryResultContact"
+	return json.Marshal(alias(inputInlineQueryResultContact))
+}
+
 // NewInputInlineQueryResultContact creates a new InputInlineQueryResultContact
 //
 // @param iD Unique identifier of the query result
@@ -19721,6 +23281,13 @@ func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) MessageTyp
 	return "inputInlineQueryResultDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultDocument wasn't created by NewInputInlineQueryResultDocument
+func (inputInlineQueryResultDocument InputInlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultDocument
+	inputInlineQueryResultDocument.tdCommon.Type = "inputInlineQueryResultDocument"
+	return json.Marshal(alias(inputInlineQueryResultDocument))
+}
+
 // NewInputInlineQueryResultDocument creates a new InputInlineQueryResultDocument
 //
 // @param iD Unique identifier of the query result
@@ -19812,6 +23379,13 @@ func (inputInlineQueryResultGame *InputInlineQueryResultGame) MessageType() stri
 	return "inputInlineQueryResultGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultGame wasn't created by NewInputInlineQueryResultGame
+func (inputInlineQueryResultGame InputInlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultGame
+	inputInlineQueryResultGame.tdCommon.Type = "inputInlineQueryResultGame"
+	return json.Marshal(alias(inputInlineQueryResultGame))
+}
+
 // NewInputInlineQueryResultGame creates a new InputInlineQueryResultGame
 //
 // @param iD Unique identifier of the query result
@@ -19880,6 +23454,13 @@ func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) MessageTyp
 	return "inputInlineQueryResultLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultLocation wasn't created by NewInputInlineQueryResultLocation
+func (inputInlineQueryResultLocation InputInlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultLocation
+	inputInlineQueryResultLocation.tdCommon.Type = "inputInlineQueryResultLocation"
+	return json.Marshal(alias(inputInlineQueryResultLocation))
+}
+
 // NewInputInlineQueryResultLocation creates a new InputInlineQueryResultLocation
 //
 // @param iD Unique identifier of the query result
@@ -19973,6 +23554,13 @@ func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) MessageType() st
 	return "inputInlineQueryResultPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultPhoto wasn't created by NewInputInlineQueryResultPhoto
+func (inputInlineQueryResultPhoto InputInlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultPhoto
+	inputInlineQueryResultPhoto.tdCommon.Type = "inputInlineQueryResultPhoto"
+	return json.Marshal(alias(inputInlineQueryResultPhoto))
+}
+
 // NewInputInlineQueryResultPhoto creates a new InputInlineQueryResultPhoto
 //
 // @param iD Unique identifier of the query result
@@ -20064,6 +23652,13 @@ func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) MessageType(
 	return "inputInlineQueryResultSticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultSticker wasn't created by NewInputInlineQueryResultSticker
+func (inputInlineQueryResultSticker InputInlineQueryResultSticker) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultSticker
+	inputInlineQueryResultSticker.tdCommon.Type = "inputInlineQueryResultSticker"
+	return json.Marshal(alias(inputInlineQueryResultSticker))
+}
+
 // NewInputInlineQueryResultSticker creates a new InputInlineQueryResultSticker
 //
 // @param iD Unique identifier of the query result
@@ -20147,6 +23742,13 @@ func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) MessageType() st
 	return "inputInlineQueryResultVenue"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultVenue wasn't created by NewInputInlineQueryResultVenue
+func (inputInlineQueryResultVenue InputInlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultVenue
+	inputInlineQueryResultVenue.tdCommon.Type = "inputInlineQueryResultVenue"
+	return json.Marshal(alias(inputInlineQueryResultVenue))
+}
+
 // NewInputInlineQueryResultVenue creates a new InputInlineQueryResultVenue
 //
 // @param iD Unique identifier of the query result
@@ -20234,6 +23836,13 @@ func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) MessageType() st
 	return "inputInlineQueryResultVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultVideo wasn't created by NewInputInlineQueryResultVideo
+func (inputInlineQueryResultVideo InputInlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultVideo
+	inputInlineQueryResultVideo.tdCommon.Type = "inputInlineQueryResultVideo"
+	return json.Marshal(alias(inputInlineQueryResultVideo))
+}
+
 // NewInputInlineQueryResultVideo creates a new InputInlineQueryResultVideo
 //
 // @param iD Unique identifier of the query result
@@ -20332,6 +23941,13 @@ func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) MessageT
 	return "inputInlineQueryResultVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputInlineQueryResultVoiceNote wasn't created by NewInputInlineQueryResultVoiceNote
+func (inputInlineQueryResultVoiceNote InputInlineQueryResultVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias InputInlineQueryResultVoiceNote
+	inputInlineQueryResultVoiceNote.tdCommon.Type = "inputInlineQueryResultVoiceNote"
+	return json.Marshal(alias(inputInlineQueryResultVoiceNote))
+}
+
 // NewInputInlineQueryResultVoiceNote creates a new InputInlineQueryResultVoiceNote
 //
 // @param iD Unique identifier of the query result
@@ -20410,6 +24026,13 @@ func (inlineQueryResultArticle *InlineQueryResultArticle) MessageType() string {
 	return "inlineQueryResultArticle"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultArticle wasn't created by NewInlineQueryResultArticle
+func (inlineQueryResultArticle InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultArticle
+	inlineQueryResultArticle.tdCommon.Type = "inlineQueryResultArticle"
+	return json.Marshal(alias(inlineQueryResultArticle))
+}
+
 // NewInlineQueryResultArticle creates a new InlineQueryResultArticle
 //
 // @param iD Unique identifier of the query result
@@ -20450,6 +24073,13 @@ func (inlineQueryResultContact *InlineQueryResultContact) MessageType() string {
 	return "inlineQueryResultContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultContact wasn't created by NewInlineQueryResultContact
+func (inlineQueryResultContact InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultContact
+	inlineQueryResultContact.tdCommon.Type = "inlineQueryResultContact"
+	return json.Marshal(alias(inlineQueryResultContact))
+}
+
 // NewInlineQueryResultContact creates a new InlineQueryResultContact
 //
 // @param iD Unique identifier of the query result
@@ -20485,6 +24115,13 @@ func (inlineQueryResultLocation *InlineQueryResultLocation) MessageType() string
 	return "inlineQueryResultLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultLocation wasn't created by NewInlineQueryResultLocation
+func (inlineQueryResultLocation InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultLocation
+	inlineQueryResultLocation.tdCommon.Type = "inlineQueryResultLocation"
+	return json.Marshal(alias(inlineQueryResultLocation))
+}
+
 // NewInlineQueryResultLocation creates a new InlineQueryResultLocation
 //
 // @param iD Unique identifier of the query result
@@ -20521,6 +24158,13 @@ func (inlineQueryResultVenue *InlineQueryResultVenue) MessageType() string {
 	return "inlineQueryResultVenue"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultVenue wasn't created by NewInlineQueryResultVenue
+func (inlineQueryResultVenue InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVenue
+	inlineQueryResultVenue.tdCommon.Type = "inlineQueryResultVenue"
+	return json.Marshal(alias(inlineQueryResultVenue))
+}
+
 // NewInlineQueryResultVenue creates a new InlineQueryResultVenue
 //
 // @param iD Unique identifier of the query result
@@ -20554,6 +24198,13 @@ func (inlineQueryResultGame *InlineQueryResultGame) MessageType() string {
 	return "inlineQueryResultGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultGame wasn't created by NewInlineQueryResultGame
+func (inlineQueryResultGame InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGame
+	inlineQueryResultGame.tdCommon.Type = "inlineQueryResultGame"
+	return json.Marshal(alias(inlineQueryResultGame))
+}
+
 // NewInlineQueryResultGame creates a new InlineQueryResultGame
 //
 // @param iD Unique identifier of the query result
@@ -20586,6 +24237,13 @@ func (inlineQueryResultAnimation *InlineQueryResultAnimation) MessageType() stri
 	return "inlineQueryResultAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultAnimation wasn't created by NewInlineQueryResultAnimation
+func (inlineQueryResultAnimation InlineQueryResultAnimation) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultAnimation
+	inlineQueryResultAnimation.tdCommon.Type = "inlineQueryResultAnimation"
+	return json.Marshal(alias(inlineQueryResultAnimation))
+}
+
 // NewInlineQueryResultAnimation creates a new InlineQueryResultAnimation
 //
 // @param iD Unique identifier of the query result
@@ -20619,6 +24277,13 @@ func (inlineQueryResultAudio *InlineQueryResultAudio) MessageType() string {
 	return "inlineQueryResultAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultAudio wasn't created by NewInlineQueryResultAudio
+func (inlineQueryResultAudio InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultAudio
+	inlineQueryResultAudio.tdCommon.Type = "inlineQueryResultAudio"
+	return json.Marshal(alias(inlineQueryResultAudio))
+}
+
 // NewInlineQueryResultAudio creates a new InlineQueryResultAudio
 //
 // @param iD Unique identifier of the query result
@@ -20652,6 +24317,13 @@ func (inlineQueryResultDocument *InlineQueryResultDocument) MessageType() string
 	return "inlineQueryResultDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultDocument wasn't created by NewInlineQueryResultDocument
+func (inlineQueryResultDocument InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultDocument
+	inlineQueryResultDocument.tdCommon.Type = "inlineQueryResultDocument"
+	return json.Marshal(alias(inlineQueryResultDocument))
+}
+
 // NewInlineQueryResultDocument creates a new InlineQueryResultDocument
 //
 // @param iD Unique identifier of the query result
@@ -20689,6 +24361,13 @@ func (inlineQueryResultPhoto *InlineQueryResultPhoto) MessageType() string {
 	return "inlineQueryResultPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultPhoto wasn't created by NewInlineQueryResultPhoto
+func (inlineQueryResultPhoto InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultPhoto
+	inlineQueryResultPhoto.tdCommon.Type = "inlineQueryResultPhoto"
+	return json.Marshal(alias(inlineQueryResultPhoto))
+}
+
 // NewInlineQueryResultPhoto creates a new InlineQueryResultPhoto
 //
 // @param iD Unique identifier of the query result
@@ -20724,6 +24403,13 @@ func (inlineQueryResultSticker *InlineQueryResultSticker) MessageType() string {
 	return "inlineQueryResultSticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultSticker wasn't created by NewInlineQueryResultSticker
+func (inlineQueryResultSticker InlineQueryResultSticker) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultSticker
+	inlineQueryResultSticker.tdCommon.Type = "inlineQueryResultSticker"
+	return json.Marshal(alias(inlineQueryResultSticker))
+}
+
 // NewInlineQueryResultSticker creates a new InlineQueryResultSticker
 //
 // @param iD Unique identifier of the query result
@@ -20757,6 +24443,13 @@ func (inlineQueryResultVideo *InlineQueryResultVideo) MessageType() string {
 	return "inlineQueryResultVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultVideo wasn't created by NewInlineQueryResultVideo
+func (inlineQueryResultVideo InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVideo
+	inlineQueryResultVideo.tdCommon.Type = "inlineQueryResultVideo"
+	return json.Marshal(alias(inlineQueryResultVideo))
+}
+
 // NewInlineQueryResultVideo creates a new InlineQueryResultVideo
 //
 // @param iD Unique identifier of the query result
@@ -20793,6 +24486,13 @@ func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) MessageType() stri
 	return "inlineQueryResultVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResultVoiceNote wasn't created by NewInlineQueryResultVoiceNote
+func (inlineQueryResultVoiceNote InlineQueryResultVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVoiceNote
+	inlineQueryResultVoiceNote.tdCommon.Type = "inlineQueryResultVoiceNote"
+	return json.Marshal(alias(inlineQueryResultVoiceNote))
+}
+
 // NewInlineQueryResultVoiceNote creates a new InlineQueryResultVoiceNote
 //
 // @param iD Unique identifier of the query result
@@ -20829,6 +24529,13 @@ func (inlineQueryResults *InlineQueryResults) MessageType() string {
 	return "inlineQueryResults"
 }
 
+// MarshalJSON marshals to json, with @type set even if InlineQueryResults wasn't created by NewInlineQueryResults
+func (inlineQueryResults InlineQueryResults) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResults
+	inlineQueryResults.tdCommon.Type = "inlineQueryResults"
+	return json.Marshal(alias(inlineQueryResults))
+}
+
 // NewInlineQueryResults creates a new InlineQueryResults
 //
 // @param inlineQueryID Unique identifier of the inline query
@@ -20860,6 +24567,13 @@ func (callbackQueryPayloadData *CallbackQueryPayloadData) MessageType() string {
 	return "callbackQueryPayloadData"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallbackQueryPayloadData wasn't created by NewCallbackQueryPayloadData
+func (callbackQueryPayloadData CallbackQueryPayloadData) MarshalJSON() ([]byte, error) {
+	type alias CallbackQueryPayloadData
+	callbackQueryPayloadData.tdCommon.Type = "callbackQueryPayloadData"
+	return json.Marshal(alias(callbackQueryPayloadData))
+}
+
 // NewCallbackQueryPayloadData creates a new CallbackQueryPayloadData
 //
 // @param data Data that was attached to the callback button
@@ -20889,6 +24603,13 @@ func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword
 	return "callbackQueryPayloadDataWithPassword"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallbackQueryPayloadDataWithPassword wasn't created by NewCallbackQueryPayloadDataWithPassword
+func (callbackQueryPayloadDataWithPassword CallbackQueryPayloadDataWithPassword) MarshalJSON() ([]byte, error) {
+	type alias CallbackQueryPayloadDataWithPassword
+	callbackQueryPayloadDataWithPassword.tdCommon.Type = "callbackQueryPayloadDataWithPassword"
+	return json.Marshal(alias(callbackQueryPayloadDataWithPassword))
+}
+
 // NewCallbackQueryPayloadDataWithPassword creates a new CallbackQueryPayloadDataWithPassword
 //
 // @param password The password for the current user
@@ -20919,6 +24640,13 @@ func (callbackQueryPayloadGame *CallbackQueryPayloadGame) MessageType() string {
 	return "callbackQueryPayloadGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallbackQueryPayloadGame wasn't created by NewCallbackQueryPayloadGame
+func (callbackQueryPayloadGame CallbackQueryPayloadGame) MarshalJSON() ([]byte, error) {
+	type alias CallbackQueryPayloadGame
+	callbackQueryPayloadGame.tdCommon.Type = "callbackQueryPayloadGame"
+	return json.Marshal(alias(callbackQueryPayloadGame))
+}
+
 // NewCallbackQueryPayloadGame creates a new CallbackQueryPayloadGame
 //
 // @param gameShortName A short name of the game that was attached to the callback button
@@ -20949,6 +24677,13 @@ func (callbackQueryAnswer *CallbackQueryAnswer) MessageType() string {
 	return "callbackQueryAnswer"
 }
 
+// MarshalJSON marshals to json, with @type set even if CallbackQueryAnswer wasn't created by NewCallbackQueryAnswer
+func (callbackQueryAnswer CallbackQueryAnswer) MarshalJSON() ([]byte, error) {
+	type alias CallbackQueryAnswer
+	callbackQueryAnswer.tdCommon.Type = "callbackQueryAnswer"
+	return json.Marshal(alias(callbackQueryAnswer))
+}
+
 // NewCallbackQueryAnswer creates a new CallbackQueryAnswer
 //
 // @param text Text of the answer
@@ -20976,6 +24711,13 @@ func (customRequestResult *CustomRequestResult) MessageType() string {
 	return "customRequestResult"
 }
 
+// MarshalJSON marshals to json, with @type set even if CustomRequestResult wasn't created by NewCustomRequestResult
+func (customRequestResult CustomRequestResult) MarshalJSON() ([]byte, error) {
+	type alias CustomRequestResult
+	customRequestResult.tdCommon.Type = "customRequestResult"
+	return json.Marshal(alias(customRequestResult))
+}
+
 // NewCustomRequestResult creates a new CustomRequestResult
 //
 // @param result A JSON-serialized result
@@ -21001,6 +24743,13 @@ func (gameHighScore *GameHighScore) MessageType() string {
 	return "gameHighScore"
 }
 
+// MarshalJSON marshals to json, with @type set even if GameHighScore wasn't created by NewGameHighScore
+func (gameHighScore GameHighScore) MarshalJSON() ([]byte, error) {
+	type alias GameHighScore
+	gameHighScore.tdCommon.Type = "gameHighScore"
+	return json.Marshal(alias(gameHighScore))
+}
+
 // NewGameHighScore creates a new GameHighScore
 //
 // @param position Position in the high score table
@@ -21028,6 +24777,13 @@ func (gameHighScores *GameHighScores) MessageType() string {
 	return "gameHighScores"
 }
 
+// MarshalJSON marshals to json, with @type set even if GameHighScores wasn't created by NewGameHighScores
+func (gameHighScores GameHighScores) MarshalJSON() ([]byte, error) {
+	type alias GameHighScores
+	gameHighScores.tdCommon.Type = "gameHighScores"
+	return json.Marshal(alias(gameHighScores))
+}
+
 // NewGameHighScores creates a new GameHighScores
 //
 // @param scores A list of game high scores
@@ -21052,6 +24808,13 @@ func (chatEventMessageEdited *ChatEventMessageEdited) MessageType() string {
 	return "chatEventMessageEdited"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMessageEdited wasn't created by NewChatEventMessageEdited
+func (chatEventMessageEdited ChatEventMessageEdited) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMessageEdited
+	chatEventMessageEdited.tdCommon.Type = "chatEventMessageEdited"
+	return json.Marshal(alias(chatEventMessageEdited))
+}
+
 // NewChatEventMessageEdited creates a new ChatEventMessageEdited
 //
 // @param oldMessage The original message before the edit
@@ -21082,6 +24845,13 @@ func (chatEventMessageDeleted *ChatEventMessageDeleted) MessageType() string {
 	return "chatEventMessageDeleted"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMessageDeleted wasn't created by NewChatEventMessageDeleted
+func (chatEventMessageDeleted ChatEventMessageDeleted) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMessageDeleted
+	chatEventMessageDeleted.tdCommon.Type = "chatEventMessageDeleted"
+	return json.Marshal(alias(chatEventMessageDeleted))
+}
+
 // NewChatEventMessageDeleted creates a new ChatEventMessageDeleted
 //
 // @param message Deleted message
@@ -21110,6 +24880,13 @@ func (chatEventPollStopped *ChatEventPollStopped) MessageType() string {
 	return "chatEventPollStopped"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventPollStopped wasn't created by NewChatEventPollStopped
+func (chatEventPollStopped ChatEventPollStopped) MarshalJSON() ([]byte, error) {
+	type alias ChatEventPollStopped
+	chatEventPollStopped.tdCommon.Type = "chatEventPollStopped"
+	return json.Marshal(alias(chatEventPollStopped))
+}
+
 // NewChatEventPollStopped creates a new ChatEventPollStopped
 //
 // @param message The message with the poll
@@ -21138,6 +24915,13 @@ func (chatEventMessagePinned *ChatEventMessagePinned) MessageType() string {
 	return "chatEventMessagePinned"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMessagePinned wasn't created by NewChatEventMessagePinned
+func (chatEventMessagePinned ChatEventMessagePinned) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMessagePinned
+	chatEventMessagePinned.tdCommon.Type = "chatEventMessagePinned"
+	return json.Marshal(alias(chatEventMessagePinned))
+}
+
 // NewChatEventMessagePinned creates a new ChatEventMessagePinned
 //
 // @param message Pinned message
@@ -21166,6 +24950,13 @@ func (chatEventMessageUnpinned *ChatEventMessageUnpinned) MessageType() string {
 	return "chatEventMessageUnpinned"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMessageUnpinned wasn't created by NewChatEventMessageUnpinned
+func (chatEventMessageUnpinned ChatEventMessageUnpinned) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMessageUnpinned
+	chatEventMessageUnpinned.tdCommon.Type = "chatEventMessageUnpinned"
+	return json.Marshal(alias(chatEventMessageUnpinned))
+}
+
 // NewChatEventMessageUnpinned creates a new ChatEventMessageUnpinned
 //
 // @param message Unpinned message
@@ -21193,6 +24984,13 @@ func (chatEventMemberJoined *ChatEventMemberJoined) MessageType() string {
 	return "chatEventMemberJoined"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMemberJoined wasn't created by NewChatEventMemberJoined
+func (chatEventMemberJoined ChatEventMemberJoined) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMemberJoined
+	chatEventMemberJoined.tdCommon.Type = "chatEventMemberJoined"
+	return json.Marshal(alias(chatEventMemberJoined))
+}
+
 // NewChatEventMemberJoined creates a new ChatEventMemberJoined
 //
 func NewChatEventMemberJoined() *ChatEventMemberJoined {
@@ -21218,6 +25016,13 @@ func (chatEventMemberLeft *ChatEventMemberLeft) MessageType() string {
 	return "chatEventMemberLeft"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMemberLeft wasn't created by NewChatEventMemberLeft
+func (chatEventMemberLeft ChatEventMemberLeft) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMemberLeft
+	chatEventMemberLeft.tdCommon.Type = "chatEventMemberLeft"
+	return json.Marshal(alias(chatEventMemberLeft))
+}
+
 // NewChatEventMemberLeft creates a new ChatEventMemberLeft
 //
 func NewChatEventMemberLeft() *ChatEventMemberLeft {
@@ -21245,6 +25050,13 @@ func (chatEventMemberInvited *ChatEventMemberInvited) MessageType() string {
 	return "chatEventMemberInvited"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMemberInvited wasn't created by NewChatEventMemberInvited
+func (chatEventMemberInvited ChatEventMemberInvited) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMemberInvited
+	chatEventMemberInvited.tdCommon.Type = "chatEventMemberInvited"
+	return json.Marshal(alias(chatEventMemberInvited))
+}
+
 // NewChatEventMemberInvited creates a new ChatEventMemberInvited
 //
 // @param userID New member user identifier
@@ -21303,6 +25115,13 @@ func (chatEventMemberPromoted *ChatEventMemberPromoted) MessageType() string {
 	return "chatEventMemberPromoted"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMemberPromoted wasn't created by NewChatEventMemberPromoted
+func (chatEventMemberPromoted ChatEventMemberPromoted) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMemberPromoted
+	chatEventMemberPromoted.tdCommon.Type = "chatEventMemberPromoted"
+	return json.Marshal(alias(chatEventMemberPromoted))
+}
+
 // NewChatEventMemberPromoted creates a new ChatEventMemberPromoted
 //
 // @param userID Chat member user identifier
@@ -21366,6 +25185,13 @@ func (chatEventMemberRestricted *ChatEventMemberRestricted) MessageType() string
 	return "chatEventMemberRestricted"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventMemberRestricted wasn't created by NewChatEventMemberRestricted
+func (chatEventMemberRestricted ChatEventMemberRestricted) MarshalJSON() ([]byte, error) {
+	type alias ChatEventMemberRestricted
+	chatEventMemberRestricted.tdCommon.Type = "chatEventMemberRestricted"
+	return json.Marshal(alias(chatEventMemberRestricted))
+}
+
 // NewChatEventMemberRestricted creates a new ChatEventMemberRestricted
 //
 // @param userID Chat member user identifier
@@ -21428,6 +25254,13 @@ func (chatEventTitleChanged *ChatEventTitleChanged) MessageType() string {
 	return "chatEventTitleChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventTitleChanged wasn't created by NewChatEventTitleChanged
+func (chatEventTitleChanged ChatEventTitleChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventTitleChanged
+	chatEventTitleChanged.tdCommon.Type = "chatEventTitleChanged"
+	return json.Marshal(alias(chatEventTitleChanged))
+}
+
 // NewChatEventTitleChanged creates a new ChatEventTitleChanged
 //
 // @param oldTitle Previous chat title
@@ -21459,6 +25292,13 @@ func (chatEventPermissionsChanged *ChatEventPermissionsChanged) MessageType() st
 	return "chatEventPermissionsChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventPermissionsChanged wasn't created by NewChatEventPermissionsChanged
+func (chatEventPermissionsChanged ChatEventPermissionsChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventPermissionsChanged
+	chatEventPermissionsChanged.tdCommon.Type = "chatEventPermissionsChanged"
+	return json.Marshal(alias(chatEventPermissionsChanged))
+}
+
 // NewChatEventPermissionsChanged creates a new ChatEventPermissionsChanged
 //
 // @param oldPermissions Previous chat permissions
@@ -21490,6 +25330,13 @@ func (chatEventDescriptionChanged *ChatEventDescriptionChanged) MessageType() st
 	return "chatEventDescriptionChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventDescriptionChanged wasn't created by NewChatEventDescriptionChanged
+func (chatEventDescriptionChanged ChatEventDescriptionChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventDescriptionChanged
+	chatEventDescriptionChanged.tdCommon.Type = "chatEventDescriptionChanged"
+	return json.Marshal(alias(chatEventDescriptionChanged))
+}
+
 // NewChatEventDescriptionChanged creates a new ChatEventDescriptionChanged
 //
 // @param oldDescription Previous chat description
@@ -21521,6 +25368,13 @@ func (chatEventUsernameChanged *ChatEventUsernameChanged) MessageType() string {
 	return "chatEventUsernameChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventUsernameChanged wasn't created by NewChatEventUsernameChanged
+func (chatEventUsernameChanged ChatEventUsernameChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventUsernameChanged
+	chatEventUsernameChanged.tdCommon.Type = "chatEventUsernameChanged"
+	return json.Marshal(alias(chatEventUsernameChanged))
+}
+
 // NewChatEventUsernameChanged creates a new ChatEventUsernameChanged
 //
 // @param oldUsername Previous chat username
@@ -21552,6 +25406,13 @@ func (chatEventPhotoChanged *ChatEventPhotoChanged) MessageType() string {
 	return "chatEventPhotoChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventPhotoChanged wasn't created by NewChatEventPhotoChanged
+func (chatEventPhotoChanged ChatEventPhotoChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventPhotoChanged
+	chatEventPhotoChanged.tdCommon.Type = "chatEventPhotoChanged"
+	return json.Marshal(alias(chatEventPhotoChanged))
+}
+
 // NewChatEventPhotoChanged creates a new ChatEventPhotoChanged
 //
 // @param oldPhoto Previous chat photo value; may be null
@@ -21582,6 +25443,13 @@ func (chatEventInvitesToggled *ChatEventInvitesToggled) MessageType() string {
 	return "chatEventInvitesToggled"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventInvitesToggled wasn't created by NewChatEventInvitesToggled
+func (chatEventInvitesToggled ChatEventInvitesToggled) MarshalJSON() ([]byte, error) {
+	type alias ChatEventInvitesToggled
+	chatEventInvitesToggled.tdCommon.Type = "chatEventInvitesToggled"
+	return json.Marshal(alias(chatEventInvitesToggled))
+}
+
 // NewChatEventInvitesToggled creates a new ChatEventInvitesToggled
 //
 // @param canInviteUsers New value of can_invite_users permission
@@ -21602,8 +25470,8 @@ func (chatEventInvitesToggled *ChatEventInvitesToggled) GetChatEventActionEnum()
 // ChatEventLinkedChatChanged The linked chat of a supergroup was changed
 type ChatEventLinkedChatChanged struct {
 	tdCommon
-	OldLinkedChatID int64 `json:"old_linked_chat_id"` // Previous supergroup linked chat identifier
-	NewLinkedChatID int64 `json:"new_linked_chat_id"` // New supergroup linked chat identifier
+	OldLinkedChatID JSONInt64 `json:"old_linked_chat_id"` // Previous supergroup linked chat identifier
+	NewLinkedChatID JSONInt64 `json:"new_linked_chat_id"` // New supergroup linked chat identifier
 }
 
 // MessageType return the string telegram-type of ChatEventLinkedChatChanged
@@ -21611,11 +25479,18 @@ func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) MessageType() stri
 	return "chatEventLinkedChatChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventLinkedChatChanged wasn't created by NewChatEventLinkedChatChanged
+func (chatEventLinkedChatChanged ChatEventLinkedChatChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventLinkedChatChanged
+	chatEventLinkedChatChanged.tdCommon.Type = "chatEventLinkedChatChanged"
+	return json.Marshal(alias(chatEventLinkedChatChanged))
+}
+
 // NewChatEventLinkedChatChanged creates a new ChatEventLinkedChatChanged
 //
 // @param oldLinkedChatID Previous supergroup linked chat identifier
 // @param newLinkedChatID New supergroup linked chat identifier
-func NewChatEventLinkedChatChanged(oldLinkedChatID int64, newLinkedChatID int64) *ChatEventLinkedChatChanged {
+func NewChatEventLinkedChatChanged(oldLinkedChatID JSONInt64, newLinkedChatID JSONInt64) *ChatEventLinkedChatChanged {
 	chatEventLinkedChatChangedTemp := ChatEventLinkedChatChanged{
 		tdCommon:        tdCommon{Type: "chatEventLinkedChatChanged"},
 		OldLinkedChatID: oldLinkedChatID,
@@ -21642,6 +25517,13 @@ func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) MessageType(
 	return "chatEventSlowModeDelayChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventSlowModeDelayChanged wasn't created by NewChatEventSlowModeDelayChanged
+func (chatEventSlowModeDelayChanged ChatEventSlowModeDelayChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventSlowModeDelayChanged
+	chatEventSlowModeDelayChanged.tdCommon.Type = "chatEventSlowModeDelayChanged"
+	return json.Marshal(alias(chatEventSlowModeDelayChanged))
+}
+
 // NewChatEventSlowModeDelayChanged creates a new ChatEventSlowModeDelayChanged
 //
 // @param oldSlowModeDelay Previous value of slow_mode_delay
@@ -21672,6 +25554,13 @@ func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) MessageType()
 	return "chatEventSignMessagesToggled"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventSignMessagesToggled wasn't created by NewChatEventSignMessagesToggled
+func (chatEventSignMessagesToggled ChatEventSignMessagesToggled) MarshalJSON() ([]byte, error) {
+	type alias ChatEventSignMessagesToggled
+	chatEventSignMessagesToggled.tdCommon.Type = "chatEventSignMessagesToggled"
+	return json.Marshal(alias(chatEventSignMessagesToggled))
+}
+
 // NewChatEventSignMessagesToggled creates a new ChatEventSignMessagesToggled
 //
 // @param signMessages New value of sign_messages
@@ -21701,6 +25590,13 @@ func (chatEventStickerSetChanged *ChatEventStickerSetChanged) MessageType() stri
 	return "chatEventStickerSetChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventStickerSetChanged wasn't created by NewChatEventStickerSetChanged
+func (chatEventStickerSetChanged ChatEventStickerSetChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventStickerSetChanged
+	chatEventStickerSetChanged.tdCommon.Type = "chatEventStickerSetChanged"
+	return json.Marshal(alias(chatEventStickerSetChanged))
+}
+
 // NewChatEventStickerSetChanged creates a new ChatEventStickerSetChanged
 //
 // @param oldStickerSetID Previous identifier of the chat sticker set; 0 if none
@@ -21732,6 +25628,13 @@ func (chatEventLocationChanged *ChatEventLocationChanged) MessageType() string {
 	return "chatEventLocationChanged"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventLocationChanged wasn't created by NewChatEventLocationChanged
+func (chatEventLocationChanged ChatEventLocationChanged) MarshalJSON() ([]byte, error) {
+	type alias ChatEventLocationChanged
+	chatEventLocationChanged.tdCommon.Type = "chatEventLocationChanged"
+	return json.Marshal(alias(chatEventLocationChanged))
+}
+
 // NewChatEventLocationChanged creates a new ChatEventLocationChanged
 //
 // @param oldLocation Previous location; may be null
@@ -21762,6 +25665,13 @@ func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggl
 	return "chatEventIsAllHistoryAvailableToggled"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventIsAllHistoryAvailableToggled wasn't created by NewChatEventIsAllHistoryAvailableToggled
+func (chatEventIsAllHistoryAvailableToggled ChatEventIsAllHistoryAvailableToggled) MarshalJSON() ([]byte, error) {
+	type alias ChatEventIsAllHistoryAvailableToggled
+	chatEventIsAllHistoryAvailableToggled.tdCommon.Type = "chatEventIsAllHistoryAvailableToggled"
+	return json.Marshal(alias(chatEventIsAllHistoryAvailableToggled))
+}
+
 // NewChatEventIsAllHistoryAvailableToggled creates a new ChatEventIsAllHistoryAvailableToggled
 //
 // @param isAllHistoryAvailable New value of is_all_history_available
@@ -21790,6 +25700,13 @@ func (chatEventVoiceChatCreated *ChatEventVoiceChatCreated) MessageType() string
 	return "chatEventVoiceChatCreated"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventVoiceChatCreated wasn't created by NewChatEventVoiceChatCreated
+func (chatEventVoiceChatCreated ChatEventVoiceChatCreated) MarshalJSON() ([]byte, error) {
+	type alias ChatEventVoiceChatCreated
+	chatEventVoiceChatCreated.tdCommon.Type = "chatEventVoiceChatCreated"
+	return json.Marshal(alias(chatEventVoiceChatCreated))
+}
+
 // NewChatEventVoiceChatCreated creates a new ChatEventVoiceChatCreated
 //
 // @param groupCallID Identifier of the voice chat. The voice chat can be received through the method getGroupCall
@@ -21818,6 +25735,13 @@ func (chatEventVoiceChatDiscarded *ChatEventVoiceChatDiscarded) MessageType() st
 	return "chatEventVoiceChatDiscarded"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventVoiceChatDiscarded wasn't created by NewChatEventVoiceChatDiscarded
+func (chatEventVoiceChatDiscarded ChatEventVoiceChatDiscarded) MarshalJSON() ([]byte, error) {
+	type alias ChatEventVoiceChatDiscarded
+	chatEventVoiceChatDiscarded.tdCommon.Type = "chatEventVoiceChatDiscarded"
+	return json.Marshal(alias(chatEventVoiceChatDiscarded))
+}
+
 // NewChatEventVoiceChatDiscarded creates a new ChatEventVoiceChatDiscarded
 //
 // @param groupCallID Identifier of the voice chat. The voice chat can be received through the method getGroupCall
@@ -21847,6 +25771,13 @@ func (chatEventVoiceChatParticipantIsMutedToggled *ChatEventVoiceChatParticipant
 	return "chatEventVoiceChatParticipantIsMutedToggled"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventVoiceChatParticipantIsMutedToggled wasn't created by NewChatEventVoiceChatParticipantIsMutedToggled
+func (chatEventVoiceChatParticipantIsMutedToggled ChatEventVoiceChatParticipantIsMutedToggled) MarshalJSON() ([]byte, error) {
+	type alias ChatEventVoiceChatParticipantIsMutedToggled
+	chatEventVoiceChatParticipantIsMutedToggled.tdCommon.Type = "chatEventVoiceChatParticipantIsMutedToggled"
+	return json.Marshal(alias(chatEventVoiceChatParticipantIsMutedToggled))
+}
+
 // NewChatEventVoiceChatParticipantIsMutedToggled creates a new ChatEventVoiceChatParticipantIsMutedToggled
 //
 // @param userID Identifier of the affected user
@@ -21877,6 +25808,13 @@ func (chatEventVoiceChatMuteNewParticipantsToggled *ChatEventVoiceChatMuteNewPar
 	return "chatEventVoiceChatMuteNewParticipantsToggled"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventVoiceChatMuteNewParticipantsToggled wasn't created by NewChatEventVoiceChatMuteNewParticipantsToggled
+func (chatEventVoiceChatMuteNewParticipantsToggled ChatEventVoiceChatMuteNewParticipantsToggled) MarshalJSON() ([]byte, error) {
+	type alias ChatEventVoiceChatMuteNewParticipantsToggled
+	chatEventVoiceChatMuteNewParticipantsToggled.tdCommon.Type = "chatEventVoiceChatMuteNewParticipantsToggled"
+	return json.Marshal(alias(chatEventVoiceChatMuteNewParticipantsToggled))
+}
+
 // NewChatEventVoiceChatMuteNewParticipantsToggled creates a new ChatEventVoiceChatMuteNewParticipantsToggled
 //
 // @param muteNewParticipants New value of the mute_new_participants setting
@@ -21908,6 +25846,13 @@ func (chatEvent *ChatEvent) MessageType() string {
 	return "chatEvent"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEvent wasn't created by NewChatEvent
+func (chatEvent ChatEvent) MarshalJSON() ([]byte, error) {
+	type alias ChatEvent
+	chatEvent.tdCommon.Type = "chatEvent"
+	return json.Marshal(alias(chatEvent))
+}
+
 // NewChatEvent creates a new ChatEvent
 //
 // @param iD Chat event identifier
@@ -21967,6 +25912,13 @@ func (chatEvents *ChatEvents) MessageType() string {
 	return "chatEvents"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEvents wasn't created by NewChatEvents
+func (chatEvents ChatEvents) MarshalJSON() ([]byte, error) {
+	type alias ChatEvents
+	chatEvents.tdCommon.Type = "chatEvents"
+	return json.Marshal(alias(chatEvents))
+}
+
 // NewChatEvents creates a new ChatEvents
 //
 // @param events List of events
@@ -22000,6 +25952,13 @@ func (chatEventLogFilters *ChatEventLogFilters) MessageType() string {
 	return "chatEventLogFilters"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatEventLogFilters wasn't created by NewChatEventLogFilters
+func (chatEventLogFilters ChatEventLogFilters) MarshalJSON() ([]byte, error) {
+	type alias ChatEventLogFilters
+	chatEventLogFilters.tdCommon.Type = "chatEventLogFilters"
+	return json.Marshal(alias(chatEventLogFilters))
+}
+
 // NewChatEventLogFilters creates a new ChatEventLogFilters
 //
 // @param messageEdits True, if message edits should be returned
@@ -22043,6 +26002,13 @@ func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) MessageT
 	return "languagePackStringValueOrdinary"
 }
 
+// MarshalJSON marshals to json, with @type set even if LanguagePackStringValueOrdinary wasn't created by NewLanguagePackStringValueOrdinary
+func (languagePackStringValueOrdinary LanguagePackStringValueOrdinary) MarshalJSON() ([]byte, error) {
+	type alias LanguagePackStringValueOrdinary
+	languagePackStringValueOrdinary.tdCommon.Type = "languagePackStringValueOrdinary"
+	return json.Marshal(alias(languagePackStringValueOrdinary))
+}
+
 // NewLanguagePackStringValueOrdinary creates a new LanguagePackStringValueOrdinary
 //
 // @param value String value
@@ -22076,6 +26042,13 @@ func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) Mess
 	return "languagePackStringValuePluralized"
 }
 
+// MarshalJSON marshals to json, with @type set even if LanguagePackStringValuePluralized wasn't created by NewLanguagePackStringValuePluralized
+func (languagePackStringValuePluralized LanguagePackStringValuePluralized) MarshalJSON() ([]byte, error) {
+	type alias LanguagePackStringValuePluralized
+	languagePackStringValuePluralized.tdCommon.Type = "languagePackStringValuePluralized"
+	return json.Marshal(alias(languagePackStringValuePluralized))
+}
+
 // NewLanguagePackStringValuePluralized creates a new LanguagePackStringValuePluralized
 //
 // @param zeroValue Value for zero objects
@@ -22113,6 +26086,13 @@ func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) MessageTyp
 	return "languagePackStringValueDeleted"
 }
 
+// MarshalJSON marshals to json, with @type set even if LanguagePackStringValueDeleted wasn't created by NewLanguagePackStringValueDeleted
+func (languagePackStringValueDeleted LanguagePackStringValueDeleted) MarshalJSON() ([]byte, error) {
+	type alias LanguagePackStringValueDeleted
+	languagePackStringValueDeleted.tdCommon.Type = "languagePackStringValueDeleted"
+	return json.Marshal(alias(languagePackStringValueDeleted))
+}
+
 // NewLanguagePackStringValueDeleted creates a new LanguagePackStringValueDeleted
 //
 func NewLanguagePackStringValueDeleted() *LanguagePackStringValueDeleted {
@@ -22140,6 +26120,13 @@ func (languagePackString *LanguagePackString) MessageType() string {
 	return "languagePackString"
 }
 
+// MarshalJSON marshals to json, with @type set even if LanguagePackString wasn't created by NewLanguagePackString
+func (languagePackString LanguagePackString) MarshalJSON() ([]byte, error) {
+	type alias LanguagePackString
+	languagePackString.tdCommon.Type = "languagePackString"
+	return json.Marshal(alias(languagePackString))
+}
+
 // NewLanguagePackString creates a new LanguagePackString
 //
 // @param key String key
@@ -22191,6 +26178,13 @@ func (languagePackStrings *LanguagePackStrings) MessageType() string {
 	return "languagePackStrings"
 }
 
+// MarshalJSON marshals to json, with @type set even if LanguagePackStrings wasn't created by NewLanguagePackStrings
+func (languagePackStrings LanguagePackStrings) MarshalJSON() ([]byte, error) {
+	type alias LanguagePackStrings
+	languagePackStrings.tdCommon.Type = "languagePackStrings"
+	return json.Marshal(alias(languagePackStrings))
+}
+
 // NewLanguagePackStrings creates a new LanguagePackStrings
 //
 // @param strings A list of language pack strings
@@ -22226,6 +26220,13 @@ func (languagePackInfo *LanguagePackInfo) MessageType() string {
 	return "languagePackInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if LanguagePackInfo wasn't created by NewLanguagePackInfo
+func (languagePackInfo LanguagePackInfo) MarshalJSON() ([]byte, error) {
+	type alias LanguagePackInfo
+	languagePackInfo.tdCommon.Type = "languagePackInfo"
+	return json.Marshal(alias(languagePackInfo))
+}
+
 // NewLanguagePackInfo creates a new LanguagePackInfo
 //
 // @param iD Unique language pack identifier
@@ -22273,6 +26274,13 @@ func (localizationTargetInfo *LocalizationTargetInfo) MessageType() string {
 	return "localizationTargetInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if LocalizationTargetInfo wasn't created by NewLocalizationTargetInfo
+func (localizationTargetInfo LocalizationTargetInfo) MarshalJSON() ([]byte, error) {
+	type alias LocalizationTargetInfo
+	localizationTargetInfo.tdCommon.Type = "localizationTargetInfo"
+	return json.Marshal(alias(localizationTargetInfo))
+}
+
 // NewLocalizationTargetInfo creates a new LocalizationTargetInfo
 //
 // @param languagePacks List of available language packs for this application
@@ -22297,6 +26305,13 @@ func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) Mess
 	return "deviceTokenFirebaseCloudMessaging"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenFirebaseCloudMessaging wasn't created by NewDeviceTokenFirebaseCloudMessaging
+func (deviceTokenFirebaseCloudMessaging DeviceTokenFirebaseCloudMessaging) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenFirebaseCloudMessaging
+	deviceTokenFirebaseCloudMessaging.tdCommon.Type = "deviceTokenFirebaseCloudMessaging"
+	return json.Marshal(alias(deviceTokenFirebaseCloudMessaging))
+}
+
 // NewDeviceTokenFirebaseCloudMessaging creates a new DeviceTokenFirebaseCloudMessaging
 //
 // @param token Device registration token; may be empty to de-register a device
@@ -22328,6 +26343,13 @@ func (deviceTokenApplePush *DeviceTokenApplePush) MessageType() string {
 	return "deviceTokenApplePush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenApplePush wasn't created by NewDeviceTokenApplePush
+func (deviceTokenApplePush DeviceTokenApplePush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenApplePush
+	deviceTokenApplePush.tdCommon.Type = "deviceTokenApplePush"
+	return json.Marshal(alias(deviceTokenApplePush))
+}
+
 // NewDeviceTokenApplePush creates a new DeviceTokenApplePush
 //
 // @param deviceToken Device token; may be empty to de-register a device
@@ -22360,6 +26382,13 @@ func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) MessageType() string {
 	return "deviceTokenApplePushVoIP"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenApplePushVoIP wasn't created by NewDeviceTokenApplePushVoIP
+func (deviceTokenApplePushVoIP DeviceTokenApplePushVoIP) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenApplePushVoIP
+	deviceTokenApplePushVoIP.tdCommon.Type = "deviceTokenApplePushVoIP"
+	return json.Marshal(alias(deviceTokenApplePushVoIP))
+}
+
 // NewDeviceTokenApplePushVoIP creates a new DeviceTokenApplePushVoIP
 //
 // @param deviceToken Device token; may be empty to de-register a device
@@ -22392,6 +26421,13 @@ func (deviceTokenWindowsPush *DeviceTokenWindowsPush) MessageType() string {
 	return "deviceTokenWindowsPush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenWindowsPush wasn't created by NewDeviceTokenWindowsPush
+func (deviceTokenWindowsPush DeviceTokenWindowsPush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenWindowsPush
+	deviceTokenWindowsPush.tdCommon.Type = "deviceTokenWindowsPush"
+	return json.Marshal(alias(deviceTokenWindowsPush))
+}
+
 // NewDeviceTokenWindowsPush creates a new DeviceTokenWindowsPush
 //
 // @param accessToken The access token that will be used to send notifications; may be empty to de-register a device
@@ -22420,6 +26456,13 @@ func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) MessageType() string {
 	return "deviceTokenMicrosoftPush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenMicrosoftPush wasn't created by NewDeviceTokenMicrosoftPush
+func (deviceTokenMicrosoftPush DeviceTokenMicrosoftPush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenMicrosoftPush
+	deviceTokenMicrosoftPush.tdCommon.Type = "deviceTokenMicrosoftPush"
+	return json.Marshal(alias(deviceTokenMicrosoftPush))
+}
+
 // NewDeviceTokenMicrosoftPush creates a new DeviceTokenMicrosoftPush
 //
 // @param channelURI Push notification channel URI; may be empty to de-register a device
@@ -22448,6 +26491,13 @@ func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) MessageType()
 	return "deviceTokenMicrosoftPushVoIP"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenMicrosoftPushVoIP wasn't created by NewDeviceTokenMicrosoftPushVoIP
+func (deviceTokenMicrosoftPushVoIP DeviceTokenMicrosoftPushVoIP) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenMicrosoftPushVoIP
+	deviceTokenMicrosoftPushVoIP.tdCommon.Type = "deviceTokenMicrosoftPushVoIP"
+	return json.Marshal(alias(deviceTokenMicrosoftPushVoIP))
+}
+
 // NewDeviceTokenMicrosoftPushVoIP creates a new DeviceTokenMicrosoftPushVoIP
 //
 // @param channelURI Push notification channel URI; may be empty to de-register a device
@@ -22478,6 +26528,13 @@ func (deviceTokenWebPush *DeviceTokenWebPush) MessageType() string {
 	return "deviceTokenWebPush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenWebPush wasn't created by NewDeviceTokenWebPush
+func (deviceTokenWebPush DeviceTokenWebPush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenWebPush
+	deviceTokenWebPush.tdCommon.Type = "deviceTokenWebPush"
+	return json.Marshal(alias(deviceTokenWebPush))
+}
+
 // NewDeviceTokenWebPush creates a new DeviceTokenWebPush
 //
 // @param endpoint Absolute URL exposed by the push service where the application server can send push messages; may be empty to de-register a device
@@ -22510,6 +26567,13 @@ func (deviceTokenSimplePush *DeviceTokenSimplePush) MessageType() string {
 	return "deviceTokenSimplePush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenSimplePush wasn't created by NewDeviceTokenSimplePush
+func (deviceTokenSimplePush DeviceTokenSimplePush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenSimplePush
+	deviceTokenSimplePush.tdCommon.Type = "deviceTokenSimplePush"
+	return json.Marshal(alias(deviceTokenSimplePush))
+}
+
 // NewDeviceTokenSimplePush creates a new DeviceTokenSimplePush
 //
 // @param endpoint Absolute URL exposed by the push service where the application server can send push messages; may be empty to de-register a device
@@ -22538,6 +26602,13 @@ func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) MessageType() string {
 	return "deviceTokenUbuntuPush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenUbuntuPush wasn't created by NewDeviceTokenUbuntuPush
+func (deviceTokenUbuntuPush DeviceTokenUbuntuPush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenUbuntuPush
+	deviceTokenUbuntuPush.tdCommon.Type = "deviceTokenUbuntuPush"
+	return json.Marshal(alias(deviceTokenUbuntuPush))
+}
+
 // NewDeviceTokenUbuntuPush creates a new DeviceTokenUbuntuPush
 //
 // @param token Token; may be empty to de-register a device
@@ -22566,6 +26637,13 @@ func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) MessageType() string
 	return "deviceTokenBlackBerryPush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenBlackBerryPush wasn't created by NewDeviceTokenBlackBerryPush
+func (deviceTokenBlackBerryPush DeviceTokenBlackBerryPush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenBlackBerryPush
+	deviceTokenBlackBerryPush.tdCommon.Type = "deviceTokenBlackBerryPush"
+	return json.Marshal(alias(deviceTokenBlackBerryPush))
+}
+
 // NewDeviceTokenBlackBerryPush creates a new DeviceTokenBlackBerryPush
 //
 // @param token Token; may be empty to de-register a device
@@ -22594,6 +26672,13 @@ func (deviceTokenTizenPush *DeviceTokenTizenPush) MessageType() string {
 	return "deviceTokenTizenPush"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeviceTokenTizenPush wasn't created by NewDeviceTokenTizenPush
+func (deviceTokenTizenPush DeviceTokenTizenPush) MarshalJSON() ([]byte, error) {
+	type alias DeviceTokenTizenPush
+	deviceTokenTizenPush.tdCommon.Type = "deviceTokenTizenPush"
+	return json.Marshal(alias(deviceTokenTizenPush))
+}
+
 // NewDeviceTokenTizenPush creates a new DeviceTokenTizenPush
 //
 // @param regID Push service registration identifier; may be empty to de-register a device
@@ -22622,6 +26707,13 @@ func (pushReceiverID *PushReceiverID) MessageType() string {
 	return "pushReceiverId"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushReceiverID wasn't created by NewPushReceiverID
+func (pushReceiverID PushReceiverID) MarshalJSON() ([]byte, error) {
+	type alias PushReceiverID
+	pushReceiverID.tdCommon.Type = "pushReceiverId"
+	return json.Marshal(alias(pushReceiverID))
+}
+
 // NewPushReceiverID creates a new PushReceiverID
 //
 // @param iD The globally unique identifier of push notification subscription
@@ -22645,6 +26737,13 @@ func (backgroundFillSolid *BackgroundFillSolid) MessageType() string {
 	return "backgroundFillSolid"
 }
 
+// MarshalJSON marshals to json, with @type set even if BackgroundFillSolid wasn't created by NewBackgroundFillSolid
+func (backgroundFillSolid BackgroundFillSolid) MarshalJSON() ([]byte, error) {
+	type alias BackgroundFillSolid
+	backgroundFillSolid.tdCommon.Type = "backgroundFillSolid"
+	return json.Marshal(alias(backgroundFillSolid))
+}
+
 // NewBackgroundFillSolid creates a new BackgroundFillSolid
 //
 // @param color A color of the background in the RGB24 format
@@ -22675,6 +26774,13 @@ func (backgroundFillGradient *BackgroundFillGradient) MessageType() string {
 	return "backgroundFillGradient"
 }
 
+// MarshalJSON marshals to json, with @type set even if BackgroundFillGradient wasn't created by NewBackgroundFillGradient
+func (backgroundFillGradient BackgroundFillGradient) MarshalJSON() ([]byte, error) {
+	type alias BackgroundFillGradient
+	backgroundFillGradient.tdCommon.Type = "backgroundFillGradient"
+	return json.Marshal(alias(backgroundFillGradient))
+}
+
 // NewBackgroundFillGradient creates a new BackgroundFillGradient
 //
 // @param topColor A top color of the background in the RGB24 format
@@ -22708,6 +26814,13 @@ func (backgroundTypeWallpaper *BackgroundTypeWallpaper) MessageType() string {
 	return "backgroundTypeWallpaper"
 }
 
+// MarshalJSON marshals to json, with @type set even if BackgroundTypeWallpaper wasn't created by NewBackgroundTypeWallpaper
+func (backgroundTypeWallpaper BackgroundTypeWallpaper) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypeWallpaper
+	backgroundTypeWallpaper.tdCommon.Type = "backgroundTypeWallpaper"
+	return json.Marshal(alias(backgroundTypeWallpaper))
+}
+
 // NewBackgroundTypeWallpaper creates a new BackgroundTypeWallpaper
 //
 // @param isBlurred True, if the wallpaper must be downscaled to fit in 450x450 square and then box-blurred with radius 12
@@ -22740,6 +26853,13 @@ func (backgroundTypePattern *BackgroundTypePattern) MessageType() string {
 	return "backgroundTypePattern"
 }
 
+// MarshalJSON marshals to json, with @type set even if BackgroundTypePattern wasn't created by NewBackgroundTypePattern
+func (backgroundTypePattern BackgroundTypePattern) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypePattern
+	backgroundTypePattern.tdCommon.Type = "backgroundTypePattern"
+	return json.Marshal(alias(backgroundTypePattern))
+}
+
 // NewBackgroundTypePattern creates a new BackgroundTypePattern
 //
 // @param fill Description of the background fill
@@ -22799,6 +26919,13 @@ func (backgroundTypeFill *BackgroundTypeFill) MessageType() string {
 	return "backgroundTypeFill"
 }
 
+// MarshalJSON marshals to json, with @type set even if BackgroundTypeFill wasn't created by NewBackgroundTypeFill
+func (backgroundTypeFill BackgroundTypeFill) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypeFill
+	backgroundTypeFill.tdCommon.Type = "backgroundTypeFill"
+	return json.Marshal(alias(backgroundTypeFill))
+}
+
 // NewBackgroundTypeFill creates a new BackgroundTypeFill
 //
 // @param fill Description of the background fill
@@ -22855,6 +26982,13 @@ func (background *Background) MessageType() string {
 	return "background"
 }
 
+// MarshalJSON marshals to json, with @type set even if Background wasn't created by NewBackground
+func (background Background) MarshalJSON() ([]byte, error) {
+	type alias Background
+	background.tdCommon.Type = "background"
+	return json.Marshal(alias(background))
+}
+
 // NewBackground creates a new Background
 //
 // @param iD Unique background identifier
@@ -22922,6 +27056,13 @@ func (backgrounds *Backgrounds) MessageType() string {
 	return "backgrounds"
 }
 
+// MarshalJSON marshals to json, with @type set even if Backgrounds wasn't created by NewBackgrounds
+func (backgrounds Backgrounds) MarshalJSON() ([]byte, error) {
+	type alias Backgrounds
+	backgrounds.tdCommon.Type = "backgrounds"
+	return json.Marshal(alias(backgrounds))
+}
+
 // NewBackgrounds creates a new Backgrounds
 //
 // @param backgrounds A list of backgrounds
@@ -22945,6 +27086,13 @@ func (inputBackgroundLocal *InputBackgroundLocal) MessageType() string {
 	return "inputBackgroundLocal"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputBackgroundLocal wasn't created by NewInputBackgroundLocal
+func (inputBackgroundLocal InputBackgroundLocal) MarshalJSON() ([]byte, error) {
+	type alias InputBackgroundLocal
+	inputBackgroundLocal.tdCommon.Type = "inputBackgroundLocal"
+	return json.Marshal(alias(inputBackgroundLocal))
+}
+
 // NewInputBackgroundLocal creates a new InputBackgroundLocal
 //
 // @param background Background file to use. Only inputFileLocal and inputFileGenerated are supported. The file must be in JPEG format for wallpapers and in PNG format for patterns
@@ -22996,6 +27144,13 @@ func (inputBackgroundRemote *InputBackgroundRemote) MessageType() string {
 	return "inputBackgroundRemote"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputBackgroundRemote wasn't created by NewInputBackgroundRemote
+func (inputBackgroundRemote InputBackgroundRemote) MarshalJSON() ([]byte, error) {
+	type alias InputBackgroundRemote
+	inputBackgroundRemote.tdCommon.Type = "inputBackgroundRemote"
+	return json.Marshal(alias(inputBackgroundRemote))
+}
+
 // NewInputBackgroundRemote creates a new InputBackgroundRemote
 //
 // @param backgroundID The background identifier
@@ -23024,6 +27179,13 @@ func (hashtags *Hashtags) MessageType() string {
 	return "hashtags"
 }
 
+// MarshalJSON marshals to json, with @type set even if Hashtags wasn't created by NewHashtags
+func (hashtags Hashtags) MarshalJSON() ([]byte, error) {
+	type alias Hashtags
+	hashtags.tdCommon.Type = "hashtags"
+	return json.Marshal(alias(hashtags))
+}
+
 // NewHashtags creates a new Hashtags
 //
 // @param hashtags A list of hashtags
@@ -23046,6 +27208,13 @@ func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) MessageType()
 	return "canTransferOwnershipResultOk"
 }
 
+// MarshalJSON marshals to json, with @type set even if CanTransferOwnershipResultOk wasn't created by NewCanTransferOwnershipResultOk
+func (canTransferOwnershipResultOk CanTransferOwnershipResultOk) MarshalJSON() ([]byte, error) {
+	type alias CanTransferOwnershipResultOk
+	canTransferOwnershipResultOk.tdCommon.Type = "canTransferOwnershipResultOk"
+	return json.Marshal(alias(canTransferOwnershipResultOk))
+}
+
 // NewCanTransferOwnershipResultOk creates a new CanTransferOwnershipResultOk
 //
 func NewCanTransferOwnershipResultOk() *CanTransferOwnershipResultOk {
@@ -23071,6 +27240,13 @@ func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswo
 	return "canTransferOwnershipResultPasswordNeeded"
 }
 
+// MarshalJSON marshals to json, with @type set even if CanTransferOwnershipResultPasswordNeeded wasn't created by NewCanTransferOwnershipResultPasswordNeeded
+func (canTransferOwnershipResultPasswordNeeded CanTransferOwnershipResultPasswordNeeded) MarshalJSON() ([]byte, error) {
+	type alias CanTransferOwnershipResultPasswordNeeded
+	canTransferOwnershipResultPasswordNeeded.tdCommon.Type = "canTransferOwnershipResultPasswordNeeded"
+	return json.Marshal(alias(canTransferOwnershipResultPasswordNeeded))
+}
+
 // NewCanTransferOwnershipResultPasswordNeeded creates a new CanTransferOwnershipResultPasswordNeeded
 //
 func NewCanTransferOwnershipResultPasswordNeeded() *CanTransferOwnershipResultPasswordNeeded {
@@ -23097,6 +27273,13 @@ func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPass
 	return "canTransferOwnershipResultPasswordTooFresh"
 }
 
+// MarshalJSON marshals to json, with @type set even if CanTransferOwnershipResultPasswordTooFresh wasn't created by NewCanTransferOwnershipResultPasswordTooFresh
+func (canTransferOwnershipResultPasswordTooFresh CanTransferOwnershipResultPasswordTooFresh) MarshalJSON() ([]byte, error) {
+	type alias CanTransferOwnershipResultPasswordTooFresh
+	canTransferOwnershipResultPasswordTooFresh.tdCommon.Type = "canTransferOwnershipResultPasswordTooFresh"
+	return json.Marshal(alias(canTransferOwnershipResultPasswordTooFresh))
+}
+
 // NewCanTransferOwnershipResultPasswordTooFresh creates a new CanTransferOwnershipResultPasswordTooFresh
 //
 // @param retryAfter Time left before the session can be used to transfer ownership of a chat, in seconds
@@ -23125,6 +27308,13 @@ func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessi
 	return "canTransferOwnershipResultSessionTooFresh"
 }
 
+// MarshalJSON marshals to json, with @type set even if CanTransferOwnershipResultSessionTooFresh wasn't created by NewCanTransferOwnershipResultSessionTooFresh
+func (canTransferOwnershipResultSessionTooFresh CanTransferOwnershipResultSessionTooFresh) MarshalJSON() ([]byte, error) {
+	type alias CanTransferOwnershipResultSessionTooFresh
+	canTransferOwnershipResultSessionTooFresh.tdCommon.Type = "canTransferOwnershipResultSessionTooFresh"
+	return json.Marshal(alias(canTransferOwnershipResultSessionTooFresh))
+}
+
 // NewCanTransferOwnershipResultSessionTooFresh creates a new CanTransferOwnershipResultSessionTooFresh
 //
 // @param retryAfter Time left before the session can be used to transfer ownership of a chat, in seconds
@@ -23152,6 +27342,13 @@ func (checkChatUsernameResultOk *CheckChatUsernameResultOk) MessageType() string
 	return "checkChatUsernameResultOk"
 }
 
+// MarshalJSON marshals to json, with @type set even if CheckChatUsernameResultOk wasn't created by NewCheckChatUsernameResultOk
+func (checkChatUsernameResultOk CheckChatUsernameResultOk) MarshalJSON() ([]byte, error) {
+	type alias CheckChatUsernameResultOk
+	checkChatUsernameResultOk.tdCommon.Type = "checkChatUsernameResultOk"
+	return json.Marshal(alias(checkChatUsernameResultOk))
+}
+
 // NewCheckChatUsernameResultOk creates a new CheckChatUsernameResultOk
 //
 func NewCheckChatUsernameResultOk() *CheckChatUsernameResultOk {
@@ -23177,6 +27374,13 @@ func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInv
 	return "checkChatUsernameResultUsernameInvalid"
 }
 
+// MarshalJSON marshals to json, with @type set even if CheckChatUsernameResultUsernameInvalid wasn't created by NewCheckChatUsernameResultUsernameInvalid
+func (checkChatUsernameResultUsernameInvalid CheckChatUsernameResultUsernameInvalid) MarshalJSON() ([]byte, error) {
+	type alias CheckChatUsernameResultUsernameInvalid
+	checkChatUsernameResultUsernameInvalid.tdCommon.Type = "checkChatUsernameResultUsernameInvalid"
+	return json.Marshal(alias(checkChatUsernameResultUsernameInvalid))
+}
+
 // NewCheckChatUsernameResultUsernameInvalid creates a new CheckChatUsernameResultUsernameInvalid
 //
 func NewCheckChatUsernameResultUsernameInvalid() *CheckChatUsernameResultUsernameInvalid {
@@ -23202,6 +27406,13 @@ func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOc
 	return "checkChatUsernameResultUsernameOccupied"
 }
 
+// MarshalJSON marshals to json, with @type set even if CheckChatUsernameResultUsernameOccupied wasn't created by NewCheckChatUsernameResultUsernameOccupied
+func (checkChatUsernameResultUsernameOccupied CheckChatUsernameResultUsernameOccupied) MarshalJSON() ([]byte, error) {
+	type alias CheckChatUsernameResultUsernameOccupied
+	checkChatUsernameResultUsernameOccupied.tdCommon.Type = "checkChatUsernameResultUsernameOccupied"
+	return json.Marshal(alias(checkChatUsernameResultUsernameOccupied))
+}
+
 // NewCheckChatUsernameResultUsernameOccupied creates a new CheckChatUsernameResultUsernameOccupied
 //
 func NewCheckChatUsernameResultUsernameOccupied() *CheckChatUsernameResultUsernameOccupied {
@@ -23227,6 +27438,13 @@ func (checkChatUsernameResultPublicChatsTooMuch *CheckChatUsernameResultPublicCh
 	return "checkChatUsernameResultPublicChatsTooMuch"
 }
 
+// MarshalJSON marshals to json, with @type set even if CheckChatUsernameResultPublicChatsTooMuch wasn't created by NewCheckChatUsernameResultPublicChatsTooMuch
+func (checkChatUsernameResultPublicChatsTooMuch CheckChatUsernameResultPublicChatsTooMuch) MarshalJSON() ([]byte, error) {
+	type alias CheckChatUsernameResultPublicChatsTooMuch
+	checkChatUsernameResultPublicChatsTooMuch.tdCommon.Type = "checkChatUsernameResultPublicChatsTooMuch"
+	return json.Marshal(alias(checkChatUsernameResultPublicChatsTooMuch))
+}
+
 // NewCheckChatUsernameResultPublicChatsTooMuch creates a new CheckChatUsernameResultPublicChatsTooMuch
 //
 func NewCheckChatUsernameResultPublicChatsTooMuch() *CheckChatUsernameResultPublicChatsTooMuch {
@@ -23252,6 +27470,13 @@ func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPub
 	return "checkChatUsernameResultPublicGroupsUnavailable"
 }
 
+// MarshalJSON marshals to json, with @type set even if CheckChatUsernameResultPublicGroupsUnavailable wasn't created by NewCheckChatUsernameResultPublicGroupsUnavailable
+func (checkChatUsernameResultPublicGroupsUnavailable CheckChatUsernameResultPublicGroupsUnavailable) MarshalJSON() ([]byte, error) {
+	type alias CheckChatUsernameResultPublicGroupsUnavailable
+	checkChatUsernameResultPublicGroupsUnavailable.tdCommon.Type = "checkChatUsernameResultPublicGroupsUnavailable"
+	return json.Marshal(alias(checkChatUsernameResultPublicGroupsUnavailable))
+}
+
 // NewCheckChatUsernameResultPublicGroupsUnavailable creates a new CheckChatUsernameResultPublicGroupsUnavailable
 //
 func NewCheckChatUsernameResultPublicGroupsUnavailable() *CheckChatUsernameResultPublicGroupsUnavailable {
@@ -23278,6 +27503,13 @@ func (messageFileTypePrivate *MessageFileTypePrivate) MessageType() string {
 	return "messageFileTypePrivate"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageFileTypePrivate wasn't created by NewMessageFileTypePrivate
+func (messageFileTypePrivate MessageFileTypePrivate) MarshalJSON() ([]byte, error) {
+	type alias MessageFileTypePrivate
+	messageFileTypePrivate.tdCommon.Type = "messageFileTypePrivate"
+	return json.Marshal(alias(messageFileTypePrivate))
+}
+
 // NewMessageFileTypePrivate creates a new MessageFileTypePrivate
 //
 // @param name Name of the other party; may be empty if unrecognized
@@ -23306,6 +27538,13 @@ func (messageFileTypeGroup *MessageFileTypeGroup) MessageType() string {
 	return "messageFileTypeGroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageFileTypeGroup wasn't created by NewMessageFileTypeGroup
+func (messageFileTypeGroup MessageFileTypeGroup) MarshalJSON() ([]byte, error) {
+	type alias MessageFileTypeGroup
+	messageFileTypeGroup.tdCommon.Type = "messageFileTypeGroup"
+	return json.Marshal(alias(messageFileTypeGroup))
+}
+
 // NewMessageFileTypeGroup creates a new MessageFileTypeGroup
 //
 // @param title Title of the group chat; may be empty if unrecognized
@@ -23333,6 +27572,13 @@ func (messageFileTypeUnknown *MessageFileTypeUnknown) MessageType() string {
 	return "messageFileTypeUnknown"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageFileTypeUnknown wasn't created by NewMessageFileTypeUnknown
+func (messageFileTypeUnknown MessageFileTypeUnknown) MarshalJSON() ([]byte, error) {
+	type alias MessageFileTypeUnknown
+	messageFileTypeUnknown.tdCommon.Type = "messageFileTypeUnknown"
+	return json.Marshal(alias(messageFileTypeUnknown))
+}
+
 // NewMessageFileTypeUnknown creates a new MessageFileTypeUnknown
 //
 func NewMessageFileTypeUnknown() *MessageFileTypeUnknown {
@@ -23359,6 +27605,13 @@ func (pushMessageContentHidden *PushMessageContentHidden) MessageType() string {
 	return "pushMessageContentHidden"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentHidden wasn't created by NewPushMessageContentHidden
+func (pushMessageContentHidden PushMessageContentHidden) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentHidden
+	pushMessageContentHidden.tdCommon.Type = "pushMessageContentHidden"
+	return json.Marshal(alias(pushMessageContentHidden))
+}
+
 // NewPushMessageContentHidden creates a new PushMessageContentHidden
 //
 // @param isPinned True, if the message is a pinned message with the specified content
@@ -23389,6 +27642,13 @@ func (pushMessageContentAnimation *PushMessageContentAnimation) MessageType() st
 	return "pushMessageContentAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentAnimation wasn't created by NewPushMessageContentAnimation
+func (pushMessageContentAnimation PushMessageContentAnimation) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentAnimation
+	pushMessageContentAnimation.tdCommon.Type = "pushMessageContentAnimation"
+	return json.Marshal(alias(pushMessageContentAnimation))
+}
+
 // NewPushMessageContentAnimation creates a new PushMessageContentAnimation
 //
 // @param animation Message content; may be null
@@ -23422,6 +27682,13 @@ func (pushMessageContentAudio *PushMessageContentAudio) MessageType() string {
 	return "pushMessageContentAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentAudio wasn't created by NewPushMessageContentAudio
+func (pushMessageContentAudio PushMessageContentAudio) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentAudio
+	pushMessageContentAudio.tdCommon.Type = "pushMessageContentAudio"
+	return json.Marshal(alias(pushMessageContentAudio))
+}
+
 // NewPushMessageContentAudio creates a new PushMessageContentAudio
 //
 // @param audio Message content; may be null
@@ -23453,6 +27720,13 @@ func (pushMessageContentContact *PushMessageContentContact) MessageType() string
 	return "pushMessageContentContact"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentContact wasn't created by NewPushMessageContentContact
+func (pushMessageContentContact PushMessageContentContact) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentContact
+	pushMessageContentContact.tdCommon.Type = "pushMessageContentContact"
+	return json.Marshal(alias(pushMessageContentContact))
+}
+
 // NewPushMessageContentContact creates a new PushMessageContentContact
 //
 // @param name Contact's name
@@ -23482,6 +27756,13 @@ func (pushMessageContentContactRegistered *PushMessageContentContactRegistered)
 	return "pushMessageContentContactRegistered"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentContactRegistered wasn't created by NewPushMessageContentContactRegistered
+func (pushMessageContentContactRegistered PushMessageContentContactRegistered) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentContactRegistered
+	pushMessageContentContactRegistered.tdCommon.Type = "pushMessageContentContactRegistered"
+	return json.Marshal(alias(pushMessageContentContactRegistered))
+}
+
 // NewPushMessageContentContactRegistered creates a new PushMessageContentContactRegistered
 //
 func NewPushMessageContentContactRegistered() *PushMessageContentContactRegistered {
@@ -23509,6 +27790,13 @@ func (pushMessageContentDocument *PushMessageContentDocument) MessageType() stri
 	return "pushMessageContentDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentDocument wasn't created by NewPushMessageContentDocument
+func (pushMessageContentDocument PushMessageContentDocument) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentDocument
+	pushMessageContentDocument.tdCommon.Type = "pushMessageContentDocument"
+	return json.Marshal(alias(pushMessageContentDocument))
+}
+
 // NewPushMessageContentDocument creates a new PushMessageContentDocument
 //
 // @param document Message content; may be null
@@ -23540,6 +27828,13 @@ func (pushMessageContentGame *PushMessageContentGame) MessageType() string {
 	return "pushMessageContentGame"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentGame wasn't created by NewPushMessageContentGame
+func (pushMessageContentGame PushMessageContentGame) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentGame
+	pushMessageContentGame.tdCommon.Type = "pushMessageContentGame"
+	return json.Marshal(alias(pushMessageContentGame))
+}
+
 // NewPushMessageContentGame creates a new PushMessageContentGame
 //
 // @param title Game title, empty for pinned game message
@@ -23572,6 +27867,13 @@ func (pushMessageContentGameScore *PushMessageContentGameScore) MessageType() st
 	return "pushMessageContentGameScore"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentGameScore wasn't created by NewPushMessageContentGameScore
+func (pushMessageContentGameScore PushMessageContentGameScore) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentGameScore
+	pushMessageContentGameScore.tdCommon.Type = "pushMessageContentGameScore"
+	return json.Marshal(alias(pushMessageContentGameScore))
+}
+
 // NewPushMessageContentGameScore creates a new PushMessageContentGameScore
 //
 // @param title Game title, empty for pinned message
@@ -23605,6 +27907,13 @@ func (pushMessageContentInvoice *PushMessageContentInvoice) MessageType() string
 	return "pushMessageContentInvoice"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentInvoice wasn't created by NewPushMessageContentInvoice
+func (pushMessageContentInvoice PushMessageContentInvoice) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentInvoice
+	pushMessageContentInvoice.tdCommon.Type = "pushMessageContentInvoice"
+	return json.Marshal(alias(pushMessageContentInvoice))
+}
+
 // NewPushMessageContentInvoice creates a new PushMessageContentInvoice
 //
 // @param price Product price
@@ -23636,6 +27945,13 @@ func (pushMessageContentLocation *PushMessageContentLocation) MessageType() stri
 	return "pushMessageContentLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentLocation wasn't created by NewPushMessageContentLocation
+func (pushMessageContentLocation PushMessageContentLocation) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentLocation
+	pushMessageContentLocation.tdCommon.Type = "pushMessageContentLocation"
+	return json.Marshal(alias(pushMessageContentLocation))
+}
+
 // NewPushMessageContentLocation creates a new PushMessageContentLocation
 //
 // @param isLive True, if the location is live
@@ -23669,6 +27985,13 @@ func (pushMessageContentPhoto *PushMessageContentPhoto) MessageType() string {
 	return "pushMessageContentPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentPhoto wasn't created by NewPushMessageContentPhoto
+func (pushMessageContentPhoto PushMessageContentPhoto) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentPhoto
+	pushMessageContentPhoto.tdCommon.Type = "pushMessageContentPhoto"
+	return json.Marshal(alias(pushMessageContentPhoto))
+}
+
 // NewPushMessageContentPhoto creates a new PushMessageContentPhoto
 //
 // @param photo Message content; may be null
@@ -23705,6 +28028,13 @@ func (pushMessageContentPoll *PushMessageContentPoll) MessageType() string {
 	return "pushMessageContentPoll"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentPoll wasn't created by NewPushMessageContentPoll
+func (pushMessageContentPoll PushMessageContentPoll) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentPoll
+	pushMessageContentPoll.tdCommon.Type = "pushMessageContentPoll"
+	return json.Marshal(alias(pushMessageContentPoll))
+}
+
 // NewPushMessageContentPoll creates a new PushMessageContentPoll
 //
 // @param question Poll question
@@ -23736,6 +28066,13 @@ func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) Mess
 	return "pushMessageContentScreenshotTaken"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentScreenshotTaken wasn't created by NewPushMessageContentScreenshotTaken
+func (pushMessageContentScreenshotTaken PushMessageContentScreenshotTaken) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentScreenshotTaken
+	pushMessageContentScreenshotTaken.tdCommon.Type = "pushMessageContentScreenshotTaken"
+	return json.Marshal(alias(pushMessageContentScreenshotTaken))
+}
+
 // NewPushMessageContentScreenshotTaken creates a new PushMessageContentScreenshotTaken
 //
 func NewPushMessageContentScreenshotTaken() *PushMessageContentScreenshotTaken {
@@ -23764,6 +28101,13 @@ func (pushMessageContentSticker *PushMessageContentSticker) MessageType() string
 	return "pushMessageContentSticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentSticker wasn't created by NewPushMessageContentSticker
+func (pushMessageContentSticker PushMessageContentSticker) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentSticker
+	pushMessageContentSticker.tdCommon.Type = "pushMessageContentSticker"
+	return json.Marshal(alias(pushMessageContentSticker))
+}
+
 // NewPushMessageContentSticker creates a new PushMessageContentSticker
 //
 // @param sticker Message content; may be null
@@ -23797,6 +28141,13 @@ func (pushMessageContentText *PushMessageContentText) MessageType() string {
 	return "pushMessageContentText"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentText wasn't created by NewPushMessageContentText
+func (pushMessageContentText PushMessageContentText) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentText
+	pushMessageContentText.tdCommon.Type = "pushMessageContentText"
+	return json.Marshal(alias(pushMessageContentText))
+}
+
 // NewPushMessageContentText creates a new PushMessageContentText
 //
 // @param text Message text
@@ -23830,6 +28181,13 @@ func (pushMessageContentVideo *PushMessageContentVideo) MessageType() string {
 	return "pushMessageContentVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentVideo wasn't created by NewPushMessageContentVideo
+func (pushMessageContentVideo PushMessageContentVideo) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentVideo
+	pushMessageContentVideo.tdCommon.Type = "pushMessageContentVideo"
+	return json.Marshal(alias(pushMessageContentVideo))
+}
+
 // NewPushMessageContentVideo creates a new PushMessageContentVideo
 //
 // @param video Message content; may be null
@@ -23865,6 +28223,13 @@ func (pushMessageContentVideoNote *PushMessageContentVideoNote) MessageType() st
 	return "pushMessageContentVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentVideoNote wasn't created by NewPushMessageContentVideoNote
+func (pushMessageContentVideoNote PushMessageContentVideoNote) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentVideoNote
+	pushMessageContentVideoNote.tdCommon.Type = "pushMessageContentVideoNote"
+	return json.Marshal(alias(pushMessageContentVideoNote))
+}
+
 // NewPushMessageContentVideoNote creates a new PushMessageContentVideoNote
 //
 // @param videoNote Message content; may be null
@@ -23896,6 +28261,13 @@ func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) MessageType() st
 	return "pushMessageContentVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentVoiceNote wasn't created by NewPushMessageContentVoiceNote
+func (pushMessageContentVoiceNote PushMessageContentVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentVoiceNote
+	pushMessageContentVoiceNote.tdCommon.Type = "pushMessageContentVoiceNote"
+	return json.Marshal(alias(pushMessageContentVoiceNote))
+}
+
 // NewPushMessageContentVoiceNote creates a new PushMessageContentVoiceNote
 //
 // @param voiceNote Message content; may be null
@@ -23925,6 +28297,13 @@ func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCr
 	return "pushMessageContentBasicGroupChatCreate"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentBasicGroupChatCreate wasn't created by NewPushMessageContentBasicGroupChatCreate
+func (pushMessageContentBasicGroupChatCreate PushMessageContentBasicGroupChatCreate) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentBasicGroupChatCreate
+	pushMessageContentBasicGroupChatCreate.tdCommon.Type = "pushMessageContentBasicGroupChatCreate"
+	return json.Marshal(alias(pushMessageContentBasicGroupChatCreate))
+}
+
 // NewPushMessageContentBasicGroupChatCreate creates a new PushMessageContentBasicGroupChatCreate
 //
 func NewPushMessageContentBasicGroupChatCreate() *PushMessageContentBasicGroupChatCreate {
@@ -23953,6 +28332,13 @@ func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) Messag
 	return "pushMessageContentChatAddMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentChatAddMembers wasn't created by NewPushMessageContentChatAddMembers
+func (pushMessageContentChatAddMembers PushMessageContentChatAddMembers) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentChatAddMembers
+	pushMessageContentChatAddMembers.tdCommon.Type = "pushMessageContentChatAddMembers"
+	return json.Marshal(alias(pushMessageContentChatAddMembers))
+}
+
 // NewPushMessageContentChatAddMembers creates a new PushMessageContentChatAddMembers
 //
 // @param memberName Name of the added member
@@ -23984,6 +28370,13 @@ func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) Mess
 	return "pushMessageContentChatChangePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentChatChangePhoto wasn't created by NewPushMessageContentChatChangePhoto
+func (pushMessageContentChatChangePhoto PushMessageContentChatChangePhoto) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentChatChangePhoto
+	pushMessageContentChatChangePhoto.tdCommon.Type = "pushMessageContentChatChangePhoto"
+	return json.Marshal(alias(pushMessageContentChatChangePhoto))
+}
+
 // NewPushMessageContentChatChangePhoto creates a new PushMessageContentChatChangePhoto
 //
 func NewPushMessageContentChatChangePhoto() *PushMessageContentChatChangePhoto {
@@ -24010,6 +28403,13 @@ func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) Mess
 	return "pushMessageContentChatChangeTitle"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentChatChangeTitle wasn't created by NewPushMessageContentChatChangeTitle
+func (pushMessageContentChatChangeTitle PushMessageContentChatChangeTitle) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentChatChangeTitle
+	pushMessageContentChatChangeTitle.tdCommon.Type = "pushMessageContentChatChangeTitle"
+	return json.Marshal(alias(pushMessageContentChatChangeTitle))
+}
+
 // NewPushMessageContentChatChangeTitle creates a new PushMessageContentChatChangeTitle
 //
 // @param title New chat title
@@ -24040,6 +28440,13 @@ func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) Me
 	return "pushMessageContentChatDeleteMember"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentChatDeleteMember wasn't created by NewPushMessageContentChatDeleteMember
+func (pushMessageContentChatDeleteMember PushMessageContentChatDeleteMember) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentChatDeleteMember
+	pushMessageContentChatDeleteMember.tdCommon.Type = "pushMessageContentChatDeleteMember"
+	return json.Marshal(alias(pushMessageContentChatDeleteMember))
+}
+
 // NewPushMessageContentChatDeleteMember creates a new PushMessageContentChatDeleteMember
 //
 // @param memberName Name of the deleted member
@@ -24071,6 +28478,13 @@ func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) Messag
 	return "pushMessageContentChatJoinByLink"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentChatJoinByLink wasn't created by NewPushMessageContentChatJoinByLink
+func (pushMessageContentChatJoinByLink PushMessageContentChatJoinByLink) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentChatJoinByLink
+	pushMessageContentChatJoinByLink.tdCommon.Type = "pushMessageContentChatJoinByLink"
+	return json.Marshal(alias(pushMessageContentChatJoinByLink))
+}
+
 // NewPushMessageContentChatJoinByLink creates a new PushMessageContentChatJoinByLink
 //
 func NewPushMessageContentChatJoinByLink() *PushMessageContentChatJoinByLink {
@@ -24097,6 +28511,13 @@ func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) Mess
 	return "pushMessageContentMessageForwards"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentMessageForwards wasn't created by NewPushMessageContentMessageForwards
+func (pushMessageContentMessageForwards PushMessageContentMessageForwards) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentMessageForwards
+	pushMessageContentMessageForwards.tdCommon.Type = "pushMessageContentMessageForwards"
+	return json.Marshal(alias(pushMessageContentMessageForwards))
+}
+
 // NewPushMessageContentMessageForwards creates a new PushMessageContentMessageForwards
 //
 // @param totalCount Number of forwarded messages
@@ -24129,6 +28550,13 @@ func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) MessageType()
 	return "pushMessageContentMediaAlbum"
 }
 
+// MarshalJSON marshals to json, with @type set even if PushMessageContentMediaAlbum wasn't created by NewPushMessageContentMediaAlbum
+func (pushMessageContentMediaAlbum PushMessageContentMediaAlbum) MarshalJSON() ([]byte, error) {
+	type alias PushMessageContentMediaAlbum
+	pushMessageContentMediaAlbum.tdCommon.Type = "pushMessageContentMediaAlbum"
+	return json.Marshal(alias(pushMessageContentMediaAlbum))
+}
+
 // NewPushMessageContentMediaAlbum creates a new PushMessageContentMediaAlbum
 //
 // @param totalCount Number of messages in the album
@@ -24165,6 +28593,13 @@ func (notificationTypeNewMessage *NotificationTypeNewMessage) MessageType() stri
 	return "notificationTypeNewMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationTypeNewMessage wasn't created by NewNotificationTypeNewMessage
+func (notificationTypeNewMessage NotificationTypeNewMessage) MarshalJSON() ([]byte, error) {
+	type alias NotificationTypeNewMessage
+	notificationTypeNewMessage.tdCommon.Type = "notificationTypeNewMessage"
+	return json.Marshal(alias(notificationTypeNewMessage))
+}
+
 // NewNotificationTypeNewMessage creates a new NotificationTypeNewMessage
 //
 // @param message The message
@@ -24192,6 +28627,13 @@ func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) MessageType(
 	return "notificationTypeNewSecretChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationTypeNewSecretChat wasn't created by NewNotificationTypeNewSecretChat
+func (notificationTypeNewSecretChat NotificationTypeNewSecretChat) MarshalJSON() ([]byte, error) {
+	type alias NotificationTypeNewSecretChat
+	notificationTypeNewSecretChat.tdCommon.Type = "notificationTypeNewSecretChat"
+	return json.Marshal(alias(notificationTypeNewSecretChat))
+}
+
 // NewNotificationTypeNewSecretChat creates a new NotificationTypeNewSecretChat
 //
 func NewNotificationTypeNewSecretChat() *NotificationTypeNewSecretChat {
@@ -24218,6 +28660,13 @@ func (notificationTypeNewCall *NotificationTypeNewCall) MessageType() string {
 	return "notificationTypeNewCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationTypeNewCall wasn't created by NewNotificationTypeNewCall
+func (notificationTypeNewCall NotificationTypeNewCall) MarshalJSON() ([]byte, error) {
+	type alias NotificationTypeNewCall
+	notificationTypeNewCall.tdCommon.Type = "notificationTypeNewCall"
+	return json.Marshal(alias(notificationTypeNewCall))
+}
+
 // NewNotificationTypeNewCall creates a new NotificationTypeNewCall
 //
 // @param callID Call identifier
@@ -24238,7 +28687,7 @@ func (notificationTypeNewCall *NotificationTypeNewCall) GetNotificationTypeEnum(
 // NotificationTypeNewPushMessage New message was received through a push notification
 type NotificationTypeNewPushMessage struct {
 	tdCommon
-	MessageID  int64              `json:"message_id"`  // The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id
+	MessageID  JSONInt64          `json:"message_id"`  // The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id
 	Sender     MessageSender      `json:"sender"`      // The sender of the message. Corresponding user or chat may be inaccessible
 	SenderName string             `json:"sender_name"` // Name of the sender
 	IsOutgoing bool               `json:"is_outgoing"` // True, if the message is outgoing
@@ -24250,6 +28699,13 @@ func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) MessageTyp
 	return "notificationTypeNewPushMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationTypeNewPushMessage wasn't created by NewNotificationTypeNewPushMessage
+func (notificationTypeNewPushMessage NotificationTypeNewPushMessage) MarshalJSON() ([]byte, error) {
+	type alias NotificationTypeNewPushMessage
+	notificationTypeNewPushMessage.tdCommon.Type = "notificationTypeNewPushMessage"
+	return json.Marshal(alias(notificationTypeNewPushMessage))
+}
+
 // NewNotificationTypeNewPushMessage creates a new NotificationTypeNewPushMessage
 //
 // @param messageID The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id
@@ -24257,7 +28713,7 @@ func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) MessageTyp
 // @param senderName Name of the sender
 // @param isOutgoing True, if the message is outgoing
 // @param content Push message content
-func NewNotificationTypeNewPushMessage(messageID int64, sender MessageSender, senderName string, isOutgoing bool, content PushMessageContent) *NotificationTypeNewPushMessage {
+func NewNotificationTypeNewPushMessage(messageID JSONInt64, sender MessageSender, senderName string, isOutgoing bool, content PushMessageContent) *NotificationTypeNewPushMessage {
 	notificationTypeNewPushMessageTemp := NotificationTypeNewPushMessage{
 		tdCommon:   tdCommon{Type: "notificationTypeNewPushMessage"},
 		MessageID:  messageID,
@@ -24279,9 +28735,9 @@ func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) UnmarshalJ
 	}
 	tempObj := struct {
 		tdCommon
-		MessageID  int64  `json:"message_id"`  // The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id
-		SenderName string `json:"sender_name"` // Name of the sender
-		IsOutgoing bool   `json:"is_outgoing"` // True, if the message is outgoing
+		MessageID  JSONInt64 `json:"message_id"`  // The message identifier. The message will not be available in the chat history, but the ID can be used in viewMessages, or as reply_to_message_id
+		SenderName string    `json:"sender_name"` // Name of the sender
+		IsOutgoing bool      `json:"is_outgoing"` // True, if the message is outgoing
 
 	}{}
 	err = json.Unmarshal(b, &tempObj)
@@ -24318,6 +28774,13 @@ func (notificationGroupTypeMessages *NotificationGroupTypeMessages) MessageType(
 	return "notificationGroupTypeMessages"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationGroupTypeMessages wasn't created by NewNotificationGroupTypeMessages
+func (notificationGroupTypeMessages NotificationGroupTypeMessages) MarshalJSON() ([]byte, error) {
+	type alias NotificationGroupTypeMessages
+	notificationGroupTypeMessages.tdCommon.Type = "notificationGroupTypeMessages"
+	return json.Marshal(alias(notificationGroupTypeMessages))
+}
+
 // NewNotificationGroupTypeMessages creates a new NotificationGroupTypeMessages
 //
 func NewNotificationGroupTypeMessages() *NotificationGroupTypeMessages {
@@ -24343,6 +28806,13 @@ func (notificationGroupTypeMentions *NotificationGroupTypeMentions) MessageType(
 	return "notificationGroupTypeMentions"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationGroupTypeMentions wasn't created by NewNotificationGroupTypeMentions
+func (notificationGroupTypeMentions NotificationGroupTypeMentions) MarshalJSON() ([]byte, error) {
+	type alias NotificationGroupTypeMentions
+	notificationGroupTypeMentions.tdCommon.Type = "notificationGroupTypeMentions"
+	return json.Marshal(alias(notificationGroupTypeMentions))
+}
+
 // NewNotificationGroupTypeMentions creates a new NotificationGroupTypeMentions
 //
 func NewNotificationGroupTypeMentions() *NotificationGroupTypeMentions {
@@ -24368,6 +28838,13 @@ func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) MessageT
 	return "notificationGroupTypeSecretChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationGroupTypeSecretChat wasn't created by NewNotificationGroupTypeSecretChat
+func (notificationGroupTypeSecretChat NotificationGroupTypeSecretChat) MarshalJSON() ([]byte, error) {
+	type alias NotificationGroupTypeSecretChat
+	notificationGroupTypeSecretChat.tdCommon.Type = "notificationGroupTypeSecretChat"
+	return json.Marshal(alias(notificationGroupTypeSecretChat))
+}
+
 // NewNotificationGroupTypeSecretChat creates a new NotificationGroupTypeSecretChat
 //
 func NewNotificationGroupTypeSecretChat() *NotificationGroupTypeSecretChat {
@@ -24393,6 +28870,13 @@ func (notificationGroupTypeCalls *NotificationGroupTypeCalls) MessageType() stri
 	return "notificationGroupTypeCalls"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationGroupTypeCalls wasn't created by NewNotificationGroupTypeCalls
+func (notificationGroupTypeCalls NotificationGroupTypeCalls) MarshalJSON() ([]byte, error) {
+	type alias NotificationGroupTypeCalls
+	notificationGroupTypeCalls.tdCommon.Type = "notificationGroupTypeCalls"
+	return json.Marshal(alias(notificationGroupTypeCalls))
+}
+
 // NewNotificationGroupTypeCalls creates a new NotificationGroupTypeCalls
 //
 func NewNotificationGroupTypeCalls() *NotificationGroupTypeCalls {
@@ -24422,6 +28906,13 @@ func (notification *Notification) MessageType() string {
 	return "notification"
 }
 
+// MarshalJSON marshals to json, with @type set even if Notification wasn't created by NewNotification
+func (notification Notification) MarshalJSON() ([]byte, error) {
+	type alias Notification
+	notification.tdCommon.Type = "notification"
+	return json.Marshal(alias(notification))
+}
+
 // NewNotification creates a new Notification
 //
 // @param iD Unique persistent identifier of this notification
@@ -24475,7 +28966,7 @@ type NotificationGroup struct {
 	tdCommon
 	ID            int32                 `json:"id"`            // Unique persistent auto-incremented from 1 identifier of the notification group
 	Type          NotificationGroupType `json:"type"`          // Type of the group
-	ChatID        int64                 `json:"chat_id"`       // Identifier of a chat to which all notifications in the group belong
+	ChatID        JSONInt64             `json:"chat_id"`       // Identifier of a chat to which all notifications in the group belong
 	TotalCount    int32                 `json:"total_count"`   // Total number of active notifications in the group
 	Notifications []Notification        `json:"notifications"` // The list of active notifications
 }
@@ -24485,6 +28976,13 @@ func (notificationGroup *NotificationGroup) MessageType() string {
 	return "notificationGroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if NotificationGroup wasn't created by NewNotificationGroup
+func (notificationGroup NotificationGroup) MarshalJSON() ([]byte, error) {
+	type alias NotificationGroup
+	notificationGroup.tdCommon.Type = "notificationGroup"
+	return json.Marshal(alias(notificationGroup))
+}
+
 // NewNotificationGroup creates a new NotificationGroup
 //
 // @param iD Unique persistent auto-incremented from 1 identifier of the notification group
@@ -24492,7 +28990,7 @@ func (notificationGroup *NotificationGroup) MessageType() string {
 // @param chatID Identifier of a chat to which all notifications in the group belong
 // @param totalCount Total number of active notifications in the group
 // @param notifications The list of active notifications
-func NewNotificationGroup(iD int32, typeParam NotificationGroupType, chatID int64, totalCount int32, notifications []Notification) *NotificationGroup {
+func NewNotificationGroup(iD int32, typeParam NotificationGroupType, chatID JSONInt64, totalCount int32, notifications []Notification) *NotificationGroup {
 	notificationGroupTemp := NotificationGroup{
 		tdCommon:      tdCommon{Type: "notificationGroup"},
 		ID:            iD,
@@ -24515,7 +29013,7 @@ func (notificationGroup *NotificationGroup) UnmarshalJSON(b []byte) error {
 	tempObj := struct {
 		tdCommon
 		ID            int32          `json:"id"`            // Unique persistent auto-incremented from 1 identifier of the notification group
-		ChatID        int64          `json:"chat_id"`       // Identifier of a chat to which all notifications in the group belong
+		ChatID        JSONInt64      `json:"chat_id"`       // Identifier of a chat to which all notifications in the group belong
 		TotalCount    int32          `json:"total_count"`   // Total number of active notifications in the group
 		Notifications []Notification `json:"notifications"` // The list of active notifications
 	}{}
@@ -24547,6 +29045,13 @@ func (optionValueBoolean *OptionValueBoolean) MessageType() string {
 	return "optionValueBoolean"
 }
 
+// MarshalJSON marshals to json, with @type set even if OptionValueBoolean wasn't created by NewOptionValueBoolean
+func (optionValueBoolean OptionValueBoolean) MarshalJSON() ([]byte, error) {
+	type alias OptionValueBoolean
+	optionValueBoolean.tdCommon.Type = "optionValueBoolean"
+	return json.Marshal(alias(optionValueBoolean))
+}
+
 // NewOptionValueBoolean creates a new OptionValueBoolean
 //
 // @param value The value of the option
@@ -24574,6 +29079,13 @@ func (optionValueEmpty *OptionValueEmpty) MessageType() string {
 	return "optionValueEmpty"
 }
 
+// MarshalJSON marshals to json, with @type set even if OptionValueEmpty wasn't created by NewOptionValueEmpty
+func (optionValueEmpty OptionValueEmpty) MarshalJSON() ([]byte, error) {
+	type alias OptionValueEmpty
+	optionValueEmpty.tdCommon.Type = "optionValueEmpty"
+	return json.Marshal(alias(optionValueEmpty))
+}
+
 // NewOptionValueEmpty creates a new OptionValueEmpty
 //
 func NewOptionValueEmpty() *OptionValueEmpty {
@@ -24600,6 +29112,13 @@ func (optionValueInteger *OptionValueInteger) MessageType() string {
 	return "optionValueInteger"
 }
 
+// MarshalJSON marshals to json, with @type set even if OptionValueInteger wasn't created by NewOptionValueInteger
+func (optionValueInteger OptionValueInteger) MarshalJSON() ([]byte, error) {
+	type alias OptionValueInteger
+	optionValueInteger.tdCommon.Type = "optionValueInteger"
+	return json.Marshal(alias(optionValueInteger))
+}
+
 // NewOptionValueInteger creates a new OptionValueInteger
 //
 // @param value The value of the option
@@ -24628,6 +29147,13 @@ func (optionValueString *OptionValueString) MessageType() string {
 	return "optionValueString"
 }
 
+// MarshalJSON marshals to json, with @type set even if OptionValueString wasn't created by NewOptionValueString
+func (optionValueString OptionValueString) MarshalJSON() ([]byte, error) {
+	type alias OptionValueString
+	optionValueString.tdCommon.Type = "optionValueString"
+	return json.Marshal(alias(optionValueString))
+}
+
 // NewOptionValueString creates a new OptionValueString
 //
 // @param value The value of the option
@@ -24657,6 +29183,13 @@ func (jsonObjectMember *JsonObjectMember) MessageType() string {
 	return "jsonObjectMember"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonObjectMember wasn't created by NewJsonObjectMember
+func (jsonObjectMember JsonObjectMember) MarshalJSON() ([]byte, error) {
+	type alias JsonObjectMember
+	jsonObjectMember.tdCommon.Type = "jsonObjectMember"
+	return json.Marshal(alias(jsonObjectMember))
+}
+
 // NewJsonObjectMember creates a new JsonObjectMember
 //
 // @param key Member's key
@@ -24707,6 +29240,13 @@ func (jsonValueNull *JsonValueNull) MessageType() string {
 	return "jsonValueNull"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonValueNull wasn't created by NewJsonValueNull
+func (jsonValueNull JsonValueNull) MarshalJSON() ([]byte, error) {
+	type alias JsonValueNull
+	jsonValueNull.tdCommon.Type = "jsonValueNull"
+	return json.Marshal(alias(jsonValueNull))
+}
+
 // NewJsonValueNull creates a new JsonValueNull
 //
 func NewJsonValueNull() *JsonValueNull {
@@ -24733,6 +29273,13 @@ func (jsonValueBoolean *JsonValueBoolean) MessageType() string {
 	return "jsonValueBoolean"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonValueBoolean wasn't created by NewJsonValueBoolean
+func (jsonValueBoolean JsonValueBoolean) MarshalJSON() ([]byte, error) {
+	type alias JsonValueBoolean
+	jsonValueBoolean.tdCommon.Type = "jsonValueBoolean"
+	return json.Marshal(alias(jsonValueBoolean))
+}
+
 // NewJsonValueBoolean creates a new JsonValueBoolean
 //
 // @param value The value
@@ -24761,6 +29308,13 @@ func (jsonValueNumber *JsonValueNumber) MessageType() string {
 	return "jsonValueNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonValueNumber wasn't created by NewJsonValueNumber
+func (jsonValueNumber JsonValueNumber) MarshalJSON() ([]byte, error) {
+	type alias JsonValueNumber
+	jsonValueNumber.tdCommon.Type = "jsonValueNumber"
+	return json.Marshal(alias(jsonValueNumber))
+}
+
 // NewJsonValueNumber creates a new JsonValueNumber
 //
 // @param value The value
@@ -24789,6 +29343,13 @@ func (jsonValueString *JsonValueString) MessageType() string {
 	return "jsonValueString"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonValueString wasn't created by NewJsonValueString
+func (jsonValueString JsonValueString) MarshalJSON() ([]byte, error) {
+	type alias JsonValueString
+	jsonValueString.tdCommon.Type = "jsonValueString"
+	return json.Marshal(alias(jsonValueString))
+}
+
 // NewJsonValueString creates a new JsonValueString
 //
 // @param value The value
@@ -24817,6 +29378,13 @@ func (jsonValueArray *JsonValueArray) MessageType() string {
 	return "jsonValueArray"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonValueArray wasn't created by NewJsonValueArray
+func (jsonValueArray JsonValueArray) MarshalJSON() ([]byte, error) {
+	type alias JsonValueArray
+	jsonValueArray.tdCommon.Type = "jsonValueArray"
+	return json.Marshal(alias(jsonValueArray))
+}
+
 // NewJsonValueArray creates a new JsonValueArray
 //
 // @param values The list of array elements
@@ -24845,6 +29413,13 @@ func (jsonValueObject *JsonValueObject) MessageType() string {
 	return "jsonValueObject"
 }
 
+// MarshalJSON marshals to json, with @type set even if JsonValueObject wasn't created by NewJsonValueObject
+func (jsonValueObject JsonValueObject) MarshalJSON() ([]byte, error) {
+	type alias JsonValueObject
+	jsonValueObject.tdCommon.Type = "jsonValueObject"
+	return json.Marshal(alias(jsonValueObject))
+}
+
 // NewJsonValueObject creates a new JsonValueObject
 //
 // @param members The list of object members
@@ -24872,6 +29447,13 @@ func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) MessageTyp
 	return "userPrivacySettingRuleAllowAll"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleAllowAll wasn't created by NewUserPrivacySettingRuleAllowAll
+func (userPrivacySettingRuleAllowAll UserPrivacySettingRuleAllowAll) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleAllowAll
+	userPrivacySettingRuleAllowAll.tdCommon.Type = "userPrivacySettingRuleAllowAll"
+	return json.Marshal(alias(userPrivacySettingRuleAllowAll))
+}
+
 // NewUserPrivacySettingRuleAllowAll creates a new UserPrivacySettingRuleAllowAll
 //
 func NewUserPrivacySettingRuleAllowAll() *UserPrivacySettingRuleAllowAll {
@@ -24897,6 +29479,13 @@ func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts)
 	return "userPrivacySettingRuleAllowContacts"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleAllowContacts wasn't created by NewUserPrivacySettingRuleAllowContacts
+func (userPrivacySettingRuleAllowContacts UserPrivacySettingRuleAllowContacts) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleAllowContacts
+	userPrivacySettingRuleAllowContacts.tdCommon.Type = "userPrivacySettingRuleAllowContacts"
+	return json.Marshal(alias(userPrivacySettingRuleAllowContacts))
+}
+
 // NewUserPrivacySettingRuleAllowContacts creates a new UserPrivacySettingRuleAllowContacts
 //
 func NewUserPrivacySettingRuleAllowContacts() *UserPrivacySettingRuleAllowContacts {
@@ -24923,6 +29512,13 @@ func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) Messag
 	return "userPrivacySettingRuleAllowUsers"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleAllowUsers wasn't created by NewUserPrivacySettingRuleAllowUsers
+func (userPrivacySettingRuleAllowUsers UserPrivacySettingRuleAllowUsers) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleAllowUsers
+	userPrivacySettingRuleAllowUsers.tdCommon.Type = "userPrivacySettingRuleAllowUsers"
+	return json.Marshal(alias(userPrivacySettingRuleAllowUsers))
+}
+
 // NewUserPrivacySettingRuleAllowUsers creates a new UserPrivacySettingRuleAllowUsers
 //
 // @param userIDs The user identifiers, total number of users in all rules must not exceed 1000
@@ -24943,7 +29539,7 @@ func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) GetUse
 // UserPrivacySettingRuleAllowChatMembers A rule to allow all members of certain specified basic groups and supergroups to doing something
 type UserPrivacySettingRuleAllowChatMembers struct {
 	tdCommon
-	ChatIDs []int64 `json:"chat_ids"` // The chat identifiers, total number of chats in all rules must not exceed 20
+	ChatIDs []JSONInt64 `json:"chat_ids"` // The chat identifiers, total number of chats in all rules must not exceed 20
 }
 
 // MessageType return the string telegram-type of UserPrivacySettingRuleAllowChatMembers
@@ -24951,10 +29547,17 @@ func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMem
 	return "userPrivacySettingRuleAllowChatMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleAllowChatMembers wasn't created by NewUserPrivacySettingRuleAllowChatMembers
+func (userPrivacySettingRuleAllowChatMembers UserPrivacySettingRuleAllowChatMembers) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleAllowChatMembers
+	userPrivacySettingRuleAllowChatMembers.tdCommon.Type = "userPrivacySettingRuleAllowChatMembers"
+	return json.Marshal(alias(userPrivacySettingRuleAllowChatMembers))
+}
+
 // NewUserPrivacySettingRuleAllowChatMembers creates a new UserPrivacySettingRuleAllowChatMembers
 //
 // @param chatIDs The chat identifiers, total number of chats in all rules must not exceed 20
-func NewUserPrivacySettingRuleAllowChatMembers(chatIDs []int64) *UserPrivacySettingRuleAllowChatMembers {
+func NewUserPrivacySettingRuleAllowChatMembers(chatIDs []JSONInt64) *UserPrivacySettingRuleAllowChatMembers {
 	userPrivacySettingRuleAllowChatMembersTemp := UserPrivacySettingRuleAllowChatMembers{
 		tdCommon: tdCommon{Type: "userPrivacySettingRuleAllowChatMembers"},
 		ChatIDs:  chatIDs,
@@ -24978,6 +29581,13 @@ func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) Mess
 	return "userPrivacySettingRuleRestrictAll"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleRestrictAll wasn't created by NewUserPrivacySettingRuleRestrictAll
+func (userPrivacySettingRuleRestrictAll UserPrivacySettingRuleRestrictAll) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleRestrictAll
+	userPrivacySettingRuleRestrictAll.tdCommon.Type = "userPrivacySettingRuleRestrictAll"
+	return json.Marshal(alias(userPrivacySettingRuleRestrictAll))
+}
+
 // NewUserPrivacySettingRuleRestrictAll creates a new UserPrivacySettingRuleRestrictAll
 //
 func NewUserPrivacySettingRuleRestrictAll() *UserPrivacySettingRuleRestrictAll {
@@ -25003,6 +29613,13 @@ func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictCont
 	return "userPrivacySettingRuleRestrictContacts"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleRestrictContacts wasn't created by NewUserPrivacySettingRuleRestrictContacts
+func (userPrivacySettingRuleRestrictContacts UserPrivacySettingRuleRestrictContacts) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleRestrictContacts
+	userPrivacySettingRuleRestrictContacts.tdCommon.Type = "userPrivacySettingRuleRestrictContacts"
+	return json.Marshal(alias(userPrivacySettingRuleRestrictContacts))
+}
+
 // NewUserPrivacySettingRuleRestrictContacts creates a new UserPrivacySettingRuleRestrictContacts
 //
 func NewUserPrivacySettingRuleRestrictContacts() *UserPrivacySettingRuleRestrictContacts {
@@ -25029,6 +29646,13 @@ func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers)
 	return "userPrivacySettingRuleRestrictUsers"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleRestrictUsers wasn't created by NewUserPrivacySettingRuleRestrictUsers
+func (userPrivacySettingRuleRestrictUsers UserPrivacySettingRuleRestrictUsers) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleRestrictUsers
+	userPrivacySettingRuleRestrictUsers.tdCommon.Type = "userPrivacySettingRuleRestrictUsers"
+	return json.Marshal(alias(userPrivacySettingRuleRestrictUsers))
+}
+
 // NewUserPrivacySettingRuleRestrictUsers creates a new UserPrivacySettingRuleRestrictUsers
 //
 // @param userIDs The user identifiers, total number of users in all rules must not exceed 1000
@@ -25049,7 +29673,7 @@ func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers)
 // UserPrivacySettingRuleRestrictChatMembers A rule to restrict all members of specified basic groups and supergroups from doing something
 type UserPrivacySettingRuleRestrictChatMembers struct {
 	tdCommon
-	ChatIDs []int64 `json:"chat_ids"` // The chat identifiers, total number of chats in all rules must not exceed 20
+	ChatIDs []JSONInt64 `json:"chat_ids"` // The chat identifiers, total number of chats in all rules must not exceed 20
 }
 
 // MessageType return the string telegram-type of UserPrivacySettingRuleRestrictChatMembers
@@ -25057,10 +29681,17 @@ func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictC
 	return "userPrivacySettingRuleRestrictChatMembers"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRuleRestrictChatMembers wasn't created by NewUserPrivacySettingRuleRestrictChatMembers
+func (userPrivacySettingRuleRestrictChatMembers UserPrivacySettingRuleRestrictChatMembers) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRuleRestrictChatMembers
+	userPrivacySettingRuleRestrictChatMembers.tdCommon.Type = "userPrivacySettingRuleRestrictChatMembers"
+	return json.Marshal(alias(userPrivacySettingRuleRestrictChatMembers))
+}
+
 // NewUserPrivacySettingRuleRestrictChatMembers creates a new UserPrivacySettingRuleRestrictChatMembers
 //
 // @param chatIDs The chat identifiers, total number of chats in all rules must not exceed 20
-func NewUserPrivacySettingRuleRestrictChatMembers(chatIDs []int64) *UserPrivacySettingRuleRestrictChatMembers {
+func NewUserPrivacySettingRuleRestrictChatMembers(chatIDs []JSONInt64) *UserPrivacySettingRuleRestrictChatMembers {
 	userPrivacySettingRuleRestrictChatMembersTemp := UserPrivacySettingRuleRestrictChatMembers{
 		tdCommon: tdCommon{Type: "userPrivacySettingRuleRestrictChatMembers"},
 		ChatIDs:  chatIDs,
@@ -25085,6 +29716,13 @@ func (userPrivacySettingRules *UserPrivacySettingRules) MessageType() string {
 	return "userPrivacySettingRules"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingRules wasn't created by NewUserPrivacySettingRules
+func (userPrivacySettingRules UserPrivacySettingRules) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingRules
+	userPrivacySettingRules.tdCommon.Type = "userPrivacySettingRules"
+	return json.Marshal(alias(userPrivacySettingRules))
+}
+
 // NewUserPrivacySettingRules creates a new UserPrivacySettingRules
 //
 // @param rules A list of rules
@@ -25107,6 +29745,13 @@ func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) MessageType()
 	return "userPrivacySettingShowStatus"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingShowStatus wasn't created by NewUserPrivacySettingShowStatus
+func (userPrivacySettingShowStatus UserPrivacySettingShowStatus) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingShowStatus
+	userPrivacySettingShowStatus.tdCommon.Type = "userPrivacySettingShowStatus"
+	return json.Marshal(alias(userPrivacySettingShowStatus))
+}
+
 // NewUserPrivacySettingShowStatus creates a new UserPrivacySettingShowStatus
 //
 func NewUserPrivacySettingShowStatus() *UserPrivacySettingShowStatus {
@@ -25132,6 +29777,13 @@ func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) Me
 	return "userPrivacySettingShowProfilePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingShowProfilePhoto wasn't created by NewUserPrivacySettingShowProfilePhoto
+func (userPrivacySettingShowProfilePhoto UserPrivacySettingShowProfilePhoto) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingShowProfilePhoto
+	userPrivacySettingShowProfilePhoto.tdCommon.Type = "userPrivacySettingShowProfilePhoto"
+	return json.Marshal(alias(userPrivacySettingShowProfilePhoto))
+}
+
 // NewUserPrivacySettingShowProfilePhoto creates a new UserPrivacySettingShowProfilePhoto
 //
 func NewUserPrivacySettingShowProfilePhoto() *UserPrivacySettingShowProfilePhoto {
@@ -25157,6 +29809,13 @@ func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkI
 	return "userPrivacySettingShowLinkInForwardedMessages"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingShowLinkInForwardedMessages wasn't created by NewUserPrivacySettingShowLinkInForwardedMessages
+func (userPrivacySettingShowLinkInForwardedMessages UserPrivacySettingShowLinkInForwardedMessages) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingShowLinkInForwardedMessages
+	userPrivacySettingShowLinkInForwardedMessages.tdCommon.Type = "userPrivacySettingShowLinkInForwardedMessages"
+	return json.Marshal(alias(userPrivacySettingShowLinkInForwardedMessages))
+}
+
 // NewUserPrivacySettingShowLinkInForwardedMessages creates a new UserPrivacySettingShowLinkInForwardedMessages
 //
 func NewUserPrivacySettingShowLinkInForwardedMessages() *UserPrivacySettingShowLinkInForwardedMessages {
@@ -25182,6 +29841,13 @@ func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) Mess
 	return "userPrivacySettingShowPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingShowPhoneNumber wasn't created by NewUserPrivacySettingShowPhoneNumber
+func (userPrivacySettingShowPhoneNumber UserPrivacySettingShowPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingShowPhoneNumber
+	userPrivacySettingShowPhoneNumber.tdCommon.Type = "userPrivacySettingShowPhoneNumber"
+	return json.Marshal(alias(userPrivacySettingShowPhoneNumber))
+}
+
 // NewUserPrivacySettingShowPhoneNumber creates a new UserPrivacySettingShowPhoneNumber
 //
 func NewUserPrivacySettingShowPhoneNumber() *UserPrivacySettingShowPhoneNumber {
@@ -25207,6 +29873,13 @@ func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) Me
 	return "userPrivacySettingAllowChatInvites"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingAllowChatInvites wasn't created by NewUserPrivacySettingAllowChatInvites
+func (userPrivacySettingAllowChatInvites UserPrivacySettingAllowChatInvites) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingAllowChatInvites
+	userPrivacySettingAllowChatInvites.tdCommon.Type = "userPrivacySettingAllowChatInvites"
+	return json.Marshal(alias(userPrivacySettingAllowChatInvites))
+}
+
 // NewUserPrivacySettingAllowChatInvites creates a new UserPrivacySettingAllowChatInvites
 //
 func NewUserPrivacySettingAllowChatInvites() *UserPrivacySettingAllowChatInvites {
@@ -25232,6 +29905,13 @@ func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) MessageType()
 	return "userPrivacySettingAllowCalls"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingAllowCalls wasn't created by NewUserPrivacySettingAllowCalls
+func (userPrivacySettingAllowCalls UserPrivacySettingAllowCalls) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingAllowCalls
+	userPrivacySettingAllowCalls.tdCommon.Type = "userPrivacySettingAllowCalls"
+	return json.Marshal(alias(userPrivacySettingAllowCalls))
+}
+
 // NewUserPrivacySettingAllowCalls creates a new UserPrivacySettingAllowCalls
 //
 func NewUserPrivacySettingAllowCalls() *UserPrivacySettingAllowCalls {
@@ -25257,6 +29937,13 @@ func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerC
 	return "userPrivacySettingAllowPeerToPeerCalls"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingAllowPeerToPeerCalls wasn't created by NewUserPrivacySettingAllowPeerToPeerCalls
+func (userPrivacySettingAllowPeerToPeerCalls UserPrivacySettingAllowPeerToPeerCalls) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingAllowPeerToPeerCalls
+	userPrivacySettingAllowPeerToPeerCalls.tdCommon.Type = "userPrivacySettingAllowPeerToPeerCalls"
+	return json.Marshal(alias(userPrivacySettingAllowPeerToPeerCalls))
+}
+
 // NewUserPrivacySettingAllowPeerToPeerCalls creates a new UserPrivacySettingAllowPeerToPeerCalls
 //
 func NewUserPrivacySettingAllowPeerToPeerCalls() *UserPrivacySettingAllowPeerToPeerCalls {
@@ -25282,6 +29969,13 @@ func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindin
 	return "userPrivacySettingAllowFindingByPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if UserPrivacySettingAllowFindingByPhoneNumber wasn't created by NewUserPrivacySettingAllowFindingByPhoneNumber
+func (userPrivacySettingAllowFindingByPhoneNumber UserPrivacySettingAllowFindingByPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias UserPrivacySettingAllowFindingByPhoneNumber
+	userPrivacySettingAllowFindingByPhoneNumber.tdCommon.Type = "userPrivacySettingAllowFindingByPhoneNumber"
+	return json.Marshal(alias(userPrivacySettingAllowFindingByPhoneNumber))
+}
+
 // NewUserPrivacySettingAllowFindingByPhoneNumber creates a new UserPrivacySettingAllowFindingByPhoneNumber
 //
 func NewUserPrivacySettingAllowFindingByPhoneNumber() *UserPrivacySettingAllowFindingByPhoneNumber {
@@ -25308,6 +30002,13 @@ func (accountTTL *AccountTTL) MessageType() string {
 	return "accountTtl"
 }
 
+// MarshalJSON marshals to json, with @type set even if AccountTTL wasn't created by NewAccountTTL
+func (accountTTL AccountTTL) MarshalJSON() ([]byte, error) {
+	type alias AccountTTL
+	accountTTL.tdCommon.Type = "accountTtl"
+	return json.Marshal(alias(accountTTL))
+}
+
 // NewAccountTTL creates a new AccountTTL
 //
 // @param days Number of days of inactivity before the account will be flagged for deletion; should range from 30-366 days
@@ -25345,6 +30046,13 @@ func (session *Session) MessageType() string {
 	return "session"
 }
 
+// MarshalJSON marshals to json, with @type set even if Session wasn't created by NewSession
+func (session Session) MarshalJSON() ([]byte, error) {
+	type alias Session
+	session.tdCommon.Type = "session"
+	return json.Marshal(alias(session))
+}
+
 // NewSession creates a new Session
 //
 // @param iD Session identifier
@@ -25396,6 +30104,13 @@ func (sessions *Sessions) MessageType() string {
 	return "sessions"
 }
 
+// MarshalJSON marshals to json, with @type set even if Sessions wasn't created by NewSessions
+func (sessions Sessions) MarshalJSON() ([]byte, error) {
+	type alias Sessions
+	sessions.tdCommon.Type = "sessions"
+	return json.Marshal(alias(sessions))
+}
+
 // NewSessions creates a new Sessions
 //
 // @param sessions List of sessions
@@ -25427,6 +30142,13 @@ func (connectedWebsite *ConnectedWebsite) MessageType() string {
 	return "connectedWebsite"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectedWebsite wasn't created by NewConnectedWebsite
+func (connectedWebsite ConnectedWebsite) MarshalJSON() ([]byte, error) {
+	type alias ConnectedWebsite
+	connectedWebsite.tdCommon.Type = "connectedWebsite"
+	return json.Marshal(alias(connectedWebsite))
+}
+
 // NewConnectedWebsite creates a new ConnectedWebsite
 //
 // @param iD Website identifier
@@ -25466,6 +30188,13 @@ func (connectedWebsites *ConnectedWebsites) MessageType() string {
 	return "connectedWebsites"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectedWebsites wasn't created by NewConnectedWebsites
+func (connectedWebsites ConnectedWebsites) MarshalJSON() ([]byte, error) {
+	type alias ConnectedWebsites
+	connectedWebsites.tdCommon.Type = "connectedWebsites"
+	return json.Marshal(alias(connectedWebsites))
+}
+
 // NewConnectedWebsites creates a new ConnectedWebsites
 //
 // @param websites List of connected websites
@@ -25488,6 +30217,13 @@ func (chatReportReasonSpam *ChatReportReasonSpam) MessageType() string {
 	return "chatReportReasonSpam"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonSpam wasn't created by NewChatReportReasonSpam
+func (chatReportReasonSpam ChatReportReasonSpam) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonSpam
+	chatReportReasonSpam.tdCommon.Type = "chatReportReasonSpam"
+	return json.Marshal(alias(chatReportReasonSpam))
+}
+
 // NewChatReportReasonSpam creates a new ChatReportReasonSpam
 //
 func NewChatReportReasonSpam() *ChatReportReasonSpam {
@@ -25513,6 +30249,13 @@ func (chatReportReasonViolence *ChatReportReasonViolence) MessageType() string {
 	return "chatReportReasonViolence"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonViolence wasn't created by NewChatReportReasonViolence
+func (chatReportReasonViolence ChatReportReasonViolence) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonViolence
+	chatReportReasonViolence.tdCommon.Type = "chatReportReasonViolence"
+	return json.Marshal(alias(chatReportReasonViolence))
+}
+
 // NewChatReportReasonViolence creates a new ChatReportReasonViolence
 //
 func NewChatReportReasonViolence() *ChatReportReasonViolence {
@@ -25538,6 +30281,13 @@ func (chatReportReasonPornography *ChatReportReasonPornography) MessageType() st
 	return "chatReportReasonPornography"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonPornography wasn't created by NewChatReportReasonPornography
+func (chatReportReasonPornography ChatReportReasonPornography) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonPornography
+	chatReportReasonPornography.tdCommon.Type = "chatReportReasonPornography"
+	return json.Marshal(alias(chatReportReasonPornography))
+}
+
 // NewChatReportReasonPornography creates a new ChatReportReasonPornography
 //
 func NewChatReportReasonPornography() *ChatReportReasonPornography {
@@ -25563,6 +30313,13 @@ func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) MessageType() stri
 	return "chatReportReasonChildAbuse"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonChildAbuse wasn't created by NewChatReportReasonChildAbuse
+func (chatReportReasonChildAbuse ChatReportReasonChildAbuse) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonChildAbuse
+	chatReportReasonChildAbuse.tdCommon.Type = "chatReportReasonChildAbuse"
+	return json.Marshal(alias(chatReportReasonChildAbuse))
+}
+
 // NewChatReportReasonChildAbuse creates a new ChatReportReasonChildAbuse
 //
 func NewChatReportReasonChildAbuse() *ChatReportReasonChildAbuse {
@@ -25588,6 +30345,13 @@ func (chatReportReasonCopyright *ChatReportReasonCopyright) MessageType() string
 	return "chatReportReasonCopyright"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonCopyright wasn't created by NewChatReportReasonCopyright
+func (chatReportReasonCopyright ChatReportReasonCopyright) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonCopyright
+	chatReportReasonCopyright.tdCommon.Type = "chatReportReasonCopyright"
+	return json.Marshal(alias(chatReportReasonCopyright))
+}
+
 // NewChatReportReasonCopyright creates a new ChatReportReasonCopyright
 //
 func NewChatReportReasonCopyright() *ChatReportReasonCopyright {
@@ -25613,6 +30377,13 @@ func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) Mess
 	return "chatReportReasonUnrelatedLocation"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonUnrelatedLocation wasn't created by NewChatReportReasonUnrelatedLocation
+func (chatReportReasonUnrelatedLocation ChatReportReasonUnrelatedLocation) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonUnrelatedLocation
+	chatReportReasonUnrelatedLocation.tdCommon.Type = "chatReportReasonUnrelatedLocation"
+	return json.Marshal(alias(chatReportReasonUnrelatedLocation))
+}
+
 // NewChatReportReasonUnrelatedLocation creates a new ChatReportReasonUnrelatedLocation
 //
 func NewChatReportReasonUnrelatedLocation() *ChatReportReasonUnrelatedLocation {
@@ -25638,6 +30409,13 @@ func (chatReportReasonFake *ChatReportReasonFake) MessageType() string {
 	return "chatReportReasonFake"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonFake wasn't created by NewChatReportReasonFake
+func (chatReportReasonFake ChatReportReasonFake) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonFake
+	chatReportReasonFake.tdCommon.Type = "chatReportReasonFake"
+	return json.Marshal(alias(chatReportReasonFake))
+}
+
 // NewChatReportReasonFake creates a new ChatReportReasonFake
 //
 func NewChatReportReasonFake() *ChatReportReasonFake {
@@ -25664,6 +30442,13 @@ func (chatReportReasonCustom *ChatReportReasonCustom) MessageType() string {
 	return "chatReportReasonCustom"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatReportReasonCustom wasn't created by NewChatReportReasonCustom
+func (chatReportReasonCustom ChatReportReasonCustom) MarshalJSON() ([]byte, error) {
+	type alias ChatReportReasonCustom
+	chatReportReasonCustom.tdCommon.Type = "chatReportReasonCustom"
+	return json.Marshal(alias(chatReportReasonCustom))
+}
+
 // NewChatReportReasonCustom creates a new ChatReportReasonCustom
 //
 // @param text Report text
@@ -25693,6 +30478,13 @@ func (messageLink *MessageLink) MessageType() string {
 	return "messageLink"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageLink wasn't created by NewMessageLink
+func (messageLink MessageLink) MarshalJSON() ([]byte, error) {
+	type alias MessageLink
+	messageLink.tdCommon.Type = "messageLink"
+	return json.Marshal(alias(messageLink))
+}
+
 // NewMessageLink creates a new MessageLink
 //
 // @param link Message link
@@ -25710,11 +30502,11 @@ func NewMessageLink(link string, isPublic bool) *MessageLink {
 // MessageLinkInfo Contains information about a link to a message in a chat
 type MessageLinkInfo struct {
 	tdCommon
-	IsPublic   bool     `json:"is_public"`   // True, if the link is a public link for a message in a chat
-	ChatID     int64    `json:"chat_id"`     // If found, identifier of the chat to which the message belongs, 0 otherwise
-	Message    *Message `json:"message"`     // If found, the linked message; may be null
-	ForAlbum   bool     `json:"for_album"`   // True, if the whole media album to which the message belongs is linked
-	ForComment bool     `json:"for_comment"` // True, if the message is linked as a channel post comment or from a message thread
+	IsPublic   bool      `json:"is_public"`   // True, if the link is a public link for a message in a chat
+	ChatID     JSONInt64 `json:"chat_id"`     // If found, identifier of the chat to which the message belongs, 0 otherwise
+	Message    *Message  `json:"message"`     // If found, the linked message; may be null
+	ForAlbum   bool      `json:"for_album"`   // True, if the whole media album to which the message belongs is linked
+	ForComment bool      `json:"for_comment"` // True, if the message is linked as a channel post comment or from a message thread
 }
 
 // MessageType return the string telegram-type of MessageLinkInfo
@@ -25722,6 +30514,13 @@ func (messageLinkInfo *MessageLinkInfo) MessageType() string {
 	return "messageLinkInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageLinkInfo wasn't created by NewMessageLinkInfo
+func (messageLinkInfo MessageLinkInfo) MarshalJSON() ([]byte, error) {
+	type alias MessageLinkInfo
+	messageLinkInfo.tdCommon.Type = "messageLinkInfo"
+	return json.Marshal(alias(messageLinkInfo))
+}
+
 // NewMessageLinkInfo creates a new MessageLinkInfo
 //
 // @param isPublic True, if the link is a public link for a message in a chat
@@ -25729,7 +30528,7 @@ func (messageLinkInfo *MessageLinkInfo) MessageType() string {
 // @param message If found, the linked message; may be null
 // @param forAlbum True, if the whole media album to which the message belongs is linked
 // @param forComment True, if the message is linked as a channel post comment or from a message thread
-func NewMessageLinkInfo(isPublic bool, chatID int64, message *Message, forAlbum bool, forComment bool) *MessageLinkInfo {
+func NewMessageLinkInfo(isPublic bool, chatID JSONInt64, message *Message, forAlbum bool, forComment bool) *MessageLinkInfo {
 	messageLinkInfoTemp := MessageLinkInfo{
 		tdCommon:   tdCommon{Type: "messageLinkInfo"},
 		IsPublic:   isPublic,
@@ -25753,6 +30552,13 @@ func (filePart *FilePart) MessageType() string {
 	return "filePart"
 }
 
+// MarshalJSON marshals to json, with @type set even if FilePart wasn't created by NewFilePart
+func (filePart FilePart) MarshalJSON() ([]byte, error) {
+	type alias FilePart
+	filePart.tdCommon.Type = "filePart"
+	return json.Marshal(alias(filePart))
+}
+
 // NewFilePart creates a new FilePart
 //
 // @param data File bytes
@@ -25775,6 +30581,13 @@ func (fileTypeNone *FileTypeNone) MessageType() string {
 	return "fileTypeNone"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeNone wasn't created by NewFileTypeNone
+func (fileTypeNone FileTypeNone) MarshalJSON() ([]byte, error) {
+	type alias FileTypeNone
+	fileTypeNone.tdCommon.Type = "fileTypeNone"
+	return json.Marshal(alias(fileTypeNone))
+}
+
 // NewFileTypeNone creates a new FileTypeNone
 //
 func NewFileTypeNone() *FileTypeNone {
@@ -25800,6 +30613,13 @@ func (fileTypeAnimation *FileTypeAnimation) MessageType() string {
 	return "fileTypeAnimation"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeAnimation wasn't created by NewFileTypeAnimation
+func (fileTypeAnimation FileTypeAnimation) MarshalJSON() ([]byte, error) {
+	type alias FileTypeAnimation
+	fileTypeAnimation.tdCommon.Type = "fileTypeAnimation"
+	return json.Marshal(alias(fileTypeAnimation))
+}
+
 // NewFileTypeAnimation creates a new FileTypeAnimation
 //
 func NewFileTypeAnimation() *FileTypeAnimation {
@@ -25825,6 +30645,13 @@ func (fileTypeAudio *FileTypeAudio) MessageType() string {
 	return "fileTypeAudio"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeAudio wasn't created by NewFileTypeAudio
+func (fileTypeAudio FileTypeAudio) MarshalJSON() ([]byte, error) {
+	type alias FileTypeAudio
+	fileTypeAudio.tdCommon.Type = "fileTypeAudio"
+	return json.Marshal(alias(fileTypeAudio))
+}
+
 // NewFileTypeAudio creates a new FileTypeAudio
 //
 func NewFileTypeAudio() *FileTypeAudio {
@@ -25850,6 +30677,13 @@ func (fileTypeDocument *FileTypeDocument) MessageType() string {
 	return "fileTypeDocument"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeDocument wasn't created by NewFileTypeDocument
+func (fileTypeDocument FileTypeDocument) MarshalJSON() ([]byte, error) {
+	type alias FileTypeDocument
+	fileTypeDocument.tdCommon.Type = "fileTypeDocument"
+	return json.Marshal(alias(fileTypeDocument))
+}
+
 // NewFileTypeDocument creates a new FileTypeDocument
 //
 func NewFileTypeDocument() *FileTypeDocument {
@@ -25875,6 +30709,13 @@ func (fileTypePhoto *FileTypePhoto) MessageType() string {
 	return "fileTypePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypePhoto wasn't created by NewFileTypePhoto
+func (fileTypePhoto FileTypePhoto) MarshalJSON() ([]byte, error) {
+	type alias FileTypePhoto
+	fileTypePhoto.tdCommon.Type = "fileTypePhoto"
+	return json.Marshal(alias(fileTypePhoto))
+}
+
 // NewFileTypePhoto creates a new FileTypePhoto
 //
 func NewFileTypePhoto() *FileTypePhoto {
@@ -25900,6 +30741,13 @@ func (fileTypeProfilePhoto *FileTypeProfilePhoto) MessageType() string {
 	return "fileTypeProfilePhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeProfilePhoto wasn't created by NewFileTypeProfilePhoto
+func (fileTypeProfilePhoto FileTypeProfilePhoto) MarshalJSON() ([]byte, error) {
+	type alias FileTypeProfilePhoto
+	fileTypeProfilePhoto.tdCommon.Type = "fileTypeProfilePhoto"
+	return json.Marshal(alias(fileTypeProfilePhoto))
+}
+
 // NewFileTypeProfilePhoto creates a new FileTypeProfilePhoto
 //
 func NewFileTypeProfilePhoto() *FileTypeProfilePhoto {
@@ -25925,6 +30773,13 @@ func (fileTypeSecret *FileTypeSecret) MessageType() string {
 	return "fileTypeSecret"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeSecret wasn't created by NewFileTypeSecret
+func (fileTypeSecret FileTypeSecret) MarshalJSON() ([]byte, error) {
+	type alias FileTypeSecret
+	fileTypeSecret.tdCommon.Type = "fileTypeSecret"
+	return json.Marshal(alias(fileTypeSecret))
+}
+
 // NewFileTypeSecret creates a new FileTypeSecret
 //
 func NewFileTypeSecret() *FileTypeSecret {
@@ -25950,6 +30805,13 @@ func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) MessageType() string {
 	return "fileTypeSecretThumbnail"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeSecretThumbnail wasn't created by NewFileTypeSecretThumbnail
+func (fileTypeSecretThumbnail FileTypeSecretThumbnail) MarshalJSON() ([]byte, error) {
+	type alias FileTypeSecretThumbnail
+	fileTypeSecretThumbnail.tdCommon.Type = "fileTypeSecretThumbnail"
+	return json.Marshal(alias(fileTypeSecretThumbnail))
+}
+
 // NewFileTypeSecretThumbnail creates a new FileTypeSecretThumbnail
 //
 func NewFileTypeSecretThumbnail() *FileTypeSecretThumbnail {
@@ -25975,6 +30837,13 @@ func (fileTypeSecure *FileTypeSecure) MessageType() string {
 	return "fileTypeSecure"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeSecure wasn't created by NewFileTypeSecure
+func (fileTypeSecure FileTypeSecure) MarshalJSON() ([]byte, error) {
+	type alias FileTypeSecure
+	fileTypeSecure.tdCommon.Type = "fileTypeSecure"
+	return json.Marshal(alias(fileTypeSecure))
+}
+
 // NewFileTypeSecure creates a new FileTypeSecure
 //
 func NewFileTypeSecure() *FileTypeSecure {
@@ -26000,6 +30869,13 @@ func (fileTypeSticker *FileTypeSticker) MessageType() string {
 	return "fileTypeSticker"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeSticker wasn't created by NewFileTypeSticker
+func (fileTypeSticker FileTypeSticker) MarshalJSON() ([]byte, error) {
+	type alias FileTypeSticker
+	fileTypeSticker.tdCommon.Type = "fileTypeSticker"
+	return json.Marshal(alias(fileTypeSticker))
+}
+
 // NewFileTypeSticker creates a new FileTypeSticker
 //
 func NewFileTypeSticker() *FileTypeSticker {
@@ -26025,6 +30901,13 @@ func (fileTypeThumbnail *FileTypeThumbnail) MessageType() string {
 	return "fileTypeThumbnail"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeThumbnail wasn't created by NewFileTypeThumbnail
+func (fileTypeThumbnail FileTypeThumbnail) MarshalJSON() ([]byte, error) {
+	type alias FileTypeThumbnail
+	fileTypeThumbnail.tdCommon.Type = "fileTypeThumbnail"
+	return json.Marshal(alias(fileTypeThumbnail))
+}
+
 // NewFileTypeThumbnail creates a new FileTypeThumbnail
 //
 func NewFileTypeThumbnail() *FileTypeThumbnail {
@@ -26050,6 +30933,13 @@ func (fileTypeUnknown *FileTypeUnknown) MessageType() string {
 	return "fileTypeUnknown"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeUnknown wasn't created by NewFileTypeUnknown
+func (fileTypeUnknown FileTypeUnknown) MarshalJSON() ([]byte, error) {
+	type alias FileTypeUnknown
+	fileTypeUnknown.tdCommon.Type = "fileTypeUnknown"
+	return json.Marshal(alias(fileTypeUnknown))
+}
+
 // NewFileTypeUnknown creates a new FileTypeUnknown
 //
 func NewFileTypeUnknown() *FileTypeUnknown {
@@ -26075,6 +30965,13 @@ func (fileTypeVideo *FileTypeVideo) MessageType() string {
 	return "fileTypeVideo"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeVideo wasn't created by NewFileTypeVideo
+func (fileTypeVideo FileTypeVideo) MarshalJSON() ([]byte, error) {
+	type alias FileTypeVideo
+	fileTypeVideo.tdCommon.Type = "fileTypeVideo"
+	return json.Marshal(alias(fileTypeVideo))
+}
+
 // NewFileTypeVideo creates a new FileTypeVideo
 //
 func NewFileTypeVideo() *FileTypeVideo {
@@ -26100,6 +30997,13 @@ func (fileTypeVideoNote *FileTypeVideoNote) MessageType() string {
 	return "fileTypeVideoNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeVideoNote wasn't created by NewFileTypeVideoNote
+func (fileTypeVideoNote FileTypeVideoNote) MarshalJSON() ([]byte, error) {
+	type alias FileTypeVideoNote
+	fileTypeVideoNote.tdCommon.Type = "fileTypeVideoNote"
+	return json.Marshal(alias(fileTypeVideoNote))
+}
+
 // NewFileTypeVideoNote creates a new FileTypeVideoNote
 //
 func NewFileTypeVideoNote() *FileTypeVideoNote {
@@ -26125,6 +31029,13 @@ func (fileTypeVoiceNote *FileTypeVoiceNote) MessageType() string {
 	return "fileTypeVoiceNote"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeVoiceNote wasn't created by NewFileTypeVoiceNote
+func (fileTypeVoiceNote FileTypeVoiceNote) MarshalJSON() ([]byte, error) {
+	type alias FileTypeVoiceNote
+	fileTypeVoiceNote.tdCommon.Type = "fileTypeVoiceNote"
+	return json.Marshal(alias(fileTypeVoiceNote))
+}
+
 // NewFileTypeVoiceNote creates a new FileTypeVoiceNote
 //
 func NewFileTypeVoiceNote() *FileTypeVoiceNote {
@@ -26150,6 +31061,13 @@ func (fileTypeWallpaper *FileTypeWallpaper) MessageType() string {
 	return "fileTypeWallpaper"
 }
 
+// MarshalJSON marshals to json, with @type set even if FileTypeWallpaper wasn't created by NewFileTypeWallpaper
+func (fileTypeWallpaper FileTypeWallpaper) MarshalJSON() ([]byte, error) {
+	type alias FileTypeWallpaper
+	fileTypeWallpaper.tdCommon.Type = "fileTypeWallpaper"
+	return json.Marshal(alias(fileTypeWallpaper))
+}
+
 // NewFileTypeWallpaper creates a new FileTypeWallpaper
 //
 func NewFileTypeWallpaper() *FileTypeWallpaper {
@@ -26168,9 +31086,9 @@ func (fileTypeWallpaper *FileTypeWallpaper) GetFileTypeEnum() FileTypeEnum {
 // StorageStatisticsByFileType Contains the storage usage statistics for a specific file type
 type StorageStatisticsByFileType struct {
 	tdCommon
-	FileType FileType `json:"file_type"` // File type
-	Size     int64    `json:"size"`      // Total size of the files
-	Count    int32    `json:"count"`     // Total number of files
+	FileType FileType  `json:"file_type"` // File type
+	Size     JSONInt64 `json:"size"`      // Total size of the files
+	Count    int32     `json:"count"`     // Total number of files
 }
 
 // MessageType return the string telegram-type of StorageStatisticsByFileType
@@ -26178,12 +31096,19 @@ func (storageStatisticsByFileType *StorageStatisticsByFileType) MessageType() st
 	return "storageStatisticsByFileType"
 }
 
+// MarshalJSON marshals to json, with @type set even if StorageStatisticsByFileType wasn't created by NewStorageStatisticsByFileType
+func (storageStatisticsByFileType StorageStatisticsByFileType) MarshalJSON() ([]byte, error) {
+	type alias StorageStatisticsByFileType
+	storageStatisticsByFileType.tdCommon.Type = "storageStatisticsByFileType"
+	return json.Marshal(alias(storageStatisticsByFileType))
+}
+
 // NewStorageStatisticsByFileType creates a new StorageStatisticsByFileType
 //
 // @param fileType File type
 // @param size Total size of the files
 // @param count Total number of files
-func NewStorageStatisticsByFileType(fileType FileType, size int64, count int32) *StorageStatisticsByFileType {
+func NewStorageStatisticsByFileType(fileType FileType, size JSONInt64, count int32) *StorageStatisticsByFileType {
 	storageStatisticsByFileTypeTemp := StorageStatisticsByFileType{
 		tdCommon: tdCommon{Type: "storageStatisticsByFileType"},
 		FileType: fileType,
@@ -26203,8 +31128,8 @@ func (storageStatisticsByFileType *StorageStatisticsByFileType) UnmarshalJSON(b
 	}
 	tempObj := struct {
 		tdCommon
-		Size  int64 `json:"size"`  // Total size of the files
-		Count int32 `json:"count"` // Total number of files
+		Size  JSONInt64 `json:"size"`  // Total size of the files
+		Count int32     `json:"count"` // Total number of files
 	}{}
 	err = json.Unmarshal(b, &tempObj)
 	if err != nil {
@@ -26224,8 +31149,8 @@ func (storageStatisticsByFileType *StorageStatisticsByFileType) UnmarshalJSON(b
 // StorageStatisticsByChat Contains the storage usage statistics for a specific chat
 type StorageStatisticsByChat struct {
 	tdCommon
-	ChatID     int64                         `json:"chat_id"`      // Chat identifier; 0 if none
-	Size       int64                         `json:"size"`         // Total size of the files in the chat
+	ChatID     JSONInt64                     `json:"chat_id"`      // Chat identifier; 0 if none
+	Size       JSONInt64                     `json:"size"`         // Total size of the files in the chat
 	Count      int32                         `json:"count"`        // Total number of files in the chat
 	ByFileType []StorageStatisticsByFileType `json:"by_file_type"` // Statistics split by file types
 }
@@ -26235,13 +31160,20 @@ func (storageStatisticsByChat *StorageStatisticsByChat) MessageType() string {
 	return "storageStatisticsByChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if StorageStatisticsByChat wasn't created by NewStorageStatisticsByChat
+func (storageStatisticsByChat StorageStatisticsByChat) MarshalJSON() ([]byte, error) {
+	type alias StorageStatisticsByChat
+	storageStatisticsByChat.tdCommon.Type = "storageStatisticsByChat"
+	return json.Marshal(alias(storageStatisticsByChat))
+}
+
 // NewStorageStatisticsByChat creates a new StorageStatisticsByChat
 //
 // @param chatID Chat identifier; 0 if none
 // @param size Total size of the files in the chat
 // @param count Total number of files in the chat
 // @param byFileType Statistics split by file types
-func NewStorageStatisticsByChat(chatID int64, size int64, count int32, byFileType []StorageStatisticsByFileType) *StorageStatisticsByChat {
+func NewStorageStatisticsByChat(chatID JSONInt64, size JSONInt64, count int32, byFileType []StorageStatisticsByFileType) *StorageStatisticsByChat {
 	storageStatisticsByChatTemp := StorageStatisticsByChat{
 		tdCommon:   tdCommon{Type: "storageStatisticsByChat"},
 		ChatID:     chatID,
@@ -26256,7 +31188,7 @@ func NewStorageStatisticsByChat(chatID int64, size int64, count int32, byFileTyp
 // StorageStatistics Contains the exact storage usage statistics split by chats and file type
 type StorageStatistics struct {
 	tdCommon
-	Size   int64                     `json:"size"`    // Total size of files
+	Size   JSONInt64                 `json:"size"`    // Total size of files
 	Count  int32                     `json:"count"`   // Total number of files
 	ByChat []StorageStatisticsByChat `json:"by_chat"` // Statistics split by chats
 }
@@ -26266,12 +31198,19 @@ func (storageStatistics *StorageStatistics) MessageType() string {
 	return "storageStatistics"
 }
 
+// MarshalJSON marshals to json, with @type set even if StorageStatistics wasn't created by NewStorageStatistics
+func (storageStatistics StorageStatistics) MarshalJSON() ([]byte, error) {
+	type alias StorageStatistics
+	storageStatistics.tdCommon.Type = "storageStatistics"
+	return json.Marshal(alias(storageStatistics))
+}
+
 // NewStorageStatistics creates a new StorageStatistics
 //
 // @param size Total size of files
 // @param count Total number of files
 // @param byChat Statistics split by chats
-func NewStorageStatistics(size int64, count int32, byChat []StorageStatisticsByChat) *StorageStatistics {
+func NewStorageStatistics(size JSONInt64, count int32, byChat []StorageStatisticsByChat) *StorageStatistics {
 	storageStatisticsTemp := StorageStatistics{
 		tdCommon: tdCommon{Type: "storageStatistics"},
 		Size:     size,
@@ -26285,11 +31224,11 @@ func NewStorageStatistics(size int64, count int32, byChat []StorageStatisticsByC
 // StorageStatisticsFast Contains approximate storage usage statistics, excluding files of unknown file type
 type StorageStatisticsFast struct {
 	tdCommon
-	FilesSize                int64 `json:"files_size"`                  // Approximate total size of files
-	FileCount                int32 `json:"file_count"`                  // Approximate number of files
-	DatabaseSize             int64 `json:"database_size"`               // Size of the database
-	LanguagePackDatabaseSize int64 `json:"language_pack_database_size"` // Size of the language pack database
-	LogSize                  int64 `json:"log_size"`                    // Size of the TDLib internal log
+	FilesSize                JSONInt64 `json:"files_size"`                  // Approximate total size of files
+	FileCount                int32     `json:"file_count"`                  // Approximate number of files
+	DatabaseSize             JSONInt64 `json:"database_size"`               // Size of the database
+	LanguagePackDatabaseSize JSONInt64 `json:"language_pack_database_size"` // Size of the language pack database
+	LogSize                  JSONInt64 `json:"log_size"`                    // Size of the TDLib internal log
 }
 
 // MessageType return the string telegram-type of StorageStatisticsFast
@@ -26297,6 +31236,13 @@ func (storageStatisticsFast *StorageStatisticsFast) MessageType() string {
 	return "storageStatisticsFast"
 }
 
+// MarshalJSON marshals to json, with @type set even if StorageStatisticsFast wasn't created by NewStorageStatisticsFast
+func (storageStatisticsFast StorageStatisticsFast) MarshalJSON() ([]byte, error) {
+	type alias StorageStatisticsFast
+	storageStatisticsFast.tdCommon.Type = "storageStatisticsFast"
+	return json.Marshal(alias(storageStatisticsFast))
+}
+
 // NewStorageStatisticsFast creates a new StorageStatisticsFast
 //
 // @param filesSize Approximate total size of files
@@ -26304,7 +31250,7 @@ func (storageStatisticsFast *StorageStatisticsFast) MessageType() string {
 // @param databaseSize Size of the database
 // @param languagePackDatabaseSize Size of the language pack database
 // @param logSize Size of the TDLib internal log
-func NewStorageStatisticsFast(filesSize int64, fileCount int32, databaseSize int64, languagePackDatabaseSize int64, logSize int64) *StorageStatisticsFast {
+func NewStorageStatisticsFast(filesSize JSONInt64, fileCount int32, databaseSize JSONInt64, languagePackDatabaseSize JSONInt64, logSize JSONInt64) *StorageStatisticsFast {
 	storageStatisticsFastTemp := StorageStatisticsFast{
 		tdCommon:                 tdCommon{Type: "storageStatisticsFast"},
 		FilesSize:                filesSize,
@@ -26328,6 +31274,13 @@ func (databaseStatistics *DatabaseStatistics) MessageType() string {
 	return "databaseStatistics"
 }
 
+// MarshalJSON marshals to json, with @type set even if DatabaseStatistics wasn't created by NewDatabaseStatistics
+func (databaseStatistics DatabaseStatistics) MarshalJSON() ([]byte, error) {
+	type alias DatabaseStatistics
+	databaseStatistics.tdCommon.Type = "databaseStatistics"
+	return json.Marshal(alias(databaseStatistics))
+}
+
 // NewDatabaseStatistics creates a new DatabaseStatistics
 //
 // @param statistics Database statistics in an unspecified human-readable format
@@ -26350,6 +31303,13 @@ func (networkTypeNone *NetworkTypeNone) MessageType() string {
 	return "networkTypeNone"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkTypeNone wasn't created by NewNetworkTypeNone
+func (networkTypeNone NetworkTypeNone) MarshalJSON() ([]byte, error) {
+	type alias NetworkTypeNone
+	networkTypeNone.tdCommon.Type = "networkTypeNone"
+	return json.Marshal(alias(networkTypeNone))
+}
+
 // NewNetworkTypeNone creates a new NetworkTypeNone
 //
 func NewNetworkTypeNone() *NetworkTypeNone {
@@ -26375,6 +31335,13 @@ func (networkTypeMobile *NetworkTypeMobile) MessageType() string {
 	return "networkTypeMobile"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkTypeMobile wasn't created by NewNetworkTypeMobile
+func (networkTypeMobile NetworkTypeMobile) MarshalJSON() ([]byte, error) {
+	type alias NetworkTypeMobile
+	networkTypeMobile.tdCommon.Type = "networkTypeMobile"
+	return json.Marshal(alias(networkTypeMobile))
+}
+
 // NewNetworkTypeMobile creates a new NetworkTypeMobile
 //
 func NewNetworkTypeMobile() *NetworkTypeMobile {
@@ -26400,6 +31367,13 @@ func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) MessageType() string {
 	return "networkTypeMobileRoaming"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkTypeMobileRoaming wasn't created by NewNetworkTypeMobileRoaming
+func (networkTypeMobileRoaming NetworkTypeMobileRoaming) MarshalJSON() ([]byte, error) {
+	type alias NetworkTypeMobileRoaming
+	networkTypeMobileRoaming.tdCommon.Type = "networkTypeMobileRoaming"
+	return json.Marshal(alias(networkTypeMobileRoaming))
+}
+
 // NewNetworkTypeMobileRoaming creates a new NetworkTypeMobileRoaming
 //
 func NewNetworkTypeMobileRoaming() *NetworkTypeMobileRoaming {
@@ -26425,6 +31399,13 @@ func (networkTypeWiFi *NetworkTypeWiFi) MessageType() string {
 	return "networkTypeWiFi"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkTypeWiFi wasn't created by NewNetworkTypeWiFi
+func (networkTypeWiFi NetworkTypeWiFi) MarshalJSON() ([]byte, error) {
+	type alias NetworkTypeWiFi
+	networkTypeWiFi.tdCommon.Type = "networkTypeWiFi"
+	return json.Marshal(alias(networkTypeWiFi))
+}
+
 // NewNetworkTypeWiFi creates a new NetworkTypeWiFi
 //
 func NewNetworkTypeWiFi() *NetworkTypeWiFi {
@@ -26450,6 +31431,13 @@ func (networkTypeOther *NetworkTypeOther) MessageType() string {
 	return "networkTypeOther"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkTypeOther wasn't created by NewNetworkTypeOther
+func (networkTypeOther NetworkTypeOther) MarshalJSON() ([]byte, error) {
+	type alias NetworkTypeOther
+	networkTypeOther.tdCommon.Type = "networkTypeOther"
+	return json.Marshal(alias(networkTypeOther))
+}
+
 // NewNetworkTypeOther creates a new NetworkTypeOther
 //
 func NewNetworkTypeOther() *NetworkTypeOther {
@@ -26470,8 +31458,8 @@ type NetworkStatisticsEntryFile struct {
 	tdCommon
 	FileType      FileType    `json:"file_type"`      // Type of the file the data is part of
 	NetworkType   NetworkType `json:"network_type"`   // Type of the network the data was sent through. Call setNetworkType to maintain the actual network type
-	SentBytes     int64       `json:"sent_bytes"`     // Total number of bytes sent
-	ReceivedBytes int64       `json:"received_bytes"` // Total number of bytes received
+	SentBytes     JSONInt64   `json:"sent_bytes"`     // Total number of bytes sent
+	ReceivedBytes JSONInt64   `json:"received_bytes"` // Total number of bytes received
 }
 
 // MessageType return the string telegram-type of NetworkStatisticsEntryFile
@@ -26479,13 +31467,20 @@ func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) MessageType() stri
 	return "networkStatisticsEntryFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkStatisticsEntryFile wasn't created by NewNetworkStatisticsEntryFile
+func (networkStatisticsEntryFile NetworkStatisticsEntryFile) MarshalJSON() ([]byte, error) {
+	type alias NetworkStatisticsEntryFile
+	networkStatisticsEntryFile.tdCommon.Type = "networkStatisticsEntryFile"
+	return json.Marshal(alias(networkStatisticsEntryFile))
+}
+
 // NewNetworkStatisticsEntryFile creates a new NetworkStatisticsEntryFile
 //
 // @param fileType Type of the file the data is part of
 // @param networkType Type of the network the data was sent through. Call setNetworkType to maintain the actual network type
 // @param sentBytes Total number of bytes sent
 // @param receivedBytes Total number of bytes received
-func NewNetworkStatisticsEntryFile(fileType FileType, networkType NetworkType, sentBytes int64, receivedBytes int64) *NetworkStatisticsEntryFile {
+func NewNetworkStatisticsEntryFile(fileType FileType, networkType NetworkType, sentBytes JSONInt64, receivedBytes JSONInt64) *NetworkStatisticsEntryFile {
 	networkStatisticsEntryFileTemp := NetworkStatisticsEntryFile{
 		tdCommon:      tdCommon{Type: "networkStatisticsEntryFile"},
 		FileType:      fileType,
@@ -26506,8 +31501,8 @@ func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) UnmarshalJSON(b []
 	}
 	tempObj := struct {
 		tdCommon
-		SentBytes     int64 `json:"sent_bytes"`     // Total number of bytes sent
-		ReceivedBytes int64 `json:"received_bytes"` // Total number of bytes received
+		SentBytes     JSONInt64 `json:"sent_bytes"`     // Total number of bytes sent
+		ReceivedBytes JSONInt64 `json:"received_bytes"` // Total number of bytes received
 	}{}
 	err = json.Unmarshal(b, &tempObj)
 	if err != nil {
@@ -26536,8 +31531,8 @@ func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) GetNetworkStatisti
 type NetworkStatisticsEntryCall struct {
 	tdCommon
 	NetworkType   NetworkType `json:"network_type"`   // Type of the network the data was sent through. Call setNetworkType to maintain the actual network type
-	SentBytes     int64       `json:"sent_bytes"`     // Total number of bytes sent
-	ReceivedBytes int64       `json:"received_bytes"` // Total number of bytes received
+	SentBytes     JSONInt64   `json:"sent_bytes"`     // Total number of bytes sent
+	ReceivedBytes JSONInt64   `json:"received_bytes"` // Total number of bytes received
 	Duration      float64     `json:"duration"`       // Total call duration, in seconds
 }
 
@@ -26546,13 +31541,20 @@ func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) MessageType() stri
 	return "networkStatisticsEntryCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkStatisticsEntryCall wasn't created by NewNetworkStatisticsEntryCall
+func (networkStatisticsEntryCall NetworkStatisticsEntryCall) MarshalJSON() ([]byte, error) {
+	type alias NetworkStatisticsEntryCall
+	networkStatisticsEntryCall.tdCommon.Type = "networkStatisticsEntryCall"
+	return json.Marshal(alias(networkStatisticsEntryCall))
+}
+
 // NewNetworkStatisticsEntryCall creates a new NetworkStatisticsEntryCall
 //
 // @param networkType Type of the network the data was sent through. Call setNetworkType to maintain the actual network type
 // @param sentBytes Total number of bytes sent
 // @param receivedBytes Total number of bytes received
 // @param duration Total call duration, in seconds
-func NewNetworkStatisticsEntryCall(networkType NetworkType, sentBytes int64, receivedBytes int64, duration float64) *NetworkStatisticsEntryCall {
+func NewNetworkStatisticsEntryCall(networkType NetworkType, sentBytes JSONInt64, receivedBytes JSONInt64, duration float64) *NetworkStatisticsEntryCall {
 	networkStatisticsEntryCallTemp := NetworkStatisticsEntryCall{
 		tdCommon:      tdCommon{Type: "networkStatisticsEntryCall"},
 		NetworkType:   networkType,
@@ -26573,9 +31575,9 @@ func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) UnmarshalJSON(b []
 	}
 	tempObj := struct {
 		tdCommon
-		SentBytes     int64   `json:"sent_bytes"`     // Total number of bytes sent
-		ReceivedBytes int64   `json:"received_bytes"` // Total number of bytes received
-		Duration      float64 `json:"duration"`       // Total call duration, in seconds
+		SentBytes     JSONInt64 `json:"sent_bytes"`     // Total number of bytes sent
+		ReceivedBytes JSONInt64 `json:"received_bytes"` // Total number of bytes received
+		Duration      float64   `json:"duration"`       // Total call duration, in seconds
 	}{}
 	err = json.Unmarshal(b, &tempObj)
 	if err != nil {
@@ -26610,6 +31612,13 @@ func (networkStatistics *NetworkStatistics) MessageType() string {
 	return "networkStatistics"
 }
 
+// MarshalJSON marshals to json, with @type set even if NetworkStatistics wasn't created by NewNetworkStatistics
+func (networkStatistics NetworkStatistics) MarshalJSON() ([]byte, error) {
+	type alias NetworkStatistics
+	networkStatistics.tdCommon.Type = "networkStatistics"
+	return json.Marshal(alias(networkStatistics))
+}
+
 // NewNetworkStatistics creates a new NetworkStatistics
 //
 // @param sinceDate Point in time (Unix timestamp) from which the statistics are collected
@@ -26642,6 +31651,13 @@ func (autoDownloadSettings *AutoDownloadSettings) MessageType() string {
 	return "autoDownloadSettings"
 }
 
+// MarshalJSON marshals to json, with @type set even if AutoDownloadSettings wasn't created by NewAutoDownloadSettings
+func (autoDownloadSettings AutoDownloadSettings) MarshalJSON() ([]byte, error) {
+	type alias AutoDownloadSettings
+	autoDownloadSettings.tdCommon.Type = "autoDownloadSettings"
+	return json.Marshal(alias(autoDownloadSettings))
+}
+
 // NewAutoDownloadSettings creates a new AutoDownloadSettings
 //
 // @param isAutoDownloadEnabled True, if the auto-download is enabled
@@ -26681,6 +31697,13 @@ func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) MessageType() st
 	return "autoDownloadSettingsPresets"
 }
 
+// MarshalJSON marshals to json, with @type set even if AutoDownloadSettingsPresets wasn't created by NewAutoDownloadSettingsPresets
+func (autoDownloadSettingsPresets AutoDownloadSettingsPresets) MarshalJSON() ([]byte, error) {
+	type alias AutoDownloadSettingsPresets
+	autoDownloadSettingsPresets.tdCommon.Type = "autoDownloadSettingsPresets"
+	return json.Marshal(alias(autoDownloadSettingsPresets))
+}
+
 // NewAutoDownloadSettingsPresets creates a new AutoDownloadSettingsPresets
 //
 // @param low Preset with lowest settings; supposed to be used by default when roaming
@@ -26707,6 +31730,13 @@ func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) Messag
 	return "connectionStateWaitingForNetwork"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectionStateWaitingForNetwork wasn't created by NewConnectionStateWaitingForNetwork
+func (connectionStateWaitingForNetwork ConnectionStateWaitingForNetwork) MarshalJSON() ([]byte, error) {
+	type alias ConnectionStateWaitingForNetwork
+	connectionStateWaitingForNetwork.tdCommon.Type = "connectionStateWaitingForNetwork"
+	return json.Marshal(alias(connectionStateWaitingForNetwork))
+}
+
 // NewConnectionStateWaitingForNetwork creates a new ConnectionStateWaitingForNetwork
 //
 func NewConnectionStateWaitingForNetwork() *ConnectionStateWaitingForNetwork {
@@ -26732,6 +31762,13 @@ func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) Messag
 	return "connectionStateConnectingToProxy"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectionStateConnectingToProxy wasn't created by NewConnectionStateConnectingToProxy
+func (connectionStateConnectingToProxy ConnectionStateConnectingToProxy) MarshalJSON() ([]byte, error) {
+	type alias ConnectionStateConnectingToProxy
+	connectionStateConnectingToProxy.tdCommon.Type = "connectionStateConnectingToProxy"
+	return json.Marshal(alias(connectionStateConnectingToProxy))
+}
+
 // NewConnectionStateConnectingToProxy creates a new ConnectionStateConnectingToProxy
 //
 func NewConnectionStateConnectingToProxy() *ConnectionStateConnectingToProxy {
@@ -26757,6 +31794,13 @@ func (connectionStateConnecting *ConnectionStateConnecting) MessageType() string
 	return "connectionStateConnecting"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectionStateConnecting wasn't created by NewConnectionStateConnecting
+func (connectionStateConnecting ConnectionStateConnecting) MarshalJSON() ([]byte, error) {
+	type alias ConnectionStateConnecting
+	connectionStateConnecting.tdCommon.Type = "connectionStateConnecting"
+	return json.Marshal(alias(connectionStateConnecting))
+}
+
 // NewConnectionStateConnecting creates a new ConnectionStateConnecting
 //
 func NewConnectionStateConnecting() *ConnectionStateConnecting {
@@ -26782,6 +31826,13 @@ func (connectionStateUpdating *ConnectionStateUpdating) MessageType() string {
 	return "connectionStateUpdating"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectionStateUpdating wasn't created by NewConnectionStateUpdating
+func (connectionStateUpdating ConnectionStateUpdating) MarshalJSON() ([]byte, error) {
+	type alias ConnectionStateUpdating
+	connectionStateUpdating.tdCommon.Type = "connectionStateUpdating"
+	return json.Marshal(alias(connectionStateUpdating))
+}
+
 // NewConnectionStateUpdating creates a new ConnectionStateUpdating
 //
 func NewConnectionStateUpdating() *ConnectionStateUpdating {
@@ -26807,6 +31858,13 @@ func (connectionStateReady *ConnectionStateReady) MessageType() string {
 	return "connectionStateReady"
 }
 
+// MarshalJSON marshals to json, with @type set even if ConnectionStateReady wasn't created by NewConnectionStateReady
+func (connectionStateReady ConnectionStateReady) MarshalJSON() ([]byte, error) {
+	type alias ConnectionStateReady
+	connectionStateReady.tdCommon.Type = "connectionStateReady"
+	return json.Marshal(alias(connectionStateReady))
+}
+
 // NewConnectionStateReady creates a new ConnectionStateReady
 //
 func NewConnectionStateReady() *ConnectionStateReady {
@@ -26832,6 +31890,13 @@ func (topChatCategoryUsers *TopChatCategoryUsers) MessageType() string {
 	return "topChatCategoryUsers"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryUsers wasn't created by NewTopChatCategoryUsers
+func (topChatCategoryUsers TopChatCategoryUsers) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryUsers
+	topChatCategoryUsers.tdCommon.Type = "topChatCategoryUsers"
+	return json.Marshal(alias(topChatCategoryUsers))
+}
+
 // NewTopChatCategoryUsers creates a new TopChatCategoryUsers
 //
 func NewTopChatCategoryUsers() *TopChatCategoryUsers {
@@ -26857,6 +31922,13 @@ func (topChatCategoryBots *TopChatCategoryBots) MessageType() string {
 	return "topChatCategoryBots"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryBots wasn't created by NewTopChatCategoryBots
+func (topChatCategoryBots TopChatCategoryBots) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryBots
+	topChatCategoryBots.tdCommon.Type = "topChatCategoryBots"
+	return json.Marshal(alias(topChatCategoryBots))
+}
+
 // NewTopChatCategoryBots creates a new TopChatCategoryBots
 //
 func NewTopChatCategoryBots() *TopChatCategoryBots {
@@ -26882,6 +31954,13 @@ func (topChatCategoryGroups *TopChatCategoryGroups) MessageType() string {
 	return "topChatCategoryGroups"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryGroups wasn't created by NewTopChatCategoryGroups
+func (topChatCategoryGroups TopChatCategoryGroups) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryGroups
+	topChatCategoryGroups.tdCommon.Type = "topChatCategoryGroups"
+	return json.Marshal(alias(topChatCategoryGroups))
+}
+
 // NewTopChatCategoryGroups creates a new TopChatCategoryGroups
 //
 func NewTopChatCategoryGroups() *TopChatCategoryGroups {
@@ -26907,6 +31986,13 @@ func (topChatCategoryChannels *TopChatCategoryChannels) MessageType() string {
 	return "topChatCategoryChannels"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryChannels wasn't created by NewTopChatCategoryChannels
+func (topChatCategoryChannels TopChatCategoryChannels) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryChannels
+	topChatCategoryChannels.tdCommon.Type = "topChatCategoryChannels"
+	return json.Marshal(alias(topChatCategoryChannels))
+}
+
 // NewTopChatCategoryChannels creates a new TopChatCategoryChannels
 //
 func NewTopChatCategoryChannels() *TopChatCategoryChannels {
@@ -26932,6 +32018,13 @@ func (topChatCategoryInlineBots *TopChatCategoryInlineBots) MessageType() string
 	return "topChatCategoryInlineBots"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryInlineBots wasn't created by NewTopChatCategoryInlineBots
+func (topChatCategoryInlineBots TopChatCategoryInlineBots) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryInlineBots
+	topChatCategoryInlineBots.tdCommon.Type = "topChatCategoryInlineBots"
+	return json.Marshal(alias(topChatCategoryInlineBots))
+}
+
 // NewTopChatCategoryInlineBots creates a new TopChatCategoryInlineBots
 //
 func NewTopChatCategoryInlineBots() *TopChatCategoryInlineBots {
@@ -26957,6 +32050,13 @@ func (topChatCategoryCalls *TopChatCategoryCalls) MessageType() string {
 	return "topChatCategoryCalls"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryCalls wasn't created by NewTopChatCategoryCalls
+func (topChatCategoryCalls TopChatCategoryCalls) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryCalls
+	topChatCategoryCalls.tdCommon.Type = "topChatCategoryCalls"
+	return json.Marshal(alias(topChatCategoryCalls))
+}
+
 // NewTopChatCategoryCalls creates a new TopChatCategoryCalls
 //
 func NewTopChatCategoryCalls() *TopChatCategoryCalls {
@@ -26982,6 +32082,13 @@ func (topChatCategoryForwardChats *TopChatCategoryForwardChats) MessageType() st
 	return "topChatCategoryForwardChats"
 }
 
+// MarshalJSON marshals to json, with @type set even if TopChatCategoryForwardChats wasn't created by NewTopChatCategoryForwardChats
+func (topChatCategoryForwardChats TopChatCategoryForwardChats) MarshalJSON() ([]byte, error) {
+	type alias TopChatCategoryForwardChats
+	topChatCategoryForwardChats.tdCommon.Type = "topChatCategoryForwardChats"
+	return json.Marshal(alias(topChatCategoryForwardChats))
+}
+
 // NewTopChatCategoryForwardChats creates a new TopChatCategoryForwardChats
 //
 func NewTopChatCategoryForwardChats() *TopChatCategoryForwardChats {
@@ -27008,6 +32115,13 @@ func (tMeURLTypeUser *TMeURLTypeUser) MessageType() string {
 	return "tMeUrlTypeUser"
 }
 
+// MarshalJSON marshals to json, with @type set even if TMeURLTypeUser wasn't created by NewTMeURLTypeUser
+func (tMeURLTypeUser TMeURLTypeUser) MarshalJSON() ([]byte, error) {
+	type alias TMeURLTypeUser
+	tMeURLTypeUser.tdCommon.Type = "tMeUrlTypeUser"
+	return json.Marshal(alias(tMeURLTypeUser))
+}
+
 // NewTMeURLTypeUser creates a new TMeURLTypeUser
 //
 // @param userID Identifier of the user
@@ -27028,7 +32142,7 @@ func (tMeURLTypeUser *TMeURLTypeUser) GetTMeURLTypeEnum() TMeURLTypeEnum {
 // TMeURLTypeSupergroup A URL linking to a public supergroup or channel
 type TMeURLTypeSupergroup struct {
 	tdCommon
-	SupergroupID int64 `json:"supergroup_id"` // Identifier of the supergroup or channel
+	SupergroupID JSONInt64 `json:"supergroup_id"` // Identifier of the supergroup or channel
 }
 
 // MessageType return the string telegram-type of TMeURLTypeSupergroup
@@ -27036,10 +32150,17 @@ func (tMeURLTypeSupergroup *TMeURLTypeSupergroup) MessageType() string {
 	return "tMeUrlTypeSupergroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if TMeURLTypeSupergroup wasn't created by NewTMeURLTypeSupergroup
+func (tMeURLTypeSupergroup TMeURLTypeSupergroup) MarshalJSON() ([]byte, error) {
+	type alias TMeURLTypeSupergroup
+	tMeURLTypeSupergroup.tdCommon.Type = "tMeUrlTypeSupergroup"
+	return json.Marshal(alias(tMeURLTypeSupergroup))
+}
+
 // NewTMeURLTypeSupergroup creates a new TMeURLTypeSupergroup
 //
 // @param supergroupID Identifier of the supergroup or channel
-func NewTMeURLTypeSupergroup(supergroupID int64) *TMeURLTypeSupergroup {
+func NewTMeURLTypeSupergroup(supergroupID JSONInt64) *TMeURLTypeSupergroup {
 	tMeURLTypeSupergroupTemp := TMeURLTypeSupergroup{
 		tdCommon:     tdCommon{Type: "tMeUrlTypeSupergroup"},
 		SupergroupID: supergroupID,
@@ -27064,6 +32185,13 @@ func (tMeURLTypeChatInvite *TMeURLTypeChatInvite) MessageType() string {
 	return "tMeUrlTypeChatInvite"
 }
 
+// MarshalJSON marshals to json, with @type set even if TMeURLTypeChatInvite wasn't created by NewTMeURLTypeChatInvite
+func (tMeURLTypeChatInvite TMeURLTypeChatInvite) MarshalJSON() ([]byte, error) {
+	type alias TMeURLTypeChatInvite
+	tMeURLTypeChatInvite.tdCommon.Type = "tMeUrlTypeChatInvite"
+	return json.Marshal(alias(tMeURLTypeChatInvite))
+}
+
 // NewTMeURLTypeChatInvite creates a new TMeURLTypeChatInvite
 //
 // @param info Chat invite link info
@@ -27092,6 +32220,13 @@ func (tMeURLTypeStickerSet *TMeURLTypeStickerSet) MessageType() string {
 	return "tMeUrlTypeStickerSet"
 }
 
+// MarshalJSON marshals to json, with @type set even if TMeURLTypeStickerSet wasn't created by NewTMeURLTypeStickerSet
+func (tMeURLTypeStickerSet TMeURLTypeStickerSet) MarshalJSON() ([]byte, error) {
+	type alias TMeURLTypeStickerSet
+	tMeURLTypeStickerSet.tdCommon.Type = "tMeUrlTypeStickerSet"
+	return json.Marshal(alias(tMeURLTypeStickerSet))
+}
+
 // NewTMeURLTypeStickerSet creates a new TMeURLTypeStickerSet
 //
 // @param stickerSetID Identifier of the sticker set
@@ -27121,6 +32256,13 @@ func (tMeURL *TMeURL) MessageType() string {
 	return "tMeUrl"
 }
 
+// MarshalJSON marshals to json, with @type set even if TMeURL wasn't created by NewTMeURL
+func (tMeURL TMeURL) MarshalJSON() ([]byte, error) {
+	type alias TMeURL
+	tMeURL.tdCommon.Type = "tMeUrl"
+	return json.Marshal(alias(tMeURL))
+}
+
 // NewTMeURL creates a new TMeURL
 //
 // @param uRL URL
@@ -27172,6 +32314,13 @@ func (tMeURLs *TMeURLs) MessageType() string {
 	return "tMeUrls"
 }
 
+// MarshalJSON marshals to json, with @type set even if TMeURLs wasn't created by NewTMeURLs
+func (tMeURLs TMeURLs) MarshalJSON() ([]byte, error) {
+	type alias TMeURLs
+	tMeURLs.tdCommon.Type = "tMeUrls"
+	return json.Marshal(alias(tMeURLs))
+}
+
 // NewTMeURLs creates a new TMeURLs
 //
 // @param uRLs List of URLs
@@ -27194,6 +32343,13 @@ func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveA
 	return "suggestedActionEnableArchiveAndMuteNewChats"
 }
 
+// MarshalJSON marshals to json, with @type set even if SuggestedActionEnableArchiveAndMuteNewChats wasn't created by NewSuggestedActionEnableArchiveAndMuteNewChats
+func (suggestedActionEnableArchiveAndMuteNewChats SuggestedActionEnableArchiveAndMuteNewChats) MarshalJSON() ([]byte, error) {
+	type alias SuggestedActionEnableArchiveAndMuteNewChats
+	suggestedActionEnableArchiveAndMuteNewChats.tdCommon.Type = "suggestedActionEnableArchiveAndMuteNewChats"
+	return json.Marshal(alias(suggestedActionEnableArchiveAndMuteNewChats))
+}
+
 // NewSuggestedActionEnableArchiveAndMuteNewChats creates a new SuggestedActionEnableArchiveAndMuteNewChats
 //
 func NewSuggestedActionEnableArchiveAndMuteNewChats() *SuggestedActionEnableArchiveAndMuteNewChats {
@@ -27219,6 +32375,13 @@ func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) MessageT
 	return "suggestedActionCheckPhoneNumber"
 }
 
+// MarshalJSON marshals to json, with @type set even if SuggestedActionCheckPhoneNumber wasn't created by NewSuggestedActionCheckPhoneNumber
+func (suggestedActionCheckPhoneNumber SuggestedActionCheckPhoneNumber) MarshalJSON() ([]byte, error) {
+	type alias SuggestedActionCheckPhoneNumber
+	suggestedActionCheckPhoneNumber.tdCommon.Type = "suggestedActionCheckPhoneNumber"
+	return json.Marshal(alias(suggestedActionCheckPhoneNumber))
+}
+
 // NewSuggestedActionCheckPhoneNumber creates a new SuggestedActionCheckPhoneNumber
 //
 func NewSuggestedActionCheckPhoneNumber() *SuggestedActionCheckPhoneNumber {
@@ -27244,6 +32407,13 @@ func (suggestedActionSeeTicksHint *SuggestedActionSeeTicksHint) MessageType() st
 	return "suggestedActionSeeTicksHint"
 }
 
+// MarshalJSON marshals to json, with @type set even if SuggestedActionSeeTicksHint wasn't created by NewSuggestedActionSeeTicksHint
+func (suggestedActionSeeTicksHint SuggestedActionSeeTicksHint) MarshalJSON() ([]byte, error) {
+	type alias SuggestedActionSeeTicksHint
+	suggestedActionSeeTicksHint.tdCommon.Type = "suggestedActionSeeTicksHint"
+	return json.Marshal(alias(suggestedActionSeeTicksHint))
+}
+
 // NewSuggestedActionSeeTicksHint creates a new SuggestedActionSeeTicksHint
 //
 func NewSuggestedActionSeeTicksHint() *SuggestedActionSeeTicksHint {
@@ -27270,6 +32440,13 @@ func (count *Count) MessageType() string {
 	return "count"
 }
 
+// MarshalJSON marshals to json, with @type set even if Count wasn't created by NewCount
+func (count Count) MarshalJSON() ([]byte, error) {
+	type alias Count
+	count.tdCommon.Type = "count"
+	return json.Marshal(alias(count))
+}
+
 // NewCount creates a new Count
 //
 // @param count Count
@@ -27293,6 +32470,13 @@ func (text *Text) MessageType() string {
 	return "text"
 }
 
+// MarshalJSON marshals to json, with @type set even if Text wasn't created by NewText
+func (text Text) MarshalJSON() ([]byte, error) {
+	type alias Text
+	text.tdCommon.Type = "text"
+	return json.Marshal(alias(text))
+}
+
 // NewText creates a new Text
 //
 // @param text Text
@@ -27316,6 +32500,13 @@ func (seconds *Seconds) MessageType() string {
 	return "seconds"
 }
 
+// MarshalJSON marshals to json, with @type set even if Seconds wasn't created by NewSeconds
+func (seconds Seconds) MarshalJSON() ([]byte, error) {
+	type alias Seconds
+	seconds.tdCommon.Type = "seconds"
+	return json.Marshal(alias(seconds))
+}
+
 // NewSeconds creates a new Seconds
 //
 // @param seconds Number of seconds
@@ -27340,6 +32531,13 @@ func (deepLinkInfo *DeepLinkInfo) MessageType() string {
 	return "deepLinkInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if DeepLinkInfo wasn't created by NewDeepLinkInfo
+func (deepLinkInfo DeepLinkInfo) MarshalJSON() ([]byte, error) {
+	type alias DeepLinkInfo
+	deepLinkInfo.tdCommon.Type = "deepLinkInfo"
+	return json.Marshal(alias(deepLinkInfo))
+}
+
 // NewDeepLinkInfo creates a new DeepLinkInfo
 //
 // @param text Text to be shown to the user
@@ -27365,6 +32563,13 @@ func (textParseModeMarkdown *TextParseModeMarkdown) MessageType() string {
 	return "textParseModeMarkdown"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextParseModeMarkdown wasn't created by NewTextParseModeMarkdown
+func (textParseModeMarkdown TextParseModeMarkdown) MarshalJSON() ([]byte, error) {
+	type alias TextParseModeMarkdown
+	textParseModeMarkdown.tdCommon.Type = "textParseModeMarkdown"
+	return json.Marshal(alias(textParseModeMarkdown))
+}
+
 // NewTextParseModeMarkdown creates a new TextParseModeMarkdown
 //
 // @param version Version of the parser: 0 or 1 - Telegram Bot API "Markdown" parse mode, 2 - Telegram Bot API "MarkdownV2" parse mode
@@ -27392,6 +32597,13 @@ func (textParseModeHTML *TextParseModeHTML) MessageType() string {
 	return "textParseModeHTML"
 }
 
+// MarshalJSON marshals to json, with @type set even if TextParseModeHTML wasn't created by NewTextParseModeHTML
+func (textParseModeHTML TextParseModeHTML) MarshalJSON() ([]byte, error) {
+	type alias TextParseModeHTML
+	textParseModeHTML.tdCommon.Type = "textParseModeHTML"
+	return json.Marshal(alias(textParseModeHTML))
+}
+
 // NewTextParseModeHTML creates a new TextParseModeHTML
 //
 func NewTextParseModeHTML() *TextParseModeHTML {
@@ -27419,6 +32631,13 @@ func (proxyTypeSocks5 *ProxyTypeSocks5) MessageType() string {
 	return "proxyTypeSocks5"
 }
 
+// MarshalJSON marshals to json, with @type set even if ProxyTypeSocks5 wasn't created by NewProxyTypeSocks5
+func (proxyTypeSocks5 ProxyTypeSocks5) MarshalJSON() ([]byte, error) {
+	type alias ProxyTypeSocks5
+	proxyTypeSocks5.tdCommon.Type = "proxyTypeSocks5"
+	return json.Marshal(alias(proxyTypeSocks5))
+}
+
 // NewProxyTypeSocks5 creates a new ProxyTypeSocks5
 //
 // @param username Username for logging in; may be empty
@@ -27451,6 +32670,13 @@ func (proxyTypeHttp *ProxyTypeHttp) MessageType() string {
 	return "proxyTypeHttp"
 }
 
+// MarshalJSON marshals to json, with @type set even if ProxyTypeHttp wasn't created by NewProxyTypeHttp
+func (proxyTypeHttp ProxyTypeHttp) MarshalJSON() ([]byte, error) {
+	type alias ProxyTypeHttp
+	proxyTypeHttp.tdCommon.Type = "proxyTypeHttp"
+	return json.Marshal(alias(proxyTypeHttp))
+}
+
 // NewProxyTypeHttp creates a new ProxyTypeHttp
 //
 // @param username Username for logging in; may be empty
@@ -27483,6 +32709,13 @@ func (proxyTypeMtproto *ProxyTypeMtproto) MessageType() string {
 	return "proxyTypeMtproto"
 }
 
+// MarshalJSON marshals to json, with @type set even if ProxyTypeMtproto wasn't created by NewProxyTypeMtproto
+func (proxyTypeMtproto ProxyTypeMtproto) MarshalJSON() ([]byte, error) {
+	type alias ProxyTypeMtproto
+	proxyTypeMtproto.tdCommon.Type = "proxyTypeMtproto"
+	return json.Marshal(alias(proxyTypeMtproto))
+}
+
 // NewProxyTypeMtproto creates a new ProxyTypeMtproto
 //
 // @param secret The proxy's secret in hexadecimal encoding
@@ -27516,6 +32749,13 @@ func (proxy *Proxy) MessageType() string {
 	return "proxy"
 }
 
+// MarshalJSON marshals to json, with @type set even if Proxy wasn't created by NewProxy
+func (proxy Proxy) MarshalJSON() ([]byte, error) {
+	type alias Proxy
+	proxy.tdCommon.Type = "proxy"
+	return json.Marshal(alias(proxy))
+}
+
 // NewProxy creates a new Proxy
 //
 // @param iD Unique identifier of the proxy
@@ -27583,6 +32823,13 @@ func (proxies *Proxies) MessageType() string {
 	return "proxies"
 }
 
+// MarshalJSON marshals to json, with @type set even if Proxies wasn't created by NewProxies
+func (proxies Proxies) MarshalJSON() ([]byte, error) {
+	type alias Proxies
+	proxies.tdCommon.Type = "proxies"
+	return json.Marshal(alias(proxies))
+}
+
 // NewProxies creates a new Proxies
 //
 // @param proxies List of proxy servers
@@ -27608,6 +32855,13 @@ func (inputStickerStatic *InputStickerStatic) MessageType() string {
 	return "inputStickerStatic"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputStickerStatic wasn't created by NewInputStickerStatic
+func (inputStickerStatic InputStickerStatic) MarshalJSON() ([]byte, error) {
+	type alias InputStickerStatic
+	inputStickerStatic.tdCommon.Type = "inputStickerStatic"
+	return json.Marshal(alias(inputStickerStatic))
+}
+
 // NewInputStickerStatic creates a new InputStickerStatic
 //
 // @param sticker PNG image with the sticker; must be up to 512 KB in size and fit in a 512x512 square
@@ -27668,6 +32922,13 @@ func (inputStickerAnimated *InputStickerAnimated) MessageType() string {
 	return "inputStickerAnimated"
 }
 
+// MarshalJSON marshals to json, with @type set even if InputStickerAnimated wasn't created by NewInputStickerAnimated
+func (inputStickerAnimated InputStickerAnimated) MarshalJSON() ([]byte, error) {
+	type alias InputStickerAnimated
+	inputStickerAnimated.tdCommon.Type = "inputStickerAnimated"
+	return json.Marshal(alias(inputStickerAnimated))
+}
+
 // NewInputStickerAnimated creates a new InputStickerAnimated
 //
 // @param sticker File with the animated sticker. Only local or uploaded within a week files are supported. See https://core.telegram.org/animated_stickers#technical-requirements for technical requirements
@@ -27724,6 +32985,13 @@ func (dateRange *DateRange) MessageType() string {
 	return "dateRange"
 }
 
+// MarshalJSON marshals to json, with @type set even if DateRange wasn't created by NewDateRange
+func (dateRange DateRange) MarshalJSON() ([]byte, error) {
+	type alias DateRange
+	dateRange.tdCommon.Type = "dateRange"
+	return json.Marshal(alias(dateRange))
+}
+
 // NewDateRange creates a new DateRange
 //
 // @param startDate Point in time (Unix timestamp) at which the date range begins
@@ -27751,6 +33019,13 @@ func (statisticalValue *StatisticalValue) MessageType() string {
 	return "statisticalValue"
 }
 
+// MarshalJSON marshals to json, with @type set even if StatisticalValue wasn't created by NewStatisticalValue
+func (statisticalValue StatisticalValue) MarshalJSON() ([]byte, error) {
+	type alias StatisticalValue
+	statisticalValue.tdCommon.Type = "statisticalValue"
+	return json.Marshal(alias(statisticalValue))
+}
+
 // NewStatisticalValue creates a new StatisticalValue
 //
 // @param value The current value
@@ -27779,6 +33054,13 @@ func (statisticalGraphData *StatisticalGraphData) MessageType() string {
 	return "statisticalGraphData"
 }
 
+// MarshalJSON marshals to json, with @type set even if StatisticalGraphData wasn't created by NewStatisticalGraphData
+func (statisticalGraphData StatisticalGraphData) MarshalJSON() ([]byte, error) {
+	type alias StatisticalGraphData
+	statisticalGraphData.tdCommon.Type = "statisticalGraphData"
+	return json.Marshal(alias(statisticalGraphData))
+}
+
 // NewStatisticalGraphData creates a new StatisticalGraphData
 //
 // @param jsonData Graph data in JSON format
@@ -27809,6 +33091,13 @@ func (statisticalGraphAsync *StatisticalGraphAsync) MessageType() string {
 	return "statisticalGraphAsync"
 }
 
+// MarshalJSON marshals to json, with @type set even if StatisticalGraphAsync wasn't created by NewStatisticalGraphAsync
+func (statisticalGraphAsync StatisticalGraphAsync) MarshalJSON() ([]byte, error) {
+	type alias StatisticalGraphAsync
+	statisticalGraphAsync.tdCommon.Type = "statisticalGraphAsync"
+	return json.Marshal(alias(statisticalGraphAsync))
+}
+
 // NewStatisticalGraphAsync creates a new StatisticalGraphAsync
 //
 // @param token The token to use for data loading
@@ -27837,6 +33126,13 @@ func (statisticalGraphError *StatisticalGraphError) MessageType() string {
 	return "statisticalGraphError"
 }
 
+// MarshalJSON marshals to json, with @type set even if StatisticalGraphError wasn't created by NewStatisticalGraphError
+func (statisticalGraphError StatisticalGraphError) MarshalJSON() ([]byte, error) {
+	type alias StatisticalGraphError
+	statisticalGraphError.tdCommon.Type = "statisticalGraphError"
+	return json.Marshal(alias(statisticalGraphError))
+}
+
 // NewStatisticalGraphError creates a new StatisticalGraphError
 //
 // @param errorMessage The error message
@@ -27857,9 +33153,9 @@ func (statisticalGraphError *StatisticalGraphError) GetStatisticalGraphEnum() St
 // ChatStatisticsMessageInteractionInfo Contains statistics about interactions with a message
 type ChatStatisticsMessageInteractionInfo struct {
 	tdCommon
-	MessageID    int64 `json:"message_id"`    // Message identifier
-	ViewCount    int32 `json:"view_count"`    // Number of times the message was viewed
-	ForwardCount int32 `json:"forward_count"` // Number of times the message was forwarded
+	MessageID    JSONInt64 `json:"message_id"`    // Message identifier
+	ViewCount    int32     `json:"view_count"`    // Number of times the message was viewed
+	ForwardCount int32     `json:"forward_count"` // Number of times the message was forwarded
 }
 
 // MessageType return the string telegram-type of ChatStatisticsMessageInteractionInfo
@@ -27867,12 +33163,19 @@ func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo
 	return "chatStatisticsMessageInteractionInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatStatisticsMessageInteractionInfo wasn't created by NewChatStatisticsMessageInteractionInfo
+func (chatStatisticsMessageInteractionInfo ChatStatisticsMessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatStatisticsMessageInteractionInfo
+	chatStatisticsMessageInteractionInfo.tdCommon.Type = "chatStatisticsMessageInteractionInfo"
+	return json.Marshal(alias(chatStatisticsMessageInteractionInfo))
+}
+
 // NewChatStatisticsMessageInteractionInfo creates a new ChatStatisticsMessageInteractionInfo
 //
 // @param messageID Message identifier
 // @param viewCount Number of times the message was viewed
 // @param forwardCount Number of times the message was forwarded
-func NewChatStatisticsMessageInteractionInfo(messageID int64, viewCount int32, forwardCount int32) *ChatStatisticsMessageInteractionInfo {
+func NewChatStatisticsMessageInteractionInfo(messageID JSONInt64, viewCount int32, forwardCount int32) *ChatStatisticsMessageInteractionInfo {
 	chatStatisticsMessageInteractionInfoTemp := ChatStatisticsMessageInteractionInfo{
 		tdCommon:     tdCommon{Type: "chatStatisticsMessageInteractionInfo"},
 		MessageID:    messageID,
@@ -27896,6 +33199,13 @@ func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) MessageT
 	return "chatStatisticsMessageSenderInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatStatisticsMessageSenderInfo wasn't created by NewChatStatisticsMessageSenderInfo
+func (chatStatisticsMessageSenderInfo ChatStatisticsMessageSenderInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatStatisticsMessageSenderInfo
+	chatStatisticsMessageSenderInfo.tdCommon.Type = "chatStatisticsMessageSenderInfo"
+	return json.Marshal(alias(chatStatisticsMessageSenderInfo))
+}
+
 // NewChatStatisticsMessageSenderInfo creates a new ChatStatisticsMessageSenderInfo
 //
 // @param userID User identifier
@@ -27926,6 +33236,13 @@ func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActions
 	return "chatStatisticsAdministratorActionsInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatStatisticsAdministratorActionsInfo wasn't created by NewChatStatisticsAdministratorActionsInfo
+func (chatStatisticsAdministratorActionsInfo ChatStatisticsAdministratorActionsInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatStatisticsAdministratorActionsInfo
+	chatStatisticsAdministratorActionsInfo.tdCommon.Type = "chatStatisticsAdministratorActionsInfo"
+	return json.Marshal(alias(chatStatisticsAdministratorActionsInfo))
+}
+
 // NewChatStatisticsAdministratorActionsInfo creates a new ChatStatisticsAdministratorActionsInfo
 //
 // @param userID Administrator user identifier
@@ -27956,6 +33273,13 @@ func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) MessageType() string
 	return "chatStatisticsInviterInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatStatisticsInviterInfo wasn't created by NewChatStatisticsInviterInfo
+func (chatStatisticsInviterInfo ChatStatisticsInviterInfo) MarshalJSON() ([]byte, error) {
+	type alias ChatStatisticsInviterInfo
+	chatStatisticsInviterInfo.tdCommon.Type = "chatStatisticsInviterInfo"
+	return json.Marshal(alias(chatStatisticsInviterInfo))
+}
+
 // NewChatStatisticsInviterInfo creates a new ChatStatisticsInviterInfo
 //
 // @param userID User identifier
@@ -27996,6 +33320,13 @@ func (chatStatisticsSupergroup *ChatStatisticsSupergroup) MessageType() string {
 	return "chatStatisticsSupergroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatStatisticsSupergroup wasn't created by NewChatStatisticsSupergroup
+func (chatStatisticsSupergroup ChatStatisticsSupergroup) MarshalJSON() ([]byte, error) {
+	type alias ChatStatisticsSupergroup
+	chatStatisticsSupergroup.tdCommon.Type = "chatStatisticsSupergroup"
+	return json.Marshal(alias(chatStatisticsSupergroup))
+}
+
 // NewChatStatisticsSupergroup creates a new ChatStatisticsSupergroup
 //
 // @param period A period to which the statistics applies
@@ -28128,6 +33459,13 @@ func (chatStatisticsChannel *ChatStatisticsChannel) MessageType() string {
 	return "chatStatisticsChannel"
 }
 
+// MarshalJSON marshals to json, with @type set even if ChatStatisticsChannel wasn't created by NewChatStatisticsChannel
+func (chatStatisticsChannel ChatStatisticsChannel) MarshalJSON() ([]byte, error) {
+	type alias ChatStatisticsChannel
+	chatStatisticsChannel.tdCommon.Type = "chatStatisticsChannel"
+	return json.Marshal(alias(chatStatisticsChannel))
+}
+
 // NewChatStatisticsChannel creates a new ChatStatisticsChannel
 //
 // @param period A period to which the statistics applies
@@ -28243,6 +33581,13 @@ func (messageStatistics *MessageStatistics) MessageType() string {
 	return "messageStatistics"
 }
 
+// MarshalJSON marshals to json, with @type set even if MessageStatistics wasn't created by NewMessageStatistics
+func (messageStatistics MessageStatistics) MarshalJSON() ([]byte, error) {
+	type alias MessageStatistics
+	messageStatistics.tdCommon.Type = "messageStatistics"
+	return json.Marshal(alias(messageStatistics))
+}
+
 // NewMessageStatistics creates a new MessageStatistics
 //
 // @param messageInteractionGraph A graph containing number of message views and shares
@@ -28290,6 +33635,13 @@ func (point *Point) MessageType() string {
 	return "point"
 }
 
+// MarshalJSON marshals to json, with @type set even if Point wasn't created by NewPoint
+func (point Point) MarshalJSON() ([]byte, error) {
+	type alias Point
+	point.tdCommon.Type = "point"
+	return json.Marshal(alias(point))
+}
+
 // NewPoint creates a new Point
 //
 // @param x The point's first coordinate
@@ -28315,6 +33667,13 @@ func (vectorPathCommandLine *VectorPathCommandLine) MessageType() string {
 	return "vectorPathCommandLine"
 }
 
+// MarshalJSON marshals to json, with @type set even if VectorPathCommandLine wasn't created by NewVectorPathCommandLine
+func (vectorPathCommandLine VectorPathCommandLine) MarshalJSON() ([]byte, error) {
+	type alias VectorPathCommandLine
+	vectorPathCommandLine.tdCommon.Type = "vectorPathCommandLine"
+	return json.Marshal(alias(vectorPathCommandLine))
+}
+
 // NewVectorPathCommandLine creates a new VectorPathCommandLine
 //
 // @param endPoint The end point of the straight line
@@ -28345,6 +33704,13 @@ func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) Mess
 	return "vectorPathCommandCubicBezierCurve"
 }
 
+// MarshalJSON marshals to json, with @type set even if VectorPathCommandCubicBezierCurve wasn't created by NewVectorPathCommandCubicBezierCurve
+func (vectorPathCommandCubicBezierCurve VectorPathCommandCubicBezierCurve) MarshalJSON() ([]byte, error) {
+	type alias VectorPathCommandCubicBezierCurve
+	vectorPathCommandCubicBezierCurve.tdCommon.Type = "vectorPathCommandCubicBezierCurve"
+	return json.Marshal(alias(vectorPathCommandCubicBezierCurve))
+}
+
 // NewVectorPathCommandCubicBezierCurve creates a new VectorPathCommandCubicBezierCurve
 //
 // @param startControlPoint The start control point of the curve
@@ -28377,6 +33743,13 @@ func (updateAuthorizationState *UpdateAuthorizationState) MessageType() string {
 	return "updateAuthorizationState"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateAuthorizationState wasn't created by NewUpdateAuthorizationState
+func (updateAuthorizationState UpdateAuthorizationState) MarshalJSON() ([]byte, error) {
+	type alias UpdateAuthorizationState
+	updateAuthorizationState.tdCommon.Type = "updateAuthorizationState"
+	return json.Marshal(alias(updateAuthorizationState))
+}
+
 // NewUpdateAuthorizationState creates a new UpdateAuthorizationState
 //
 // @param authorizationState New authorization state
@@ -28428,6 +33801,13 @@ func (updateNewMessage *UpdateNewMessage) MessageType() string {
 	return "updateNewMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewMessage wasn't created by NewUpdateNewMessage
+func (updateNewMessage UpdateNewMessage) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewMessage
+	updateNewMessage.tdCommon.Type = "updateNewMessage"
+	return json.Marshal(alias(updateNewMessage))
+}
+
 // NewUpdateNewMessage creates a new UpdateNewMessage
 //
 // @param message The new message
@@ -28448,8 +33828,8 @@ func (updateNewMessage *UpdateNewMessage) GetUpdateEnum() UpdateEnum {
 // UpdateMessageSendAcknowledged A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully or even that the send message request will be processed. This update will be sent only if the option "use_quick_ack" is set to true. This update may be sent multiple times for the same message
 type UpdateMessageSendAcknowledged struct {
 	tdCommon
-	ChatID    int64 `json:"chat_id"`    // The chat identifier of the sent message
-	MessageID int64 `json:"message_id"` // A temporary message identifier
+	ChatID    JSONInt64 `json:"chat_id"`    // The chat identifier of the sent message
+	MessageID JSONInt64 `json:"message_id"` // A temporary message identifier
 }
 
 // MessageType return the string telegram-type of UpdateMessageSendAcknowledged
@@ -28457,11 +33837,18 @@ func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) MessageType(
 	return "updateMessageSendAcknowledged"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageSendAcknowledged wasn't created by NewUpdateMessageSendAcknowledged
+func (updateMessageSendAcknowledged UpdateMessageSendAcknowledged) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageSendAcknowledged
+	updateMessageSendAcknowledged.tdCommon.Type = "updateMessageSendAcknowledged"
+	return json.Marshal(alias(updateMessageSendAcknowledged))
+}
+
 // NewUpdateMessageSendAcknowledged creates a new UpdateMessageSendAcknowledged
 //
 // @param chatID The chat identifier of the sent message
 // @param messageID A temporary message identifier
-func NewUpdateMessageSendAcknowledged(chatID int64, messageID int64) *UpdateMessageSendAcknowledged {
+func NewUpdateMessageSendAcknowledged(chatID JSONInt64, messageID JSONInt64) *UpdateMessageSendAcknowledged {
 	updateMessageSendAcknowledgedTemp := UpdateMessageSendAcknowledged{
 		tdCommon:  tdCommon{Type: "updateMessageSendAcknowledged"},
 		ChatID:    chatID,
@@ -28479,8 +33866,8 @@ func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) GetUpdateEnu
 // UpdateMessageSendSucceeded A message has been successfully sent
 type UpdateMessageSendSucceeded struct {
 	tdCommon
-	Message      *Message `json:"message"`        // Information about the sent message. Usually only the message identifier, date, and content are changed, but almost all other fields can also change
-	OldMessageID int64    `json:"old_message_id"` // The previous temporary message identifier
+	Message      *Message  `json:"message"`        // Information about the sent message. Usually only the message identifier, date, and content are changed, but almost all other fields can also change
+	OldMessageID JSONInt64 `json:"old_message_id"` // The previous temporary message identifier
 }
 
 // MessageType return the string telegram-type of UpdateMessageSendSucceeded
@@ -28488,11 +33875,18 @@ func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) MessageType() stri
 	return "updateMessageSendSucceeded"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageSendSucceeded wasn't created by NewUpdateMessageSendSucceeded
+func (updateMessageSendSucceeded UpdateMessageSendSucceeded) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageSendSucceeded
+	updateMessageSendSucceeded.tdCommon.Type = "updateMessageSendSucceeded"
+	return json.Marshal(alias(updateMessageSendSucceeded))
+}
+
 // NewUpdateMessageSendSucceeded creates a new UpdateMessageSendSucceeded
 //
 // @param message Information about the sent message. Usually only the message identifier, date, and content are changed, but almost all other fields can also change
 // @param oldMessageID The previous temporary message identifier
-func NewUpdateMessageSendSucceeded(message *Message, oldMessageID int64) *UpdateMessageSendSucceeded {
+func NewUpdateMessageSendSucceeded(message *Message, oldMessageID JSONInt64) *UpdateMessageSendSucceeded {
 	updateMessageSendSucceededTemp := UpdateMessageSendSucceeded{
 		tdCommon:     tdCommon{Type: "updateMessageSendSucceeded"},
 		Message:      message,
@@ -28510,10 +33904,10 @@ func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) GetUpdateEnum() Up
 // UpdateMessageSendFailed A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update
 type UpdateMessageSendFailed struct {
 	tdCommon
-	Message      *Message `json:"message"`        // Contains information about the message which failed to send
-	OldMessageID int64    `json:"old_message_id"` // The previous temporary message identifier
-	ErrorCode    int32    `json:"error_code"`     // An error code
-	ErrorMessage string   `json:"error_message"`  // Error message
+	Message      *Message  `json:"message"`        // Contains information about the message which failed to send
+	OldMessageID JSONInt64 `json:"old_message_id"` // The previous temporary message identifier
+	ErrorCode    int32     `json:"error_code"`     // An error code
+	ErrorMessage string    `json:"error_message"`  // Error message
 }
 
 // MessageType return the string telegram-type of UpdateMessageSendFailed
@@ -28521,13 +33915,20 @@ func (updateMessageSendFailed *UpdateMessageSendFailed) MessageType() string {
 	return "updateMessageSendFailed"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageSendFailed wasn't created by NewUpdateMessageSendFailed
+func (updateMessageSendFailed UpdateMessageSendFailed) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageSendFailed
+	updateMessageSendFailed.tdCommon.Type = "updateMessageSendFailed"
+	return json.Marshal(alias(updateMessageSendFailed))
+}
+
 // NewUpdateMessageSendFailed creates a new UpdateMessageSendFailed
 //
 // @param message Contains information about the message which failed to send
 // @param oldMessageID The previous temporary message identifier
 // @param errorCode An error code
 // @param errorMessage Error message
-func NewUpdateMessageSendFailed(message *Message, oldMessageID int64, errorCode int32, errorMessage string) *UpdateMessageSendFailed {
+func NewUpdateMessageSendFailed(message *Message, oldMessageID JSONInt64, errorCode int32, errorMessage string) *UpdateMessageSendFailed {
 	updateMessageSendFailedTemp := UpdateMessageSendFailed{
 		tdCommon:     tdCommon{Type: "updateMessageSendFailed"},
 		Message:      message,
@@ -28547,8 +33948,8 @@ func (updateMessageSendFailed *UpdateMessageSendFailed) GetUpdateEnum() UpdateEn
 // UpdateMessageContent The message content has changed
 type UpdateMessageContent struct {
 	tdCommon
-	ChatID     int64          `json:"chat_id"`     // Chat identifier
-	MessageID  int64          `json:"message_id"`  // Message identifier
+	ChatID     JSONInt64      `json:"chat_id"`     // Chat identifier
+	MessageID  JSONInt64      `json:"message_id"`  // Message identifier
 	NewContent MessageContent `json:"new_content"` // New message content
 }
 
@@ -28557,12 +33958,19 @@ func (updateMessageContent *UpdateMessageContent) MessageType() string {
 	return "updateMessageContent"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageContent wasn't created by NewUpdateMessageContent
+func (updateMessageContent UpdateMessageContent) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageContent
+	updateMessageContent.tdCommon.Type = "updateMessageContent"
+	return json.Marshal(alias(updateMessageContent))
+}
+
 // NewUpdateMessageContent creates a new UpdateMessageContent
 //
 // @param chatID Chat identifier
 // @param messageID Message identifier
 // @param newContent New message content
-func NewUpdateMessageContent(chatID int64, messageID int64, newContent MessageContent) *UpdateMessageContent {
+func NewUpdateMessageContent(chatID JSONInt64, messageID JSONInt64, newContent MessageContent) *UpdateMessageContent {
 	updateMessageContentTemp := UpdateMessageContent{
 		tdCommon:   tdCommon{Type: "updateMessageContent"},
 		ChatID:     chatID,
@@ -28582,8 +33990,8 @@ func (updateMessageContent *UpdateMessageContent) UnmarshalJSON(b []byte) error
 	}
 	tempObj := struct {
 		tdCommon
-		ChatID    int64 `json:"chat_id"`    // Chat identifier
-		MessageID int64 `json:"message_id"` // Message identifier
+		ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+		MessageID JSONInt64 `json:"message_id"` // Message identifier
 
 	}{}
 	err = json.Unmarshal(b, &tempObj)
@@ -28609,8 +34017,8 @@ func (updateMessageContent *UpdateMessageContent) GetUpdateEnum() UpdateEnum {
 // UpdateMessageEdited A message was edited. Changes in the message content will come in a separate updateMessageContent
 type UpdateMessageEdited struct {
 	tdCommon
-	ChatID      int64       `json:"chat_id"`      // Chat identifier
-	MessageID   int64       `json:"message_id"`   // Message identifier
+	ChatID      JSONInt64   `json:"chat_id"`      // Chat identifier
+	MessageID   JSONInt64   `json:"message_id"`   // Message identifier
 	EditDate    int32       `json:"edit_date"`    // Point in time (Unix timestamp) when the message was edited
 	ReplyMarkup ReplyMarkup `json:"reply_markup"` // New message reply markup; may be null
 }
@@ -28620,13 +34028,20 @@ func (updateMessageEdited *UpdateMessageEdited) MessageType() string {
 	return "updateMessageEdited"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageEdited wasn't created by NewUpdateMessageEdited
+func (updateMessageEdited UpdateMessageEdited) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageEdited
+	updateMessageEdited.tdCommon.Type = "updateMessageEdited"
+	return json.Marshal(alias(updateMessageEdited))
+}
+
 // NewUpdateMessageEdited creates a new UpdateMessageEdited
 //
 // @param chatID Chat identifier
 // @param messageID Message identifier
 // @param editDate Point in time (Unix timestamp) when the message was edited
 // @param replyMarkup New message reply markup; may be null
-func NewUpdateMessageEdited(chatID int64, messageID int64, editDate int32, replyMarkup ReplyMarkup) *UpdateMessageEdited {
+func NewUpdateMessageEdited(chatID JSONInt64, messageID JSONInt64, editDate int32, replyMarkup ReplyMarkup) *UpdateMessageEdited {
 	updateMessageEditedTemp := UpdateMessageEdited{
 		tdCommon:    tdCommon{Type: "updateMessageEdited"},
 		ChatID:      chatID,
@@ -28647,9 +34062,9 @@ func (updateMessageEdited *UpdateMessageEdited) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		ChatID    int64 `json:"chat_id"`    // Chat identifier
-		MessageID int64 `json:"message_id"` // Message identifier
-		EditDate  int32 `json:"edit_date"`  // Point in time (Unix timestamp) when the message was edited
+		ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+		MessageID JSONInt64 `json:"message_id"` // Message identifier
+		EditDate  int32     `json:"edit_date"`  // Point in time (Unix timestamp) when the message was edited
 
 	}{}
 	err = json.Unmarshal(b, &tempObj)
@@ -28676,9 +34091,9 @@ func (updateMessageEdited *UpdateMessageEdited) GetUpdateEnum() UpdateEnum {
 // UpdateMessageIsPinned The message pinned state was changed
 type UpdateMessageIsPinned struct {
 	tdCommon
-	ChatID    int64 `json:"chat_id"`    // Chat identifier
-	MessageID int64 `json:"message_id"` // The message identifier
-	IsPinned  bool  `json:"is_pinned"`  // True, if the message is pinned
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+	MessageID JSONInt64 `json:"message_id"` // The message identifier
+	IsPinned  bool      `json:"is_pinned"`  // True, if the message is pinned
 }
 
 // MessageType return the string telegram-type of UpdateMessageIsPinned
@@ -28686,12 +34101,19 @@ func (updateMessageIsPinned *UpdateMessageIsPinned) MessageType() string {
 	return "updateMessageIsPinned"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageIsPinned wasn't created by NewUpdateMessageIsPinned
+func (updateMessageIsPinned UpdateMessageIsPinned) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageIsPinned
+	updateMessageIsPinned.tdCommon.Type = "updateMessageIsPinned"
+	return json.Marshal(alias(updateMessageIsPinned))
+}
+
 // NewUpdateMessageIsPinned creates a new UpdateMessageIsPinned
 //
 // @param chatID Chat identifier
 // @param messageID The message identifier
 // @param isPinned True, if the message is pinned
-func NewUpdateMessageIsPinned(chatID int64, messageID int64, isPinned bool) *UpdateMessageIsPinned {
+func NewUpdateMessageIsPinned(chatID JSONInt64, messageID JSONInt64, isPinned bool) *UpdateMessageIsPinned {
 	updateMessageIsPinnedTemp := UpdateMessageIsPinned{
 		tdCommon:  tdCommon{Type: "updateMessageIsPinned"},
 		ChatID:    chatID,
@@ -28710,8 +34132,8 @@ func (updateMessageIsPinned *UpdateMessageIsPinned) GetUpdateEnum() UpdateEnum {
 // UpdateMessageInteractionInfo The information about interactions with a message has changed
 type UpdateMessageInteractionInfo struct {
 	tdCommon
-	ChatID          int64                   `json:"chat_id"`          // Chat identifier
-	MessageID       int64                   `json:"message_id"`       // Message identifier
+	ChatID          JSONInt64               `json:"chat_id"`          // Chat identifier
+	MessageID       JSONInt64               `json:"message_id"`       // Message identifier
 	InteractionInfo *MessageInteractionInfo `json:"interaction_info"` // New information about interactions with the message; may be null
 }
 
@@ -28720,12 +34142,19 @@ func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) MessageType()
 	return "updateMessageInteractionInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageInteractionInfo wasn't created by NewUpdateMessageInteractionInfo
+func (updateMessageInteractionInfo UpdateMessageInteractionInfo) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageInteractionInfo
+	updateMessageInteractionInfo.tdCommon.Type = "updateMessageInteractionInfo"
+	return json.Marshal(alias(updateMessageInteractionInfo))
+}
+
 // NewUpdateMessageInteractionInfo creates a new UpdateMessageInteractionInfo
 //
 // @param chatID Chat identifier
 // @param messageID Message identifier
 // @param interactionInfo New information about interactions with the message; may be null
-func NewUpdateMessageInteractionInfo(chatID int64, messageID int64, interactionInfo *MessageInteractionInfo) *UpdateMessageInteractionInfo {
+func NewUpdateMessageInteractionInfo(chatID JSONInt64, messageID JSONInt64, interactionInfo *MessageInteractionInfo) *UpdateMessageInteractionInfo {
 	updateMessageInteractionInfoTemp := UpdateMessageInteractionInfo{
 		tdCommon:        tdCommon{Type: "updateMessageInteractionInfo"},
 		ChatID:          chatID,
@@ -28744,8 +34173,8 @@ func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) GetUpdateEnum(
 // UpdateMessageContentOpened The message content was opened. Updates voice note messages to "listened", video note messages to "viewed" and starts the TTL timer for self-destructing messages
 type UpdateMessageContentOpened struct {
 	tdCommon
-	ChatID    int64 `json:"chat_id"`    // Chat identifier
-	MessageID int64 `json:"message_id"` // Message identifier
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+	MessageID JSONInt64 `json:"message_id"` // Message identifier
 }
 
 // MessageType return the string telegram-type of UpdateMessageContentOpened
@@ -28753,11 +34182,18 @@ func (updateMessageContentOpened *UpdateMessageContentOpened) MessageType() stri
 	return "updateMessageContentOpened"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageContentOpened wasn't created by NewUpdateMessageContentOpened
+func (updateMessageContentOpened UpdateMessageContentOpened) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageContentOpened
+	updateMessageContentOpened.tdCommon.Type = "updateMessageContentOpened"
+	return json.Marshal(alias(updateMessageContentOpened))
+}
+
 // NewUpdateMessageContentOpened creates a new UpdateMessageContentOpened
 //
 // @param chatID Chat identifier
 // @param messageID Message identifier
-func NewUpdateMessageContentOpened(chatID int64, messageID int64) *UpdateMessageContentOpened {
+func NewUpdateMessageContentOpened(chatID JSONInt64, messageID JSONInt64) *UpdateMessageContentOpened {
 	updateMessageContentOpenedTemp := UpdateMessageContentOpened{
 		tdCommon:  tdCommon{Type: "updateMessageContentOpened"},
 		ChatID:    chatID,
@@ -28775,9 +34211,9 @@ func (updateMessageContentOpened *UpdateMessageContentOpened) GetUpdateEnum() Up
 // UpdateMessageMentionRead A message with an unread mention was read
 type UpdateMessageMentionRead struct {
 	tdCommon
-	ChatID             int64 `json:"chat_id"`              // Chat identifier
-	MessageID          int64 `json:"message_id"`           // Message identifier
-	UnreadMentionCount int32 `json:"unread_mention_count"` // The new number of unread mention messages left in the chat
+	ChatID             JSONInt64 `json:"chat_id"`              // Chat identifier
+	MessageID          JSONInt64 `json:"message_id"`           // Message identifier
+	UnreadMentionCount int32     `json:"unread_mention_count"` // The new number of unread mention messages left in the chat
 }
 
 // MessageType return the string telegram-type of UpdateMessageMentionRead
@@ -28785,12 +34221,19 @@ func (updateMessageMentionRead *UpdateMessageMentionRead) MessageType() string {
 	return "updateMessageMentionRead"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageMentionRead wasn't created by NewUpdateMessageMentionRead
+func (updateMessageMentionRead UpdateMessageMentionRead) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageMentionRead
+	updateMessageMentionRead.tdCommon.Type = "updateMessageMentionRead"
+	return json.Marshal(alias(updateMessageMentionRead))
+}
+
 // NewUpdateMessageMentionRead creates a new UpdateMessageMentionRead
 //
 // @param chatID Chat identifier
 // @param messageID Message identifier
 // @param unreadMentionCount The new number of unread mention messages left in the chat
-func NewUpdateMessageMentionRead(chatID int64, messageID int64, unreadMentionCount int32) *UpdateMessageMentionRead {
+func NewUpdateMessageMentionRead(chatID JSONInt64, messageID JSONInt64, unreadMentionCount int32) *UpdateMessageMentionRead {
 	updateMessageMentionReadTemp := UpdateMessageMentionRead{
 		tdCommon:           tdCommon{Type: "updateMessageMentionRead"},
 		ChatID:             chatID,
@@ -28809,8 +34252,8 @@ func (updateMessageMentionRead *UpdateMessageMentionRead) GetUpdateEnum() Update
 // UpdateMessageLiveLocationViewed A message with a live location was viewed. When the update is received, the application is supposed to update the live location
 type UpdateMessageLiveLocationViewed struct {
 	tdCommon
-	ChatID    int64 `json:"chat_id"`    // Identifier of the chat with the live location message
-	MessageID int64 `json:"message_id"` // Identifier of the message with live location
+	ChatID    JSONInt64 `json:"chat_id"`    // Identifier of the chat with the live location message
+	MessageID JSONInt64 `json:"message_id"` // Identifier of the message with live location
 }
 
 // MessageType return the string telegram-type of UpdateMessageLiveLocationViewed
@@ -28818,11 +34261,18 @@ func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) MessageT
 	return "updateMessageLiveLocationViewed"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateMessageLiveLocationViewed wasn't created by NewUpdateMessageLiveLocationViewed
+func (updateMessageLiveLocationViewed UpdateMessageLiveLocationViewed) MarshalJSON() ([]byte, error) {
+	type alias UpdateMessageLiveLocationViewed
+	updateMessageLiveLocationViewed.tdCommon.Type = "updateMessageLiveLocationViewed"
+	return json.Marshal(alias(updateMessageLiveLocationViewed))
+}
+
 // NewUpdateMessageLiveLocationViewed creates a new UpdateMessageLiveLocationViewed
 //
 // @param chatID Identifier of the chat with the live location message
 // @param messageID Identifier of the message with live location
-func NewUpdateMessageLiveLocationViewed(chatID int64, messageID int64) *UpdateMessageLiveLocationViewed {
+func NewUpdateMessageLiveLocationViewed(chatID JSONInt64, messageID JSONInt64) *UpdateMessageLiveLocationViewed {
 	updateMessageLiveLocationViewedTemp := UpdateMessageLiveLocationViewed{
 		tdCommon:  tdCommon{Type: "updateMessageLiveLocationViewed"},
 		ChatID:    chatID,
@@ -28848,6 +34298,13 @@ func (updateNewChat *UpdateNewChat) MessageType() string {
 	return "updateNewChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewChat wasn't created by NewUpdateNewChat
+func (updateNewChat UpdateNewChat) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewChat
+	updateNewChat.tdCommon.Type = "updateNewChat"
+	return json.Marshal(alias(updateNewChat))
+}
+
 // NewUpdateNewChat creates a new UpdateNewChat
 //
 // @param chat The chat
@@ -28868,8 +34325,8 @@ func (updateNewChat *UpdateNewChat) GetUpdateEnum() UpdateEnum {
 // UpdateChatTitle The title of a chat was changed
 type UpdateChatTitle struct {
 	tdCommon
-	ChatID int64  `json:"chat_id"` // Chat identifier
-	Title  string `json:"title"`   // The new chat title
+	ChatID JSONInt64 `json:"chat_id"` // Chat identifier
+	Title  string    `json:"title"`   // The new chat title
 }
 
 // MessageType return the string telegram-type of UpdateChatTitle
@@ -28877,11 +34334,18 @@ func (updateChatTitle *UpdateChatTitle) MessageType() string {
 	return "updateChatTitle"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatTitle wasn't created by NewUpdateChatTitle
+func (updateChatTitle UpdateChatTitle) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatTitle
+	updateChatTitle.tdCommon.Type = "updateChatTitle"
+	return json.Marshal(alias(updateChatTitle))
+}
+
 // NewUpdateChatTitle creates a new UpdateChatTitle
 //
 // @param chatID Chat identifier
 // @param title The new chat title
-func NewUpdateChatTitle(chatID int64, title string) *UpdateChatTitle {
+func NewUpdateChatTitle(chatID JSONInt64, title string) *UpdateChatTitle {
 	updateChatTitleTemp := UpdateChatTitle{
 		tdCommon: tdCommon{Type: "updateChatTitle"},
 		ChatID:   chatID,
@@ -28899,7 +34363,7 @@ func (updateChatTitle *UpdateChatTitle) GetUpdateEnum() UpdateEnum {
 // UpdateChatPhoto A chat photo was changed
 type UpdateChatPhoto struct {
 	tdCommon
-	ChatID int64          `json:"chat_id"` // Chat identifier
+	ChatID JSONInt64      `json:"chat_id"` // Chat identifier
 	Photo  *ChatPhotoInfo `json:"photo"`   // The new chat photo; may be null
 }
 
@@ -28908,11 +34372,18 @@ func (updateChatPhoto *UpdateChatPhoto) MessageType() string {
 	return "updateChatPhoto"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatPhoto wasn't created by NewUpdateChatPhoto
+func (updateChatPhoto UpdateChatPhoto) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatPhoto
+	updateChatPhoto.tdCommon.Type = "updateChatPhoto"
+	return json.Marshal(alias(updateChatPhoto))
+}
+
 // NewUpdateChatPhoto creates a new UpdateChatPhoto
 //
 // @param chatID Chat identifier
 // @param photo The new chat photo; may be null
-func NewUpdateChatPhoto(chatID int64, photo *ChatPhotoInfo) *UpdateChatPhoto {
+func NewUpdateChatPhoto(chatID JSONInt64, photo *ChatPhotoInfo) *UpdateChatPhoto {
 	updateChatPhotoTemp := UpdateChatPhoto{
 		tdCommon: tdCommon{Type: "updateChatPhoto"},
 		ChatID:   chatID,
@@ -28930,7 +34401,7 @@ func (updateChatPhoto *UpdateChatPhoto) GetUpdateEnum() UpdateEnum {
 // UpdateChatPermissions Chat permissions was changed
 type UpdateChatPermissions struct {
 	tdCommon
-	ChatID      int64            `json:"chat_id"`     // Chat identifier
+	ChatID      JSONInt64        `json:"chat_id"`     // Chat identifier
 	Permissions *ChatPermissions `json:"permissions"` // The new chat permissions
 }
 
@@ -28939,11 +34410,18 @@ func (updateChatPermissions *UpdateChatPermissions) MessageType() string {
 	return "updateChatPermissions"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatPermissions wasn't created by NewUpdateChatPermissions
+func (updateChatPermissions UpdateChatPermissions) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatPermissions
+	updateChatPermissions.tdCommon.Type = "updateChatPermissions"
+	return json.Marshal(alias(updateChatPermissions))
+}
+
 // NewUpdateChatPermissions creates a new UpdateChatPermissions
 //
 // @param chatID Chat identifier
 // @param permissions The new chat permissions
-func NewUpdateChatPermissions(chatID int64, permissions *ChatPermissions) *UpdateChatPermissions {
+func NewUpdateChatPermissions(chatID JSONInt64, permissions *ChatPermissions) *UpdateChatPermissions {
 	updateChatPermissionsTemp := UpdateChatPermissions{
 		tdCommon:    tdCommon{Type: "updateChatPermissions"},
 		ChatID:      chatID,
@@ -28961,7 +34439,7 @@ func (updateChatPermissions *UpdateChatPermissions) GetUpdateEnum() UpdateEnum {
 // UpdateChatLastMessage The last message of a chat was changed. If last_message is null, then the last message in the chat became unknown. Some new unknown messages might be added to the chat in this case
 type UpdateChatLastMessage struct {
 	tdCommon
-	ChatID      int64          `json:"chat_id"`      // Chat identifier
+	ChatID      JSONInt64      `json:"chat_id"`      // Chat identifier
 	LastMessage *Message       `json:"last_message"` // The new last message in the chat; may be null
 	Positions   []ChatPosition `json:"positions"`    // The new chat positions in the chat lists
 }
@@ -28971,12 +34449,19 @@ func (updateChatLastMessage *UpdateChatLastMessage) MessageType() string {
 	return "updateChatLastMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatLastMessage wasn't created by NewUpdateChatLastMessage
+func (updateChatLastMessage UpdateChatLastMessage) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatLastMessage
+	updateChatLastMessage.tdCommon.Type = "updateChatLastMessage"
+	return json.Marshal(alias(updateChatLastMessage))
+}
+
 // NewUpdateChatLastMessage creates a new UpdateChatLastMessage
 //
 // @param chatID Chat identifier
 // @param lastMessage The new last message in the chat; may be null
 // @param positions The new chat positions in the chat lists
-func NewUpdateChatLastMessage(chatID int64, lastMessage *Message, positions []ChatPosition) *UpdateChatLastMessage {
+func NewUpdateChatLastMessage(chatID JSONInt64, lastMessage *Message, positions []ChatPosition) *UpdateChatLastMessage {
 	updateChatLastMessageTemp := UpdateChatLastMessage{
 		tdCommon:    tdCommon{Type: "updateChatLastMessage"},
 		ChatID:      chatID,
@@ -28995,7 +34480,7 @@ func (updateChatLastMessage *UpdateChatLastMessage) GetUpdateEnum() UpdateEnum {
 // UpdateChatPosition The position of a chat in a chat list has changed. Instead of this update updateChatLastMessage or updateChatDraftMessage might be sent
 type UpdateChatPosition struct {
 	tdCommon
-	ChatID   int64         `json:"chat_id"`  // Chat identifier
+	ChatID   JSONInt64     `json:"chat_id"`  // Chat identifier
 	Position *ChatPosition `json:"position"` // New chat position. If new order is 0, then the chat needs to be removed from the list
 }
 
@@ -29004,11 +34489,18 @@ func (updateChatPosition *UpdateChatPosition) MessageType() string {
 	return "updateChatPosition"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatPosition wasn't created by NewUpdateChatPosition
+func (updateChatPosition UpdateChatPosition) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatPosition
+	updateChatPosition.tdCommon.Type = "updateChatPosition"
+	return json.Marshal(alias(updateChatPosition))
+}
+
 // NewUpdateChatPosition creates a new UpdateChatPosition
 //
 // @param chatID Chat identifier
 // @param position New chat position. If new order is 0, then the chat needs to be removed from the list
-func NewUpdateChatPosition(chatID int64, position *ChatPosition) *UpdateChatPosition {
+func NewUpdateChatPosition(chatID JSONInt64, position *ChatPosition) *UpdateChatPosition {
 	updateChatPositionTemp := UpdateChatPosition{
 		tdCommon: tdCommon{Type: "updateChatPosition"},
 		ChatID:   chatID,
@@ -29026,8 +34518,8 @@ func (updateChatPosition *UpdateChatPosition) GetUpdateEnum() UpdateEnum {
 // UpdateChatIsMarkedAsUnread A chat was marked as unread or was read
 type UpdateChatIsMarkedAsUnread struct {
 	tdCommon
-	ChatID           int64 `json:"chat_id"`             // Chat identifier
-	IsMarkedAsUnread bool  `json:"is_marked_as_unread"` // New value of is_marked_as_unread
+	ChatID           JSONInt64 `json:"chat_id"`             // Chat identifier
+	IsMarkedAsUnread bool      `json:"is_marked_as_unread"` // New value of is_marked_as_unread
 }
 
 // MessageType return the string telegram-type of UpdateChatIsMarkedAsUnread
@@ -29035,11 +34527,18 @@ func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) MessageType() stri
 	return "updateChatIsMarkedAsUnread"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatIsMarkedAsUnread wasn't created by NewUpdateChatIsMarkedAsUnread
+func (updateChatIsMarkedAsUnread UpdateChatIsMarkedAsUnread) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatIsMarkedAsUnread
+	updateChatIsMarkedAsUnread.tdCommon.Type = "updateChatIsMarkedAsUnread"
+	return json.Marshal(alias(updateChatIsMarkedAsUnread))
+}
+
 // NewUpdateChatIsMarkedAsUnread creates a new UpdateChatIsMarkedAsUnread
 //
 // @param chatID Chat identifier
 // @param isMarkedAsUnread New value of is_marked_as_unread
-func NewUpdateChatIsMarkedAsUnread(chatID int64, isMarkedAsUnread bool) *UpdateChatIsMarkedAsUnread {
+func NewUpdateChatIsMarkedAsUnread(chatID JSONInt64, isMarkedAsUnread bool) *UpdateChatIsMarkedAsUnread {
 	updateChatIsMarkedAsUnreadTemp := UpdateChatIsMarkedAsUnread{
 		tdCommon:         tdCommon{Type: "updateChatIsMarkedAsUnread"},
 		ChatID:           chatID,
@@ -29057,8 +34556,8 @@ func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) GetUpdateEnum() Up
 // UpdateChatIsBlocked A chat was blocked or unblocked
 type UpdateChatIsBlocked struct {
 	tdCommon
-	ChatID    int64 `json:"chat_id"`    // Chat identifier
-	IsBlocked bool  `json:"is_blocked"` // New value of is_blocked
+	ChatID    JSONInt64 `json:"chat_id"`    // Chat identifier
+	IsBlocked bool      `json:"is_blocked"` // New value of is_blocked
 }
 
 // MessageType return the string telegram-type of UpdateChatIsBlocked
@@ -29066,11 +34565,18 @@ func (updateChatIsBlocked *UpdateChatIsBlocked) MessageType() string {
 	return "updateChatIsBlocked"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatIsBlocked wasn't created by NewUpdateChatIsBlocked
+func (updateChatIsBlocked UpdateChatIsBlocked) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatIsBlocked
+	updateChatIsBlocked.tdCommon.Type = "updateChatIsBlocked"
+	return json.Marshal(alias(updateChatIsBlocked))
+}
+
 // NewUpdateChatIsBlocked creates a new UpdateChatIsBlocked
 //
 // @param chatID Chat identifier
 // @param isBlocked New value of is_blocked
-func NewUpdateChatIsBlocked(chatID int64, isBlocked bool) *UpdateChatIsBlocked {
+func NewUpdateChatIsBlocked(chatID JSONInt64, isBlocked bool) *UpdateChatIsBlocked {
 	updateChatIsBlockedTemp := UpdateChatIsBlocked{
 		tdCommon:  tdCommon{Type: "updateChatIsBlocked"},
 		ChatID:    chatID,
@@ -29088,8 +34594,8 @@ func (updateChatIsBlocked *UpdateChatIsBlocked) GetUpdateEnum() UpdateEnum {
 // UpdateChatHasScheduledMessages A chat's has_scheduled_messages field has changed
 type UpdateChatHasScheduledMessages struct {
 	tdCommon
-	ChatID               int64 `json:"chat_id"`                // Chat identifier
-	HasScheduledMessages bool  `json:"has_scheduled_messages"` // New value of has_scheduled_messages
+	ChatID               JSONInt64 `json:"chat_id"`                // Chat identifier
+	HasScheduledMessages bool      `json:"has_scheduled_messages"` // New value of has_scheduled_messages
 }
 
 // MessageType return the string telegram-type of UpdateChatHasScheduledMessages
@@ -29097,11 +34603,18 @@ func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) MessageTyp
 	return "updateChatHasScheduledMessages"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatHasScheduledMessages wasn't created by NewUpdateChatHasScheduledMessages
+func (updateChatHasScheduledMessages UpdateChatHasScheduledMessages) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatHasScheduledMessages
+	updateChatHasScheduledMessages.tdCommon.Type = "updateChatHasScheduledMessages"
+	return json.Marshal(alias(updateChatHasScheduledMessages))
+}
+
 // NewUpdateChatHasScheduledMessages creates a new UpdateChatHasScheduledMessages
 //
 // @param chatID Chat identifier
 // @param hasScheduledMessages New value of has_scheduled_messages
-func NewUpdateChatHasScheduledMessages(chatID int64, hasScheduledMessages bool) *UpdateChatHasScheduledMessages {
+func NewUpdateChatHasScheduledMessages(chatID JSONInt64, hasScheduledMessages bool) *UpdateChatHasScheduledMessages {
 	updateChatHasScheduledMessagesTemp := UpdateChatHasScheduledMessages{
 		tdCommon:             tdCommon{Type: "updateChatHasScheduledMessages"},
 		ChatID:               chatID,
@@ -29119,9 +34632,9 @@ func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) GetUpdateE
 // UpdateChatVoiceChat A chat voice chat state has changed
 type UpdateChatVoiceChat struct {
 	tdCommon
-	ChatID               int64 `json:"chat_id"`                  // Chat identifier
-	VoiceChatGroupCallID int32 `json:"voice_chat_group_call_id"` // New value of voice_chat_group_call_id
-	IsVoiceChatEmpty     bool  `json:"is_voice_chat_empty"`      // New value of is_voice_chat_empty
+	ChatID               JSONInt64 `json:"chat_id"`                  // Chat identifier
+	VoiceChatGroupCallID int32     `json:"voice_chat_group_call_id"` // New value of voice_chat_group_call_id
+	IsVoiceChatEmpty     bool      `json:"is_voice_chat_empty"`      // New value of is_voice_chat_empty
 }
 
 // MessageType return the string telegram-type of UpdateChatVoiceChat
@@ -29129,12 +34642,19 @@ func (updateChatVoiceChat *UpdateChatVoiceChat) MessageType() string {
 	return "updateChatVoiceChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatVoiceChat wasn't created by NewUpdateChatVoiceChat
+func (updateChatVoiceChat UpdateChatVoiceChat) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatVoiceChat
+	updateChatVoiceChat.tdCommon.Type = "updateChatVoiceChat"
+	return json.Marshal(alias(updateChatVoiceChat))
+}
+
 // NewUpdateChatVoiceChat creates a new UpdateChatVoiceChat
 //
 // @param chatID Chat identifier
 // @param voiceChatGroupCallID New value of voice_chat_group_call_id
 // @param isVoiceChatEmpty New value of is_voice_chat_empty
-func NewUpdateChatVoiceChat(chatID int64, voiceChatGroupCallID int32, isVoiceChatEmpty bool) *UpdateChatVoiceChat {
+func NewUpdateChatVoiceChat(chatID JSONInt64, voiceChatGroupCallID int32, isVoiceChatEmpty bool) *UpdateChatVoiceChat {
 	updateChatVoiceChatTemp := UpdateChatVoiceChat{
 		tdCommon:             tdCommon{Type: "updateChatVoiceChat"},
 		ChatID:               chatID,
@@ -29153,8 +34673,8 @@ func (updateChatVoiceChat *UpdateChatVoiceChat) GetUpdateEnum() UpdateEnum {
 // UpdateChatDefaultDisableNotification The value of the default disable_notification parameter, used when a message is sent to the chat, was changed
 type UpdateChatDefaultDisableNotification struct {
 	tdCommon
-	ChatID                     int64 `json:"chat_id"`                      // Chat identifier
-	DefaultDisableNotification bool  `json:"default_disable_notification"` // The new default_disable_notification value
+	ChatID                     JSONInt64 `json:"chat_id"`                      // Chat identifier
+	DefaultDisableNotification bool      `json:"default_disable_notification"` // The new default_disable_notification value
 }
 
 // MessageType return the string telegram-type of UpdateChatDefaultDisableNotification
@@ -29162,11 +34682,18 @@ func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification
 	return "updateChatDefaultDisableNotification"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatDefaultDisableNotification wasn't created by NewUpdateChatDefaultDisableNotification
+func (updateChatDefaultDisableNotification UpdateChatDefaultDisableNotification) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatDefaultDisableNotification
+	updateChatDefaultDisableNotification.tdCommon.Type = "updateChatDefaultDisableNotification"
+	return json.Marshal(alias(updateChatDefaultDisableNotification))
+}
+
 // NewUpdateChatDefaultDisableNotification creates a new UpdateChatDefaultDisableNotification
 //
 // @param chatID Chat identifier
 // @param defaultDisableNotification The new default_disable_notification value
-func NewUpdateChatDefaultDisableNotification(chatID int64, defaultDisableNotification bool) *UpdateChatDefaultDisableNotification {
+func NewUpdateChatDefaultDisableNotification(chatID JSONInt64, defaultDisableNotification bool) *UpdateChatDefaultDisableNotification {
 	updateChatDefaultDisableNotificationTemp := UpdateChatDefaultDisableNotification{
 		tdCommon:                   tdCommon{Type: "updateChatDefaultDisableNotification"},
 		ChatID:                     chatID,
@@ -29184,9 +34711,9 @@ func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification
 // UpdateChatReadInbox Incoming messages were read or number of unread messages has been changed
 type UpdateChatReadInbox struct {
 	tdCommon
-	ChatID                 int64 `json:"chat_id"`                    // Chat identifier
-	LastReadInboxMessageID int64 `json:"last_read_inbox_message_id"` // Identifier of the last read incoming message
-	UnreadCount            int32 `json:"unread_count"`               // The number of unread messages left in the chat
+	ChatID                 JSONInt64 `json:"chat_id"`                    // Chat identifier
+	LastReadInboxMessageID JSONInt64 `json:"last_read_inbox_message_id"` // Identifier of the last read incoming message
+	UnreadCount            int32     `json:"unread_count"`               // The number of unread messages left in the chat
 }
 
 // MessageType return the string telegram-type of UpdateChatReadInbox
@@ -29194,12 +34721,19 @@ func (updateChatReadInbox *UpdateChatReadInbox) MessageType() string {
 	return "updateChatReadInbox"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatReadInbox wasn't created by NewUpdateChatReadInbox
+func (updateChatReadInbox UpdateChatReadInbox) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatReadInbox
+	updateChatReadInbox.tdCommon.Type = "updateChatReadInbox"
+	return json.Marshal(alias(updateChatReadInbox))
+}
+
 // NewUpdateChatReadInbox creates a new UpdateChatReadInbox
 //
 // @param chatID Chat identifier
 // @param lastReadInboxMessageID Identifier of the last read incoming message
 // @param unreadCount The number of unread messages left in the chat
-func NewUpdateChatReadInbox(chatID int64, lastReadInboxMessageID int64, unreadCount int32) *UpdateChatReadInbox {
+func NewUpdateChatReadInbox(chatID JSONInt64, lastReadInboxMessageID JSONInt64, unreadCount int32) *UpdateChatReadInbox {
 	updateChatReadInboxTemp := UpdateChatReadInbox{
 		tdCommon:               tdCommon{Type: "updateChatReadInbox"},
 		ChatID:                 chatID,
@@ -29218,8 +34752,8 @@ func (updateChatReadInbox *UpdateChatReadInbox) GetUpdateEnum() UpdateEnum {
 // UpdateChatReadOutbox Outgoing messages were read
 type UpdateChatReadOutbox struct {
 	tdCommon
-	ChatID                  int64 `json:"chat_id"`                     // Chat identifier
-	LastReadOutboxMessageID int64 `json:"last_read_outbox_message_id"` // Identifier of last read outgoing message
+	ChatID                  JSONInt64 `json:"chat_id"`                     // Chat identifier
+	LastReadOutboxMessageID JSONInt64 `json:"last_read_outbox_message_id"` // Identifier of last read outgoing message
 }
 
 // MessageType return the string telegram-type of UpdateChatReadOutbox
@@ -29227,11 +34761,18 @@ func (updateChatReadOutbox *UpdateChatReadOutbox) MessageType() string {
 	return "updateChatReadOutbox"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatReadOutbox wasn't created by NewUpdateChatReadOutbox
+func (updateChatReadOutbox UpdateChatReadOutbox) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatReadOutbox
+	updateChatReadOutbox.tdCommon.Type = "updateChatReadOutbox"
+	return json.Marshal(alias(updateChatReadOutbox))
+}
+
 // NewUpdateChatReadOutbox creates a new UpdateChatReadOutbox
 //
 // @param chatID Chat identifier
 // @param lastReadOutboxMessageID Identifier of last read outgoing message
-func NewUpdateChatReadOutbox(chatID int64, lastReadOutboxMessageID int64) *UpdateChatReadOutbox {
+func NewUpdateChatReadOutbox(chatID JSONInt64, lastReadOutboxMessageID JSONInt64) *UpdateChatReadOutbox {
 	updateChatReadOutboxTemp := UpdateChatReadOutbox{
 		tdCommon:                tdCommon{Type: "updateChatReadOutbox"},
 		ChatID:                  chatID,
@@ -29249,8 +34790,8 @@ func (updateChatReadOutbox *UpdateChatReadOutbox) GetUpdateEnum() UpdateEnum {
 // UpdateChatUnreadMentionCount The chat unread_mention_count has changed
 type UpdateChatUnreadMentionCount struct {
 	tdCommon
-	ChatID             int64 `json:"chat_id"`              // Chat identifier
-	UnreadMentionCount int32 `json:"unread_mention_count"` // The number of unread mention messages left in the chat
+	ChatID             JSONInt64 `json:"chat_id"`              // Chat identifier
+	UnreadMentionCount int32     `json:"unread_mention_count"` // The number of unread mention messages left in the chat
 }
 
 // MessageType return the string telegram-type of UpdateChatUnreadMentionCount
@@ -29258,11 +34799,18 @@ func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) MessageType()
 	return "updateChatUnreadMentionCount"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatUnreadMentionCount wasn't created by NewUpdateChatUnreadMentionCount
+func (updateChatUnreadMentionCount UpdateChatUnreadMentionCount) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatUnreadMentionCount
+	updateChatUnreadMentionCount.tdCommon.Type = "updateChatUnreadMentionCount"
+	return json.Marshal(alias(updateChatUnreadMentionCount))
+}
+
 // NewUpdateChatUnreadMentionCount creates a new UpdateChatUnreadMentionCount
 //
 // @param chatID Chat identifier
 // @param unreadMentionCount The number of unread mention messages left in the chat
-func NewUpdateChatUnreadMentionCount(chatID int64, unreadMentionCount int32) *UpdateChatUnreadMentionCount {
+func NewUpdateChatUnreadMentionCount(chatID JSONInt64, unreadMentionCount int32) *UpdateChatUnreadMentionCount {
 	updateChatUnreadMentionCountTemp := UpdateChatUnreadMentionCount{
 		tdCommon:           tdCommon{Type: "updateChatUnreadMentionCount"},
 		ChatID:             chatID,
@@ -29280,7 +34828,7 @@ func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) GetUpdateEnum(
 // UpdateChatNotificationSettings Notification settings for a chat were changed
 type UpdateChatNotificationSettings struct {
 	tdCommon
-	ChatID               int64                     `json:"chat_id"`               // Chat identifier
+	ChatID               JSONInt64                 `json:"chat_id"`               // Chat identifier
 	NotificationSettings *ChatNotificationSettings `json:"notification_settings"` // The new notification settings
 }
 
@@ -29289,11 +34837,18 @@ func (updateChatNotificationSettings *UpdateChatNotificationSettings) MessageTyp
 	return "updateChatNotificationSettings"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatNotificationSettings wasn't created by NewUpdateChatNotificationSettings
+func (updateChatNotificationSettings UpdateChatNotificationSettings) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatNotificationSettings
+	updateChatNotificationSettings.tdCommon.Type = "updateChatNotificationSettings"
+	return json.Marshal(alias(updateChatNotificationSettings))
+}
+
 // NewUpdateChatNotificationSettings creates a new UpdateChatNotificationSettings
 //
 // @param chatID Chat identifier
 // @param notificationSettings The new notification settings
-func NewUpdateChatNotificationSettings(chatID int64, notificationSettings *ChatNotificationSettings) *UpdateChatNotificationSettings {
+func NewUpdateChatNotificationSettings(chatID JSONInt64, notificationSettings *ChatNotificationSettings) *UpdateChatNotificationSettings {
 	updateChatNotificationSettingsTemp := UpdateChatNotificationSettings{
 		tdCommon:             tdCommon{Type: "updateChatNotificationSettings"},
 		ChatID:               chatID,
@@ -29320,6 +34875,13 @@ func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) MessageT
 	return "updateScopeNotificationSettings"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateScopeNotificationSettings wasn't created by NewUpdateScopeNotificationSettings
+func (updateScopeNotificationSettings UpdateScopeNotificationSettings) MarshalJSON() ([]byte, error) {
+	type alias UpdateScopeNotificationSettings
+	updateScopeNotificationSettings.tdCommon.Type = "updateScopeNotificationSettings"
+	return json.Marshal(alias(updateScopeNotificationSettings))
+}
+
 // NewUpdateScopeNotificationSettings creates a new UpdateScopeNotificationSettings
 //
 // @param scope Types of chats for which notification settings were updated
@@ -29367,7 +34929,7 @@ func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) GetUpdat
 // UpdateChatActionBar The chat action bar was changed
 type UpdateChatActionBar struct {
 	tdCommon
-	ChatID    int64         `json:"chat_id"`    // Chat identifier
+	ChatID    JSONInt64     `json:"chat_id"`    // Chat identifier
 	ActionBar ChatActionBar `json:"action_bar"` // The new value of the action bar; may be null
 }
 
@@ -29376,11 +34938,18 @@ func (updateChatActionBar *UpdateChatActionBar) MessageType() string {
 	return "updateChatActionBar"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatActionBar wasn't created by NewUpdateChatActionBar
+func (updateChatActionBar UpdateChatActionBar) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatActionBar
+	updateChatActionBar.tdCommon.Type = "updateChatActionBar"
+	return json.Marshal(alias(updateChatActionBar))
+}
+
 // NewUpdateChatActionBar creates a new UpdateChatActionBar
 //
 // @param chatID Chat identifier
 // @param actionBar The new value of the action bar; may be null
-func NewUpdateChatActionBar(chatID int64, actionBar ChatActionBar) *UpdateChatActionBar {
+func NewUpdateChatActionBar(chatID JSONInt64, actionBar ChatActionBar) *UpdateChatActionBar {
 	updateChatActionBarTemp := UpdateChatActionBar{
 		tdCommon:  tdCommon{Type: "updateChatActionBar"},
 		ChatID:    chatID,
@@ -29399,7 +34968,7 @@ func (updateChatActionBar *UpdateChatActionBar) UnmarshalJSON(b []byte) error {
 	}
 	tempObj := struct {
 		tdCommon
-		ChatID int64 `json:"chat_id"` // Chat identifier
+		ChatID JSONInt64 `json:"chat_id"` // Chat identifier
 
 	}{}
 	err = json.Unmarshal(b, &tempObj)
@@ -29424,8 +34993,8 @@ func (updateChatActionBar *UpdateChatActionBar) GetUpdateEnum() UpdateEnum {
 // UpdateChatReplyMarkup The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user
 type UpdateChatReplyMarkup struct {
 	tdCommon
-	ChatID               int64 `json:"chat_id"`                 // Chat identifier
-	ReplyMarkupMessageID int64 `json:"reply_markup_message_id"` // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
+	ChatID               JSONInt64 `json:"chat_id"`                 // Chat identifier
+	ReplyMarkupMessageID JSONInt64 `json:"reply_markup_message_id"` // Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
 }
 
 // MessageType return the string telegram-type of UpdateChatReplyMarkup
@@ -29433,11 +35002,18 @@ func (updateChatReplyMarkup *UpdateChatReplyMarkup) MessageType() string {
 	return "updateChatReplyMarkup"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatReplyMarkup wasn't created by NewUpdateChatReplyMarkup
+func (updateChatReplyMarkup UpdateChatReplyMarkup) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatReplyMarkup
+	updateChatReplyMarkup.tdCommon.Type = "updateChatReplyMarkup"
+	return json.Marshal(alias(updateChatReplyMarkup))
+}
+
 // NewUpdateChatReplyMarkup creates a new UpdateChatReplyMarkup
 //
 // @param chatID Chat identifier
 // @param replyMarkupMessageID Identifier of the message from which reply markup needs to be used; 0 if there is no default custom reply markup in the chat
-func NewUpdateChatReplyMarkup(chatID int64, replyMarkupMessageID int64) *UpdateChatReplyMarkup {
+func NewUpdateChatReplyMarkup(chatID JSONInt64, replyMarkupMessageID JSONInt64) *UpdateChatReplyMarkup {
 	updateChatReplyMarkupTemp := UpdateChatReplyMarkup{
 		tdCommon:             tdCommon{Type: "updateChatReplyMarkup"},
 		ChatID:               chatID,
@@ -29455,7 +35031,7 @@ func (updateChatReplyMarkup *UpdateChatReplyMarkup) GetUpdateEnum() UpdateEnum {
 // UpdateChatDraftMessage A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update shouldn't be applied
 type UpdateChatDraftMessage struct {
 	tdCommon
-	ChatID       int64          `json:"chat_id"`       // Chat identifier
+	ChatID       JSONInt64      `json:"chat_id"`       // Chat identifier
 	DraftMessage *DraftMessage  `json:"draft_message"` // The new draft message; may be null
 	Positions    []ChatPosition `json:"positions"`     // The new chat positions in the chat lists
 }
@@ -29465,12 +35041,19 @@ func (updateChatDraftMessage *UpdateChatDraftMessage) MessageType() string {
 	return "updateChatDraftMessage"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatDraftMessage wasn't created by NewUpdateChatDraftMessage
+func (updateChatDraftMessage UpdateChatDraftMessage) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatDraftMessage
+	updateChatDraftMessage.tdCommon.Type = "updateChatDraftMessage"
+	return json.Marshal(alias(updateChatDraftMessage))
+}
+
 // NewUpdateChatDraftMessage creates a new UpdateChatDraftMessage
 //
 // @param chatID Chat identifier
 // @param draftMessage The new draft message; may be null
 // @param positions The new chat positions in the chat lists
-func NewUpdateChatDraftMessage(chatID int64, draftMessage *DraftMessage, positions []ChatPosition) *UpdateChatDraftMessage {
+func NewUpdateChatDraftMessage(chatID JSONInt64, draftMessage *DraftMessage, positions []ChatPosition) *UpdateChatDraftMessage {
 	updateChatDraftMessageTemp := UpdateChatDraftMessage{
 		tdCommon:     tdCommon{Type: "updateChatDraftMessage"},
 		ChatID:       chatID,
@@ -29497,6 +35080,13 @@ func (updateChatFilters *UpdateChatFilters) MessageType() string {
 	return "updateChatFilters"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatFilters wasn't created by NewUpdateChatFilters
+func (updateChatFilters UpdateChatFilters) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatFilters
+	updateChatFilters.tdCommon.Type = "updateChatFilters"
+	return json.Marshal(alias(updateChatFilters))
+}
+
 // NewUpdateChatFilters creates a new UpdateChatFilters
 //
 // @param chatFilters The new list of chat filters
@@ -29517,8 +35107,8 @@ func (updateChatFilters *UpdateChatFilters) GetUpdateEnum() UpdateEnum {
 // UpdateChatOnlineMemberCount The number of online group members has changed. This update with non-zero count is sent only for currently opened chats. There is no guarantee that it will be sent just after the count has changed
 type UpdateChatOnlineMemberCount struct {
 	tdCommon
-	ChatID            int64 `json:"chat_id"`             // Identifier of the chat
-	OnlineMemberCount int32 `json:"online_member_count"` // New number of online members in the chat, or 0 if unknown
+	ChatID            JSONInt64 `json:"chat_id"`             // Identifier of the chat
+	OnlineMemberCount int32     `json:"online_member_count"` // New number of online members in the chat, or 0 if unknown
 }
 
 // MessageType return the string telegram-type of UpdateChatOnlineMemberCount
@@ -29526,11 +35116,18 @@ func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) MessageType() st
 	return "updateChatOnlineMemberCount"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateChatOnlineMemberCount wasn't created by NewUpdateChatOnlineMemberCount
+func (updateChatOnlineMemberCount UpdateChatOnlineMemberCount) MarshalJSON() ([]byte, error) {
+	type alias UpdateChatOnlineMemberCount
+	updateChatOnlineMemberCount.tdCommon.Type = "updateChatOnlineMemberCount"
+	return json.Marshal(alias(updateChatOnlineMemberCount))
+}
+
 // NewUpdateChatOnlineMemberCount creates a new UpdateChatOnlineMemberCount
 //
 // @param chatID Identifier of the chat
 // @param onlineMemberCount New number of online members in the chat, or 0 if unknown
-func NewUpdateChatOnlineMemberCount(chatID int64, onlineMemberCount int32) *UpdateChatOnlineMemberCount {
+func NewUpdateChatOnlineMemberCount(chatID JSONInt64, onlineMemberCount int32) *UpdateChatOnlineMemberCount {
 	updateChatOnlineMemberCountTemp := UpdateChatOnlineMemberCount{
 		tdCommon:          tdCommon{Type: "updateChatOnlineMemberCount"},
 		ChatID:            chatID,
@@ -29557,6 +35154,13 @@ func (updateNotification *UpdateNotification) MessageType() string {
 	return "updateNotification"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNotification wasn't created by NewUpdateNotification
+func (updateNotification UpdateNotification) MarshalJSON() ([]byte, error) {
+	type alias UpdateNotification
+	updateNotification.tdCommon.Type = "updateNotification"
+	return json.Marshal(alias(updateNotification))
+}
+
 // NewUpdateNotification creates a new UpdateNotification
 //
 // @param notificationGroupID Unique notification group identifier
@@ -29581,8 +35185,8 @@ type UpdateNotificationGroup struct {
 	tdCommon
 	NotificationGroupID        int32                 `json:"notification_group_id"`         // Unique notification group identifier
 	Type                       NotificationGroupType `json:"type"`                          // New type of the notification group
-	ChatID                     int64                 `json:"chat_id"`                       // Identifier of a chat to which all notifications in the group belong
-	NotificationSettingsChatID int64                 `json:"notification_settings_chat_id"` // Chat identifier, which notification settings must be applied to the added notifications
+	ChatID                     JSONInt64             `json:"chat_id"`                       // Identifier of a chat to which all notifications in the group belong
+	NotificationSettingsChatID JSONInt64             `json:"notification_settings_chat_id"` // Chat identifier, which notification settings must be applied to the added notifications
 	IsSilent                   bool                  `json:"is_silent"`                     // True, if the notifications should be shown without sound
 	TotalCount                 int32                 `json:"total_count"`                   // Total number of unread notifications in the group, can be bigger than number of active notifications
 	AddedNotifications         []Notification        `json:"added_notifications"`           // List of added group notifications, sorted by notification ID
@@ -29594,6 +35198,13 @@ func (updateNotificationGroup *UpdateNotificationGroup) MessageType() string {
 	return "updateNotificationGroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNotificationGroup wasn't created by NewUpdateNotificationGroup
+func (updateNotificationGroup UpdateNotificationGroup) MarshalJSON() ([]byte, error) {
+	type alias UpdateNotificationGroup
+	updateNotificationGroup.tdCommon.Type = "updateNotificationGroup"
+	return json.Marshal(alias(updateNotificationGroup))
+}
+
 // NewUpdateNotificationGroup creates a new UpdateNotificationGroup
 //
 // @param notificationGroupID Unique notification group identifier
@@ -29604,7 +35215,7 @@ func (updateNotificationGroup *UpdateNotificationGroup) MessageType() string {
 // @param totalCount Total number of unread notifications in the group, can be bigger than number of active notifications
 // @param addedNotifications List of added group notifications, sorted by notification ID
 // @param removedNotificationIDs Identifiers of removed group notifications, sorted by notification ID
-func NewUpdateNotificationGroup(notificationGroupID int32, typeParam NotificationGroupType, chatID int64, notificationSettingsChatID int64, isSilent bool, totalCount int32, addedNotifications []Notification, removedNotificationIDs []int32) *UpdateNotificationGroup {
+func NewUpdateNotificationGroup(notificationGroupID int32, typeParam NotificationGroupType, chatID JSONInt64, notificationSettingsChatID JSONInt64, isSilent bool, totalCount int32, addedNotifications []Notification, removedNotificationIDs []int32) *UpdateNotificationGroup {
 	updateNotificationGroupTemp := UpdateNotificationGroup{
 		tdCommon:                   tdCommon{Type: "updateNotificationGroup"},
 		NotificationGroupID:        notificationGroupID,
@@ -29630,8 +35241,8 @@ func (updateNotificationGroup *UpdateNotificationGroup) UnmarshalJSON(b []byte)
 	tempObj := struct {
 		tdCommon
 		NotificationGroupID        int32          `json:"notification_group_id"`         // Unique notification group identifier
-		ChatID                     int64          `json:"chat_id"`                       // Identifier of a chat to which all notifications in the group belong
-		NotificationSettingsChatID int64          `json:"notification_settings_chat_id"` // Chat identifier, which notification settings must be applied to the added notifications
+		ChatID                     JSONInt64      `json:"chat_id"`                       // Identifier of a chat to which all notifications in the group belong
+		NotificationSettingsChatID JSONInt64      `json:"notification_settings_chat_id"` // Chat identifier, which notification settings must be applied to the added notifications
 		IsSilent                   bool           `json:"is_silent"`                     // True, if the notifications should be shown without sound
 		TotalCount                 int32          `json:"total_count"`                   // Total number of unread notifications in the group, can be bigger than number of active notifications
 		AddedNotifications         []Notification `json:"added_notifications"`           // List of added group notifications, sorted by notification ID
@@ -29673,6 +35284,13 @@ func (updateActiveNotifications *UpdateActiveNotifications) MessageType() string
 	return "updateActiveNotifications"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateActiveNotifications wasn't created by NewUpdateActiveNotifications
+func (updateActiveNotifications UpdateActiveNotifications) MarshalJSON() ([]byte, error) {
+	type alias UpdateActiveNotifications
+	updateActiveNotifications.tdCommon.Type = "updateActiveNotifications"
+	return json.Marshal(alias(updateActiveNotifications))
+}
+
 // NewUpdateActiveNotifications creates a new UpdateActiveNotifications
 //
 // @param groups Lists of active notification groups
@@ -29702,6 +35320,13 @@ func (updateHavePendingNotifications *UpdateHavePendingNotifications) MessageTyp
 	return "updateHavePendingNotifications"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateHavePendingNotifications wasn't created by NewUpdateHavePendingNotifications
+func (updateHavePendingNotifications UpdateHavePendingNotifications) MarshalJSON() ([]byte, error) {
+	type alias UpdateHavePendingNotifications
+	updateHavePendingNotifications.tdCommon.Type = "updateHavePendingNotifications"
+	return json.Marshal(alias(updateHavePendingNotifications))
+}
+
 // NewUpdateHavePendingNotifications creates a new UpdateHavePendingNotifications
 //
 // @param haveDelayedNotifications True, if there are some delayed notification updates, which will be sent soon
@@ -29724,10 +35349,10 @@ func (updateHavePendingNotifications *UpdateHavePendingNotifications) GetUpdateE
 // UpdateDeleteMessages Some messages were deleted
 type UpdateDeleteMessages struct {
 	tdCommon
-	ChatID      int64   `json:"chat_id"`      // Chat identifier
-	MessageIDs  []int64 `json:"message_ids"`  // Identifiers of the deleted messages
-	IsPermanent bool    `json:"is_permanent"` // True, if the messages are permanently deleted by a user (as opposed to just becoming inaccessible)
-	FromCache   bool    `json:"from_cache"`   // True, if the messages are deleted only from the cache and can possibly be retrieved again in the future
+	ChatID      JSONInt64   `json:"chat_id"`      // Chat identifier
+	MessageIDs  []JSONInt64 `json:"message_ids"`  // Identifiers of the deleted messages
+	IsPermanent bool        `json:"is_permanent"` // True, if the messages are permanently deleted by a user (as opposed to just becoming inaccessible)
+	FromCache   bool        `json:"from_cache"`   // True, if the messages are deleted only from the cache and can possibly be retrieved again in the future
 }
 
 // MessageType return the string telegram-type of UpdateDeleteMessages
@@ -29735,13 +35360,20 @@ func (updateDeleteMessages *UpdateDeleteMessages) MessageType() string {
 	return "updateDeleteMessages"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateDeleteMessages wasn't created by NewUpdateDeleteMessages
+func (updateDeleteMessages UpdateDeleteMessages) MarshalJSON() ([]byte, error) {
+	type alias UpdateDeleteMessages
+	updateDeleteMessages.tdCommon.Type = "updateDeleteMessages"
+	return json.Marshal(alias(updateDeleteMessages))
+}
+
 // NewUpdateDeleteMessages creates a new UpdateDeleteMessages
 //
 // @param chatID Chat identifier
 // @param messageIDs Identifiers of the deleted messages
 // @param isPermanent True, if the messages are permanently deleted by a user (as opposed to just becoming inaccessible)
 // @param fromCache True, if the messages are deleted only from the cache and can possibly be retrieved again in the future
-func NewUpdateDeleteMessages(chatID int64, messageIDs []int64, isPermanent bool, fromCache bool) *UpdateDeleteMessages {
+func NewUpdateDeleteMessages(chatID JSONInt64, messageIDs []JSONInt64, isPermanent bool, fromCache bool) *UpdateDeleteMessages {
 	updateDeleteMessagesTemp := UpdateDeleteMessages{
 		tdCommon:    tdCommon{Type: "updateDeleteMessages"},
 		ChatID:      chatID,
@@ -29761,8 +35393,8 @@ func (updateDeleteMessages *UpdateDeleteMessages) GetUpdateEnum() UpdateEnum {
 // UpdateUserChatAction User activity in the chat has changed
 type UpdateUserChatAction struct {
 	tdCommon
-	ChatID          int64      `json:"chat_id"`           // Chat identifier
-	MessageThreadID int64      `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
+	ChatID          JSONInt64  `json:"chat_id"`           // Chat identifier
+	MessageThreadID JSONInt64  `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
 	UserID          int32      `json:"user_id"`           // Identifier of a user performing an action
 	Action          ChatAction `json:"action"`            // The action description
 }
@@ -29772,13 +35404,20 @@ func (updateUserChatAction *UpdateUserChatAction) MessageType() string {
 	return "updateUserChatAction"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUserChatAction wasn't created by NewUpdateUserChatAction
+func (updateUserChatAction UpdateUserChatAction) MarshalJSON() ([]byte, error) {
+	type alias UpdateUserChatAction
+	updateUserChatAction.tdCommon.Type = "updateUserChatAction"
+	return json.Marshal(alias(updateUserChatAction))
+}
+
 // NewUpdateUserChatAction creates a new UpdateUserChatAction
 //
 // @param chatID Chat identifier
 // @param messageThreadID If not 0, a message thread identifier in which the action was performed
 // @param userID Identifier of a user performing an action
 // @param action The action description
-func NewUpdateUserChatAction(chatID int64, messageThreadID int64, userID int32, action ChatAction) *UpdateUserChatAction {
+func NewUpdateUserChatAction(chatID JSONInt64, messageThreadID JSONInt64, userID int32, action ChatAction) *UpdateUserChatAction {
 	updateUserChatActionTemp := UpdateUserChatAction{
 		tdCommon:        tdCommon{Type: "updateUserChatAction"},
 		ChatID:          chatID,
@@ -29799,9 +35438,9 @@ func (updateUserChatAction *UpdateUserChatAction) UnmarshalJSON(b []byte) error
 	}
 	tempObj := struct {
 		tdCommon
-		ChatID          int64 `json:"chat_id"`           // Chat identifier
-		MessageThreadID int64 `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
-		UserID          int32 `json:"user_id"`           // Identifier of a user performing an action
+		ChatID          JSONInt64 `json:"chat_id"`           // Chat identifier
+		MessageThreadID JSONInt64 `json:"message_thread_id"` // If not 0, a message thread identifier in which the action was performed
+		UserID          int32     `json:"user_id"`           // Identifier of a user performing an action
 
 	}{}
 	err = json.Unmarshal(b, &tempObj)
@@ -29837,6 +35476,13 @@ func (updateUserStatus *UpdateUserStatus) MessageType() string {
 	return "updateUserStatus"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUserStatus wasn't created by NewUpdateUserStatus
+func (updateUserStatus UpdateUserStatus) MarshalJSON() ([]byte, error) {
+	type alias UpdateUserStatus
+	updateUserStatus.tdCommon.Type = "updateUserStatus"
+	return json.Marshal(alias(updateUserStatus))
+}
+
 // NewUpdateUserStatus creates a new UpdateUserStatus
 //
 // @param userID User identifier
@@ -29893,6 +35539,13 @@ func (updateUser *UpdateUser) MessageType() string {
 	return "updateUser"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUser wasn't created by NewUpdateUser
+func (updateUser UpdateUser) MarshalJSON() ([]byte, error) {
+	type alias UpdateUser
+	updateUser.tdCommon.Type = "updateUser"
+	return json.Marshal(alias(updateUser))
+}
+
 // NewUpdateUser creates a new UpdateUser
 //
 // @param user New data about the user
@@ -29921,6 +35574,13 @@ func (updateBasicGroup *UpdateBasicGroup) MessageType() string {
 	return "updateBasicGroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateBasicGroup wasn't created by NewUpdateBasicGroup
+func (updateBasicGroup UpdateBasicGroup) MarshalJSON() ([]byte, error) {
+	type alias UpdateBasicGroup
+	updateBasicGroup.tdCommon.Type = "updateBasicGroup"
+	return json.Marshal(alias(updateBasicGroup))
+}
+
 // NewUpdateBasicGroup creates a new UpdateBasicGroup
 //
 // @param basicGroup New data about the group
@@ -29949,6 +35609,13 @@ func (updateSupergroup *UpdateSupergroup) MessageType() string {
 	return "updateSupergroup"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateSupergroup wasn't created by NewUpdateSupergroup
+func (updateSupergroup UpdateSupergroup) MarshalJSON() ([]byte, error) {
+	type alias UpdateSupergroup
+	updateSupergroup.tdCommon.Type = "updateSupergroup"
+	return json.Marshal(alias(updateSupergroup))
+}
+
 // NewUpdateSupergroup creates a new UpdateSupergroup
 //
 // @param supergroup New data about the supergroup
@@ -29977,6 +35644,13 @@ func (updateSecretChat *UpdateSecretChat) MessageType() string {
 	return "updateSecretChat"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateSecretChat wasn't created by NewUpdateSecretChat
+func (updateSecretChat UpdateSecretChat) MarshalJSON() ([]byte, error) {
+	type alias UpdateSecretChat
+	updateSecretChat.tdCommon.Type = "updateSecretChat"
+	return json.Marshal(alias(updateSecretChat))
+}
+
 // NewUpdateSecretChat creates a new UpdateSecretChat
 //
 // @param secretChat New data about the secret chat
@@ -30006,6 +35680,13 @@ func (updateUserFullInfo *UpdateUserFullInfo) MessageType() string {
 	return "updateUserFullInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUserFullInfo wasn't created by NewUpdateUserFullInfo
+func (updateUserFullInfo UpdateUserFullInfo) MarshalJSON() ([]byte, error) {
+	type alias UpdateUserFullInfo
+	updateUserFullInfo.tdCommon.Type = "updateUserFullInfo"
+	return json.Marshal(alias(updateUserFullInfo))
+}
+
 // NewUpdateUserFullInfo creates a new UpdateUserFullInfo
 //
 // @param userID User identifier
@@ -30037,6 +35718,13 @@ func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) MessageType() string {
 	return "updateBasicGroupFullInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateBasicGroupFullInfo wasn't created by NewUpdateBasicGroupFullInfo
+func (updateBasicGroupFullInfo UpdateBasicGroupFullInfo) MarshalJSON() ([]byte, error) {
+	type alias UpdateBasicGroupFullInfo
+	updateBasicGroupFullInfo.tdCommon.Type = "updateBasicGroupFullInfo"
+	return json.Marshal(alias(updateBasicGroupFullInfo))
+}
+
 // NewUpdateBasicGroupFullInfo creates a new UpdateBasicGroupFullInfo
 //
 // @param basicGroupID Identifier of a basic group
@@ -30068,6 +35756,13 @@ func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) MessageType() string {
 	return "updateSupergroupFullInfo"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateSupergroupFullInfo wasn't created by NewUpdateSupergroupFullInfo
+func (updateSupergroupFullInfo UpdateSupergroupFullInfo) MarshalJSON() ([]byte, error) {
+	type alias UpdateSupergroupFullInfo
+	updateSupergroupFullInfo.tdCommon.Type = "updateSupergroupFullInfo"
+	return json.Marshal(alias(updateSupergroupFullInfo))
+}
+
 // NewUpdateSupergroupFullInfo creates a new UpdateSupergroupFullInfo
 //
 // @param supergroupID Identifier of the supergroup or channel
@@ -30099,6 +35794,13 @@ func (updateServiceNotification *UpdateServiceNotification) MessageType() string
 	return "updateServiceNotification"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateServiceNotification wasn't created by NewUpdateServiceNotification
+func (updateServiceNotification UpdateServiceNotification) MarshalJSON() ([]byte, error) {
+	type alias UpdateServiceNotification
+	updateServiceNotification.tdCommon.Type = "updateServiceNotification"
+	return json.Marshal(alias(updateServiceNotification))
+}
+
 // NewUpdateServiceNotification creates a new UpdateServiceNotification
 //
 // @param typeParam Notification type. If type begins with "AUTH_KEY_DROP_", then two buttons "Cancel" and "Log out" should be shown under notification; if user presses the second, all local data should be destroyed using Destroy method
@@ -30155,6 +35857,13 @@ func (updateFile *UpdateFile) MessageType() string {
 	return "updateFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateFile wasn't created by NewUpdateFile
+func (updateFile UpdateFile) MarshalJSON() ([]byte, error) {
+	type alias UpdateFile
+	updateFile.tdCommon.Type = "updateFile"
+	return json.Marshal(alias(updateFile))
+}
+
 // NewUpdateFile creates a new UpdateFile
 //
 // @param file New data about the file
@@ -30186,6 +35895,13 @@ func (updateFileGenerationStart *UpdateFileGenerationStart) MessageType() string
 	return "updateFileGenerationStart"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateFileGenerationStart wasn't created by NewUpdateFileGenerationStart
+func (updateFileGenerationStart UpdateFileGenerationStart) MarshalJSON() ([]byte, error) {
+	type alias UpdateFileGenerationStart
+	updateFileGenerationStart.tdCommon.Type = "updateFileGenerationStart"
+	return json.Marshal(alias(updateFileGenerationStart))
+}
+
 // NewUpdateFileGenerationStart creates a new UpdateFileGenerationStart
 //
 // @param generationID Unique identifier for the generation process
@@ -30220,6 +35936,13 @@ func (updateFileGenerationStop *UpdateFileGenerationStop) MessageType() string {
 	return "updateFileGenerationStop"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateFileGenerationStop wasn't created by NewUpdateFileGenerationStop
+func (updateFileGenerationStop UpdateFileGenerationStop) MarshalJSON() ([]byte, error) {
+	type alias UpdateFileGenerationStop
+	updateFileGenerationStop.tdCommon.Type = "updateFileGenerationStop"
+	return json.Marshal(alias(updateFileGenerationStop))
+}
+
 // NewUpdateFileGenerationStop creates a new UpdateFileGenerationStop
 //
 // @param generationID Unique identifier for the generation process
@@ -30248,6 +35971,13 @@ func (updateCall *UpdateCall) MessageType() string {
 	return "updateCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateCall wasn't created by NewUpdateCall
+func (updateCall UpdateCall) MarshalJSON() ([]byte, error) {
+	type alias UpdateCall
+	updateCall.tdCommon.Type = "updateCall"
+	return json.Marshal(alias(updateCall))
+}
+
 // NewUpdateCall creates a new UpdateCall
 //
 // @param call New data about a call
@@ -30276,6 +36006,13 @@ func (updateGroupCall *UpdateGroupCall) MessageType() string {
 	return "updateGroupCall"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateGroupCall wasn't created by NewUpdateGroupCall
+func (updateGroupCall UpdateGroupCall) MarshalJSON() ([]byte, error) {
+	type alias UpdateGroupCall
+	updateGroupCall.tdCommon.Type = "updateGroupCall"
+	return json.Marshal(alias(updateGroupCall))
+}
+
 // NewUpdateGroupCall creates a new UpdateGroupCall
 //
 // @param groupCall New data about a group call
@@ -30305,6 +36042,13 @@ func (updateGroupCallParticipant *UpdateGroupCallParticipant) MessageType() stri
 	return "updateGroupCallParticipant"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateGroupCallParticipant wasn't created by NewUpdateGroupCallParticipant
+func (updateGroupCallParticipant UpdateGroupCallParticipant) MarshalJSON() ([]byte, error) {
+	type alias UpdateGroupCallParticipant
+	updateGroupCallParticipant.tdCommon.Type = "updateGroupCallParticipant"
+	return json.Marshal(alias(updateGroupCallParticipant))
+}
+
 // NewUpdateGroupCallParticipant creates a new UpdateGroupCallParticipant
 //
 // @param groupCallID Identifier of group call
@@ -30336,6 +36080,13 @@ func (updateNewCallSignalingData *UpdateNewCallSignalingData) MessageType() stri
 	return "updateNewCallSignalingData"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewCallSignalingData wasn't created by NewUpdateNewCallSignalingData
+func (updateNewCallSignalingData UpdateNewCallSignalingData) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewCallSignalingData
+	updateNewCallSignalingData.tdCommon.Type = "updateNewCallSignalingData"
+	return json.Marshal(alias(updateNewCallSignalingData))
+}
+
 // NewUpdateNewCallSignalingData creates a new UpdateNewCallSignalingData
 //
 // @param callID The call identifier
@@ -30367,6 +36118,13 @@ func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) MessageType(
 	return "updateUserPrivacySettingRules"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUserPrivacySettingRules wasn't created by NewUpdateUserPrivacySettingRules
+func (updateUserPrivacySettingRules UpdateUserPrivacySettingRules) MarshalJSON() ([]byte, error) {
+	type alias UpdateUserPrivacySettingRules
+	updateUserPrivacySettingRules.tdCommon.Type = "updateUserPrivacySettingRules"
+	return json.Marshal(alias(updateUserPrivacySettingRules))
+}
+
 // NewUpdateUserPrivacySettingRules creates a new UpdateUserPrivacySettingRules
 //
 // @param setting The privacy setting
@@ -30424,6 +36182,13 @@ func (updateUnreadMessageCount *UpdateUnreadMessageCount) MessageType() string {
 	return "updateUnreadMessageCount"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUnreadMessageCount wasn't created by NewUpdateUnreadMessageCount
+func (updateUnreadMessageCount UpdateUnreadMessageCount) MarshalJSON() ([]byte, error) {
+	type alias UpdateUnreadMessageCount
+	updateUnreadMessageCount.tdCommon.Type = "updateUnreadMessageCount"
+	return json.Marshal(alias(updateUnreadMessageCount))
+}
+
 // NewUpdateUnreadMessageCount creates a new UpdateUnreadMessageCount
 //
 // @param chatList The chat list with changed number of unread messages
@@ -30488,6 +36253,13 @@ func (updateUnreadChatCount *UpdateUnreadChatCount) MessageType() string {
 	return "updateUnreadChatCount"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUnreadChatCount wasn't created by NewUpdateUnreadChatCount
+func (updateUnreadChatCount UpdateUnreadChatCount) MarshalJSON() ([]byte, error) {
+	type alias UpdateUnreadChatCount
+	updateUnreadChatCount.tdCommon.Type = "updateUnreadChatCount"
+	return json.Marshal(alias(updateUnreadChatCount))
+}
+
 // NewUpdateUnreadChatCount creates a new UpdateUnreadChatCount
 //
 // @param chatList The chat list with changed number of unread messages
@@ -30560,6 +36332,13 @@ func (updateOption *UpdateOption) MessageType() string {
 	return "updateOption"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateOption wasn't created by NewUpdateOption
+func (updateOption UpdateOption) MarshalJSON() ([]byte, error) {
+	type alias UpdateOption
+	updateOption.tdCommon.Type = "updateOption"
+	return json.Marshal(alias(updateOption))
+}
+
 // NewUpdateOption creates a new UpdateOption
 //
 // @param name The option name
@@ -30616,6 +36395,13 @@ func (updateStickerSet *UpdateStickerSet) MessageType() string {
 	return "updateStickerSet"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateStickerSet wasn't created by NewUpdateStickerSet
+func (updateStickerSet UpdateStickerSet) MarshalJSON() ([]byte, error) {
+	type alias UpdateStickerSet
+	updateStickerSet.tdCommon.Type = "updateStickerSet"
+	return json.Marshal(alias(updateStickerSet))
+}
+
 // NewUpdateStickerSet creates a new UpdateStickerSet
 //
 // @param stickerSet The sticker set
@@ -30645,6 +36431,13 @@ func (updateInstalledStickerSets *UpdateInstalledStickerSets) MessageType() stri
 	return "updateInstalledStickerSets"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateInstalledStickerSets wasn't created by NewUpdateInstalledStickerSets
+func (updateInstalledStickerSets UpdateInstalledStickerSets) MarshalJSON() ([]byte, error) {
+	type alias UpdateInstalledStickerSets
+	updateInstalledStickerSets.tdCommon.Type = "updateInstalledStickerSets"
+	return json.Marshal(alias(updateInstalledStickerSets))
+}
+
 // NewUpdateInstalledStickerSets creates a new UpdateInstalledStickerSets
 //
 // @param isMasks True, if the list of installed mask sticker sets was updated
@@ -30675,6 +36468,13 @@ func (updateTrendingStickerSets *UpdateTrendingStickerSets) MessageType() string
 	return "updateTrendingStickerSets"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateTrendingStickerSets wasn't created by NewUpdateTrendingStickerSets
+func (updateTrendingStickerSets UpdateTrendingStickerSets) MarshalJSON() ([]byte, error) {
+	type alias UpdateTrendingStickerSets
+	updateTrendingStickerSets.tdCommon.Type = "updateTrendingStickerSets"
+	return json.Marshal(alias(updateTrendingStickerSets))
+}
+
 // NewUpdateTrendingStickerSets creates a new UpdateTrendingStickerSets
 //
 // @param stickerSets The prefix of the list of trending sticker sets with the newest trending sticker sets
@@ -30704,6 +36504,13 @@ func (updateRecentStickers *UpdateRecentStickers) MessageType() string {
 	return "updateRecentStickers"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateRecentStickers wasn't created by NewUpdateRecentStickers
+func (updateRecentStickers UpdateRecentStickers) MarshalJSON() ([]byte, error) {
+	type alias UpdateRecentStickers
+	updateRecentStickers.tdCommon.Type = "updateRecentStickers"
+	return json.Marshal(alias(updateRecentStickers))
+}
+
 // NewUpdateRecentStickers creates a new UpdateRecentStickers
 //
 // @param isAttached True, if the list of stickers attached to photo or video files was updated, otherwise the list of sent stickers is updated
@@ -30734,6 +36541,13 @@ func (updateFavoriteStickers *UpdateFavoriteStickers) MessageType() string {
 	return "updateFavoriteStickers"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateFavoriteStickers wasn't created by NewUpdateFavoriteStickers
+func (updateFavoriteStickers UpdateFavoriteStickers) MarshalJSON() ([]byte, error) {
+	type alias UpdateFavoriteStickers
+	updateFavoriteStickers.tdCommon.Type = "updateFavoriteStickers"
+	return json.Marshal(alias(updateFavoriteStickers))
+}
+
 // NewUpdateFavoriteStickers creates a new UpdateFavoriteStickers
 //
 // @param stickerIDs The new list of file identifiers of favorite stickers
@@ -30762,6 +36576,13 @@ func (updateSavedAnimations *UpdateSavedAnimations) MessageType() string {
 	return "updateSavedAnimations"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateSavedAnimations wasn't created by NewUpdateSavedAnimations
+func (updateSavedAnimations UpdateSavedAnimations) MarshalJSON() ([]byte, error) {
+	type alias UpdateSavedAnimations
+	updateSavedAnimations.tdCommon.Type = "updateSavedAnimations"
+	return json.Marshal(alias(updateSavedAnimations))
+}
+
 // NewUpdateSavedAnimations creates a new UpdateSavedAnimations
 //
 // @param animationIDs The new list of file identifiers of saved animations
@@ -30791,6 +36612,13 @@ func (updateSelectedBackground *UpdateSelectedBackground) MessageType() string {
 	return "updateSelectedBackground"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateSelectedBackground wasn't created by NewUpdateSelectedBackground
+func (updateSelectedBackground UpdateSelectedBackground) MarshalJSON() ([]byte, error) {
+	type alias UpdateSelectedBackground
+	updateSelectedBackground.tdCommon.Type = "updateSelectedBackground"
+	return json.Marshal(alias(updateSelectedBackground))
+}
+
 // NewUpdateSelectedBackground creates a new UpdateSelectedBackground
 //
 // @param forDarkTheme True, if background for dark theme has changed
@@ -30823,6 +36651,13 @@ func (updateLanguagePackStrings *UpdateLanguagePackStrings) MessageType() string
 	return "updateLanguagePackStrings"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateLanguagePackStrings wasn't created by NewUpdateLanguagePackStrings
+func (updateLanguagePackStrings UpdateLanguagePackStrings) MarshalJSON() ([]byte, error) {
+	type alias UpdateLanguagePackStrings
+	updateLanguagePackStrings.tdCommon.Type = "updateLanguagePackStrings"
+	return json.Marshal(alias(updateLanguagePackStrings))
+}
+
 // NewUpdateLanguagePackStrings creates a new UpdateLanguagePackStrings
 //
 // @param localizationTarget Localization target to which the language pack belongs
@@ -30855,6 +36690,13 @@ func (updateConnectionState *UpdateConnectionState) MessageType() string {
 	return "updateConnectionState"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateConnectionState wasn't created by NewUpdateConnectionState
+func (updateConnectionState UpdateConnectionState) MarshalJSON() ([]byte, error) {
+	type alias UpdateConnectionState
+	updateConnectionState.tdCommon.Type = "updateConnectionState"
+	return json.Marshal(alias(updateConnectionState))
+}
+
 // NewUpdateConnectionState creates a new UpdateConnectionState
 //
 // @param state The new connection state
@@ -30907,6 +36749,13 @@ func (updateTermsOfService *UpdateTermsOfService) MessageType() string {
 	return "updateTermsOfService"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateTermsOfService wasn't created by NewUpdateTermsOfService
+func (updateTermsOfService UpdateTermsOfService) MarshalJSON() ([]byte, error) {
+	type alias UpdateTermsOfService
+	updateTermsOfService.tdCommon.Type = "updateTermsOfService"
+	return json.Marshal(alias(updateTermsOfService))
+}
+
 // NewUpdateTermsOfService creates a new UpdateTermsOfService
 //
 // @param termsOfServiceID Identifier of the terms of service
@@ -30937,6 +36786,13 @@ func (updateUsersNearby *UpdateUsersNearby) MessageType() string {
 	return "updateUsersNearby"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateUsersNearby wasn't created by NewUpdateUsersNearby
+func (updateUsersNearby UpdateUsersNearby) MarshalJSON() ([]byte, error) {
+	type alias UpdateUsersNearby
+	updateUsersNearby.tdCommon.Type = "updateUsersNearby"
+	return json.Marshal(alias(updateUsersNearby))
+}
+
 // NewUpdateUsersNearby creates a new UpdateUsersNearby
 //
 // @param usersNearby The new list of users nearby
@@ -30965,6 +36821,13 @@ func (updateDiceEmojis *UpdateDiceEmojis) MessageType() string {
 	return "updateDiceEmojis"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateDiceEmojis wasn't created by NewUpdateDiceEmojis
+func (updateDiceEmojis UpdateDiceEmojis) MarshalJSON() ([]byte, error) {
+	type alias UpdateDiceEmojis
+	updateDiceEmojis.tdCommon.Type = "updateDiceEmojis"
+	return json.Marshal(alias(updateDiceEmojis))
+}
+
 // NewUpdateDiceEmojis creates a new UpdateDiceEmojis
 //
 // @param emojis The new list of supported dice emojis
@@ -30994,6 +36857,13 @@ func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) MessageT
 	return "updateAnimationSearchParameters"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateAnimationSearchParameters wasn't created by NewUpdateAnimationSearchParameters
+func (updateAnimationSearchParameters UpdateAnimationSearchParameters) MarshalJSON() ([]byte, error) {
+	type alias UpdateAnimationSearchParameters
+	updateAnimationSearchParameters.tdCommon.Type = "updateAnimationSearchParameters"
+	return json.Marshal(alias(updateAnimationSearchParameters))
+}
+
 // NewUpdateAnimationSearchParameters creates a new UpdateAnimationSearchParameters
 //
 // @param provider Name of the animation search provider
@@ -31025,6 +36895,13 @@ func (updateSuggestedActions *UpdateSuggestedActions) MessageType() string {
 	return "updateSuggestedActions"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateSuggestedActions wasn't created by NewUpdateSuggestedActions
+func (updateSuggestedActions UpdateSuggestedActions) MarshalJSON() ([]byte, error) {
+	type alias UpdateSuggestedActions
+	updateSuggestedActions.tdCommon.Type = "updateSuggestedActions"
+	return json.Marshal(alias(updateSuggestedActions))
+}
+
 // NewUpdateSuggestedActions creates a new UpdateSuggestedActions
 //
 // @param addedActions Added suggested actions
@@ -31060,6 +36937,13 @@ func (updateNewInlineQuery *UpdateNewInlineQuery) MessageType() string {
 	return "updateNewInlineQuery"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewInlineQuery wasn't created by NewUpdateNewInlineQuery
+func (updateNewInlineQuery UpdateNewInlineQuery) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewInlineQuery
+	updateNewInlineQuery.tdCommon.Type = "updateNewInlineQuery"
+	return json.Marshal(alias(updateNewInlineQuery))
+}
+
 // NewUpdateNewInlineQuery creates a new UpdateNewInlineQuery
 //
 // @param iD Unique query identifier
@@ -31135,6 +37019,13 @@ func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) MessageType() st
 	return "updateNewChosenInlineResult"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewChosenInlineResult wasn't created by NewUpdateNewChosenInlineResult
+func (updateNewChosenInlineResult UpdateNewChosenInlineResult) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewChosenInlineResult
+	updateNewChosenInlineResult.tdCommon.Type = "updateNewChosenInlineResult"
+	return json.Marshal(alias(updateNewChosenInlineResult))
+}
+
 // NewUpdateNewChosenInlineResult creates a new UpdateNewChosenInlineResult
 //
 // @param senderUserID Identifier of the user who sent the query
@@ -31165,8 +37056,8 @@ type UpdateNewCallbackQuery struct {
 	tdCommon
 	ID           JSONInt64            `json:"id"`             // Unique query identifier
 	SenderUserID int32                `json:"sender_user_id"` // Identifier of the user who sent the query
-	ChatID       int64                `json:"chat_id"`        // Identifier of the chat where the query was sent
-	MessageID    int64                `json:"message_id"`     // Identifier of the message, from which the query originated
+	ChatID       JSONInt64            `json:"chat_id"`        // Identifier of the chat where the query was sent
+	MessageID    JSONInt64            `json:"message_id"`     // Identifier of the message, from which the query originated
 	ChatInstance JSONInt64            `json:"chat_instance"`  // Identifier that uniquely corresponds to the chat to which the message was sent
 	Payload      CallbackQueryPayload `json:"payload"`        // Query payload
 }
@@ -31176,6 +37067,13 @@ func (updateNewCallbackQuery *UpdateNewCallbackQuery) MessageType() string {
 	return "updateNewCallbackQuery"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewCallbackQuery wasn't created by NewUpdateNewCallbackQuery
+func (updateNewCallbackQuery UpdateNewCallbackQuery) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewCallbackQuery
+	updateNewCallbackQuery.tdCommon.Type = "updateNewCallbackQuery"
+	return json.Marshal(alias(updateNewCallbackQuery))
+}
+
 // NewUpdateNewCallbackQuery creates a new UpdateNewCallbackQuery
 //
 // @param iD Unique query identifier
@@ -31184,7 +37082,7 @@ func (updateNewCallbackQuery *UpdateNewCallbackQuery) MessageType() string {
 // @param messageID Identifier of the message, from which the query originated
 // @param chatInstance Identifier that uniquely corresponds to the chat to which the message was sent
 // @param payload Query payload
-func NewUpdateNewCallbackQuery(iD JSONInt64, senderUserID int32, chatID int64, messageID int64, chatInstance JSONInt64, payload CallbackQueryPayload) *UpdateNewCallbackQuery {
+func NewUpdateNewCallbackQuery(iD JSONInt64, senderUserID int32, chatID JSONInt64, messageID JSONInt64, chatInstance JSONInt64, payload CallbackQueryPayload) *UpdateNewCallbackQuery {
 	updateNewCallbackQueryTemp := UpdateNewCallbackQuery{
 		tdCommon:     tdCommon{Type: "updateNewCallbackQuery"},
 		ID:           iD,
@@ -31209,8 +37107,8 @@ func (updateNewCallbackQuery *UpdateNewCallbackQuery) UnmarshalJSON(b []byte) er
 		tdCommon
 		ID           JSONInt64 `json:"id"`             // Unique query identifier
 		SenderUserID int32     `json:"sender_user_id"` // Identifier of the user who sent the query
-		ChatID       int64     `json:"chat_id"`        // Identifier of the chat where the query was sent
-		MessageID    int64     `json:"message_id"`     // Identifier of the message, from which the query originated
+		ChatID       JSONInt64 `json:"chat_id"`        // Identifier of the chat where the query was sent
+		MessageID    JSONInt64 `json:"message_id"`     // Identifier of the message, from which the query originated
 		ChatInstance JSONInt64 `json:"chat_instance"`  // Identifier that uniquely corresponds to the chat to which the message was sent
 
 	}{}
@@ -31252,6 +37150,13 @@ func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) MessageType()
 	return "updateNewInlineCallbackQuery"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewInlineCallbackQuery wasn't created by NewUpdateNewInlineCallbackQuery
+func (updateNewInlineCallbackQuery UpdateNewInlineCallbackQuery) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewInlineCallbackQuery
+	updateNewInlineCallbackQuery.tdCommon.Type = "updateNewInlineCallbackQuery"
+	return json.Marshal(alias(updateNewInlineCallbackQuery))
+}
+
 // NewUpdateNewInlineCallbackQuery creates a new UpdateNewInlineCallbackQuery
 //
 // @param iD Unique query identifier
@@ -31323,6 +37228,13 @@ func (updateNewShippingQuery *UpdateNewShippingQuery) MessageType() string {
 	return "updateNewShippingQuery"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewShippingQuery wasn't created by NewUpdateNewShippingQuery
+func (updateNewShippingQuery UpdateNewShippingQuery) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewShippingQuery
+	updateNewShippingQuery.tdCommon.Type = "updateNewShippingQuery"
+	return json.Marshal(alias(updateNewShippingQuery))
+}
+
 // NewUpdateNewShippingQuery creates a new UpdateNewShippingQuery
 //
 // @param iD Unique query identifier
@@ -31352,7 +37264,7 @@ type UpdateNewPreCheckoutQuery struct {
 	ID               JSONInt64  `json:"id"`                 // Unique query identifier
 	SenderUserID     int32      `json:"sender_user_id"`     // Identifier of the user who sent the query
 	Currency         string     `json:"currency"`           // Currency for the product price
-	TotalAmount      int64      `json:"total_amount"`       // Total price for the product, in the minimal quantity of the currency
+	TotalAmount      JSONInt64  `json:"total_amount"`       // Total price for the product, in the minimal quantity of the currency
 	InvoicePayload   []byte     `json:"invoice_payload"`    // Invoice payload
 	ShippingOptionID string     `json:"shipping_option_id"` // Identifier of a shipping option chosen by the user; may be empty if not applicable
 	OrderInfo        *OrderInfo `json:"order_info"`         // Information about the order; may be null
@@ -31363,6 +37275,13 @@ func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) MessageType() string
 	return "updateNewPreCheckoutQuery"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewPreCheckoutQuery wasn't created by NewUpdateNewPreCheckoutQuery
+func (updateNewPreCheckoutQuery UpdateNewPreCheckoutQuery) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewPreCheckoutQuery
+	updateNewPreCheckoutQuery.tdCommon.Type = "updateNewPreCheckoutQuery"
+	return json.Marshal(alias(updateNewPreCheckoutQuery))
+}
+
 // NewUpdateNewPreCheckoutQuery creates a new UpdateNewPreCheckoutQuery
 //
 // @param iD Unique query identifier
@@ -31372,7 +37291,7 @@ func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) MessageType() string
 // @param invoicePayload Invoice payload
 // @param shippingOptionID Identifier of a shipping option chosen by the user; may be empty if not applicable
 // @param orderInfo Information about the order; may be null
-func NewUpdateNewPreCheckoutQuery(iD JSONInt64, senderUserID int32, currency string, totalAmount int64, invoicePayload []byte, shippingOptionID string, orderInfo *OrderInfo) *UpdateNewPreCheckoutQuery {
+func NewUpdateNewPreCheckoutQuery(iD JSONInt64, senderUserID int32, currency string, totalAmount JSONInt64, invoicePayload []byte, shippingOptionID string, orderInfo *OrderInfo) *UpdateNewPreCheckoutQuery {
 	updateNewPreCheckoutQueryTemp := UpdateNewPreCheckoutQuery{
 		tdCommon:         tdCommon{Type: "updateNewPreCheckoutQuery"},
 		ID:               iD,
@@ -31403,6 +37322,13 @@ func (updateNewCustomEvent *UpdateNewCustomEvent) MessageType() string {
 	return "updateNewCustomEvent"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewCustomEvent wasn't created by NewUpdateNewCustomEvent
+func (updateNewCustomEvent UpdateNewCustomEvent) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewCustomEvent
+	updateNewCustomEvent.tdCommon.Type = "updateNewCustomEvent"
+	return json.Marshal(alias(updateNewCustomEvent))
+}
+
 // NewUpdateNewCustomEvent creates a new UpdateNewCustomEvent
 //
 // @param event A JSON-serialized event
@@ -31433,6 +37359,13 @@ func (updateNewCustomQuery *UpdateNewCustomQuery) MessageType() string {
 	return "updateNewCustomQuery"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdateNewCustomQuery wasn't created by NewUpdateNewCustomQuery
+func (updateNewCustomQuery UpdateNewCustomQuery) MarshalJSON() ([]byte, error) {
+	type alias UpdateNewCustomQuery
+	updateNewCustomQuery.tdCommon.Type = "updateNewCustomQuery"
+	return json.Marshal(alias(updateNewCustomQuery))
+}
+
 // NewUpdateNewCustomQuery creates a new UpdateNewCustomQuery
 //
 // @param iD The query identifier
@@ -31465,6 +37398,13 @@ func (updatePoll *UpdatePoll) MessageType() string {
 	return "updatePoll"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdatePoll wasn't created by NewUpdatePoll
+func (updatePoll UpdatePoll) MarshalJSON() ([]byte, error) {
+	type alias UpdatePoll
+	updatePoll.tdCommon.Type = "updatePoll"
+	return json.Marshal(alias(updatePoll))
+}
+
 // NewUpdatePoll creates a new UpdatePoll
 //
 // @param poll New data about the poll
@@ -31495,6 +37435,13 @@ func (updatePollAnswer *UpdatePollAnswer) MessageType() string {
 	return "updatePollAnswer"
 }
 
+// MarshalJSON marshals to json, with @type set even if UpdatePollAnswer wasn't created by NewUpdatePollAnswer
+func (updatePollAnswer UpdatePollAnswer) MarshalJSON() ([]byte, error) {
+	type alias UpdatePollAnswer
+	updatePollAnswer.tdCommon.Type = "updatePollAnswer"
+	return json.Marshal(alias(updatePollAnswer))
+}
+
 // NewUpdatePollAnswer creates a new UpdatePollAnswer
 //
 // @param pollID Unique poll identifier
@@ -31527,6 +37474,13 @@ func (updates *Updates) MessageType() string {
 	return "updates"
 }
 
+// MarshalJSON marshals to json, with @type set even if Updates wasn't created by NewUpdates
+func (updates Updates) MarshalJSON() ([]byte, error) {
+	type alias Updates
+	updates.tdCommon.Type = "updates"
+	return json.Marshal(alias(updates))
+}
+
 // NewUpdates creates a new Updates
 //
 // @param updates List of updates
@@ -31549,6 +37503,13 @@ func (logStreamDefault *LogStreamDefault) MessageType() string {
 	return "logStreamDefault"
 }
 
+// MarshalJSON marshals to json, with @type set even if LogStreamDefault wasn't created by NewLogStreamDefault
+func (logStreamDefault LogStreamDefault) MarshalJSON() ([]byte, error) {
+	type alias LogStreamDefault
+	logStreamDefault.tdCommon.Type = "logStreamDefault"
+	return json.Marshal(alias(logStreamDefault))
+}
+
 // NewLogStreamDefault creates a new LogStreamDefault
 //
 func NewLogStreamDefault() *LogStreamDefault {
@@ -31567,9 +37528,9 @@ func (logStreamDefault *LogStreamDefault) GetLogStreamEnum() LogStreamEnum {
 // LogStreamFile The log is written to a file
 type LogStreamFile struct {
 	tdCommon
-	Path           string `json:"path"`            // Path to the file to where the internal TDLib log will be written
-	MaxFileSize    int64  `json:"max_file_size"`   // The maximum size of the file to where the internal TDLib log is written before the file will be auto-rotated
-	RedirectStderr bool   `json:"redirect_stderr"` // Pass true to additionally redirect stderr to the log file. Ignored on Windows
+	Path           string    `json:"path"`            // Path to the file to where the internal TDLib log will be written
+	MaxFileSize    JSONInt64 `json:"max_file_size"`   // The maximum size of the file to where the internal TDLib log is written before the file will be auto-rotated
+	RedirectStderr bool      `json:"redirect_stderr"` // Pass true to additionally redirect stderr to the log file. Ignored on Windows
 }
 
 // MessageType return the string telegram-type of LogStreamFile
@@ -31577,12 +37538,19 @@ func (logStreamFile *LogStreamFile) MessageType() string {
 	return "logStreamFile"
 }
 
+// MarshalJSON marshals to json, with @type set even if LogStreamFile wasn't created by NewLogStreamFile
+func (logStreamFile LogStreamFile) MarshalJSON() ([]byte, error) {
+	type alias LogStreamFile
+	logStreamFile.tdCommon.Type = "logStreamFile"
+	return json.Marshal(alias(logStreamFile))
+}
+
 // NewLogStreamFile creates a new LogStreamFile
 //
 // @param path Path to the file to where the internal TDLib log will be written
 // @param maxFileSize The maximum size of the file to where the internal TDLib log is written before the file will be auto-rotated
 // @param redirectStderr Pass true to additionally redirect stderr to the log file. Ignored on Windows
-func NewLogStreamFile(path string, maxFileSize int64, redirectStderr bool) *LogStreamFile {
+func NewLogStreamFile(path string, maxFileSize JSONInt64, redirectStderr bool) *LogStreamFile {
 	logStreamFileTemp := LogStreamFile{
 		tdCommon:       tdCommon{Type: "logStreamFile"},
 		Path:           path,
@@ -31608,6 +37576,13 @@ func (logStreamEmpty *LogStreamEmpty) MessageType() string {
 	return "logStreamEmpty"
 }
 
+// MarshalJSON marshals to json, with @type set even if LogStreamEmpty wasn't created by NewLogStreamEmpty
+func (logStreamEmpty LogStreamEmpty) MarshalJSON() ([]byte, error) {
+	type alias LogStreamEmpty
+	logStreamEmpty.tdCommon.Type = "logStreamEmpty"
+	return json.Marshal(alias(logStreamEmpty))
+}
+
 // NewLogStreamEmpty creates a new LogStreamEmpty
 //
 func NewLogStreamEmpty() *LogStreamEmpty {
@@ -31634,6 +37609,13 @@ func (logVerbosityLevel *LogVerbosityLevel) MessageType() string {
 	return "logVerbosityLevel"
 }
 
+// MarshalJSON marshals to json, with @type set even if LogVerbosityLevel wasn't created by NewLogVerbosityLevel
+func (logVerbosityLevel LogVerbosityLevel) MarshalJSON() ([]byte, error) {
+	type alias LogVerbosityLevel
+	logVerbosityLevel.tdCommon.Type = "logVerbosityLevel"
+	return json.Marshal(alias(logVerbosityLevel))
+}
+
 // NewLogVerbosityLevel creates a new LogVerbosityLevel
 //
 // @param verbosityLevel Log verbosity level
@@ -31657,6 +37639,13 @@ func (logTags *LogTags) MessageType() string {
 	return "logTags"
 }
 
+// MarshalJSON marshals to json, with @type set even if LogTags wasn't created by NewLogTags
+func (logTags LogTags) MarshalJSON() ([]byte, error) {
+	type alias LogTags
+	logTags.tdCommon.Type = "logTags"
+	return json.Marshal(alias(logTags))
+}
+
 // NewLogTags creates a new LogTags
 //
 // @param tags List of log tags
@@ -31680,6 +37669,13 @@ func (testInt *TestInt) MessageType() string {
 	return "testInt"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestInt wasn't created by NewTestInt
+func (testInt TestInt) MarshalJSON() ([]byte, error) {
+	type alias TestInt
+	testInt.tdCommon.Type = "testInt"
+	return json.Marshal(alias(testInt))
+}
+
 // NewTestInt creates a new TestInt
 //
 // @param value Number
@@ -31703,6 +37699,13 @@ func (testString *TestString) MessageType() string {
 	return "testString"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestString wasn't created by NewTestString
+func (testString TestString) MarshalJSON() ([]byte, error) {
+	type alias TestString
+	testString.tdCommon.Type = "testString"
+	return json.Marshal(alias(testString))
+}
+
 // NewTestString creates a new TestString
 //
 // @param value String
@@ -31726,6 +37729,13 @@ func (testBytes *TestBytes) MessageType() string {
 	return "testBytes"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestBytes wasn't created by NewTestBytes
+func (testBytes TestBytes) MarshalJSON() ([]byte, error) {
+	type alias TestBytes
+	testBytes.tdCommon.Type = "testBytes"
+	return json.Marshal(alias(testBytes))
+}
+
 // NewTestBytes creates a new TestBytes
 //
 // @param value Bytes
@@ -31749,6 +37759,13 @@ func (testVectorInt *TestVectorInt) MessageType() string {
 	return "testVectorInt"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestVectorInt wasn't created by NewTestVectorInt
+func (testVectorInt TestVectorInt) MarshalJSON() ([]byte, error) {
+	type alias TestVectorInt
+	testVectorInt.tdCommon.Type = "testVectorInt"
+	return json.Marshal(alias(testVectorInt))
+}
+
 // NewTestVectorInt creates a new TestVectorInt
 //
 // @param value Vector of numbers
@@ -31772,6 +37789,13 @@ func (testVectorIntObject *TestVectorIntObject) MessageType() string {
 	return "testVectorIntObject"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestVectorIntObject wasn't created by NewTestVectorIntObject
+func (testVectorIntObject TestVectorIntObject) MarshalJSON() ([]byte, error) {
+	type alias TestVectorIntObject
+	testVectorIntObject.tdCommon.Type = "testVectorIntObject"
+	return json.Marshal(alias(testVectorIntObject))
+}
+
 // NewTestVectorIntObject creates a new TestVectorIntObject
 //
 // @param value Vector of objects
@@ -31795,6 +37819,13 @@ func (testVectorString *TestVectorString) MessageType() string {
 	return "testVectorString"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestVectorString wasn't created by NewTestVectorString
+func (testVectorString TestVectorString) MarshalJSON() ([]byte, error) {
+	type alias TestVectorString
+	testVectorString.tdCommon.Type = "testVectorString"
+	return json.Marshal(alias(testVectorString))
+}
+
 // NewTestVectorString creates a new TestVectorString
 //
 // @param value Vector of strings
@@ -31818,6 +37849,13 @@ func (testVectorStringObject *TestVectorStringObject) MessageType() string {
 	return "testVectorStringObject"
 }
 
+// MarshalJSON marshals to json, with @type set even if TestVectorStringObject wasn't created by NewTestVectorStringObject
+func (testVectorStringObject TestVectorStringObject) MarshalJSON() ([]byte, error) {
+	type alias TestVectorStringObject
+	testVectorStringObject.tdCommon.Type = "testVectorStringObject"
+	return json.Marshal(alias(testVectorStringObject))
+}
+
 // NewTestVectorStringObject creates a new TestVectorStringObject
 //
 // @param value Vector of objects
@@ -31863,7 +37901,9 @@ func unmarshalAuthenticationCodeType(rawMsg *json.RawMessage) (AuthenticationCod
 		return &authenticationCodeTypeFlashCall, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownAuthenticationCodeType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -31935,7 +37975,9 @@ func unmarshalAuthorizationState(rawMsg *json.RawMessage) (AuthorizationState, e
 		return &authorizationStateClosed, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownAuthorizationState
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -31972,7 +38014,9 @@ func unmarshalInputFile(rawMsg *json.RawMessage) (InputFile, error) {
 		return &inputFileGenerated, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputFile
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32019,7 +38063,9 @@ func unmarshalThumbnailFormat(rawMsg *json.RawMessage) (ThumbnailFormat, error)
 		return &thumbnailFormatMpeg4, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownThumbnailFormat
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32056,7 +38102,9 @@ func unmarshalMaskPoint(rawMsg *json.RawMessage) (MaskPoint, error) {
 		return &maskPointChin, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMaskPoint
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32083,7 +38131,9 @@ func unmarshalPollType(rawMsg *json.RawMessage) (PollType, error) {
 		return &pollTypeQuiz, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPollType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32120,7 +38170,9 @@ func unmarshalUserType(rawMsg *json.RawMessage) (UserType, error) {
 		return &userTypeUnknown, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownUserType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32152,7 +38204,9 @@ func unmarshalInputChatPhoto(rawMsg *json.RawMessage) (InputChatPhoto, error) {
 		return &inputChatPhotoAnimation, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputChatPhoto
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32199,7 +38253,9 @@ func unmarshalChatMemberStatus(rawMsg *json.RawMessage) (ChatMemberStatus, error
 		return &chatMemberStatusBanned, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatMemberStatus
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32251,7 +38307,9 @@ func unmarshalChatMembersFilter(rawMsg *json.RawMessage) (ChatMembersFilter, err
 		return &chatMembersFilterBots, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatMembersFilter
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32308,7 +38366,9 @@ func unmarshalSupergroupMembersFilter(rawMsg *json.RawMessage) (SupergroupMember
 		return &supergroupMembersFilterBots, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownSupergroupMembersFilter
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32340,20 +38400,10 @@ func unmarshalSecretChatState(rawMsg *json.RawMessage) (SecretChatState, error)
 		return &secretChatStateClosed, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
-	}
-}
-
-func unmarshalListOfMessageSender(rawMsgs []*json.RawMessage) ([]MessageSender, error) {
-	messageSenders := make([]MessageSender, len(rawMsgs))
-	var err error
-	for i, rawMsg := range rawMsgs {
-		messageSenders[i], err = unmarshalMessageSender(rawMsg)
-		if err != nil {
-			return nil, err
-		}
+		var unknown UnknownSecretChatState
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
-	return messageSenders, nil
 }
 
 func unmarshalMessageSender(rawMsg *json.RawMessage) (MessageSender, error) {
@@ -32379,7 +38429,9 @@ func unmarshalMessageSender(rawMsg *json.RawMessage) (MessageSender, error) {
 		return &messageSenderChat, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMessageSender
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32421,7 +38473,9 @@ func unmarshalMessageForwardOrigin(rawMsg *json.RawMessage) (MessageForwardOrigi
 		return &messageForwardOriginMessageImport, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMessageForwardOrigin
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32448,7 +38502,9 @@ func unmarshalMessageSendingState(rawMsg *json.RawMessage) (MessageSendingState,
 		return &messageSendingStateFailed, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMessageSendingState
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32480,7 +38536,9 @@ func unmarshalNotificationSettingsScope(rawMsg *json.RawMessage) (NotificationSe
 		return &notificationSettingsScopeChannelChats, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownNotificationSettingsScope
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32517,7 +38575,9 @@ func unmarshalChatType(rawMsg *json.RawMessage) (ChatType, error) {
 		return &chatTypeSecret, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32549,7 +38609,9 @@ func unmarshalChatList(rawMsg *json.RawMessage) (ChatList, error) {
 		return &chatListFilter, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatList
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32576,7 +38638,9 @@ func unmarshalChatSource(rawMsg *json.RawMessage) (ChatSource, error) {
 		return &chatSourcePublicServiceAnnouncement, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatSource
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32603,7 +38667,9 @@ func unmarshalPublicChatType(rawMsg *json.RawMessage) (PublicChatType, error) {
 		return &publicChatTypeIsLocationBased, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPublicChatType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32650,7 +38716,9 @@ func unmarshalChatActionBar(rawMsg *json.RawMessage) (ChatActionBar, error) {
 		return &chatActionBarSharePhoneNumber, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatActionBar
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32687,7 +38755,9 @@ func unmarshalKeyboardButtonType(rawMsg *json.RawMessage) (KeyboardButtonType, e
 		return &keyboardButtonTypeRequestPoll, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownKeyboardButtonType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32739,7 +38809,9 @@ func unmarshalInlineKeyboardButtonType(rawMsg *json.RawMessage) (InlineKeyboardB
 		return &inlineKeyboardButtonTypeBuy, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInlineKeyboardButtonType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32776,7 +38848,9 @@ func unmarshalReplyMarkup(rawMsg *json.RawMessage) (ReplyMarkup, error) {
 		return &replyMarkupInlineKeyboard, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownReplyMarkup
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32803,7 +38877,9 @@ func unmarshalLoginURLInfo(rawMsg *json.RawMessage) (LoginURLInfo, error) {
 		return &loginURLInfoRequestConfirmation, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownLoginURLInfo
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32905,7 +38981,9 @@ func unmarshalRichText(rawMsg *json.RawMessage) (RichText, error) {
 		return &richTexts, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownRichText
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32937,7 +39015,9 @@ func unmarshalPageBlockHorizontalAlignment(rawMsg *json.RawMessage) (PageBlockHo
 		return &pageBlockHorizontalAlignmentRight, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPageBlockHorizontalAlignment
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -32969,7 +39049,9 @@ func unmarshalPageBlockVerticalAlignment(rawMsg *json.RawMessage) (PageBlockVert
 		return &pageBlockVerticalAlignmentBottom, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPageBlockVerticalAlignment
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33131,7 +39213,9 @@ func unmarshalPageBlock(rawMsg *json.RawMessage) (PageBlock, error) {
 		return &pageBlockMap, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPageBlock
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33168,7 +39252,9 @@ func unmarshalInputCredentials(rawMsg *json.RawMessage) (InputCredentials, error
 		return &inputCredentialsGooglePay, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputCredentials
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33250,7 +39336,9 @@ func unmarshalPassportElementType(rawMsg *json.RawMessage) (PassportElementType,
 		return &passportElementTypeEmailAddress, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPassportElementType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33332,7 +39420,9 @@ func unmarshalPassportElement(rawMsg *json.RawMessage) (PassportElement, error)
 		return &passportElementEmailAddress, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPassportElement
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33414,7 +39504,9 @@ func unmarshalInputPassportElement(rawMsg *json.RawMessage) (InputPassportElemen
 		return &inputPassportElementEmailAddress, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputPassportElement
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33476,7 +39568,9 @@ func unmarshalPassportElementErrorSource(rawMsg *json.RawMessage) (PassportEleme
 		return &passportElementErrorSourceFiles, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPassportElementErrorSource
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33538,7 +39632,9 @@ func unmarshalInputPassportElementErrorSource(rawMsg *json.RawMessage) (InputPas
 		return &inputPassportElementErrorSourceFiles, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputPassportElementErrorSource
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33780,7 +39876,9 @@ func unmarshalMessageContent(rawMsg *json.RawMessage) (MessageContent, error) {
 		return &messageUnsupported, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMessageContent
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33882,7 +39980,9 @@ func unmarshalTextEntityType(rawMsg *json.RawMessage) (TextEntityType, error) {
 		return &textEntityTypeMentionName, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownTextEntityType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -33909,7 +40009,9 @@ func unmarshalMessageSchedulingState(rawMsg *json.RawMessage) (MessageScheduling
 		return &messageSchedulingStateSendWhenOnline, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMessageSchedulingState
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34011,7 +40113,9 @@ func unmarshalInputMessageContent(rawMsg *json.RawMessage) (InputMessageContent,
 		return &inputMessageForwarded, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputMessageContent
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34118,7 +40222,9 @@ func unmarshalSearchMessagesFilter(rawMsg *json.RawMessage) (SearchMessagesFilte
 		return &searchMessagesFilterPinned, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownSearchMessagesFilter
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34200,7 +40306,9 @@ func unmarshalChatAction(rawMsg *json.RawMessage) (ChatAction, error) {
 		return &chatActionCancel, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatAction
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34247,7 +40355,9 @@ func unmarshalUserStatus(rawMsg *json.RawMessage) (UserStatus, error) {
 		return &userStatusLastMonth, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownUserStatus
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34289,7 +40399,9 @@ func unmarshalCallDiscardReason(rawMsg *json.RawMessage) (CallDiscardReason, err
 		return &callDiscardReasonHungUp, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCallDiscardReason
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34316,7 +40428,9 @@ func unmarshalCallServerType(rawMsg *json.RawMessage) (CallServerType, error) {
 		return &callServerTypeWebrtc, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCallServerType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34363,7 +40477,9 @@ func unmarshalCallState(rawMsg *json.RawMessage) (CallState, error) {
 		return &callStateError, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCallState
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34425,7 +40541,9 @@ func unmarshalCallProblem(rawMsg *json.RawMessage) (CallProblem, error) {
 		return &callProblemPixelatedVideo, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCallProblem
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34452,7 +40570,9 @@ func unmarshalDiceStickers(rawMsg *json.RawMessage) (DiceStickers, error) {
 		return &diceStickersSlotMachine, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownDiceStickers
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34529,7 +40649,9 @@ func unmarshalInputInlineQueryResult(rawMsg *json.RawMessage) (InputInlineQueryR
 		return &inputInlineQueryResultVoiceNote, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputInlineQueryResult
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34606,7 +40728,9 @@ func unmarshalInlineQueryResult(rawMsg *json.RawMessage) (InlineQueryResult, err
 		return &inlineQueryResultVoiceNote, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInlineQueryResult
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34638,7 +40762,9 @@ func unmarshalCallbackQueryPayload(rawMsg *json.RawMessage) (CallbackQueryPayloa
 		return &callbackQueryPayloadGame, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCallbackQueryPayload
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34785,7 +40911,9 @@ func unmarshalChatEventAction(rawMsg *json.RawMessage) (ChatEventAction, error)
 		return &chatEventVoiceChatMuteNewParticipantsToggled, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatEventAction
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34817,7 +40945,9 @@ func unmarshalLanguagePackStringValue(rawMsg *json.RawMessage) (LanguagePackStri
 		return &languagePackStringValueDeleted, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownLanguagePackStringValue
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34889,7 +41019,9 @@ func unmarshalDeviceToken(rawMsg *json.RawMessage) (DeviceToken, error) {
 		return &deviceTokenTizenPush, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownDeviceToken
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34916,7 +41048,9 @@ func unmarshalBackgroundFill(rawMsg *json.RawMessage) (BackgroundFill, error) {
 		return &backgroundFillGradient, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownBackgroundFill
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34948,7 +41082,9 @@ func unmarshalBackgroundType(rawMsg *json.RawMessage) (BackgroundType, error) {
 		return &backgroundTypeFill, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownBackgroundType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -34975,7 +41111,9 @@ func unmarshalInputBackground(rawMsg *json.RawMessage) (InputBackground, error)
 		return &inputBackgroundRemote, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputBackground
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35012,7 +41150,9 @@ func unmarshalCanTransferOwnershipResult(rawMsg *json.RawMessage) (CanTransferOw
 		return &canTransferOwnershipResultSessionTooFresh, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCanTransferOwnershipResult
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35054,7 +41194,9 @@ func unmarshalCheckChatUsernameResult(rawMsg *json.RawMessage) (CheckChatUsernam
 		return &checkChatUsernameResultPublicGroupsUnavailable, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownCheckChatUsernameResult
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35086,7 +41228,9 @@ func unmarshalMessageFileType(rawMsg *json.RawMessage) (MessageFileType, error)
 		return &messageFileTypeUnknown, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownMessageFileType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35233,7 +41377,9 @@ func unmarshalPushMessageContent(rawMsg *json.RawMessage) (PushMessageContent, e
 		return &pushMessageContentMediaAlbum, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownPushMessageContent
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35270,7 +41416,9 @@ func unmarshalNotificationType(rawMsg *json.RawMessage) (NotificationType, error
 		return &notificationTypeNewPushMessage, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownNotificationType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35307,7 +41455,9 @@ func unmarshalNotificationGroupType(rawMsg *json.RawMessage) (NotificationGroupT
 		return &notificationGroupTypeCalls, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownNotificationGroupType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35344,7 +41494,9 @@ func unmarshalOptionValue(rawMsg *json.RawMessage) (OptionValue, error) {
 		return &optionValueString, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownOptionValue
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35391,7 +41543,9 @@ func unmarshalJsonValue(rawMsg *json.RawMessage) (JsonValue, error) {
 		return &jsonValueObject, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownJsonValue
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35448,7 +41602,9 @@ func unmarshalUserPrivacySettingRule(rawMsg *json.RawMessage) (UserPrivacySettin
 		return &userPrivacySettingRuleRestrictChatMembers, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownUserPrivacySettingRule
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35505,7 +41661,9 @@ func unmarshalUserPrivacySetting(rawMsg *json.RawMessage) (UserPrivacySetting, e
 		return &userPrivacySettingAllowFindingByPhoneNumber, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownUserPrivacySetting
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35562,7 +41720,9 @@ func unmarshalChatReportReason(rawMsg *json.RawMessage) (ChatReportReason, error
 		return &chatReportReasonCustom, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatReportReason
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35659,7 +41819,9 @@ func unmarshalFileType(rawMsg *json.RawMessage) (FileType, error) {
 		return &fileTypeWallpaper, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownFileType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35701,7 +41863,9 @@ func unmarshalNetworkType(rawMsg *json.RawMessage) (NetworkType, error) {
 		return &networkTypeOther, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownNetworkType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35728,7 +41892,9 @@ func unmarshalNetworkStatisticsEntry(rawMsg *json.RawMessage) (NetworkStatistics
 		return &networkStatisticsEntryCall, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownNetworkStatisticsEntry
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35770,7 +41936,9 @@ func unmarshalConnectionState(rawMsg *json.RawMessage) (ConnectionState, error)
 		return &connectionStateReady, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownConnectionState
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35822,7 +41990,9 @@ func unmarshalTopChatCategory(rawMsg *json.RawMessage) (TopChatCategory, error)
 		return &topChatCategoryForwardChats, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownTopChatCategory
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35859,7 +42029,9 @@ func unmarshalTMeURLType(rawMsg *json.RawMessage) (TMeURLType, error) {
 		return &tMeURLTypeStickerSet, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownTMeURLType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35891,7 +42063,9 @@ func unmarshalSuggestedAction(rawMsg *json.RawMessage) (SuggestedAction, error)
 		return &suggestedActionSeeTicksHint, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownSuggestedAction
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35918,7 +42092,9 @@ func unmarshalTextParseMode(rawMsg *json.RawMessage) (TextParseMode, error) {
 		return &textParseModeHTML, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownTextParseMode
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35950,7 +42126,9 @@ func unmarshalProxyType(rawMsg *json.RawMessage) (ProxyType, error) {
 		return &proxyTypeMtproto, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownProxyType
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -35977,7 +42155,9 @@ func unmarshalInputSticker(rawMsg *json.RawMessage) (InputSticker, error) {
 		return &inputStickerAnimated, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownInputSticker
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -36009,7 +42189,9 @@ func unmarshalStatisticalGraph(rawMsg *json.RawMessage) (StatisticalGraph, error
 		return &statisticalGraphError, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownStatisticalGraph
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -36036,7 +42218,9 @@ func unmarshalChatStatistics(rawMsg *json.RawMessage) (ChatStatistics, error) {
 		return &chatStatisticsChannel, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownChatStatistics
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -36063,7 +42247,9 @@ func unmarshalVectorPathCommand(rawMsg *json.RawMessage) (VectorPathCommand, err
 		return &vectorPathCommandCubicBezierCurve, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownVectorPathCommand
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -36495,7 +42681,9 @@ func unmarshalUpdate(rawMsg *json.RawMessage) (Update, error) {
 		return &updatePollAnswer, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownUpdate
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
 
@@ -36527,6 +42715,8 @@ func unmarshalLogStream(rawMsg *json.RawMessage) (LogStream, error) {
 		return &logStreamEmpty, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		var unknown UnknownLogStream
+		err := json.Unmarshal(*rawMsg, &unknown)
+		return &unknown, err
 	}
 }
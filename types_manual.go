@@ -0,0 +1,44 @@
+package tdlib
+
+import "encoding/json"
+
+// The declarations below are maintained by hand, cmd/tdlib-gen doesn't generate them.
+// MessageReplyInfo.RecentRepliers is a vector of an abstract type, which encoding/json can't decode on its own.
+
+// UnmarshalJSON unmarshal to json
+func (messageReplyInfo *MessageReplyInfo) UnmarshalJSON(data []byte) error {
+	var tmp struct {
+		ReplyCount              int32              `json:"reply_count"`
+		RecentRepliers          []*json.RawMessage `json:"recent_repliers"`
+		LastReadInboxMessageId  JSONInt64          `json:"last_read_inbox_message_id"`
+		LastReadOutboxMessageId JSONInt64          `json:"last_read_outbox_message_id"`
+		LastMessageId           JSONInt64          `json:"last_message_id"`
+	}
+
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+
+	messageReplyInfo.ReplyCount = tmp.ReplyCount
+	messageReplyInfo.LastReadInboxMessageID = tmp.LastReadInboxMessageId
+	messageReplyInfo.LastReadOutboxMessageID = tmp.LastReadOutboxMessageId
+	messageReplyInfo.LastMessageID = tmp.LastMessageId
+
+	fieldRecentRepliers, _ := unmarshalListOfMessageSender(tmp.RecentRepliers)
+	messageReplyInfo.RecentRepliers = fieldRecentRepliers
+
+	return nil
+}
+
+func unmarshalListOfMessageSender(rawMsgs []*json.RawMessage) ([]MessageSender, error) {
+	messageSenders := make([]MessageSender, len(rawMsgs))
+	var err error
+	for i, rawMsg := range rawMsgs {
+		messageSenders[i], err = unmarshalMessageSender(rawMsg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return messageSenders, nil
+}
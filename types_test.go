@@ -0,0 +1,124 @@
+package tdlib_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+func TestJSONInt64RoundTrip(t *testing.T) {
+	const big = tdlib.JSONInt64(1<<60 + 1)
+
+	// chat.id is an int53 and stickerSetInfo.id an int64 in the schema, both are JSONInt64
+	for _, msg := range []interface{}{&tdlib.Chat{ID: big}, &tdlib.StickerSetInfo{ID: big}, &tdlib.Chat{ID: -big}} {
+		raw, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := tdlib.Decode(raw)
+		if err != nil {
+			t.Fatalf("Decode(%s) failed: %v", raw, err)
+		}
+
+		var want, got tdlib.JSONInt64
+		switch msg := msg.(type) {
+		case *tdlib.Chat:
+			want, got = msg.ID, decoded.(*tdlib.Chat).ID
+		case *tdlib.StickerSetInfo:
+			want, got = msg.ID, decoded.(*tdlib.StickerSetInfo).ID
+		}
+		if got != want {
+			t.Errorf("%s decoded into %d, want %d", raw, got, want)
+		}
+		if encoded := fmt.Sprintf(`"id":"%d"`, int64(want)); !strings.Contains(string(raw), encoded) {
+			t.Errorf("%s doesn't contain %s", raw, encoded)
+		}
+	}
+}
+
+func TestJSONInt64Unmarshal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  tdlib.JSONInt64
+	}{
+		{`"1152921504606846977"`, 1<<60 + 1},
+		{`1152921504606846977`, 1<<60 + 1},
+		{`"-9223372036854775808"`, -1 << 63},
+		{`9223372036854775807`, 1<<63 - 1},
+		{`null`, 0},
+	}
+
+	for _, test := range tests {
+		var value struct {
+			ID tdlib.JSONInt64 `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(`{"id":`+test.input+`}`), &value); err != nil {
+			t.Errorf("unmarshaling %s failed: %v", test.input, err)
+			continue
+		}
+		if value.ID != test.want {
+			t.Errorf("%s unmarshaled into %d, want %d", test.input, value.ID, test.want)
+		}
+	}
+
+	var value tdlib.JSONInt64
+	if err := json.Unmarshal([]byte(`"12a"`), &value); err == nil {
+		t.Errorf("unmarshaling \"12a\" succeeded with %d", value)
+	}
+}
+
+func TestStructLiteralsSentWithType(t *testing.T) {
+	server := tdlibtest.NewServer()
+	server.Handle("sendMessage", &tdlib.Message{ID: 1, ChatID: 7})
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	content := &tdlib.InputMessageText{Text: &tdlib.FormattedText{Text: "hello"}}
+	markup := &tdlib.ReplyMarkupInlineKeyboard{Rows: [][]tdlib.InlineKeyboardButton{{{Text: "ok", Type: &tdlib.InlineKeyboardButtonTypeCallback{Data: []byte("ok")}}}}}
+
+	// through a generated method and through Invoke with a request literal
+	if _, err := client.SendMessage(7, 0, 0, nil, markup, content); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tdlib.Invoke[*tdlib.Message](context.Background(), client, &tdlib.SendMessageRequest{ChatID: 7, ReplyMarkup: markup, InputMessageContent: content}); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(requests))
+	}
+	for _, request := range requests {
+		for _, path := range []struct {
+			keys []string
+			want string
+		}{
+			{[]string{"input_message_content"}, "inputMessageText"},
+			{[]string{"input_message_content", "text"}, "formattedText"},
+			{[]string{"reply_markup"}, "replyMarkupInlineKeyboard"},
+		} {
+			if got := typeAt(request, path.keys...); got != path.want {
+				t.Errorf("@type of %s is %q, want %q in %v", strings.Join(path.keys, "."), got, path.want, request)
+			}
+		}
+
+		button := request["reply_markup"].(map[string]interface{})["rows"].([]interface{})[0].([]interface{})[0]
+		if got := typeAt(button.(map[string]interface{}), "type"); got != "inlineKeyboardButtonTypeCallback" {
+			t.Errorf("@type of the button type is %q in %v", got, button)
+		}
+	}
+}
+
+// typeAt returns the @type of the object nested in data under keys
+func typeAt(data map[string]interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		data, _ = data[key].(map[string]interface{})
+	}
+	return data["@type"]
+}
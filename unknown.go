@@ -0,0 +1,2641 @@
+package tdlib
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// unknownType is the Unknown* fallback of an abstract type
+type unknownType struct {
+	prefix     string       // the common prefix of the @type of its constructors, e.g. authorizationState
+	class      reflect.Type // the abstract type, e.g. AuthorizationState
+	newUnknown func() TdMessage
+}
+
+// unknownTypes lists the Unknown* fallback of every abstract type
+var unknownTypes = []unknownType{
+	{"authenticationCodeType", reflect.TypeOf((*AuthenticationCodeType)(nil)).Elem(), func() TdMessage { return &UnknownAuthenticationCodeType{} }},
+	{"authorizationState", reflect.TypeOf((*AuthorizationState)(nil)).Elem(), func() TdMessage { return &UnknownAuthorizationState{} }},
+	{"inputFile", reflect.TypeOf((*InputFile)(nil)).Elem(), func() TdMessage { return &UnknownInputFile{} }},
+	{"thumbnailFormat", reflect.TypeOf((*ThumbnailFormat)(nil)).Elem(), func() TdMessage { return &UnknownThumbnailFormat{} }},
+	{"maskPoint", reflect.TypeOf((*MaskPoint)(nil)).Elem(), func() TdMessage { return &UnknownMaskPoint{} }},
+	{"pollType", reflect.TypeOf((*PollType)(nil)).Elem(), func() TdMessage { return &UnknownPollType{} }},
+	{"userType", reflect.TypeOf((*UserType)(nil)).Elem(), func() TdMessage { return &UnknownUserType{} }},
+	{"inputChatPhoto", reflect.TypeOf((*InputChatPhoto)(nil)).Elem(), func() TdMessage { return &UnknownInputChatPhoto{} }},
+	{"chatMemberStatus", reflect.TypeOf((*ChatMemberStatus)(nil)).Elem(), func() TdMessage { return &UnknownChatMemberStatus{} }},
+	{"chatMembersFilter", reflect.TypeOf((*ChatMembersFilter)(nil)).Elem(), func() TdMessage { return &UnknownChatMembersFilter{} }},
+	{"supergroupMembersFilter", reflect.TypeOf((*SupergroupMembersFilter)(nil)).Elem(), func() TdMessage { return &UnknownSupergroupMembersFilter{} }},
+	{"secretChatState", reflect.TypeOf((*SecretChatState)(nil)).Elem(), func() TdMessage { return &UnknownSecretChatState{} }},
+	{"messageSender", reflect.TypeOf((*MessageSender)(nil)).Elem(), func() TdMessage { return &UnknownMessageSender{} }},
+	{"messageForwardOrigin", reflect.TypeOf((*MessageForwardOrigin)(nil)).Elem(), func() TdMessage { return &UnknownMessageForwardOrigin{} }},
+	{"messageSendingState", reflect.TypeOf((*MessageSendingState)(nil)).Elem(), func() TdMessage { return &UnknownMessageSendingState{} }},
+	{"notificationSettingsScope", reflect.TypeOf((*NotificationSettingsScope)(nil)).Elem(), func() TdMessage { return &UnknownNotificationSettingsScope{} }},
+	{"chatType", reflect.TypeOf((*ChatType)(nil)).Elem(), func() TdMessage { return &UnknownChatType{} }},
+	{"chatList", reflect.TypeOf((*ChatList)(nil)).Elem(), func() TdMessage { return &UnknownChatList{} }},
+	{"chatSource", reflect.TypeOf((*ChatSource)(nil)).Elem(), func() TdMessage { return &UnknownChatSource{} }},
+	{"publicChatType", reflect.TypeOf((*PublicChatType)(nil)).Elem(), func() TdMessage { return &UnknownPublicChatType{} }},
+	{"chatActionBar", reflect.TypeOf((*ChatActionBar)(nil)).Elem(), func() TdMessage { return &UnknownChatActionBar{} }},
+	{"keyboardButtonType", reflect.TypeOf((*KeyboardButtonType)(nil)).Elem(), func() TdMessage { return &UnknownKeyboardButtonType{} }},
+	{"inlineKeyboardButtonType", reflect.TypeOf((*InlineKeyboardButtonType)(nil)).Elem(), func() TdMessage { return &UnknownInlineKeyboardButtonType{} }},
+	{"replyMarkup", reflect.TypeOf((*ReplyMarkup)(nil)).Elem(), func() TdMessage { return &UnknownReplyMarkup{} }},
+	{"loginUrlInfo", reflect.TypeOf((*LoginURLInfo)(nil)).Elem(), func() TdMessage { return &UnknownLoginURLInfo{} }},
+	{"richText", reflect.TypeOf((*RichText)(nil)).Elem(), func() TdMessage { return &UnknownRichText{} }},
+	{"pageBlockHorizontalAlignment", reflect.TypeOf((*PageBlockHorizontalAlignment)(nil)).Elem(), func() TdMessage { return &UnknownPageBlockHorizontalAlignment{} }},
+	{"pageBlockVerticalAlignment", reflect.TypeOf((*PageBlockVerticalAlignment)(nil)).Elem(), func() TdMessage { return &UnknownPageBlockVerticalAlignment{} }},
+	{"pageBlock", reflect.TypeOf((*PageBlock)(nil)).Elem(), func() TdMessage { return &UnknownPageBlock{} }},
+	{"inputCredentials", reflect.TypeOf((*InputCredentials)(nil)).Elem(), func() TdMessage { return &UnknownInputCredentials{} }},
+	{"passportElementType", reflect.TypeOf((*PassportElementType)(nil)).Elem(), func() TdMessage { return &UnknownPassportElementType{} }},
+	{"passportElement", reflect.TypeOf((*PassportElement)(nil)).Elem(), func() TdMessage { return &UnknownPassportElement{} }},
+	{"inputPassportElement", reflect.TypeOf((*InputPassportElement)(nil)).Elem(), func() TdMessage { return &UnknownInputPassportElement{} }},
+	{"passportElementErrorSource", reflect.TypeOf((*PassportElementErrorSource)(nil)).Elem(), func() TdMessage { return &UnknownPassportElementErrorSource{} }},
+	{"inputPassportElementErrorSource", reflect.TypeOf((*InputPassportElementErrorSource)(nil)).Elem(), func() TdMessage { return &UnknownInputPassportElementErrorSource{} }},
+	{"message", reflect.TypeOf((*MessageContent)(nil)).Elem(), func() TdMessage { return &UnknownMessageContent{} }},
+	{"textEntityType", reflect.TypeOf((*TextEntityType)(nil)).Elem(), func() TdMessage { return &UnknownTextEntityType{} }},
+	{"messageSchedulingState", reflect.TypeOf((*MessageSchedulingState)(nil)).Elem(), func() TdMessage { return &UnknownMessageSchedulingState{} }},
+	{"inputMessage", reflect.TypeOf((*InputMessageContent)(nil)).Elem(), func() TdMessage { return &UnknownInputMessageContent{} }},
+	{"searchMessagesFilter", reflect.TypeOf((*SearchMessagesFilter)(nil)).Elem(), func() TdMessage { return &UnknownSearchMessagesFilter{} }},
+	{"chatAction", reflect.TypeOf((*ChatAction)(nil)).Elem(), func() TdMessage { return &UnknownChatAction{} }},
+	{"userStatus", reflect.TypeOf((*UserStatus)(nil)).Elem(), func() TdMessage { return &UnknownUserStatus{} }},
+	{"callDiscardReason", reflect.TypeOf((*CallDiscardReason)(nil)).Elem(), func() TdMessage { return &UnknownCallDiscardReason{} }},
+	{"callServerType", reflect.TypeOf((*CallServerType)(nil)).Elem(), func() TdMessage { return &UnknownCallServerType{} }},
+	{"callState", reflect.TypeOf((*CallState)(nil)).Elem(), func() TdMessage { return &UnknownCallState{} }},
+	{"callProblem", reflect.TypeOf((*CallProblem)(nil)).Elem(), func() TdMessage { return &UnknownCallProblem{} }},
+	{"diceStickers", reflect.TypeOf((*DiceStickers)(nil)).Elem(), func() TdMessage { return &UnknownDiceStickers{} }},
+	{"inputInlineQueryResult", reflect.TypeOf((*InputInlineQueryResult)(nil)).Elem(), func() TdMessage { return &UnknownInputInlineQueryResult{} }},
+	{"inlineQueryResult", reflect.TypeOf((*InlineQueryResult)(nil)).Elem(), func() TdMessage { return &UnknownInlineQueryResult{} }},
+	{"callbackQueryPayload", reflect.TypeOf((*CallbackQueryPayload)(nil)).Elem(), func() TdMessage { return &UnknownCallbackQueryPayload{} }},
+	{"chatEvent", reflect.TypeOf((*ChatEventAction)(nil)).Elem(), func() TdMessage { return &UnknownChatEventAction{} }},
+	{"languagePackStringValue", reflect.TypeOf((*LanguagePackStringValue)(nil)).Elem(), func() TdMessage { return &UnknownLanguagePackStringValue{} }},
+	{"deviceToken", reflect.TypeOf((*DeviceToken)(nil)).Elem(), func() TdMessage { return &UnknownDeviceToken{} }},
+	{"backgroundFill", reflect.TypeOf((*BackgroundFill)(nil)).Elem(), func() TdMessage { return &UnknownBackgroundFill{} }},
+	{"backgroundType", reflect.TypeOf((*BackgroundType)(nil)).Elem(), func() TdMessage { return &UnknownBackgroundType{} }},
+	{"inputBackground", reflect.TypeOf((*InputBackground)(nil)).Elem(), func() TdMessage { return &UnknownInputBackground{} }},
+	{"canTransferOwnershipResult", reflect.TypeOf((*CanTransferOwnershipResult)(nil)).Elem(), func() TdMessage { return &UnknownCanTransferOwnershipResult{} }},
+	{"checkChatUsernameResult", reflect.TypeOf((*CheckChatUsernameResult)(nil)).Elem(), func() TdMessage { return &UnknownCheckChatUsernameResult{} }},
+	{"messageFileType", reflect.TypeOf((*MessageFileType)(nil)).Elem(), func() TdMessage { return &UnknownMessageFileType{} }},
+	{"pushMessageContent", reflect.TypeOf((*PushMessageContent)(nil)).Elem(), func() TdMessage { return &UnknownPushMessageContent{} }},
+	{"notificationType", reflect.TypeOf((*NotificationType)(nil)).Elem(), func() TdMessage { return &UnknownNotificationType{} }},
+	{"notificationGroupType", reflect.TypeOf((*NotificationGroupType)(nil)).Elem(), func() TdMessage { return &UnknownNotificationGroupType{} }},
+	{"optionValue", reflect.TypeOf((*OptionValue)(nil)).Elem(), func() TdMessage { return &UnknownOptionValue{} }},
+	{"jsonValue", reflect.TypeOf((*JsonValue)(nil)).Elem(), func() TdMessage { return &UnknownJsonValue{} }},
+	{"userPrivacySettingRule", reflect.TypeOf((*UserPrivacySettingRule)(nil)).Elem(), func() TdMessage { return &UnknownUserPrivacySettingRule{} }},
+	{"userPrivacySetting", reflect.TypeOf((*UserPrivacySetting)(nil)).Elem(), func() TdMessage { return &UnknownUserPrivacySetting{} }},
+	{"chatReportReason", reflect.TypeOf((*ChatReportReason)(nil)).Elem(), func() TdMessage { return &UnknownChatReportReason{} }},
+	{"fileType", reflect.TypeOf((*FileType)(nil)).Elem(), func() TdMessage { return &UnknownFileType{} }},
+	{"networkType", reflect.TypeOf((*NetworkType)(nil)).Elem(), func() TdMessage { return &UnknownNetworkType{} }},
+	{"networkStatisticsEntry", reflect.TypeOf((*NetworkStatisticsEntry)(nil)).Elem(), func() TdMessage { return &UnknownNetworkStatisticsEntry{} }},
+	{"connectionState", reflect.TypeOf((*ConnectionState)(nil)).Elem(), func() TdMessage { return &UnknownConnectionState{} }},
+	{"topChatCategory", reflect.TypeOf((*TopChatCategory)(nil)).Elem(), func() TdMessage { return &UnknownTopChatCategory{} }},
+	{"tMeUrlType", reflect.TypeOf((*TMeURLType)(nil)).Elem(), func() TdMessage { return &UnknownTMeURLType{} }},
+	{"suggestedAction", reflect.TypeOf((*SuggestedAction)(nil)).Elem(), func() TdMessage { return &UnknownSuggestedAction{} }},
+	{"textParseMode", reflect.TypeOf((*TextParseMode)(nil)).Elem(), func() TdMessage { return &UnknownTextParseMode{} }},
+	{"proxyType", reflect.TypeOf((*ProxyType)(nil)).Elem(), func() TdMessage { return &UnknownProxyType{} }},
+	{"inputSticker", reflect.TypeOf((*InputSticker)(nil)).Elem(), func() TdMessage { return &UnknownInputSticker{} }},
+	{"statisticalGraph", reflect.TypeOf((*StatisticalGraph)(nil)).Elem(), func() TdMessage { return &UnknownStatisticalGraph{} }},
+	{"chatStatistics", reflect.TypeOf((*ChatStatistics)(nil)).Elem(), func() TdMessage { return &UnknownChatStatistics{} }},
+	{"vectorPathCommand", reflect.TypeOf((*VectorPathCommand)(nil)).Elem(), func() TdMessage { return &UnknownVectorPathCommand{} }},
+	{"update", reflect.TypeOf((*Update)(nil)).Elem(), func() TdMessage { return &UnknownUpdate{} }},
+	{"logStream", reflect.TypeOf((*LogStream)(nil)).Elem(), func() TdMessage { return &UnknownLogStream{} }},
+}
+
+// UnknownAuthenticationCodeType is the AuthenticationCodeType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownAuthenticationCodeType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownAuthenticationCodeType
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) MessageType() string {
+	return unknownAuthenticationCodeType.Type
+}
+
+// GetAuthenticationCodeTypeEnum return the enum type of this object
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) GetAuthenticationCodeTypeEnum() AuthenticationCodeTypeEnum {
+	return AuthenticationCodeTypeEnum(unknownAuthenticationCodeType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownAuthenticationCodeType *UnknownAuthenticationCodeType) UnmarshalJSON(b []byte) error {
+	unknownAuthenticationCodeType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownAuthenticationCodeType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownAuthenticationCodeType UnknownAuthenticationCodeType) MarshalJSON() ([]byte, error) {
+	if unknownAuthenticationCodeType.Raw == nil {
+		return json.Marshal(unknownAuthenticationCodeType.tdCommon)
+	}
+	return unknownAuthenticationCodeType.Raw, nil
+}
+
+// UnknownAuthorizationState is the AuthorizationState fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownAuthorizationState struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownAuthorizationState
+func (unknownAuthorizationState *UnknownAuthorizationState) MessageType() string {
+	return unknownAuthorizationState.Type
+}
+
+// GetAuthorizationStateEnum return the enum type of this object
+func (unknownAuthorizationState *UnknownAuthorizationState) GetAuthorizationStateEnum() AuthorizationStateEnum {
+	return AuthorizationStateEnum(unknownAuthorizationState.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownAuthorizationState *UnknownAuthorizationState) UnmarshalJSON(b []byte) error {
+	unknownAuthorizationState.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownAuthorizationState.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownAuthorizationState UnknownAuthorizationState) MarshalJSON() ([]byte, error) {
+	if unknownAuthorizationState.Raw == nil {
+		return json.Marshal(unknownAuthorizationState.tdCommon)
+	}
+	return unknownAuthorizationState.Raw, nil
+}
+
+// UnknownInputFile is the InputFile fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputFile struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputFile
+func (unknownInputFile *UnknownInputFile) MessageType() string {
+	return unknownInputFile.Type
+}
+
+// GetInputFileEnum return the enum type of this object
+func (unknownInputFile *UnknownInputFile) GetInputFileEnum() InputFileEnum {
+	return InputFileEnum(unknownInputFile.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputFile *UnknownInputFile) UnmarshalJSON(b []byte) error {
+	unknownInputFile.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputFile.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputFile UnknownInputFile) MarshalJSON() ([]byte, error) {
+	if unknownInputFile.Raw == nil {
+		return json.Marshal(unknownInputFile.tdCommon)
+	}
+	return unknownInputFile.Raw, nil
+}
+
+// UnknownThumbnailFormat is the ThumbnailFormat fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownThumbnailFormat struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownThumbnailFormat
+func (unknownThumbnailFormat *UnknownThumbnailFormat) MessageType() string {
+	return unknownThumbnailFormat.Type
+}
+
+// GetThumbnailFormatEnum return the enum type of this object
+func (unknownThumbnailFormat *UnknownThumbnailFormat) GetThumbnailFormatEnum() ThumbnailFormatEnum {
+	return ThumbnailFormatEnum(unknownThumbnailFormat.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownThumbnailFormat *UnknownThumbnailFormat) UnmarshalJSON(b []byte) error {
+	unknownThumbnailFormat.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownThumbnailFormat.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownThumbnailFormat UnknownThumbnailFormat) MarshalJSON() ([]byte, error) {
+	if unknownThumbnailFormat.Raw == nil {
+		return json.Marshal(unknownThumbnailFormat.tdCommon)
+	}
+	return unknownThumbnailFormat.Raw, nil
+}
+
+// UnknownMaskPoint is the MaskPoint fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMaskPoint struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMaskPoint
+func (unknownMaskPoint *UnknownMaskPoint) MessageType() string {
+	return unknownMaskPoint.Type
+}
+
+// GetMaskPointEnum return the enum type of this object
+func (unknownMaskPoint *UnknownMaskPoint) GetMaskPointEnum() MaskPointEnum {
+	return MaskPointEnum(unknownMaskPoint.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMaskPoint *UnknownMaskPoint) UnmarshalJSON(b []byte) error {
+	unknownMaskPoint.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMaskPoint.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMaskPoint UnknownMaskPoint) MarshalJSON() ([]byte, error) {
+	if unknownMaskPoint.Raw == nil {
+		return json.Marshal(unknownMaskPoint.tdCommon)
+	}
+	return unknownMaskPoint.Raw, nil
+}
+
+// UnknownPollType is the PollType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPollType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPollType
+func (unknownPollType *UnknownPollType) MessageType() string {
+	return unknownPollType.Type
+}
+
+// GetPollTypeEnum return the enum type of this object
+func (unknownPollType *UnknownPollType) GetPollTypeEnum() PollTypeEnum {
+	return PollTypeEnum(unknownPollType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPollType *UnknownPollType) UnmarshalJSON(b []byte) error {
+	unknownPollType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPollType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPollType UnknownPollType) MarshalJSON() ([]byte, error) {
+	if unknownPollType.Raw == nil {
+		return json.Marshal(unknownPollType.tdCommon)
+	}
+	return unknownPollType.Raw, nil
+}
+
+// UnknownUserType is the UserType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownUserType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownUserType
+func (unknownUserType *UnknownUserType) MessageType() string {
+	return unknownUserType.Type
+}
+
+// GetUserTypeEnum return the enum type of this object
+func (unknownUserType *UnknownUserType) GetUserTypeEnum() UserTypeEnum {
+	return UserTypeEnum(unknownUserType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownUserType *UnknownUserType) UnmarshalJSON(b []byte) error {
+	unknownUserType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownUserType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownUserType UnknownUserType) MarshalJSON() ([]byte, error) {
+	if unknownUserType.Raw == nil {
+		return json.Marshal(unknownUserType.tdCommon)
+	}
+	return unknownUserType.Raw, nil
+}
+
+// UnknownInputChatPhoto is the InputChatPhoto fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputChatPhoto struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputChatPhoto
+func (unknownInputChatPhoto *UnknownInputChatPhoto) MessageType() string {
+	return unknownInputChatPhoto.Type
+}
+
+// GetInputChatPhotoEnum return the enum type of this object
+func (unknownInputChatPhoto *UnknownInputChatPhoto) GetInputChatPhotoEnum() InputChatPhotoEnum {
+	return InputChatPhotoEnum(unknownInputChatPhoto.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputChatPhoto *UnknownInputChatPhoto) UnmarshalJSON(b []byte) error {
+	unknownInputChatPhoto.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputChatPhoto.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputChatPhoto UnknownInputChatPhoto) MarshalJSON() ([]byte, error) {
+	if unknownInputChatPhoto.Raw == nil {
+		return json.Marshal(unknownInputChatPhoto.tdCommon)
+	}
+	return unknownInputChatPhoto.Raw, nil
+}
+
+// UnknownChatMemberStatus is the ChatMemberStatus fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatMemberStatus struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatMemberStatus
+func (unknownChatMemberStatus *UnknownChatMemberStatus) MessageType() string {
+	return unknownChatMemberStatus.Type
+}
+
+// GetChatMemberStatusEnum return the enum type of this object
+func (unknownChatMemberStatus *UnknownChatMemberStatus) GetChatMemberStatusEnum() ChatMemberStatusEnum {
+	return ChatMemberStatusEnum(unknownChatMemberStatus.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatMemberStatus *UnknownChatMemberStatus) UnmarshalJSON(b []byte) error {
+	unknownChatMemberStatus.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatMemberStatus.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatMemberStatus UnknownChatMemberStatus) MarshalJSON() ([]byte, error) {
+	if unknownChatMemberStatus.Raw == nil {
+		return json.Marshal(unknownChatMemberStatus.tdCommon)
+	}
+	return unknownChatMemberStatus.Raw, nil
+}
+
+// UnknownChatMembersFilter is the ChatMembersFilter fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatMembersFilter struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatMembersFilter
+func (unknownChatMembersFilter *UnknownChatMembersFilter) MessageType() string {
+	return unknownChatMembersFilter.Type
+}
+
+// GetChatMembersFilterEnum return the enum type of this object
+func (unknownChatMembersFilter *UnknownChatMembersFilter) GetChatMembersFilterEnum() ChatMembersFilterEnum {
+	return ChatMembersFilterEnum(unknownChatMembersFilter.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatMembersFilter *UnknownChatMembersFilter) UnmarshalJSON(b []byte) error {
+	unknownChatMembersFilter.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatMembersFilter.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatMembersFilter UnknownChatMembersFilter) MarshalJSON() ([]byte, error) {
+	if unknownChatMembersFilter.Raw == nil {
+		return json.Marshal(unknownChatMembersFilter.tdCommon)
+	}
+	return unknownChatMembersFilter.Raw, nil
+}
+
+// UnknownSupergroupMembersFilter is the SupergroupMembersFilter fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownSupergroupMembersFilter struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownSupergroupMembersFilter
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) MessageType() string {
+	return unknownSupergroupMembersFilter.Type
+}
+
+// GetSupergroupMembersFilterEnum return the enum type of this object
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) GetSupergroupMembersFilterEnum() SupergroupMembersFilterEnum {
+	return SupergroupMembersFilterEnum(unknownSupergroupMembersFilter.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownSupergroupMembersFilter *UnknownSupergroupMembersFilter) UnmarshalJSON(b []byte) error {
+	unknownSupergroupMembersFilter.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownSupergroupMembersFilter.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownSupergroupMembersFilter UnknownSupergroupMembersFilter) MarshalJSON() ([]byte, error) {
+	if unknownSupergroupMembersFilter.Raw == nil {
+		return json.Marshal(unknownSupergroupMembersFilter.tdCommon)
+	}
+	return unknownSupergroupMembersFilter.Raw, nil
+}
+
+// UnknownSecretChatState is the SecretChatState fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownSecretChatState struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownSecretChatState
+func (unknownSecretChatState *UnknownSecretChatState) MessageType() string {
+	return unknownSecretChatState.Type
+}
+
+// GetSecretChatStateEnum return the enum type of this object
+func (unknownSecretChatState *UnknownSecretChatState) GetSecretChatStateEnum() SecretChatStateEnum {
+	return SecretChatStateEnum(unknownSecretChatState.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownSecretChatState *UnknownSecretChatState) UnmarshalJSON(b []byte) error {
+	unknownSecretChatState.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownSecretChatState.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownSecretChatState UnknownSecretChatState) MarshalJSON() ([]byte, error) {
+	if unknownSecretChatState.Raw == nil {
+		return json.Marshal(unknownSecretChatState.tdCommon)
+	}
+	return unknownSecretChatState.Raw, nil
+}
+
+// UnknownMessageSender is the MessageSender fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMessageSender struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMessageSender
+func (unknownMessageSender *UnknownMessageSender) MessageType() string {
+	return unknownMessageSender.Type
+}
+
+// GetMessageSenderEnum return the enum type of this object
+func (unknownMessageSender *UnknownMessageSender) GetMessageSenderEnum() MessageSenderEnum {
+	return MessageSenderEnum(unknownMessageSender.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMessageSender *UnknownMessageSender) UnmarshalJSON(b []byte) error {
+	unknownMessageSender.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMessageSender.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMessageSender UnknownMessageSender) MarshalJSON() ([]byte, error) {
+	if unknownMessageSender.Raw == nil {
+		return json.Marshal(unknownMessageSender.tdCommon)
+	}
+	return unknownMessageSender.Raw, nil
+}
+
+// UnknownMessageForwardOrigin is the MessageForwardOrigin fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMessageForwardOrigin struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMessageForwardOrigin
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) MessageType() string {
+	return unknownMessageForwardOrigin.Type
+}
+
+// GetMessageForwardOriginEnum return the enum type of this object
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) GetMessageForwardOriginEnum() MessageForwardOriginEnum {
+	return MessageForwardOriginEnum(unknownMessageForwardOrigin.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMessageForwardOrigin *UnknownMessageForwardOrigin) UnmarshalJSON(b []byte) error {
+	unknownMessageForwardOrigin.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMessageForwardOrigin.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMessageForwardOrigin UnknownMessageForwardOrigin) MarshalJSON() ([]byte, error) {
+	if unknownMessageForwardOrigin.Raw == nil {
+		return json.Marshal(unknownMessageForwardOrigin.tdCommon)
+	}
+	return unknownMessageForwardOrigin.Raw, nil
+}
+
+// UnknownMessageSendingState is the MessageSendingState fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMessageSendingState struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMessageSendingState
+func (unknownMessageSendingState *UnknownMessageSendingState) MessageType() string {
+	return unknownMessageSendingState.Type
+}
+
+// GetMessageSendingStateEnum return the enum type of this object
+func (unknownMessageSendingState *UnknownMessageSendingState) GetMessageSendingStateEnum() MessageSendingStateEnum {
+	return MessageSendingStateEnum(unknownMessageSendingState.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMessageSendingState *UnknownMessageSendingState) UnmarshalJSON(b []byte) error {
+	unknownMessageSendingState.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMessageSendingState.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMessageSendingState UnknownMessageSendingState) MarshalJSON() ([]byte, error) {
+	if unknownMessageSendingState.Raw == nil {
+		return json.Marshal(unknownMessageSendingState.tdCommon)
+	}
+	return unknownMessageSendingState.Raw, nil
+}
+
+// UnknownNotificationSettingsScope is the NotificationSettingsScope fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownNotificationSettingsScope struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownNotificationSettingsScope
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) MessageType() string {
+	return unknownNotificationSettingsScope.Type
+}
+
+// GetNotificationSettingsScopeEnum return the enum type of this object
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) GetNotificationSettingsScopeEnum() NotificationSettingsScopeEnum {
+	return NotificationSettingsScopeEnum(unknownNotificationSettingsScope.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownNotificationSettingsScope *UnknownNotificationSettingsScope) UnmarshalJSON(b []byte) error {
+	unknownNotificationSettingsScope.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownNotificationSettingsScope.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownNotificationSettingsScope UnknownNotificationSettingsScope) MarshalJSON() ([]byte, error) {
+	if unknownNotificationSettingsScope.Raw == nil {
+		return json.Marshal(unknownNotificationSettingsScope.tdCommon)
+	}
+	return unknownNotificationSettingsScope.Raw, nil
+}
+
+// UnknownChatType is the ChatType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatType
+func (unknownChatType *UnknownChatType) MessageType() string {
+	return unknownChatType.Type
+}
+
+// GetChatTypeEnum return the enum type of this object
+func (unknownChatType *UnknownChatType) GetChatTypeEnum() ChatTypeEnum {
+	return ChatTypeEnum(unknownChatType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatType *UnknownChatType) UnmarshalJSON(b []byte) error {
+	unknownChatType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatType UnknownChatType) MarshalJSON() ([]byte, error) {
+	if unknownChatType.Raw == nil {
+		return json.Marshal(unknownChatType.tdCommon)
+	}
+	return unknownChatType.Raw, nil
+}
+
+// UnknownChatList is the ChatList fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatList struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatList
+func (unknownChatList *UnknownChatList) MessageType() string {
+	return unknownChatList.Type
+}
+
+// GetChatListEnum return the enum type of this object
+func (unknownChatList *UnknownChatList) GetChatListEnum() ChatListEnum {
+	return ChatListEnum(unknownChatList.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatList *UnknownChatList) UnmarshalJSON(b []byte) error {
+	unknownChatList.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatList.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatList UnknownChatList) MarshalJSON() ([]byte, error) {
+	if unknownChatList.Raw == nil {
+		return json.Marshal(unknownChatList.tdCommon)
+	}
+	return unknownChatList.Raw, nil
+}
+
+// UnknownChatSource is the ChatSource fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatSource struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatSource
+func (unknownChatSource *UnknownChatSource) MessageType() string {
+	return unknownChatSource.Type
+}
+
+// GetChatSourceEnum return the enum type of this object
+func (unknownChatSource *UnknownChatSource) GetChatSourceEnum() ChatSourceEnum {
+	return ChatSourceEnum(unknownChatSource.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatSource *UnknownChatSource) UnmarshalJSON(b []byte) error {
+	unknownChatSource.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatSource.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatSource UnknownChatSource) MarshalJSON() ([]byte, error) {
+	if unknownChatSource.Raw == nil {
+		return json.Marshal(unknownChatSource.tdCommon)
+	}
+	return unknownChatSource.Raw, nil
+}
+
+// UnknownPublicChatType is the PublicChatType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPublicChatType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPublicChatType
+func (unknownPublicChatType *UnknownPublicChatType) MessageType() string {
+	return unknownPublicChatType.Type
+}
+
+// GetPublicChatTypeEnum return the enum type of this object
+func (unknownPublicChatType *UnknownPublicChatType) GetPublicChatTypeEnum() PublicChatTypeEnum {
+	return PublicChatTypeEnum(unknownPublicChatType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPublicChatType *UnknownPublicChatType) UnmarshalJSON(b []byte) error {
+	unknownPublicChatType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPublicChatType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPublicChatType UnknownPublicChatType) MarshalJSON() ([]byte, error) {
+	if unknownPublicChatType.Raw == nil {
+		return json.Marshal(unknownPublicChatType.tdCommon)
+	}
+	return unknownPublicChatType.Raw, nil
+}
+
+// UnknownChatActionBar is the ChatActionBar fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatActionBar struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatActionBar
+func (unknownChatActionBar *UnknownChatActionBar) MessageType() string {
+	return unknownChatActionBar.Type
+}
+
+// GetChatActionBarEnum return the enum type of this object
+func (unknownChatActionBar *UnknownChatActionBar) GetChatActionBarEnum() ChatActionBarEnum {
+	return ChatActionBarEnum(unknownChatActionBar.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatActionBar *UnknownChatActionBar) UnmarshalJSON(b []byte) error {
+	unknownChatActionBar.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatActionBar.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatActionBar UnknownChatActionBar) MarshalJSON() ([]byte, error) {
+	if unknownChatActionBar.Raw == nil {
+		return json.Marshal(unknownChatActionBar.tdCommon)
+	}
+	return unknownChatActionBar.Raw, nil
+}
+
+// UnknownKeyboardButtonType is the KeyboardButtonType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownKeyboardButtonType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownKeyboardButtonType
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) MessageType() string {
+	return unknownKeyboardButtonType.Type
+}
+
+// GetKeyboardButtonTypeEnum return the enum type of this object
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) GetKeyboardButtonTypeEnum() KeyboardButtonTypeEnum {
+	return KeyboardButtonTypeEnum(unknownKeyboardButtonType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownKeyboardButtonType *UnknownKeyboardButtonType) UnmarshalJSON(b []byte) error {
+	unknownKeyboardButtonType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownKeyboardButtonType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownKeyboardButtonType UnknownKeyboardButtonType) MarshalJSON() ([]byte, error) {
+	if unknownKeyboardButtonType.Raw == nil {
+		return json.Marshal(unknownKeyboardButtonType.tdCommon)
+	}
+	return unknownKeyboardButtonType.Raw, nil
+}
+
+// UnknownInlineKeyboardButtonType is the InlineKeyboardButtonType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInlineKeyboardButtonType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInlineKeyboardButtonType
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) MessageType() string {
+	return unknownInlineKeyboardButtonType.Type
+}
+
+// GetInlineKeyboardButtonTypeEnum return the enum type of this object
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) GetInlineKeyboardButtonTypeEnum() InlineKeyboardButtonTypeEnum {
+	return InlineKeyboardButtonTypeEnum(unknownInlineKeyboardButtonType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInlineKeyboardButtonType *UnknownInlineKeyboardButtonType) UnmarshalJSON(b []byte) error {
+	unknownInlineKeyboardButtonType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInlineKeyboardButtonType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInlineKeyboardButtonType UnknownInlineKeyboardButtonType) MarshalJSON() ([]byte, error) {
+	if unknownInlineKeyboardButtonType.Raw == nil {
+		return json.Marshal(unknownInlineKeyboardButtonType.tdCommon)
+	}
+	return unknownInlineKeyboardButtonType.Raw, nil
+}
+
+// UnknownReplyMarkup is the ReplyMarkup fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownReplyMarkup struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownReplyMarkup
+func (unknownReplyMarkup *UnknownReplyMarkup) MessageType() string {
+	return unknownReplyMarkup.Type
+}
+
+// GetReplyMarkupEnum return the enum type of this object
+func (unknownReplyMarkup *UnknownReplyMarkup) GetReplyMarkupEnum() ReplyMarkupEnum {
+	return ReplyMarkupEnum(unknownReplyMarkup.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownReplyMarkup *UnknownReplyMarkup) UnmarshalJSON(b []byte) error {
+	unknownReplyMarkup.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownReplyMarkup.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownReplyMarkup UnknownReplyMarkup) MarshalJSON() ([]byte, error) {
+	if unknownReplyMarkup.Raw == nil {
+		return json.Marshal(unknownReplyMarkup.tdCommon)
+	}
+	return unknownReplyMarkup.Raw, nil
+}
+
+// UnknownLoginURLInfo is the LoginURLInfo fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownLoginURLInfo struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownLoginURLInfo
+func (unknownLoginURLInfo *UnknownLoginURLInfo) MessageType() string {
+	return unknownLoginURLInfo.Type
+}
+
+// GetLoginURLInfoEnum return the enum type of this object
+func (unknownLoginURLInfo *UnknownLoginURLInfo) GetLoginURLInfoEnum() LoginURLInfoEnum {
+	return LoginURLInfoEnum(unknownLoginURLInfo.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownLoginURLInfo *UnknownLoginURLInfo) UnmarshalJSON(b []byte) error {
+	unknownLoginURLInfo.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownLoginURLInfo.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownLoginURLInfo UnknownLoginURLInfo) MarshalJSON() ([]byte, error) {
+	if unknownLoginURLInfo.Raw == nil {
+		return json.Marshal(unknownLoginURLInfo.tdCommon)
+	}
+	return unknownLoginURLInfo.Raw, nil
+}
+
+// UnknownRichText is the RichText fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownRichText struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownRichText
+func (unknownRichText *UnknownRichText) MessageType() string {
+	return unknownRichText.Type
+}
+
+// GetRichTextEnum return the enum type of this object
+func (unknownRichText *UnknownRichText) GetRichTextEnum() RichTextEnum {
+	return RichTextEnum(unknownRichText.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownRichText *UnknownRichText) UnmarshalJSON(b []byte) error {
+	unknownRichText.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownRichText.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownRichText UnknownRichText) MarshalJSON() ([]byte, error) {
+	if unknownRichText.Raw == nil {
+		return json.Marshal(unknownRichText.tdCommon)
+	}
+	return unknownRichText.Raw, nil
+}
+
+// UnknownPageBlockHorizontalAlignment is the PageBlockHorizontalAlignment fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPageBlockHorizontalAlignment struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPageBlockHorizontalAlignment
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) MessageType() string {
+	return unknownPageBlockHorizontalAlignment.Type
+}
+
+// GetPageBlockHorizontalAlignmentEnum return the enum type of this object
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) GetPageBlockHorizontalAlignmentEnum() PageBlockHorizontalAlignmentEnum {
+	return PageBlockHorizontalAlignmentEnum(unknownPageBlockHorizontalAlignment.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPageBlockHorizontalAlignment *UnknownPageBlockHorizontalAlignment) UnmarshalJSON(b []byte) error {
+	unknownPageBlockHorizontalAlignment.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPageBlockHorizontalAlignment.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPageBlockHorizontalAlignment UnknownPageBlockHorizontalAlignment) MarshalJSON() ([]byte, error) {
+	if unknownPageBlockHorizontalAlignment.Raw == nil {
+		return json.Marshal(unknownPageBlockHorizontalAlignment.tdCommon)
+	}
+	return unknownPageBlockHorizontalAlignment.Raw, nil
+}
+
+// UnknownPageBlockVerticalAlignment is the PageBlockVerticalAlignment fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPageBlockVerticalAlignment struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPageBlockVerticalAlignment
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) MessageType() string {
+	return unknownPageBlockVerticalAlignment.Type
+}
+
+// GetPageBlockVerticalAlignmentEnum return the enum type of this object
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) GetPageBlockVerticalAlignmentEnum() PageBlockVerticalAlignmentEnum {
+	return PageBlockVerticalAlignmentEnum(unknownPageBlockVerticalAlignment.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPageBlockVerticalAlignment *UnknownPageBlockVerticalAlignment) UnmarshalJSON(b []byte) error {
+	unknownPageBlockVerticalAlignment.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPageBlockVerticalAlignment.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPageBlockVerticalAlignment UnknownPageBlockVerticalAlignment) MarshalJSON() ([]byte, error) {
+	if unknownPageBlockVerticalAlignment.Raw == nil {
+		return json.Marshal(unknownPageBlockVerticalAlignment.tdCommon)
+	}
+	return unknownPageBlockVerticalAlignment.Raw, nil
+}
+
+// UnknownPageBlock is the PageBlock fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPageBlock struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPageBlock
+func (unknownPageBlock *UnknownPageBlock) MessageType() string {
+	return unknownPageBlock.Type
+}
+
+// GetPageBlockEnum return the enum type of this object
+func (unknownPageBlock *UnknownPageBlock) GetPageBlockEnum() PageBlockEnum {
+	return PageBlockEnum(unknownPageBlock.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPageBlock *UnknownPageBlock) UnmarshalJSON(b []byte) error {
+	unknownPageBlock.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPageBlock.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPageBlock UnknownPageBlock) MarshalJSON() ([]byte, error) {
+	if unknownPageBlock.Raw == nil {
+		return json.Marshal(unknownPageBlock.tdCommon)
+	}
+	return unknownPageBlock.Raw, nil
+}
+
+// UnknownInputCredentials is the InputCredentials fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputCredentials struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputCredentials
+func (unknownInputCredentials *UnknownInputCredentials) MessageType() string {
+	return unknownInputCredentials.Type
+}
+
+// GetInputCredentialsEnum return the enum type of this object
+func (unknownInputCredentials *UnknownInputCredentials) GetInputCredentialsEnum() InputCredentialsEnum {
+	return InputCredentialsEnum(unknownInputCredentials.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputCredentials *UnknownInputCredentials) UnmarshalJSON(b []byte) error {
+	unknownInputCredentials.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputCredentials.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputCredentials UnknownInputCredentials) MarshalJSON() ([]byte, error) {
+	if unknownInputCredentials.Raw == nil {
+		return json.Marshal(unknownInputCredentials.tdCommon)
+	}
+	return unknownInputCredentials.Raw, nil
+}
+
+// UnknownPassportElementType is the PassportElementType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPassportElementType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPassportElementType
+func (unknownPassportElementType *UnknownPassportElementType) MessageType() string {
+	return unknownPassportElementType.Type
+}
+
+// GetPassportElementTypeEnum return the enum type of this object
+func (unknownPassportElementType *UnknownPassportElementType) GetPassportElementTypeEnum() PassportElementTypeEnum {
+	return PassportElementTypeEnum(unknownPassportElementType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPassportElementType *UnknownPassportElementType) UnmarshalJSON(b []byte) error {
+	unknownPassportElementType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPassportElementType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPassportElementType UnknownPassportElementType) MarshalJSON() ([]byte, error) {
+	if unknownPassportElementType.Raw == nil {
+		return json.Marshal(unknownPassportElementType.tdCommon)
+	}
+	return unknownPassportElementType.Raw, nil
+}
+
+// UnknownPassportElement is the PassportElement fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPassportElement struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPassportElement
+func (unknownPassportElement *UnknownPassportElement) MessageType() string {
+	return unknownPassportElement.Type
+}
+
+// GetPassportElementEnum return the enum type of this object
+func (unknownPassportElement *UnknownPassportElement) GetPassportElementEnum() PassportElementEnum {
+	return PassportElementEnum(unknownPassportElement.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPassportElement *UnknownPassportElement) UnmarshalJSON(b []byte) error {
+	unknownPassportElement.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPassportElement.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPassportElement UnknownPassportElement) MarshalJSON() ([]byte, error) {
+	if unknownPassportElement.Raw == nil {
+		return json.Marshal(unknownPassportElement.tdCommon)
+	}
+	return unknownPassportElement.Raw, nil
+}
+
+// UnknownInputPassportElement is the InputPassportElement fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputPassportElement struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputPassportElement
+func (unknownInputPassportElement *UnknownInputPassportElement) MessageType() string {
+	return unknownInputPassportElement.Type
+}
+
+// GetInputPassportElementEnum return the enum type of this object
+func (unknownInputPassportElement *UnknownInputPassportElement) GetInputPassportElementEnum() InputPassportElementEnum {
+	return InputPassportElementEnum(unknownInputPassportElement.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputPassportElement *UnknownInputPassportElement) UnmarshalJSON(b []byte) error {
+	unknownInputPassportElement.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputPassportElement.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputPassportElement UnknownInputPassportElement) MarshalJSON() ([]byte, error) {
+	if unknownInputPassportElement.Raw == nil {
+		return json.Marshal(unknownInputPassportElement.tdCommon)
+	}
+	return unknownInputPassportElement.Raw, nil
+}
+
+// UnknownPassportElementErrorSource is the PassportElementErrorSource fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPassportElementErrorSource struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPassportElementErrorSource
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) MessageType() string {
+	return unknownPassportElementErrorSource.Type
+}
+
+// GetPassportElementErrorSourceEnum return the enum type of this object
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) GetPassportElementErrorSourceEnum() PassportElementErrorSourceEnum {
+	return PassportElementErrorSourceEnum(unknownPassportElementErrorSource.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPassportElementErrorSource *UnknownPassportElementErrorSource) UnmarshalJSON(b []byte) error {
+	unknownPassportElementErrorSource.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPassportElementErrorSource.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPassportElementErrorSource UnknownPassportElementErrorSource) MarshalJSON() ([]byte, error) {
+	if unknownPassportElementErrorSource.Raw == nil {
+		return json.Marshal(unknownPassportElementErrorSource.tdCommon)
+	}
+	return unknownPassportElementErrorSource.Raw, nil
+}
+
+// UnknownInputPassportElementErrorSource is the InputPassportElementErrorSource fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputPassportElementErrorSource struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputPassportElementErrorSource
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) MessageType() string {
+	return unknownInputPassportElementErrorSource.Type
+}
+
+// GetInputPassportElementErrorSourceEnum return the enum type of this object
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) GetInputPassportElementErrorSourceEnum() InputPassportElementErrorSourceEnum {
+	return InputPassportElementErrorSourceEnum(unknownInputPassportElementErrorSource.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputPassportElementErrorSource *UnknownInputPassportElementErrorSource) UnmarshalJSON(b []byte) error {
+	unknownInputPassportElementErrorSource.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputPassportElementErrorSource.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputPassportElementErrorSource UnknownInputPassportElementErrorSource) MarshalJSON() ([]byte, error) {
+	if unknownInputPassportElementErrorSource.Raw == nil {
+		return json.Marshal(unknownInputPassportElementErrorSource.tdCommon)
+	}
+	return unknownInputPassportElementErrorSource.Raw, nil
+}
+
+// UnknownMessageContent is the MessageContent fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMessageContent struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMessageContent
+func (unknownMessageContent *UnknownMessageContent) MessageType() string {
+	return unknownMessageContent.Type
+}
+
+// GetMessageContentEnum return the enum type of this object
+func (unknownMessageContent *UnknownMessageContent) GetMessageContentEnum() MessageContentEnum {
+	return MessageContentEnum(unknownMessageContent.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMessageContent *UnknownMessageContent) UnmarshalJSON(b []byte) error {
+	unknownMessageContent.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMessageContent.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMessageContent UnknownMessageContent) MarshalJSON() ([]byte, error) {
+	if unknownMessageContent.Raw == nil {
+		return json.Marshal(unknownMessageContent.tdCommon)
+	}
+	return unknownMessageContent.Raw, nil
+}
+
+// UnknownTextEntityType is the TextEntityType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownTextEntityType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownTextEntityType
+func (unknownTextEntityType *UnknownTextEntityType) MessageType() string {
+	return unknownTextEntityType.Type
+}
+
+// GetTextEntityTypeEnum return the enum type of this object
+func (unknownTextEntityType *UnknownTextEntityType) GetTextEntityTypeEnum() TextEntityTypeEnum {
+	return TextEntityTypeEnum(unknownTextEntityType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownTextEntityType *UnknownTextEntityType) UnmarshalJSON(b []byte) error {
+	unknownTextEntityType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownTextEntityType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownTextEntityType UnknownTextEntityType) MarshalJSON() ([]byte, error) {
+	if unknownTextEntityType.Raw == nil {
+		return json.Marshal(unknownTextEntityType.tdCommon)
+	}
+	return unknownTextEntityType.Raw, nil
+}
+
+// UnknownMessageSchedulingState is the MessageSchedulingState fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMessageSchedulingState struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMessageSchedulingState
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) MessageType() string {
+	return unknownMessageSchedulingState.Type
+}
+
+// GetMessageSchedulingStateEnum return the enum type of this object
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) GetMessageSchedulingStateEnum() MessageSchedulingStateEnum {
+	return MessageSchedulingStateEnum(unknownMessageSchedulingState.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMessageSchedulingState *UnknownMessageSchedulingState) UnmarshalJSON(b []byte) error {
+	unknownMessageSchedulingState.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMessageSchedulingState.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMessageSchedulingState UnknownMessageSchedulingState) MarshalJSON() ([]byte, error) {
+	if unknownMessageSchedulingState.Raw == nil {
+		return json.Marshal(unknownMessageSchedulingState.tdCommon)
+	}
+	return unknownMessageSchedulingState.Raw, nil
+}
+
+// UnknownInputMessageContent is the InputMessageContent fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputMessageContent struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputMessageContent
+func (unknownInputMessageContent *UnknownInputMessageContent) MessageType() string {
+	return unknownInputMessageContent.Type
+}
+
+// GetInputMessageContentEnum return the enum type of this object
+func (unknownInputMessageContent *UnknownInputMessageContent) GetInputMessageContentEnum() InputMessageContentEnum {
+	return InputMessageContentEnum(unknownInputMessageContent.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputMessageContent *UnknownInputMessageContent) UnmarshalJSON(b []byte) error {
+	unknownInputMessageContent.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputMessageContent.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputMessageContent UnknownInputMessageContent) MarshalJSON() ([]byte, error) {
+	if unknownInputMessageContent.Raw == nil {
+		return json.Marshal(unknownInputMessageContent.tdCommon)
+	}
+	return unknownInputMessageContent.Raw, nil
+}
+
+// UnknownSearchMessagesFilter is the SearchMessagesFilter fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownSearchMessagesFilter struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownSearchMessagesFilter
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) MessageType() string {
+	return unknownSearchMessagesFilter.Type
+}
+
+// GetSearchMessagesFilterEnum return the enum type of this object
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) GetSearchMessagesFilterEnum() SearchMessagesFilterEnum {
+	return SearchMessagesFilterEnum(unknownSearchMessagesFilter.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownSearchMessagesFilter *UnknownSearchMessagesFilter) UnmarshalJSON(b []byte) error {
+	unknownSearchMessagesFilter.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownSearchMessagesFilter.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownSearchMessagesFilter UnknownSearchMessagesFilter) MarshalJSON() ([]byte, error) {
+	if unknownSearchMessagesFilter.Raw == nil {
+		return json.Marshal(unknownSearchMessagesFilter.tdCommon)
+	}
+	return unknownSearchMessagesFilter.Raw, nil
+}
+
+// UnknownChatAction is the ChatAction fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatAction struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatAction
+func (unknownChatAction *UnknownChatAction) MessageType() string {
+	return unknownChatAction.Type
+}
+
+// GetChatActionEnum return the enum type of this object
+func (unknownChatAction *UnknownChatAction) GetChatActionEnum() ChatActionEnum {
+	return ChatActionEnum(unknownChatAction.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatAction *UnknownChatAction) UnmarshalJSON(b []byte) error {
+	unknownChatAction.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatAction.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatAction UnknownChatAction) MarshalJSON() ([]byte, error) {
+	if unknownChatAction.Raw == nil {
+		return json.Marshal(unknownChatAction.tdCommon)
+	}
+	return unknownChatAction.Raw, nil
+}
+
+// UnknownUserStatus is the UserStatus fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownUserStatus struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownUserStatus
+func (unknownUserStatus *UnknownUserStatus) MessageType() string {
+	return unknownUserStatus.Type
+}
+
+// GetUserStatusEnum return the enum type of this object
+func (unknownUserStatus *UnknownUserStatus) GetUserStatusEnum() UserStatusEnum {
+	return UserStatusEnum(unknownUserStatus.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownUserStatus *UnknownUserStatus) UnmarshalJSON(b []byte) error {
+	unknownUserStatus.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownUserStatus.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownUserStatus UnknownUserStatus) MarshalJSON() ([]byte, error) {
+	if unknownUserStatus.Raw == nil {
+		return json.Marshal(unknownUserStatus.tdCommon)
+	}
+	return unknownUserStatus.Raw, nil
+}
+
+// UnknownCallDiscardReason is the CallDiscardReason fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCallDiscardReason struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCallDiscardReason
+func (unknownCallDiscardReason *UnknownCallDiscardReason) MessageType() string {
+	return unknownCallDiscardReason.Type
+}
+
+// GetCallDiscardReasonEnum return the enum type of this object
+func (unknownCallDiscardReason *UnknownCallDiscardReason) GetCallDiscardReasonEnum() CallDiscardReasonEnum {
+	return CallDiscardReasonEnum(unknownCallDiscardReason.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCallDiscardReason *UnknownCallDiscardReason) UnmarshalJSON(b []byte) error {
+	unknownCallDiscardReason.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCallDiscardReason.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCallDiscardReason UnknownCallDiscardReason) MarshalJSON() ([]byte, error) {
+	if unknownCallDiscardReason.Raw == nil {
+		return json.Marshal(unknownCallDiscardReason.tdCommon)
+	}
+	return unknownCallDiscardReason.Raw, nil
+}
+
+// UnknownCallServerType is the CallServerType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCallServerType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCallServerType
+func (unknownCallServerType *UnknownCallServerType) MessageType() string {
+	return unknownCallServerType.Type
+}
+
+// GetCallServerTypeEnum return the enum type of this object
+func (unknownCallServerType *UnknownCallServerType) GetCallServerTypeEnum() CallServerTypeEnum {
+	return CallServerTypeEnum(unknownCallServerType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCallServerType *UnknownCallServerType) UnmarshalJSON(b []byte) error {
+	unknownCallServerType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCallServerType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCallServerType UnknownCallServerType) MarshalJSON() ([]byte, error) {
+	if unknownCallServerType.Raw == nil {
+		return json.Marshal(unknownCallServerType.tdCommon)
+	}
+	return unknownCallServerType.Raw, nil
+}
+
+// UnknownCallState is the CallState fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCallState struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCallState
+func (unknownCallState *UnknownCallState) MessageType() string {
+	return unknownCallState.Type
+}
+
+// GetCallStateEnum return the enum type of this object
+func (unknownCallState *UnknownCallState) GetCallStateEnum() CallStateEnum {
+	return CallStateEnum(unknownCallState.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCallState *UnknownCallState) UnmarshalJSON(b []byte) error {
+	unknownCallState.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCallState.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCallState UnknownCallState) MarshalJSON() ([]byte, error) {
+	if unknownCallState.Raw == nil {
+		return json.Marshal(unknownCallState.tdCommon)
+	}
+	return unknownCallState.Raw, nil
+}
+
+// UnknownCallProblem is the CallProblem fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCallProblem struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCallProblem
+func (unknownCallProblem *UnknownCallProblem) MessageType() string {
+	return unknownCallProblem.Type
+}
+
+// GetCallProblemEnum return the enum type of this object
+func (unknownCallProblem *UnknownCallProblem) GetCallProblemEnum() CallProblemEnum {
+	return CallProblemEnum(unknownCallProblem.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCallProblem *UnknownCallProblem) UnmarshalJSON(b []byte) error {
+	unknownCallProblem.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCallProblem.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCallProblem UnknownCallProblem) MarshalJSON() ([]byte, error) {
+	if unknownCallProblem.Raw == nil {
+		return json.Marshal(unknownCallProblem.tdCommon)
+	}
+	return unknownCallProblem.Raw, nil
+}
+
+// UnknownDiceStickers is the DiceStickers fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownDiceStickers struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownDiceStickers
+func (unknownDiceStickers *UnknownDiceStickers) MessageType() string {
+	return unknownDiceStickers.Type
+}
+
+// GetDiceStickersEnum return the enum type of this object
+func (unknownDiceStickers *UnknownDiceStickers) GetDiceStickersEnum() DiceStickersEnum {
+	return DiceStickersEnum(unknownDiceStickers.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownDiceStickers *UnknownDiceStickers) UnmarshalJSON(b []byte) error {
+	unknownDiceStickers.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownDiceStickers.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownDiceStickers UnknownDiceStickers) MarshalJSON() ([]byte, error) {
+	if unknownDiceStickers.Raw == nil {
+		return json.Marshal(unknownDiceStickers.tdCommon)
+	}
+	return unknownDiceStickers.Raw, nil
+}
+
+// UnknownInputInlineQueryResult is the InputInlineQueryResult fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputInlineQueryResult struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputInlineQueryResult
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) MessageType() string {
+	return unknownInputInlineQueryResult.Type
+}
+
+// GetInputInlineQueryResultEnum return the enum type of this object
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) GetInputInlineQueryResultEnum() InputInlineQueryResultEnum {
+	return InputInlineQueryResultEnum(unknownInputInlineQueryResult.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputInlineQueryResult *UnknownInputInlineQueryResult) UnmarshalJSON(b []byte) error {
+	unknownInputInlineQueryResult.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputInlineQueryResult.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputInlineQueryResult UnknownInputInlineQueryResult) MarshalJSON() ([]byte, error) {
+	if unknownInputInlineQueryResult.Raw == nil {
+		return json.Marshal(unknownInputInlineQueryResult.tdCommon)
+	}
+	return unknownInputInlineQueryResult.Raw, nil
+}
+
+// UnknownInlineQueryResult is the InlineQueryResult fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInlineQueryResult struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInlineQueryResult
+func (unknownInlineQueryResult *UnknownInlineQueryResult) MessageType() string {
+	return unknownInlineQueryResult.Type
+}
+
+// GetInlineQueryResultEnum return the enum type of this object
+func (unknownInlineQueryResult *UnknownInlineQueryResult) GetInlineQueryResultEnum() InlineQueryResultEnum {
+	return InlineQueryResultEnum(unknownInlineQueryResult.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInlineQueryResult *UnknownInlineQueryResult) UnmarshalJSON(b []byte) error {
+	unknownInlineQueryResult.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInlineQueryResult.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInlineQueryResult UnknownInlineQueryResult) MarshalJSON() ([]byte, error) {
+	if unknownInlineQueryResult.Raw == nil {
+		return json.Marshal(unknownInlineQueryResult.tdCommon)
+	}
+	return unknownInlineQueryResult.Raw, nil
+}
+
+// UnknownCallbackQueryPayload is the CallbackQueryPayload fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCallbackQueryPayload struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCallbackQueryPayload
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) MessageType() string {
+	return unknownCallbackQueryPayload.Type
+}
+
+// GetCallbackQueryPayloadEnum return the enum type of this object
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) GetCallbackQueryPayloadEnum() CallbackQueryPayloadEnum {
+	return CallbackQueryPayloadEnum(unknownCallbackQueryPayload.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCallbackQueryPayload *UnknownCallbackQueryPayload) UnmarshalJSON(b []byte) error {
+	unknownCallbackQueryPayload.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCallbackQueryPayload.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCallbackQueryPayload UnknownCallbackQueryPayload) MarshalJSON() ([]byte, error) {
+	if unknownCallbackQueryPayload.Raw == nil {
+		return json.Marshal(unknownCallbackQueryPayload.tdCommon)
+	}
+	return unknownCallbackQueryPayload.Raw, nil
+}
+
+// UnknownChatEventAction is the ChatEventAction fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatEventAction struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatEventAction
+func (unknownChatEventAction *UnknownChatEventAction) MessageType() string {
+	return unknownChatEventAction.Type
+}
+
+// GetChatEventActionEnum return the enum type of this object
+func (unknownChatEventAction *UnknownChatEventAction) GetChatEventActionEnum() ChatEventActionEnum {
+	return ChatEventActionEnum(unknownChatEventAction.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatEventAction *UnknownChatEventAction) UnmarshalJSON(b []byte) error {
+	unknownChatEventAction.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatEventAction.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatEventAction UnknownChatEventAction) MarshalJSON() ([]byte, error) {
+	if unknownChatEventAction.Raw == nil {
+		return json.Marshal(unknownChatEventAction.tdCommon)
+	}
+	return unknownChatEventAction.Raw, nil
+}
+
+// UnknownLanguagePackStringValue is the LanguagePackStringValue fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownLanguagePackStringValue struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownLanguagePackStringValue
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) MessageType() string {
+	return unknownLanguagePackStringValue.Type
+}
+
+// GetLanguagePackStringValueEnum return the enum type of this object
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) GetLanguagePackStringValueEnum() LanguagePackStringValueEnum {
+	return LanguagePackStringValueEnum(unknownLanguagePackStringValue.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownLanguagePackStringValue *UnknownLanguagePackStringValue) UnmarshalJSON(b []byte) error {
+	unknownLanguagePackStringValue.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownLanguagePackStringValue.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownLanguagePackStringValue UnknownLanguagePackStringValue) MarshalJSON() ([]byte, error) {
+	if unknownLanguagePackStringValue.Raw == nil {
+		return json.Marshal(unknownLanguagePackStringValue.tdCommon)
+	}
+	return unknownLanguagePackStringValue.Raw, nil
+}
+
+// UnknownDeviceToken is the DeviceToken fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownDeviceToken struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownDeviceToken
+func (unknownDeviceToken *UnknownDeviceToken) MessageType() string {
+	return unknownDeviceToken.Type
+}
+
+// GetDeviceTokenEnum return the enum type of this object
+func (unknownDeviceToken *UnknownDeviceToken) GetDeviceTokenEnum() DeviceTokenEnum {
+	return DeviceTokenEnum(unknownDeviceToken.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownDeviceToken *UnknownDeviceToken) UnmarshalJSON(b []byte) error {
+	unknownDeviceToken.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownDeviceToken.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownDeviceToken UnknownDeviceToken) MarshalJSON() ([]byte, error) {
+	if unknownDeviceToken.Raw == nil {
+		return json.Marshal(unknownDeviceToken.tdCommon)
+	}
+	return unknownDeviceToken.Raw, nil
+}
+
+// UnknownBackgroundFill is the BackgroundFill fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownBackgroundFill struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownBackgroundFill
+func (unknownBackgroundFill *UnknownBackgroundFill) MessageType() string {
+	return unknownBackgroundFill.Type
+}
+
+// GetBackgroundFillEnum return the enum type of this object
+func (unknownBackgroundFill *UnknownBackgroundFill) GetBackgroundFillEnum() BackgroundFillEnum {
+	return BackgroundFillEnum(unknownBackgroundFill.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownBackgroundFill *UnknownBackgroundFill) UnmarshalJSON(b []byte) error {
+	unknownBackgroundFill.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownBackgroundFill.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownBackgroundFill UnknownBackgroundFill) MarshalJSON() ([]byte, error) {
+	if unknownBackgroundFill.Raw == nil {
+		return json.Marshal(unknownBackgroundFill.tdCommon)
+	}
+	return unknownBackgroundFill.Raw, nil
+}
+
+// UnknownBackgroundType is the BackgroundType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownBackgroundType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownBackgroundType
+func (unknownBackgroundType *UnknownBackgroundType) MessageType() string {
+	return unknownBackgroundType.Type
+}
+
+// GetBackgroundTypeEnum return the enum type of this object
+func (unknownBackgroundType *UnknownBackgroundType) GetBackgroundTypeEnum() BackgroundTypeEnum {
+	return BackgroundTypeEnum(unknownBackgroundType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownBackgroundType *UnknownBackgroundType) UnmarshalJSON(b []byte) error {
+	unknownBackgroundType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownBackgroundType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownBackgroundType UnknownBackgroundType) MarshalJSON() ([]byte, error) {
+	if unknownBackgroundType.Raw == nil {
+		return json.Marshal(unknownBackgroundType.tdCommon)
+	}
+	return unknownBackgroundType.Raw, nil
+}
+
+// UnknownInputBackground is the InputBackground fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputBackground struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputBackground
+func (unknownInputBackground *UnknownInputBackground) MessageType() string {
+	return unknownInputBackground.Type
+}
+
+// GetInputBackgroundEnum return the enum type of this object
+func (unknownInputBackground *UnknownInputBackground) GetInputBackgroundEnum() InputBackgroundEnum {
+	return InputBackgroundEnum(unknownInputBackground.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputBackground *UnknownInputBackground) UnmarshalJSON(b []byte) error {
+	unknownInputBackground.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputBackground.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputBackground UnknownInputBackground) MarshalJSON() ([]byte, error) {
+	if unknownInputBackground.Raw == nil {
+		return json.Marshal(unknownInputBackground.tdCommon)
+	}
+	return unknownInputBackground.Raw, nil
+}
+
+// UnknownCanTransferOwnershipResult is the CanTransferOwnershipResult fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCanTransferOwnershipResult struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCanTransferOwnershipResult
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) MessageType() string {
+	return unknownCanTransferOwnershipResult.Type
+}
+
+// GetCanTransferOwnershipResultEnum return the enum type of this object
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) GetCanTransferOwnershipResultEnum() CanTransferOwnershipResultEnum {
+	return CanTransferOwnershipResultEnum(unknownCanTransferOwnershipResult.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCanTransferOwnershipResult *UnknownCanTransferOwnershipResult) UnmarshalJSON(b []byte) error {
+	unknownCanTransferOwnershipResult.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCanTransferOwnershipResult.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCanTransferOwnershipResult UnknownCanTransferOwnershipResult) MarshalJSON() ([]byte, error) {
+	if unknownCanTransferOwnershipResult.Raw == nil {
+		return json.Marshal(unknownCanTransferOwnershipResult.tdCommon)
+	}
+	return unknownCanTransferOwnershipResult.Raw, nil
+}
+
+// UnknownCheckChatUsernameResult is the CheckChatUsernameResult fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownCheckChatUsernameResult struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownCheckChatUsernameResult
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) MessageType() string {
+	return unknownCheckChatUsernameResult.Type
+}
+
+// GetCheckChatUsernameResultEnum return the enum type of this object
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) GetCheckChatUsernameResultEnum() CheckChatUsernameResultEnum {
+	return CheckChatUsernameResultEnum(unknownCheckChatUsernameResult.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownCheckChatUsernameResult *UnknownCheckChatUsernameResult) UnmarshalJSON(b []byte) error {
+	unknownCheckChatUsernameResult.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownCheckChatUsernameResult.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownCheckChatUsernameResult UnknownCheckChatUsernameResult) MarshalJSON() ([]byte, error) {
+	if unknownCheckChatUsernameResult.Raw == nil {
+		return json.Marshal(unknownCheckChatUsernameResult.tdCommon)
+	}
+	return unknownCheckChatUsernameResult.Raw, nil
+}
+
+// UnknownMessageFileType is the MessageFileType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownMessageFileType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownMessageFileType
+func (unknownMessageFileType *UnknownMessageFileType) MessageType() string {
+	return unknownMessageFileType.Type
+}
+
+// GetMessageFileTypeEnum return the enum type of this object
+func (unknownMessageFileType *UnknownMessageFileType) GetMessageFileTypeEnum() MessageFileTypeEnum {
+	return MessageFileTypeEnum(unknownMessageFileType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownMessageFileType *UnknownMessageFileType) UnmarshalJSON(b []byte) error {
+	unknownMessageFileType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownMessageFileType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownMessageFileType UnknownMessageFileType) MarshalJSON() ([]byte, error) {
+	if unknownMessageFileType.Raw == nil {
+		return json.Marshal(unknownMessageFileType.tdCommon)
+	}
+	return unknownMessageFileType.Raw, nil
+}
+
+// UnknownPushMessageContent is the PushMessageContent fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownPushMessageContent struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownPushMessageContent
+func (unknownPushMessageContent *UnknownPushMessageContent) MessageType() string {
+	return unknownPushMessageContent.Type
+}
+
+// GetPushMessageContentEnum return the enum type of this object
+func (unknownPushMessageContent *UnknownPushMessageContent) GetPushMessageContentEnum() PushMessageContentEnum {
+	return PushMessageContentEnum(unknownPushMessageContent.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownPushMessageContent *UnknownPushMessageContent) UnmarshalJSON(b []byte) error {
+	unknownPushMessageContent.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownPushMessageContent.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownPushMessageContent UnknownPushMessageContent) MarshalJSON() ([]byte, error) {
+	if unknownPushMessageContent.Raw == nil {
+		return json.Marshal(unknownPushMessageContent.tdCommon)
+	}
+	return unknownPushMessageContent.Raw, nil
+}
+
+// UnknownNotificationType is the NotificationType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownNotificationType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownNotificationType
+func (unknownNotificationType *UnknownNotificationType) MessageType() string {
+	return unknownNotificationType.Type
+}
+
+// GetNotificationTypeEnum return the enum type of this object
+func (unknownNotificationType *UnknownNotificationType) GetNotificationTypeEnum() NotificationTypeEnum {
+	return NotificationTypeEnum(unknownNotificationType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownNotificationType *UnknownNotificationType) UnmarshalJSON(b []byte) error {
+	unknownNotificationType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownNotificationType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownNotificationType UnknownNotificationType) MarshalJSON() ([]byte, error) {
+	if unknownNotificationType.Raw == nil {
+		return json.Marshal(unknownNotificationType.tdCommon)
+	}
+	return unknownNotificationType.Raw, nil
+}
+
+// UnknownNotificationGroupType is the NotificationGroupType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownNotificationGroupType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownNotificationGroupType
+func (unknownNotificationGroupType *UnknownNotificationGroupType) MessageType() string {
+	return unknownNotificationGroupType.Type
+}
+
+// GetNotificationGroupTypeEnum return the enum type of this object
+func (unknownNotificationGroupType *UnknownNotificationGroupType) GetNotificationGroupTypeEnum() NotificationGroupTypeEnum {
+	return NotificationGroupTypeEnum(unknownNotificationGroupType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownNotificationGroupType *UnknownNotificationGroupType) UnmarshalJSON(b []byte) error {
+	unknownNotificationGroupType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownNotificationGroupType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownNotificationGroupType UnknownNotificationGroupType) MarshalJSON() ([]byte, error) {
+	if unknownNotificationGroupType.Raw == nil {
+		return json.Marshal(unknownNotificationGroupType.tdCommon)
+	}
+	return unknownNotificationGroupType.Raw, nil
+}
+
+// UnknownOptionValue is the OptionValue fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownOptionValue struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownOptionValue
+func (unknownOptionValue *UnknownOptionValue) MessageType() string {
+	return unknownOptionValue.Type
+}
+
+// GetOptionValueEnum return the enum type of this object
+func (unknownOptionValue *UnknownOptionValue) GetOptionValueEnum() OptionValueEnum {
+	return OptionValueEnum(unknownOptionValue.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownOptionValue *UnknownOptionValue) UnmarshalJSON(b []byte) error {
+	unknownOptionValue.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownOptionValue.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownOptionValue UnknownOptionValue) MarshalJSON() ([]byte, error) {
+	if unknownOptionValue.Raw == nil {
+		return json.Marshal(unknownOptionValue.tdCommon)
+	}
+	return unknownOptionValue.Raw, nil
+}
+
+// UnknownJsonValue is the JsonValue fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownJsonValue struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownJsonValue
+func (unknownJsonValue *UnknownJsonValue) MessageType() string {
+	return unknownJsonValue.Type
+}
+
+// GetJsonValueEnum return the enum type of this object
+func (unknownJsonValue *UnknownJsonValue) GetJsonValueEnum() JsonValueEnum {
+	return JsonValueEnum(unknownJsonValue.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownJsonValue *UnknownJsonValue) UnmarshalJSON(b []byte) error {
+	unknownJsonValue.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownJsonValue.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownJsonValue UnknownJsonValue) MarshalJSON() ([]byte, error) {
+	if unknownJsonValue.Raw == nil {
+		return json.Marshal(unknownJsonValue.tdCommon)
+	}
+	return unknownJsonValue.Raw, nil
+}
+
+// UnknownUserPrivacySettingRule is the UserPrivacySettingRule fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownUserPrivacySettingRule struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownUserPrivacySettingRule
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) MessageType() string {
+	return unknownUserPrivacySettingRule.Type
+}
+
+// GetUserPrivacySettingRuleEnum return the enum type of this object
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) GetUserPrivacySettingRuleEnum() UserPrivacySettingRuleEnum {
+	return UserPrivacySettingRuleEnum(unknownUserPrivacySettingRule.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownUserPrivacySettingRule *UnknownUserPrivacySettingRule) UnmarshalJSON(b []byte) error {
+	unknownUserPrivacySettingRule.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownUserPrivacySettingRule.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownUserPrivacySettingRule UnknownUserPrivacySettingRule) MarshalJSON() ([]byte, error) {
+	if unknownUserPrivacySettingRule.Raw == nil {
+		return json.Marshal(unknownUserPrivacySettingRule.tdCommon)
+	}
+	return unknownUserPrivacySettingRule.Raw, nil
+}
+
+// UnknownUserPrivacySetting is the UserPrivacySetting fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownUserPrivacySetting struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownUserPrivacySetting
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) MessageType() string {
+	return unknownUserPrivacySetting.Type
+}
+
+// GetUserPrivacySettingEnum return the enum type of this object
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) GetUserPrivacySettingEnum() UserPrivacySettingEnum {
+	return UserPrivacySettingEnum(unknownUserPrivacySetting.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownUserPrivacySetting *UnknownUserPrivacySetting) UnmarshalJSON(b []byte) error {
+	unknownUserPrivacySetting.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownUserPrivacySetting.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownUserPrivacySetting UnknownUserPrivacySetting) MarshalJSON() ([]byte, error) {
+	if unknownUserPrivacySetting.Raw == nil {
+		return json.Marshal(unknownUserPrivacySetting.tdCommon)
+	}
+	return unknownUserPrivacySetting.Raw, nil
+}
+
+// UnknownChatReportReason is the ChatReportReason fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatReportReason struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatReportReason
+func (unknownChatReportReason *UnknownChatReportReason) MessageType() string {
+	return unknownChatReportReason.Type
+}
+
+// GetChatReportReasonEnum return the enum type of this object
+func (unknownChatReportReason *UnknownChatReportReason) GetChatReportReasonEnum() ChatReportReasonEnum {
+	return ChatReportReasonEnum(unknownChatReportReason.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatReportReason *UnknownChatReportReason) UnmarshalJSON(b []byte) error {
+	unknownChatReportReason.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatReportReason.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatReportReason UnknownChatReportReason) MarshalJSON() ([]byte, error) {
+	if unknownChatReportReason.Raw == nil {
+		return json.Marshal(unknownChatReportReason.tdCommon)
+	}
+	return unknownChatReportReason.Raw, nil
+}
+
+// UnknownFileType is the FileType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownFileType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownFileType
+func (unknownFileType *UnknownFileType) MessageType() string {
+	return unknownFileType.Type
+}
+
+// GetFileTypeEnum return the enum type of this object
+func (unknownFileType *UnknownFileType) GetFileTypeEnum() FileTypeEnum {
+	return FileTypeEnum(unknownFileType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownFileType *UnknownFileType) UnmarshalJSON(b []byte) error {
+	unknownFileType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownFileType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownFileType UnknownFileType) MarshalJSON() ([]byte, error) {
+	if unknownFileType.Raw == nil {
+		return json.Marshal(unknownFileType.tdCommon)
+	}
+	return unknownFileType.Raw, nil
+}
+
+// UnknownNetworkType is the NetworkType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownNetworkType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownNetworkType
+func (unknownNetworkType *UnknownNetworkType) MessageType() string {
+	return unknownNetworkType.Type
+}
+
+// GetNetworkTypeEnum return the enum type of this object
+func (unknownNetworkType *UnknownNetworkType) GetNetworkTypeEnum() NetworkTypeEnum {
+	return NetworkTypeEnum(unknownNetworkType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownNetworkType *UnknownNetworkType) UnmarshalJSON(b []byte) error {
+	unknownNetworkType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownNetworkType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownNetworkType UnknownNetworkType) MarshalJSON() ([]byte, error) {
+	if unknownNetworkType.Raw == nil {
+		return json.Marshal(unknownNetworkType.tdCommon)
+	}
+	return unknownNetworkType.Raw, nil
+}
+
+// UnknownNetworkStatisticsEntry is the NetworkStatisticsEntry fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownNetworkStatisticsEntry struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownNetworkStatisticsEntry
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) MessageType() string {
+	return unknownNetworkStatisticsEntry.Type
+}
+
+// GetNetworkStatisticsEntryEnum return the enum type of this object
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) GetNetworkStatisticsEntryEnum() NetworkStatisticsEntryEnum {
+	return NetworkStatisticsEntryEnum(unknownNetworkStatisticsEntry.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownNetworkStatisticsEntry *UnknownNetworkStatisticsEntry) UnmarshalJSON(b []byte) error {
+	unknownNetworkStatisticsEntry.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownNetworkStatisticsEntry.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownNetworkStatisticsEntry UnknownNetworkStatisticsEntry) MarshalJSON() ([]byte, error) {
+	if unknownNetworkStatisticsEntry.Raw == nil {
+		return json.Marshal(unknownNetworkStatisticsEntry.tdCommon)
+	}
+	return unknownNetworkStatisticsEntry.Raw, nil
+}
+
+// UnknownConnectionState is the ConnectionState fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownConnectionState struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownConnectionState
+func (unknownConnectionState *UnknownConnectionState) MessageType() string {
+	return unknownConnectionState.Type
+}
+
+// GetConnectionStateEnum return the enum type of this object
+func (unknownConnectionState *UnknownConnectionState) GetConnectionStateEnum() ConnectionStateEnum {
+	return ConnectionStateEnum(unknownConnectionState.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownConnectionState *UnknownConnectionState) UnmarshalJSON(b []byte) error {
+	unknownConnectionState.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownConnectionState.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownConnectionState UnknownConnectionState) MarshalJSON() ([]byte, error) {
+	if unknownConnectionState.Raw == nil {
+		return json.Marshal(unknownConnectionState.tdCommon)
+	}
+	return unknownConnectionState.Raw, nil
+}
+
+// UnknownTopChatCategory is the TopChatCategory fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownTopChatCategory struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownTopChatCategory
+func (unknownTopChatCategory *UnknownTopChatCategory) MessageType() string {
+	return unknownTopChatCategory.Type
+}
+
+// GetTopChatCategoryEnum return the enum type of this object
+func (unknownTopChatCategory *UnknownTopChatCategory) GetTopChatCategoryEnum() TopChatCategoryEnum {
+	return TopChatCategoryEnum(unknownTopChatCategory.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownTopChatCategory *UnknownTopChatCategory) UnmarshalJSON(b []byte) error {
+	unknownTopChatCategory.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownTopChatCategory.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownTopChatCategory UnknownTopChatCategory) MarshalJSON() ([]byte, error) {
+	if unknownTopChatCategory.Raw == nil {
+		return json.Marshal(unknownTopChatCategory.tdCommon)
+	}
+	return unknownTopChatCategory.Raw, nil
+}
+
+// UnknownTMeURLType is the TMeURLType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownTMeURLType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownTMeURLType
+func (unknownTMeURLType *UnknownTMeURLType) MessageType() string {
+	return unknownTMeURLType.Type
+}
+
+// GetTMeURLTypeEnum return the enum type of this object
+func (unknownTMeURLType *UnknownTMeURLType) GetTMeURLTypeEnum() TMeURLTypeEnum {
+	return TMeURLTypeEnum(unknownTMeURLType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownTMeURLType *UnknownTMeURLType) UnmarshalJSON(b []byte) error {
+	unknownTMeURLType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownTMeURLType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownTMeURLType UnknownTMeURLType) MarshalJSON() ([]byte, error) {
+	if unknownTMeURLType.Raw == nil {
+		return json.Marshal(unknownTMeURLType.tdCommon)
+	}
+	return unknownTMeURLType.Raw, nil
+}
+
+// UnknownSuggestedAction is the SuggestedAction fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownSuggestedAction struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownSuggestedAction
+func (unknownSuggestedAction *UnknownSuggestedAction) MessageType() string {
+	return unknownSuggestedAction.Type
+}
+
+// GetSuggestedActionEnum return the enum type of this object
+func (unknownSuggestedAction *UnknownSuggestedAction) GetSuggestedActionEnum() SuggestedActionEnum {
+	return SuggestedActionEnum(unknownSuggestedAction.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownSuggestedAction *UnknownSuggestedAction) UnmarshalJSON(b []byte) error {
+	unknownSuggestedAction.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownSuggestedAction.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownSuggestedAction UnknownSuggestedAction) MarshalJSON() ([]byte, error) {
+	if unknownSuggestedAction.Raw == nil {
+		return json.Marshal(unknownSuggestedAction.tdCommon)
+	}
+	return unknownSuggestedAction.Raw, nil
+}
+
+// UnknownTextParseMode is the TextParseMode fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownTextParseMode struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownTextParseMode
+func (unknownTextParseMode *UnknownTextParseMode) MessageType() string {
+	return unknownTextParseMode.Type
+}
+
+// GetTextParseModeEnum return the enum type of this object
+func (unknownTextParseMode *UnknownTextParseMode) GetTextParseModeEnum() TextParseModeEnum {
+	return TextParseModeEnum(unknownTextParseMode.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownTextParseMode *UnknownTextParseMode) UnmarshalJSON(b []byte) error {
+	unknownTextParseMode.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownTextParseMode.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownTextParseMode UnknownTextParseMode) MarshalJSON() ([]byte, error) {
+	if unknownTextParseMode.Raw == nil {
+		return json.Marshal(unknownTextParseMode.tdCommon)
+	}
+	return unknownTextParseMode.Raw, nil
+}
+
+// UnknownProxyType is the ProxyType fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownProxyType struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownProxyType
+func (unknownProxyType *UnknownProxyType) MessageType() string {
+	return unknownProxyType.Type
+}
+
+// GetProxyTypeEnum return the enum type of this object
+func (unknownProxyType *UnknownProxyType) GetProxyTypeEnum() ProxyTypeEnum {
+	return ProxyTypeEnum(unknownProxyType.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownProxyType *UnknownProxyType) UnmarshalJSON(b []byte) error {
+	unknownProxyType.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownProxyType.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownProxyType UnknownProxyType) MarshalJSON() ([]byte, error) {
+	if unknownProxyType.Raw == nil {
+		return json.Marshal(unknownProxyType.tdCommon)
+	}
+	return unknownProxyType.Raw, nil
+}
+
+// UnknownInputSticker is the InputSticker fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownInputSticker struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownInputSticker
+func (unknownInputSticker *UnknownInputSticker) MessageType() string {
+	return unknownInputSticker.Type
+}
+
+// GetInputStickerEnum return the enum type of this object
+func (unknownInputSticker *UnknownInputSticker) GetInputStickerEnum() InputStickerEnum {
+	return InputStickerEnum(unknownInputSticker.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownInputSticker *UnknownInputSticker) UnmarshalJSON(b []byte) error {
+	unknownInputSticker.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownInputSticker.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownInputSticker UnknownInputSticker) MarshalJSON() ([]byte, error) {
+	if unknownInputSticker.Raw == nil {
+		return json.Marshal(unknownInputSticker.tdCommon)
+	}
+	return unknownInputSticker.Raw, nil
+}
+
+// UnknownStatisticalGraph is the StatisticalGraph fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownStatisticalGraph struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownStatisticalGraph
+func (unknownStatisticalGraph *UnknownStatisticalGraph) MessageType() string {
+	return unknownStatisticalGraph.Type
+}
+
+// GetStatisticalGraphEnum return the enum type of this object
+func (unknownStatisticalGraph *UnknownStatisticalGraph) GetStatisticalGraphEnum() StatisticalGraphEnum {
+	return StatisticalGraphEnum(unknownStatisticalGraph.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownStatisticalGraph *UnknownStatisticalGraph) UnmarshalJSON(b []byte) error {
+	unknownStatisticalGraph.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownStatisticalGraph.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownStatisticalGraph UnknownStatisticalGraph) MarshalJSON() ([]byte, error) {
+	if unknownStatisticalGraph.Raw == nil {
+		return json.Marshal(unknownStatisticalGraph.tdCommon)
+	}
+	return unknownStatisticalGraph.Raw, nil
+}
+
+// UnknownChatStatistics is the ChatStatistics fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownChatStatistics struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownChatStatistics
+func (unknownChatStatistics *UnknownChatStatistics) MessageType() string {
+	return unknownChatStatistics.Type
+}
+
+// GetChatStatisticsEnum return the enum type of this object
+func (unknownChatStatistics *UnknownChatStatistics) GetChatStatisticsEnum() ChatStatisticsEnum {
+	return ChatStatisticsEnum(unknownChatStatistics.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownChatStatistics *UnknownChatStatistics) UnmarshalJSON(b []byte) error {
+	unknownChatStatistics.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownChatStatistics.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownChatStatistics UnknownChatStatistics) MarshalJSON() ([]byte, error) {
+	if unknownChatStatistics.Raw == nil {
+		return json.Marshal(unknownChatStatistics.tdCommon)
+	}
+	return unknownChatStatistics.Raw, nil
+}
+
+// UnknownVectorPathCommand is the VectorPathCommand fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownVectorPathCommand struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownVectorPathCommand
+func (unknownVectorPathCommand *UnknownVectorPathCommand) MessageType() string {
+	return unknownVectorPathCommand.Type
+}
+
+// GetVectorPathCommandEnum return the enum type of this object
+func (unknownVectorPathCommand *UnknownVectorPathCommand) GetVectorPathCommandEnum() VectorPathCommandEnum {
+	return VectorPathCommandEnum(unknownVectorPathCommand.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownVectorPathCommand *UnknownVectorPathCommand) UnmarshalJSON(b []byte) error {
+	unknownVectorPathCommand.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownVectorPathCommand.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownVectorPathCommand UnknownVectorPathCommand) MarshalJSON() ([]byte, error) {
+	if unknownVectorPathCommand.Raw == nil {
+		return json.Marshal(unknownVectorPathCommand.tdCommon)
+	}
+	return unknownVectorPathCommand.Raw, nil
+}
+
+// UnknownUpdate is the Update fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownUpdate struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownUpdate
+func (unknownUpdate *UnknownUpdate) MessageType() string {
+	return unknownUpdate.Type
+}
+
+// GetUpdateEnum return the enum type of this object
+func (unknownUpdate *UnknownUpdate) GetUpdateEnum() UpdateEnum {
+	return UpdateEnum(unknownUpdate.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownUpdate *UnknownUpdate) UnmarshalJSON(b []byte) error {
+	unknownUpdate.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownUpdate.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownUpdate UnknownUpdate) MarshalJSON() ([]byte, error) {
+	if unknownUpdate.Raw == nil {
+		return json.Marshal(unknownUpdate.tdCommon)
+	}
+	return unknownUpdate.Raw, nil
+}
+
+// UnknownLogStream is the LogStream fallback for types missing from the schema, e.g. ones added by a newer TDLib.
+// Raw keeps the whole object, so it can still be inspected or sent back.
+type UnknownLogStream struct {
+	tdCommon
+	Raw json.RawMessage `json:"-"` // The object as received
+}
+
+// MessageType return the string telegram-type of UnknownLogStream
+func (unknownLogStream *UnknownLogStream) MessageType() string {
+	return unknownLogStream.Type
+}
+
+// GetLogStreamEnum return the enum type of this object
+func (unknownLogStream *UnknownLogStream) GetLogStreamEnum() LogStreamEnum {
+	return LogStreamEnum(unknownLogStream.Type)
+}
+
+// UnmarshalJSON unmarshal to json
+func (unknownLogStream *UnknownLogStream) UnmarshalJSON(b []byte) error {
+	unknownLogStream.Raw = append(json.RawMessage(nil), b...)
+	return json.Unmarshal(b, &unknownLogStream.tdCommon)
+}
+
+// MarshalJSON marshals to json, the object as received
+func (unknownLogStream UnknownLogStream) MarshalJSON() ([]byte, error) {
+	if unknownLogStream.Raw == nil {
+		return json.Marshal(unknownLogStream.tdCommon)
+	}
+	return unknownLogStream.Raw, nil
+}
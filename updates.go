@@ -0,0 +1,582 @@
+package tdlib
+
+// OnUpdateAuthorizationState Registers a handler called for every updateAuthorizationState: The user authorization state has changed
+func (client *Client) OnUpdateAuthorizationState(handler func(update *UpdateAuthorizationState)) {
+	client.addUpdateHandler(UpdateAuthorizationStateType, func(update Update) {
+		handler(update.(*UpdateAuthorizationState))
+	})
+}
+
+// OnUpdateNewMessage Registers a handler called for every updateNewMessage: A new message was received; can also be an outgoing message
+func (client *Client) OnUpdateNewMessage(handler func(update *UpdateNewMessage)) {
+	client.addUpdateHandler(UpdateNewMessageType, func(update Update) {
+		handler(update.(*UpdateNewMessage))
+	})
+}
+
+// OnUpdateMessageSendAcknowledged Registers a handler called for every updateMessageSendAcknowledged: A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully or even that the send message request will be processed. This update will be sent only if the option "use_quick_ack" is set to true. This update may be sent multiple times for the same message
+func (client *Client) OnUpdateMessageSendAcknowledged(handler func(update *UpdateMessageSendAcknowledged)) {
+	client.addUpdateHandler(UpdateMessageSendAcknowledgedType, func(update Update) {
+		handler(update.(*UpdateMessageSendAcknowledged))
+	})
+}
+
+// OnUpdateMessageSendSucceeded Registers a handler called for every updateMessageSendSucceeded: A message has been successfully sent
+func (client *Client) OnUpdateMessageSendSucceeded(handler func(update *UpdateMessageSendSucceeded)) {
+	client.addUpdateHandler(UpdateMessageSendSucceededType, func(update Update) {
+		handler(update.(*UpdateMessageSendSucceeded))
+	})
+}
+
+// OnUpdateMessageSendFailed Registers a handler called for every updateMessageSendFailed: A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update
+func (client *Client) OnUpdateMessageSendFailed(handler func(update *UpdateMessageSendFailed)) {
+	client.addUpdateHandler(UpdateMessageSendFailedType, func(update Update) {
+		handler(update.(*UpdateMessageSendFailed))
+	})
+}
+
+// OnUpdateMessageContent Registers a handler called for every updateMessageContent: The message content has changed
+func (client *Client) OnUpdateMessageContent(handler func(update *UpdateMessageContent)) {
+	client.addUpdateHandler(UpdateMessageContentType, func(update Update) {
+		handler(update.(*UpdateMessageContent))
+	})
+}
+
+// OnUpdateMessageEdited Registers a handler called for every updateMessageEdited: A message was edited. Changes in the message content will come in a separate updateMessageContent
+func (client *Client) OnUpdateMessageEdited(handler func(update *UpdateMessageEdited)) {
+	client.addUpdateHandler(UpdateMessageEditedType, func(update Update) {
+		handler(update.(*UpdateMessageEdited))
+	})
+}
+
+// OnUpdateMessageIsPinned Registers a handler called for every updateMessageIsPinned: The message pinned state was changed
+func (client *Client) OnUpdateMessageIsPinned(handler func(update *UpdateMessageIsPinned)) {
+	client.addUpdateHandler(UpdateMessageIsPinnedType, func(update Update) {
+		handler(update.(*UpdateMessageIsPinned))
+	})
+}
+
+// OnUpdateMessageInteractionInfo Registers a handler called for every updateMessageInteractionInfo: The information about interactions with a message has changed
+func (client *Client) OnUpdateMessageInteractionInfo(handler func(update *UpdateMessageInteractionInfo)) {
+	client.addUpdateHandler(UpdateMessageInteractionInfoType, func(update Update) {
+		handler(update.(*UpdateMessageInteractionInfo))
+	})
+}
+
+// OnUpdateMessageContentOpened Registers a handler called for every updateMessageContentOpened: The message content was opened. Updates voice note messages to "listened", video note messages to "viewed" and starts the TTL timer for self-destructing messages
+func (client *Client) OnUpdateMessageContentOpened(handler func(update *UpdateMessageContentOpened)) {
+	client.addUpdateHandler(UpdateMessageContentOpenedType, func(update Update) {
+		handler(update.(*UpdateMessageContentOpened))
+	})
+}
+
+// OnUpdateMessageMentionRead Registers a handler called for every updateMessageMentionRead: A message with an unread mention was read
+func (client *Client) OnUpdateMessageMentionRead(handler func(update *UpdateMessageMentionRead)) {
+	client.addUpdateHandler(UpdateMessageMentionReadType, func(update Update) {
+		handler(update.(*UpdateMessageMentionRead))
+	})
+}
+
+// OnUpdateMessageLiveLocationViewed Registers a handler called for every updateMessageLiveLocationViewed: A message with a live location was viewed. When the update is received, the application is supposed to update the live location
+func (client *Client) OnUpdateMessageLiveLocationViewed(handler func(update *UpdateMessageLiveLocationViewed)) {
+	client.addUpdateHandler(UpdateMessageLiveLocationViewedType, func(update Update) {
+		handler(update.(*UpdateMessageLiveLocationViewed))
+	})
+}
+
+// OnUpdateNewChat Registers a handler called for every updateNewChat: A new chat has been loaded/created. This update is guaranteed to come before the chat identifier is returned to the application. The chat field changes will be reported through separate updates
+func (client *Client) OnUpdateNewChat(handler func(update *UpdateNewChat)) {
+	client.addUpdateHandler(UpdateNewChatType, func(update Update) {
+		handler(update.(*UpdateNewChat))
+	})
+}
+
+// OnUpdateChatTitle Registers a handler called for every updateChatTitle: The title of a chat was changed
+func (client *Client) OnUpdateChatTitle(handler func(update *UpdateChatTitle)) {
+	client.addUpdateHandler(UpdateChatTitleType, func(update Update) {
+		handler(update.(*UpdateChatTitle))
+	})
+}
+
+// OnUpdateChatPhoto Registers a handler called for every updateChatPhoto: A chat photo was changed
+func (client *Client) OnUpdateChatPhoto(handler func(update *UpdateChatPhoto)) {
+	client.addUpdateHandler(UpdateChatPhotoType, func(update Update) {
+		handler(update.(*UpdateChatPhoto))
+	})
+}
+
+// OnUpdateChatPermissions Registers a handler called for every updateChatPermissions: Chat permissions was changed
+func (client *Client) OnUpdateChatPermissions(handler func(update *UpdateChatPermissions)) {
+	client.addUpdateHandler(UpdateChatPermissionsType, func(update Update) {
+		handler(update.(*UpdateChatPermissions))
+	})
+}
+
+// OnUpdateChatLastMessage Registers a handler called for every updateChatLastMessage: The last message of a chat was changed. If last_message is null, then the last message in the chat became unknown. Some new unknown messages might be added to the chat in this case
+func (client *Client) OnUpdateChatLastMessage(handler func(update *UpdateChatLastMessage)) {
+	client.addUpdateHandler(UpdateChatLastMessageType, func(update Update) {
+		handler(update.(*UpdateChatLastMessage))
+	})
+}
+
+// OnUpdateChatPosition Registers a handler called for every updateChatPosition: The position of a chat in a chat list has changed. Instead of this update updateChatLastMessage or updateChatDraftMessage might be sent
+func (client *Client) OnUpdateChatPosition(handler func(update *UpdateChatPosition)) {
+	client.addUpdateHandler(UpdateChatPositionType, func(update Update) {
+		handler(update.(*UpdateChatPosition))
+	})
+}
+
+// OnUpdateChatIsMarkedAsUnread Registers a handler called for every updateChatIsMarkedAsUnread: A chat was marked as unread or was read
+func (client *Client) OnUpdateChatIsMarkedAsUnread(handler func(update *UpdateChatIsMarkedAsUnread)) {
+	client.addUpdateHandler(UpdateChatIsMarkedAsUnreadType, func(update Update) {
+		handler(update.(*UpdateChatIsMarkedAsUnread))
+	})
+}
+
+// OnUpdateChatIsBlocked Registers a handler called for every updateChatIsBlocked: A chat was blocked or unblocked
+func (client *Client) OnUpdateChatIsBlocked(handler func(update *UpdateChatIsBlocked)) {
+	client.addUpdateHandler(UpdateChatIsBlockedType, func(update Update) {
+		handler(update.(*UpdateChatIsBlocked))
+	})
+}
+
+// OnUpdateChatHasScheduledMessages Registers a handler called for every updateChatHasScheduledMessages: A chat's has_scheduled_messages field has changed
+func (client *Client) OnUpdateChatHasScheduledMessages(handler func(update *UpdateChatHasScheduledMessages)) {
+	client.addUpdateHandler(UpdateChatHasScheduledMessagesType, func(update Update) {
+		handler(update.(*UpdateChatHasScheduledMessages))
+	})
+}
+
+// OnUpdateChatVoiceChat Registers a handler called for every updateChatVoiceChat: A chat voice chat state has changed
+func (client *Client) OnUpdateChatVoiceChat(handler func(update *UpdateChatVoiceChat)) {
+	client.addUpdateHandler(UpdateChatVoiceChatType, func(update Update) {
+		handler(update.(*UpdateChatVoiceChat))
+	})
+}
+
+// OnUpdateChatDefaultDisableNotification Registers a handler called for every updateChatDefaultDisableNotification: The value of the default disable_notification parameter, used when a message is sent to the chat, was changed
+func (client *Client) OnUpdateChatDefaultDisableNotification(handler func(update *UpdateChatDefaultDisableNotification)) {
+	client.addUpdateHandler(UpdateChatDefaultDisableNotificationType, func(update Update) {
+		handler(update.(*UpdateChatDefaultDisableNotification))
+	})
+}
+
+// OnUpdateChatReadInbox Registers a handler called for every updateChatReadInbox: Incoming messages were read or number of unread messages has been changed
+func (client *Client) OnUpdateChatReadInbox(handler func(update *UpdateChatReadInbox)) {
+	client.addUpdateHandler(UpdateChatReadInboxType, func(update Update) {
+		handler(update.(*UpdateChatReadInbox))
+	})
+}
+
+// OnUpdateChatReadOutbox Registers a handler called for every updateChatReadOutbox: Outgoing messages were read
+func (client *Client) OnUpdateChatReadOutbox(handler func(update *UpdateChatReadOutbox)) {
+	client.addUpdateHandler(UpdateChatReadOutboxType, func(update Update) {
+		handler(update.(*UpdateChatReadOutbox))
+	})
+}
+
+// OnUpdateChatUnreadMentionCount Registers a handler called for every updateChatUnreadMentionCount: The chat unread_mention_count has changed
+func (client *Client) OnUpdateChatUnreadMentionCount(handler func(update *UpdateChatUnreadMentionCount)) {
+	client.addUpdateHandler(UpdateChatUnreadMentionCountType, func(update Update) {
+		handler(update.(*UpdateChatUnreadMentionCount))
+	})
+}
+
+// OnUpdateChatNotificationSettings Registers a handler called for every updateChatNotificationSettings: Notification settings for a chat were changed
+func (client *Client) OnUpdateChatNotificationSettings(handler func(update *UpdateChatNotificationSettings)) {
+	client.addUpdateHandler(UpdateChatNotificationSettingsType, func(update Update) {
+		handler(update.(*UpdateChatNotificationSettings))
+	})
+}
+
+// OnUpdateScopeNotificationSettings Registers a handler called for every updateScopeNotificationSettings: Notification settings for some type of chats were updated
+func (client *Client) OnUpdateScopeNotificationSettings(handler func(update *UpdateScopeNotificationSettings)) {
+	client.addUpdateHandler(UpdateScopeNotificationSettingsType, func(update Update) {
+		handler(update.(*UpdateScopeNotificationSettings))
+	})
+}
+
+// OnUpdateChatActionBar Registers a handler called for every updateChatActionBar: The chat action bar was changed
+func (client *Client) OnUpdateChatActionBar(handler func(update *UpdateChatActionBar)) {
+	client.addUpdateHandler(UpdateChatActionBarType, func(update Update) {
+		handler(update.(*UpdateChatActionBar))
+	})
+}
+
+// OnUpdateChatReplyMarkup Registers a handler called for every updateChatReplyMarkup: The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user
+func (client *Client) OnUpdateChatReplyMarkup(handler func(update *UpdateChatReplyMarkup)) {
+	client.addUpdateHandler(UpdateChatReplyMarkupType, func(update Update) {
+		handler(update.(*UpdateChatReplyMarkup))
+	})
+}
+
+// OnUpdateChatDraftMessage Registers a handler called for every updateChatDraftMessage: A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update shouldn't be applied
+func (client *Client) OnUpdateChatDraftMessage(handler func(update *UpdateChatDraftMessage)) {
+	client.addUpdateHandler(UpdateChatDraftMessageType, func(update Update) {
+		handler(update.(*UpdateChatDraftMessage))
+	})
+}
+
+// OnUpdateChatFilters Registers a handler called for every updateChatFilters: The list of chat filters or a chat filter has changed
+func (client *Client) OnUpdateChatFilters(handler func(update *UpdateChatFilters)) {
+	client.addUpdateHandler(UpdateChatFiltersType, func(update Update) {
+		handler(update.(*UpdateChatFilters))
+	})
+}
+
+// OnUpdateChatOnlineMemberCount Registers a handler called for every updateChatOnlineMemberCount: The number of online group members has changed. This update with non-zero count is sent only for currently opened chats. There is no guarantee that it will be sent just after the count has changed
+func (client *Client) OnUpdateChatOnlineMemberCount(handler func(update *UpdateChatOnlineMemberCount)) {
+	client.addUpdateHandler(UpdateChatOnlineMemberCountType, func(update Update) {
+		handler(update.(*UpdateChatOnlineMemberCount))
+	})
+}
+
+// OnUpdateNotification Registers a handler called for every updateNotification: A notification was changed
+func (client *Client) OnUpdateNotification(handler func(update *UpdateNotification)) {
+	client.addUpdateHandler(UpdateNotificationType, func(update Update) {
+		handler(update.(*UpdateNotification))
+	})
+}
+
+// OnUpdateNotificationGroup Registers a handler called for every updateNotificationGroup: A list of active notifications in a notification group has changed
+func (client *Client) OnUpdateNotificationGroup(handler func(update *UpdateNotificationGroup)) {
+	client.addUpdateHandler(UpdateNotificationGroupType, func(update Update) {
+		handler(update.(*UpdateNotificationGroup))
+	})
+}
+
+// OnUpdateActiveNotifications Registers a handler called for every updateActiveNotifications: Contains active notifications that was shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update
+func (client *Client) OnUpdateActiveNotifications(handler func(update *UpdateActiveNotifications)) {
+	client.addUpdateHandler(UpdateActiveNotificationsType, func(update Update) {
+		handler(update.(*UpdateActiveNotifications))
+	})
+}
+
+// OnUpdateHavePendingNotifications Registers a handler called for every updateHavePendingNotifications: Describes whether there are some pending notification updates. Can be used to prevent application from killing, while there are some pending notifications
+func (client *Client) OnUpdateHavePendingNotifications(handler func(update *UpdateHavePendingNotifications)) {
+	client.addUpdateHandler(UpdateHavePendingNotificationsType, func(update Update) {
+		handler(update.(*UpdateHavePendingNotifications))
+	})
+}
+
+// OnUpdateDeleteMessages Registers a handler called for every updateDeleteMessages: Some messages were deleted
+func (client *Client) OnUpdateDeleteMessages(handler func(update *UpdateDeleteMessages)) {
+	client.addUpdateHandler(UpdateDeleteMessagesType, func(update Update) {
+		handler(update.(*UpdateDeleteMessages))
+	})
+}
+
+// OnUpdateUserChatAction Registers a handler called for every updateUserChatAction: User activity in the chat has changed
+func (client *Client) OnUpdateUserChatAction(handler func(update *UpdateUserChatAction)) {
+	client.addUpdateHandler(UpdateUserChatActionType, func(update Update) {
+		handler(update.(*UpdateUserChatAction))
+	})
+}
+
+// OnUpdateUserStatus Registers a handler called for every updateUserStatus: The user went online or offline
+func (client *Client) OnUpdateUserStatus(handler func(update *UpdateUserStatus)) {
+	client.addUpdateHandler(UpdateUserStatusType, func(update Update) {
+		handler(update.(*UpdateUserStatus))
+	})
+}
+
+// OnUpdateUser Registers a handler called for every updateUser: Some data of a user has changed. This update is guaranteed to come before the user identifier is returned to the application
+func (client *Client) OnUpdateUser(handler func(update *UpdateUser)) {
+	client.addUpdateHandler(UpdateUserType, func(update Update) {
+		handler(update.(*UpdateUser))
+	})
+}
+
+// OnUpdateBasicGroup Registers a handler called for every updateBasicGroup: Some data of a basic group has changed. This update is guaranteed to come before the basic group identifier is returned to the application
+func (client *Client) OnUpdateBasicGroup(handler func(update *UpdateBasicGroup)) {
+	client.addUpdateHandler(UpdateBasicGroupType, func(update Update) {
+		handler(update.(*UpdateBasicGroup))
+	})
+}
+
+// OnUpdateSupergroup Registers a handler called for every updateSupergroup: Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application
+func (client *Client) OnUpdateSupergroup(handler func(update *UpdateSupergroup)) {
+	client.addUpdateHandler(UpdateSupergroupType, func(update Update) {
+		handler(update.(*UpdateSupergroup))
+	})
+}
+
+// OnUpdateSecretChat Registers a handler called for every updateSecretChat: Some data of a secret chat has changed. This update is guaranteed to come before the secret chat identifier is returned to the application
+func (client *Client) OnUpdateSecretChat(handler func(update *UpdateSecretChat)) {
+	client.addUpdateHandler(UpdateSecretChatType, func(update Update) {
+		handler(update.(*UpdateSecretChat))
+	})
+}
+
+// OnUpdateUserFullInfo Registers a handler called for every updateUserFullInfo: Some data from userFullInfo has been changed
+func (client *Client) OnUpdateUserFullInfo(handler func(update *UpdateUserFullInfo)) {
+	client.addUpdateHandler(UpdateUserFullInfoType, func(update Update) {
+		handler(update.(*UpdateUserFullInfo))
+	})
+}
+
+// OnUpdateBasicGroupFullInfo Registers a handler called for every updateBasicGroupFullInfo: Some data from basicGroupFullInfo has been changed
+func (client *Client) OnUpdateBasicGroupFullInfo(handler func(update *UpdateBasicGroupFullInfo)) {
+	client.addUpdateHandler(UpdateBasicGroupFullInfoType, func(update Update) {
+		handler(update.(*UpdateBasicGroupFullInfo))
+	})
+}
+
+// OnUpdateSupergroupFullInfo Registers a handler called for every updateSupergroupFullInfo: Some data from supergroupFullInfo has been changed
+func (client *Client) OnUpdateSupergroupFullInfo(handler func(update *UpdateSupergroupFullInfo)) {
+	client.addUpdateHandler(UpdateSupergroupFullInfoType, func(update Update) {
+		handler(update.(*UpdateSupergroupFullInfo))
+	})
+}
+
+// OnUpdateServiceNotification Registers a handler called for every updateServiceNotification: Service notification from the server. Upon receiving this the application must show a popup with the content of the notification
+func (client *Client) OnUpdateServiceNotification(handler func(update *UpdateServiceNotification)) {
+	client.addUpdateHandler(UpdateServiceNotificationType, func(update Update) {
+		handler(update.(*UpdateServiceNotification))
+	})
+}
+
+// OnUpdateFile Registers a handler called for every updateFile: Information about a file was updated
+func (client *Client) OnUpdateFile(handler func(update *UpdateFile)) {
+	client.addUpdateHandler(UpdateFileType, func(update Update) {
+		handler(update.(*UpdateFile))
+	})
+}
+
+// OnUpdateFileGenerationStart Registers a handler called for every updateFileGenerationStart: The file generation process needs to be started by the application
+func (client *Client) OnUpdateFileGenerationStart(handler func(update *UpdateFileGenerationStart)) {
+	client.addUpdateHandler(UpdateFileGenerationStartType, func(update Update) {
+		handler(update.(*UpdateFileGenerationStart))
+	})
+}
+
+// OnUpdateFileGenerationStop Registers a handler called for every updateFileGenerationStop: File generation is no longer needed
+func (client *Client) OnUpdateFileGenerationStop(handler func(update *UpdateFileGenerationStop)) {
+	client.addUpdateHandler(UpdateFileGenerationStopType, func(update Update) {
+		handler(update.(*UpdateFileGenerationStop))
+	})
+}
+
+// OnUpdateCall Registers a handler called for every updateCall: New call was created or information about a call was updated
+func (client *Client) OnUpdateCall(handler func(update *UpdateCall)) {
+	client.addUpdateHandler(UpdateCallType, func(update Update) {
+		handler(update.(*UpdateCall))
+	})
+}
+
+// OnUpdateGroupCall Registers a handler called for every updateGroupCall: Information about a group call was updated
+func (client *Client) OnUpdateGroupCall(handler func(update *UpdateGroupCall)) {
+	client.addUpdateHandler(UpdateGroupCallType, func(update Update) {
+		handler(update.(*UpdateGroupCall))
+	})
+}
+
+// OnUpdateGroupCallParticipant Registers a handler called for every updateGroupCallParticipant: Information about a group call participant was changed. The updates are sent only after the group call is received through getGroupCall and only if the call is joined or being joined
+func (client *Client) OnUpdateGroupCallParticipant(handler func(update *UpdateGroupCallParticipant)) {
+	client.addUpdateHandler(UpdateGroupCallParticipantType, func(update Update) {
+		handler(update.(*UpdateGroupCallParticipant))
+	})
+}
+
+// OnUpdateNewCallSignalingData Registers a handler called for every updateNewCallSignalingData: New call signaling data arrived
+func (client *Client) OnUpdateNewCallSignalingData(handler func(update *UpdateNewCallSignalingData)) {
+	client.addUpdateHandler(UpdateNewCallSignalingDataType, func(update Update) {
+		handler(update.(*UpdateNewCallSignalingData))
+	})
+}
+
+// OnUpdateUserPrivacySettingRules Registers a handler called for every updateUserPrivacySettingRules: Some privacy setting rules have been changed
+func (client *Client) OnUpdateUserPrivacySettingRules(handler func(update *UpdateUserPrivacySettingRules)) {
+	client.addUpdateHandler(UpdateUserPrivacySettingRulesType, func(update Update) {
+		handler(update.(*UpdateUserPrivacySettingRules))
+	})
+}
+
+// OnUpdateUnreadMessageCount Registers a handler called for every updateUnreadMessageCount: Number of unread messages in a chat list has changed. This update is sent only if the message database is used
+func (client *Client) OnUpdateUnreadMessageCount(handler func(update *UpdateUnreadMessageCount)) {
+	client.addUpdateHandler(UpdateUnreadMessageCountType, func(update Update) {
+		handler(update.(*UpdateUnreadMessageCount))
+	})
+}
+
+// OnUpdateUnreadChatCount Registers a handler called for every updateUnreadChatCount: Number of unread chats, i.e. with unread messages or marked as unread, has changed. This update is sent only if the message database is used
+func (client *Client) OnUpdateUnreadChatCount(handler func(update *UpdateUnreadChatCount)) {
+	client.addUpdateHandler(UpdateUnreadChatCountType, func(update Update) {
+		handler(update.(*UpdateUnreadChatCount))
+	})
+}
+
+// OnUpdateOption Registers a handler called for every updateOption: An option changed its value
+func (client *Client) OnUpdateOption(handler func(update *UpdateOption)) {
+	client.addUpdateHandler(UpdateOptionType, func(update Update) {
+		handler(update.(*UpdateOption))
+	})
+}
+
+// OnUpdateStickerSet Registers a handler called for every updateStickerSet: A sticker set has changed
+func (client *Client) OnUpdateStickerSet(handler func(update *UpdateStickerSet)) {
+	client.addUpdateHandler(UpdateStickerSetType, func(update Update) {
+		handler(update.(*UpdateStickerSet))
+	})
+}
+
+// OnUpdateInstalledStickerSets Registers a handler called for every updateInstalledStickerSets: The list of installed sticker sets was updated
+func (client *Client) OnUpdateInstalledStickerSets(handler func(update *UpdateInstalledStickerSets)) {
+	client.addUpdateHandler(UpdateInstalledStickerSetsType, func(update Update) {
+		handler(update.(*UpdateInstalledStickerSets))
+	})
+}
+
+// OnUpdateTrendingStickerSets Registers a handler called for every updateTrendingStickerSets: The list of trending sticker sets was updated or some of them were viewed
+func (client *Client) OnUpdateTrendingStickerSets(handler func(update *UpdateTrendingStickerSets)) {
+	client.addUpdateHandler(UpdateTrendingStickerSetsType, func(update Update) {
+		handler(update.(*UpdateTrendingStickerSets))
+	})
+}
+
+// OnUpdateRecentStickers Registers a handler called for every updateRecentStickers: The list of recently used stickers was updated
+func (client *Client) OnUpdateRecentStickers(handler func(update *UpdateRecentStickers)) {
+	client.addUpdateHandler(UpdateRecentStickersType, func(update Update) {
+		handler(update.(*UpdateRecentStickers))
+	})
+}
+
+// OnUpdateFavoriteStickers Registers a handler called for every updateFavoriteStickers: The list of favorite stickers was updated
+func (client *Client) OnUpdateFavoriteStickers(handler func(update *UpdateFavoriteStickers)) {
+	client.addUpdateHandler(UpdateFavoriteStickersType, func(update Update) {
+		handler(update.(*UpdateFavoriteStickers))
+	})
+}
+
+// OnUpdateSavedAnimations Registers a handler called for every updateSavedAnimations: The list of saved animations was updated
+func (client *Client) OnUpdateSavedAnimations(handler func(update *UpdateSavedAnimations)) {
+	client.addUpdateHandler(UpdateSavedAnimationsType, func(update Update) {
+		handler(update.(*UpdateSavedAnimations))
+	})
+}
+
+// OnUpdateSelectedBackground Registers a handler called for every updateSelectedBackground: The selected background has changed
+func (client *Client) OnUpdateSelectedBackground(handler func(update *UpdateSelectedBackground)) {
+	client.addUpdateHandler(UpdateSelectedBackgroundType, func(update Update) {
+		handler(update.(*UpdateSelectedBackground))
+	})
+}
+
+// OnUpdateLanguagePackStrings Registers a handler called for every updateLanguagePackStrings: Some language pack strings have been updated
+func (client *Client) OnUpdateLanguagePackStrings(handler func(update *UpdateLanguagePackStrings)) {
+	client.addUpdateHandler(UpdateLanguagePackStringsType, func(update Update) {
+		handler(update.(*UpdateLanguagePackStrings))
+	})
+}
+
+// OnUpdateConnectionState Registers a handler called for every updateConnectionState: The connection state has changed. This update must be used only to show a human-readable description of the connection state
+func (client *Client) OnUpdateConnectionState(handler func(update *UpdateConnectionState)) {
+	client.addUpdateHandler(UpdateConnectionStateType, func(update Update) {
+		handler(update.(*UpdateConnectionState))
+	})
+}
+
+// OnUpdateTermsOfService Registers a handler called for every updateTermsOfService: New terms of service must be accepted by the user. If the terms of service are declined, then the deleteAccount method should be called with the reason "Decline ToS update"
+func (client *Client) OnUpdateTermsOfService(handler func(update *UpdateTermsOfService)) {
+	client.addUpdateHandler(UpdateTermsOfServiceType, func(update Update) {
+		handler(update.(*UpdateTermsOfService))
+	})
+}
+
+// OnUpdateUsersNearby Registers a handler called for every updateUsersNearby: The list of users nearby has changed. The update is guaranteed to be sent only 60 seconds after a successful searchChatsNearby request
+func (client *Client) OnUpdateUsersNearby(handler func(update *UpdateUsersNearby)) {
+	client.addUpdateHandler(UpdateUsersNearbyType, func(update Update) {
+		handler(update.(*UpdateUsersNearby))
+	})
+}
+
+// OnUpdateDiceEmojis Registers a handler called for every updateDiceEmojis: The list of supported dice emojis has changed
+func (client *Client) OnUpdateDiceEmojis(handler func(update *UpdateDiceEmojis)) {
+	client.addUpdateHandler(UpdateDiceEmojisType, func(update Update) {
+		handler(update.(*UpdateDiceEmojis))
+	})
+}
+
+// OnUpdateAnimationSearchParameters Registers a handler called for every updateAnimationSearchParameters: The parameters of animation search through GetOption("animation_search_bot_username") bot has changed
+func (client *Client) OnUpdateAnimationSearchParameters(handler func(update *UpdateAnimationSearchParameters)) {
+	client.addUpdateHandler(UpdateAnimationSearchParametersType, func(update Update) {
+		handler(update.(*UpdateAnimationSearchParameters))
+	})
+}
+
+// OnUpdateSuggestedActions Registers a handler called for every updateSuggestedActions: The list of suggested to the user actions has changed
+func (client *Client) OnUpdateSuggestedActions(handler func(update *UpdateSuggestedActions)) {
+	client.addUpdateHandler(UpdateSuggestedActionsType, func(update Update) {
+		handler(update.(*UpdateSuggestedActions))
+	})
+}
+
+// OnUpdateNewInlineQuery Registers a handler called for every updateNewInlineQuery: A new incoming inline query; for bots only
+func (client *Client) OnUpdateNewInlineQuery(handler func(update *UpdateNewInlineQuery)) {
+	client.addUpdateHandler(UpdateNewInlineQueryType, func(update Update) {
+		handler(update.(*UpdateNewInlineQuery))
+	})
+}
+
+// OnUpdateNewChosenInlineResult Registers a handler called for every updateNewChosenInlineResult: The user has chosen a result of an inline query; for bots only
+func (client *Client) OnUpdateNewChosenInlineResult(handler func(update *UpdateNewChosenInlineResult)) {
+	client.addUpdateHandler(UpdateNewChosenInlineResultType, func(update Update) {
+		handler(update.(*UpdateNewChosenInlineResult))
+	})
+}
+
+// OnUpdateNewCallbackQuery Registers a handler called for every updateNewCallbackQuery: A new incoming callback query; for bots only
+func (client *Client) OnUpdateNewCallbackQuery(handler func(update *UpdateNewCallbackQuery)) {
+	client.addUpdateHandler(UpdateNewCallbackQueryType, func(update Update) {
+		handler(update.(*UpdateNewCallbackQuery))
+	})
+}
+
+// OnUpdateNewInlineCallbackQuery Registers a handler called for every updateNewInlineCallbackQuery: A new incoming callback query from a message sent via a bot; for bots only
+func (client *Client) OnUpdateNewInlineCallbackQuery(handler func(update *UpdateNewInlineCallbackQuery)) {
+	client.addUpdateHandler(UpdateNewInlineCallbackQueryType, func(update Update) {
+		handler(update.(*UpdateNewInlineCallbackQuery))
+	})
+}
+
+// OnUpdateNewShippingQuery Registers a handler called for every updateNewShippingQuery: A new incoming shipping query; for bots only. Only for invoices with flexible price
+func (client *Client) OnUpdateNewShippingQuery(handler func(update *UpdateNewShippingQuery)) {
+	client.addUpdateHandler(UpdateNewShippingQueryType, func(update Update) {
+		handler(update.(*UpdateNewShippingQuery))
+	})
+}
+
+// OnUpdateNewPreCheckoutQuery Registers a handler called for every updateNewPreCheckoutQuery: A new incoming pre-checkout query; for bots only. Contains full information about a checkout
+func (client *Client) OnUpdateNewPreCheckoutQuery(handler func(update *UpdateNewPreCheckoutQuery)) {
+	client.addUpdateHandler(UpdateNewPreCheckoutQueryType, func(update Update) {
+		handler(update.(*UpdateNewPreCheckoutQuery))
+	})
+}
+
+// OnUpdateNewCustomEvent Registers a handler called for every updateNewCustomEvent: A new incoming event; for bots only
+func (client *Client) OnUpdateNewCustomEvent(handler func(update *UpdateNewCustomEvent)) {
+	client.addUpdateHandler(UpdateNewCustomEventType, func(update Update) {
+		handler(update.(*UpdateNewCustomEvent))
+	})
+}
+
+// OnUpdateNewCustomQuery Registers a handler called for every updateNewCustomQuery: A new incoming query; for bots only
+func (client *Client) OnUpdateNewCustomQuery(handler func(update *UpdateNewCustomQuery)) {
+	client.addUpdateHandler(UpdateNewCustomQueryType, func(update Update) {
+		handler(update.(*UpdateNewCustomQuery))
+	})
+}
+
+// OnUpdatePoll Registers a handler called for every updatePoll: A poll was updated; for bots only
+func (client *Client) OnUpdatePoll(handler func(update *UpdatePoll)) {
+	client.addUpdateHandler(UpdatePollType, func(update Update) {
+		handler(update.(*UpdatePoll))
+	})
+}
+
+// OnUpdatePollAnswer Registers a handler called for every updatePollAnswer: A user changed the answer to a poll; for bots only
+func (client *Client) OnUpdatePollAnswer(handler func(update *UpdatePollAnswer)) {
+	client.addUpdateHandler(UpdatePollAnswerType, func(update Update) {
+		handler(update.(*UpdatePollAnswer))
+	})
+}
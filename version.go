@@ -0,0 +1,118 @@
+package tdlib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// SchemaMismatchPolicy decides what happens on startup when the connected TDLib isn't the version
+// the types and methods were generated for, see SchemaVersion
+type SchemaMismatchPolicy string
+
+// SchemaMismatchPolicy values
+const (
+	SchemaMismatchWarn   SchemaMismatchPolicy = "warn"   // Log a warning and carry on (default)
+	SchemaMismatchError  SchemaMismatchPolicy = "error"  // Abort the startup with a *SchemaVersionError
+	SchemaMismatchIgnore SchemaMismatchPolicy = "ignore" // Don't check at all
+)
+
+// ErrSchemaMismatch is the class of *SchemaVersionError, use errors.Is to check for it
+var ErrSchemaMismatch = errors.New("schema mismatch")
+
+// SchemaVersionError is returned on startup under SchemaMismatchError when the version or commit_hash option
+// of the connected TDLib differs from SchemaVersion or SchemaCommitHash
+type SchemaVersionError struct {
+	Version          string // version option of the connected TDLib
+	CommitHash       string // commit_hash option of the connected TDLib, empty if it wasn't sent
+	SchemaVersion    string
+	SchemaCommitHash string
+}
+
+// Error returns the error message
+func (e *SchemaVersionError) Error() string {
+	return fmt.Sprintf("%v: libtdjson is TDLib %s, package tdlib was generated for TDLib %s",
+		ErrSchemaMismatch, describeVersion(e.Version, e.CommitHash), describeVersion(e.SchemaVersion, e.SchemaCommitHash))
+}
+
+// Is reports whether target is ErrSchemaMismatch
+func (e *SchemaVersionError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+// describeVersion formats a version and an optional commit hash, e.g. 1.7.0 (commit abcdef0)
+func describeVersion(version string, commitHash string) string {
+	if commitHash == "" {
+		return version
+	}
+	return fmt.Sprintf("%s (commit %s)", version, commitHash)
+}
+
+// checkSchemaVersion applies Config.SchemaMismatch on startup, right before tdlib parameters are sent.
+// The mismatch itself has already been logged by observeSchemaVersion when the options arrived,
+// so under SchemaMismatchError it's returned, and a TDLib that hasn't sent its version yet is warned about.
+func (client *Client) checkSchemaVersion() error {
+	if client.Config.SchemaMismatch == SchemaMismatchIgnore {
+		return nil
+	}
+
+	if _, ok := client.options.String("version"); !ok {
+		getLogger().Warn("tdlib: libtdjson didn't send its version, it can't be compared with the version package tdlib was generated for",
+			"schema_version", SchemaVersion)
+		return nil
+	}
+
+	if err := client.schemaVersionError(); err != nil && client.Config.SchemaMismatch == SchemaMismatchError {
+		return err
+	}
+	return nil
+}
+
+// observeSchemaVersion compares the schema with the version and commit_hash options as soon as TDLib announces them,
+// whoever sends tdlib parameters. A mismatch is logged once, as a warning or as an error under SchemaMismatchError.
+func (client *Client) observeSchemaVersion() {
+	if client.Config.SchemaMismatch == SchemaMismatchIgnore {
+		return
+	}
+
+	err := client.schemaVersionError()
+	if err == nil || !client.schemaMismatchLogged.CompareAndSwap(false, true) {
+		return
+	}
+
+	level := slog.LevelWarn
+	if client.Config.SchemaMismatch == SchemaMismatchError {
+		level = slog.LevelError
+	}
+	getLogger().Log(context.Background(), level, "tdlib: libtdjson doesn't match the version package tdlib was generated for",
+		"version", err.Version, "commit_hash", err.CommitHash, "schema_version", SchemaVersion, "schema_commit_hash", SchemaCommitHash)
+}
+
+// schemaVersionError compares the version and commit_hash options of the connected TDLib with the schema,
+// nil if they match or the version isn't known yet. Commit hashes are only compared if both are known.
+func (client *Client) schemaVersionError() *SchemaVersionError {
+	version, ok := client.options.String("version")
+	if !ok {
+		return nil
+	}
+	commitHash, _ := client.options.String("commit_hash")
+
+	if version == SchemaVersion && (commitHash == "" || SchemaCommitHash == "" || sameCommit(commitHash, SchemaCommitHash)) {
+		return nil
+	}
+
+	return &SchemaVersionError{
+		Version:          version,
+		CommitHash:       commitHash,
+		SchemaVersion:    SchemaVersion,
+		SchemaCommitHash: SchemaCommitHash,
+	}
+}
+
+// sameCommit reports whether two commit hashes match, either of them may be abbreviated
+func sameCommit(a string, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
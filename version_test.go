@@ -0,0 +1,132 @@
+package tdlib_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Arman92/go-tdlib"
+	"github.com/Arman92/go-tdlib/tdlibtest"
+)
+
+func TestSchemaMismatchPolicy(t *testing.T) {
+	tests := []struct {
+		policy   tdlib.SchemaMismatchPolicy
+		version  string
+		mismatch bool
+	}{
+		{tdlib.SchemaMismatchWarn, "1.8.0", false},
+		{tdlib.SchemaMismatchError, "1.8.0", true},
+		{tdlib.SchemaMismatchError, tdlib.SchemaVersion, false},
+		{tdlib.SchemaMismatchIgnore, "1.8.0", false},
+	}
+
+	for _, test := range tests {
+		server := tdlibtest.NewServer()
+		server.Handle("getAuthorizationState", tdlib.NewAuthorizationStateWaitTdlibParameters())
+		server.Handle("setTdlibParameters", tdlib.NewOk())
+		client := server.NewClient(tdlib.Config{
+			APIID:              "1",
+			APIHash:            "0123456789abcdef0123456789abcdef",
+			SystemLanguageCode: "en",
+			DeviceModel:        "test",
+			SystemVersion:      "1",
+			ApplicationVersion: "1",
+			SchemaMismatch:     test.policy,
+		})
+
+		server.PushUpdate(tdlib.NewUpdateOption("version", tdlib.NewOptionValueString(test.version)))
+		deadline := time.Now().Add(3 * time.Second)
+		for _, ok := client.Options().String("version"); !ok && time.Now().Before(deadline); _, ok = client.Options().String("version") {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		_, err := client.Authorize()
+		client.DestroyInstance()
+
+		if errors.Is(err, tdlib.ErrSchemaMismatch) != test.mismatch {
+			t.Errorf("policy %q, version %s: Authorize returned %v", test.policy, test.version, err)
+		}
+		if !test.mismatch && err != nil {
+			t.Errorf("policy %q, version %s: Authorize returned %v", test.policy, test.version, err)
+		}
+	}
+}
+
+// logBuffer collects the package logs of a test, safe for the receive loop to write to
+type logBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+// captureLogs routes the package logs into the returned buffer until the end of the test
+func captureLogs(t *testing.T) *logBuffer {
+	logs := &logBuffer{}
+	tdlib.SetLogger(slog.New(slog.NewTextHandler(logs, nil)))
+	t.Cleanup(func() {
+		tdlib.SetLogger(slog.Default())
+	})
+	return logs
+}
+
+func TestSchemaMismatchLoggedWhenVersionArrives(t *testing.T) {
+	logs := captureLogs(t)
+	server := tdlibtest.NewServer()
+	server.Handle("getAuthorizationState", tdlib.NewAuthorizationStateReady())
+	client := server.NewClient(tdlib.Config{})
+	defer client.DestroyInstance()
+
+	// the client never sends tdlib parameters through the package
+	server.PushUpdate(tdlib.NewUpdateOption("version", tdlib.NewOptionValueString("1.8.0")))
+	server.PushUpdate(tdlib.NewUpdateOption("commit_hash", tdlib.NewOptionValueString("abcdef0")))
+	if _, err := client.GetAuthorizationState(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := strings.Count(logs.String(), "doesn't match the version"); count != 1 {
+		t.Errorf("mismatch logged %d times, want once:\n%s", count, logs)
+	}
+	if !strings.Contains(logs.String(), "version=1.8.0") {
+		t.Errorf("mismatch log doesn't name the version:\n%s", logs)
+	}
+}
+
+func TestSchemaVersionUnknownOnStartup(t *testing.T) {
+	logs := captureLogs(t)
+	server := tdlibtest.NewServer()
+	server.Handle("getAuthorizationState", tdlib.NewAuthorizationStateWaitTdlibParameters())
+	server.Handle("setTdlibParameters", tdlib.NewOk())
+	client := server.NewClient(tdlib.Config{
+		APIID:              "1",
+		APIHash:            "0123456789abcdef0123456789abcdef",
+		SystemLanguageCode: "en",
+		DeviceModel:        "test",
+		SystemVersion:      "1",
+		ApplicationVersion: "1",
+		SchemaMismatch:     tdlib.SchemaMismatchError,
+	})
+	defer client.DestroyInstance()
+
+	if _, err := client.Authorize(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), "didn't send its version") {
+		t.Errorf("unknown version not warned about:\n%s", logs)
+	}
+}